@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"slices"
 
 	"github.com/spf13/cobra"
 	"connectrpc.com/connect"
@@ -17,13 +18,19 @@ import (
 	"github.com/at-ishikawa/langner/internal/database"
 	"github.com/at-ishikawa/langner/internal/dictionary"
 	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+	"github.com/at-ishikawa/langner/internal/ebook"
+	"github.com/at-ishikawa/langner/internal/frequency"
 	"github.com/at-ishikawa/langner/internal/inference"
 	"github.com/at-ishikawa/langner/internal/inference/mock"
 	"github.com/at-ishikawa/langner/internal/inference/openai"
 	"github.com/at-ishikawa/langner/internal/learning"
 	"github.com/at-ishikawa/langner/internal/notebook"
 	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/search"
 	"github.com/at-ishikawa/langner/internal/server"
+	"github.com/at-ishikawa/langner/internal/stt"
+	"github.com/at-ishikawa/langner/internal/tts"
+	"github.com/at-ishikawa/langner/internal/versioning"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
@@ -78,6 +85,12 @@ func run(ctx context.Context) error {
 		slog.Warn("failed to load dictionary cache", "error", err)
 		dictionaryMap = make(map[string]rapidapi.Response)
 	}
+	var frequencyList *frequency.List
+	if cfg.Frequency.ListFile != "" {
+		if frequencyList, err = frequency.LoadList(cfg.Frequency.ListFile); err != nil {
+			slog.Warn("frequency list disabled — failed to load the list file", "error", err)
+		}
+	}
 
 	errorLogger := connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
 		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
@@ -92,6 +105,12 @@ func run(ctx context.Context) error {
 	// Set up repositories with dual storage when DB is configured
 	calculator := notebook.NewIntervalCalculator(cfg.Quiz.Algorithm, cfg.Quiz.FixedIntervals)
 	yamlLearningRepo := learning.NewYAMLLearningRepository(cfg.Notebooks.LearningNotesDirectory, calculator)
+	recorder, err := versioning.NewRecorder(cfg.Versioning)
+	if err != nil {
+		slog.Warn("learning data versioning disabled — repository init failed", "error", err)
+		recorder = versioning.NopRecorder()
+	}
+	yamlLearningRepo = yamlLearningRepo.WithRecorder(recorder)
 	var learningRepo learning.LearningRepository = yamlLearningRepo
 	var noteRepo notebook.NoteRepository
 	var defsDir string
@@ -99,36 +118,80 @@ func run(ctx context.Context) error {
 	yamlNoteRepo := notebook.NewYAMLNoteRepositoryWithDefsDir(defsDir)
 	noteRepo = yamlNoteRepo
 
-	// Analytics always reads from YAML: the on-disk learning history files are
-	// the only place etymology quiz results are persisted today —
-	// SaveEtymologyOriginResult writes YAML directly and does not go through
-	// the learning repository, so the DB's learning_logs has only vocab rows.
-	// Once etymology gets first-class DB storage we can swap this for a
-	// hybrid that pulls vocab from DB and etymology from YAML.
+	// Analytics reads from YAML unless a database is connected below. Every
+	// quiz result, etymology and grammar included, goes through the learning
+	// repository, so learning_logs holds the same attempts as the YAML files.
+	var metadataResolver analytics.MetadataResolver
 	yamlAnalyticsRepo := analytics.NewYAMLRepository(cfg.Notebooks.LearningNotesDirectory)
 	// Journals are read alongside stories (they share the story format, see
 	// quiz.Service.newReader) so a grammar attempt's notebook — a journal —
 	// resolves here too; LoadGrammars then attaches the corrections
 	// themselves, which resolveGrammar needs to turn a correction id back
 	// into its mistake/fix text.
-	analyticsStoryDirectories := append(append([]string{}, cfg.Notebooks.StoriesDirectories...), cfg.Notebooks.JournalsDirectories...)
-	if reader, err := notebook.NewReader(
-		analyticsStoryDirectories,
-		cfg.Notebooks.FlashcardsDirectories,
-		cfg.Notebooks.BooksDirectories,
-		cfg.Notebooks.DefinitionsDirectories,
-		cfg.Notebooks.EtymologyDirectories,
-		dictionaryMap,
-	); err != nil {
-		slog.Warn("analytics meaning lookup disabled — notebook reader init failed", "error", err)
-	} else if err := reader.LoadGrammars(cfg.Notebooks.GrammarsDirectories); err != nil {
-		slog.Warn("analytics grammar meaning lookup disabled — LoadGrammars failed", "error", err)
-	} else {
-		yamlAnalyticsRepo = yamlAnalyticsRepo.WithMetadataResolver(analytics.NewNotebookMetadataResolver(reader))
+	readerDirectories := notebook.ReaderDirectories{
+		Stories:     cfg.Notebooks.StoriesDirectories,
+		Journals:    cfg.Notebooks.JournalsDirectories,
+		Flashcards:  cfg.Notebooks.FlashcardsDirectories,
+		Books:       cfg.Notebooks.BooksDirectories,
+		Definitions: cfg.Notebooks.DefinitionsDirectories,
+		Etymology:   cfg.Notebooks.EtymologyDirectories,
+		Grammars:    cfg.Notebooks.GrammarsDirectories,
+	}
+	// In postgres storage mode the notebooks are read from the database
+	// below instead of these directories.
+	var readerSource notebook.ReaderSource
+	if cfg.Server.HotReload && !cfg.Storage.IsPostgres() {
+		watchedReader, err := notebook.NewWatchedReader(readerDirectories, cfg.Notebooks.LearningNotesDirectory, dictionaryMap)
+		if err != nil {
+			slog.Warn("notebook hot reload disabled — watcher init failed", "error", err)
+		} else {
+			app.AddShutdownHook(func(ctx context.Context) error {
+				return watchedReader.Close()
+			})
+			readerSource = watchedReader
+			metadataResolver = analytics.NewWatchedNotebookMetadataResolver(watchedReader)
+			yamlAnalyticsRepo = yamlAnalyticsRepo.WithMetadataResolver(metadataResolver)
+			slog.Info("notebook hot reload enabled")
+		}
+	}
+	if readerSource == nil && !cfg.Storage.IsPostgres() {
+		if reader, err := notebook.NewReaderFromDirectories(readerDirectories, dictionaryMap); err != nil {
+			slog.Warn("analytics meaning lookup disabled — notebook reader init failed", "error", err)
+		} else {
+			metadataResolver = analytics.NewNotebookMetadataResolver(reader)
+			yamlAnalyticsRepo = yamlAnalyticsRepo.WithMetadataResolver(metadataResolver)
+		}
 	}
 	analyticsRepo := analytics.Repository(yamlAnalyticsRepo)
+	var historySource notebook.LearningHistorySource
 
-	if cfg.Database.Host != "" && cfg.Database.Password != "" {
+	if cfg.Storage.IsPostgres() {
+		// The database is the source of truth: every read and write goes to
+		// it and the YAML directories are only produced by export-db, so a
+		// missing connection is fatal instead of a fallback.
+		db, err := database.Open(cfg.Database)
+		if err != nil {
+			return fmt.Errorf("database.Open() > %w", err)
+		}
+		app.AddShutdownHook(func(ctx context.Context) error {
+			return db.Close()
+		})
+		dbLearningRepo := learning.NewDBLearningRepository(db)
+		dbNoteRepo := notebook.NewDBNoteRepository(db)
+		learningRepo = dbLearningRepo
+		noteRepo = dbNoteRepo
+		dbReaderSource := notebook.NewNoteReaderSource(dbNoteRepo, dictionaryMap)
+		readerSource = dbReaderSource
+		historySource = learning.NewRepositoryHistorySource(dbNoteRepo, dbLearningRepo)
+		metadataResolver = analytics.NewWatchedNotebookMetadataResolver(dbReaderSource)
+		// The database stores no etymology notebooks, journals or grammar
+		// annotations, so their directories are not read in this mode.
+		if dirs := slices.Concat(cfg.Notebooks.EtymologyDirectories, cfg.Notebooks.JournalsDirectories, cfg.Notebooks.GrammarsDirectories); len(dirs) > 0 {
+			slog.Warn("postgres storage serves no etymology notebooks, journals or grammars; ignoring their directories", "directories", dirs)
+		}
+		analyticsRepo = analytics.NewDBRepository(db).WithMetadataResolver(metadataResolver)
+		slog.Info("database connected, postgres storage enabled")
+	} else if cfg.Database.Host != "" && cfg.Database.Password != "" {
 		db, err := database.Open(cfg.Database)
 		if err != nil {
 			slog.Warn("failed to open database, running with YAML-only storage", "error", err)
@@ -140,11 +203,29 @@ func run(ctx context.Context) error {
 			dbNoteRepo := notebook.NewDBNoteRepository(db)
 			learningRepo = learning.NewMultiLearningRepository(yamlLearningRepo, dbLearningRepo)
 			noteRepo = notebook.NewMultiNoteRepository(yamlNoteRepo, dbNoteRepo)
+			analyticsRepo = analytics.NewDBRepository(db).WithMetadataResolver(metadataResolver)
 			slog.Info("database connected, dual storage enabled")
 		}
 	}
 
 	svc := quiz.NewService(cfg.Notebooks, inferenceClient, dictionaryMap, learningRepo, cfg.Quiz)
+	svc.SetRecorder(recorder)
+	if frequencyList != nil {
+		svc.SetFrequencyList(frequencyList)
+	}
+	// Dictation lines without a recording are synthesized; without a
+	// synthesizer only recorded lines are quizzed.
+	if synthesizer, err := tts.NewSynthesizer(cfg.TTS); err != nil {
+		slog.Warn("text-to-speech is not available, dictation uses recorded lines only", "error", err)
+	} else {
+		svc.SetSynthesizer(synthesizer)
+	}
+	if readerSource != nil {
+		svc.SetReaderSource(readerSource)
+	}
+	if historySource != nil {
+		svc.SetLearningHistorySource(historySource)
+	}
 
 	dictConfig := dictionary.Config{
 		RapidAPIHost: cfg.Dictionaries.RapidAPI.Host,
@@ -152,10 +233,52 @@ func run(ctx context.Context) error {
 	}
 	dictReader := dictionary.NewReader(cfg.Dictionaries.RapidAPI.CacheDirectory, dictConfig)
 	notebookHandler := server.NewNotebookHandler(cfg.Notebooks, cfg.Templates, dictionaryMap, dictReader, inferenceClient, noteRepo)
+	if readerSource != nil {
+		notebookHandler.SetReaderSource(readerSource)
+	}
+	if historySource != nil {
+		notebookHandler.SetLearningHistorySource(historySource)
+	}
+	notebookHandler.SetPDFFonts(cfg.PDF)
+	// The search index is rebuilt when a notebook file changes. Notebooks
+	// read from the database have no files to tell that by, so they are
+	// indexed again on every search.
+	searchDirectories := readerDirectories.All()
+	if cfg.Storage.IsPostgres() {
+		searchDirectories = nil
+	}
+	loadSearchReader := func() (*notebook.Reader, error) {
+		return notebook.NewReaderFromDirectories(readerDirectories, dictionaryMap)
+	}
+	if readerSource != nil {
+		loadSearchReader = readerSource.Reader
+	}
+	notebookHandler.SetSearchStore(search.NewStore(searchDirectories, cfg.Search.IndexFile, loadSearchReader))
+	var booksDir string
+	if len(cfg.Notebooks.BooksDirectories) > 0 {
+		booksDir = cfg.Notebooks.BooksDirectories[0]
+	}
+	ebookManager := ebook.NewManager(cfg.Books.RepoDirectory, cfg.Books.RepositoriesFile, booksDir)
+	notebookHandler.SetPassageStore(search.NewPassageStore(searchDirectories, loadSearchReader, ebookManager.List))
+	leechThreshold := analytics.LeechThreshold{Lapses: cfg.Quiz.Leech.Lapses, WrongStreak: cfg.Quiz.Leech.WrongStreak}
+	notebookHandler.SetLeechThreshold(leechThreshold)
+	if frequencyList != nil {
+		notebookHandler.SetFrequencyList(frequencyList)
+	}
 
 	handler := server.NewQuizHandler(svc)
 	handler.SetNoteRepository(noteRepo)
+	if transcriber, err := stt.NewTranscriber(cfg.STT); err != nil {
+		slog.Warn("spoken answers disabled — speech-to-text init failed", "error", err)
+	} else if transcriber != nil {
+		handler.SetTranscriber(transcriber)
+		slog.Info("spoken answers enabled", "stt", cfg.STT.Mode)
+	}
 	analyticsHandler := server.NewAnalyticsHandler(analyticsRepo)
+	analyticsHandler.SetLeechThreshold(leechThreshold)
+	if frequencyList != nil {
+		analyticsHandler.SetFrequencyList(frequencyList)
+	}
 	path, h := apiv1connect.NewQuizServiceHandler(handler, errorLogger)
 	notebookPath, notebookH := apiv1connect.NewNotebookServiceHandler(notebookHandler, errorLogger)
 	analyticsPath, analyticsH := apiv1connect.NewAnalyticsServiceHandler(analyticsHandler, errorLogger)
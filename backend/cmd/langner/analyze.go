@@ -2,8 +2,12 @@ package main
 
 import (
 	"fmt"
+	"os"
 
+	"github.com/at-ishikawa/langner/internal/analytics"
 	"github.com/at-ishikawa/langner/internal/cli"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +17,8 @@ func newAnalyzeCommand() *cobra.Command {
 		Short: "Analyze learning progress and statistics",
 	}
 	cmd.AddCommand(newAnalyzeReportCommand())
+	cmd.AddCommand(newAnalyzeLeechesCommand())
+	cmd.AddCommand(newAnalyzeConfusionsCommand())
 	return cmd
 }
 
@@ -44,3 +50,97 @@ func newAnalyzeReportCommand() *cobra.Command {
 
 	return cmd
 }
+
+func newAnalyzeLeechesCommand() *cobra.Command {
+	var notebookID, quizType, action string
+	var lapses, wrongStreak int
+
+	cmd := &cobra.Command{
+		Use:   "leeches",
+		Short: "List words that keep failing, and optionally suspend them, move them to the relearn pool or give them a mnemonic",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			leechAction := cli.LeechAction(action)
+			switch leechAction {
+			case cli.LeechActionNone, cli.LeechActionSuspend, cli.LeechActionRelearn, cli.LeechActionMnemonic:
+			default:
+				return fmt.Errorf("--action must be %q, %q or %q", cli.LeechActionSuspend, cli.LeechActionRelearn, cli.LeechActionMnemonic)
+			}
+			if lapses < 0 || wrongStreak < 0 {
+				return fmt.Errorf("--lapses and --wrong-streak must not be negative")
+			}
+
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			threshold := analytics.LeechThreshold{Lapses: cfg.Quiz.Leech.Lapses, WrongStreak: cfg.Quiz.Leech.WrongStreak}
+			if cmd.Flags().Changed("lapses") {
+				threshold.Lapses = lapses
+			}
+			if cmd.Flags().Changed("wrong-streak") {
+				threshold.WrongStreak = wrongStreak
+			}
+
+			var svc *quiz.Service
+			if leechAction == cli.LeechActionMnemonic {
+				svc, err = newEnrichService(cfg, false)
+			} else {
+				svc, err = newWorksheetService(cfg)
+			}
+			if err != nil {
+				return err
+			}
+			repo := analytics.NewYAMLRepository(cfg.Notebooks.LearningNotesDirectory)
+			query := analytics.LeechQuery{
+				Threshold: threshold,
+				Filters:   analytics.Filters{NotebookID: notebookID, QuizType: quizType},
+			}
+			return cli.RunAnalyzeLeeches(cmd.Context(), os.Stdout, repo, query, leechAction, svc)
+		},
+	}
+
+	cmd.Flags().StringVar(&notebookID, "notebook", "", "Only analyze this notebook (learning history file name)")
+	cmd.Flags().StringVar(&quizType, "quiz-type", "", "Only analyze this quiz type (e.g. notebook, reverse)")
+	cmd.Flags().IntVar(&lapses, "lapses", 0, "Lapses that make a leech (default quiz.leech.lapses, 0 disables)")
+	cmd.Flags().IntVar(&wrongStreak, "wrong-streak", 0, "Consecutive wrong answers that make a leech (default quiz.leech.wrong_streak, 0 disables)")
+	cmd.Flags().StringVar(&action, "action", "", "Apply to every leech: suspend (exclude from its quiz type), relearn (move into the relearn pool) or mnemonic (write a memory hook into its memo)")
+
+	return cmd
+}
+
+func newAnalyzeConfusionsCommand() *cobra.Command {
+	var notebookID, quizType string
+
+	cmd := &cobra.Command{
+		Use:   "confusions",
+		Short: "List pairs of words whose meanings your wrong answers mix up",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			reader, err := notebook.NewReader(
+				cfg.Notebooks.StoriesDirectories,
+				cfg.Notebooks.FlashcardsDirectories,
+				cfg.Notebooks.BooksDirectories,
+				cfg.Notebooks.DefinitionsDirectories,
+				cfg.Notebooks.EtymologyDirectories,
+				nil,
+			)
+			if err != nil {
+				return fmt.Errorf("notebook.NewReader: %w", err)
+			}
+			repo := analytics.NewYAMLRepository(cfg.Notebooks.LearningNotesDirectory).
+				WithMetadataResolver(analytics.NewNotebookMetadataResolver(reader))
+			query := analytics.ConfusionQuery{
+				Filters: analytics.Filters{NotebookID: notebookID, QuizType: quizType},
+			}
+			return cli.RunAnalyzeConfusions(cmd.Context(), os.Stdout, repo, query)
+		},
+	}
+
+	cmd.Flags().StringVar(&notebookID, "notebook", "", "Only analyze this notebook (learning history file name)")
+	cmd.Flags().StringVar(&quizType, "quiz-type", "", "Only analyze this quiz type (notebook, reverse or freeform)")
+
+	return cmd
+}
@@ -70,3 +70,60 @@ func TestNewAnalyzeReportCommand_RunE_WithConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAnalyzeLeechesCommand_RunE(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "unknown action",
+			args:    []string{"--action", "delete"},
+			wantErr: "--action must be",
+		},
+		{
+			name:    "negative lapses",
+			args:    []string{"--lapses", "-1"},
+			wantErr: "must not be negative",
+		},
+		{
+			name:    "invalid config",
+			args:    []string{},
+			wantErr: "configuration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.name == "invalid config" {
+				cfgPath := setupBrokenConfigFile(t)
+				setConfigFile(t, cfgPath)
+			}
+			cmd := newAnalyzeLeechesCommand()
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestNewAnalyzeLeechesCommand_RunE_WithConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	setConfigFile(t, cfgPath)
+
+	cmd := newAnalyzeLeechesCommand()
+	cmd.SetArgs([]string{"--lapses", "2", "--action", "suspend"})
+	assert.NoError(t, cmd.Execute())
+}
+
+func TestNewAnalyzeConfusionsCommand_RunE_WithConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	setConfigFile(t, cfgPath)
+
+	cmd := newAnalyzeConfusionsCommand()
+	cmd.SetArgs([]string{"--quiz-type", "reverse"})
+	assert.NoError(t, cmd.Execute())
+}
@@ -43,7 +43,13 @@ revert. Use --dry-run to preview the counts without writing any files.`,
 			if err != nil {
 				return err
 			}
-			return assignIDs(cmd.Context(), cfg, dryRun, os.Stdout)
+			if err := assignIDs(cmd.Context(), cfg, dryRun, os.Stdout); err != nil {
+				return err
+			}
+			if dryRun {
+				return nil
+			}
+			return recordChange(cfg, "Assign ids to source vocabulary")
 		},
 	}
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report counts without writing any files")
@@ -61,7 +67,7 @@ func assignIDs(ctx context.Context, cfg *config.Config, dryRun bool, w io.Writer
 	sourceDirs = append(sourceDirs, cfg.Notebooks.BooksDirectories...)
 	sourceDirs = append(sourceDirs, cfg.Notebooks.DefinitionsDirectories...)
 
-	files, err := collectYAMLFiles(sourceDirs)
+	files, err := notebook.CollectYAMLFiles(sourceDirs)
 	if err != nil {
 		return fmt.Errorf("collect source files: %w", err)
 	}
@@ -219,43 +225,3 @@ func rekeyLearningHistories(ctx context.Context, cfg *config.Config, w io.Writer
 	_, _ = fmt.Fprintf(w, "Re-keyed %d learning-history entr(y/ies)\n", totalRekeyed)
 	return nil
 }
-
-// collectYAMLFiles returns every .yml file under the given directories,
-// de-duplicated and sorted for deterministic processing.
-func collectYAMLFiles(dirs []string) ([]string, error) {
-	seen := make(map[string]bool)
-	var files []string
-	for _, dir := range dirs {
-		if dir == "" {
-			continue
-		}
-		info, err := os.Stat(dir)
-		if err != nil {
-			if os.IsNotExist(err) {
-				continue
-			}
-			return nil, err
-		}
-		if !info.IsDir() {
-			continue
-		}
-		err = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
-			if err != nil {
-				return err
-			}
-			if d.IsDir() || filepath.Ext(path) != ".yml" {
-				return nil
-			}
-			if !seen[path] {
-				seen[path] = true
-				files = append(files, path)
-			}
-			return nil
-		})
-		if err != nil {
-			return nil, err
-		}
-	}
-	sort.Strings(files)
-	return files, nil
-}
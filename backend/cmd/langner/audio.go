@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/tts"
+)
+
+func newNotebookAudioCommand() *cobra.Command {
+	var dryRun bool
+	command := &cobra.Command{
+		Use:   "audio [notebook id...]",
+		Short: "Synthesize pronunciation audio for notes without any",
+		Long: `Synthesize the pronunciation of every story, book, flashcard and
+definitions-book note that has no audio yet with the local text-to-speech
+program configured under tts (espeak-ng by default, or piper). The audio is
+cached under audio/ next to each notebook's index.yml, or next to a
+standalone definitions book, and its path is added to the note as
+"audio:" without reformatting any other line. Notes that already have an
+audio, such as your own recordings, are left alone.
+
+Without arguments every notebook is processed. Use --dry-run to list the
+notes that would get audio without running the synthesizer.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			synthesizer, err := tts.NewSynthesizer(cfg.TTS)
+			if err != nil {
+				return err
+			}
+			added, err := synthesizeNotebookAudio(cmd.Context(), cfg, synthesizer, args, dryRun, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			if dryRun || added == 0 {
+				return nil
+			}
+			return recordChange(cfg, "Synthesize pronunciation audio")
+		},
+	}
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "List the notes that would get audio without writing any files")
+	return command
+}
+
+// audioNotebook is a notebook whose source files get audio.
+type audioNotebook struct {
+	id        string
+	directory string
+	files     []string
+}
+
+// synthesizeNotebookAudio adds audio to the notes of the notebooks with
+// notebookIDs, or of every notebook when empty, and returns the number of
+// notes it added audio to.
+func synthesizeNotebookAudio(ctx context.Context, cfg *config.Config, synthesizer tts.Synthesizer, notebookIDs []string, dryRun bool, w io.Writer) (int, error) {
+	reader, err := notebook.NewReader(
+		cfg.Notebooks.StoriesDirectories,
+		cfg.Notebooks.FlashcardsDirectories,
+		cfg.Notebooks.BooksDirectories,
+		cfg.Notebooks.DefinitionsDirectories,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("build reader: %w", err)
+	}
+
+	var notebooks []audioNotebook
+	for id, index := range reader.GetStoryIndexes() {
+		notebooks = append(notebooks, audioNotebook{id: id, directory: index.Path, files: index.NotebookPaths})
+	}
+	for id, index := range reader.GetFlashcardIndexes() {
+		notebooks = append(notebooks, audioNotebook{id: id, directory: index.Path, files: index.NotebookPaths})
+	}
+	for _, id := range reader.GetDefinitionsBookIDs() {
+		nb, err := definitionsAudioNotebook(reader, id)
+		if err != nil {
+			return 0, err
+		}
+		notebooks = append(notebooks, nb)
+	}
+	sort.SliceStable(notebooks, func(i, j int) bool { return notebooks[i].id < notebooks[j].id })
+	if len(notebookIDs) > 0 {
+		// A definitions book shares its id with the book it annotates, so
+		// one id can select both.
+		byID := make(map[string][]audioNotebook, len(notebooks))
+		for _, nb := range notebooks {
+			byID[nb.id] = append(byID[nb.id], nb)
+		}
+		notebooks = notebooks[:0]
+		for _, id := range notebookIDs {
+			selected, ok := byID[id]
+			if !ok {
+				return 0, fmt.Errorf("notebook %s not found", id)
+			}
+			notebooks = append(notebooks, selected...)
+		}
+	}
+
+	cache := tts.NewCache(synthesizer)
+	totalAdded, totalSynthesized := 0, 0
+	for _, nb := range notebooks {
+		audioFor := func(entry notebook.AudioEntry) (string, error) {
+			if dryRun {
+				return cache.Path(entry.Key()), nil
+			}
+			path, synthesized, err := cache.Audio(ctx, nb.directory, entry.Key(), entry.Text())
+			if err != nil {
+				return "", err
+			}
+			if synthesized {
+				totalSynthesized++
+			}
+			return path, nil
+		}
+
+		for _, name := range nb.files {
+			file := filepath.Join(nb.directory, name)
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return totalAdded, fmt.Errorf("read %s: %w", file, err)
+			}
+			// Book chapters holding raw prose don't always parse as strict
+			// YAML; they carry no entries, so skip them like assign-ids does.
+			var doc yaml.Node
+			if err := yaml.Unmarshal(data, &doc); err != nil {
+				_, _ = fmt.Fprintf(w, "  skip (unparseable YAML): %s\n", file)
+				continue
+			}
+			out, added, err := notebook.AddAudioToSourceYAML(data, audioFor)
+			if err != nil {
+				return totalAdded, fmt.Errorf("add audio in %s: %w", file, err)
+			}
+			if added == 0 {
+				continue
+			}
+			totalAdded += added
+			if !dryRun {
+				if err := os.WriteFile(file, out, 0o644); err != nil {
+					return totalAdded, fmt.Errorf("write %s: %w", file, err)
+				}
+			}
+			_, _ = fmt.Fprintf(w, "  +%d audio in %s\n", added, file)
+		}
+	}
+
+	if dryRun {
+		_, _ = fmt.Fprintf(w, "Would add audio to %d note(s) (dry-run — nothing written)\n", totalAdded)
+		return totalAdded, nil
+	}
+	_, _ = fmt.Fprintf(w, "Added audio to %d note(s), synthesizing %d file(s)\n", totalAdded, totalSynthesized)
+	return totalAdded, nil
+}
+
+// definitionsAudioNotebook returns the source files of the definitions book
+// with id: those under its index directory, or its own file when it is
+// standalone. Its audio is cached next to them.
+func definitionsAudioNotebook(reader *notebook.Reader, id string) (audioNotebook, error) {
+	path, ok := reader.DefinitionsBookPath(id)
+	if !ok {
+		return audioNotebook{}, fmt.Errorf("definitions book %s not found", id)
+	}
+	if filepath.Ext(path) == ".yml" {
+		return audioNotebook{id: id, directory: filepath.Dir(path), files: []string{filepath.Base(path)}}, nil
+	}
+	files, err := notebook.CollectYAMLFiles([]string{path})
+	if err != nil {
+		return audioNotebook{}, fmt.Errorf("collect the files of definitions book %s: %w", id, err)
+	}
+	nb := audioNotebook{id: id, directory: path}
+	for _, file := range files {
+		if filepath.Base(file) == "index.yml" {
+			continue
+		}
+		rel, err := filepath.Rel(path, file)
+		if err != nil {
+			return audioNotebook{}, fmt.Errorf("relative path of %s: %w", file, err)
+		}
+		nb.files = append(nb.files, rel)
+	}
+	return nb, nil
+}
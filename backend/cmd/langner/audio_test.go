@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/testutil"
+)
+
+type fakeSynthesizer struct {
+	texts []string
+}
+
+func (s *fakeSynthesizer) Synthesize(_ context.Context, text string) ([]byte, error) {
+	s.texts = append(s.texts, text)
+	return []byte("RIFF " + text), nil
+}
+
+func (s *fakeSynthesizer) Extension() string {
+	return ".wav"
+}
+
+// setupAudioNotebook writes a flashcard notebook "vocab" with one card
+// without audio and one with a recording, and returns its directory.
+func setupAudioNotebook(t *testing.T, tmpDir string) string {
+	t.Helper()
+	notebookDir := filepath.Join(tmpDir, "flashcards", "vocab")
+	require.NoError(t, os.MkdirAll(notebookDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "index.yml"), []byte(
+		"id: vocab\nname: Vocabulary\nnotebooks:\n  - ./cards.yml\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "cards.yml"), []byte(`- title: Week 1
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: ephemeral
+      meaning: lasting a very short time
+    - expression: candid
+      audio: recordings/candid.mp3
+`), 0644))
+	return notebookDir
+}
+
+func TestSynthesizeNotebookAudio(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	notebookDir := setupAudioNotebook(t, tmpDir)
+	cfg, err := config.NewConfigLoader(cfgPath)
+	require.NoError(t, err)
+	loaded, err := cfg.Load()
+	require.NoError(t, err)
+
+	synthesizer := &fakeSynthesizer{}
+	var out bytes.Buffer
+	added, err := synthesizeNotebookAudio(context.Background(), loaded, synthesizer, nil, true, &out)
+	require.NoError(t, err)
+	assert.Equal(t, 1, added)
+	assert.Empty(t, synthesizer.texts, "a dry run synthesizes nothing")
+	assert.Contains(t, out.String(), "Would add audio to 1 note(s)")
+
+	added, err = synthesizeNotebookAudio(context.Background(), loaded, synthesizer, []string{"vocab"}, false, &out)
+	require.NoError(t, err)
+	assert.Equal(t, 1, added)
+	assert.Equal(t, []string{"ephemeral"}, synthesizer.texts)
+
+	cards, err := os.ReadFile(filepath.Join(notebookDir, "cards.yml"))
+	require.NoError(t, err)
+	assert.Equal(t, `- title: Week 1
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: ephemeral
+      audio: audio/ephemeral.wav
+      meaning: lasting a very short time
+    - expression: candid
+      audio: recordings/candid.mp3
+`, string(cards))
+	audio, err := os.ReadFile(filepath.Join(notebookDir, "audio", "ephemeral.wav"))
+	require.NoError(t, err)
+	assert.Equal(t, "RIFF ephemeral", string(audio))
+
+	added, err = synthesizeNotebookAudio(context.Background(), loaded, synthesizer, nil, false, &out)
+	require.NoError(t, err)
+	assert.Zero(t, added, "every note has audio")
+
+	_, err = synthesizeNotebookAudio(context.Background(), loaded, synthesizer, []string{"unknown"}, false, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "notebook unknown not found")
+}
+
+func TestSynthesizeNotebookAudio_DefinitionsBook(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	bookDir := filepath.Join(tmpDir, "definitions", "novel")
+	require.NoError(t, os.MkdirAll(bookDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(bookDir, "index.yml"), []byte(
+		"id: novel\nnotebooks:\n  - ./chapter-1.yml\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(bookDir, "chapter-1.yml"), []byte(`- metadata:
+    notebook: chapter-1.yml
+  scenes:
+    - metadata:
+        title: Scene A
+      expressions:
+        - expression: colossal
+          meaning: extremely large
+`), 0644))
+	cfg, err := config.NewConfigLoader(cfgPath)
+	require.NoError(t, err)
+	loaded, err := cfg.Load()
+	require.NoError(t, err)
+
+	synthesizer := &fakeSynthesizer{}
+	var out bytes.Buffer
+	added, err := synthesizeNotebookAudio(context.Background(), loaded, synthesizer, []string{"novel"}, false, &out)
+	require.NoError(t, err)
+	assert.Equal(t, 1, added)
+	assert.Equal(t, []string{"colossal"}, synthesizer.texts)
+
+	chapter, err := os.ReadFile(filepath.Join(bookDir, "chapter-1.yml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(chapter), "        - expression: colossal\n          audio: audio/colossal.wav\n")
+	audio, err := os.ReadFile(filepath.Join(bookDir, "audio", "colossal.wav"))
+	require.NoError(t, err)
+	assert.Equal(t, "RIFF colossal", string(audio))
+}
+
+func TestNewNotebookAudioCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	notebookDir := setupAudioNotebook(t, tmpDir)
+
+	fakeTTS := filepath.Join(tmpDir, "fake-tts")
+	require.NoError(t, os.WriteFile(fakeTTS, []byte("#!/bin/sh\nprintf 'RIFF %s' \"$2\" > \"$1\"\n"), 0755))
+	content, err := os.ReadFile(cfgPath)
+	require.NoError(t, err)
+	content = append(content, []byte(fmt.Sprintf("tts:\n  backend: command\n  command: %s\n  args: [\"{output}\", \"{text}\"]\n", fakeTTS))...)
+	require.NoError(t, os.WriteFile(cfgPath, content, 0644))
+	setConfigFile(t, cfgPath)
+
+	var out bytes.Buffer
+	cmd := newNotebookAudioCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"vocab"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "Added audio to 1 note(s), synthesizing 1 file(s)")
+
+	audio, err := os.ReadFile(filepath.Join(notebookDir, "audio", "ephemeral.wav"))
+	require.NoError(t, err)
+	assert.Equal(t, "RIFF ephemeral", string(audio))
+}
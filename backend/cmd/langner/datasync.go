@@ -38,6 +38,9 @@ func newMigrateImportDBCommand() *cobra.Command {
 				return err
 			}
 			defer func() { _ = db.Close() }()
+			if err := refuseYAMLImport(cfg, "import-db"); err != nil {
+				return err
+			}
 
 			// Auto-apply schema migrations before import. The embedded
 			// migration files always match the binary version, so we can
@@ -67,6 +70,7 @@ func newMigrateImportDBCommand() *cobra.Command {
 			}
 			fmt.Printf("  Notes:              %d new, %d skipped, %d updated, %d deleted\n", result.Notes.NotesNew, result.Notes.NotesSkipped, result.Notes.NotesUpdated, result.Notes.NotesDeleted)
 			fmt.Printf("  Notebook notes:     %d new, %d skipped, %d deleted\n", result.Notes.NotebookNew, result.Notes.NotebookSkipped, result.Notes.NotebookNotesDeleted)
+			fmt.Printf("  Notebooks:          %d, with %d scenes of prose\n", result.Notes.Notebooks, result.Notes.NotebookScenes)
 			fmt.Printf("  Learning logs:      %d new, %d skipped, %d deleted\n", result.Learning.LearningNew, result.Learning.LearningSkipped, result.Learning.LearningDeleted)
 			fmt.Printf("  Dictionary entries: %d new, %d skipped, %d updated\n", result.Dictionary.DictionaryNew, result.Dictionary.DictionarySkipped, result.Dictionary.DictionaryUpdated)
 			if result.Etymology != nil {
@@ -90,6 +94,9 @@ func newExportDBCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "export-db",
 		Short: "Export database to YAML files",
+		Long: `Write the database's notes, learning logs and dictionary entries as
+YAML notebooks under --output. With storage.mode: postgres the database
+is the source of truth and this is how YAML is produced.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
@@ -199,6 +206,9 @@ modifying the database, use "migrate validate-db" instead.`,
 				return err
 			}
 			defer func() { _ = db.Close() }()
+			if err := refuseYAMLImport(cfg, "sync-db"); err != nil {
+				return err
+			}
 
 			// Auto-apply schema migrations before clearing so the TRUNCATE
 			// has tables to target. Without this, sync-db against a
@@ -282,6 +292,17 @@ func runRoundTripDiff(ctx context.Context, cfg *config.Config, db *sqlx.DB, out
 	return nil
 }
 
+// refuseYAMLImport stops commands that overwrite the database from YAML
+// when the database is the source of truth, since they would replace
+// answers recorded since the last export.
+func refuseYAMLImport(cfg *config.Config, command string) error {
+	if !cfg.Storage.IsPostgres() {
+		return nil
+	}
+	return fmt.Errorf("%s overwrites the database from YAML, but storage.mode is %s; seed the database with storage.mode: %s first, or use export-db to produce YAML",
+		command, config.StorageModePostgres, config.StorageModeYAML)
+}
+
 func openConfigAndDB() (*config.Config, *sqlx.DB, error) {
 	loader, err := config.NewConfigLoader(configFile)
 	if err != nil {
@@ -373,7 +394,15 @@ func readNotesFromDirs(ctx context.Context, storyDirs, flashcardDirs, bookDirs,
 func readLearningByNotebook(notes []notebook.NoteRecord, learningDir string) map[string][]notebook.LearningHistoryExpression {
 	result := make(map[string][]notebook.LearningHistoryExpression)
 	repo := learning.NewYAMLLearningRepository(learningDir, nil)
-	for _, nbID := range extractNotebookIDs(notes) {
+	notebookIDs := extractNotebookIDs(notes)
+	// Journals have no notes; their grammar histories are listed separately.
+	if grammarIDs, err := repo.GrammarNotebookIDs(); err == nil {
+		notebookIDs = append(notebookIDs, grammarIDs...)
+	}
+	for _, nbID := range notebookIDs {
+		if _, ok := result[nbID]; ok {
+			continue
+		}
 		exprs, err := repo.FindByNotebookID(nbID)
 		if err != nil || len(exprs) == 0 {
 			continue
@@ -428,7 +457,7 @@ func extractNotebookIDs(notes []notebook.NoteRecord) []string {
 //
 // Order rationale:
 //   - note_origin_parts depends on notes + etymology_origins + etymology_origin_forms (no CASCADE on note_id)
-//   - notebook_notes, note_images, note_references, learning_logs depend on notes
+//   - notebook_notes, note_images, note_references, learning_logs, learning_skips, learning_relearns depend on notes
 //   - etymology_origin_forms depends on etymology_origins (CASCADE; listed for clarity)
 //   - semantic_concept_members, concept_relations CASCADE from semantic_concepts
 //   - definition_concept_members CASCADE from definition_concepts
@@ -436,15 +465,19 @@ func extractNotebookIDs(notes []notebook.NoteRecord) []string {
 func dataTablesInDeletionOrder() []string {
 	return []string{
 		"note_origin_parts",
+		"notebook_scenes",
 		"notebook_notes",
 		"note_images",
 		"note_references",
 		"learning_logs",
+		"learning_skips",
+		"learning_relearns",
 		"etymology_origin_forms",
 		"semantic_concept_members",
 		"concept_relations",
 		"definition_concept_members",
 		"notes",
+		"notebooks",
 		"etymology_origins",
 		"semantic_concepts",
 		"definition_concepts",
@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
 )
 
 func TestNewExportDBCommand(t *testing.T) {
@@ -94,6 +96,16 @@ func TestNewSyncDBCommand_RunE_ConfigError(t *testing.T) {
 	assert.Contains(t, err.Error(), "load config")
 }
 
+func TestRefuseYAMLImport(t *testing.T) {
+	assert.NoError(t, refuseYAMLImport(&config.Config{}, "sync-db"))
+	assert.NoError(t, refuseYAMLImport(&config.Config{Storage: config.StorageConfig{Mode: config.StorageModeYAML}}, "sync-db"))
+
+	err := refuseYAMLImport(&config.Config{Storage: config.StorageConfig{Mode: config.StorageModePostgres}}, "sync-db")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sync-db")
+	assert.Contains(t, err.Error(), "export-db")
+}
+
 // TestClearAllDataTablesCoversAllSchemaTables guards against the bug
 // that previously broke `langner migrate validate-db`: the clear-list
 // missed `note_origin_parts`, which has a FK from notes — running the
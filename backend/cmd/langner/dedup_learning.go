@@ -37,7 +37,13 @@ Commit your notebook data to version control first. Use --dry-run to preview.`,
 			if err != nil {
 				return err
 			}
-			return dedupLearningIDs(cfg, dryRun, os.Stdout)
+			if err := dedupLearningIDs(cfg, dryRun, os.Stdout); err != nil {
+				return err
+			}
+			if dryRun {
+				return nil
+			}
+			return recordChange(cfg, "Merge id-less duplicate learning entries")
 		},
 	}
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report counts without writing any files")
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/inference/openai"
+	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/versioning"
+)
+
+func newNotebookEnrichCommand() *cobra.Command {
+	var mnemonics, examples, dryRun bool
+	command := &cobra.Command{
+		Use:   "enrich",
+		Short: "Generate missing content for notes with the LLM",
+		Long: `Generate content that notes are missing and write it back to the
+story, book, flashcard and definitions notebooks without reformatting any
+other line.
+
+--mnemonics writes a short memory hook into the "memo" of every note that
+has a meaning but no memo yet, built from the meaning, the meanings of its
+origin parts and the scene lines or examples using the word. Notes that
+already have a memo are left alone.
+
+--examples writes 2-3 example sentences into every flashcard and
+definitions note that has a meaning but no examples. Each sentence must
+contain the expression's inflected form, which is saved as its highlight,
+and is marked "generated: true" so exports can leave it out with
+--skip-generated-examples.
+
+Use --dry-run to list the notes that would be enriched without calling the
+model.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !mnemonics && !examples {
+				return fmt.Errorf("nothing to enrich: pass --mnemonics or --examples")
+			}
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			svc, err := newEnrichService(cfg, dryRun)
+			if err != nil {
+				return err
+			}
+			if mnemonics {
+				if err := enrichMnemonics(cmd.Context(), svc, dryRun, cmd.OutOrStdout()); err != nil {
+					return err
+				}
+			}
+			if examples {
+				if err := enrichExamples(cmd.Context(), svc, dryRun, cmd.OutOrStdout()); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	command.Flags().BoolVar(&mnemonics, "mnemonics", false, "Write a memory hook into notes without a memo")
+	command.Flags().BoolVar(&examples, "examples", false, "Write example sentences into flashcard and definitions notes without examples")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "List the notes that would be enriched without calling the model or writing any files")
+	return command
+}
+
+// newEnrichService returns a quiz service that generates with OpenAI and
+// versions the notebook files it writes. A dry run needs no API key.
+func newEnrichService(cfg *config.Config, dryRun bool) (*quiz.Service, error) {
+	var client inference.Client
+	if !dryRun {
+		if cfg.OpenAI.APIKey == "" {
+			return nil, fmt.Errorf("OPENAI_API_KEY environment variable is required")
+		}
+		client = openai.NewClient(cfg.OpenAI.APIKey, cfg.OpenAI.Model, inference.DefaultMaxRetryAttempts)
+	}
+	recorder, err := versioning.NewRecorder(cfg.Versioning)
+	if err != nil {
+		return nil, err
+	}
+	svc := quiz.NewService(cfg.Notebooks, client, nil, nil, cfg.Quiz)
+	svc.SetRecorder(recorder)
+	return svc, nil
+}
+
+// enrichMnemonics writes a mnemonic into every note without a memo and
+// lists the notes it wrote.
+func enrichMnemonics(ctx context.Context, svc *quiz.Service, dryRun bool, w io.Writer) error {
+	results, err := svc.GenerateMnemonics(ctx, quiz.MnemonicTarget{}, dryRun)
+	for _, r := range results {
+		if dryRun {
+			_, _ = fmt.Fprintf(w, "  %s (%s)\n", r.Expression, r.File)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "  %s: %s\n", r.Expression, r.Mnemonic)
+	}
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		_, _ = fmt.Fprintf(w, "Would add a mnemonic to %d note(s) (dry-run — nothing written)\n", len(results))
+		return nil
+	}
+	_, _ = fmt.Fprintf(w, "Added a mnemonic to %d note(s)\n", len(results))
+	return nil
+}
+
+// enrichExamples writes example sentences into every flashcard and
+// definitions note without examples and lists the sentences it wrote.
+func enrichExamples(ctx context.Context, svc *quiz.Service, dryRun bool, w io.Writer) error {
+	results, err := svc.GenerateExamples(ctx, dryRun)
+	for _, r := range results {
+		if dryRun {
+			_, _ = fmt.Fprintf(w, "  %s (%s)\n", r.Expression, r.File)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "  %s:\n", r.Expression)
+		for _, ex := range r.Examples {
+			_, _ = fmt.Fprintf(w, "    - %s\n", ex.Text)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		_, _ = fmt.Fprintf(w, "Would add examples to %d note(s) (dry-run — nothing written)\n", len(results))
+		return nil
+	}
+	_, _ = fmt.Fprintf(w, "Added examples to %d note(s)\n", len(results))
+	return nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/inference/mock"
+	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/testutil"
+)
+
+func TestEnrichMnemonics(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	notebookDir := setupAudioNotebook(t, tmpDir)
+	cfg, err := config.NewConfigLoader(cfgPath)
+	require.NoError(t, err)
+	loaded, err := cfg.Load()
+	require.NoError(t, err)
+	svc := quiz.NewService(loaded.Notebooks, mock.NewClient(), nil, nil, loaded.Quiz)
+
+	var out bytes.Buffer
+	require.NoError(t, enrichMnemonics(context.Background(), svc, true, &out))
+	assert.Contains(t, out.String(), "  ephemeral (")
+	assert.Contains(t, out.String(), "Would add a mnemonic to 1 note(s)")
+
+	out.Reset()
+	require.NoError(t, enrichMnemonics(context.Background(), svc, false, &out))
+	assert.Contains(t, out.String(), "Added a mnemonic to 1 note(s)")
+
+	cards, err := os.ReadFile(filepath.Join(notebookDir, "cards.yml"))
+	require.NoError(t, err)
+	assert.Equal(t, `- title: Week 1
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: ephemeral
+      memo: '(mock mnemonic: ephemeral means lasting a very short time)'
+      meaning: lasting a very short time
+    - expression: candid
+      audio: recordings/candid.mp3
+`, string(cards))
+}
+
+func TestEnrichExamples(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	notebookDir := setupAudioNotebook(t, tmpDir)
+	cfg, err := config.NewConfigLoader(cfgPath)
+	require.NoError(t, err)
+	loaded, err := cfg.Load()
+	require.NoError(t, err)
+	svc := quiz.NewService(loaded.Notebooks, mock.NewClient(), nil, nil, loaded.Quiz)
+
+	var out bytes.Buffer
+	require.NoError(t, enrichExamples(context.Background(), svc, true, &out))
+	assert.Contains(t, out.String(), "Would add examples to 1 note(s)")
+
+	out.Reset()
+	require.NoError(t, enrichExamples(context.Background(), svc, false, &out))
+	assert.Contains(t, out.String(), "    - Mock example 1 uses ephemeral in a sentence.\n")
+	assert.Contains(t, out.String(), "Added examples to 1 note(s)")
+
+	cards, err := os.ReadFile(filepath.Join(notebookDir, "cards.yml"))
+	require.NoError(t, err)
+	assert.Equal(t, `- title: Week 1
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: ephemeral
+      examples:
+        - text: Mock example 1 uses ephemeral in a sentence.
+          highlight: ephemeral
+          generated: true
+        - text: Mock example 2 uses ephemeral in a sentence.
+          highlight: ephemeral
+          generated: true
+        - text: Mock example 3 uses ephemeral in a sentence.
+          highlight: ephemeral
+          generated: true
+      meaning: lasting a very short time
+    - expression: candid
+      audio: recordings/candid.mp3
+`, string(cards))
+}
+
+func TestNewNotebookEnrichCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	setupAudioNotebook(t, tmpDir)
+	setConfigFile(t, cfgPath)
+
+	cmd := newNotebookEnrichCommand()
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nothing to enrich")
+
+	var out bytes.Buffer
+	cmd = newNotebookEnrichCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--mnemonics", "--dry-run"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "Would add a mnemonic to 1 note(s)")
+
+	out.Reset()
+	cmd = newNotebookEnrichCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--examples", "--dry-run"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "Would add examples to 1 note(s)")
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/at-ishikawa/langner/internal/config"
 	"github.com/at-ishikawa/langner/internal/database"
+	"github.com/at-ishikawa/langner/internal/versioning"
 )
 
 func loadConfig() (*config.Config, error) {
@@ -21,3 +22,18 @@ func loadConfig() (*config.Config, error) {
 func openDB(cfg *config.Config) (*sqlx.DB, error) {
 	return database.Open(cfg.Database)
 }
+
+// recordChange commits every pending change under the versioning
+// directory with message when versioning is enabled. Commands that rewrite
+// learning data call it once they finish so the run can be undone with
+// `langner history undo`.
+func recordChange(cfg *config.Config, message string) error {
+	recorder, err := versioning.NewRecorder(cfg.Versioning)
+	if err != nil {
+		return fmt.Errorf("versioning.NewRecorder() > %w", err)
+	}
+	if err := recorder.Record(message); err != nil {
+		return fmt.Errorf("recorder.Record() > %w", err)
+	}
+	return nil
+}
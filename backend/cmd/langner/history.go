@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/versioning"
+)
+
+func newHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Inspect and undo recorded learning data changes (requires versioning.mode: git)",
+	}
+	cmd.AddCommand(newHistoryLogCommand())
+	cmd.AddCommand(newHistoryUndoCommand())
+	return cmd
+}
+
+func newHistoryLogCommand() *cobra.Command {
+	var limit int
+	cmd := &cobra.Command{
+		Use:   "log",
+		Short: "List recorded changes, newest first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			recorder, err := openHistory(cfg)
+			if err != nil {
+				return err
+			}
+			changes, err := recorder.Log(limit)
+			if err != nil {
+				return err
+			}
+			printChanges(os.Stdout, changes)
+			return nil
+		},
+	}
+	cmd.Flags().IntVarP(&limit, "number", "n", 20, "Number of changes to show (0 for all)")
+	return cmd
+}
+
+func newHistoryUndoCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undo [count]",
+		Short: "Revert the last count recorded changes (default 1) with a new commit",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			count := 1
+			if len(args) == 1 {
+				n, err := strconv.Atoi(args[0])
+				if err != nil || n <= 0 {
+					return fmt.Errorf("count must be a positive integer, got %q", args[0])
+				}
+				count = n
+			}
+
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			recorder, err := openHistory(cfg)
+			if err != nil {
+				return err
+			}
+			change, err := recorder.Undo(count)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Reverted %d change(s):\n", count)
+			printChanges(os.Stdout, []versioning.Change{change})
+			return nil
+		},
+	}
+	return cmd
+}
+
+// openHistory opens the git repository configured by versioning.
+func openHistory(cfg *config.Config) (*versioning.GitRecorder, error) {
+	if cfg.Versioning.Mode != versioning.ModeGit {
+		return nil, fmt.Errorf("history requires versioning.mode: %s in the config", versioning.ModeGit)
+	}
+	return versioning.OpenGitRecorder(cfg.Versioning.Directory)
+}
+
+func printChanges(w io.Writer, changes []versioning.Change) {
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(w, "No recorded changes.")
+		return
+	}
+	for _, change := range changes {
+		hash := change.Hash
+		if len(hash) > 7 {
+			hash = hash[:7]
+		}
+		_, _ = fmt.Fprintf(w, "%s %s %s\n", hash, change.When.Local().Format("2006-01-02 15:04"), change.Subject())
+		for _, file := range change.Files {
+			_, _ = fmt.Fprintf(w, "    %s\n", file)
+		}
+	}
+}
@@ -37,6 +37,10 @@ func main() {
 		newParseCommand(),
 		newMigrateCommand(),
 		newEbookCommand(),
+		newHistoryCommand(),
+		newSchemaCommand(),
+		newWorksheetCommand(),
+		newSearchCommand(),
 	)
 	if err := rootCommand.Execute(); err != nil {
 		if _, fprintfErr := fmt.Fprintf(os.Stderr, "failed to execute a command: %+v\n", err); fprintfErr != nil {
@@ -104,11 +108,17 @@ func newMigrateEtymologyToScenesCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return cli.MigrateEtymologyToScenes(
+			if err := cli.MigrateEtymologyToScenes(
 				cfg.Notebooks.EtymologyDirectories,
 				cfg.Notebooks.DefinitionsDirectories,
 				dryRun,
-			)
+			); err != nil {
+				return err
+			}
+			if dryRun {
+				return nil
+			}
+			return recordChange(cfg, "Migrate etymology sessions to scenes")
 		},
 	}
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report planned migrations without writing files")
@@ -150,7 +160,10 @@ func newMigrateLearningHistoryCommand() *cobra.Command {
 			}
 
 			calculator := notebook.NewIntervalCalculator(cfg.Quiz.Algorithm, cfg.Quiz.FixedIntervals)
-			return cli.MigrateLearningHistory(cfg.Notebooks.LearningNotesDirectory, recalculate, calculator)
+			if err := cli.MigrateLearningHistory(cfg.Notebooks.LearningNotesDirectory, recalculate, calculator); err != nil {
+				return err
+			}
+			return recordChange(cfg, "Migrate learning history")
 		},
 	}
 	cmd.Flags().BoolVar(&recalculate, "recalculate", false, "Force recalculation of intervals for all learning history entries using the configured algorithm")
@@ -176,7 +189,9 @@ log interval_days is rewritten to min across all members. Non-head
 member entries are then removed.
 
 This operation is ONE-WAY. Commit your learning_notes directory to
-version control before running so you can revert if needed.
+version control before running so you can revert if needed, or set
+versioning.mode to git so the run is committed and can be reverted with
+` + "`langner history undo`" + `.
 
 Use --dry-run to preview the changes without writing any files.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -184,11 +199,17 @@ Use --dry-run to preview the changes without writing any files.`,
 			if err != nil {
 				return err
 			}
-			return cli.MergeConcepts(
+			if err := cli.MergeConcepts(
 				cfg.Notebooks.LearningNotesDirectory,
 				cfg.Notebooks.DefinitionsDirectories,
 				dryRun,
-			)
+			); err != nil {
+				return err
+			}
+			if dryRun {
+				return nil
+			}
+			return recordChange(cfg, "Merge concept learning histories")
 		},
 	}
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report planned merges without writing files")
@@ -205,11 +226,14 @@ func newRecalculateIntervalsCommand() *cobra.Command {
 				return err
 			}
 
-			return cli.RecalculateIntervals(
+			if err := cli.RecalculateIntervals(
 				cfg.Notebooks.LearningNotesDirectory,
 				cfg.Quiz.Algorithm,
 				cfg.Quiz.FixedIntervals,
-			)
+			); err != nil {
+				return err
+			}
+			return recordChange(cfg, "Recalculate learning intervals")
 		},
 	}
 	return cmd
@@ -9,6 +9,7 @@ import (
 	"github.com/at-ishikawa/langner/internal/analytics"
 	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
 	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/pdf"
 	"github.com/at-ishikawa/langner/internal/quizreview"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -51,6 +52,69 @@ const (
 	SortAscending  SortFlag = "asc"
 )
 
+// FormatFlag selects the file format `notebooks stories` writes.
+type FormatFlag notebook.ExportFormat
+
+// Set implements pflag.Value.
+func (f *FormatFlag) Set(v string) error {
+	for _, format := range notebook.ExportFormats {
+		if v == string(format) {
+			*f = FormatFlag(format)
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value %q, valid values are %q", v, notebook.ExportFormats)
+}
+
+// String implements pflag.Value.
+func (f *FormatFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return string(*f)
+}
+
+// Type implements pflag.Value.
+func (f *FormatFlag) Type() string {
+	return "FormatFlag"
+}
+
+var (
+	_ pflag.Value = (*FormatFlag)(nil)
+)
+
+// GraphFormatFlag selects the graph format `notebooks etymology` writes
+// instead of markdown.
+type GraphFormatFlag notebook.GraphFormat
+
+// Set implements pflag.Value.
+func (f *GraphFormatFlag) Set(v string) error {
+	for _, format := range notebook.GraphFormats {
+		if v == string(format) {
+			*f = GraphFormatFlag(format)
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value %q, valid values are %q", v, notebook.GraphFormats)
+}
+
+// String implements pflag.Value.
+func (f *GraphFormatFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return string(*f)
+}
+
+// Type implements pflag.Value.
+func (f *GraphFormatFlag) Type() string {
+	return "GraphFormatFlag"
+}
+
+var (
+	_ pflag.Value = (*GraphFormatFlag)(nil)
+)
+
 func newNotebookCommand() *cobra.Command {
 	notebookCommands := &cobra.Command{
 		Use: "notebooks",
@@ -58,12 +122,19 @@ func newNotebookCommand() *cobra.Command {
 	sortFlag := SortDescending
 	flags := notebookCommands.PersistentFlags()
 	flags.Var(&sortFlag, "sort", "Sort order for the output. Options: asc, desc")
+	// Shared by the stories, flashcards and definitions exports.
+	var skipGeneratedExamples bool
 
 	var generatePDF bool
+	formatFlag := FormatFlag(notebook.ExportFormatMarkdown)
 	storiesCmd := &cobra.Command{
 		Use:  "stories <notebook id>",
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			format := notebook.ExportFormat(formatFlag)
+			if generatePDF && format != notebook.ExportFormatMarkdown {
+				return fmt.Errorf("--pdf can only be used with --format %s", notebook.ExportFormatMarkdown)
+			}
 			cfg, err := loadConfig()
 			if err != nil {
 				return err
@@ -87,6 +158,16 @@ func newNotebookCommand() *cobra.Command {
 			}
 
 			writer := notebook.NewStoryNotebookWriter(reader, cfg.Templates.StoryNotebookTemplate)
+			writer.SetPDFOptions(pdf.Options{Fonts: cfg.PDF})
+			writer.SetSkipGeneratedExamples(skipGeneratedExamples)
+			if format != notebook.ExportFormatMarkdown {
+				outputPath, err := writer.ExportStoryNotebook(storyID, dictionaryMap, learningHistories, sortFlag == SortDescending, cfg.Outputs.StoryDirectory, format)
+				if err != nil {
+					return fmt.Errorf("notebooks.ExportStoryNotebook > %w", err)
+				}
+				fmt.Printf("Story notebook written to: %s\n", outputPath)
+				return nil
+			}
 			if err := writer.OutputStoryNotebooks(storyID, dictionaryMap, learningHistories, sortFlag == SortDescending, cfg.Outputs.StoryDirectory, generatePDF); err != nil {
 				return fmt.Errorf("notebooks.OutputStoryNotebooks > %w", err)
 			}
@@ -94,8 +175,12 @@ func newNotebookCommand() *cobra.Command {
 		},
 	}
 	storiesCmd.Flags().BoolVar(&generatePDF, "pdf", false, "Generate PDF output in addition to markdown")
+	storiesCmd.Flags().Var(&formatFlag, "format", "Output format. Options: markdown, html (single file with collapsible meanings), epub")
+	storiesCmd.Flags().BoolVar(&skipGeneratedExamples, "skip-generated-examples", false, "Leave out examples generated by 'notebooks enrich --examples'")
 
 	notebookCommands.AddCommand(storiesCmd)
+	notebookCommands.AddCommand(newNotebookAudioCommand())
+	notebookCommands.AddCommand(newNotebookEnrichCommand())
 
 	var flashcardGeneratePDF bool
 	flashcardsCmd := &cobra.Command{
@@ -126,6 +211,8 @@ func newNotebookCommand() *cobra.Command {
 			}
 
 			writer := notebook.NewFlashcardNotebookWriter(reader, cfg.Templates.FlashcardNotebookTemplate)
+			writer.SetPDFOptions(pdf.Options{Fonts: cfg.PDF})
+			writer.SetSkipGeneratedExamples(skipGeneratedExamples)
 			if err := writer.OutputFlashcardNotebooks(flashcardID, dictionaryMap, learningHistories, sortFlag == SortDescending, cfg.Outputs.FlashcardDirectory, flashcardGeneratePDF); err != nil {
 				return fmt.Errorf("writer.OutputFlashcardNotebooks > %w", err)
 			}
@@ -133,15 +220,20 @@ func newNotebookCommand() *cobra.Command {
 		},
 	}
 	flashcardsCmd.Flags().BoolVar(&flashcardGeneratePDF, "pdf", false, "Generate PDF output in addition to markdown")
+	flashcardsCmd.Flags().BoolVar(&skipGeneratedExamples, "skip-generated-examples", false, "Leave out examples generated by 'notebooks enrich --examples'")
 
 	notebookCommands.AddCommand(flashcardsCmd)
 
 	var etymologyGeneratePDF bool
+	var etymologyGraphFormat GraphFormatFlag
 	etymologyCmd := &cobra.Command{
 		Use:   "etymology <etymology id>",
-		Short: "Generate markdown/PDF output from etymology notebooks",
+		Short: "Generate markdown/PDF output or a word family graph from etymology notebooks",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if etymologyGeneratePDF && etymologyGraphFormat != "" {
+				return fmt.Errorf("--pdf cannot be used with --format")
+			}
 			cfg, err := loadConfig()
 			if err != nil {
 				return err
@@ -159,6 +251,15 @@ func newNotebookCommand() *cobra.Command {
 				return fmt.Errorf("notebook.NewLearningHistories() > %w", err)
 			}
 			writer := notebook.NewEtymologyNotebookWriter(reader, cfg.Templates.EtymologyNotebookTemplate, cfg.Notebooks.DefinitionsDirectories, learningHistories)
+			writer.SetPDFOptions(pdf.Options{Fonts: cfg.PDF})
+			if etymologyGraphFormat != "" {
+				outputPath, err := writer.ExportEtymologyGraph(cmd.Context(), etymologyID, cfg.Outputs.EtymologyDirectory, notebook.GraphFormat(etymologyGraphFormat))
+				if err != nil {
+					return fmt.Errorf("writer.ExportEtymologyGraph > %w", err)
+				}
+				fmt.Printf("Etymology graph written to: %s\n", outputPath)
+				return nil
+			}
 			if err := writer.OutputEtymologyNotebook(etymologyID, cfg.Outputs.EtymologyDirectory, etymologyGeneratePDF); err != nil {
 				return fmt.Errorf("writer.OutputEtymologyNotebook > %w", err)
 			}
@@ -166,6 +267,7 @@ func newNotebookCommand() *cobra.Command {
 		},
 	}
 	etymologyCmd.Flags().BoolVar(&etymologyGeneratePDF, "pdf", false, "Generate PDF output in addition to markdown")
+	etymologyCmd.Flags().Var(&etymologyGraphFormat, "format", "Write a graph of origins, words and concepts instead of markdown. Options: dot, graphml, json-graph")
 
 	notebookCommands.AddCommand(etymologyCmd)
 
@@ -185,6 +287,8 @@ func newNotebookCommand() *cobra.Command {
 				return fmt.Errorf("notebook.NewReader() > %w", err)
 			}
 			writer := notebook.NewDefinitionsBookWriter(reader, cfg.Templates.StoryNotebookTemplate)
+			writer.SetPDFOptions(pdf.Options{Fonts: cfg.PDF})
+			writer.SetSkipGeneratedExamples(skipGeneratedExamples)
 			outDir := cfg.Outputs.StoryDirectory
 			if err := writer.OutputDefinitionsBook(bookID, outDir, definitionsGeneratePDF); err != nil {
 				return fmt.Errorf("writer.OutputDefinitionsBook > %w", err)
@@ -193,6 +297,7 @@ func newNotebookCommand() *cobra.Command {
 		},
 	}
 	definitionsCmd.Flags().BoolVar(&definitionsGeneratePDF, "pdf", false, "Generate PDF output in addition to markdown")
+	definitionsCmd.Flags().BoolVar(&skipGeneratedExamples, "skip-generated-examples", false, "Leave out examples generated by 'notebooks enrich --examples'")
 	notebookCommands.AddCommand(definitionsCmd)
 
 	var quizReviewGeneratePDF bool
@@ -242,6 +347,7 @@ The date argument defaults to today in your local timezone.`,
 			repo := analytics.NewYAMLRepository(cfg.Notebooks.LearningNotesDirectory).
 				WithMetadataResolver(analytics.NewNotebookMetadataResolver(reader))
 			writer := quizreview.NewWriterWithSource(repo, quizreview.NewReaderSource(reader))
+			writer.SetPDFOptions(pdf.Options{Fonts: cfg.PDF})
 
 			outDir := cfg.Outputs.QuizReviewDirectory
 			if outDir == "" {
@@ -2,11 +2,13 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/at-ishikawa/langner/internal/notebook"
 	"github.com/at-ishikawa/langner/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -100,6 +102,68 @@ func TestNewNotebookCommand_Stories_RunE(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFormatFlag_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    FormatFlag
+		wantErr bool
+	}{
+		{name: "markdown", value: "markdown", want: FormatFlag(notebook.ExportFormatMarkdown)},
+		{name: "html", value: "html", want: FormatFlag(notebook.ExportFormatHTML)},
+		{name: "epub", value: "epub", want: FormatFlag(notebook.ExportFormatEPUB)},
+		{name: "invalid value", value: "docx", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var flag FormatFlag
+			err := flag.Set(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid value")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, flag)
+		})
+	}
+}
+
+func TestNewNotebookCommand_Stories_Format(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantFile string
+		wantErr  string
+	}{
+		{name: "html", args: []string{"--format", "html"}, wantFile: "test-story.html"},
+		{name: "epub", args: []string{"--format", "epub"}, wantFile: "test-story.epub"},
+		{name: "pdf needs markdown", args: []string{"--format", "epub", "--pdf"}, wantErr: "--pdf can only be used with --format markdown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			cfgPath := testutil.SetupTestConfig(t, tmpDir)
+			setConfigFile(t, cfgPath)
+			testutil.CreateStoryNotebook(t, filepath.Join(tmpDir, "stories"), filepath.Join(tmpDir, "learning_notes"), "test-story")
+
+			cmd := newNotebookCommand()
+			cmd.SetArgs(append([]string{"stories", "test-story"}, tt.args...))
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			matches, err := filepath.Glob(filepath.Join(tmpDir, "*", tt.wantFile))
+			require.NoError(t, err)
+			assert.Len(t, matches, 1)
+		})
+	}
+}
+
 func TestNewNotebookCommand_Flashcards_RunE(t *testing.T) {
 	tmpDir := t.TempDir()
 	cfgPath := testutil.SetupTestConfig(t, tmpDir)
@@ -170,13 +234,40 @@ func TestNewNotebookCommand_NotFound(t *testing.T) {
 // EtymologyNotebookWriter, and the markdown template. The CLI test
 // covers all of those together.
 func TestNewNotebookCommand_Etymology_HidesMasteredWords(t *testing.T) {
+	tmpDir := writeEtymologyCLIFixture(t)
+
+	cmd := newNotebookCommand()
+	cmd.SetArgs([]string{"etymology", "demo-vocab"})
+	require.NoError(t, cmd.Execute())
+
+	mdPath := filepath.Join(tmpDir, "output_etymology", "demo-vocab.md")
+	content, err := os.ReadFile(mdPath)
+	require.NoError(t, err, "the etymology CLI should have written %s", mdPath)
+	out := string(content)
+
+	assert.NotContains(t, out, "braveword",
+		"the CLI-driven etymology PDF/markdown must exclude derived words "+
+			"the user has already learned (recent correct in both "+
+			"directions, interval 30) — re-reading a known word's "+
+			"definition doesn't help drill its origins")
+	assert.Contains(t, out, "wordless",
+		"unlearned words must still appear so the user can drill the origins via context")
+	assert.Contains(t, out, "brave-root",
+		"unmastered origins must still appear in the origins list at the top of the chapter")
+	assert.Contains(t, out, "word-root",
+		"unmastered origins must still appear in the origins list at the top of the chapter")
+}
+
+// writeEtymologyCLIFixture writes a config and an etymology + definitions +
+// learning_notes layout matching real user data, points the CLI at it, and
+// returns its root directory. The session's origins still need review (the
+// user has never drilled brave-root / word-root); the derived word
+// `braveword` was answered correctly in both directions today (interval 30)
+// and `wordless` was never answered.
+func writeEtymologyCLIFixture(t *testing.T) string {
+	t.Helper()
 	tmpDir := t.TempDir()
 
-	// Build an etymology + definitions + learning_notes layout matching
-	// real user data: a session whose origins still need review (the
-	// user has never drilled brave-root / word-root) and a derived word
-	// `braveword` they have answered correctly in both directions today
-	// (interval 30) plus an unanswered `wordless`.
 	for _, d := range []string{
 		"stories", "learning_notes", "flashcards", "dictionaries",
 		"output_stories", "output_flashcards", "books", "definitions",
@@ -289,25 +380,35 @@ books:
 		filepath.Join(tmpDir, "repos.yml"),
 	)), 0o644))
 	setConfigFile(t, cfgPath)
+	return tmpDir
+}
+
+func TestNewNotebookCommand_Etymology_GraphFormat(t *testing.T) {
+	tmpDir := writeEtymologyCLIFixture(t)
 
 	cmd := newNotebookCommand()
-	cmd.SetArgs([]string{"etymology", "demo-vocab"})
+	cmd.SetArgs([]string{"etymology", "demo-vocab", "--format", "dot"})
 	require.NoError(t, cmd.Execute())
 
-	mdPath := filepath.Join(tmpDir, "output_etymology", "demo-vocab.md")
-	content, err := os.ReadFile(mdPath)
-	require.NoError(t, err, "the etymology CLI should have written %s", mdPath)
+	content, err := os.ReadFile(filepath.Join(tmpDir, "output_etymology", "demo-vocab.dot"))
+	require.NoError(t, err)
 	out := string(content)
+	assert.Contains(t, out, `"origin:Session 1/brave-root" [label="brave-root\nbrave", shape=box, kind=origin];`)
+	assert.Contains(t, out, `"word:demo-vocab/Session 1/braveword" -> "origin:Session 1/word-root" [kind=origin_part];`)
+	assert.Contains(t, out, `"word:demo-vocab/Session 1/wordless" -> "origin:Session 1/word-root" [kind=origin_part];`)
+	_, err = os.Stat(filepath.Join(tmpDir, "output_etymology", "demo-vocab.md"))
+	assert.True(t, os.IsNotExist(err), "a graph export writes no markdown")
+}
 
-	assert.NotContains(t, out, "braveword",
-		"the CLI-driven etymology PDF/markdown must exclude derived words "+
-			"the user has already learned (recent correct in both "+
-			"directions, interval 30) — re-reading a known word's "+
-			"definition doesn't help drill its origins")
-	assert.Contains(t, out, "wordless",
-		"unlearned words must still appear so the user can drill the origins via context")
-	assert.Contains(t, out, "brave-root",
-		"unmastered origins must still appear in the origins list at the top of the chapter")
-	assert.Contains(t, out, "word-root",
-		"unmastered origins must still appear in the origins list at the top of the chapter")
+func TestNewNotebookCommand_Etymology_InvalidFlags(t *testing.T) {
+	for _, args := range [][]string{
+		{"etymology", "demo-vocab", "--format", "svg"},
+		{"etymology", "demo-vocab", "--format", "dot", "--pdf"},
+	} {
+		cmd := newNotebookCommand()
+		cmd.SetArgs(args)
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+		assert.Error(t, cmd.Execute(), "args %v", args)
+	}
 }
@@ -85,5 +85,6 @@ func newParseCommand() *cobra.Command {
 			return nil
 		},
 	})
+	parseCommand.AddCommand(newParseVideoCommand(), newParseCaptionsCommand())
 	return parseCommand
 }
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/stt"
+	"github.com/at-ishikawa/langner/internal/video"
+)
+
+func newParseVideoCommand() *cobra.Command {
+	var output storyOutput
+	var transcriptPath string
+
+	command := &cobra.Command{
+		Use:   "video <file>",
+		Short: "Create a story notebook from the speech in a video",
+		Long: `Extract the soundtrack of a video with ffmpeg, transcribe it with a
+whisper.cpp compatible server, and write the lines as a story notebook.
+A pause of video.scene_gap_seconds starts a new scene, and speakers are
+named "Speaker 1", "Speaker 2", … when the server tells them apart, so
+rename them and add definitions afterwards. Each line keeps the time it
+is said at, which links into the video given with --youtube-url.
+
+The notebook is written to --output-dir as <title>.yml and listed in the
+index.yml there, which is created if missing. The program and the server
+are set under video in config.yml. --transcript reads a verbose_json
+response saved from the server instead of running either.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			segments, err := transcribeVideo(cmd.Context(), cfg, args[0], transcriptPath, cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+			return output.write(cfg, args[0], segments, cmd.OutOrStdout())
+		},
+	}
+
+	output.addFlags(command)
+	command.Flags().StringVar(&transcriptPath, "transcript", "", "verbose_json transcript of the video to use instead of transcribing it")
+
+	return command
+}
+
+func newParseCaptionsCommand() *cobra.Command {
+	var output storyOutput
+
+	command := &cobra.Command{
+		Use:   "captions <file.vtt>",
+		Short: "Create a story notebook from a WebVTT caption file",
+		Long: `Write the lines of a WebVTT caption file as a story notebook, such as the
+captions of a YouTube video downloaded with
+
+  yt-dlp --skip-download --write-subs --write-auto-subs --sub-langs en <url>
+
+Lines are grouped into scenes and speakers like "langner parse video"
+does, taking a caption's voice as its speaker, and each keeps the time it
+is said at, which links into the video given with --youtube-url.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			file, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("os.Open() > %w", err)
+			}
+			defer func() {
+				_ = file.Close()
+			}()
+			segments, err := video.ParseVTT(file)
+			if err != nil {
+				return fmt.Errorf("video.ParseVTT(%s) > %w", args[0], err)
+			}
+			return output.write(cfg, args[0], segments, cmd.OutOrStdout())
+		},
+	}
+
+	output.addFlags(command)
+	return command
+}
+
+// storyOutput is where and how a story notebook made from a transcript is
+// written.
+type storyOutput struct {
+	title      string
+	outputDir  string
+	id         string
+	name       string
+	youTubeURL string
+}
+
+func (o *storyOutput) addFlags(command *cobra.Command) {
+	command.Flags().StringVar(&o.title, "title", "", "Event title of the notebook, which also names its file; an existing notebook is never overwritten (default: the file name)")
+	command.Flags().StringVarP(&o.outputDir, "output-dir", "o", ".", "Story directory to write the notebook and its index.yml entry to")
+	command.Flags().StringVar(&o.id, "id", "", "ID of a new index.yml (default: the output directory's name)")
+	command.Flags().StringVar(&o.name, "name", "", "Name of a new index.yml (default: the output directory's name)")
+	command.Flags().StringVar(&o.youTubeURL, "youtube-url", "", "YouTube video the lines are from, linked to by their times")
+}
+
+// write aligns segments transcribed from sourcePath into a story notebook
+// and writes it.
+func (o storyOutput) write(cfg *config.Config, sourcePath string, segments []stt.Segment, out io.Writer) error {
+	if len(segments) == 0 {
+		return fmt.Errorf("no speech was found in %s", sourcePath)
+	}
+	title := o.title
+	if title == "" {
+		title = strings.TrimSuffix(filepath.Base(sourcePath), filepath.Ext(sourcePath))
+	}
+	outputDir, err := filepath.Abs(o.outputDir)
+	if err != nil {
+		return fmt.Errorf("filepath.Abs() > %w", err)
+	}
+	name := o.name
+	if name == "" {
+		name = filepath.Base(outputDir)
+	}
+	id := o.id
+	if id == "" {
+		id = notebook.Slugify(name)
+	}
+
+	scenes := video.Align(segments, cfg.Video.SceneGapSeconds)
+	story := notebook.StoryNotebook{
+		Event:      title,
+		Date:       time.Now(),
+		YouTubeURL: o.youTubeURL,
+		Scenes:     scenes,
+	}
+	path, err := video.WriteStory(outputDir, notebook.Slugify(title)+".yml", id, name, story)
+	if err != nil {
+		return fmt.Errorf("video.WriteStory() > %w", err)
+	}
+	_, _ = fmt.Fprintf(out, "Wrote %d scene(s) to %s\n", len(scenes), path)
+	return recordChange(cfg, fmt.Sprintf("Parse %s", filepath.Base(sourcePath)))
+}
+
+// transcribeVideo returns the transcript segments of the video at
+// videoPath, read from transcriptPath when it's set.
+func transcribeVideo(ctx context.Context, cfg *config.Config, videoPath, transcriptPath string, progress io.Writer) ([]stt.Segment, error) {
+	if transcriptPath != "" {
+		data, err := os.ReadFile(transcriptPath)
+		if err != nil {
+			return nil, fmt.Errorf("os.ReadFile() > %w", err)
+		}
+		return stt.ParseSegments(data)
+	}
+
+	extractor, err := video.NewExtractor(cfg.Video)
+	if err != nil {
+		return nil, err
+	}
+	transcriber, err := video.NewTranscriber(cfg.Video, cfg.STT)
+	if err != nil {
+		return nil, err
+	}
+	_, _ = fmt.Fprintf(progress, "Extracting the audio of %s...\n", videoPath)
+	audio, err := extractor.ExtractAudio(ctx, videoPath)
+	if err != nil {
+		return nil, fmt.Errorf("extractor.ExtractAudio() > %w", err)
+	}
+	_, _ = fmt.Fprintln(progress, "Transcribing...")
+	segments, err := transcriber.TranscribeSegments(ctx, audio, "video.wav")
+	if err != nil {
+		return nil, fmt.Errorf("transcriber.TranscribeSegments() > %w", err)
+	}
+	return segments, nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/testutil"
+)
+
+// videoTranscript is a verbose_json response of a whisper.cpp server.
+const videoTranscript = `{"text": "...", "segments": [
+	{"start": 1.0, "end": 2.5, "text": " How you doin'?", "speaker_turn_next": true},
+	{"start": 3.0, "end": 4.0, "text": " Hi, Joey."},
+	{"start": 30.0, "end": 32.0, "text": " Welcome back."}
+]}`
+
+func TestNewParseVideoCommand(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		file, _, err := r.FormFile("file")
+		require.NoError(t, err)
+		audio, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Contains(t, string(audio), "episode1.mp4")
+		_, _ = io.WriteString(w, videoTranscript)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		transcript bool
+	}{
+		{name: "extracted and transcribed"},
+		{name: "read from a saved transcript", transcript: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			cfgPath := testutil.SetupTestConfig(t, tmpDir)
+			fakeFFmpeg := filepath.Join(tmpDir, "fake-ffmpeg")
+			require.NoError(t, os.WriteFile(fakeFFmpeg, []byte("#!/bin/sh\nprintf 'RIFF %s' \"$1\" > \"$2\"\n"), 0755))
+			content, err := os.ReadFile(cfgPath)
+			require.NoError(t, err)
+			content = append(content, []byte(fmt.Sprintf("video:\n  command: %s\n  args: [\"{input}\", \"{output}\"]\n  whisper_url: %s\n", fakeFFmpeg, server.URL))...)
+			require.NoError(t, os.WriteFile(cfgPath, content, 0644))
+			setConfigFile(t, cfgPath)
+
+			outputDir := filepath.Join(tmpDir, "stories", "friends")
+			args := []string{"episode1.mp4", "--title", "Episode 1", "--output-dir", outputDir, "--name", "Friends", "--youtube-url", "https://www.youtube.com/watch?v=X5oCPvGe-4M"}
+			if tt.transcript {
+				transcriptPath := filepath.Join(tmpDir, "transcript.json")
+				require.NoError(t, os.WriteFile(transcriptPath, []byte(videoTranscript), 0644))
+				args = append(args, "--transcript", transcriptPath)
+			}
+
+			var out bytes.Buffer
+			cmd := newParseVideoCommand()
+			cmd.SetOut(&out)
+			cmd.SetErr(io.Discard)
+			cmd.SetArgs(args)
+			require.NoError(t, cmd.Execute())
+			assert.Contains(t, out.String(), "Wrote 2 scene(s) to "+filepath.Join(outputDir, "episode-1.yml"))
+
+			index, err := os.ReadFile(filepath.Join(outputDir, "index.yml"))
+			require.NoError(t, err)
+			assert.Equal(t, "id: friends\nname: Friends\nnotebooks:\n  - ./episode-1.yml\n", string(index))
+			story, err := os.ReadFile(filepath.Join(outputDir, "episode-1.yml"))
+			require.NoError(t, err)
+			assert.Contains(t, string(story), `- event: Episode 1
+  date: `)
+			assert.Contains(t, string(story), "  youtube_url: https://www.youtube.com/watch?v=X5oCPvGe-4M\n")
+			assert.Contains(t, string(story), `    - scene: Scene 1 (0:01–0:04)
+      conversations:
+        - speaker: Speaker 1
+          quote: How you doin'?
+          time_seconds: 1
+        - speaker: Speaker 2
+          quote: Hi, Joey.
+          time_seconds: 3
+    - scene: Scene 2 (0:30–0:32)
+      conversations:
+        - speaker: Speaker 2
+          quote: Welcome back.
+          time_seconds: 30
+`)
+		})
+	}
+}
+
+func TestNewParseVideoCommand_NoWhisperServer(t *testing.T) {
+	tmpDir := t.TempDir()
+	setConfigFile(t, testutil.SetupTestConfig(t, tmpDir))
+
+	cmd := newParseVideoCommand()
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"episode1.mp4", "--output-dir", tmpDir})
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "video.whisper_url or stt.url is required")
+}
+
+func TestNewParseCaptionsCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	setConfigFile(t, testutil.SetupTestConfig(t, tmpDir))
+	captionsPath := filepath.Join(tmpDir, "episode1.en.vtt")
+	require.NoError(t, os.WriteFile(captionsPath, []byte(`WEBVTT
+
+00:00:01.000 --> 00:00:02.500
+<v Joey>How you doin'?
+
+00:00:03.000 --> 00:00:04.000
+<v Rachel>Hi, Joey.
+`), 0644))
+
+	outputDir := filepath.Join(tmpDir, "stories", "friends")
+	var out bytes.Buffer
+	cmd := newParseCaptionsCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{captionsPath, "--output-dir", outputDir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "Wrote 1 scene(s) to "+filepath.Join(outputDir, "episode1-en.yml"))
+
+	story, err := os.ReadFile(filepath.Join(outputDir, "episode1-en.yml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(story), `- event: episode1.en
+`)
+	assert.Contains(t, string(story), `        - speaker: Joey
+          quote: How you doin'?
+          time_seconds: 1
+        - speaker: Rachel
+          quote: Hi, Joey.
+          time_seconds: 3
+`)
+}
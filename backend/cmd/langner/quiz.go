@@ -9,6 +9,8 @@ import (
 	"github.com/at-ishikawa/langner/internal/inference"
 	"github.com/at-ishikawa/langner/internal/inference/openai"
 	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiztui"
+	"github.com/at-ishikawa/langner/internal/versioning"
 	"github.com/spf13/cobra"
 )
 
@@ -21,15 +23,24 @@ func newQuizCommand() *cobra.Command {
 	quizCommand.AddCommand(newQuizNotebookCommand())
 	quizCommand.AddCommand(newQuizFreeformCommand())
 	quizCommand.AddCommand(newQuizEtymologyStatusCommand())
+	quizCommand.AddCommand(newQuizTUICommand())
+	quizCommand.AddCommand(newQuizDictationCommand())
+	quizCommand.AddCommand(newQuizWordChoiceCommand())
 
 	return quizCommand
 }
 
 func newQuizFreeformCommand() *cobra.Command {
+	var server string
+
 	command := &cobra.Command{
 		Use:   "freeform",
 		Short: "Freeform quiz where you provide both word and meaning from memory",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if server != "" {
+				return runRemoteQuiz(server, quiztui.ModeFreeform, "", false)
+			}
+
 			cfg, err := loadConfig()
 			if err != nil {
 				return err
@@ -63,6 +74,8 @@ func newQuizFreeformCommand() *cobra.Command {
 		},
 	}
 
+	addServerFlag(command, &server)
+
 	return command
 }
 
@@ -71,6 +84,7 @@ func newQuizNotebookCommand() *cobra.Command {
 	var notebookName string
 	var quizMode string
 	var listMissingContext bool
+	var server string
 
 	command := &cobra.Command{
 		Use:   "notebook",
@@ -83,8 +97,19 @@ func newQuizNotebookCommand() *cobra.Command {
 Examples:
   langner quiz notebook                           # Recognition quiz from all notebooks
   langner quiz notebook --mode=reverse            # Reverse quiz from all notebooks
-  langner quiz notebook -n friends --mode=reverse # Reverse quiz from specific notebook`,
+  langner quiz notebook -n friends --mode=reverse # Reverse quiz from specific notebook
+  langner quiz notebook --server http://nas:8080  # Quiz through a langner-server`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if server != "" {
+				if listMissingContext {
+					return fmt.Errorf("--list-missing-context is not supported with --server")
+				}
+				if quizMode == "reverse" {
+					return runRemoteQuiz(server, quiztui.ModeReverse, notebookName, false)
+				}
+				return runRemoteQuiz(server, quiztui.ModeRecognition, notebookName, includeNoCorrectAnswers)
+			}
+
 			cfg, err := loadConfig()
 			if err != nil {
 				return err
@@ -114,11 +139,17 @@ Examples:
 	command.Flags().StringVarP(&notebookName, "notebook", "n", "", "Quiz from a specific notebook (empty for all notebooks)")
 	command.Flags().StringVarP(&quizMode, "mode", "m", "recognition", "Quiz mode: recognition (default) or reverse")
 	command.Flags().BoolVar(&listMissingContext, "list-missing-context", false, "List words without context sentences (reverse mode only)")
+	addServerFlag(command, &server)
 
 	return command
 }
 
 func runRecognitionQuiz(cfg *config.Config, openaiClient inference.Client, notebookName string, includeNoCorrectAnswers bool) error {
+	recorder, err := versioning.NewRecorder(cfg.Versioning)
+	if err != nil {
+		return err
+	}
+
 	// If no notebook specified, quiz from all story notebooks
 	if notebookName == "" {
 		notebookCLI, err := cli.NewNotebookQuizCLI(
@@ -132,6 +163,7 @@ func runRecognitionQuiz(cfg *config.Config, openaiClient inference.Client, noteb
 		if err != nil {
 			return err
 		}
+		notebookCLI.SetRecorder(recorder)
 		notebookCLI.ShuffleCards()
 		fmt.Printf("Starting Q&A session with all notebooks with %d cards\n\n", notebookCLI.GetCardCount())
 
@@ -164,6 +196,7 @@ func runRecognitionQuiz(cfg *config.Config, openaiClient inference.Client, noteb
 		if err != nil {
 			return err
 		}
+		flashcardCLI.SetRecorder(recorder)
 		flashcardCLI.ShuffleCards()
 		fmt.Printf("Starting flashcard Q&A session with %d cards\n\n", flashcardCLI.GetCardCount())
 
@@ -182,6 +215,7 @@ func runRecognitionQuiz(cfg *config.Config, openaiClient inference.Client, noteb
 	if err != nil {
 		return err
 	}
+	notebookCLI.SetRecorder(recorder)
 	notebookCLI.ShuffleCards()
 	fmt.Printf("Starting Q&A session for notebook %s with %d cards\n\n", notebookName, notebookCLI.GetCardCount())
 
@@ -207,6 +241,12 @@ func runReverseQuiz(cfg *config.Config, openaiClient inference.Client, notebookN
 		return nil
 	}
 
+	recorder, err := versioning.NewRecorder(cfg.Versioning)
+	if err != nil {
+		return err
+	}
+	reverseCLI.SetRecorder(recorder)
+
 	if reverseCLI.GetCardCount() == 0 {
 		fmt.Println("No cards need reverse quiz review.")
 		return nil
@@ -221,4 +261,3 @@ func runReverseQuiz(cfg *config.Config, openaiClient inference.Client, notebookN
 
 	return reverseCLI.Run(context.Background(), reverseCLI)
 }
-
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/tts"
+	"github.com/at-ishikawa/langner/internal/versioning"
+)
+
+func newQuizDictationCommand() *cobra.Command {
+	var notebookIDs []string
+	var includeUnstudied bool
+
+	command := &cobra.Command{
+		Use:   "dictation",
+		Short: "Listening quiz where you type the conversation line you hear",
+		Long: `Play conversation lines of story notebooks and type what you heard. Each
+line is chosen for a word it teaches, and is graded word by word with that
+word counting more than the rest. Lines use the audio attached to the
+conversation, or are synthesized with the text-to-speech program configured
+under tts and played with tts.player.
+
+Press Enter on an empty answer to replay the line, or type "quit" to stop.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			player, err := tts.NewPlayer(cfg.TTS)
+			if err != nil {
+				return err
+			}
+			recorder, err := versioning.NewRecorder(cfg.Versioning)
+			if err != nil {
+				return err
+			}
+			calculator := notebook.NewIntervalCalculator(cfg.Quiz.Algorithm, cfg.Quiz.FixedIntervals)
+			// Dictation is graded locally, so no inference client is needed.
+			svc := quiz.NewService(cfg.Notebooks, nil, nil, learning.NewYAMLLearningRepository(cfg.Notebooks.LearningNotesDirectory, calculator), cfg.Quiz)
+			svc.SetRecorder(recorder)
+			if synthesizer, err := tts.NewSynthesizer(cfg.TTS); err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Text-to-speech is not available, only recorded lines are played: %v\n", err)
+			} else {
+				svc.SetSynthesizer(synthesizer)
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer cancel()
+			cards, err := svc.LoadDictationCards(ctx, notebookIDs, includeUnstudied, nil)
+			if err != nil {
+				return fmt.Errorf("svc.LoadDictationCards() > %w", err)
+			}
+			return runDictationQuiz(ctx, svc, player, cards, cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+
+	command.Flags().StringSliceVarP(&notebookIDs, "notebook", "n", nil, "Story notebook IDs to draw lines from (default: every story notebook)")
+	command.Flags().BoolVar(&includeUnstudied, "include-unstudied", false, "Also play lines of words that have never been answered")
+
+	return command
+}
+
+// dictationPlayer plays the audio file of a dictation line.
+type dictationPlayer interface {
+	Play(ctx context.Context, path string) error
+}
+
+// dictationRecorder saves a graded dictation answer.
+type dictationRecorder interface {
+	SaveDictationResult(ctx context.Context, card quiz.DictationCard, result quiz.GradeResult, responseTimeMs int64) error
+}
+
+// runDictationQuiz plays each card and grades the line typed back, until
+// the cards run out, the input ends, or the learner types "quit".
+func runDictationQuiz(ctx context.Context, recorder dictationRecorder, player dictationPlayer, cards []quiz.DictationCard, in io.Reader, out io.Writer) error {
+	if len(cards) == 0 {
+		_, _ = fmt.Fprintln(out, "No lines are due for dictation.")
+		return nil
+	}
+	_, _ = fmt.Fprintf(out, "%d line(s) to listen to. Press Enter to replay a line, or type 'quit' to stop.\n", len(cards))
+
+	scanner := bufio.NewScanner(in)
+	correct, answered := 0, 0
+	defer func() {
+		_, _ = fmt.Fprintf(out, "\n%d of %d line(s) correct.\n", correct, answered)
+	}()
+	for i, card := range cards {
+		_, _ = fmt.Fprintf(out, "\n[%d/%d] %s — %s (%d words)\n", i+1, len(cards), card.StoryTitle, card.SceneTitle, card.WordCount())
+		start := time.Now()
+		var answer string
+		for {
+			if err := player.Play(ctx, card.AudioFile); err != nil {
+				return fmt.Errorf("play %s: %w", card.Audio, err)
+			}
+			_, _ = fmt.Fprint(out, "> ")
+			if !scanner.Scan() {
+				return scanner.Err()
+			}
+			answer = strings.TrimSpace(scanner.Text())
+			if answer != "" {
+				break
+			}
+		}
+		if strings.EqualFold(answer, "quit") {
+			return nil
+		}
+
+		result := quiz.GradeDictation(card, answer)
+		if err := recorder.SaveDictationResult(ctx, card, result, time.Since(start).Milliseconds()); err != nil {
+			return fmt.Errorf("save dictation result: %w", err)
+		}
+		answered++
+		mark := "✗"
+		if result.Correct {
+			correct++
+			mark = "✓"
+		}
+		_, _ = fmt.Fprintf(out, "%s %s\n", mark, result.Reason)
+		if card.Speaker != "" {
+			_, _ = fmt.Fprintf(out, "  %s: %s\n", card.Speaker, card.Line)
+		} else {
+			_, _ = fmt.Fprintf(out, "  %s\n", card.Line)
+		}
+		_, _ = fmt.Fprintf(out, "  %s: %s\n", card.Expression, card.Meaning)
+	}
+	return nil
+}
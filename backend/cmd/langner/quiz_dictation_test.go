@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+type fakeDictationPlayer struct {
+	played []string
+}
+
+func (p *fakeDictationPlayer) Play(_ context.Context, path string) error {
+	p.played = append(p.played, path)
+	return nil
+}
+
+type fakeDictationRecorder struct {
+	results []quiz.GradeResult
+}
+
+func (r *fakeDictationRecorder) SaveDictationResult(_ context.Context, _ quiz.DictationCard, result quiz.GradeResult, _ int64) error {
+	r.results = append(r.results, result)
+	return nil
+}
+
+func TestRunDictationQuiz(t *testing.T) {
+	cards := []quiz.DictationCard{
+		{
+			StoryTitle: "Episode 1", SceneTitle: "Kitchen", Speaker: "Bob",
+			Line:       "I can't put up with this noise anymore.",
+			Expression: "put up with", Entry: "put up with", Meaning: "to tolerate",
+			Audio: "audio/kitchen.wav", AudioFile: "/notebooks/drama/audio/kitchen.wav",
+		},
+		{
+			StoryTitle: "Episode 1", SceneTitle: "Office", Speaker: "Ann",
+			Line:       "Let's touch base tomorrow.",
+			Expression: "touch base", Entry: "touch base", Meaning: "to talk briefly",
+			Audio: "audio/office.wav", AudioFile: "/notebooks/drama/audio/office.wav",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		input        string
+		wantPlayed   []string
+		wantCorrect  []bool
+		wantContains []string
+	}{
+		{
+			name:        "an empty answer replays the line",
+			input:       "\nI can't put up with this noise anymore\nlet's touch bass tomorrow\n",
+			wantPlayed:  []string{"/notebooks/drama/audio/kitchen.wav", "/notebooks/drama/audio/kitchen.wav", "/notebooks/drama/audio/office.wav"},
+			wantCorrect: []bool{true, false},
+			wantContains: []string{
+				"✓ Every word heard.",
+				"Bob: I can't put up with this noise anymore.",
+				`✗ Misheard the target "touch base".`,
+				"touch base: to talk briefly",
+				"1 of 2 line(s) correct.",
+			},
+		},
+		{
+			name:         "quit stops without recording",
+			input:        "quit\n",
+			wantPlayed:   []string{"/notebooks/drama/audio/kitchen.wav"},
+			wantContains: []string{"0 of 0 line(s) correct."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := &fakeDictationPlayer{}
+			recorder := &fakeDictationRecorder{}
+			var out bytes.Buffer
+
+			err := runDictationQuiz(context.Background(), recorder, player, cards, strings.NewReader(tt.input), &out)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPlayed, player.played)
+			var correct []bool
+			for _, r := range recorder.results {
+				correct = append(correct, r.Correct)
+			}
+			assert.Equal(t, tt.wantCorrect, correct)
+			for _, s := range tt.wantContains {
+				assert.Contains(t, out.String(), s)
+			}
+		})
+	}
+}
+
+func TestRunDictationQuiz_NoCards(t *testing.T) {
+	var out bytes.Buffer
+	err := runDictationQuiz(context.Background(), &fakeDictationRecorder{}, &fakeDictationPlayer{}, nil, strings.NewReader(""), &out)
+	require.NoError(t, err)
+	assert.Equal(t, "No lines are due for dictation.\n", out.String())
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/gen-protos/api/v1/apiv1connect"
+	"github.com/at-ishikawa/langner/internal/cli"
+	"github.com/at-ishikawa/langner/internal/quiztui"
+)
+
+// addServerFlag registers --server, which sends the quiz through a running
+// langner-server instead of the local notebooks.
+func addServerFlag(command *cobra.Command, server *string) {
+	command.Flags().StringVar(server, "server", "", "Run the quiz on a langner-server at this URL (e.g. http://localhost:8080); no local notebooks or OpenAI key are needed")
+}
+
+func newRemoteBackend(serverURL string) *quiztui.RemoteBackend {
+	client := apiv1connect.NewQuizServiceClient(http.DefaultClient, strings.TrimRight(serverURL, "/"))
+	return quiztui.NewRemoteBackend(client)
+}
+
+// runRemoteQuiz runs a line-by-line quiz of mode on the server. An empty
+// notebookName quizzes every notebook with something due.
+func runRemoteQuiz(serverURL string, mode quiztui.Mode, notebookName string, includeUnstudied bool) error {
+	ctx := context.Background()
+	backend := newRemoteBackend(serverURL).WithIncludeUnstudied(includeUnstudied)
+	var selections []quiztui.Selection
+	if notebookName != "" {
+		selections = []quiztui.Selection{{NotebookID: notebookName}}
+	}
+	remoteCLI, err := cli.NewRemoteQuizCLI(ctx, backend, mode, selections)
+	if err != nil {
+		return err
+	}
+
+	if mode == quiztui.ModeFreeform {
+		fmt.Printf("Interactive word practice session started on %s!\n", serverURL)
+		fmt.Println("Enter word and meaning pairs. Type 'quit' to exit.")
+		fmt.Println()
+		return remoteCLI.Run(ctx, remoteCLI)
+	}
+
+	if remoteCLI.GetCardCount() == 0 {
+		fmt.Printf("No cards need %s quiz review.\n", mode)
+		return nil
+	}
+	remoteCLI.ShuffleCards()
+	if notebookName == "" {
+		fmt.Printf("Starting %s quiz session on %s with %d cards from all notebooks\n\n", mode, serverURL, remoteCLI.GetCardCount())
+	} else {
+		fmt.Printf("Starting %s quiz session on %s for notebook %s with %d cards\n\n", mode, serverURL, notebookName, remoteCLI.GetCardCount())
+	}
+	return remoteCLI.Run(ctx, remoteCLI)
+}
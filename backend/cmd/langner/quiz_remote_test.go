@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+	"github.com/at-ishikawa/langner/gen-protos/api/v1/apiv1connect"
+)
+
+type emptyQuizServer struct {
+	apiv1connect.UnimplementedQuizServiceHandler
+}
+
+func (emptyQuizServer) GetQuizOptions(context.Context, *connect.Request[apiv1.GetQuizOptionsRequest]) (*connect.Response[apiv1.GetQuizOptionsResponse], error) {
+	return connect.NewResponse(&apiv1.GetQuizOptionsResponse{}), nil
+}
+
+func (emptyQuizServer) StartQuiz(context.Context, *connect.Request[apiv1.StartQuizRequest]) (*connect.Response[apiv1.StartQuizResponse], error) {
+	return connect.NewResponse(&apiv1.StartQuizResponse{}), nil
+}
+
+func newEmptyQuizServer(t *testing.T) string {
+	t.Helper()
+	path, handler := apiv1connect.NewQuizServiceHandler(emptyQuizServer{})
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestNewQuizNotebookCommand_RunE_Server(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "all notebooks", args: nil},
+		{name: "named notebook", args: []string{"-n", "friends"}},
+		{name: "missing context listing is local only", args: []string{"--list-missing-context"}, wantErr: "--list-missing-context"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A broken config proves nothing local is loaded.
+			setConfigFile(t, setupBrokenConfigFile(t))
+
+			cmd := newQuizNotebookCommand()
+			cmd.SetArgs(append([]string{"--server", newEmptyQuizServer(t) + "/"}, tt.args...))
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewQuizNotebookCommand_RunE_ServerUnavailable(t *testing.T) {
+	setConfigFile(t, setupBrokenConfigFile(t))
+
+	cmd := newQuizNotebookCommand()
+	cmd.SetArgs([]string{"--server", newEmptyQuizServer(t), "--mode", "reverse", "-n", "friends"})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/inference/openai"
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/quiztui"
+	"github.com/at-ishikawa/langner/internal/tui"
+	"github.com/at-ishikawa/langner/internal/versioning"
+)
+
+func newQuizTUICommand() *cobra.Command {
+	var includeUnstudied bool
+	var batchSize int
+	var server string
+
+	command := &cobra.Command{
+		Use:   "tui",
+		Short: "Full-screen quiz for every mode (recognition, reverse, freeform, etymology, grammar, relearn)",
+		Long: `Start a full-screen terminal quiz. Pick a mode, then the notebooks or sections
+to draw from; answers are graded in the background and shown in batches, where
+each one can be overridden (o), the override undone (u), or the word excluded
+from the mode (x). Everything is driven from the keyboard.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if server != "" {
+				backend := newRemoteBackend(server).WithIncludeUnstudied(includeUnstudied)
+				return runQuizTUI(backend, batchSize)
+			}
+
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			if cfg.OpenAI.APIKey == "" {
+				return fmt.Errorf("OPENAI_API_KEY environment variable is required")
+			}
+			openaiClient := openai.NewClient(cfg.OpenAI.APIKey, cfg.OpenAI.Model, inference.DefaultMaxRetryAttempts)
+			defer func() {
+				_ = openaiClient.Close()
+			}()
+
+			responses, err := rapidapi.NewReader().Read(cfg.Dictionaries.RapidAPI.CacheDirectory)
+			if err != nil {
+				return fmt.Errorf("rapidapi.NewReader().Read() > %w", err)
+			}
+			recorder, err := versioning.NewRecorder(cfg.Versioning)
+			if err != nil {
+				return err
+			}
+			calculator := notebook.NewIntervalCalculator(cfg.Quiz.Algorithm, cfg.Quiz.FixedIntervals)
+			svc := quiz.NewService(cfg.Notebooks, openaiClient, rapidapi.FromResponsesToMap(responses), learning.NewYAMLLearningRepository(cfg.Notebooks.LearningNotesDirectory, calculator), cfg.Quiz)
+			svc.SetRecorder(recorder)
+
+			return runQuizTUI(quiztui.NewServiceBackend(svc).WithIncludeUnstudied(includeUnstudied), batchSize)
+		},
+	}
+
+	command.Flags().BoolVar(&includeUnstudied, "include-unstudied", false, "Also quiz words that have never been answered")
+	command.Flags().IntVar(&batchSize, "batch-size", quiztui.DefaultBatchSize, "Number of answers between feedback screens")
+	addServerFlag(command, &server)
+
+	return command
+}
+
+func runQuizTUI(backend quiztui.Backend, batchSize int) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	app := quiztui.NewApp(ctx, backend).WithBatchSize(batchSize)
+	_, err := tui.NewProgram(app).Run(ctx)
+	return err
+}
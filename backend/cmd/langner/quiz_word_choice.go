@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/inference/openai"
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/versioning"
+)
+
+func newQuizWordChoiceCommand() *cobra.Command {
+	var notebookIDs []string
+	var includeUnstudied bool
+
+	command := &cobra.Command{
+		Use:   "word-choice",
+		Short: "Quiz telling apart the members of synonym and antonym concepts",
+		Long: `Ask about the synonym and antonym concepts of definitions books. When a
+member has an example, the example is shown with the member blanked out and
+you pick the member that fits best. Otherwise you explain the difference
+between the member and another member of its concept.
+
+Answers are graded with OpenAI when OPENAI_API_KEY is set, and by comparing
+against the notebook otherwise. Type "quit" to stop.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			recorder, err := versioning.NewRecorder(cfg.Versioning)
+			if err != nil {
+				return err
+			}
+			var client inference.Client
+			if cfg.OpenAI.APIKey != "" {
+				openaiClient := openai.NewClient(cfg.OpenAI.APIKey, cfg.OpenAI.Model, inference.DefaultMaxRetryAttempts)
+				defer func() {
+					_ = openaiClient.Close()
+				}()
+				client = openaiClient
+			}
+			calculator := notebook.NewIntervalCalculator(cfg.Quiz.Algorithm, cfg.Quiz.FixedIntervals)
+			svc := quiz.NewService(cfg.Notebooks, client, nil, learning.NewYAMLLearningRepository(cfg.Notebooks.LearningNotesDirectory, calculator), cfg.Quiz)
+			svc.SetRecorder(recorder)
+
+			cards, err := svc.LoadWordChoiceCards(notebookIDs, includeUnstudied, nil)
+			if err != nil {
+				return fmt.Errorf("svc.LoadWordChoiceCards() > %w", err)
+			}
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer cancel()
+			return runWordChoiceQuiz(ctx, svc, cards, cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+
+	command.Flags().StringSliceVarP(&notebookIDs, "notebook", "n", nil, "Definitions book IDs to draw concepts from (default: every definitions book)")
+	command.Flags().BoolVar(&includeUnstudied, "include-unstudied", false, "Also ask about members that have never been answered")
+
+	return command
+}
+
+// wordChoiceGrader grades and saves word choice answers.
+type wordChoiceGrader interface {
+	GradeWordChoice(ctx context.Context, card quiz.WordChoiceCard, answer string, responseTimeMs int64) quiz.GradeResult
+	SaveWordChoiceResult(ctx context.Context, card quiz.WordChoiceCard, result quiz.GradeResult, responseTimeMs int64) error
+}
+
+// runWordChoiceQuiz asks each card and grades the answer, until the cards
+// run out, the input ends, or the learner types "quit". A fit question
+// accepts either a member or its number in the list.
+func runWordChoiceQuiz(ctx context.Context, grader wordChoiceGrader, cards []quiz.WordChoiceCard, in io.Reader, out io.Writer) error {
+	if len(cards) == 0 {
+		_, _ = fmt.Fprintln(out, "No synonym or antonym members are due.")
+		return nil
+	}
+	_, _ = fmt.Fprintf(out, "%d question(s). Type 'quit' to stop.\n", len(cards))
+
+	scanner := bufio.NewScanner(in)
+	correct, answered := 0, 0
+	defer func() {
+		_, _ = fmt.Fprintf(out, "\n%d of %d answer(s) correct.\n", correct, answered)
+	}()
+	for i, card := range cards {
+		_, _ = fmt.Fprintf(out, "\n[%d/%d] %s %s: %s\n", i+1, len(cards), card.ConceptKind, card.ConceptHead, card.ConceptMeaning)
+		if card.IsDifference() {
+			_, _ = fmt.Fprintf(out, "What is the difference between %q and %q?\n", card.Expression, card.Compare.Expression)
+		} else {
+			_, _ = fmt.Fprintf(out, "%s\n", card.Sentence)
+			for j, option := range card.Options {
+				_, _ = fmt.Fprintf(out, "  %d. %s\n", j+1, option.Expression)
+			}
+		}
+		start := time.Now()
+		var answer string
+		for answer == "" {
+			_, _ = fmt.Fprint(out, "> ")
+			if !scanner.Scan() {
+				return scanner.Err()
+			}
+			answer = strings.TrimSpace(scanner.Text())
+		}
+		if strings.EqualFold(answer, "quit") {
+			return nil
+		}
+		if n, err := strconv.Atoi(answer); err == nil && !card.IsDifference() && n >= 1 && n <= len(card.Options) {
+			answer = card.Options[n-1].Expression
+		}
+
+		responseTimeMs := time.Since(start).Milliseconds()
+		result := grader.GradeWordChoice(ctx, card, answer, responseTimeMs)
+		if err := grader.SaveWordChoiceResult(ctx, card, result, responseTimeMs); err != nil {
+			return fmt.Errorf("save word choice result: %w", err)
+		}
+		answered++
+		mark := "✗"
+		if result.Correct {
+			correct++
+			mark = "✓"
+		}
+		_, _ = fmt.Fprintf(out, "%s %s\n", mark, result.Reason)
+		for _, option := range card.Options {
+			_, _ = fmt.Fprintf(out, "  %s: %s\n", option.Expression, option.Meaning)
+		}
+	}
+	return nil
+}
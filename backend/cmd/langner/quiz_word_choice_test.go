@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+type fakeWordChoiceGrader struct {
+	answers []string
+	results []quiz.GradeResult
+}
+
+func (g *fakeWordChoiceGrader) GradeWordChoice(_ context.Context, card quiz.WordChoiceCard, answer string, _ int64) quiz.GradeResult {
+	g.answers = append(g.answers, answer)
+	return quiz.GradeWordChoiceDeterministic(card, answer)
+}
+
+func (g *fakeWordChoiceGrader) SaveWordChoiceResult(_ context.Context, _ quiz.WordChoiceCard, result quiz.GradeResult, _ int64) error {
+	g.results = append(g.results, result)
+	return nil
+}
+
+func TestRunWordChoiceQuiz(t *testing.T) {
+	options := []quiz.WordChoiceOption{
+		{Expression: "hearty", Meaning: "large and satisfying"},
+		{Expression: "cordial", Meaning: "warm and friendly"},
+	}
+	cards := []quiz.WordChoiceCard{
+		{
+			ConceptHead: "hearty", ConceptKind: "synonym", ConceptMeaning: "warm",
+			Expression: "hearty", Entry: "hearty", Meaning: "large and satisfying",
+			Sentence: "We had a ______ breakfast.", Options: options,
+		},
+		{
+			ConceptHead: "hearty", ConceptKind: "synonym", ConceptMeaning: "warm",
+			Expression: "cordial", Entry: "cordial", Meaning: "warm and friendly",
+			Options: options, Compare: options[0],
+		},
+	}
+
+	tests := []struct {
+		name         string
+		input        string
+		wantAnswers  []string
+		wantCorrect  []bool
+		wantContains []string
+	}{
+		{
+			name:        "a number picks the option",
+			input:       "1\n\nfriendly versus filling\n",
+			wantAnswers: []string{"hearty", "friendly versus filling"},
+			wantCorrect: []bool{true, false},
+			wantContains: []string{
+				"  1. hearty",
+				`What is the difference between "cordial" and "hearty"?`,
+				"cordial: warm and friendly",
+				"1 of 2 answer(s) correct.",
+			},
+		},
+		{
+			name:         "quit stops without recording",
+			input:        "quit\n",
+			wantContains: []string{"0 of 0 answer(s) correct."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grader := &fakeWordChoiceGrader{}
+			var out bytes.Buffer
+
+			err := runWordChoiceQuiz(context.Background(), grader, cards, strings.NewReader(tt.input), &out)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAnswers, grader.answers)
+			var correct []bool
+			for _, r := range grader.results {
+				correct = append(correct, r.Correct)
+			}
+			assert.Equal(t, tt.wantCorrect, correct)
+			for _, s := range tt.wantContains {
+				assert.Contains(t, out.String(), s)
+			}
+		})
+	}
+}
+
+func TestRunWordChoiceQuiz_NoCards(t *testing.T) {
+	var out bytes.Buffer
+	err := runWordChoiceQuiz(context.Background(), &fakeWordChoiceGrader{}, nil, strings.NewReader(""), &out)
+	require.NoError(t, err)
+	assert.Equal(t, "No synonym or antonym members are due.\n", out.String())
+}
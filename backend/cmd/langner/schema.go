@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/internal/jsonschema"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// schemaBaseURL is where the committed schemas are published; it prefixes
+// each schema's $id so editors can fetch them by URL as well as by path.
+const schemaBaseURL = "https://raw.githubusercontent.com/at-ishikawa/langner/main/backend/schemas/json/"
+
+func newSchemaCommand() *cobra.Command {
+	schemaCmd := &cobra.Command{
+		Use:   "schema",
+		Short: "JSON Schemas for notebook YAML files",
+	}
+	schemaCmd.AddCommand(newSchemaExportCommand())
+	return schemaCmd
+}
+
+func newSchemaExportCommand() *cobra.Command {
+	var outputDir string
+
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Write a JSON Schema for each notebook YAML format",
+		Long: `Write <format>.schema.json for every notebook YAML format (stories, flashcards,
+definitions, etymology, grammar, learning history and their index files) into the
+output directory. Point a YAML language server at them to get key and type checks
+while editing, e.g. with a modeline at the top of a story file:
+
+  # yaml-language-server: $schema=../../backend/schemas/json/story.schema.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := generateSchemaFiles()
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(outputDir, 0755); err != nil {
+				return fmt.Errorf("os.MkdirAll(%s) > %w", outputDir, err)
+			}
+			for name, data := range files {
+				path := filepath.Join(outputDir, name)
+				if err := os.WriteFile(path, data, 0644); err != nil {
+					return fmt.Errorf("os.WriteFile(%s) > %w", path, err)
+				}
+			}
+			fmt.Printf("Wrote %d schemas to %s\n", len(files), outputDir)
+			return nil
+		},
+	}
+	command.Flags().StringVar(&outputDir, "output", filepath.Join("schemas", "json"), "directory to write the schema files into")
+	return command
+}
+
+// generateSchemaFiles renders every notebook YAML format keyed by its
+// output file name.
+func generateSchemaFiles() (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	for _, format := range notebook.YAMLFormats() {
+		name := format.Name + ".schema.json"
+		schema := jsonschema.Generate(schemaBaseURL+name, format.Title, format.Description, format.Values...)
+		data, err := jsonschema.Marshal(schema)
+		if err != nil {
+			return nil, fmt.Errorf("jsonschema.Marshal(%s) > %w", format.Name, err)
+		}
+		files[name] = data
+	}
+	return files, nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/at-ishikawa/langner/internal/jsonschema"
+)
+
+// TestSchemaFilesUpToDate fails when a notebook type changes without the
+// committed schemas being regenerated with `langner schema export`.
+func TestSchemaFilesUpToDate(t *testing.T) {
+	dir := filepath.Join(filepath.Dir(findMigrationsDir(t)), "json")
+	files, err := generateSchemaFiles()
+	require.NoError(t, err)
+
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err, "run `langner schema export` from backend/")
+		assert.Equal(t, string(want), string(got), "%s is stale; run `langner schema export` from backend/", name)
+	}
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		assert.Contains(t, files, entry.Name(), "%s no longer matches a notebook format", entry.Name())
+	}
+}
+
+// TestSchemaAcceptsExamples validates every example notebook against its
+// schema, so a schema that is stricter than the readers is caught here.
+func TestSchemaAcceptsExamples(t *testing.T) {
+	files, err := generateSchemaFiles()
+	require.NoError(t, err)
+
+	examplesDir := filepath.Join(filepath.Dir(filepath.Dir(filepath.Dir(findMigrationsDir(t)))), "examples")
+	formatByDir := map[string]string{
+		"stories":        "story",
+		"books":          "story",
+		"journals":       "story",
+		"flashcards":     "flashcard",
+		"definitions":    "definitions",
+		"etymology":      "etymology",
+		"grammars":       "grammar",
+		"learning_notes": "learning-history",
+	}
+
+	var checked []string
+	err = filepath.WalkDir(examplesDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".yml" {
+			return err
+		}
+		rel, err := filepath.Rel(examplesDir, path)
+		if err != nil {
+			return err
+		}
+		format, ok := formatByDir[strings.Split(rel, string(filepath.Separator))[0]]
+		if !ok {
+			return nil
+		}
+		if d.Name() == "index.yml" {
+			format += "-index"
+		}
+		checked = append(checked, rel)
+
+		var doc jsonschema.Schema
+		require.NoError(t, json.Unmarshal(files[format+".schema.json"], &doc), format)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		var value any
+		require.NoError(t, yaml.Unmarshal(data, &value), rel)
+		assert.Empty(t, jsonschema.Validate(doc, value), rel)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, checked)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/search"
+)
+
+func newSearchCommand() *cobra.Command {
+	var notebookIDs []string
+	var limit int
+	command := &cobra.Command{
+		Use:   "search <text>",
+		Short: "Find every scene, speaker and note that uses a word",
+		Long: `List every story line, statement, note and example across all
+notebooks that uses the word or phrase in any inflected form: "run into"
+finds "ran into" and "runs into" as well.
+
+The index is saved to search.index_file when it's set and rebuilt whenever
+a notebook file changes.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			dirs := notebook.ReaderDirectories{
+				Stories:     cfg.Notebooks.StoriesDirectories,
+				Journals:    cfg.Notebooks.JournalsDirectories,
+				Flashcards:  cfg.Notebooks.FlashcardsDirectories,
+				Books:       cfg.Notebooks.BooksDirectories,
+				Definitions: cfg.Notebooks.DefinitionsDirectories,
+			}
+			store := search.NewStore(dirs.All(), cfg.Search.IndexFile, func() (*notebook.Reader, error) {
+				return notebook.NewReaderFromDirectories(dirs, nil)
+			})
+			index, err := store.Index()
+			if err != nil {
+				return err
+			}
+			query := strings.Join(args, " ")
+			occurrences, total := index.Search(query, search.SearchOptions{NotebookIDs: notebookIDs, Limit: limit})
+			printOccurrences(cmd.OutOrStdout(), query, occurrences, total)
+			return nil
+		},
+	}
+	command.Flags().StringSliceVar(&notebookIDs, "notebook", nil, "Only search these notebook IDs (repeatable)")
+	command.Flags().IntVar(&limit, "limit", 0, "Show at most this many occurrences (0 shows all)")
+	return command
+}
+
+// printOccurrences lists the occurrences under a heading for each scene,
+// flashcard deck or definitions session.
+func printOccurrences(w io.Writer, query string, occurrences []search.Occurrence, total int) {
+	if total == 0 {
+		_, _ = fmt.Fprintf(w, "No occurrences of %q\n", query)
+		return
+	}
+	heading := ""
+	for _, o := range occurrences {
+		if h := occurrenceHeading(o.Document); h != heading {
+			heading = h
+			_, _ = fmt.Fprintln(w, heading)
+		}
+		switch o.Kind {
+		case search.DocumentKindConversation:
+			_, _ = fmt.Fprintf(w, "  %s: %s\n", o.Speaker, o.Text)
+		case search.DocumentKindStatement:
+			_, _ = fmt.Fprintf(w, "  %s\n", o.Text)
+		case search.DocumentKindNote:
+			_, _ = fmt.Fprintf(w, "  note: %s — %s\n", o.Expression, o.Meaning)
+		case search.DocumentKindExample:
+			_, _ = fmt.Fprintf(w, "  example of %s: %s\n", o.Expression, o.Text)
+		}
+	}
+	if len(occurrences) < total {
+		_, _ = fmt.Fprintf(w, "Showing %d of %d occurrences of %q\n", len(occurrences), total, query)
+		return
+	}
+	_, _ = fmt.Fprintf(w, "%d occurrence(s) of %q\n", total, query)
+}
+
+// occurrenceHeading is "notebook › story › scene", leaving out empty parts.
+func occurrenceHeading(d search.Document) string {
+	title := d.NotebookTitle
+	if title == "" {
+		title = d.NotebookID
+	}
+	parts := []string{title}
+	for _, part := range []string{d.StoryTitle, d.SceneTitle} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " › ")
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/testutil"
+)
+
+func TestNewSearchCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	indexPath := filepath.Join(tmpDir, "search-index.json")
+	cfg, err := os.ReadFile(cfgPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfgPath, append(cfg, []byte("search:\n  index_file: "+indexPath+"\n")...), 0644))
+	setConfigFile(t, cfgPath)
+	setupAudioNotebook(t, tmpDir)
+
+	storyDir := filepath.Join(tmpDir, "stories", "drama")
+	require.NoError(t, os.MkdirAll(storyDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(storyDir, "index.yml"), []byte(
+		"id: drama\nname: Office Drama\nnotebooks:\n  - ./episode1.yml\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(storyDir, "episode1.yml"), []byte(`- event: "Episode 1"
+  date: 2025-01-15T00:00:00Z
+  scenes:
+    - scene: "Kitchen"
+      conversations:
+        - speaker: "Ann"
+          quote: "Fame is {{ ephemeral }}."
+      statements:
+        - "Bob was candid about it."
+`), 0644))
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "lists every occurrence under its scene",
+			args: []string{"ephemeral"},
+			want: `Office Drama › Episode 1 › Kitchen
+  Ann: Fame is ephemeral.
+Vocabulary › Week 1
+  note: ephemeral — lasting a very short time
+2 occurrence(s) of "ephemeral"
+`,
+		},
+		{
+			name: "limit and notebook filter",
+			args: []string{"candid", "--notebook", "drama", "--limit", "1"},
+			want: `Office Drama › Episode 1 › Kitchen
+  Bob was candid about it.
+1 occurrence(s) of "candid"
+`,
+		},
+		{
+			name: "limit below the total",
+			args: []string{"ephemeral", "--limit", "1"},
+			want: `Office Drama › Episode 1 › Kitchen
+  Ann: Fame is ephemeral.
+Showing 1 of 2 occurrences of "ephemeral"
+`,
+		},
+		{
+			name: "no occurrences",
+			args: []string{"ran", "into"},
+			want: "No occurrences of \"ran into\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			cmd := newSearchCommand()
+			cmd.SetOut(&out)
+			cmd.SetArgs(tt.args)
+			require.NoError(t, cmd.Execute())
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+	assert.FileExists(t, indexPath)
+}
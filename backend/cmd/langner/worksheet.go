@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/pdf"
+	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/versioning"
+	"github.com/at-ishikawa/langner/internal/worksheet"
+)
+
+func newWorksheetCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "worksheet",
+		Short: "Print spaced-repetition reviews on paper and record the results",
+	}
+	command.AddCommand(newWorksheetGenerateCommand(), newWorksheetGradeCommand())
+	return command
+}
+
+func newWorksheetGenerateCommand() *cobra.Command {
+	var days, limit int
+	var notebookIDs []string
+	var generatePDF bool
+
+	command := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a worksheet and answer key of the words due in the next days",
+		Long: `Generate a printable worksheet of every studied word due for review within
+the next --days days: cloze sentences for the recognition track, meanings
+to write the word for in the reverse track, and origins to match for the
+etymology track. The questions and the answer key are written as separate
+markdown files (and PDFs with --pdf) under outputs.worksheet_directory,
+together with <sheet-id>.yml, which "langner worksheet grade" reads back.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if days < 0 {
+				return fmt.Errorf("--days must not be negative")
+			}
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			svc, err := newWorksheetService(cfg)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			dueBy := now.AddDate(0, 0, days)
+			items, err := svc.LoadWorksheetItems(dueBy, notebookIDs, limit)
+			if err != nil {
+				return fmt.Errorf("svc.LoadWorksheetItems() > %w", err)
+			}
+			if len(items) == 0 {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "No words are due by %s — nothing to write.\n", dueBy.Format("2006-01-02"))
+				return nil
+			}
+
+			store := worksheet.NewStore(cfg.Outputs.WorksheetDirectory)
+			store.SetPDFOptions(pdf.Options{Fonts: cfg.PDF})
+			id, err := store.NextID(now)
+			if err != nil {
+				return err
+			}
+			sheet := worksheet.NewSheet(id, now, dueBy, items)
+			written, err := store.Output(sheet, generatePDF)
+			for _, path := range written {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s\n", path)
+			}
+			if err != nil {
+				return fmt.Errorf("store.Output(%s) > %w", id, err)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Record the results with: langner worksheet grade %s\n", id)
+			return nil
+		},
+	}
+	command.Flags().IntVar(&days, "days", 7, "Include words due within this many days")
+	command.Flags().IntVar(&limit, "limit", 0, "Maximum number of questions, most overdue first (0 for no limit)")
+	command.Flags().StringSliceVarP(&notebookIDs, "notebook", "n", nil, "Only include words from these notebooks (empty for all notebooks)")
+	command.Flags().BoolVar(&generatePDF, "pdf", false, "Generate PDF output in addition to markdown")
+	return command
+}
+
+func newWorksheetGradeCommand() *cobra.Command {
+	var wrong, skipped []int
+
+	command := &cobra.Command{
+		Use:   "grade <sheet-id>",
+		Short: "Record the results of a worksheet answered on paper",
+		Long: `Record the results of a worksheet in the learning history, as if each
+question had been answered in the quiz of its track. Pass the numbers of
+the questions answered wrong with --wrong and of those left blank with
+--skip; every other question is recorded as correct. Without either flag,
+each question is asked in turn. If recording stops part-way, run grade
+again without the flags to record the remaining results.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			store := worksheet.NewStore(cfg.Outputs.WorksheetDirectory)
+			sheet, err := store.Load(args[0])
+			if err != nil {
+				return err
+			}
+			// The results are saved before any is recorded and each item is
+			// marked once recorded, so a run that fails part-way is resumed
+			// by grading the sheet again, without asking for the results or
+			// recording any of them twice.
+			if sheet.GradedAt == nil {
+				if !cmd.Flags().Changed("wrong") && !cmd.Flags().Changed("skip") {
+					wrong, skipped, err = promptWorksheetResults(cmd.InOrStdin(), cmd.OutOrStdout(), sheet)
+					if err != nil {
+						return err
+					}
+				}
+				if err := sheet.Grade(wrong, skipped, time.Now()); err != nil {
+					return err
+				}
+				if err := store.Save(sheet); err != nil {
+					return err
+				}
+			} else if cmd.Flags().Changed("wrong") || cmd.Flags().Changed("skip") {
+				return fmt.Errorf("worksheet %s was already graded on %s; run grade without --wrong and --skip to record its saved results", sheet.ID, sheet.GradedAt.Format("2006-01-02"))
+			} else if len(sheet.Unrecorded()) == 0 {
+				return fmt.Errorf("worksheet %s was already graded on %s", sheet.ID, sheet.GradedAt.Format("2006-01-02"))
+			} else {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Resuming worksheet %s graded on %s.\n", sheet.ID, sheet.GradedAt.Format("2006-01-02"))
+			}
+
+			svc, err := newWorksheetService(cfg)
+			if err != nil {
+				return err
+			}
+			ctx := context.Background()
+			for _, item := range sheet.Unrecorded() {
+				if err := svc.SaveWorksheetResult(ctx, item.QuizItem(), item.Result == worksheet.ResultCorrect); err != nil {
+					return fmt.Errorf("record question %d (%s): %w", item.Number, item.Expression, err)
+				}
+				item.Recorded = true
+				if err := store.Save(sheet); err != nil {
+					return err
+				}
+			}
+			counts := make(map[worksheet.Result]int)
+			for _, item := range sheet.Items {
+				counts[item.Result]++
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Recorded worksheet %s: %d correct, %d wrong, %d skipped.\n",
+				sheet.ID, counts[worksheet.ResultCorrect], counts[worksheet.ResultWrong], counts[worksheet.ResultSkipped])
+			return nil
+		},
+	}
+	command.Flags().IntSliceVar(&wrong, "wrong", nil, "Numbers of the questions answered wrong, e.g. 3,7")
+	command.Flags().IntSliceVar(&skipped, "skip", nil, "Numbers of the questions left unanswered; nothing is recorded for them")
+	return command
+}
+
+// newWorksheetService returns a quiz service for reading due words and
+// recording results. Worksheets are graded by hand, so no inference client
+// is needed.
+func newWorksheetService(cfg *config.Config) (*quiz.Service, error) {
+	recorder, err := versioning.NewRecorder(cfg.Versioning)
+	if err != nil {
+		return nil, err
+	}
+	calculator := notebook.NewIntervalCalculator(cfg.Quiz.Algorithm, cfg.Quiz.FixedIntervals)
+	svc := quiz.NewService(cfg.Notebooks, nil, nil, learning.NewYAMLLearningRepository(cfg.Notebooks.LearningNotesDirectory, calculator), cfg.Quiz)
+	svc.SetRecorder(recorder)
+	return svc, nil
+}
+
+// promptWorksheetResults asks for the result of each question of sheet:
+// y (or an empty line) for correct, n for wrong and s for skipped.
+func promptWorksheetResults(in io.Reader, out io.Writer, sheet worksheet.Sheet) (wrong, skipped []int, err error) {
+	scanner := bufio.NewScanner(in)
+	for _, item := range sheet.Items {
+		for {
+			_, _ = fmt.Fprintf(out, "%d. %s (%s) correct? [Y/n/s]: ", item.Number, item.Expression, item.Section)
+			if !scanner.Scan() {
+				if err := scanner.Err(); err != nil {
+					return nil, nil, fmt.Errorf("read answer: %w", err)
+				}
+				return nil, nil, fmt.Errorf("no result entered for question %d", item.Number)
+			}
+			answer := strings.ToLower(strings.TrimSpace(scanner.Text()))
+			switch answer {
+			case "", "y", "yes":
+			case "n", "no":
+				wrong = append(wrong, item.Number)
+			case "s", "skip":
+				skipped = append(skipped, item.Number)
+			default:
+				_, _ = fmt.Fprintln(out, "Please answer y, n or s.")
+				continue
+			}
+			break
+		}
+	}
+	return wrong, skipped, nil
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/testutil"
+	"github.com/at-ishikawa/langner/internal/worksheet"
+)
+
+// setupWorksheetConfig writes a config with one flashcard notebook whose
+// "ephemeral" is due for recognition and "candid" for reverse, and returns
+// the worksheet and learning notes directories.
+func setupWorksheetConfig(t *testing.T) (worksheetDir, learningDir string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	cfgPath := testutil.SetupTestConfig(t, tmpDir)
+	worksheetDir = filepath.Join(tmpDir, "worksheet")
+	learningDir = filepath.Join(tmpDir, "learning_notes")
+
+	content, err := os.ReadFile(cfgPath)
+	require.NoError(t, err)
+	content = []byte(strings.Replace(string(content), "outputs:\n", "outputs:\n  worksheet_directory: "+worksheetDir+"\n", 1))
+	require.NoError(t, os.WriteFile(cfgPath, content, 0644))
+	setConfigFile(t, cfgPath)
+
+	notebookDir := filepath.Join(tmpDir, "flashcards", "vocab")
+	require.NoError(t, os.MkdirAll(notebookDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "index.yml"), []byte(
+		"id: vocab\nname: Vocabulary\nnotebooks:\n  - ./cards.yml\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "cards.yml"), []byte(`- title: "Flashcards"
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: ephemeral
+      meaning: lasting a very short time
+      examples:
+        - Fame is ephemeral.
+    - expression: candid
+      meaning: truthful and straightforward
+`), 0644))
+
+	learnedAt := time.Now().AddDate(0, 0, -3).Format(time.RFC3339)
+	require.NoError(t, os.WriteFile(filepath.Join(learningDir, "vocab.yml"), []byte(`- metadata:
+    id: vocab
+    title: flashcards
+    type: flashcard
+  expressions:
+    - expression: ephemeral
+      learned_logs:
+        - status: understood
+          learned_at: "`+learnedAt+`"
+          interval_days: 5
+    - expression: candid
+      reverse_logs:
+        - status: understood
+          learned_at: "`+learnedAt+`"
+          interval_days: 30
+`), 0644))
+	return worksheetDir, learningDir
+}
+
+func TestNewWorksheetCommand_GenerateAndGrade(t *testing.T) {
+	worksheetDir, learningDir := setupWorksheetConfig(t)
+	id := "worksheet-" + time.Now().Format("2006-01-02")
+
+	var out bytes.Buffer
+	cmd := newWorksheetCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"generate", "--days", "7"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "Record the results with: langner worksheet grade "+id)
+
+	questions, err := os.ReadFile(filepath.Join(worksheetDir, id+".md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(questions), `1. Fame is \_\_\_\_\_\_.`)
+	assert.NotContains(t, string(questions), "candid", "candid isn't due within the week")
+	answers, err := os.ReadFile(filepath.Join(worksheetDir, id+"-answers.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(answers), "1. **ephemeral**: lasting a very short time")
+
+	out.Reset()
+	cmd = newWorksheetCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"grade", id, "--wrong", "1"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Recorded worksheet "+id+": 0 correct, 1 wrong, 0 skipped.\n", out.String())
+
+	histories, err := notebook.NewLearningHistories(learningDir)
+	require.NoError(t, err)
+	expr := notebook.FindExpressionInHistories(histories["vocab"], "", "ephemeral")
+	require.NotNil(t, expr)
+	require.Len(t, expr.LearnedLogs, 2)
+	assert.Equal(t, notebook.LearnedStatusMisunderstood, expr.LearnedLogs[0].Status)
+
+	sheet, err := worksheet.NewStore(worksheetDir).Load(id)
+	require.NoError(t, err)
+	require.NotNil(t, sheet.GradedAt)
+	assert.Equal(t, worksheet.ResultWrong, sheet.Items[0].Result)
+
+	cmd = newWorksheetCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"grade", id})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "was already graded")
+}
+
+func TestNewWorksheetCommand_Grade_Resumes(t *testing.T) {
+	worksheetDir, learningDir := setupWorksheetConfig(t)
+	id := "worksheet-" + time.Now().Format("2006-01-02")
+
+	cmd := newWorksheetCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetArgs([]string{"generate", "--days", "7"})
+	require.NoError(t, cmd.Execute())
+
+	// A run that saved the results and then stopped before recording any.
+	store := worksheet.NewStore(worksheetDir)
+	sheet, err := store.Load(id)
+	require.NoError(t, err)
+	require.NoError(t, sheet.Grade(nil, nil, time.Now()))
+	require.NoError(t, store.Save(sheet))
+
+	cmd = newWorksheetCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetArgs([]string{"grade", id, "--wrong", "1"})
+	require.ErrorContains(t, cmd.Execute(), "run grade without --wrong and --skip", "the saved results can't be changed")
+
+	var out bytes.Buffer
+	cmd = newWorksheetCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"grade", id})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "Resuming worksheet "+id)
+	assert.Contains(t, out.String(), "1 correct, 0 wrong")
+
+	cmd = newWorksheetCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetArgs([]string{"grade", id})
+	require.ErrorContains(t, cmd.Execute(), "was already graded")
+
+	histories, err := notebook.NewLearningHistories(learningDir)
+	require.NoError(t, err)
+	expr := notebook.FindExpressionInHistories(histories["vocab"], "", "ephemeral")
+	require.NotNil(t, expr)
+	assert.Len(t, expr.LearnedLogs, 2, "the result is recorded once")
+}
+
+func TestNewWorksheetCommand_Generate_NothingDue(t *testing.T) {
+	worksheetDir, _ := setupWorksheetConfig(t)
+
+	var out bytes.Buffer
+	cmd := newWorksheetCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"generate", "--days", "0"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "nothing to write")
+	_, err := os.Stat(worksheetDir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestNewWorksheetCommand_Grade_NotFound(t *testing.T) {
+	setupWorksheetConfig(t)
+
+	cmd := newWorksheetCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetArgs([]string{"grade", "worksheet-2000-01-01", "--wrong", "1"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "worksheet worksheet-2000-01-01 not found")
+}
+
+func TestPromptWorksheetResults(t *testing.T) {
+	sheet := worksheet.NewSheet("worksheet-2026-03-01", time.Now(), time.Now(), []quiz.WorksheetItem{
+		{QuizType: notebook.QuizTypeNotebook, Card: quiz.FreeformCard{Expression: "ephemeral"}},
+		{QuizType: notebook.QuizTypeNotebook, Card: quiz.FreeformCard{Expression: "resilient"}},
+		{QuizType: notebook.QuizTypeReverse, Card: quiz.FreeformCard{Expression: "candid"}},
+		{QuizType: notebook.QuizTypeReverse, Card: quiz.FreeformCard{Expression: "benevolent"}},
+	})
+
+	tests := []struct {
+		name        string
+		input       string
+		wantWrong   []int
+		wantSkipped []int
+		wantErr     string
+	}{
+		{
+			name:  "all correct",
+			input: "y\n\nyes\nY\n",
+		},
+		{
+			name:        "wrong and skipped",
+			input:       "n\ns\ny\nno\n",
+			wantWrong:   []int{1, 4},
+			wantSkipped: []int{2},
+		},
+		{
+			name:      "asks again on an unknown answer",
+			input:     "maybe\nn\ny\ny\ny\n",
+			wantWrong: []int{1},
+		},
+		{
+			name:    "input ends early",
+			input:   "y\n",
+			wantErr: "no result entered for question 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			wrong, skipped, err := promptWorksheetResults(strings.NewReader(tt.input), &out, sheet)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantWrong, wrong)
+			assert.Equal(t, tt.wantSkipped, skipped)
+			assert.Contains(t, out.String(), "1. ephemeral (meaning) correct? [Y/n/s]: ")
+		})
+	}
+}
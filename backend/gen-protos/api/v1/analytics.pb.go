@@ -1426,8 +1426,14 @@ type AttemptEntry struct {
 	// this was the very first attempt.
 	StreakBeforeWrong   int32 `protobuf:"varint,5,opt,name=streak_before_wrong,json=streakBeforeWrong,proto3" json:"streak_before_wrong,omitempty"`
 	StreakBeforeCorrect int32 `protobuf:"varint,6,opt,name=streak_before_correct,json=streakBeforeCorrect,proto3" json:"streak_before_correct,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// answer is what the learner answered, and grader_reason why grader
+	// ("model", "deterministic" or "override") accepted or rejected it.
+	// All three are empty for attempts recorded before answers were kept.
+	Answer        string `protobuf:"bytes,7,opt,name=answer,proto3" json:"answer,omitempty"`
+	GraderReason  string `protobuf:"bytes,8,opt,name=grader_reason,json=graderReason,proto3" json:"grader_reason,omitempty"`
+	Grader        string `protobuf:"bytes,9,opt,name=grader,proto3" json:"grader,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AttemptEntry) Reset() {
@@ -1502,6 +1508,688 @@ func (x *AttemptEntry) GetStreakBeforeCorrect() int32 {
 	return 0
 }
 
+func (x *AttemptEntry) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *AttemptEntry) GetGraderReason() string {
+	if x != nil {
+		return x.GraderReason
+	}
+	return ""
+}
+
+func (x *AttemptEntry) GetGrader() string {
+	if x != nil {
+		return x.Grader
+	}
+	return ""
+}
+
+type GetLeechesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Filters *AnalyticsFilters      `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
+	// lapses / wrong_streak override the configured leech threshold
+	// (quiz.leech) when non-zero.
+	Lapses        int32 `protobuf:"varint,2,opt,name=lapses,proto3" json:"lapses,omitempty"`
+	WrongStreak   int32 `protobuf:"varint,3,opt,name=wrong_streak,json=wrongStreak,proto3" json:"wrong_streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeechesRequest) Reset() {
+	*x = GetLeechesRequest{}
+	mi := &file_api_v1_analytics_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeechesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeechesRequest) ProtoMessage() {}
+
+func (x *GetLeechesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeechesRequest.ProtoReflect.Descriptor instead.
+func (*GetLeechesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetLeechesRequest) GetFilters() *AnalyticsFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetLeechesRequest) GetLapses() int32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+func (x *GetLeechesRequest) GetWrongStreak() int32 {
+	if x != nil {
+		return x.WrongStreak
+	}
+	return 0
+}
+
+type GetLeechesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leeches       []*LeechEntry          `protobuf:"bytes,1,rep,name=leeches,proto3" json:"leeches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeechesResponse) Reset() {
+	*x = GetLeechesResponse{}
+	mi := &file_api_v1_analytics_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeechesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeechesResponse) ProtoMessage() {}
+
+func (x *GetLeechesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeechesResponse.ProtoReflect.Descriptor instead.
+func (*GetLeechesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetLeechesResponse) GetLeeches() []*LeechEntry {
+	if x != nil {
+		return x.Leeches
+	}
+	return nil
+}
+
+// LeechEntry is one word × quiz type series that counts as a leech.
+type LeechEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenseId       string                 `protobuf:"bytes,1,opt,name=sense_id,json=senseId,proto3" json:"sense_id,omitempty"`
+	Expression    string                 `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	NotebookId    string                 `protobuf:"bytes,3,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	NotebookTitle string                 `protobuf:"bytes,4,opt,name=notebook_title,json=notebookTitle,proto3" json:"notebook_title,omitempty"`
+	SceneTitle    string                 `protobuf:"bytes,5,opt,name=scene_title,json=sceneTitle,proto3" json:"scene_title,omitempty"`
+	QuizType      string                 `protobuf:"bytes,6,opt,name=quiz_type,json=quizType,proto3" json:"quiz_type,omitempty"`
+	// lapses counts the times a correct answer was followed by a wrong one.
+	Lapses             int32 `protobuf:"varint,7,opt,name=lapses,proto3" json:"lapses,omitempty"`
+	CurrentWrongStreak int32 `protobuf:"varint,8,opt,name=current_wrong_streak,json=currentWrongStreak,proto3" json:"current_wrong_streak,omitempty"`
+	Attempts           int32 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last_attempt_date in YYYY-MM-DD format.
+	LastAttemptDate string `protobuf:"bytes,10,opt,name=last_attempt_date,json=lastAttemptDate,proto3" json:"last_attempt_date,omitempty"`
+	// skipped / in_relearn_pool report the leech action already applied:
+	// excluded from the quiz type, or moved into the relearn pool.
+	Skipped       bool `protobuf:"varint,11,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	InRelearnPool bool `protobuf:"varint,12,opt,name=in_relearn_pool,json=inRelearnPool,proto3" json:"in_relearn_pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeechEntry) Reset() {
+	*x = LeechEntry{}
+	mi := &file_api_v1_analytics_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeechEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeechEntry) ProtoMessage() {}
+
+func (x *LeechEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeechEntry.ProtoReflect.Descriptor instead.
+func (*LeechEntry) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LeechEntry) GetSenseId() string {
+	if x != nil {
+		return x.SenseId
+	}
+	return ""
+}
+
+func (x *LeechEntry) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *LeechEntry) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
+func (x *LeechEntry) GetNotebookTitle() string {
+	if x != nil {
+		return x.NotebookTitle
+	}
+	return ""
+}
+
+func (x *LeechEntry) GetSceneTitle() string {
+	if x != nil {
+		return x.SceneTitle
+	}
+	return ""
+}
+
+func (x *LeechEntry) GetQuizType() string {
+	if x != nil {
+		return x.QuizType
+	}
+	return ""
+}
+
+func (x *LeechEntry) GetLapses() int32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+func (x *LeechEntry) GetCurrentWrongStreak() int32 {
+	if x != nil {
+		return x.CurrentWrongStreak
+	}
+	return 0
+}
+
+func (x *LeechEntry) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *LeechEntry) GetLastAttemptDate() string {
+	if x != nil {
+		return x.LastAttemptDate
+	}
+	return ""
+}
+
+func (x *LeechEntry) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *LeechEntry) GetInRelearnPool() bool {
+	if x != nil {
+		return x.InRelearnPool
+	}
+	return false
+}
+
+type GetConfusionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Filters *AnalyticsFilters      `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
+	// unresolved_only drops the pairs whose words have all been answered
+	// correctly since they were last confused.
+	UnresolvedOnly bool `protobuf:"varint,2,opt,name=unresolved_only,json=unresolvedOnly,proto3" json:"unresolved_only,omitempty"`
+	// limit caps the number of pairs; 0 returns all of them.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfusionsRequest) Reset() {
+	*x = GetConfusionsRequest{}
+	mi := &file_api_v1_analytics_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfusionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfusionsRequest) ProtoMessage() {}
+
+func (x *GetConfusionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfusionsRequest.ProtoReflect.Descriptor instead.
+func (*GetConfusionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetConfusionsRequest) GetFilters() *AnalyticsFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetConfusionsRequest) GetUnresolvedOnly() bool {
+	if x != nil {
+		return x.UnresolvedOnly
+	}
+	return false
+}
+
+func (x *GetConfusionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetConfusionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairs         []*ConfusionPair       `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfusionsResponse) Reset() {
+	*x = GetConfusionsResponse{}
+	mi := &file_api_v1_analytics_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfusionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfusionsResponse) ProtoMessage() {}
+
+func (x *GetConfusionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfusionsResponse.ProtoReflect.Descriptor instead.
+func (*GetConfusionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetConfusionsResponse) GetPairs() []*ConfusionPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+// ConfusionPair is two words of one notebook the learner mixes up.
+type ConfusionPair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NotebookId    string                 `protobuf:"bytes,1,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	NotebookTitle string                 `protobuf:"bytes,2,opt,name=notebook_title,json=notebookTitle,proto3" json:"notebook_title,omitempty"`
+	// words are the two words, ordered by expression.
+	Words []*ConfusionWord `protobuf:"bytes,3,rep,name=words,proto3" json:"words,omitempty"`
+	// count is the number of wrong answers that matched the other word.
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// last_confused_date in YYYY-MM-DD format.
+	LastConfusedDate string   `protobuf:"bytes,5,opt,name=last_confused_date,json=lastConfusedDate,proto3" json:"last_confused_date,omitempty"`
+	QuizTypes        []string `protobuf:"bytes,6,rep,name=quiz_types,json=quizTypes,proto3" json:"quiz_types,omitempty"`
+	// answers are sample wrong answers that matched, newest first.
+	Answers []string `protobuf:"bytes,7,rep,name=answers,proto3" json:"answers,omitempty"`
+	// resolved is true once both words have been answered correctly since
+	// they were last confused.
+	Resolved      bool `protobuf:"varint,8,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfusionPair) Reset() {
+	*x = ConfusionPair{}
+	mi := &file_api_v1_analytics_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfusionPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfusionPair) ProtoMessage() {}
+
+func (x *ConfusionPair) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfusionPair.ProtoReflect.Descriptor instead.
+func (*ConfusionPair) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ConfusionPair) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
+func (x *ConfusionPair) GetNotebookTitle() string {
+	if x != nil {
+		return x.NotebookTitle
+	}
+	return ""
+}
+
+func (x *ConfusionPair) GetWords() []*ConfusionWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *ConfusionPair) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ConfusionPair) GetLastConfusedDate() string {
+	if x != nil {
+		return x.LastConfusedDate
+	}
+	return ""
+}
+
+func (x *ConfusionPair) GetQuizTypes() []string {
+	if x != nil {
+		return x.QuizTypes
+	}
+	return nil
+}
+
+func (x *ConfusionPair) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *ConfusionPair) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+type ConfusionWord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenseId       string                 `protobuf:"bytes,1,opt,name=sense_id,json=senseId,proto3" json:"sense_id,omitempty"`
+	Expression    string                 `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	Meaning       string                 `protobuf:"bytes,3,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	Example       string                 `protobuf:"bytes,4,opt,name=example,proto3" json:"example,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfusionWord) Reset() {
+	*x = ConfusionWord{}
+	mi := &file_api_v1_analytics_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfusionWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfusionWord) ProtoMessage() {}
+
+func (x *ConfusionWord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfusionWord.ProtoReflect.Descriptor instead.
+func (*ConfusionWord) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConfusionWord) GetSenseId() string {
+	if x != nil {
+		return x.SenseId
+	}
+	return ""
+}
+
+func (x *ConfusionWord) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *ConfusionWord) GetMeaning() string {
+	if x != nil {
+		return x.Meaning
+	}
+	return ""
+}
+
+func (x *ConfusionWord) GetExample() string {
+	if x != nil {
+		return x.Example
+	}
+	return ""
+}
+
+type GetFrequencyCoverageRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Filters *AnalyticsFilters      `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
+	// top_n is how many of the most frequent words to cover, 1000 when 0.
+	TopN int32 `protobuf:"varint,2,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// missing_limit caps missing_words; 0 lists none.
+	MissingLimit  int32 `protobuf:"varint,3,opt,name=missing_limit,json=missingLimit,proto3" json:"missing_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFrequencyCoverageRequest) Reset() {
+	*x = GetFrequencyCoverageRequest{}
+	mi := &file_api_v1_analytics_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrequencyCoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrequencyCoverageRequest) ProtoMessage() {}
+
+func (x *GetFrequencyCoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrequencyCoverageRequest.ProtoReflect.Descriptor instead.
+func (*GetFrequencyCoverageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetFrequencyCoverageRequest) GetFilters() *AnalyticsFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetFrequencyCoverageRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *GetFrequencyCoverageRequest) GetMissingLimit() int32 {
+	if x != nil {
+		return x.MissingLimit
+	}
+	return 0
+}
+
+type GetFrequencyCoverageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// top_n is the number of words covered, fewer than requested when the
+	// list is shorter.
+	TopN int32 `protobuf:"varint,1,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// studied counts the words answered at least once in any quiz type, and
+	// known the studied words whose latest answer was correct. An answer
+	// counts for every inflection of its word.
+	Studied int32 `protobuf:"varint,2,opt,name=studied,proto3" json:"studied,omitempty"`
+	Known   int32 `protobuf:"varint,3,opt,name=known,proto3" json:"known,omitempty"`
+	// missing_words lists the words never studied, most frequent first.
+	MissingWords  []string `protobuf:"bytes,4,rep,name=missing_words,json=missingWords,proto3" json:"missing_words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFrequencyCoverageResponse) Reset() {
+	*x = GetFrequencyCoverageResponse{}
+	mi := &file_api_v1_analytics_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrequencyCoverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrequencyCoverageResponse) ProtoMessage() {}
+
+func (x *GetFrequencyCoverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_analytics_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrequencyCoverageResponse.ProtoReflect.Descriptor instead.
+func (*GetFrequencyCoverageResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_analytics_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetFrequencyCoverageResponse) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *GetFrequencyCoverageResponse) GetStudied() int32 {
+	if x != nil {
+		return x.Studied
+	}
+	return 0
+}
+
+func (x *GetFrequencyCoverageResponse) GetKnown() int32 {
+	if x != nil {
+		return x.Known
+	}
+	return 0
+}
+
+func (x *GetFrequencyCoverageResponse) GetMissingWords() []string {
+	if x != nil {
+		return x.MissingWords
+	}
+	return nil
+}
+
 var File_api_v1_analytics_proto protoreflect.FileDescriptor
 
 const file_api_v1_analytics_proto_rawDesc = "" +
@@ -1615,14 +2303,75 @@ const file_api_v1_analytics_proto_rawDesc = "" +
 	"\x0ecurrent_status\x18\x04 \x01(\tR\rcurrentStatus\x120\n" +
 	"\x14current_wrong_streak\x18\x05 \x01(\x05R\x12currentWrongStreak\x120\n" +
 	"\battempts\x18\x06 \x03(\v2\x14.api.v1.AttemptEntryR\battempts\x12\x19\n" +
-	"\bsense_id\x18\a \x01(\tR\asenseId\"\xd5\x01\n" +
+	"\bsense_id\x18\a \x01(\tR\asenseId\"\xaa\x02\n" +
 	"\fAttemptEntry\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1b\n" +
 	"\tquiz_type\x18\x02 \x01(\tR\bquizType\x12\x16\n" +
 	"\x06result\x18\x03 \x01(\tR\x06result\x12\x18\n" +
 	"\aquality\x18\x04 \x01(\x05R\aquality\x12.\n" +
 	"\x13streak_before_wrong\x18\x05 \x01(\x05R\x11streakBeforeWrong\x122\n" +
-	"\x15streak_before_correct\x18\x06 \x01(\x05R\x13streakBeforeCorrect*\x82\x01\n" +
+	"\x15streak_before_correct\x18\x06 \x01(\x05R\x13streakBeforeCorrect\x12\x16\n" +
+	"\x06answer\x18\a \x01(\tR\x06answer\x12#\n" +
+	"\rgrader_reason\x18\b \x01(\tR\fgraderReason\x12\x16\n" +
+	"\x06grader\x18\t \x01(\tR\x06grader\"\x94\x01\n" +
+	"\x11GetLeechesRequest\x122\n" +
+	"\afilters\x18\x01 \x01(\v2\x18.api.v1.AnalyticsFiltersR\afilters\x12\x1f\n" +
+	"\x06lapses\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06lapses\x12*\n" +
+	"\fwrong_streak\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\vwrongStreak\"B\n" +
+	"\x12GetLeechesResponse\x12,\n" +
+	"\aleeches\x18\x01 \x03(\v2\x12.api.v1.LeechEntryR\aleeches\"\xa1\x03\n" +
+	"\n" +
+	"LeechEntry\x12\x19\n" +
+	"\bsense_id\x18\x01 \x01(\tR\asenseId\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\x12\x1f\n" +
+	"\vnotebook_id\x18\x03 \x01(\tR\n" +
+	"notebookId\x12%\n" +
+	"\x0enotebook_title\x18\x04 \x01(\tR\rnotebookTitle\x12\x1f\n" +
+	"\vscene_title\x18\x05 \x01(\tR\n" +
+	"sceneTitle\x12\x1b\n" +
+	"\tquiz_type\x18\x06 \x01(\tR\bquizType\x12\x16\n" +
+	"\x06lapses\x18\a \x01(\x05R\x06lapses\x120\n" +
+	"\x14current_wrong_streak\x18\b \x01(\x05R\x12currentWrongStreak\x12\x1a\n" +
+	"\battempts\x18\t \x01(\x05R\battempts\x12*\n" +
+	"\x11last_attempt_date\x18\n" +
+	" \x01(\tR\x0flastAttemptDate\x12\x18\n" +
+	"\askipped\x18\v \x01(\bR\askipped\x12&\n" +
+	"\x0fin_relearn_pool\x18\f \x01(\bR\rinRelearnPool\"\x92\x01\n" +
+	"\x14GetConfusionsRequest\x122\n" +
+	"\afilters\x18\x01 \x01(\v2\x18.api.v1.AnalyticsFiltersR\afilters\x12'\n" +
+	"\x0funresolved_only\x18\x02 \x01(\bR\x0eunresolvedOnly\x12\x1d\n" +
+	"\x05limit\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05limit\"D\n" +
+	"\x15GetConfusionsResponse\x12+\n" +
+	"\x05pairs\x18\x01 \x03(\v2\x15.api.v1.ConfusionPairR\x05pairs\"\x9d\x02\n" +
+	"\rConfusionPair\x12\x1f\n" +
+	"\vnotebook_id\x18\x01 \x01(\tR\n" +
+	"notebookId\x12%\n" +
+	"\x0enotebook_title\x18\x02 \x01(\tR\rnotebookTitle\x12+\n" +
+	"\x05words\x18\x03 \x03(\v2\x15.api.v1.ConfusionWordR\x05words\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12,\n" +
+	"\x12last_confused_date\x18\x05 \x01(\tR\x10lastConfusedDate\x12\x1d\n" +
+	"\n" +
+	"quiz_types\x18\x06 \x03(\tR\tquizTypes\x12\x18\n" +
+	"\aanswers\x18\a \x03(\tR\aanswers\x12\x1a\n" +
+	"\bresolved\x18\b \x01(\bR\bresolved\"~\n" +
+	"\rConfusionWord\x12\x19\n" +
+	"\bsense_id\x18\x01 \x01(\tR\asenseId\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\x12\x18\n" +
+	"\ameaning\x18\x03 \x01(\tR\ameaning\x12\x18\n" +
+	"\aexample\x18\x04 \x01(\tR\aexample\"\x9d\x01\n" +
+	"\x1bGetFrequencyCoverageRequest\x122\n" +
+	"\afilters\x18\x01 \x01(\v2\x18.api.v1.AnalyticsFiltersR\afilters\x12\x1c\n" +
+	"\x05top_n\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x04topN\x12,\n" +
+	"\rmissing_limit\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\fmissingLimit\"\x88\x01\n" +
+	"\x1cGetFrequencyCoverageResponse\x12\x13\n" +
+	"\x05top_n\x18\x01 \x01(\x05R\x04topN\x12\x18\n" +
+	"\astudied\x18\x02 \x01(\x05R\astudied\x12\x14\n" +
+	"\x05known\x18\x03 \x01(\x05R\x05known\x12#\n" +
+	"\rmissing_words\x18\x04 \x03(\tR\fmissingWords*\x82\x01\n" +
 	"\vGranularity\x12\x1b\n" +
 	"\x17GRANULARITY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fGRANULARITY_DAY\x10\x01\x12\x14\n" +
@@ -1634,12 +2383,16 @@ const file_api_v1_analytics_proto_rawDesc = "" +
 	"\x18TREND_GROUP_BY_QUIZ_TYPE\x10\x01\x12\x1b\n" +
 	"\x17TREND_GROUP_BY_NOTEBOOK\x10\x02\x12\x19\n" +
 	"\x15TREND_GROUP_BY_STATUS\x10\x03\x12\x18\n" +
-	"\x14TREND_GROUP_BY_LEVEL\x10\x042\xca\x02\n" +
+	"\x14TREND_GROUP_BY_LEVEL\x10\x042\xc0\x04\n" +
 	"\x10AnalyticsService\x12X\n" +
 	"\x11GetDailySummaries\x12 .api.v1.GetDailySummariesRequest\x1a!.api.v1.GetDailySummariesResponse\x12I\n" +
 	"\fGetDayDetail\x12\x1b.api.v1.GetDayDetailRequest\x1a\x1c.api.v1.GetDayDetailResponse\x12O\n" +
 	"\x0eGetWordHistory\x12\x1d.api.v1.GetWordHistoryRequest\x1a\x1e.api.v1.GetWordHistoryResponse\x12@\n" +
-	"\tGetTrends\x12\x18.api.v1.GetTrendsRequest\x1a\x19.api.v1.GetTrendsResponseB8Z6github.com/at-ishikawa/langner/gen-protos/api/v1;apiv1b\x06proto3"
+	"\tGetTrends\x12\x18.api.v1.GetTrendsRequest\x1a\x19.api.v1.GetTrendsResponse\x12C\n" +
+	"\n" +
+	"GetLeeches\x12\x19.api.v1.GetLeechesRequest\x1a\x1a.api.v1.GetLeechesResponse\x12L\n" +
+	"\rGetConfusions\x12\x1c.api.v1.GetConfusionsRequest\x1a\x1d.api.v1.GetConfusionsResponse\x12a\n" +
+	"\x14GetFrequencyCoverage\x12#.api.v1.GetFrequencyCoverageRequest\x1a$.api.v1.GetFrequencyCoverageResponseB8Z6github.com/at-ishikawa/langner/gen-protos/api/v1;apiv1b\x06proto3"
 
 var (
 	file_api_v1_analytics_proto_rawDescOnce sync.Once
@@ -1654,27 +2407,36 @@ func file_api_v1_analytics_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_api_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_api_v1_analytics_proto_goTypes = []any{
-	(Granularity)(0),                  // 0: api.v1.Granularity
-	(TrendGroupBy)(0),                 // 1: api.v1.TrendGroupBy
-	(*GetTrendsRequest)(nil),          // 2: api.v1.GetTrendsRequest
-	(*GetTrendsResponse)(nil),         // 3: api.v1.GetTrendsResponse
-	(*TrendBucket)(nil),               // 4: api.v1.TrendBucket
-	(*TrendSeries)(nil),               // 5: api.v1.TrendSeries
-	(*TrendsSummary)(nil),             // 6: api.v1.TrendsSummary
-	(*BacklogSnapshot)(nil),           // 7: api.v1.BacklogSnapshot
-	(*AnalyticsFilters)(nil),          // 8: api.v1.AnalyticsFilters
-	(*GetDailySummariesRequest)(nil),  // 9: api.v1.GetDailySummariesRequest
-	(*GetDailySummariesResponse)(nil), // 10: api.v1.GetDailySummariesResponse
-	(*DailySummary)(nil),              // 11: api.v1.DailySummary
-	(*GetDayDetailRequest)(nil),       // 12: api.v1.GetDayDetailRequest
-	(*GetDayDetailResponse)(nil),      // 13: api.v1.GetDayDetailResponse
-	(*WrongWord)(nil),                 // 14: api.v1.WrongWord
-	(*RelatedGroup)(nil),              // 15: api.v1.RelatedGroup
-	(*GetWordHistoryRequest)(nil),     // 16: api.v1.GetWordHistoryRequest
-	(*GetWordHistoryResponse)(nil),    // 17: api.v1.GetWordHistoryResponse
-	(*AttemptEntry)(nil),              // 18: api.v1.AttemptEntry
+	(Granularity)(0),                     // 0: api.v1.Granularity
+	(TrendGroupBy)(0),                    // 1: api.v1.TrendGroupBy
+	(*GetTrendsRequest)(nil),             // 2: api.v1.GetTrendsRequest
+	(*GetTrendsResponse)(nil),            // 3: api.v1.GetTrendsResponse
+	(*TrendBucket)(nil),                  // 4: api.v1.TrendBucket
+	(*TrendSeries)(nil),                  // 5: api.v1.TrendSeries
+	(*TrendsSummary)(nil),                // 6: api.v1.TrendsSummary
+	(*BacklogSnapshot)(nil),              // 7: api.v1.BacklogSnapshot
+	(*AnalyticsFilters)(nil),             // 8: api.v1.AnalyticsFilters
+	(*GetDailySummariesRequest)(nil),     // 9: api.v1.GetDailySummariesRequest
+	(*GetDailySummariesResponse)(nil),    // 10: api.v1.GetDailySummariesResponse
+	(*DailySummary)(nil),                 // 11: api.v1.DailySummary
+	(*GetDayDetailRequest)(nil),          // 12: api.v1.GetDayDetailRequest
+	(*GetDayDetailResponse)(nil),         // 13: api.v1.GetDayDetailResponse
+	(*WrongWord)(nil),                    // 14: api.v1.WrongWord
+	(*RelatedGroup)(nil),                 // 15: api.v1.RelatedGroup
+	(*GetWordHistoryRequest)(nil),        // 16: api.v1.GetWordHistoryRequest
+	(*GetWordHistoryResponse)(nil),       // 17: api.v1.GetWordHistoryResponse
+	(*AttemptEntry)(nil),                 // 18: api.v1.AttemptEntry
+	(*GetLeechesRequest)(nil),            // 19: api.v1.GetLeechesRequest
+	(*GetLeechesResponse)(nil),           // 20: api.v1.GetLeechesResponse
+	(*LeechEntry)(nil),                   // 21: api.v1.LeechEntry
+	(*GetConfusionsRequest)(nil),         // 22: api.v1.GetConfusionsRequest
+	(*GetConfusionsResponse)(nil),        // 23: api.v1.GetConfusionsResponse
+	(*ConfusionPair)(nil),                // 24: api.v1.ConfusionPair
+	(*ConfusionWord)(nil),                // 25: api.v1.ConfusionWord
+	(*GetFrequencyCoverageRequest)(nil),  // 26: api.v1.GetFrequencyCoverageRequest
+	(*GetFrequencyCoverageResponse)(nil), // 27: api.v1.GetFrequencyCoverageResponse
 }
 var file_api_v1_analytics_proto_depIdxs = []int32{
 	0,  // 0: api.v1.GetTrendsRequest.granularity:type_name -> api.v1.Granularity
@@ -1691,19 +2453,31 @@ var file_api_v1_analytics_proto_depIdxs = []int32{
 	14, // 11: api.v1.GetDayDetailResponse.wrong_words:type_name -> api.v1.WrongWord
 	15, // 12: api.v1.WrongWord.related_groups:type_name -> api.v1.RelatedGroup
 	18, // 13: api.v1.GetWordHistoryResponse.attempts:type_name -> api.v1.AttemptEntry
-	9,  // 14: api.v1.AnalyticsService.GetDailySummaries:input_type -> api.v1.GetDailySummariesRequest
-	12, // 15: api.v1.AnalyticsService.GetDayDetail:input_type -> api.v1.GetDayDetailRequest
-	16, // 16: api.v1.AnalyticsService.GetWordHistory:input_type -> api.v1.GetWordHistoryRequest
-	2,  // 17: api.v1.AnalyticsService.GetTrends:input_type -> api.v1.GetTrendsRequest
-	10, // 18: api.v1.AnalyticsService.GetDailySummaries:output_type -> api.v1.GetDailySummariesResponse
-	13, // 19: api.v1.AnalyticsService.GetDayDetail:output_type -> api.v1.GetDayDetailResponse
-	17, // 20: api.v1.AnalyticsService.GetWordHistory:output_type -> api.v1.GetWordHistoryResponse
-	3,  // 21: api.v1.AnalyticsService.GetTrends:output_type -> api.v1.GetTrendsResponse
-	18, // [18:22] is the sub-list for method output_type
-	14, // [14:18] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	8,  // 14: api.v1.GetLeechesRequest.filters:type_name -> api.v1.AnalyticsFilters
+	21, // 15: api.v1.GetLeechesResponse.leeches:type_name -> api.v1.LeechEntry
+	8,  // 16: api.v1.GetConfusionsRequest.filters:type_name -> api.v1.AnalyticsFilters
+	24, // 17: api.v1.GetConfusionsResponse.pairs:type_name -> api.v1.ConfusionPair
+	25, // 18: api.v1.ConfusionPair.words:type_name -> api.v1.ConfusionWord
+	8,  // 19: api.v1.GetFrequencyCoverageRequest.filters:type_name -> api.v1.AnalyticsFilters
+	9,  // 20: api.v1.AnalyticsService.GetDailySummaries:input_type -> api.v1.GetDailySummariesRequest
+	12, // 21: api.v1.AnalyticsService.GetDayDetail:input_type -> api.v1.GetDayDetailRequest
+	16, // 22: api.v1.AnalyticsService.GetWordHistory:input_type -> api.v1.GetWordHistoryRequest
+	2,  // 23: api.v1.AnalyticsService.GetTrends:input_type -> api.v1.GetTrendsRequest
+	19, // 24: api.v1.AnalyticsService.GetLeeches:input_type -> api.v1.GetLeechesRequest
+	22, // 25: api.v1.AnalyticsService.GetConfusions:input_type -> api.v1.GetConfusionsRequest
+	26, // 26: api.v1.AnalyticsService.GetFrequencyCoverage:input_type -> api.v1.GetFrequencyCoverageRequest
+	10, // 27: api.v1.AnalyticsService.GetDailySummaries:output_type -> api.v1.GetDailySummariesResponse
+	13, // 28: api.v1.AnalyticsService.GetDayDetail:output_type -> api.v1.GetDayDetailResponse
+	17, // 29: api.v1.AnalyticsService.GetWordHistory:output_type -> api.v1.GetWordHistoryResponse
+	3,  // 30: api.v1.AnalyticsService.GetTrends:output_type -> api.v1.GetTrendsResponse
+	20, // 31: api.v1.AnalyticsService.GetLeeches:output_type -> api.v1.GetLeechesResponse
+	23, // 32: api.v1.AnalyticsService.GetConfusions:output_type -> api.v1.GetConfusionsResponse
+	27, // 33: api.v1.AnalyticsService.GetFrequencyCoverage:output_type -> api.v1.GetFrequencyCoverageResponse
+	27, // [27:34] is the sub-list for method output_type
+	20, // [20:27] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_analytics_proto_init() }
@@ -1717,7 +2491,7 @@ func file_api_v1_analytics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_analytics_proto_rawDesc), len(file_api_v1_analytics_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   17,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -45,6 +45,15 @@ const (
 	// AnalyticsServiceGetTrendsProcedure is the fully-qualified name of the AnalyticsService's
 	// GetTrends RPC.
 	AnalyticsServiceGetTrendsProcedure = "/api.v1.AnalyticsService/GetTrends"
+	// AnalyticsServiceGetLeechesProcedure is the fully-qualified name of the AnalyticsService's
+	// GetLeeches RPC.
+	AnalyticsServiceGetLeechesProcedure = "/api.v1.AnalyticsService/GetLeeches"
+	// AnalyticsServiceGetConfusionsProcedure is the fully-qualified name of the AnalyticsService's
+	// GetConfusions RPC.
+	AnalyticsServiceGetConfusionsProcedure = "/api.v1.AnalyticsService/GetConfusions"
+	// AnalyticsServiceGetFrequencyCoverageProcedure is the fully-qualified name of the
+	// AnalyticsService's GetFrequencyCoverage RPC.
+	AnalyticsServiceGetFrequencyCoverageProcedure = "/api.v1.AnalyticsService/GetFrequencyCoverage"
 )
 
 // AnalyticsServiceClient is a client for the api.v1.AnalyticsService service.
@@ -66,6 +75,18 @@ type AnalyticsServiceClient interface {
 	// each split into series by the chosen dimension, plus the range totals
 	// and the end-of-range backlog snapshot.
 	GetTrends(context.Context, *connect.Request[v1.GetTrendsRequest]) (*connect.Response[v1.GetTrendsResponse], error)
+	// GetLeeches returns the word × quiz type series that keep failing —
+	// lapsed too often or stuck on a run of wrong answers — worst first.
+	GetLeeches(context.Context, *connect.Request[v1.GetLeechesRequest]) (*connect.Response[v1.GetLeechesResponse], error)
+	// GetConfusions returns the pairs of words of one notebook the learner
+	// mixes up: a wrong answer for one was the other's meaning, or, in the
+	// reverse quiz, the other word. The discrimination drill is built from
+	// the unresolved pairs.
+	GetConfusions(context.Context, *connect.Request[v1.GetConfusionsRequest]) (*connect.Response[v1.GetConfusionsResponse], error)
+	// GetFrequencyCoverage reports how many of the most frequent words of
+	// the configured frequency list (frequency.list_file) the learner has
+	// studied and knows. FAILED_PRECONDITION when no list is configured.
+	GetFrequencyCoverage(context.Context, *connect.Request[v1.GetFrequencyCoverageRequest]) (*connect.Response[v1.GetFrequencyCoverageResponse], error)
 }
 
 // NewAnalyticsServiceClient constructs a client for the api.v1.AnalyticsService service. By
@@ -103,15 +124,36 @@ func NewAnalyticsServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(analyticsServiceMethods.ByName("GetTrends")),
 			connect.WithClientOptions(opts...),
 		),
+		getLeeches: connect.NewClient[v1.GetLeechesRequest, v1.GetLeechesResponse](
+			httpClient,
+			baseURL+AnalyticsServiceGetLeechesProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("GetLeeches")),
+			connect.WithClientOptions(opts...),
+		),
+		getConfusions: connect.NewClient[v1.GetConfusionsRequest, v1.GetConfusionsResponse](
+			httpClient,
+			baseURL+AnalyticsServiceGetConfusionsProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("GetConfusions")),
+			connect.WithClientOptions(opts...),
+		),
+		getFrequencyCoverage: connect.NewClient[v1.GetFrequencyCoverageRequest, v1.GetFrequencyCoverageResponse](
+			httpClient,
+			baseURL+AnalyticsServiceGetFrequencyCoverageProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("GetFrequencyCoverage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // analyticsServiceClient implements AnalyticsServiceClient.
 type analyticsServiceClient struct {
-	getDailySummaries *connect.Client[v1.GetDailySummariesRequest, v1.GetDailySummariesResponse]
-	getDayDetail      *connect.Client[v1.GetDayDetailRequest, v1.GetDayDetailResponse]
-	getWordHistory    *connect.Client[v1.GetWordHistoryRequest, v1.GetWordHistoryResponse]
-	getTrends         *connect.Client[v1.GetTrendsRequest, v1.GetTrendsResponse]
+	getDailySummaries    *connect.Client[v1.GetDailySummariesRequest, v1.GetDailySummariesResponse]
+	getDayDetail         *connect.Client[v1.GetDayDetailRequest, v1.GetDayDetailResponse]
+	getWordHistory       *connect.Client[v1.GetWordHistoryRequest, v1.GetWordHistoryResponse]
+	getTrends            *connect.Client[v1.GetTrendsRequest, v1.GetTrendsResponse]
+	getLeeches           *connect.Client[v1.GetLeechesRequest, v1.GetLeechesResponse]
+	getConfusions        *connect.Client[v1.GetConfusionsRequest, v1.GetConfusionsResponse]
+	getFrequencyCoverage *connect.Client[v1.GetFrequencyCoverageRequest, v1.GetFrequencyCoverageResponse]
 }
 
 // GetDailySummaries calls api.v1.AnalyticsService.GetDailySummaries.
@@ -134,6 +176,21 @@ func (c *analyticsServiceClient) GetTrends(ctx context.Context, req *connect.Req
 	return c.getTrends.CallUnary(ctx, req)
 }
 
+// GetLeeches calls api.v1.AnalyticsService.GetLeeches.
+func (c *analyticsServiceClient) GetLeeches(ctx context.Context, req *connect.Request[v1.GetLeechesRequest]) (*connect.Response[v1.GetLeechesResponse], error) {
+	return c.getLeeches.CallUnary(ctx, req)
+}
+
+// GetConfusions calls api.v1.AnalyticsService.GetConfusions.
+func (c *analyticsServiceClient) GetConfusions(ctx context.Context, req *connect.Request[v1.GetConfusionsRequest]) (*connect.Response[v1.GetConfusionsResponse], error) {
+	return c.getConfusions.CallUnary(ctx, req)
+}
+
+// GetFrequencyCoverage calls api.v1.AnalyticsService.GetFrequencyCoverage.
+func (c *analyticsServiceClient) GetFrequencyCoverage(ctx context.Context, req *connect.Request[v1.GetFrequencyCoverageRequest]) (*connect.Response[v1.GetFrequencyCoverageResponse], error) {
+	return c.getFrequencyCoverage.CallUnary(ctx, req)
+}
+
 // AnalyticsServiceHandler is an implementation of the api.v1.AnalyticsService service.
 type AnalyticsServiceHandler interface {
 	// GetDailySummaries returns one row per day with quiz activity in the
@@ -153,6 +210,18 @@ type AnalyticsServiceHandler interface {
 	// each split into series by the chosen dimension, plus the range totals
 	// and the end-of-range backlog snapshot.
 	GetTrends(context.Context, *connect.Request[v1.GetTrendsRequest]) (*connect.Response[v1.GetTrendsResponse], error)
+	// GetLeeches returns the word × quiz type series that keep failing —
+	// lapsed too often or stuck on a run of wrong answers — worst first.
+	GetLeeches(context.Context, *connect.Request[v1.GetLeechesRequest]) (*connect.Response[v1.GetLeechesResponse], error)
+	// GetConfusions returns the pairs of words of one notebook the learner
+	// mixes up: a wrong answer for one was the other's meaning, or, in the
+	// reverse quiz, the other word. The discrimination drill is built from
+	// the unresolved pairs.
+	GetConfusions(context.Context, *connect.Request[v1.GetConfusionsRequest]) (*connect.Response[v1.GetConfusionsResponse], error)
+	// GetFrequencyCoverage reports how many of the most frequent words of
+	// the configured frequency list (frequency.list_file) the learner has
+	// studied and knows. FAILED_PRECONDITION when no list is configured.
+	GetFrequencyCoverage(context.Context, *connect.Request[v1.GetFrequencyCoverageRequest]) (*connect.Response[v1.GetFrequencyCoverageResponse], error)
 }
 
 // NewAnalyticsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -186,6 +255,24 @@ func NewAnalyticsServiceHandler(svc AnalyticsServiceHandler, opts ...connect.Han
 		connect.WithSchema(analyticsServiceMethods.ByName("GetTrends")),
 		connect.WithHandlerOptions(opts...),
 	)
+	analyticsServiceGetLeechesHandler := connect.NewUnaryHandler(
+		AnalyticsServiceGetLeechesProcedure,
+		svc.GetLeeches,
+		connect.WithSchema(analyticsServiceMethods.ByName("GetLeeches")),
+		connect.WithHandlerOptions(opts...),
+	)
+	analyticsServiceGetConfusionsHandler := connect.NewUnaryHandler(
+		AnalyticsServiceGetConfusionsProcedure,
+		svc.GetConfusions,
+		connect.WithSchema(analyticsServiceMethods.ByName("GetConfusions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	analyticsServiceGetFrequencyCoverageHandler := connect.NewUnaryHandler(
+		AnalyticsServiceGetFrequencyCoverageProcedure,
+		svc.GetFrequencyCoverage,
+		connect.WithSchema(analyticsServiceMethods.ByName("GetFrequencyCoverage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.AnalyticsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AnalyticsServiceGetDailySummariesProcedure:
@@ -196,6 +283,12 @@ func NewAnalyticsServiceHandler(svc AnalyticsServiceHandler, opts ...connect.Han
 			analyticsServiceGetWordHistoryHandler.ServeHTTP(w, r)
 		case AnalyticsServiceGetTrendsProcedure:
 			analyticsServiceGetTrendsHandler.ServeHTTP(w, r)
+		case AnalyticsServiceGetLeechesProcedure:
+			analyticsServiceGetLeechesHandler.ServeHTTP(w, r)
+		case AnalyticsServiceGetConfusionsProcedure:
+			analyticsServiceGetConfusionsHandler.ServeHTTP(w, r)
+		case AnalyticsServiceGetFrequencyCoverageProcedure:
+			analyticsServiceGetFrequencyCoverageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -220,3 +313,15 @@ func (UnimplementedAnalyticsServiceHandler) GetWordHistory(context.Context, *con
 func (UnimplementedAnalyticsServiceHandler) GetTrends(context.Context, *connect.Request[v1.GetTrendsRequest]) (*connect.Response[v1.GetTrendsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AnalyticsService.GetTrends is not implemented"))
 }
+
+func (UnimplementedAnalyticsServiceHandler) GetLeeches(context.Context, *connect.Request[v1.GetLeechesRequest]) (*connect.Response[v1.GetLeechesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AnalyticsService.GetLeeches is not implemented"))
+}
+
+func (UnimplementedAnalyticsServiceHandler) GetConfusions(context.Context, *connect.Request[v1.GetConfusionsRequest]) (*connect.Response[v1.GetConfusionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AnalyticsService.GetConfusions is not implemented"))
+}
+
+func (UnimplementedAnalyticsServiceHandler) GetFrequencyCoverage(context.Context, *connect.Request[v1.GetFrequencyCoverageRequest]) (*connect.Response[v1.GetFrequencyCoverageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AnalyticsService.GetFrequencyCoverage is not implemented"))
+}
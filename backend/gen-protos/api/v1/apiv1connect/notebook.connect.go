@@ -51,6 +51,18 @@ const (
 	// NotebookServiceGetEtymologyNotebookProcedure is the fully-qualified name of the NotebookService's
 	// GetEtymologyNotebook RPC.
 	NotebookServiceGetEtymologyNotebookProcedure = "/api.v1.NotebookService/GetEtymologyNotebook"
+	// NotebookServiceGetEtymologyGraphProcedure is the fully-qualified name of the NotebookService's
+	// GetEtymologyGraph RPC.
+	NotebookServiceGetEtymologyGraphProcedure = "/api.v1.NotebookService/GetEtymologyGraph"
+	// NotebookServiceStreamNoteAudioProcedure is the fully-qualified name of the NotebookService's
+	// StreamNoteAudio RPC.
+	NotebookServiceStreamNoteAudioProcedure = "/api.v1.NotebookService/StreamNoteAudio"
+	// NotebookServiceSearchNotebooksProcedure is the fully-qualified name of the NotebookService's
+	// SearchNotebooks RPC.
+	NotebookServiceSearchNotebooksProcedure = "/api.v1.NotebookService/SearchNotebooks"
+	// NotebookServiceSearchPassagesProcedure is the fully-qualified name of the NotebookService's
+	// SearchPassages RPC.
+	NotebookServiceSearchPassagesProcedure = "/api.v1.NotebookService/SearchPassages"
 )
 
 // NotebookServiceClient is a client for the api.v1.NotebookService service.
@@ -61,6 +73,17 @@ type NotebookServiceClient interface {
 	RegisterDefinition(context.Context, *connect.Request[v1.RegisterDefinitionRequest]) (*connect.Response[v1.RegisterDefinitionResponse], error)
 	DeleteDefinition(context.Context, *connect.Request[v1.DeleteDefinitionRequest]) (*connect.Response[v1.DeleteDefinitionResponse], error)
 	GetEtymologyNotebook(context.Context, *connect.Request[v1.GetEtymologyNotebookRequest]) (*connect.Response[v1.GetEtymologyNotebookResponse], error)
+	// GetEtymologyGraph returns the word family graph of an etymology
+	// notebook, the same graph `langner notebooks etymology --format` writes.
+	GetEtymologyGraph(context.Context, *connect.Request[v1.GetEtymologyGraphRequest]) (*connect.Response[v1.GetEtymologyGraphResponse], error)
+	// StreamNoteAudio streams the pronunciation audio a note refers to.
+	StreamNoteAudio(context.Context, *connect.Request[v1.StreamNoteAudioRequest]) (*connect.ServerStreamForClient[v1.StreamNoteAudioResponse], error)
+	// SearchNotebooks lists every scene line, statement, note and example
+	// across all notebooks that uses a word or phrase in any inflected form.
+	SearchNotebooks(context.Context, *connect.Request[v1.SearchNotebooksRequest]) (*connect.Response[v1.SearchNotebooksResponse], error)
+	// SearchPassages ranks the ebook paragraphs and story lines containing
+	// every word of a query, best match first, a page at a time.
+	SearchPassages(context.Context, *connect.Request[v1.SearchPassagesRequest]) (*connect.Response[v1.SearchPassagesResponse], error)
 }
 
 // NewNotebookServiceClient constructs a client for the api.v1.NotebookService service. By default,
@@ -110,6 +133,30 @@ func NewNotebookServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(notebookServiceMethods.ByName("GetEtymologyNotebook")),
 			connect.WithClientOptions(opts...),
 		),
+		getEtymologyGraph: connect.NewClient[v1.GetEtymologyGraphRequest, v1.GetEtymologyGraphResponse](
+			httpClient,
+			baseURL+NotebookServiceGetEtymologyGraphProcedure,
+			connect.WithSchema(notebookServiceMethods.ByName("GetEtymologyGraph")),
+			connect.WithClientOptions(opts...),
+		),
+		streamNoteAudio: connect.NewClient[v1.StreamNoteAudioRequest, v1.StreamNoteAudioResponse](
+			httpClient,
+			baseURL+NotebookServiceStreamNoteAudioProcedure,
+			connect.WithSchema(notebookServiceMethods.ByName("StreamNoteAudio")),
+			connect.WithClientOptions(opts...),
+		),
+		searchNotebooks: connect.NewClient[v1.SearchNotebooksRequest, v1.SearchNotebooksResponse](
+			httpClient,
+			baseURL+NotebookServiceSearchNotebooksProcedure,
+			connect.WithSchema(notebookServiceMethods.ByName("SearchNotebooks")),
+			connect.WithClientOptions(opts...),
+		),
+		searchPassages: connect.NewClient[v1.SearchPassagesRequest, v1.SearchPassagesResponse](
+			httpClient,
+			baseURL+NotebookServiceSearchPassagesProcedure,
+			connect.WithSchema(notebookServiceMethods.ByName("SearchPassages")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -121,6 +168,10 @@ type notebookServiceClient struct {
 	registerDefinition   *connect.Client[v1.RegisterDefinitionRequest, v1.RegisterDefinitionResponse]
 	deleteDefinition     *connect.Client[v1.DeleteDefinitionRequest, v1.DeleteDefinitionResponse]
 	getEtymologyNotebook *connect.Client[v1.GetEtymologyNotebookRequest, v1.GetEtymologyNotebookResponse]
+	getEtymologyGraph    *connect.Client[v1.GetEtymologyGraphRequest, v1.GetEtymologyGraphResponse]
+	streamNoteAudio      *connect.Client[v1.StreamNoteAudioRequest, v1.StreamNoteAudioResponse]
+	searchNotebooks      *connect.Client[v1.SearchNotebooksRequest, v1.SearchNotebooksResponse]
+	searchPassages       *connect.Client[v1.SearchPassagesRequest, v1.SearchPassagesResponse]
 }
 
 // GetNotebookDetail calls api.v1.NotebookService.GetNotebookDetail.
@@ -153,6 +204,26 @@ func (c *notebookServiceClient) GetEtymologyNotebook(ctx context.Context, req *c
 	return c.getEtymologyNotebook.CallUnary(ctx, req)
 }
 
+// GetEtymologyGraph calls api.v1.NotebookService.GetEtymologyGraph.
+func (c *notebookServiceClient) GetEtymologyGraph(ctx context.Context, req *connect.Request[v1.GetEtymologyGraphRequest]) (*connect.Response[v1.GetEtymologyGraphResponse], error) {
+	return c.getEtymologyGraph.CallUnary(ctx, req)
+}
+
+// StreamNoteAudio calls api.v1.NotebookService.StreamNoteAudio.
+func (c *notebookServiceClient) StreamNoteAudio(ctx context.Context, req *connect.Request[v1.StreamNoteAudioRequest]) (*connect.ServerStreamForClient[v1.StreamNoteAudioResponse], error) {
+	return c.streamNoteAudio.CallServerStream(ctx, req)
+}
+
+// SearchNotebooks calls api.v1.NotebookService.SearchNotebooks.
+func (c *notebookServiceClient) SearchNotebooks(ctx context.Context, req *connect.Request[v1.SearchNotebooksRequest]) (*connect.Response[v1.SearchNotebooksResponse], error) {
+	return c.searchNotebooks.CallUnary(ctx, req)
+}
+
+// SearchPassages calls api.v1.NotebookService.SearchPassages.
+func (c *notebookServiceClient) SearchPassages(ctx context.Context, req *connect.Request[v1.SearchPassagesRequest]) (*connect.Response[v1.SearchPassagesResponse], error) {
+	return c.searchPassages.CallUnary(ctx, req)
+}
+
 // NotebookServiceHandler is an implementation of the api.v1.NotebookService service.
 type NotebookServiceHandler interface {
 	GetNotebookDetail(context.Context, *connect.Request[v1.GetNotebookDetailRequest]) (*connect.Response[v1.GetNotebookDetailResponse], error)
@@ -161,6 +232,17 @@ type NotebookServiceHandler interface {
 	RegisterDefinition(context.Context, *connect.Request[v1.RegisterDefinitionRequest]) (*connect.Response[v1.RegisterDefinitionResponse], error)
 	DeleteDefinition(context.Context, *connect.Request[v1.DeleteDefinitionRequest]) (*connect.Response[v1.DeleteDefinitionResponse], error)
 	GetEtymologyNotebook(context.Context, *connect.Request[v1.GetEtymologyNotebookRequest]) (*connect.Response[v1.GetEtymologyNotebookResponse], error)
+	// GetEtymologyGraph returns the word family graph of an etymology
+	// notebook, the same graph `langner notebooks etymology --format` writes.
+	GetEtymologyGraph(context.Context, *connect.Request[v1.GetEtymologyGraphRequest]) (*connect.Response[v1.GetEtymologyGraphResponse], error)
+	// StreamNoteAudio streams the pronunciation audio a note refers to.
+	StreamNoteAudio(context.Context, *connect.Request[v1.StreamNoteAudioRequest], *connect.ServerStream[v1.StreamNoteAudioResponse]) error
+	// SearchNotebooks lists every scene line, statement, note and example
+	// across all notebooks that uses a word or phrase in any inflected form.
+	SearchNotebooks(context.Context, *connect.Request[v1.SearchNotebooksRequest]) (*connect.Response[v1.SearchNotebooksResponse], error)
+	// SearchPassages ranks the ebook paragraphs and story lines containing
+	// every word of a query, best match first, a page at a time.
+	SearchPassages(context.Context, *connect.Request[v1.SearchPassagesRequest]) (*connect.Response[v1.SearchPassagesResponse], error)
 }
 
 // NewNotebookServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -206,6 +288,30 @@ func NewNotebookServiceHandler(svc NotebookServiceHandler, opts ...connect.Handl
 		connect.WithSchema(notebookServiceMethods.ByName("GetEtymologyNotebook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	notebookServiceGetEtymologyGraphHandler := connect.NewUnaryHandler(
+		NotebookServiceGetEtymologyGraphProcedure,
+		svc.GetEtymologyGraph,
+		connect.WithSchema(notebookServiceMethods.ByName("GetEtymologyGraph")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notebookServiceStreamNoteAudioHandler := connect.NewServerStreamHandler(
+		NotebookServiceStreamNoteAudioProcedure,
+		svc.StreamNoteAudio,
+		connect.WithSchema(notebookServiceMethods.ByName("StreamNoteAudio")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notebookServiceSearchNotebooksHandler := connect.NewUnaryHandler(
+		NotebookServiceSearchNotebooksProcedure,
+		svc.SearchNotebooks,
+		connect.WithSchema(notebookServiceMethods.ByName("SearchNotebooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notebookServiceSearchPassagesHandler := connect.NewUnaryHandler(
+		NotebookServiceSearchPassagesProcedure,
+		svc.SearchPassages,
+		connect.WithSchema(notebookServiceMethods.ByName("SearchPassages")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.NotebookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case NotebookServiceGetNotebookDetailProcedure:
@@ -220,6 +326,14 @@ func NewNotebookServiceHandler(svc NotebookServiceHandler, opts ...connect.Handl
 			notebookServiceDeleteDefinitionHandler.ServeHTTP(w, r)
 		case NotebookServiceGetEtymologyNotebookProcedure:
 			notebookServiceGetEtymologyNotebookHandler.ServeHTTP(w, r)
+		case NotebookServiceGetEtymologyGraphProcedure:
+			notebookServiceGetEtymologyGraphHandler.ServeHTTP(w, r)
+		case NotebookServiceStreamNoteAudioProcedure:
+			notebookServiceStreamNoteAudioHandler.ServeHTTP(w, r)
+		case NotebookServiceSearchNotebooksProcedure:
+			notebookServiceSearchNotebooksHandler.ServeHTTP(w, r)
+		case NotebookServiceSearchPassagesProcedure:
+			notebookServiceSearchPassagesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -252,3 +366,19 @@ func (UnimplementedNotebookServiceHandler) DeleteDefinition(context.Context, *co
 func (UnimplementedNotebookServiceHandler) GetEtymologyNotebook(context.Context, *connect.Request[v1.GetEtymologyNotebookRequest]) (*connect.Response[v1.GetEtymologyNotebookResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.NotebookService.GetEtymologyNotebook is not implemented"))
 }
+
+func (UnimplementedNotebookServiceHandler) GetEtymologyGraph(context.Context, *connect.Request[v1.GetEtymologyGraphRequest]) (*connect.Response[v1.GetEtymologyGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.NotebookService.GetEtymologyGraph is not implemented"))
+}
+
+func (UnimplementedNotebookServiceHandler) StreamNoteAudio(context.Context, *connect.Request[v1.StreamNoteAudioRequest], *connect.ServerStream[v1.StreamNoteAudioResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.NotebookService.StreamNoteAudio is not implemented"))
+}
+
+func (UnimplementedNotebookServiceHandler) SearchNotebooks(context.Context, *connect.Request[v1.SearchNotebooksRequest]) (*connect.Response[v1.SearchNotebooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.NotebookService.SearchNotebooks is not implemented"))
+}
+
+func (UnimplementedNotebookServiceHandler) SearchPassages(context.Context, *connect.Request[v1.SearchPassagesRequest]) (*connect.Response[v1.SearchPassagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.NotebookService.SearchPassages is not implemented"))
+}
@@ -53,6 +53,9 @@ const (
 	// QuizServiceBatchSubmitReverseAnswersProcedure is the fully-qualified name of the QuizService's
 	// BatchSubmitReverseAnswers RPC.
 	QuizServiceBatchSubmitReverseAnswersProcedure = "/api.v1.QuizService/BatchSubmitReverseAnswers"
+	// QuizServiceSubmitReverseAnswerAudioProcedure is the fully-qualified name of the QuizService's
+	// SubmitReverseAnswerAudio RPC.
+	QuizServiceSubmitReverseAnswerAudioProcedure = "/api.v1.QuizService/SubmitReverseAnswerAudio"
 	// QuizServiceStartFreeformQuizProcedure is the fully-qualified name of the QuizService's
 	// StartFreeformQuiz RPC.
 	QuizServiceStartFreeformQuizProcedure = "/api.v1.QuizService/StartFreeformQuiz"
@@ -99,6 +102,24 @@ const (
 	// QuizServiceResumeGrammarMistakeProcedure is the fully-qualified name of the QuizService's
 	// ResumeGrammarMistake RPC.
 	QuizServiceResumeGrammarMistakeProcedure = "/api.v1.QuizService/ResumeGrammarMistake"
+	// QuizServiceStartDictationQuizProcedure is the fully-qualified name of the QuizService's
+	// StartDictationQuiz RPC.
+	QuizServiceStartDictationQuizProcedure = "/api.v1.QuizService/StartDictationQuiz"
+	// QuizServiceSubmitDictationAnswerProcedure is the fully-qualified name of the QuizService's
+	// SubmitDictationAnswer RPC.
+	QuizServiceSubmitDictationAnswerProcedure = "/api.v1.QuizService/SubmitDictationAnswer"
+	// QuizServiceStartWordChoiceQuizProcedure is the fully-qualified name of the QuizService's
+	// StartWordChoiceQuiz RPC.
+	QuizServiceStartWordChoiceQuizProcedure = "/api.v1.QuizService/StartWordChoiceQuiz"
+	// QuizServiceSubmitWordChoiceAnswerProcedure is the fully-qualified name of the QuizService's
+	// SubmitWordChoiceAnswer RPC.
+	QuizServiceSubmitWordChoiceAnswerProcedure = "/api.v1.QuizService/SubmitWordChoiceAnswer"
+	// QuizServiceGenerateMnemonicProcedure is the fully-qualified name of the QuizService's
+	// GenerateMnemonic RPC.
+	QuizServiceGenerateMnemonicProcedure = "/api.v1.QuizService/GenerateMnemonic"
+	// QuizServiceSubmitDiscriminationAnswerProcedure is the fully-qualified name of the QuizService's
+	// SubmitDiscriminationAnswer RPC.
+	QuizServiceSubmitDiscriminationAnswerProcedure = "/api.v1.QuizService/SubmitDiscriminationAnswer"
 )
 
 // QuizServiceClient is a client for the api.v1.QuizService service.
@@ -110,6 +131,11 @@ type QuizServiceClient interface {
 	StartReverseQuiz(context.Context, *connect.Request[v1.StartReverseQuizRequest]) (*connect.Response[v1.StartReverseQuizResponse], error)
 	SubmitReverseAnswer(context.Context, *connect.Request[v1.SubmitReverseAnswerRequest]) (*connect.Response[v1.SubmitReverseAnswerResponse], error)
 	BatchSubmitReverseAnswers(context.Context, *connect.Request[v1.BatchSubmitReverseAnswersRequest]) (*connect.Response[v1.BatchSubmitReverseAnswersResponse], error)
+	// SubmitReverseAnswerAudio grades a spoken reverse-quiz answer: the audio
+	// is transcribed with the configured speech-to-text service and the
+	// transcript is graded and recorded like a typed SubmitReverseAnswer.
+	// Fails with FAILED_PRECONDITION when no speech-to-text service is set up.
+	SubmitReverseAnswerAudio(context.Context, *connect.Request[v1.SubmitReverseAnswerAudioRequest]) (*connect.Response[v1.SubmitReverseAnswerAudioResponse], error)
 	StartFreeformQuiz(context.Context, *connect.Request[v1.StartFreeformQuizRequest]) (*connect.Response[v1.StartFreeformQuizResponse], error)
 	SubmitFreeformAnswer(context.Context, *connect.Request[v1.SubmitFreeformAnswerRequest]) (*connect.Response[v1.SubmitFreeformAnswerResponse], error)
 	OverrideAnswer(context.Context, *connect.Request[v1.OverrideAnswerRequest]) (*connect.Response[v1.OverrideAnswerResponse], error)
@@ -152,6 +178,33 @@ type QuizServiceClient interface {
 	ListGrammarMistakes(context.Context, *connect.Request[v1.ListGrammarMistakesRequest]) (*connect.Response[v1.ListGrammarMistakesResponse], error)
 	ExcludeGrammarMistake(context.Context, *connect.Request[v1.ExcludeGrammarMistakeRequest]) (*connect.Response[v1.ExcludeGrammarMistakeResponse], error)
 	ResumeGrammarMistake(context.Context, *connect.Request[v1.ResumeGrammarMistakeRequest]) (*connect.Response[v1.ResumeGrammarMistakeResponse], error)
+	// Dictation Quiz — plays a conversation line containing a story expression
+	// and has the user type what they heard. The answer is graded by word-level
+	// edit distance with the target expression weighted, and recorded in the
+	// expression's dictation learning history. The line's audio is streamed
+	// with NotebookService.StreamNoteAudio.
+	StartDictationQuiz(context.Context, *connect.Request[v1.StartDictationQuizRequest]) (*connect.Response[v1.StartDictationQuizResponse], error)
+	SubmitDictationAnswer(context.Context, *connect.Request[v1.SubmitDictationAnswerRequest]) (*connect.Response[v1.SubmitDictationAnswerResponse], error)
+	// Word Choice Quiz — asks about one member of a synonym or antonym concept
+	// of a definitions book: which member fits the blank of its example
+	// sentence, or, for a member without an example, how it differs from
+	// another member. Answers are graded by the model, falling back to a
+	// deterministic grade, and recorded in the member's word choice learning
+	// history.
+	StartWordChoiceQuiz(context.Context, *connect.Request[v1.StartWordChoiceQuizRequest]) (*connect.Response[v1.StartWordChoiceQuizResponse], error)
+	SubmitWordChoiceAnswer(context.Context, *connect.Request[v1.SubmitWordChoiceAnswerRequest]) (*connect.Response[v1.SubmitWordChoiceAnswerResponse], error)
+	// GenerateMnemonic writes an LLM-generated memory hook into the memo of
+	// the note a quiz card was built from, the same way `langner notebooks
+	// enrich --mnemonics` does. Fails with FAILED_PRECONDITION when the note
+	// already has a memo or has no meaning to hook to.
+	GenerateMnemonic(context.Context, *connect.Request[v1.GenerateMnemonicRequest]) (*connect.Response[v1.GenerateMnemonicResponse], error)
+	// SubmitDiscriminationAnswer reports an answer of the discrimination
+	// drill on a confusion pair (AnalyticsService.GetConfusions). A miss moves
+	// the studied words of the pair into the Relearn Quiz pool for the quiz
+	// types they were confused in, so they are drilled there until answered
+	// correctly, which also resolves the pair. A correct answer changes
+	// nothing.
+	SubmitDiscriminationAnswer(context.Context, *connect.Request[v1.SubmitDiscriminationAnswerRequest]) (*connect.Response[v1.SubmitDiscriminationAnswerResponse], error)
 }
 
 // NewQuizServiceClient constructs a client for the api.v1.QuizService service. By default, it uses
@@ -207,6 +260,12 @@ func NewQuizServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(quizServiceMethods.ByName("BatchSubmitReverseAnswers")),
 			connect.WithClientOptions(opts...),
 		),
+		submitReverseAnswerAudio: connect.NewClient[v1.SubmitReverseAnswerAudioRequest, v1.SubmitReverseAnswerAudioResponse](
+			httpClient,
+			baseURL+QuizServiceSubmitReverseAnswerAudioProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("SubmitReverseAnswerAudio")),
+			connect.WithClientOptions(opts...),
+		),
 		startFreeformQuiz: connect.NewClient[v1.StartFreeformQuizRequest, v1.StartFreeformQuizResponse](
 			httpClient,
 			baseURL+QuizServiceStartFreeformQuizProcedure,
@@ -303,34 +362,77 @@ func NewQuizServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(quizServiceMethods.ByName("ResumeGrammarMistake")),
 			connect.WithClientOptions(opts...),
 		),
+		startDictationQuiz: connect.NewClient[v1.StartDictationQuizRequest, v1.StartDictationQuizResponse](
+			httpClient,
+			baseURL+QuizServiceStartDictationQuizProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("StartDictationQuiz")),
+			connect.WithClientOptions(opts...),
+		),
+		submitDictationAnswer: connect.NewClient[v1.SubmitDictationAnswerRequest, v1.SubmitDictationAnswerResponse](
+			httpClient,
+			baseURL+QuizServiceSubmitDictationAnswerProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("SubmitDictationAnswer")),
+			connect.WithClientOptions(opts...),
+		),
+		startWordChoiceQuiz: connect.NewClient[v1.StartWordChoiceQuizRequest, v1.StartWordChoiceQuizResponse](
+			httpClient,
+			baseURL+QuizServiceStartWordChoiceQuizProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("StartWordChoiceQuiz")),
+			connect.WithClientOptions(opts...),
+		),
+		submitWordChoiceAnswer: connect.NewClient[v1.SubmitWordChoiceAnswerRequest, v1.SubmitWordChoiceAnswerResponse](
+			httpClient,
+			baseURL+QuizServiceSubmitWordChoiceAnswerProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("SubmitWordChoiceAnswer")),
+			connect.WithClientOptions(opts...),
+		),
+		generateMnemonic: connect.NewClient[v1.GenerateMnemonicRequest, v1.GenerateMnemonicResponse](
+			httpClient,
+			baseURL+QuizServiceGenerateMnemonicProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("GenerateMnemonic")),
+			connect.WithClientOptions(opts...),
+		),
+		submitDiscriminationAnswer: connect.NewClient[v1.SubmitDiscriminationAnswerRequest, v1.SubmitDiscriminationAnswerResponse](
+			httpClient,
+			baseURL+QuizServiceSubmitDiscriminationAnswerProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("SubmitDiscriminationAnswer")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // quizServiceClient implements QuizServiceClient.
 type quizServiceClient struct {
-	getQuizOptions            *connect.Client[v1.GetQuizOptionsRequest, v1.GetQuizOptionsResponse]
-	startQuiz                 *connect.Client[v1.StartQuizRequest, v1.StartQuizResponse]
-	submitAnswer              *connect.Client[v1.SubmitAnswerRequest, v1.SubmitAnswerResponse]
-	batchSubmitAnswers        *connect.Client[v1.BatchSubmitAnswersRequest, v1.BatchSubmitAnswersResponse]
-	startReverseQuiz          *connect.Client[v1.StartReverseQuizRequest, v1.StartReverseQuizResponse]
-	submitReverseAnswer       *connect.Client[v1.SubmitReverseAnswerRequest, v1.SubmitReverseAnswerResponse]
-	batchSubmitReverseAnswers *connect.Client[v1.BatchSubmitReverseAnswersRequest, v1.BatchSubmitReverseAnswersResponse]
-	startFreeformQuiz         *connect.Client[v1.StartFreeformQuizRequest, v1.StartFreeformQuizResponse]
-	submitFreeformAnswer      *connect.Client[v1.SubmitFreeformAnswerRequest, v1.SubmitFreeformAnswerResponse]
-	overrideAnswer            *connect.Client[v1.OverrideAnswerRequest, v1.OverrideAnswerResponse]
-	undoOverrideAnswer        *connect.Client[v1.UndoOverrideAnswerRequest, v1.UndoOverrideAnswerResponse]
-	skipWord                  *connect.Client[v1.SkipWordRequest, v1.SkipWordResponse]
-	resumeWord                *connect.Client[v1.ResumeWordRequest, v1.ResumeWordResponse]
-	excludeEtymologyWord      *connect.Client[v1.ExcludeEtymologyWordRequest, v1.ExcludeEtymologyWordResponse]
-	resumeEtymologyWord       *connect.Client[v1.ResumeEtymologyWordRequest, v1.ResumeEtymologyWordResponse]
-	startRelearnQuiz          *connect.Client[v1.StartRelearnQuizRequest, v1.StartRelearnQuizResponse]
-	submitRelearnAnswer       *connect.Client[v1.SubmitRelearnAnswerRequest, v1.SubmitRelearnAnswerResponse]
-	batchSubmitRelearnAnswers *connect.Client[v1.BatchSubmitRelearnAnswersRequest, v1.BatchSubmitRelearnAnswersResponse]
-	startGrammarQuiz          *connect.Client[v1.StartGrammarQuizRequest, v1.StartGrammarQuizResponse]
-	submitGrammarPost         *connect.Client[v1.SubmitGrammarPostRequest, v1.SubmitGrammarPostResponse]
-	listGrammarMistakes       *connect.Client[v1.ListGrammarMistakesRequest, v1.ListGrammarMistakesResponse]
-	excludeGrammarMistake     *connect.Client[v1.ExcludeGrammarMistakeRequest, v1.ExcludeGrammarMistakeResponse]
-	resumeGrammarMistake      *connect.Client[v1.ResumeGrammarMistakeRequest, v1.ResumeGrammarMistakeResponse]
+	getQuizOptions             *connect.Client[v1.GetQuizOptionsRequest, v1.GetQuizOptionsResponse]
+	startQuiz                  *connect.Client[v1.StartQuizRequest, v1.StartQuizResponse]
+	submitAnswer               *connect.Client[v1.SubmitAnswerRequest, v1.SubmitAnswerResponse]
+	batchSubmitAnswers         *connect.Client[v1.BatchSubmitAnswersRequest, v1.BatchSubmitAnswersResponse]
+	startReverseQuiz           *connect.Client[v1.StartReverseQuizRequest, v1.StartReverseQuizResponse]
+	submitReverseAnswer        *connect.Client[v1.SubmitReverseAnswerRequest, v1.SubmitReverseAnswerResponse]
+	batchSubmitReverseAnswers  *connect.Client[v1.BatchSubmitReverseAnswersRequest, v1.BatchSubmitReverseAnswersResponse]
+	submitReverseAnswerAudio   *connect.Client[v1.SubmitReverseAnswerAudioRequest, v1.SubmitReverseAnswerAudioResponse]
+	startFreeformQuiz          *connect.Client[v1.StartFreeformQuizRequest, v1.StartFreeformQuizResponse]
+	submitFreeformAnswer       *connect.Client[v1.SubmitFreeformAnswerRequest, v1.SubmitFreeformAnswerResponse]
+	overrideAnswer             *connect.Client[v1.OverrideAnswerRequest, v1.OverrideAnswerResponse]
+	undoOverrideAnswer         *connect.Client[v1.UndoOverrideAnswerRequest, v1.UndoOverrideAnswerResponse]
+	skipWord                   *connect.Client[v1.SkipWordRequest, v1.SkipWordResponse]
+	resumeWord                 *connect.Client[v1.ResumeWordRequest, v1.ResumeWordResponse]
+	excludeEtymologyWord       *connect.Client[v1.ExcludeEtymologyWordRequest, v1.ExcludeEtymologyWordResponse]
+	resumeEtymologyWord        *connect.Client[v1.ResumeEtymologyWordRequest, v1.ResumeEtymologyWordResponse]
+	startRelearnQuiz           *connect.Client[v1.StartRelearnQuizRequest, v1.StartRelearnQuizResponse]
+	submitRelearnAnswer        *connect.Client[v1.SubmitRelearnAnswerRequest, v1.SubmitRelearnAnswerResponse]
+	batchSubmitRelearnAnswers  *connect.Client[v1.BatchSubmitRelearnAnswersRequest, v1.BatchSubmitRelearnAnswersResponse]
+	startGrammarQuiz           *connect.Client[v1.StartGrammarQuizRequest, v1.StartGrammarQuizResponse]
+	submitGrammarPost          *connect.Client[v1.SubmitGrammarPostRequest, v1.SubmitGrammarPostResponse]
+	listGrammarMistakes        *connect.Client[v1.ListGrammarMistakesRequest, v1.ListGrammarMistakesResponse]
+	excludeGrammarMistake      *connect.Client[v1.ExcludeGrammarMistakeRequest, v1.ExcludeGrammarMistakeResponse]
+	resumeGrammarMistake       *connect.Client[v1.ResumeGrammarMistakeRequest, v1.ResumeGrammarMistakeResponse]
+	startDictationQuiz         *connect.Client[v1.StartDictationQuizRequest, v1.StartDictationQuizResponse]
+	submitDictationAnswer      *connect.Client[v1.SubmitDictationAnswerRequest, v1.SubmitDictationAnswerResponse]
+	startWordChoiceQuiz        *connect.Client[v1.StartWordChoiceQuizRequest, v1.StartWordChoiceQuizResponse]
+	submitWordChoiceAnswer     *connect.Client[v1.SubmitWordChoiceAnswerRequest, v1.SubmitWordChoiceAnswerResponse]
+	generateMnemonic           *connect.Client[v1.GenerateMnemonicRequest, v1.GenerateMnemonicResponse]
+	submitDiscriminationAnswer *connect.Client[v1.SubmitDiscriminationAnswerRequest, v1.SubmitDiscriminationAnswerResponse]
 }
 
 // GetQuizOptions calls api.v1.QuizService.GetQuizOptions.
@@ -368,6 +470,11 @@ func (c *quizServiceClient) BatchSubmitReverseAnswers(ctx context.Context, req *
 	return c.batchSubmitReverseAnswers.CallUnary(ctx, req)
 }
 
+// SubmitReverseAnswerAudio calls api.v1.QuizService.SubmitReverseAnswerAudio.
+func (c *quizServiceClient) SubmitReverseAnswerAudio(ctx context.Context, req *connect.Request[v1.SubmitReverseAnswerAudioRequest]) (*connect.Response[v1.SubmitReverseAnswerAudioResponse], error) {
+	return c.submitReverseAnswerAudio.CallUnary(ctx, req)
+}
+
 // StartFreeformQuiz calls api.v1.QuizService.StartFreeformQuiz.
 func (c *quizServiceClient) StartFreeformQuiz(ctx context.Context, req *connect.Request[v1.StartFreeformQuizRequest]) (*connect.Response[v1.StartFreeformQuizResponse], error) {
 	return c.startFreeformQuiz.CallUnary(ctx, req)
@@ -448,6 +555,36 @@ func (c *quizServiceClient) ResumeGrammarMistake(ctx context.Context, req *conne
 	return c.resumeGrammarMistake.CallUnary(ctx, req)
 }
 
+// StartDictationQuiz calls api.v1.QuizService.StartDictationQuiz.
+func (c *quizServiceClient) StartDictationQuiz(ctx context.Context, req *connect.Request[v1.StartDictationQuizRequest]) (*connect.Response[v1.StartDictationQuizResponse], error) {
+	return c.startDictationQuiz.CallUnary(ctx, req)
+}
+
+// SubmitDictationAnswer calls api.v1.QuizService.SubmitDictationAnswer.
+func (c *quizServiceClient) SubmitDictationAnswer(ctx context.Context, req *connect.Request[v1.SubmitDictationAnswerRequest]) (*connect.Response[v1.SubmitDictationAnswerResponse], error) {
+	return c.submitDictationAnswer.CallUnary(ctx, req)
+}
+
+// StartWordChoiceQuiz calls api.v1.QuizService.StartWordChoiceQuiz.
+func (c *quizServiceClient) StartWordChoiceQuiz(ctx context.Context, req *connect.Request[v1.StartWordChoiceQuizRequest]) (*connect.Response[v1.StartWordChoiceQuizResponse], error) {
+	return c.startWordChoiceQuiz.CallUnary(ctx, req)
+}
+
+// SubmitWordChoiceAnswer calls api.v1.QuizService.SubmitWordChoiceAnswer.
+func (c *quizServiceClient) SubmitWordChoiceAnswer(ctx context.Context, req *connect.Request[v1.SubmitWordChoiceAnswerRequest]) (*connect.Response[v1.SubmitWordChoiceAnswerResponse], error) {
+	return c.submitWordChoiceAnswer.CallUnary(ctx, req)
+}
+
+// GenerateMnemonic calls api.v1.QuizService.GenerateMnemonic.
+func (c *quizServiceClient) GenerateMnemonic(ctx context.Context, req *connect.Request[v1.GenerateMnemonicRequest]) (*connect.Response[v1.GenerateMnemonicResponse], error) {
+	return c.generateMnemonic.CallUnary(ctx, req)
+}
+
+// SubmitDiscriminationAnswer calls api.v1.QuizService.SubmitDiscriminationAnswer.
+func (c *quizServiceClient) SubmitDiscriminationAnswer(ctx context.Context, req *connect.Request[v1.SubmitDiscriminationAnswerRequest]) (*connect.Response[v1.SubmitDiscriminationAnswerResponse], error) {
+	return c.submitDiscriminationAnswer.CallUnary(ctx, req)
+}
+
 // QuizServiceHandler is an implementation of the api.v1.QuizService service.
 type QuizServiceHandler interface {
 	GetQuizOptions(context.Context, *connect.Request[v1.GetQuizOptionsRequest]) (*connect.Response[v1.GetQuizOptionsResponse], error)
@@ -457,6 +594,11 @@ type QuizServiceHandler interface {
 	StartReverseQuiz(context.Context, *connect.Request[v1.StartReverseQuizRequest]) (*connect.Response[v1.StartReverseQuizResponse], error)
 	SubmitReverseAnswer(context.Context, *connect.Request[v1.SubmitReverseAnswerRequest]) (*connect.Response[v1.SubmitReverseAnswerResponse], error)
 	BatchSubmitReverseAnswers(context.Context, *connect.Request[v1.BatchSubmitReverseAnswersRequest]) (*connect.Response[v1.BatchSubmitReverseAnswersResponse], error)
+	// SubmitReverseAnswerAudio grades a spoken reverse-quiz answer: the audio
+	// is transcribed with the configured speech-to-text service and the
+	// transcript is graded and recorded like a typed SubmitReverseAnswer.
+	// Fails with FAILED_PRECONDITION when no speech-to-text service is set up.
+	SubmitReverseAnswerAudio(context.Context, *connect.Request[v1.SubmitReverseAnswerAudioRequest]) (*connect.Response[v1.SubmitReverseAnswerAudioResponse], error)
 	StartFreeformQuiz(context.Context, *connect.Request[v1.StartFreeformQuizRequest]) (*connect.Response[v1.StartFreeformQuizResponse], error)
 	SubmitFreeformAnswer(context.Context, *connect.Request[v1.SubmitFreeformAnswerRequest]) (*connect.Response[v1.SubmitFreeformAnswerResponse], error)
 	OverrideAnswer(context.Context, *connect.Request[v1.OverrideAnswerRequest]) (*connect.Response[v1.OverrideAnswerResponse], error)
@@ -499,6 +641,33 @@ type QuizServiceHandler interface {
 	ListGrammarMistakes(context.Context, *connect.Request[v1.ListGrammarMistakesRequest]) (*connect.Response[v1.ListGrammarMistakesResponse], error)
 	ExcludeGrammarMistake(context.Context, *connect.Request[v1.ExcludeGrammarMistakeRequest]) (*connect.Response[v1.ExcludeGrammarMistakeResponse], error)
 	ResumeGrammarMistake(context.Context, *connect.Request[v1.ResumeGrammarMistakeRequest]) (*connect.Response[v1.ResumeGrammarMistakeResponse], error)
+	// Dictation Quiz — plays a conversation line containing a story expression
+	// and has the user type what they heard. The answer is graded by word-level
+	// edit distance with the target expression weighted, and recorded in the
+	// expression's dictation learning history. The line's audio is streamed
+	// with NotebookService.StreamNoteAudio.
+	StartDictationQuiz(context.Context, *connect.Request[v1.StartDictationQuizRequest]) (*connect.Response[v1.StartDictationQuizResponse], error)
+	SubmitDictationAnswer(context.Context, *connect.Request[v1.SubmitDictationAnswerRequest]) (*connect.Response[v1.SubmitDictationAnswerResponse], error)
+	// Word Choice Quiz — asks about one member of a synonym or antonym concept
+	// of a definitions book: which member fits the blank of its example
+	// sentence, or, for a member without an example, how it differs from
+	// another member. Answers are graded by the model, falling back to a
+	// deterministic grade, and recorded in the member's word choice learning
+	// history.
+	StartWordChoiceQuiz(context.Context, *connect.Request[v1.StartWordChoiceQuizRequest]) (*connect.Response[v1.StartWordChoiceQuizResponse], error)
+	SubmitWordChoiceAnswer(context.Context, *connect.Request[v1.SubmitWordChoiceAnswerRequest]) (*connect.Response[v1.SubmitWordChoiceAnswerResponse], error)
+	// GenerateMnemonic writes an LLM-generated memory hook into the memo of
+	// the note a quiz card was built from, the same way `langner notebooks
+	// enrich --mnemonics` does. Fails with FAILED_PRECONDITION when the note
+	// already has a memo or has no meaning to hook to.
+	GenerateMnemonic(context.Context, *connect.Request[v1.GenerateMnemonicRequest]) (*connect.Response[v1.GenerateMnemonicResponse], error)
+	// SubmitDiscriminationAnswer reports an answer of the discrimination
+	// drill on a confusion pair (AnalyticsService.GetConfusions). A miss moves
+	// the studied words of the pair into the Relearn Quiz pool for the quiz
+	// types they were confused in, so they are drilled there until answered
+	// correctly, which also resolves the pair. A correct answer changes
+	// nothing.
+	SubmitDiscriminationAnswer(context.Context, *connect.Request[v1.SubmitDiscriminationAnswerRequest]) (*connect.Response[v1.SubmitDiscriminationAnswerResponse], error)
 }
 
 // NewQuizServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -550,6 +719,12 @@ func NewQuizServiceHandler(svc QuizServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(quizServiceMethods.ByName("BatchSubmitReverseAnswers")),
 		connect.WithHandlerOptions(opts...),
 	)
+	quizServiceSubmitReverseAnswerAudioHandler := connect.NewUnaryHandler(
+		QuizServiceSubmitReverseAnswerAudioProcedure,
+		svc.SubmitReverseAnswerAudio,
+		connect.WithSchema(quizServiceMethods.ByName("SubmitReverseAnswerAudio")),
+		connect.WithHandlerOptions(opts...),
+	)
 	quizServiceStartFreeformQuizHandler := connect.NewUnaryHandler(
 		QuizServiceStartFreeformQuizProcedure,
 		svc.StartFreeformQuiz,
@@ -646,6 +821,42 @@ func NewQuizServiceHandler(svc QuizServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(quizServiceMethods.ByName("ResumeGrammarMistake")),
 		connect.WithHandlerOptions(opts...),
 	)
+	quizServiceStartDictationQuizHandler := connect.NewUnaryHandler(
+		QuizServiceStartDictationQuizProcedure,
+		svc.StartDictationQuiz,
+		connect.WithSchema(quizServiceMethods.ByName("StartDictationQuiz")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quizServiceSubmitDictationAnswerHandler := connect.NewUnaryHandler(
+		QuizServiceSubmitDictationAnswerProcedure,
+		svc.SubmitDictationAnswer,
+		connect.WithSchema(quizServiceMethods.ByName("SubmitDictationAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quizServiceStartWordChoiceQuizHandler := connect.NewUnaryHandler(
+		QuizServiceStartWordChoiceQuizProcedure,
+		svc.StartWordChoiceQuiz,
+		connect.WithSchema(quizServiceMethods.ByName("StartWordChoiceQuiz")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quizServiceSubmitWordChoiceAnswerHandler := connect.NewUnaryHandler(
+		QuizServiceSubmitWordChoiceAnswerProcedure,
+		svc.SubmitWordChoiceAnswer,
+		connect.WithSchema(quizServiceMethods.ByName("SubmitWordChoiceAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quizServiceGenerateMnemonicHandler := connect.NewUnaryHandler(
+		QuizServiceGenerateMnemonicProcedure,
+		svc.GenerateMnemonic,
+		connect.WithSchema(quizServiceMethods.ByName("GenerateMnemonic")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quizServiceSubmitDiscriminationAnswerHandler := connect.NewUnaryHandler(
+		QuizServiceSubmitDiscriminationAnswerProcedure,
+		svc.SubmitDiscriminationAnswer,
+		connect.WithSchema(quizServiceMethods.ByName("SubmitDiscriminationAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.QuizService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case QuizServiceGetQuizOptionsProcedure:
@@ -662,6 +873,8 @@ func NewQuizServiceHandler(svc QuizServiceHandler, opts ...connect.HandlerOption
 			quizServiceSubmitReverseAnswerHandler.ServeHTTP(w, r)
 		case QuizServiceBatchSubmitReverseAnswersProcedure:
 			quizServiceBatchSubmitReverseAnswersHandler.ServeHTTP(w, r)
+		case QuizServiceSubmitReverseAnswerAudioProcedure:
+			quizServiceSubmitReverseAnswerAudioHandler.ServeHTTP(w, r)
 		case QuizServiceStartFreeformQuizProcedure:
 			quizServiceStartFreeformQuizHandler.ServeHTTP(w, r)
 		case QuizServiceSubmitFreeformAnswerProcedure:
@@ -694,6 +907,18 @@ func NewQuizServiceHandler(svc QuizServiceHandler, opts ...connect.HandlerOption
 			quizServiceExcludeGrammarMistakeHandler.ServeHTTP(w, r)
 		case QuizServiceResumeGrammarMistakeProcedure:
 			quizServiceResumeGrammarMistakeHandler.ServeHTTP(w, r)
+		case QuizServiceStartDictationQuizProcedure:
+			quizServiceStartDictationQuizHandler.ServeHTTP(w, r)
+		case QuizServiceSubmitDictationAnswerProcedure:
+			quizServiceSubmitDictationAnswerHandler.ServeHTTP(w, r)
+		case QuizServiceStartWordChoiceQuizProcedure:
+			quizServiceStartWordChoiceQuizHandler.ServeHTTP(w, r)
+		case QuizServiceSubmitWordChoiceAnswerProcedure:
+			quizServiceSubmitWordChoiceAnswerHandler.ServeHTTP(w, r)
+		case QuizServiceGenerateMnemonicProcedure:
+			quizServiceGenerateMnemonicHandler.ServeHTTP(w, r)
+		case QuizServiceSubmitDiscriminationAnswerProcedure:
+			quizServiceSubmitDiscriminationAnswerHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -731,6 +956,10 @@ func (UnimplementedQuizServiceHandler) BatchSubmitReverseAnswers(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.BatchSubmitReverseAnswers is not implemented"))
 }
 
+func (UnimplementedQuizServiceHandler) SubmitReverseAnswerAudio(context.Context, *connect.Request[v1.SubmitReverseAnswerAudioRequest]) (*connect.Response[v1.SubmitReverseAnswerAudioResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.SubmitReverseAnswerAudio is not implemented"))
+}
+
 func (UnimplementedQuizServiceHandler) StartFreeformQuiz(context.Context, *connect.Request[v1.StartFreeformQuizRequest]) (*connect.Response[v1.StartFreeformQuizResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.StartFreeformQuiz is not implemented"))
 }
@@ -794,3 +1023,27 @@ func (UnimplementedQuizServiceHandler) ExcludeGrammarMistake(context.Context, *c
 func (UnimplementedQuizServiceHandler) ResumeGrammarMistake(context.Context, *connect.Request[v1.ResumeGrammarMistakeRequest]) (*connect.Response[v1.ResumeGrammarMistakeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.ResumeGrammarMistake is not implemented"))
 }
+
+func (UnimplementedQuizServiceHandler) StartDictationQuiz(context.Context, *connect.Request[v1.StartDictationQuizRequest]) (*connect.Response[v1.StartDictationQuizResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.StartDictationQuiz is not implemented"))
+}
+
+func (UnimplementedQuizServiceHandler) SubmitDictationAnswer(context.Context, *connect.Request[v1.SubmitDictationAnswerRequest]) (*connect.Response[v1.SubmitDictationAnswerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.SubmitDictationAnswer is not implemented"))
+}
+
+func (UnimplementedQuizServiceHandler) StartWordChoiceQuiz(context.Context, *connect.Request[v1.StartWordChoiceQuizRequest]) (*connect.Response[v1.StartWordChoiceQuizResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.StartWordChoiceQuiz is not implemented"))
+}
+
+func (UnimplementedQuizServiceHandler) SubmitWordChoiceAnswer(context.Context, *connect.Request[v1.SubmitWordChoiceAnswerRequest]) (*connect.Response[v1.SubmitWordChoiceAnswerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.SubmitWordChoiceAnswer is not implemented"))
+}
+
+func (UnimplementedQuizServiceHandler) GenerateMnemonic(context.Context, *connect.Request[v1.GenerateMnemonicRequest]) (*connect.Response[v1.GenerateMnemonicResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.GenerateMnemonic is not implemented"))
+}
+
+func (UnimplementedQuizServiceHandler) SubmitDiscriminationAnswer(context.Context, *connect.Request[v1.SubmitDiscriminationAnswerRequest]) (*connect.Response[v1.SubmitDiscriminationAnswerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.QuizService.SubmitDiscriminationAnswer is not implemented"))
+}
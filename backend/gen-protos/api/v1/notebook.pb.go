@@ -135,11 +135,13 @@ func (x *GetNotebookDetailResponse) GetTotalWordCount() int32 {
 }
 
 type StoryEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Metadata      *StoryMetadata         `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Scenes        []*StoryScene          `protobuf:"bytes,4,rep,name=scenes,proto3" json:"scenes,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Event    string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Metadata *StoryMetadata         `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Date     string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Scenes   []*StoryScene          `protobuf:"bytes,4,rep,name=scenes,proto3" json:"scenes,omitempty"`
+	// youtube_url is the video the story was taken from. Empty when none.
+	YoutubeUrl    string `protobuf:"bytes,5,opt,name=youtube_url,json=youtubeUrl,proto3" json:"youtube_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -202,6 +204,13 @@ func (x *StoryEntry) GetScenes() []*StoryScene {
 	return nil
 }
 
+func (x *StoryEntry) GetYoutubeUrl() string {
+	if x != nil {
+		return x.YoutubeUrl
+	}
+	return ""
+}
+
 type StoryMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Series        string                 `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
@@ -331,9 +340,13 @@ func (x *StoryScene) GetStatements() []string {
 }
 
 type Conversation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Speaker       string                 `protobuf:"bytes,1,opt,name=speaker,proto3" json:"speaker,omitempty"`
-	Quote         string                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Speaker string                 `protobuf:"bytes,1,opt,name=speaker,proto3" json:"speaker,omitempty"`
+	Quote   string                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	// time_seconds is when the line is said in the story's video, and
+	// youtube_url links to that moment. Zero and empty when unknown.
+	TimeSeconds   int32  `protobuf:"varint,3,opt,name=time_seconds,json=timeSeconds,proto3" json:"time_seconds,omitempty"`
+	YoutubeUrl    string `protobuf:"bytes,4,opt,name=youtube_url,json=youtubeUrl,proto3" json:"youtube_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -382,6 +395,20 @@ func (x *Conversation) GetQuote() string {
 	return ""
 }
 
+func (x *Conversation) GetTimeSeconds() int32 {
+	if x != nil {
+		return x.TimeSeconds
+	}
+	return 0
+}
+
+func (x *Conversation) GetYoutubeUrl() string {
+	if x != nil {
+		return x.YoutubeUrl
+	}
+	return ""
+}
+
 type NotebookWord struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Expression     string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
@@ -417,8 +444,26 @@ type NotebookWord struct {
 	ConceptHead    string   `protobuf:"bytes,17,opt,name=concept_head,json=conceptHead,proto3" json:"concept_head,omitempty"`
 	ConceptMembers []string `protobuf:"bytes,18,rep,name=concept_members,json=conceptMembers,proto3" json:"concept_members,omitempty"`
 	ConceptMeaning string   `protobuf:"bytes,19,opt,name=concept_meaning,json=conceptMeaning,proto3" json:"concept_meaning,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// audio is the note's pronunciation audio, relative to its notebook.
+	// Pass it to StreamNoteAudio to play it. Empty when the note has none.
+	Audio string `protobuf:"bytes,20,opt,name=audio,proto3" json:"audio,omitempty"`
+	// time_seconds is when the word is said in the story's video, and
+	// youtube_url links to that moment, so reviewing it can jump there.
+	// Zero and empty when unknown.
+	TimeSeconds int32  `protobuf:"varint,21,opt,name=time_seconds,json=timeSeconds,proto3" json:"time_seconds,omitempty"`
+	YoutubeUrl  string `protobuf:"bytes,22,opt,name=youtube_url,json=youtubeUrl,proto3" json:"youtube_url,omitempty"`
+	// is_leech is true when the word keeps failing in at least one quiz
+	// type (see GetLeeches); leech_quiz_types lists those quiz types.
+	IsLeech        bool     `protobuf:"varint,23,opt,name=is_leech,json=isLeech,proto3" json:"is_leech,omitempty"`
+	LeechQuizTypes []string `protobuf:"bytes,24,rep,name=leech_quiz_types,json=leechQuizTypes,proto3" json:"leech_quiz_types,omitempty"`
+	// frequency_band is how common the word is: "very_common" (about the
+	// 1,000 most frequent words), "common" (up to 5,000), "uncommon" (up to
+	// 20,000) or "rare". Empty when unknown. frequency_rank is its rank in
+	// the configured frequency list, 0 when not listed.
+	FrequencyBand string `protobuf:"bytes,25,opt,name=frequency_band,json=frequencyBand,proto3" json:"frequency_band,omitempty"`
+	FrequencyRank int32  `protobuf:"varint,26,opt,name=frequency_rank,json=frequencyRank,proto3" json:"frequency_rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NotebookWord) Reset() {
@@ -577,6 +622,55 @@ func (x *NotebookWord) GetConceptMeaning() string {
 	return ""
 }
 
+func (x *NotebookWord) GetAudio() string {
+	if x != nil {
+		return x.Audio
+	}
+	return ""
+}
+
+func (x *NotebookWord) GetTimeSeconds() int32 {
+	if x != nil {
+		return x.TimeSeconds
+	}
+	return 0
+}
+
+func (x *NotebookWord) GetYoutubeUrl() string {
+	if x != nil {
+		return x.YoutubeUrl
+	}
+	return ""
+}
+
+func (x *NotebookWord) GetIsLeech() bool {
+	if x != nil {
+		return x.IsLeech
+	}
+	return false
+}
+
+func (x *NotebookWord) GetLeechQuizTypes() []string {
+	if x != nil {
+		return x.LeechQuizTypes
+	}
+	return nil
+}
+
+func (x *NotebookWord) GetFrequencyBand() string {
+	if x != nil {
+		return x.FrequencyBand
+	}
+	return ""
+}
+
+func (x *NotebookWord) GetFrequencyRank() int32 {
+	if x != nil {
+		return x.FrequencyRank
+	}
+	return 0
+}
+
 type LearningLogEntry struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -910,10 +1004,14 @@ func (x *WordDefinition) GetOrigin() string {
 }
 
 type LookupWordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
-	Definitions   []*WordDefinition      `protobuf:"bytes,2,rep,name=definitions,proto3" json:"definitions,omitempty"`
-	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Word        string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Definitions []*WordDefinition      `protobuf:"bytes,2,rep,name=definitions,proto3" json:"definitions,omitempty"`
+	Source      string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// frequency_band and frequency_rank tell how common the word is, as in
+	// NotebookWord, so the reader can point out the words worth saving.
+	FrequencyBand string `protobuf:"bytes,4,opt,name=frequency_band,json=frequencyBand,proto3" json:"frequency_band,omitempty"`
+	FrequencyRank int32  `protobuf:"varint,5,opt,name=frequency_rank,json=frequencyRank,proto3" json:"frequency_rank,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -969,6 +1067,20 @@ func (x *LookupWordResponse) GetSource() string {
 	return ""
 }
 
+func (x *LookupWordResponse) GetFrequencyBand() string {
+	if x != nil {
+		return x.FrequencyBand
+	}
+	return ""
+}
+
+func (x *LookupWordResponse) GetFrequencyRank() int32 {
+	if x != nil {
+		return x.FrequencyRank
+	}
+	return 0
+}
+
 type RegisterDefinitionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NotebookId    string                 `protobuf:"bytes,1,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
@@ -1914,185 +2026,1167 @@ func (x *GetEtymologyNotebookResponse) GetConcepts() []*SemanticConcept {
 	return nil
 }
 
-var File_api_v1_notebook_proto protoreflect.FileDescriptor
+// StreamNoteAudioRequest names the audio of a note: notebook_id and the
+// note's audio path as returned in NotebookWord.audio.
+type StreamNoteAudioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NotebookId    string                 `protobuf:"bytes,1,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	Audio         string                 `protobuf:"bytes,2,opt,name=audio,proto3" json:"audio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_v1_notebook_proto_rawDesc = "" +
-	"\n" +
-	"\x15api/v1/notebook.proto\x12\x06api.v1\x1a\x1bbuf/validate/validate.proto\"D\n" +
-	"\x18GetNotebookDetailRequest\x12(\n" +
-	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
-	"notebookId\"\xa8\x01\n" +
-	"\x19GetNotebookDetailResponse\x12\x1f\n" +
-	"\vnotebook_id\x18\x01 \x01(\tR\n" +
-	"notebookId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
-	"\astories\x18\x03 \x03(\v2\x12.api.v1.StoryEntryR\astories\x12(\n" +
-	"\x10total_word_count\x18\x04 \x01(\x05R\x0etotalWordCount\"\x95\x01\n" +
-	"\n" +
-	"StoryEntry\x12\x14\n" +
-	"\x05event\x18\x01 \x01(\tR\x05event\x121\n" +
-	"\bmetadata\x18\x02 \x01(\v2\x15.api.v1.StoryMetadataR\bmetadata\x12\x12\n" +
-	"\x04date\x18\x03 \x01(\tR\x04date\x12*\n" +
-	"\x06scenes\x18\x04 \x03(\v2\x12.api.v1.StorySceneR\x06scenes\"Y\n" +
-	"\rStoryMetadata\x12\x16\n" +
-	"\x06series\x18\x01 \x01(\tR\x06series\x12\x16\n" +
-	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x18\n" +
-	"\aepisode\x18\x03 \x01(\x05R\aepisode\"\xb6\x01\n" +
-	"\n" +
-	"StoryScene\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12:\n" +
-	"\rconversations\x18\x02 \x03(\v2\x14.api.v1.ConversationR\rconversations\x126\n" +
-	"\vdefinitions\x18\x03 \x03(\v2\x14.api.v1.NotebookWordR\vdefinitions\x12\x1e\n" +
-	"\n" +
-	"statements\x18\x05 \x03(\tR\n" +
-	"statements\">\n" +
-	"\fConversation\x12\x18\n" +
-	"\aspeaker\x18\x01 \x01(\tR\aspeaker\x12\x14\n" +
-	"\x05quote\x18\x02 \x01(\tR\x05quote\"\x91\x05\n" +
-	"\fNotebookWord\x12\x1e\n" +
-	"\n" +
-	"expression\x18\x01 \x01(\tR\n" +
-	"expression\x12\x1e\n" +
-	"\n" +
-	"definition\x18\x02 \x01(\tR\n" +
-	"definition\x12\x18\n" +
-	"\ameaning\x18\x03 \x01(\tR\ameaning\x12$\n" +
-	"\x0epart_of_speech\x18\x04 \x01(\tR\fpartOfSpeech\x12$\n" +
-	"\rpronunciation\x18\x05 \x01(\tR\rpronunciation\x12\x1a\n" +
-	"\bexamples\x18\x06 \x03(\tR\bexamples\x12\x1a\n" +
-	"\bsynonyms\x18\a \x03(\tR\bsynonyms\x12\x1a\n" +
-	"\bantonyms\x18\b \x03(\tR\bantonyms\x12'\n" +
-	"\x0flearning_status\x18\t \x01(\tR\x0elearningStatus\x12;\n" +
-	"\flearned_logs\x18\n" +
-	" \x03(\v2\x18.api.v1.LearningLogEntryR\vlearnedLogs\x12(\n" +
-	"\x10next_review_date\x18\f \x01(\tR\x0enextReviewDate\x12\x16\n" +
-	"\x06origin\x18\r \x01(\tR\x06origin\x12\x1d\n" +
-	"\n" +
-	"is_skipped\x18\x0e \x01(\bR\tisSkipped\x12,\n" +
-	"\x12skipped_quiz_types\x18\x0f \x03(\tR\x10skippedQuizTypes\x12\x17\n" +
-	"\anote_id\x18\x10 \x01(\x03R\x06noteId\x12!\n" +
-	"\fconcept_head\x18\x11 \x01(\tR\vconceptHead\x12'\n" +
-	"\x0fconcept_members\x18\x12 \x03(\tR\x0econceptMembers\x12'\n" +
-	"\x0fconcept_meaning\x18\x13 \x01(\tR\x0econceptMeaningJ\x04\b\v\x10\f\"\xcf\x01\n" +
-	"\x10LearningLogEntry\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
-	"\n" +
-	"learned_at\x18\x02 \x01(\tR\tlearnedAt\x12\x18\n" +
-	"\aquality\x18\x03 \x01(\x05R\aquality\x12(\n" +
-	"\x10response_time_ms\x18\x04 \x01(\x03R\x0eresponseTimeMs\x12\x1b\n" +
-	"\tquiz_type\x18\x05 \x01(\tR\bquizType\x12#\n" +
-	"\rinterval_days\x18\x06 \x01(\x05R\fintervalDays\"D\n" +
-	"\x18ExportNotebookPDFRequest\x12(\n" +
-	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
-	"notebookId\"X\n" +
-	"\x19ExportNotebookPDFResponse\x12\x1f\n" +
-	"\vpdf_content\x18\x01 \x01(\fR\n" +
-	"pdfContent\x12\x1a\n" +
-	"\bfilename\x18\x02 \x01(\tR\bfilename\"k\n" +
-	"\x11LookupWordRequest\x12\x1b\n" +
-	"\x04word\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04word\x12\x1f\n" +
-	"\vnotebook_id\x18\x02 \x01(\tR\n" +
-	"notebookId\x12\x18\n" +
-	"\acontext\x18\x03 \x01(\tR\acontext\"\xe8\x01\n" +
-	"\x0eWordDefinition\x12$\n" +
-	"\x0epart_of_speech\x18\x01 \x01(\tR\fpartOfSpeech\x12\x1e\n" +
-	"\n" +
-	"definition\x18\x02 \x01(\tR\n" +
-	"definition\x12\x1a\n" +
-	"\bexamples\x18\x03 \x03(\tR\bexamples\x12\x1a\n" +
-	"\bsynonyms\x18\x04 \x03(\tR\bsynonyms\x12$\n" +
-	"\rpronunciation\x18\x05 \x01(\tR\rpronunciation\x12\x1a\n" +
-	"\bantonyms\x18\x06 \x03(\tR\bantonyms\x12\x16\n" +
-	"\x06origin\x18\a \x01(\tR\x06origin\"z\n" +
-	"\x12LookupWordResponse\x12\x12\n" +
-	"\x04word\x18\x01 \x01(\tR\x04word\x128\n" +
-	"\vdefinitions\x18\x02 \x03(\v2\x16.api.v1.WordDefinitionR\vdefinitions\x12\x16\n" +
-	"\x06source\x18\x03 \x01(\tR\x06source\"\x99\x02\n" +
-	"\x19RegisterDefinitionRequest\x12(\n" +
-	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
-	"notebookId\x12#\n" +
-	"\rnotebook_file\x18\x02 \x01(\tR\fnotebookFile\x12\x1f\n" +
-	"\vscene_index\x18\x03 \x01(\x05R\n" +
-	"sceneIndex\x12'\n" +
-	"\n" +
-	"expression\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
-	"expression\x12!\n" +
-	"\ameaning\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\ameaning\x12$\n" +
-	"\x0epart_of_speech\x18\x06 \x01(\tR\fpartOfSpeech\x12\x1a\n" +
-	"\bexamples\x18\a \x03(\tR\bexamples\"\x1c\n" +
-	"\x1aRegisterDefinitionResponse\"\xb2\x01\n" +
-	"\x17DeleteDefinitionRequest\x12(\n" +
-	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
-	"notebookId\x12#\n" +
-	"\rnotebook_file\x18\x02 \x01(\tR\fnotebookFile\x12\x1f\n" +
-	"\vscene_index\x18\x03 \x01(\x05R\n" +
-	"sceneIndex\x12'\n" +
-	"\n" +
-	"expression\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
-	"expression\"\x1a\n" +
-	"\x18DeleteDefinitionResponse\"Q\n" +
-	"\x13EtymologyOriginForm\x12\x12\n" +
-	"\x04form\x18\x01 \x01(\tR\x04form\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role\x12\x12\n" +
-	"\x04note\x18\x03 \x01(\tR\x04note\"\xae\x02\n" +
-	"\x13EtymologyOriginPart\x12\x16\n" +
-	"\x06origin\x18\x01 \x01(\tR\x06origin\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
-	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x18\n" +
-	"\ameaning\x18\x04 \x01(\tR\ameaning\x12\x1d\n" +
-	"\n" +
-	"word_count\x18\x05 \x01(\x05R\twordCount\x121\n" +
-	"\x05forms\x18\x06 \x03(\v2\x1b.api.v1.EtymologyOriginFormR\x05forms\x12\x1b\n" +
-	"\tfrom_form\x18\a \x01(\tR\bfromForm\x12!\n" +
-	"\fconcept_keys\x18\b \x03(\tR\vconceptKeys\x12#\n" +
-	"\rsession_title\x18\t \x01(\tR\fsessionTitle\"\x8c\x03\n" +
-	"\x13EtymologyDefinition\x12\x1e\n" +
-	"\n" +
-	"expression\x18\x01 \x01(\tR\n" +
-	"expression\x12\x18\n" +
-	"\ameaning\x18\x02 \x01(\tR\ameaning\x12$\n" +
-	"\x0epart_of_speech\x18\x03 \x01(\tR\fpartOfSpeech\x12\x12\n" +
-	"\x04note\x18\x04 \x01(\tR\x04note\x12>\n" +
-	"\forigin_parts\x18\x05 \x03(\v2\x1b.api.v1.EtymologyOriginPartR\voriginParts\x12#\n" +
-	"\rnotebook_name\x18\x06 \x01(\tR\fnotebookName\x12\x1a\n" +
-	"\bexamples\x18\a \x03(\tR\bexamples\x12\x1a\n" +
-	"\bcontexts\x18\b \x03(\tR\bcontexts\x12\x1d\n" +
-	"\n" +
-	"is_skipped\x18\t \x01(\bR\tisSkipped\x12,\n" +
-	"\x12skipped_quiz_types\x18\n" +
-	" \x03(\tR\x10skippedQuizTypes\x12\x17\n" +
-	"\anote_id\x18\v \x01(\x03R\x06noteId\"h\n" +
-	"\x15EtymologyMeaningGroup\x12\x18\n" +
-	"\ameaning\x18\x01 \x01(\tR\ameaning\x125\n" +
-	"\aorigins\x18\x02 \x03(\v2\x1b.api.v1.EtymologyOriginPartR\aorigins\"G\n" +
-	"\x1bGetEtymologyNotebookRequest\x12(\n" +
-	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
-	"notebookId\"q\n" +
-	"\x15SemanticConceptMember\x123\n" +
-	"\x06origin\x18\x01 \x01(\v2\x1b.api.v1.EtymologyOriginPartR\x06origin\x12#\n" +
-	"\rsession_title\x18\x02 \x01(\tR\fsessionTitle\"\x96\x01\n" +
-	"\x0fConceptRelation\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
-	"\vis_directed\x18\x02 \x01(\bR\n" +
-	"isDirected\x12(\n" +
-	"\x10from_concept_key\x18\x03 \x01(\tR\x0efromConceptKey\x12$\n" +
-	"\x0eto_concept_key\x18\x04 \x01(\tR\ftoConceptKey\"\x82\x02\n" +
-	"\x0fSemanticConcept\x12\x1f\n" +
-	"\vnotebook_id\x18\x01 \x01(\tR\n" +
-	"notebookId\x12\x1f\n" +
-	"\vconcept_key\x18\x02 \x01(\tR\n" +
-	"conceptKey\x12\x18\n" +
-	"\ameaning\x18\x03 \x01(\tR\ameaning\x12\x12\n" +
-	"\x04note\x18\x04 \x01(\tR\x04note\x127\n" +
-	"\amembers\x18\x05 \x03(\v2\x1d.api.v1.SemanticConceptMemberR\amembers\x12F\n" +
-	"\x12outgoing_relations\x18\x06 \x03(\v2\x17.api.v1.ConceptRelationR\x11outgoingRelations\"\xdd\x02\n" +
-	"\x1cGetEtymologyNotebookResponse\x125\n" +
-	"\aorigins\x18\x01 \x03(\v2\x1b.api.v1.EtymologyOriginPartR\aorigins\x12=\n" +
-	"\vdefinitions\x18\x02 \x03(\v2\x1b.api.v1.EtymologyDefinitionR\vdefinitions\x12D\n" +
-	"\x0emeaning_groups\x18\x03 \x03(\v2\x1d.api.v1.EtymologyMeaningGroupR\rmeaningGroups\x12!\n" +
-	"\forigin_count\x18\x04 \x01(\x05R\voriginCount\x12)\n" +
-	"\x10definition_count\x18\x05 \x01(\x05R\x0fdefinitionCount\x123\n" +
-	"\bconcepts\x18\x06 \x03(\v2\x17.api.v1.SemanticConceptR\bconcepts2\xa1\x04\n" +
+func (x *StreamNoteAudioRequest) Reset() {
+	*x = StreamNoteAudioRequest{}
+	mi := &file_api_v1_notebook_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNoteAudioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNoteAudioRequest) ProtoMessage() {}
+
+func (x *StreamNoteAudioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNoteAudioRequest.ProtoReflect.Descriptor instead.
+func (*StreamNoteAudioRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StreamNoteAudioRequest) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
+func (x *StreamNoteAudioRequest) GetAudio() string {
+	if x != nil {
+		return x.Audio
+	}
+	return ""
+}
+
+// StreamNoteAudioResponse is one chunk of the audio. The first chunk
+// carries the media type, e.g. "audio/wav".
+type StreamNoteAudioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNoteAudioResponse) Reset() {
+	*x = StreamNoteAudioResponse{}
+	mi := &file_api_v1_notebook_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNoteAudioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNoteAudioResponse) ProtoMessage() {}
+
+func (x *StreamNoteAudioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNoteAudioResponse.ProtoReflect.Descriptor instead.
+func (*StreamNoteAudioResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StreamNoteAudioResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *StreamNoteAudioResponse) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+type GetEtymologyGraphRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NotebookId    string                 `protobuf:"bytes,1,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEtymologyGraphRequest) Reset() {
+	*x = GetEtymologyGraphRequest{}
+	mi := &file_api_v1_notebook_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEtymologyGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEtymologyGraphRequest) ProtoMessage() {}
+
+func (x *GetEtymologyGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEtymologyGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetEtymologyGraphRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetEtymologyGraphRequest) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
+// GetEtymologyGraphResponse lists origins, then words, then concepts as
+// nodes, and the edges between them.
+type GetEtymologyGraphResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*EtymologyGraphNode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*EtymologyGraphEdge  `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEtymologyGraphResponse) Reset() {
+	*x = GetEtymologyGraphResponse{}
+	mi := &file_api_v1_notebook_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEtymologyGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEtymologyGraphResponse) ProtoMessage() {}
+
+func (x *GetEtymologyGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEtymologyGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetEtymologyGraphResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetEtymologyGraphResponse) GetNodes() []*EtymologyGraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetEtymologyGraphResponse) GetEdges() []*EtymologyGraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+// EtymologyGraphNode is an origin sense, a word whose origin_parts bind to
+// one, or a concept.
+type EtymologyGraphNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// kind is "origin", "word" or "concept".
+	Kind    string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Label   string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Meaning string `protobuf:"bytes,4,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	// language and origin_type are set on origins only.
+	Language   string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	OriginType string `protobuf:"bytes,6,opt,name=origin_type,json=originType,proto3" json:"origin_type,omitempty"`
+	// session_title is the session an origin or word was declared in.
+	SessionTitle string `protobuf:"bytes,7,opt,name=session_title,json=sessionTitle,proto3" json:"session_title,omitempty"`
+	// notebook_id is the notebook a word is defined in.
+	NotebookId    string `protobuf:"bytes,8,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtymologyGraphNode) Reset() {
+	*x = EtymologyGraphNode{}
+	mi := &file_api_v1_notebook_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtymologyGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtymologyGraphNode) ProtoMessage() {}
+
+func (x *EtymologyGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtymologyGraphNode.ProtoReflect.Descriptor instead.
+func (*EtymologyGraphNode) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EtymologyGraphNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EtymologyGraphNode) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EtymologyGraphNode) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *EtymologyGraphNode) GetMeaning() string {
+	if x != nil {
+		return x.Meaning
+	}
+	return ""
+}
+
+func (x *EtymologyGraphNode) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *EtymologyGraphNode) GetOriginType() string {
+	if x != nil {
+		return x.OriginType
+	}
+	return ""
+}
+
+func (x *EtymologyGraphNode) GetSessionTitle() string {
+	if x != nil {
+		return x.SessionTitle
+	}
+	return ""
+}
+
+func (x *EtymologyGraphNode) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
+// EtymologyGraphEdge connects two nodes by id.
+type EtymologyGraphEdge struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Source string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// kind is "origin_part" (word to origin), "member" (concept to origin) or
+	// "relation" (concept to concept).
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// label is the relation type of a relation edge and the from_form of an
+	// origin part edge.
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// directed is false for relations declared with between.
+	Directed      bool `protobuf:"varint,5,opt,name=directed,proto3" json:"directed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtymologyGraphEdge) Reset() {
+	*x = EtymologyGraphEdge{}
+	mi := &file_api_v1_notebook_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtymologyGraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtymologyGraphEdge) ProtoMessage() {}
+
+func (x *EtymologyGraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtymologyGraphEdge.ProtoReflect.Descriptor instead.
+func (*EtymologyGraphEdge) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EtymologyGraphEdge) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *EtymologyGraphEdge) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *EtymologyGraphEdge) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EtymologyGraphEdge) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *EtymologyGraphEdge) GetDirected() bool {
+	if x != nil {
+		return x.Directed
+	}
+	return false
+}
+
+type SearchNotebooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// notebook_ids limits the search to these notebooks; empty searches all.
+	NotebookIds []string `protobuf:"bytes,2,rep,name=notebook_ids,json=notebookIds,proto3" json:"notebook_ids,omitempty"`
+	// limit caps the occurrences returned; 0 returns all.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotebooksRequest) Reset() {
+	*x = SearchNotebooksRequest{}
+	mi := &file_api_v1_notebook_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotebooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotebooksRequest) ProtoMessage() {}
+
+func (x *SearchNotebooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotebooksRequest.ProtoReflect.Descriptor instead.
+func (*SearchNotebooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchNotebooksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchNotebooksRequest) GetNotebookIds() []string {
+	if x != nil {
+		return x.NotebookIds
+	}
+	return nil
+}
+
+func (x *SearchNotebooksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchNotebooksResponse lists the occurrences in notebook order. total
+// counts every match, including those past the limit.
+type SearchNotebooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Occurrences   []*NotebookOccurrence  `protobuf:"bytes,1,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotebooksResponse) Reset() {
+	*x = SearchNotebooksResponse{}
+	mi := &file_api_v1_notebook_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotebooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotebooksResponse) ProtoMessage() {}
+
+func (x *SearchNotebooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotebooksResponse.ProtoReflect.Descriptor instead.
+func (*SearchNotebooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchNotebooksResponse) GetOccurrences() []*NotebookOccurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+func (x *SearchNotebooksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// NotebookOccurrence is a part of a notebook that mentions the searched
+// word.
+type NotebookOccurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is "conversation", "statement", "note" or "example".
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	NotebookId    string `protobuf:"bytes,2,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	NotebookTitle string `protobuf:"bytes,3,opt,name=notebook_title,json=notebookTitle,proto3" json:"notebook_title,omitempty"`
+	// story_title is the story event, flashcard deck title or definitions
+	// session.
+	StoryTitle string `protobuf:"bytes,4,opt,name=story_title,json=storyTitle,proto3" json:"story_title,omitempty"`
+	SceneTitle string `protobuf:"bytes,5,opt,name=scene_title,json=sceneTitle,proto3" json:"scene_title,omitempty"`
+	Speaker    string `protobuf:"bytes,6,opt,name=speaker,proto3" json:"speaker,omitempty"`
+	// text is the line or example sentence; empty for notes.
+	Text string `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
+	// expression and meaning are the note, or the note an example belongs to.
+	Expression string `protobuf:"bytes,8,opt,name=expression,proto3" json:"expression,omitempty"`
+	Meaning    string `protobuf:"bytes,9,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	// highlight is the matched text as written, e.g. "ran into" for "run
+	// into".
+	Highlight     string `protobuf:"bytes,10,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotebookOccurrence) Reset() {
+	*x = NotebookOccurrence{}
+	mi := &file_api_v1_notebook_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotebookOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotebookOccurrence) ProtoMessage() {}
+
+func (x *NotebookOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotebookOccurrence.ProtoReflect.Descriptor instead.
+func (*NotebookOccurrence) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *NotebookOccurrence) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NotebookOccurrence) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
+func (x *NotebookOccurrence) GetNotebookTitle() string {
+	if x != nil {
+		return x.NotebookTitle
+	}
+	return ""
+}
+
+func (x *NotebookOccurrence) GetStoryTitle() string {
+	if x != nil {
+		return x.StoryTitle
+	}
+	return ""
+}
+
+func (x *NotebookOccurrence) GetSceneTitle() string {
+	if x != nil {
+		return x.SceneTitle
+	}
+	return ""
+}
+
+func (x *NotebookOccurrence) GetSpeaker() string {
+	if x != nil {
+		return x.Speaker
+	}
+	return ""
+}
+
+func (x *NotebookOccurrence) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *NotebookOccurrence) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *NotebookOccurrence) GetMeaning() string {
+	if x != nil {
+		return x.Meaning
+	}
+	return ""
+}
+
+func (x *NotebookOccurrence) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
+type SearchPassagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is the words to find, each in any inflected form. Words in
+	// double quotes must appear together as a phrase, where "one's" stands
+	// for any possessive.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// source_ids limits the search to these ebooks and story notebooks;
+	// empty searches all.
+	SourceIds []string `protobuf:"bytes,2,rep,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+	// page_size is the number of hits per page, 20 when 0.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page; empty for the
+	// first page.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPassagesRequest) Reset() {
+	*x = SearchPassagesRequest{}
+	mi := &file_api_v1_notebook_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPassagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPassagesRequest) ProtoMessage() {}
+
+func (x *SearchPassagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPassagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchPassagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchPassagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPassagesRequest) GetSourceIds() []string {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+func (x *SearchPassagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPassagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchPassagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Hits  []*PassageHit          `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// total counts the hits on every page.
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// next_page_token fetches the next page; empty on the last one.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPassagesResponse) Reset() {
+	*x = SearchPassagesResponse{}
+	mi := &file_api_v1_notebook_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPassagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPassagesResponse) ProtoMessage() {}
+
+func (x *SearchPassagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPassagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchPassagesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SearchPassagesResponse) GetHits() []*PassageHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchPassagesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchPassagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// PassageHit is an ebook paragraph or story line matching a search.
+type PassageHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is "book" or "story".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// source_id is the ebook or story notebook ID. An ebook's ID is also the
+	// ID of the book notebook generated from it.
+	SourceId    string `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SourceTitle string `protobuf:"bytes,3,opt,name=source_title,json=sourceTitle,proto3" json:"source_title,omitempty"`
+	// section is the chapter title of a book or the event of a story.
+	Section string `protobuf:"bytes,4,opt,name=section,proto3" json:"section,omitempty"`
+	Scene   string `protobuf:"bytes,5,opt,name=scene,proto3" json:"scene,omitempty"`
+	Speaker string `protobuf:"bytes,6,opt,name=speaker,proto3" json:"speaker,omitempty"`
+	// position is the 1-based number of the paragraph in its chapter, or of
+	// the line in its scene.
+	Position int32 `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	// snippet is the text around the best match with every matched word in
+	// {{ }} markers, and "…" where the text was cut.
+	Snippet       string  `protobuf:"bytes,8,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Score         float64 `protobuf:"fixed64,9,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PassageHit) Reset() {
+	*x = PassageHit{}
+	mi := &file_api_v1_notebook_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PassageHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassageHit) ProtoMessage() {}
+
+func (x *PassageHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notebook_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassageHit.ProtoReflect.Descriptor instead.
+func (*PassageHit) Descriptor() ([]byte, []int) {
+	return file_api_v1_notebook_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PassageHit) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PassageHit) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *PassageHit) GetSourceTitle() string {
+	if x != nil {
+		return x.SourceTitle
+	}
+	return ""
+}
+
+func (x *PassageHit) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *PassageHit) GetScene() string {
+	if x != nil {
+		return x.Scene
+	}
+	return ""
+}
+
+func (x *PassageHit) GetSpeaker() string {
+	if x != nil {
+		return x.Speaker
+	}
+	return ""
+}
+
+func (x *PassageHit) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PassageHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *PassageHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+var File_api_v1_notebook_proto protoreflect.FileDescriptor
+
+const file_api_v1_notebook_proto_rawDesc = "" +
+	"\n" +
+	"\x15api/v1/notebook.proto\x12\x06api.v1\x1a\x1bbuf/validate/validate.proto\"D\n" +
+	"\x18GetNotebookDetailRequest\x12(\n" +
+	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"notebookId\"\xa8\x01\n" +
+	"\x19GetNotebookDetailResponse\x12\x1f\n" +
+	"\vnotebook_id\x18\x01 \x01(\tR\n" +
+	"notebookId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\astories\x18\x03 \x03(\v2\x12.api.v1.StoryEntryR\astories\x12(\n" +
+	"\x10total_word_count\x18\x04 \x01(\x05R\x0etotalWordCount\"\xb6\x01\n" +
+	"\n" +
+	"StoryEntry\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x121\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x15.api.v1.StoryMetadataR\bmetadata\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12*\n" +
+	"\x06scenes\x18\x04 \x03(\v2\x12.api.v1.StorySceneR\x06scenes\x12\x1f\n" +
+	"\vyoutube_url\x18\x05 \x01(\tR\n" +
+	"youtubeUrl\"Y\n" +
+	"\rStoryMetadata\x12\x16\n" +
+	"\x06series\x18\x01 \x01(\tR\x06series\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x18\n" +
+	"\aepisode\x18\x03 \x01(\x05R\aepisode\"\xb6\x01\n" +
+	"\n" +
+	"StoryScene\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12:\n" +
+	"\rconversations\x18\x02 \x03(\v2\x14.api.v1.ConversationR\rconversations\x126\n" +
+	"\vdefinitions\x18\x03 \x03(\v2\x14.api.v1.NotebookWordR\vdefinitions\x12\x1e\n" +
+	"\n" +
+	"statements\x18\x05 \x03(\tR\n" +
+	"statements\"\x82\x01\n" +
+	"\fConversation\x12\x18\n" +
+	"\aspeaker\x18\x01 \x01(\tR\aspeaker\x12\x14\n" +
+	"\x05quote\x18\x02 \x01(\tR\x05quote\x12!\n" +
+	"\ftime_seconds\x18\x03 \x01(\x05R\vtimeSeconds\x12\x1f\n" +
+	"\vyoutube_url\x18\x04 \x01(\tR\n" +
+	"youtubeUrl\"\xfe\x06\n" +
+	"\fNotebookWord\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x12\x1e\n" +
+	"\n" +
+	"definition\x18\x02 \x01(\tR\n" +
+	"definition\x12\x18\n" +
+	"\ameaning\x18\x03 \x01(\tR\ameaning\x12$\n" +
+	"\x0epart_of_speech\x18\x04 \x01(\tR\fpartOfSpeech\x12$\n" +
+	"\rpronunciation\x18\x05 \x01(\tR\rpronunciation\x12\x1a\n" +
+	"\bexamples\x18\x06 \x03(\tR\bexamples\x12\x1a\n" +
+	"\bsynonyms\x18\a \x03(\tR\bsynonyms\x12\x1a\n" +
+	"\bantonyms\x18\b \x03(\tR\bantonyms\x12'\n" +
+	"\x0flearning_status\x18\t \x01(\tR\x0elearningStatus\x12;\n" +
+	"\flearned_logs\x18\n" +
+	" \x03(\v2\x18.api.v1.LearningLogEntryR\vlearnedLogs\x12(\n" +
+	"\x10next_review_date\x18\f \x01(\tR\x0enextReviewDate\x12\x16\n" +
+	"\x06origin\x18\r \x01(\tR\x06origin\x12\x1d\n" +
+	"\n" +
+	"is_skipped\x18\x0e \x01(\bR\tisSkipped\x12,\n" +
+	"\x12skipped_quiz_types\x18\x0f \x03(\tR\x10skippedQuizTypes\x12\x17\n" +
+	"\anote_id\x18\x10 \x01(\x03R\x06noteId\x12!\n" +
+	"\fconcept_head\x18\x11 \x01(\tR\vconceptHead\x12'\n" +
+	"\x0fconcept_members\x18\x12 \x03(\tR\x0econceptMembers\x12'\n" +
+	"\x0fconcept_meaning\x18\x13 \x01(\tR\x0econceptMeaning\x12\x14\n" +
+	"\x05audio\x18\x14 \x01(\tR\x05audio\x12!\n" +
+	"\ftime_seconds\x18\x15 \x01(\x05R\vtimeSeconds\x12\x1f\n" +
+	"\vyoutube_url\x18\x16 \x01(\tR\n" +
+	"youtubeUrl\x12\x19\n" +
+	"\bis_leech\x18\x17 \x01(\bR\aisLeech\x12(\n" +
+	"\x10leech_quiz_types\x18\x18 \x03(\tR\x0eleechQuizTypes\x12%\n" +
+	"\x0efrequency_band\x18\x19 \x01(\tR\rfrequencyBand\x12%\n" +
+	"\x0efrequency_rank\x18\x1a \x01(\x05R\rfrequencyRankJ\x04\b\v\x10\f\"\xcf\x01\n" +
+	"\x10LearningLogEntry\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"learned_at\x18\x02 \x01(\tR\tlearnedAt\x12\x18\n" +
+	"\aquality\x18\x03 \x01(\x05R\aquality\x12(\n" +
+	"\x10response_time_ms\x18\x04 \x01(\x03R\x0eresponseTimeMs\x12\x1b\n" +
+	"\tquiz_type\x18\x05 \x01(\tR\bquizType\x12#\n" +
+	"\rinterval_days\x18\x06 \x01(\x05R\fintervalDays\"D\n" +
+	"\x18ExportNotebookPDFRequest\x12(\n" +
+	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"notebookId\"X\n" +
+	"\x19ExportNotebookPDFResponse\x12\x1f\n" +
+	"\vpdf_content\x18\x01 \x01(\fR\n" +
+	"pdfContent\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"k\n" +
+	"\x11LookupWordRequest\x12\x1b\n" +
+	"\x04word\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04word\x12\x1f\n" +
+	"\vnotebook_id\x18\x02 \x01(\tR\n" +
+	"notebookId\x12\x18\n" +
+	"\acontext\x18\x03 \x01(\tR\acontext\"\xe8\x01\n" +
+	"\x0eWordDefinition\x12$\n" +
+	"\x0epart_of_speech\x18\x01 \x01(\tR\fpartOfSpeech\x12\x1e\n" +
+	"\n" +
+	"definition\x18\x02 \x01(\tR\n" +
+	"definition\x12\x1a\n" +
+	"\bexamples\x18\x03 \x03(\tR\bexamples\x12\x1a\n" +
+	"\bsynonyms\x18\x04 \x03(\tR\bsynonyms\x12$\n" +
+	"\rpronunciation\x18\x05 \x01(\tR\rpronunciation\x12\x1a\n" +
+	"\bantonyms\x18\x06 \x03(\tR\bantonyms\x12\x16\n" +
+	"\x06origin\x18\a \x01(\tR\x06origin\"\xc8\x01\n" +
+	"\x12LookupWordResponse\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x128\n" +
+	"\vdefinitions\x18\x02 \x03(\v2\x16.api.v1.WordDefinitionR\vdefinitions\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12%\n" +
+	"\x0efrequency_band\x18\x04 \x01(\tR\rfrequencyBand\x12%\n" +
+	"\x0efrequency_rank\x18\x05 \x01(\x05R\rfrequencyRank\"\x99\x02\n" +
+	"\x19RegisterDefinitionRequest\x12(\n" +
+	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"notebookId\x12#\n" +
+	"\rnotebook_file\x18\x02 \x01(\tR\fnotebookFile\x12\x1f\n" +
+	"\vscene_index\x18\x03 \x01(\x05R\n" +
+	"sceneIndex\x12'\n" +
+	"\n" +
+	"expression\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"expression\x12!\n" +
+	"\ameaning\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\ameaning\x12$\n" +
+	"\x0epart_of_speech\x18\x06 \x01(\tR\fpartOfSpeech\x12\x1a\n" +
+	"\bexamples\x18\a \x03(\tR\bexamples\"\x1c\n" +
+	"\x1aRegisterDefinitionResponse\"\xb2\x01\n" +
+	"\x17DeleteDefinitionRequest\x12(\n" +
+	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"notebookId\x12#\n" +
+	"\rnotebook_file\x18\x02 \x01(\tR\fnotebookFile\x12\x1f\n" +
+	"\vscene_index\x18\x03 \x01(\x05R\n" +
+	"sceneIndex\x12'\n" +
+	"\n" +
+	"expression\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"expression\"\x1a\n" +
+	"\x18DeleteDefinitionResponse\"Q\n" +
+	"\x13EtymologyOriginForm\x12\x12\n" +
+	"\x04form\x18\x01 \x01(\tR\x04form\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xae\x02\n" +
+	"\x13EtymologyOriginPart\x12\x16\n" +
+	"\x06origin\x18\x01 \x01(\tR\x06origin\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x18\n" +
+	"\ameaning\x18\x04 \x01(\tR\ameaning\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x05 \x01(\x05R\twordCount\x121\n" +
+	"\x05forms\x18\x06 \x03(\v2\x1b.api.v1.EtymologyOriginFormR\x05forms\x12\x1b\n" +
+	"\tfrom_form\x18\a \x01(\tR\bfromForm\x12!\n" +
+	"\fconcept_keys\x18\b \x03(\tR\vconceptKeys\x12#\n" +
+	"\rsession_title\x18\t \x01(\tR\fsessionTitle\"\x8c\x03\n" +
+	"\x13EtymologyDefinition\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x12\x18\n" +
+	"\ameaning\x18\x02 \x01(\tR\ameaning\x12$\n" +
+	"\x0epart_of_speech\x18\x03 \x01(\tR\fpartOfSpeech\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12>\n" +
+	"\forigin_parts\x18\x05 \x03(\v2\x1b.api.v1.EtymologyOriginPartR\voriginParts\x12#\n" +
+	"\rnotebook_name\x18\x06 \x01(\tR\fnotebookName\x12\x1a\n" +
+	"\bexamples\x18\a \x03(\tR\bexamples\x12\x1a\n" +
+	"\bcontexts\x18\b \x03(\tR\bcontexts\x12\x1d\n" +
+	"\n" +
+	"is_skipped\x18\t \x01(\bR\tisSkipped\x12,\n" +
+	"\x12skipped_quiz_types\x18\n" +
+	" \x03(\tR\x10skippedQuizTypes\x12\x17\n" +
+	"\anote_id\x18\v \x01(\x03R\x06noteId\"h\n" +
+	"\x15EtymologyMeaningGroup\x12\x18\n" +
+	"\ameaning\x18\x01 \x01(\tR\ameaning\x125\n" +
+	"\aorigins\x18\x02 \x03(\v2\x1b.api.v1.EtymologyOriginPartR\aorigins\"G\n" +
+	"\x1bGetEtymologyNotebookRequest\x12(\n" +
+	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"notebookId\"q\n" +
+	"\x15SemanticConceptMember\x123\n" +
+	"\x06origin\x18\x01 \x01(\v2\x1b.api.v1.EtymologyOriginPartR\x06origin\x12#\n" +
+	"\rsession_title\x18\x02 \x01(\tR\fsessionTitle\"\x96\x01\n" +
+	"\x0fConceptRelation\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
+	"\vis_directed\x18\x02 \x01(\bR\n" +
+	"isDirected\x12(\n" +
+	"\x10from_concept_key\x18\x03 \x01(\tR\x0efromConceptKey\x12$\n" +
+	"\x0eto_concept_key\x18\x04 \x01(\tR\ftoConceptKey\"\x82\x02\n" +
+	"\x0fSemanticConcept\x12\x1f\n" +
+	"\vnotebook_id\x18\x01 \x01(\tR\n" +
+	"notebookId\x12\x1f\n" +
+	"\vconcept_key\x18\x02 \x01(\tR\n" +
+	"conceptKey\x12\x18\n" +
+	"\ameaning\x18\x03 \x01(\tR\ameaning\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x127\n" +
+	"\amembers\x18\x05 \x03(\v2\x1d.api.v1.SemanticConceptMemberR\amembers\x12F\n" +
+	"\x12outgoing_relations\x18\x06 \x03(\v2\x17.api.v1.ConceptRelationR\x11outgoingRelations\"\xdd\x02\n" +
+	"\x1cGetEtymologyNotebookResponse\x125\n" +
+	"\aorigins\x18\x01 \x03(\v2\x1b.api.v1.EtymologyOriginPartR\aorigins\x12=\n" +
+	"\vdefinitions\x18\x02 \x03(\v2\x1b.api.v1.EtymologyDefinitionR\vdefinitions\x12D\n" +
+	"\x0emeaning_groups\x18\x03 \x03(\v2\x1d.api.v1.EtymologyMeaningGroupR\rmeaningGroups\x12!\n" +
+	"\forigin_count\x18\x04 \x01(\x05R\voriginCount\x12)\n" +
+	"\x10definition_count\x18\x05 \x01(\x05R\x0fdefinitionCount\x123\n" +
+	"\bconcepts\x18\x06 \x03(\v2\x17.api.v1.SemanticConceptR\bconcepts\"a\n" +
+	"\x16StreamNoteAudioRequest\x12(\n" +
+	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"notebookId\x12\x1d\n" +
+	"\x05audio\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05audio\"N\n" +
+	"\x17StreamNoteAudioResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x02 \x01(\tR\tmediaType\"D\n" +
+	"\x18GetEtymologyGraphRequest\x12(\n" +
+	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"notebookId\"\x7f\n" +
+	"\x19GetEtymologyGraphResponse\x120\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1a.api.v1.EtymologyGraphNodeR\x05nodes\x120\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1a.api.v1.EtymologyGraphEdgeR\x05edges\"\xeb\x01\n" +
+	"\x12EtymologyGraphNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x18\n" +
+	"\ameaning\x18\x04 \x01(\tR\ameaning\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x1f\n" +
+	"\vorigin_type\x18\x06 \x01(\tR\n" +
+	"originType\x12#\n" +
+	"\rsession_title\x18\a \x01(\tR\fsessionTitle\x12\x1f\n" +
+	"\vnotebook_id\x18\b \x01(\tR\n" +
+	"notebookId\"\x8a\x01\n" +
+	"\x12EtymologyGraphEdge\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x1a\n" +
+	"\bdirected\x18\x05 \x01(\bR\bdirected\"y\n" +
+	"\x16SearchNotebooksRequest\x12\x1d\n" +
+	"\x05query\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05query\x12!\n" +
+	"\fnotebook_ids\x18\x02 \x03(\tR\vnotebookIds\x12\x1d\n" +
+	"\x05limit\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05limit\"m\n" +
+	"\x17SearchNotebooksResponse\x12<\n" +
+	"\voccurrences\x18\x01 \x03(\v2\x1a.api.v1.NotebookOccurrenceR\voccurrences\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xb8\x02\n" +
+	"\x12NotebookOccurrence\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vnotebook_id\x18\x02 \x01(\tR\n" +
+	"notebookId\x12%\n" +
+	"\x0enotebook_title\x18\x03 \x01(\tR\rnotebookTitle\x12\x1f\n" +
+	"\vstory_title\x18\x04 \x01(\tR\n" +
+	"storyTitle\x12\x1f\n" +
+	"\vscene_title\x18\x05 \x01(\tR\n" +
+	"sceneTitle\x12\x18\n" +
+	"\aspeaker\x18\x06 \x01(\tR\aspeaker\x12\x12\n" +
+	"\x04text\x18\a \x01(\tR\x04text\x12\x1e\n" +
+	"\n" +
+	"expression\x18\b \x01(\tR\n" +
+	"expression\x12\x18\n" +
+	"\ameaning\x18\t \x01(\tR\ameaning\x12\x1c\n" +
+	"\thighlight\x18\n" +
+	" \x01(\tR\thighlight\"\x9c\x01\n" +
+	"\x15SearchPassagesRequest\x12\x1d\n" +
+	"\x05query\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05query\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\x02 \x03(\tR\tsourceIds\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"~\n" +
+	"\x16SearchPassagesResponse\x12&\n" +
+	"\x04hits\x18\x01 \x03(\v2\x12.api.v1.PassageHitR\x04hits\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xf6\x01\n" +
+	"\n" +
+	"PassageHit\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12!\n" +
+	"\fsource_title\x18\x03 \x01(\tR\vsourceTitle\x12\x18\n" +
+	"\asection\x18\x04 \x01(\tR\asection\x12\x14\n" +
+	"\x05scene\x18\x05 \x01(\tR\x05scene\x12\x18\n" +
+	"\aspeaker\x18\x06 \x01(\tR\aspeaker\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\x12\x18\n" +
+	"\asnippet\x18\b \x01(\tR\asnippet\x12\x14\n" +
+	"\x05score\x18\t \x01(\x01R\x05score2\xf6\x06\n" +
 	"\x0fNotebookService\x12X\n" +
 	"\x11GetNotebookDetail\x12 .api.v1.GetNotebookDetailRequest\x1a!.api.v1.GetNotebookDetailResponse\x12X\n" +
 	"\x11ExportNotebookPDF\x12 .api.v1.ExportNotebookPDFRequest\x1a!.api.v1.ExportNotebookPDFResponse\x12C\n" +
@@ -2100,7 +3194,11 @@ const file_api_v1_notebook_proto_rawDesc = "" +
 	"LookupWord\x12\x19.api.v1.LookupWordRequest\x1a\x1a.api.v1.LookupWordResponse\x12[\n" +
 	"\x12RegisterDefinition\x12!.api.v1.RegisterDefinitionRequest\x1a\".api.v1.RegisterDefinitionResponse\x12U\n" +
 	"\x10DeleteDefinition\x12\x1f.api.v1.DeleteDefinitionRequest\x1a .api.v1.DeleteDefinitionResponse\x12a\n" +
-	"\x14GetEtymologyNotebook\x12#.api.v1.GetEtymologyNotebookRequest\x1a$.api.v1.GetEtymologyNotebookResponseB8Z6github.com/at-ishikawa/langner/gen-protos/api/v1;apiv1b\x06proto3"
+	"\x14GetEtymologyNotebook\x12#.api.v1.GetEtymologyNotebookRequest\x1a$.api.v1.GetEtymologyNotebookResponse\x12X\n" +
+	"\x11GetEtymologyGraph\x12 .api.v1.GetEtymologyGraphRequest\x1a!.api.v1.GetEtymologyGraphResponse\x12T\n" +
+	"\x0fStreamNoteAudio\x12\x1e.api.v1.StreamNoteAudioRequest\x1a\x1f.api.v1.StreamNoteAudioResponse0\x01\x12R\n" +
+	"\x0fSearchNotebooks\x12\x1e.api.v1.SearchNotebooksRequest\x1a\x1f.api.v1.SearchNotebooksResponse\x12O\n" +
+	"\x0eSearchPassages\x12\x1d.api.v1.SearchPassagesRequest\x1a\x1e.api.v1.SearchPassagesResponseB8Z6github.com/at-ishikawa/langner/gen-protos/api/v1;apiv1b\x06proto3"
 
 var (
 	file_api_v1_notebook_proto_rawDescOnce sync.Once
@@ -2114,7 +3212,7 @@ func file_api_v1_notebook_proto_rawDescGZIP() []byte {
 	return file_api_v1_notebook_proto_rawDescData
 }
 
-var file_api_v1_notebook_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_api_v1_notebook_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_api_v1_notebook_proto_goTypes = []any{
 	(*GetNotebookDetailRequest)(nil),     // 0: api.v1.GetNotebookDetailRequest
 	(*GetNotebookDetailResponse)(nil),    // 1: api.v1.GetNotebookDetailResponse
@@ -2142,6 +3240,18 @@ var file_api_v1_notebook_proto_goTypes = []any{
 	(*ConceptRelation)(nil),              // 23: api.v1.ConceptRelation
 	(*SemanticConcept)(nil),              // 24: api.v1.SemanticConcept
 	(*GetEtymologyNotebookResponse)(nil), // 25: api.v1.GetEtymologyNotebookResponse
+	(*StreamNoteAudioRequest)(nil),       // 26: api.v1.StreamNoteAudioRequest
+	(*StreamNoteAudioResponse)(nil),      // 27: api.v1.StreamNoteAudioResponse
+	(*GetEtymologyGraphRequest)(nil),     // 28: api.v1.GetEtymologyGraphRequest
+	(*GetEtymologyGraphResponse)(nil),    // 29: api.v1.GetEtymologyGraphResponse
+	(*EtymologyGraphNode)(nil),           // 30: api.v1.EtymologyGraphNode
+	(*EtymologyGraphEdge)(nil),           // 31: api.v1.EtymologyGraphEdge
+	(*SearchNotebooksRequest)(nil),       // 32: api.v1.SearchNotebooksRequest
+	(*SearchNotebooksResponse)(nil),      // 33: api.v1.SearchNotebooksResponse
+	(*NotebookOccurrence)(nil),           // 34: api.v1.NotebookOccurrence
+	(*SearchPassagesRequest)(nil),        // 35: api.v1.SearchPassagesRequest
+	(*SearchPassagesResponse)(nil),       // 36: api.v1.SearchPassagesResponse
+	(*PassageHit)(nil),                   // 37: api.v1.PassageHit
 }
 var file_api_v1_notebook_proto_depIdxs = []int32{
 	2,  // 0: api.v1.GetNotebookDetailResponse.stories:type_name -> api.v1.StoryEntry
@@ -2161,23 +3271,35 @@ var file_api_v1_notebook_proto_depIdxs = []int32{
 	19, // 14: api.v1.GetEtymologyNotebookResponse.definitions:type_name -> api.v1.EtymologyDefinition
 	20, // 15: api.v1.GetEtymologyNotebookResponse.meaning_groups:type_name -> api.v1.EtymologyMeaningGroup
 	24, // 16: api.v1.GetEtymologyNotebookResponse.concepts:type_name -> api.v1.SemanticConcept
-	0,  // 17: api.v1.NotebookService.GetNotebookDetail:input_type -> api.v1.GetNotebookDetailRequest
-	8,  // 18: api.v1.NotebookService.ExportNotebookPDF:input_type -> api.v1.ExportNotebookPDFRequest
-	10, // 19: api.v1.NotebookService.LookupWord:input_type -> api.v1.LookupWordRequest
-	13, // 20: api.v1.NotebookService.RegisterDefinition:input_type -> api.v1.RegisterDefinitionRequest
-	15, // 21: api.v1.NotebookService.DeleteDefinition:input_type -> api.v1.DeleteDefinitionRequest
-	21, // 22: api.v1.NotebookService.GetEtymologyNotebook:input_type -> api.v1.GetEtymologyNotebookRequest
-	1,  // 23: api.v1.NotebookService.GetNotebookDetail:output_type -> api.v1.GetNotebookDetailResponse
-	9,  // 24: api.v1.NotebookService.ExportNotebookPDF:output_type -> api.v1.ExportNotebookPDFResponse
-	12, // 25: api.v1.NotebookService.LookupWord:output_type -> api.v1.LookupWordResponse
-	14, // 26: api.v1.NotebookService.RegisterDefinition:output_type -> api.v1.RegisterDefinitionResponse
-	16, // 27: api.v1.NotebookService.DeleteDefinition:output_type -> api.v1.DeleteDefinitionResponse
-	25, // 28: api.v1.NotebookService.GetEtymologyNotebook:output_type -> api.v1.GetEtymologyNotebookResponse
-	23, // [23:29] is the sub-list for method output_type
-	17, // [17:23] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	30, // 17: api.v1.GetEtymologyGraphResponse.nodes:type_name -> api.v1.EtymologyGraphNode
+	31, // 18: api.v1.GetEtymologyGraphResponse.edges:type_name -> api.v1.EtymologyGraphEdge
+	34, // 19: api.v1.SearchNotebooksResponse.occurrences:type_name -> api.v1.NotebookOccurrence
+	37, // 20: api.v1.SearchPassagesResponse.hits:type_name -> api.v1.PassageHit
+	0,  // 21: api.v1.NotebookService.GetNotebookDetail:input_type -> api.v1.GetNotebookDetailRequest
+	8,  // 22: api.v1.NotebookService.ExportNotebookPDF:input_type -> api.v1.ExportNotebookPDFRequest
+	10, // 23: api.v1.NotebookService.LookupWord:input_type -> api.v1.LookupWordRequest
+	13, // 24: api.v1.NotebookService.RegisterDefinition:input_type -> api.v1.RegisterDefinitionRequest
+	15, // 25: api.v1.NotebookService.DeleteDefinition:input_type -> api.v1.DeleteDefinitionRequest
+	21, // 26: api.v1.NotebookService.GetEtymologyNotebook:input_type -> api.v1.GetEtymologyNotebookRequest
+	28, // 27: api.v1.NotebookService.GetEtymologyGraph:input_type -> api.v1.GetEtymologyGraphRequest
+	26, // 28: api.v1.NotebookService.StreamNoteAudio:input_type -> api.v1.StreamNoteAudioRequest
+	32, // 29: api.v1.NotebookService.SearchNotebooks:input_type -> api.v1.SearchNotebooksRequest
+	35, // 30: api.v1.NotebookService.SearchPassages:input_type -> api.v1.SearchPassagesRequest
+	1,  // 31: api.v1.NotebookService.GetNotebookDetail:output_type -> api.v1.GetNotebookDetailResponse
+	9,  // 32: api.v1.NotebookService.ExportNotebookPDF:output_type -> api.v1.ExportNotebookPDFResponse
+	12, // 33: api.v1.NotebookService.LookupWord:output_type -> api.v1.LookupWordResponse
+	14, // 34: api.v1.NotebookService.RegisterDefinition:output_type -> api.v1.RegisterDefinitionResponse
+	16, // 35: api.v1.NotebookService.DeleteDefinition:output_type -> api.v1.DeleteDefinitionResponse
+	25, // 36: api.v1.NotebookService.GetEtymologyNotebook:output_type -> api.v1.GetEtymologyNotebookResponse
+	29, // 37: api.v1.NotebookService.GetEtymologyGraph:output_type -> api.v1.GetEtymologyGraphResponse
+	27, // 38: api.v1.NotebookService.StreamNoteAudio:output_type -> api.v1.StreamNoteAudioResponse
+	33, // 39: api.v1.NotebookService.SearchNotebooks:output_type -> api.v1.SearchNotebooksResponse
+	36, // 40: api.v1.NotebookService.SearchPassages:output_type -> api.v1.SearchPassagesResponse
+	31, // [31:41] is the sub-list for method output_type
+	21, // [21:31] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_notebook_proto_init() }
@@ -2191,7 +3313,7 @@ func file_api_v1_notebook_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_notebook_proto_rawDesc), len(file_api_v1_notebook_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
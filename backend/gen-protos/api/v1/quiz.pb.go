@@ -44,18 +44,27 @@ const (
 	// QUIZ_TYPE_GRAMMAR drills grammar mistakes annotated in journal notebooks:
 	// the user is shown a sentence with an incorrect span and types the fix.
 	QuizType_QUIZ_TYPE_GRAMMAR QuizType = 8
+	// QUIZ_TYPE_DICTATION plays a conversation line from a story scene and has
+	// the user type what they heard.
+	QuizType_QUIZ_TYPE_DICTATION QuizType = 9
+	// QUIZ_TYPE_WORD_CHOICE tells apart the members of a synonym or antonym
+	// concept of a definitions book: which member fits a sentence, or how two
+	// members differ.
+	QuizType_QUIZ_TYPE_WORD_CHOICE QuizType = 10
 )
 
 // Enum value maps for QuizType.
 var (
 	QuizType_name = map[int32]string{
-		0: "QUIZ_TYPE_UNSPECIFIED",
-		1: "QUIZ_TYPE_STANDARD",
-		2: "QUIZ_TYPE_REVERSE",
-		3: "QUIZ_TYPE_FREEFORM",
-		4: "QUIZ_TYPE_ETYMOLOGY_ORIGIN",
-		7: "QUIZ_TYPE_RELEARN",
-		8: "QUIZ_TYPE_GRAMMAR",
+		0:  "QUIZ_TYPE_UNSPECIFIED",
+		1:  "QUIZ_TYPE_STANDARD",
+		2:  "QUIZ_TYPE_REVERSE",
+		3:  "QUIZ_TYPE_FREEFORM",
+		4:  "QUIZ_TYPE_ETYMOLOGY_ORIGIN",
+		7:  "QUIZ_TYPE_RELEARN",
+		8:  "QUIZ_TYPE_GRAMMAR",
+		9:  "QUIZ_TYPE_DICTATION",
+		10: "QUIZ_TYPE_WORD_CHOICE",
 	}
 	QuizType_value = map[string]int32{
 		"QUIZ_TYPE_UNSPECIFIED":      0,
@@ -65,6 +74,8 @@ var (
 		"QUIZ_TYPE_ETYMOLOGY_ORIGIN": 4,
 		"QUIZ_TYPE_RELEARN":          7,
 		"QUIZ_TYPE_GRAMMAR":          8,
+		"QUIZ_TYPE_DICTATION":        9,
+		"QUIZ_TYPE_WORD_CHOICE":      10,
 	}
 )
 
@@ -327,8 +338,12 @@ type NotebookSummary struct {
 	// toggle. Grammar summaries are 0. The Vocabulary tab lists a notebook iff
 	// this is > 0, so grammar-only and definition-less journal rows drop out.
 	VocabularyCount int32 `protobuf:"varint,11,opt,name=vocabulary_count,json=vocabularyCount,proto3" json:"vocabulary_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// frequent_review_count is how many of the review_count words are very
+	// common or common, by the configured frequency list or else the
+	// dictionary's word frequency.
+	FrequentReviewCount int32 `protobuf:"varint,12,opt,name=frequent_review_count,json=frequentReviewCount,proto3" json:"frequent_review_count,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *NotebookSummary) Reset() {
@@ -438,6 +453,13 @@ func (x *NotebookSummary) GetVocabularyCount() int32 {
 	return 0
 }
 
+func (x *NotebookSummary) GetFrequentReviewCount() int32 {
+	if x != nil {
+		return x.FrequentReviewCount
+	}
+	return 0
+}
+
 // NotebookSectionSummary holds the display title and per-mode review counts
 // for a single section within a notebook.
 type NotebookSectionSummary struct {
@@ -2253,8 +2275,12 @@ type OverrideAnswerResponse struct {
 	OriginalQuality      int32                  `protobuf:"varint,2,opt,name=original_quality,json=originalQuality,proto3" json:"original_quality,omitempty"`
 	OriginalStatus       string                 `protobuf:"bytes,3,opt,name=original_status,json=originalStatus,proto3" json:"original_status,omitempty"`
 	OriginalIntervalDays int32                  `protobuf:"varint,4,opt,name=original_interval_days,json=originalIntervalDays,proto3" json:"original_interval_days,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// original_grader is who graded the answer before the override
+	// ("model", "deterministic", "override" or "" for older logs), passed
+	// back on undo.
+	OriginalGrader string `protobuf:"bytes,6,opt,name=original_grader,json=originalGrader,proto3" json:"original_grader,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *OverrideAnswerResponse) Reset() {
@@ -2315,6 +2341,13 @@ func (x *OverrideAnswerResponse) GetOriginalIntervalDays() int32 {
 	return 0
 }
 
+func (x *OverrideAnswerResponse) GetOriginalGrader() string {
+	if x != nil {
+		return x.OriginalGrader
+	}
+	return ""
+}
+
 // Undo Override Answer
 type UndoOverrideAnswerRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -2325,9 +2358,11 @@ type UndoOverrideAnswerRequest struct {
 	OriginalStatus       string                 `protobuf:"bytes,5,opt,name=original_status,json=originalStatus,proto3" json:"original_status,omitempty"`
 	OriginalIntervalDays int32                  `protobuf:"varint,6,opt,name=original_interval_days,json=originalIntervalDays,proto3" json:"original_interval_days,omitempty"`
 	// sense_id — see OverrideAnswerRequest.sense_id.
-	SenseId       string `protobuf:"bytes,8,opt,name=sense_id,json=senseId,proto3" json:"sense_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SenseId string `protobuf:"bytes,8,opt,name=sense_id,json=senseId,proto3" json:"sense_id,omitempty"`
+	// original_grader — see OverrideAnswerResponse.original_grader.
+	OriginalGrader string `protobuf:"bytes,9,opt,name=original_grader,json=originalGrader,proto3" json:"original_grader,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UndoOverrideAnswerRequest) Reset() {
@@ -2409,6 +2444,13 @@ func (x *UndoOverrideAnswerRequest) GetSenseId() string {
 	return ""
 }
 
+func (x *UndoOverrideAnswerRequest) GetOriginalGrader() string {
+	if x != nil {
+		return x.OriginalGrader
+	}
+	return ""
+}
+
 type UndoOverrideAnswerResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Correct        bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
@@ -3036,7 +3078,11 @@ type RelearnCard struct {
 	// shown by the frontend ONLY after answering (never during the question, to
 	// avoid hinting a reverse answer). Excludes the drilled words and any word
 	// whose skipped_at exclude marker is set. Never quizzed, never persisted.
-	RelatedWords  []*OriginFamilyMember `protobuf:"bytes,15,rep,name=related_words,json=relatedWords,proto3" json:"related_words,omitempty"`
+	RelatedWords []*OriginFamilyMember `protobuf:"bytes,15,rep,name=related_words,json=relatedWords,proto3" json:"related_words,omitempty"`
+	// audio and notebook_id name the line a QUIZ_TYPE_DICTATION card plays;
+	// pass them to NotebookService.StreamNoteAudio. Empty for other cards.
+	Audio         string `protobuf:"bytes,16,opt,name=audio,proto3" json:"audio,omitempty"`
+	NotebookId    string `protobuf:"bytes,17,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3176,6 +3222,20 @@ func (x *RelearnCard) GetRelatedWords() []*OriginFamilyMember {
 	return nil
 }
 
+func (x *RelearnCard) GetAudio() string {
+	if x != nil {
+		return x.Audio
+	}
+	return ""
+}
+
+func (x *RelearnCard) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
 // OriginFamilyMember is one word from an origin family shown as post-answer
 // reference on the Relearn origin card: the word and a short meaning/gloss.
 type OriginFamilyMember struct {
@@ -3314,7 +3374,8 @@ type SubmitRelearnAnswerResponse struct {
 	// what the live grammar quiz's feedback card shows (see
 	// GrammarFeedbackCard.tsx): the reference fix, the mistake's category,
 	// and the authored grammar note (separate from `reason`, which here
-	// carries the grader's critique of THIS answer).
+	// carries the grader's critique of THIS answer). For a QUIZ_TYPE_DICTATION
+	// card correct_answer is the line that was played.
 	CorrectAnswer string `protobuf:"bytes,9,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"`
 	Category      string `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
 	GrammarNote   string `protobuf:"bytes,11,opt,name=grammar_note,json=grammarNote,proto3" json:"grammar_note,omitempty"`
@@ -4765,228 +4826,1429 @@ func (*ResumeEtymologyWordResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_quiz_proto_rawDescGZIP(), []int{66}
 }
 
-var File_api_v1_quiz_proto protoreflect.FileDescriptor
+type StartDictationQuizRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NotebookIds      []string               `protobuf:"bytes,1,rep,name=notebook_ids,json=notebookIds,proto3" json:"notebook_ids,omitempty"`
+	IncludeUnstudied bool                   `protobuf:"varint,2,opt,name=include_unstudied,json=includeUnstudied,proto3" json:"include_unstudied,omitempty"`
+	// notebook_sections, when non-empty, replaces notebook_ids and narrows the
+	// quiz to specific scenes within each story notebook.
+	NotebookSections []*NotebookSection `protobuf:"bytes,3,rep,name=notebook_sections,json=notebookSections,proto3" json:"notebook_sections,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
 
-const file_api_v1_quiz_proto_rawDesc = "" +
-	"\n" +
-	"\x11api/v1/quiz.proto\x12\x06api.v1\x1a\x1bbuf/validate/validate.proto\x1a\x15api/v1/notebook.proto\"D\n" +
-	"\x15GetQuizOptionsRequest\x12+\n" +
-	"\x11include_unstudied\x18\x01 \x01(\bR\x10includeUnstudied\"O\n" +
-	"\x16GetQuizOptionsResponse\x125\n" +
-	"\tnotebooks\x18\x01 \x03(\v2\x17.api.v1.NotebookSummaryR\tnotebooks\"\xe4\x03\n" +
-	"\x0fNotebookSummary\x12\x1f\n" +
-	"\vnotebook_id\x18\x01 \x01(\tR\n" +
-	"notebookId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
-	"\freview_count\x18\x03 \x01(\x05R\vreviewCount\x12\x12\n" +
-	"\x04kind\x18\x04 \x01(\tR\x04kind\x120\n" +
-	"\x14reverse_review_count\x18\x05 \x01(\x05R\x12reverseReviewCount\x124\n" +
-	"\x16etymology_review_count\x18\x06 \x01(\x05R\x14etymologyReviewCount\x12\x1f\n" +
-	"\vhas_content\x18\a \x01(\bR\n" +
-	"hasContent\x12:\n" +
-	"\bsections\x18\b \x03(\v2\x1e.api.v1.NotebookSectionSummaryR\bsections\x12C\n" +
-	"\x1eetymology_reverse_review_count\x18\t \x01(\x05R\x1betymologyReverseReviewCount\x120\n" +
-	"\x14grammar_review_count\x18\n" +
-	" \x01(\x05R\x12grammarReviewCount\x12)\n" +
-	"\x10vocabulary_count\x18\v \x01(\x05R\x0fvocabularyCount\"\xb0\x02\n" +
-	"\x16NotebookSectionSummary\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12!\n" +
-	"\freview_count\x18\x02 \x01(\x05R\vreviewCount\x120\n" +
-	"\x14reverse_review_count\x18\x03 \x01(\x05R\x12reverseReviewCount\x124\n" +
-	"\x16etymology_review_count\x18\x04 \x01(\x05R\x14etymologyReviewCount\x12C\n" +
-	"\x1eetymology_reverse_review_count\x18\x05 \x01(\x05R\x1betymologyReverseReviewCount\x120\n" +
-	"\x14grammar_review_count\x18\x06 \x01(\x05R\x12grammarReviewCount\"b\n" +
-	"\x0fNotebookSection\x12(\n" +
-	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
-	"notebookId\x12%\n" +
-	"\x0esection_titles\x18\x02 \x03(\tR\rsectionTitles\"\xa8\x01\n" +
-	"\x10StartQuizRequest\x12!\n" +
-	"\fnotebook_ids\x18\x01 \x03(\tR\vnotebookIds\x12+\n" +
-	"\x11include_unstudied\x18\x02 \x01(\bR\x10includeUnstudied\x12D\n" +
-	"\x11notebook_sections\x18\x03 \x03(\v2\x17.api.v1.NotebookSectionR\x10notebookSections\"F\n" +
-	"\x11StartQuizResponse\x121\n" +
-	"\n" +
-	"flashcards\x18\x01 \x03(\v2\x11.api.v1.FlashcardR\n" +
-	"flashcards\"\x83\x02\n" +
-	"\tFlashcard\x12\x17\n" +
-	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x14\n" +
-	"\x05entry\x18\x02 \x01(\tR\x05entry\x12+\n" +
-	"\bexamples\x18\x03 \x03(\v2\x0f.api.v1.ExampleR\bexamples\x12%\n" +
-	"\x0eoriginal_entry\x18\x04 \x01(\tR\roriginalEntry\x12!\n" +
-	"\fconcept_head\x18\x05 \x01(\tR\vconceptHead\x12'\n" +
-	"\x0fconcept_members\x18\x06 \x03(\tR\x0econceptMembers\x12'\n" +
-	"\x0fconcept_meaning\x18\a \x01(\tR\x0econceptMeaning\"U\n" +
-	"\aExample\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text\x12\x18\n" +
-	"\aspeaker\x18\x02 \x01(\tR\aspeaker\x12\x1c\n" +
-	"\thighlight\x18\x03 \x01(\tR\thighlight\"\xf7\x01\n" +
-	"\n" +
-	"WordDetail\x12\x16\n" +
-	"\x06origin\x18\x01 \x01(\tR\x06origin\x12$\n" +
-	"\rpronunciation\x18\x02 \x01(\tR\rpronunciation\x12$\n" +
-	"\x0epart_of_speech\x18\x03 \x01(\tR\fpartOfSpeech\x12\x1a\n" +
-	"\bsynonyms\x18\x04 \x03(\tR\bsynonyms\x12\x1a\n" +
-	"\bantonyms\x18\x05 \x03(\tR\bantonyms\x12\x12\n" +
-	"\x04memo\x18\x06 \x01(\tR\x04memo\x129\n" +
-	"\forigin_parts\x18\a \x03(\v2\x16.api.v1.WordOriginPartR\voriginParts\"r\n" +
-	"\x0eWordOriginPart\x12\x16\n" +
-	"\x06origin\x18\x01 \x01(\tR\x06origin\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
-	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x18\n" +
-	"\ameaning\x18\x04 \x01(\tR\ameaning\"\x98\x01\n" +
-	"\x13SubmitAnswerRequest\x12 \n" +
-	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12\x16\n" +
-	"\x06answer\x18\x02 \x01(\tR\x06answer\x12(\n" +
-	"\x10response_time_ms\x18\x03 \x01(\x03R\x0eresponseTimeMs\x12\x1d\n" +
-	"\n" +
-	"is_skipped\x18\x04 \x01(\bR\tisSkipped\"\x93\x02\n" +
-	"\x14SubmitAnswerResponse\x12\x18\n" +
-	"\acorrect\x18\x01 \x01(\bR\acorrect\x12\x18\n" +
-	"\ameaning\x18\x02 \x01(\tR\ameaning\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\x123\n" +
-	"\vword_detail\x18\x04 \x01(\v2\x12.api.v1.WordDetailR\n" +
-	"wordDetail\x12(\n" +
-	"\x10next_review_date\x18\x05 \x01(\tR\x0enextReviewDate\x12\x1d\n" +
-	"\n" +
-	"learned_at\x18\x06 \x01(\tR\tlearnedAt\x12\x16\n" +
-	"\x06images\x18\a \x03(\tR\x06images\x12\x19\n" +
-	"\bsense_id\x18\b \x01(\tR\asenseId\"\\\n" +
-	"\x19BatchSubmitAnswersRequest\x12?\n" +
-	"\aanswers\x18\x01 \x03(\v2\x1b.api.v1.SubmitAnswerRequestB\b\xbaH\x05\x92\x01\x02\b\x01R\aanswers\"X\n" +
-	"\x1aBatchSubmitAnswersResponse\x12:\n" +
-	"\tresponses\x18\x01 \x03(\v2\x1c.api.v1.SubmitAnswerResponseR\tresponses\"\xe1\x01\n" +
-	"\x17StartReverseQuizRequest\x12!\n" +
-	"\fnotebook_ids\x18\x01 \x03(\tR\vnotebookIds\x120\n" +
-	"\x14list_missing_context\x18\x02 \x01(\bR\x12listMissingContext\x12D\n" +
-	"\x11notebook_sections\x18\x03 \x03(\v2\x17.api.v1.NotebookSectionR\x10notebookSections\x12+\n" +
-	"\x11include_unstudied\x18\x04 \x01(\bR\x10includeUnstudied\"T\n" +
-	"\x18StartReverseQuizResponse\x128\n" +
-	"\n" +
-	"flashcards\x18\x01 \x03(\v2\x18.api.v1.ReverseFlashcardR\n" +
-	"flashcards\"\xd6\x02\n" +
-	"\x10ReverseFlashcard\x12\x17\n" +
-	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x18\n" +
-	"\ameaning\x18\x02 \x01(\tR\ameaning\x123\n" +
-	"\bcontexts\x18\x03 \x03(\v2\x17.api.v1.ContextSentenceR\bcontexts\x12#\n" +
-	"\rnotebook_name\x18\x04 \x01(\tR\fnotebookName\x12\x1f\n" +
-	"\vstory_title\x18\x05 \x01(\tR\n" +
-	"storyTitle\x12\x1f\n" +
-	"\vscene_title\x18\x06 \x01(\tR\n" +
-	"sceneTitle\x12!\n" +
-	"\fconcept_head\x18\a \x01(\tR\vconceptHead\x12'\n" +
-	"\x0fconcept_members\x18\b \x03(\tR\x0econceptMembers\x12'\n" +
-	"\x0fconcept_meaning\x18\t \x01(\tR\x0econceptMeaning\"R\n" +
-	"\x0fContextSentence\x12\x18\n" +
-	"\acontext\x18\x01 \x01(\tR\acontext\x12%\n" +
-	"\x0emasked_context\x18\x02 \x01(\tR\rmaskedContext\"\xda\x01\n" +
-	"\x1aSubmitReverseAnswerRequest\x12 \n" +
-	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12\x16\n" +
-	"\x06answer\x18\x02 \x01(\tR\x06answer\x12(\n" +
-	"\x10response_time_ms\x18\x03 \x01(\x03R\x0eresponseTimeMs\x129\n" +
-	"\x19accept_synonym_as_correct\x18\x04 \x01(\bR\x16acceptSynonymAsCorrect\x12\x1d\n" +
-	"\n" +
-	"is_skipped\x18\x05 \x01(\bR\tisSkipped\"\xfe\x02\n" +
-	"\x1bSubmitReverseAnswerResponse\x12\x18\n" +
-	"\acorrect\x18\x01 \x01(\bR\acorrect\x12\x1e\n" +
-	"\n" +
-	"expression\x18\x02 \x01(\tR\n" +
-	"expression\x12\x18\n" +
-	"\ameaning\x18\x03 \x01(\tR\ameaning\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
-	"\bcontexts\x18\x05 \x03(\tR\bcontexts\x123\n" +
-	"\vword_detail\x18\x06 \x01(\v2\x12.api.v1.WordDetailR\n" +
-	"wordDetail\x12&\n" +
-	"\x0eclassification\x18\a \x01(\tR\x0eclassification\x12(\n" +
-	"\x10next_review_date\x18\b \x01(\tR\x0enextReviewDate\x12\x1d\n" +
-	"\n" +
-	"learned_at\x18\t \x01(\tR\tlearnedAt\x12\x16\n" +
-	"\x06images\x18\n" +
-	" \x03(\tR\x06images\x12\x19\n" +
-	"\bsense_id\x18\v \x01(\tR\asenseId\"j\n" +
-	" BatchSubmitReverseAnswersRequest\x12F\n" +
-	"\aanswers\x18\x01 \x03(\v2\".api.v1.SubmitReverseAnswerRequestB\b\xbaH\x05\x92\x01\x02\b\x01R\aanswers\"f\n" +
-	"!BatchSubmitReverseAnswersResponse\x12A\n" +
-	"\tresponses\x18\x01 \x03(\v2#.api.v1.SubmitReverseAnswerResponseR\tresponses\"\x1a\n" +
-	"\x18StartFreeformQuizRequest\"\xa9\x02\n" +
-	"\x19StartFreeformQuizResponse\x12\x1d\n" +
-	"\n" +
-	"word_count\x18\x01 \x01(\x05R\twordCount\x12 \n" +
-	"\vexpressions\x18\x02 \x03(\tR\vexpressions\x12~\n" +
-	"\x1bexpression_next_review_date\x18\x03 \x03(\v2?.api.v1.StartFreeformQuizResponse.ExpressionNextReviewDateEntryR\x18expressionNextReviewDate\x1aK\n" +
-	"\x1dExpressionNextReviewDateEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
-	"\x1bSubmitFreeformAnswerRequest\x12\x1f\n" +
-	"\x04word\x18\x01 \x01(\tB\v\xbaH\br\x06\x10\x012\x02\\SR\x04word\x12%\n" +
-	"\ameaning\x18\x02 \x01(\tB\v\xbaH\br\x06\x10\x012\x02\\SR\ameaning\x12(\n" +
-	"\x10response_time_ms\x18\x03 \x01(\x03R\x0eresponseTimeMs\"\x87\x03\n" +
-	"\x1cSubmitFreeformAnswerResponse\x12\x18\n" +
-	"\acorrect\x18\x01 \x01(\bR\acorrect\x12\x12\n" +
-	"\x04word\x18\x02 \x01(\tR\x04word\x12\x18\n" +
-	"\ameaning\x18\x03 \x01(\tR\ameaning\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
-	"\acontext\x18\x05 \x01(\tR\acontext\x12#\n" +
-	"\rnotebook_name\x18\x06 \x01(\tR\fnotebookName\x123\n" +
-	"\vword_detail\x18\a \x01(\v2\x12.api.v1.WordDetailR\n" +
-	"wordDetail\x12(\n" +
-	"\x10next_review_date\x18\b \x01(\tR\x0enextReviewDate\x12\x1d\n" +
-	"\n" +
-	"learned_at\x18\t \x01(\tR\tlearnedAt\x12\x17\n" +
-	"\anote_id\x18\n" +
-	" \x01(\x03R\x06noteId\x12\x16\n" +
-	"\x06images\x18\v \x03(\tR\x06images\x12\x19\n" +
-	"\bsense_id\x18\f \x01(\tR\asenseId\"\xa6\x03\n" +
-	"\x15OverrideAnswerRequest\x12 \n" +
-	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12-\n" +
-	"\tquiz_type\x18\x02 \x01(\x0e2\x10.api.v1.QuizTypeR\bquizType\x12&\n" +
-	"\n" +
-	"learned_at\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\n" +
-	"R\tlearnedAt\x12&\n" +
-	"\fmark_correct\x18\x04 \x01(\bH\x00R\vmarkCorrect\x88\x01\x01\x12-\n" +
-	"\x10next_review_date\x18\x05 \x01(\tH\x01R\x0enextReviewDate\x88\x01\x01\x12\x19\n" +
-	"\bsense_id\x18\x06 \x01(\tR\asenseId\x12,\n" +
-	"\x0fword_expression\x18\a \x01(\tH\x02R\x0ewordExpression\x88\x01\x01\x12(\n" +
-	"\rword_excluded\x18\b \x01(\bH\x03R\fwordExcluded\x88\x01\x01B\x0f\n" +
-	"\r_mark_correctB\x13\n" +
-	"\x11_next_review_dateB\x12\n" +
-	"\x10_word_expressionB\x10\n" +
-	"\x0e_word_excluded\"\xd2\x01\n" +
-	"\x16OverrideAnswerResponse\x12(\n" +
-	"\x10next_review_date\x18\x01 \x01(\tR\x0enextReviewDate\x12)\n" +
-	"\x10original_quality\x18\x02 \x01(\x05R\x0foriginalQuality\x12'\n" +
-	"\x0foriginal_status\x18\x03 \x01(\tR\x0eoriginalStatus\x124\n" +
-	"\x16original_interval_days\x18\x04 \x01(\x05R\x14originalIntervalDaysJ\x04\b\x05\x10\x06\"\xbf\x02\n" +
-	"\x19UndoOverrideAnswerRequest\x12 \n" +
-	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12-\n" +
-	"\tquiz_type\x18\x02 \x01(\x0e2\x10.api.v1.QuizTypeR\bquizType\x12&\n" +
-	"\n" +
-	"learned_at\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\n" +
-	"R\tlearnedAt\x12)\n" +
-	"\x10original_quality\x18\x04 \x01(\x05R\x0foriginalQuality\x12'\n" +
-	"\x0foriginal_status\x18\x05 \x01(\tR\x0eoriginalStatus\x124\n" +
-	"\x16original_interval_days\x18\x06 \x01(\x05R\x14originalIntervalDays\x12\x19\n" +
-	"\bsense_id\x18\b \x01(\tR\asenseIdJ\x04\b\a\x10\b\"`\n" +
-	"\x1aUndoOverrideAnswerResponse\x12\x18\n" +
-	"\acorrect\x18\x01 \x01(\bR\acorrect\x12(\n" +
-	"\x10next_review_date\x18\x02 \x01(\tR\x0enextReviewDate\"\x9e\x01\n" +
-	"\x0fSkipWordRequest\x12 \n" +
-	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x129\n" +
-	"\n" +
-	"quiz_types\x18\x04 \x03(\x0e2\x10.api.v1.QuizTypeB\b\xbaH\x05\x92\x01\x02\b\x01R\tquizTypes\x12\x1d\n" +
-	"\n" +
-	"skip_until\x18\x03 \x01(\tR\tskipUntilJ\x04\b\x02\x10\x03R\tquiz_type\"\x12\n" +
-	"\x10SkipWordResponse\"\x81\x01\n" +
-	"\x11ResumeWordRequest\x12 \n" +
-	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x129\n" +
-	"\n" +
-	"quiz_types\x18\x03 \x03(\x0e2\x10.api.v1.QuizTypeB\b\xbaH\x05\x92\x01\x02\b\x01R\tquizTypesJ\x04\b\x02\x10\x03R\tquiz_type\"\x14\n" +
-	"\x12ResumeWordResponse\"\x84\x02\n" +
-	"\vGraphPrompt\x12/\n" +
-	"\x05shape\x18\x01 \x01(\x0e2\x19.api.v1.GraphPrompt.ShapeR\x05shape\x12'\n" +
-	"\x05nodes\x18\x02 \x03(\v2\x11.api.v1.GraphNodeR\x05nodes\x12'\n" +
-	"\x05edges\x18\x03 \x03(\v2\x11.api.v1.GraphEdgeR\x05edges\x12\"\n" +
-	"\rblank_node_id\x18\x04 \x01(\tR\vblankNodeId\"N\n" +
-	"\x05Shape\x12\x15\n" +
+func (x *StartDictationQuizRequest) Reset() {
+	*x = StartDictationQuizRequest{}
+	mi := &file_api_v1_quiz_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDictationQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDictationQuizRequest) ProtoMessage() {}
+
+func (x *StartDictationQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDictationQuizRequest.ProtoReflect.Descriptor instead.
+func (*StartDictationQuizRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StartDictationQuizRequest) GetNotebookIds() []string {
+	if x != nil {
+		return x.NotebookIds
+	}
+	return nil
+}
+
+func (x *StartDictationQuizRequest) GetIncludeUnstudied() bool {
+	if x != nil {
+		return x.IncludeUnstudied
+	}
+	return false
+}
+
+func (x *StartDictationQuizRequest) GetNotebookSections() []*NotebookSection {
+	if x != nil {
+		return x.NotebookSections
+	}
+	return nil
+}
+
+type StartDictationQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*DictationCard       `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartDictationQuizResponse) Reset() {
+	*x = StartDictationQuizResponse{}
+	mi := &file_api_v1_quiz_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDictationQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDictationQuizResponse) ProtoMessage() {}
+
+func (x *StartDictationQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDictationQuizResponse.ProtoReflect.Descriptor instead.
+func (*StartDictationQuizResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StartDictationQuizResponse) GetCards() []*DictationCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// DictationCard is one line to play. The line's text is NOT sent to the
+// client; it is returned by SubmitDictationAnswer once the user answered.
+type DictationCard struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	NoteId     int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	NotebookId string                 `protobuf:"bytes,2,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	StoryTitle string                 `protobuf:"bytes,3,opt,name=story_title,json=storyTitle,proto3" json:"story_title,omitempty"`
+	SceneTitle string                 `protobuf:"bytes,4,opt,name=scene_title,json=sceneTitle,proto3" json:"scene_title,omitempty"`
+	Speaker    string                 `protobuf:"bytes,5,opt,name=speaker,proto3" json:"speaker,omitempty"`
+	// audio is the line's audio, relative to its notebook. Pass it with
+	// notebook_id to NotebookService.StreamNoteAudio.
+	Audio string `protobuf:"bytes,6,opt,name=audio,proto3" json:"audio,omitempty"`
+	// word_count is the number of words in the line, for sizing the answer box.
+	WordCount     int32 `protobuf:"varint,7,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictationCard) Reset() {
+	*x = DictationCard{}
+	mi := &file_api_v1_quiz_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictationCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictationCard) ProtoMessage() {}
+
+func (x *DictationCard) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictationCard.ProtoReflect.Descriptor instead.
+func (*DictationCard) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DictationCard) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *DictationCard) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
+func (x *DictationCard) GetStoryTitle() string {
+	if x != nil {
+		return x.StoryTitle
+	}
+	return ""
+}
+
+func (x *DictationCard) GetSceneTitle() string {
+	if x != nil {
+		return x.SceneTitle
+	}
+	return ""
+}
+
+func (x *DictationCard) GetSpeaker() string {
+	if x != nil {
+		return x.Speaker
+	}
+	return ""
+}
+
+func (x *DictationCard) GetAudio() string {
+	if x != nil {
+		return x.Audio
+	}
+	return ""
+}
+
+func (x *DictationCard) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+type SubmitDictationAnswerRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NoteId int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// answer is what the user heard. When is_skipped is true the field is
+	// ignored and the backend records the result as incorrect without grading.
+	Answer         string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	ResponseTimeMs int64  `protobuf:"varint,3,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	IsSkipped      bool   `protobuf:"varint,4,opt,name=is_skipped,json=isSkipped,proto3" json:"is_skipped,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitDictationAnswerRequest) Reset() {
+	*x = SubmitDictationAnswerRequest{}
+	mi := &file_api_v1_quiz_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDictationAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDictationAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitDictationAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDictationAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDictationAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SubmitDictationAnswerRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *SubmitDictationAnswerRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *SubmitDictationAnswerRequest) GetResponseTimeMs() int64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *SubmitDictationAnswerRequest) GetIsSkipped() bool {
+	if x != nil {
+		return x.IsSkipped
+	}
+	return false
+}
+
+type SubmitDictationAnswerResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Correct bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	// line is the conversation line that was played.
+	Line string `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	// expression is the target expression of the line.
+	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	Meaning    string `protobuf:"bytes,4,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	// reason lists the words that were missed or misheard.
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	NextReviewDate string `protobuf:"bytes,6,opt,name=next_review_date,json=nextReviewDate,proto3" json:"next_review_date,omitempty"`
+	LearnedAt      string `protobuf:"bytes,7,opt,name=learned_at,json=learnedAt,proto3" json:"learned_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitDictationAnswerResponse) Reset() {
+	*x = SubmitDictationAnswerResponse{}
+	mi := &file_api_v1_quiz_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDictationAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDictationAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitDictationAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDictationAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitDictationAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SubmitDictationAnswerResponse) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *SubmitDictationAnswerResponse) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *SubmitDictationAnswerResponse) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *SubmitDictationAnswerResponse) GetMeaning() string {
+	if x != nil {
+		return x.Meaning
+	}
+	return ""
+}
+
+func (x *SubmitDictationAnswerResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubmitDictationAnswerResponse) GetNextReviewDate() string {
+	if x != nil {
+		return x.NextReviewDate
+	}
+	return ""
+}
+
+func (x *SubmitDictationAnswerResponse) GetLearnedAt() string {
+	if x != nil {
+		return x.LearnedAt
+	}
+	return ""
+}
+
+type SubmitReverseAnswerAudioRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NoteId int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// audio is the recorded answer, e.g. what MediaRecorder produced.
+	Audio []byte `protobuf:"bytes,2,opt,name=audio,proto3" json:"audio,omitempty"`
+	// media_type is the type of audio, e.g. "audio/webm;codecs=opus".
+	MediaType      string `protobuf:"bytes,3,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	ResponseTimeMs int64  `protobuf:"varint,4,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitReverseAnswerAudioRequest) Reset() {
+	*x = SubmitReverseAnswerAudioRequest{}
+	mi := &file_api_v1_quiz_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReverseAnswerAudioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReverseAnswerAudioRequest) ProtoMessage() {}
+
+func (x *SubmitReverseAnswerAudioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReverseAnswerAudioRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReverseAnswerAudioRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SubmitReverseAnswerAudioRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *SubmitReverseAnswerAudioRequest) GetAudio() []byte {
+	if x != nil {
+		return x.Audio
+	}
+	return nil
+}
+
+func (x *SubmitReverseAnswerAudioRequest) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *SubmitReverseAnswerAudioRequest) GetResponseTimeMs() int64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+type SubmitReverseAnswerAudioResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// result is the graded transcript, exactly as SubmitReverseAnswer returns
+	// it for a typed answer.
+	Result *SubmitReverseAnswerResponse `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// transcript is what the speech-to-text service heard.
+	Transcript    string `protobuf:"bytes,2,opt,name=transcript,proto3" json:"transcript,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReverseAnswerAudioResponse) Reset() {
+	*x = SubmitReverseAnswerAudioResponse{}
+	mi := &file_api_v1_quiz_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReverseAnswerAudioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReverseAnswerAudioResponse) ProtoMessage() {}
+
+func (x *SubmitReverseAnswerAudioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReverseAnswerAudioResponse.ProtoReflect.Descriptor instead.
+func (*SubmitReverseAnswerAudioResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SubmitReverseAnswerAudioResponse) GetResult() *SubmitReverseAnswerResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SubmitReverseAnswerAudioResponse) GetTranscript() string {
+	if x != nil {
+		return x.Transcript
+	}
+	return ""
+}
+
+type GenerateMnemonicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateMnemonicRequest) Reset() {
+	*x = GenerateMnemonicRequest{}
+	mi := &file_api_v1_quiz_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateMnemonicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMnemonicRequest) ProtoMessage() {}
+
+func (x *GenerateMnemonicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMnemonicRequest.ProtoReflect.Descriptor instead.
+func (*GenerateMnemonicRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GenerateMnemonicRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+type GenerateMnemonicResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// memo is the memory hook written into the note.
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateMnemonicResponse) Reset() {
+	*x = GenerateMnemonicResponse{}
+	mi := &file_api_v1_quiz_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateMnemonicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMnemonicResponse) ProtoMessage() {}
+
+func (x *GenerateMnemonicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMnemonicResponse.ProtoReflect.Descriptor instead.
+func (*GenerateMnemonicResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GenerateMnemonicResponse) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type StartWordChoiceQuizRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NotebookIds      []string               `protobuf:"bytes,1,rep,name=notebook_ids,json=notebookIds,proto3" json:"notebook_ids,omitempty"`
+	IncludeUnstudied bool                   `protobuf:"varint,2,opt,name=include_unstudied,json=includeUnstudied,proto3" json:"include_unstudied,omitempty"`
+	// notebook_sections, when non-empty, replaces notebook_ids and narrows the
+	// quiz to specific sessions within each definitions book.
+	NotebookSections []*NotebookSection `protobuf:"bytes,3,rep,name=notebook_sections,json=notebookSections,proto3" json:"notebook_sections,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartWordChoiceQuizRequest) Reset() {
+	*x = StartWordChoiceQuizRequest{}
+	mi := &file_api_v1_quiz_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartWordChoiceQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartWordChoiceQuizRequest) ProtoMessage() {}
+
+func (x *StartWordChoiceQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartWordChoiceQuizRequest.ProtoReflect.Descriptor instead.
+func (*StartWordChoiceQuizRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *StartWordChoiceQuizRequest) GetNotebookIds() []string {
+	if x != nil {
+		return x.NotebookIds
+	}
+	return nil
+}
+
+func (x *StartWordChoiceQuizRequest) GetIncludeUnstudied() bool {
+	if x != nil {
+		return x.IncludeUnstudied
+	}
+	return false
+}
+
+func (x *StartWordChoiceQuizRequest) GetNotebookSections() []*NotebookSection {
+	if x != nil {
+		return x.NotebookSections
+	}
+	return nil
+}
+
+type StartWordChoiceQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*WordChoiceCard      `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartWordChoiceQuizResponse) Reset() {
+	*x = StartWordChoiceQuizResponse{}
+	mi := &file_api_v1_quiz_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartWordChoiceQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartWordChoiceQuizResponse) ProtoMessage() {}
+
+func (x *StartWordChoiceQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartWordChoiceQuizResponse.ProtoReflect.Descriptor instead.
+func (*StartWordChoiceQuizResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *StartWordChoiceQuizResponse) GetCards() []*WordChoiceCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// WordChoiceCard is one question. A card with a sentence asks which of
+// options fits its blank, and does not reveal which member it was written
+// for. A card without one asks how expression differs from
+// compare_expression.
+type WordChoiceCard struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NoteId         int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	NotebookId     string                 `protobuf:"bytes,2,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	ConceptHead    string                 `protobuf:"bytes,3,opt,name=concept_head,json=conceptHead,proto3" json:"concept_head,omitempty"`
+	ConceptMeaning string                 `protobuf:"bytes,4,opt,name=concept_meaning,json=conceptMeaning,proto3" json:"concept_meaning,omitempty"`
+	// concept_kind is "synonym" or "antonym".
+	ConceptKind string `protobuf:"bytes,5,opt,name=concept_kind,json=conceptKind,proto3" json:"concept_kind,omitempty"`
+	// sentence is an example with the member blanked out as "______".
+	Sentence string `protobuf:"bytes,6,opt,name=sentence,proto3" json:"sentence,omitempty"`
+	// options are every member of the concept, in declaration order.
+	Options []string `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	// expression and compare_expression are set for difference questions only.
+	Expression        string `protobuf:"bytes,8,opt,name=expression,proto3" json:"expression,omitempty"`
+	CompareExpression string `protobuf:"bytes,9,opt,name=compare_expression,json=compareExpression,proto3" json:"compare_expression,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WordChoiceCard) Reset() {
+	*x = WordChoiceCard{}
+	mi := &file_api_v1_quiz_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordChoiceCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordChoiceCard) ProtoMessage() {}
+
+func (x *WordChoiceCard) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordChoiceCard.ProtoReflect.Descriptor instead.
+func (*WordChoiceCard) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *WordChoiceCard) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *WordChoiceCard) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
+func (x *WordChoiceCard) GetConceptHead() string {
+	if x != nil {
+		return x.ConceptHead
+	}
+	return ""
+}
+
+func (x *WordChoiceCard) GetConceptMeaning() string {
+	if x != nil {
+		return x.ConceptMeaning
+	}
+	return ""
+}
+
+func (x *WordChoiceCard) GetConceptKind() string {
+	if x != nil {
+		return x.ConceptKind
+	}
+	return ""
+}
+
+func (x *WordChoiceCard) GetSentence() string {
+	if x != nil {
+		return x.Sentence
+	}
+	return ""
+}
+
+func (x *WordChoiceCard) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *WordChoiceCard) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *WordChoiceCard) GetCompareExpression() string {
+	if x != nil {
+		return x.CompareExpression
+	}
+	return ""
+}
+
+type SubmitWordChoiceAnswerRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NoteId int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// answer is the chosen member, or the explained difference. When
+	// is_skipped is true the field is ignored and the backend records the
+	// result as incorrect without grading.
+	Answer         string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	ResponseTimeMs int64  `protobuf:"varint,3,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	IsSkipped      bool   `protobuf:"varint,4,opt,name=is_skipped,json=isSkipped,proto3" json:"is_skipped,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitWordChoiceAnswerRequest) Reset() {
+	*x = SubmitWordChoiceAnswerRequest{}
+	mi := &file_api_v1_quiz_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitWordChoiceAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitWordChoiceAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitWordChoiceAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitWordChoiceAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitWordChoiceAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SubmitWordChoiceAnswerRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *SubmitWordChoiceAnswerRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *SubmitWordChoiceAnswerRequest) GetResponseTimeMs() int64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *SubmitWordChoiceAnswerRequest) GetIsSkipped() bool {
+	if x != nil {
+		return x.IsSkipped
+	}
+	return false
+}
+
+type SubmitWordChoiceAnswerResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Correct bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	// expression is the member the question was about.
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	Meaning    string `protobuf:"bytes,3,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	// example is the sentence with the blank filled in.
+	Example string `protobuf:"bytes,4,opt,name=example,proto3" json:"example,omitempty"`
+	// members are the members of the concept with their meanings.
+	Members        []*WordChoiceMember `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	Reason         string              `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	NextReviewDate string              `protobuf:"bytes,7,opt,name=next_review_date,json=nextReviewDate,proto3" json:"next_review_date,omitempty"`
+	LearnedAt      string              `protobuf:"bytes,8,opt,name=learned_at,json=learnedAt,proto3" json:"learned_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitWordChoiceAnswerResponse) Reset() {
+	*x = SubmitWordChoiceAnswerResponse{}
+	mi := &file_api_v1_quiz_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitWordChoiceAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitWordChoiceAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitWordChoiceAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitWordChoiceAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitWordChoiceAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SubmitWordChoiceAnswerResponse) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *SubmitWordChoiceAnswerResponse) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *SubmitWordChoiceAnswerResponse) GetMeaning() string {
+	if x != nil {
+		return x.Meaning
+	}
+	return ""
+}
+
+func (x *SubmitWordChoiceAnswerResponse) GetExample() string {
+	if x != nil {
+		return x.Example
+	}
+	return ""
+}
+
+func (x *SubmitWordChoiceAnswerResponse) GetMembers() []*WordChoiceMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *SubmitWordChoiceAnswerResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubmitWordChoiceAnswerResponse) GetNextReviewDate() string {
+	if x != nil {
+		return x.NextReviewDate
+	}
+	return ""
+}
+
+func (x *SubmitWordChoiceAnswerResponse) GetLearnedAt() string {
+	if x != nil {
+		return x.LearnedAt
+	}
+	return ""
+}
+
+type WordChoiceMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Meaning       string                 `protobuf:"bytes,2,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordChoiceMember) Reset() {
+	*x = WordChoiceMember{}
+	mi := &file_api_v1_quiz_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordChoiceMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordChoiceMember) ProtoMessage() {}
+
+func (x *WordChoiceMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordChoiceMember.ProtoReflect.Descriptor instead.
+func (*WordChoiceMember) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *WordChoiceMember) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *WordChoiceMember) GetMeaning() string {
+	if x != nil {
+		return x.Meaning
+	}
+	return ""
+}
+
+type SubmitDiscriminationAnswerRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	NotebookId string                 `protobuf:"bytes,1,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	// words are the two words of the pair.
+	Words []*DiscriminationWord `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	// quiz_types are the pair's ConfusionPair.quiz_types.
+	QuizTypes     []string `protobuf:"bytes,3,rep,name=quiz_types,json=quizTypes,proto3" json:"quiz_types,omitempty"`
+	Correct       bool     `protobuf:"varint,4,opt,name=correct,proto3" json:"correct,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDiscriminationAnswerRequest) Reset() {
+	*x = SubmitDiscriminationAnswerRequest{}
+	mi := &file_api_v1_quiz_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDiscriminationAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDiscriminationAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitDiscriminationAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDiscriminationAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDiscriminationAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SubmitDiscriminationAnswerRequest) GetNotebookId() string {
+	if x != nil {
+		return x.NotebookId
+	}
+	return ""
+}
+
+func (x *SubmitDiscriminationAnswerRequest) GetWords() []*DiscriminationWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *SubmitDiscriminationAnswerRequest) GetQuizTypes() []string {
+	if x != nil {
+		return x.QuizTypes
+	}
+	return nil
+}
+
+func (x *SubmitDiscriminationAnswerRequest) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+type DiscriminationWord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenseId       string                 `protobuf:"bytes,1,opt,name=sense_id,json=senseId,proto3" json:"sense_id,omitempty"`
+	Expression    string                 `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscriminationWord) Reset() {
+	*x = DiscriminationWord{}
+	mi := &file_api_v1_quiz_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscriminationWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscriminationWord) ProtoMessage() {}
+
+func (x *DiscriminationWord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscriminationWord.ProtoReflect.Descriptor instead.
+func (*DiscriminationWord) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DiscriminationWord) GetSenseId() string {
+	if x != nil {
+		return x.SenseId
+	}
+	return ""
+}
+
+func (x *DiscriminationWord) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type SubmitDiscriminationAnswerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// moved_to_relearn_pool is true when a miss put the words in the Relearn
+	// Quiz pool.
+	MovedToRelearnPool bool `protobuf:"varint,1,opt,name=moved_to_relearn_pool,json=movedToRelearnPool,proto3" json:"moved_to_relearn_pool,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SubmitDiscriminationAnswerResponse) Reset() {
+	*x = SubmitDiscriminationAnswerResponse{}
+	mi := &file_api_v1_quiz_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDiscriminationAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDiscriminationAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitDiscriminationAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_quiz_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDiscriminationAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitDiscriminationAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_quiz_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SubmitDiscriminationAnswerResponse) GetMovedToRelearnPool() bool {
+	if x != nil {
+		return x.MovedToRelearnPool
+	}
+	return false
+}
+
+var File_api_v1_quiz_proto protoreflect.FileDescriptor
+
+const file_api_v1_quiz_proto_rawDesc = "" +
+	"\n" +
+	"\x11api/v1/quiz.proto\x12\x06api.v1\x1a\x1bbuf/validate/validate.proto\x1a\x15api/v1/notebook.proto\"D\n" +
+	"\x15GetQuizOptionsRequest\x12+\n" +
+	"\x11include_unstudied\x18\x01 \x01(\bR\x10includeUnstudied\"O\n" +
+	"\x16GetQuizOptionsResponse\x125\n" +
+	"\tnotebooks\x18\x01 \x03(\v2\x17.api.v1.NotebookSummaryR\tnotebooks\"\x98\x04\n" +
+	"\x0fNotebookSummary\x12\x1f\n" +
+	"\vnotebook_id\x18\x01 \x01(\tR\n" +
+	"notebookId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\freview_count\x18\x03 \x01(\x05R\vreviewCount\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x120\n" +
+	"\x14reverse_review_count\x18\x05 \x01(\x05R\x12reverseReviewCount\x124\n" +
+	"\x16etymology_review_count\x18\x06 \x01(\x05R\x14etymologyReviewCount\x12\x1f\n" +
+	"\vhas_content\x18\a \x01(\bR\n" +
+	"hasContent\x12:\n" +
+	"\bsections\x18\b \x03(\v2\x1e.api.v1.NotebookSectionSummaryR\bsections\x12C\n" +
+	"\x1eetymology_reverse_review_count\x18\t \x01(\x05R\x1betymologyReverseReviewCount\x120\n" +
+	"\x14grammar_review_count\x18\n" +
+	" \x01(\x05R\x12grammarReviewCount\x12)\n" +
+	"\x10vocabulary_count\x18\v \x01(\x05R\x0fvocabularyCount\x122\n" +
+	"\x15frequent_review_count\x18\f \x01(\x05R\x13frequentReviewCount\"\xb0\x02\n" +
+	"\x16NotebookSectionSummary\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12!\n" +
+	"\freview_count\x18\x02 \x01(\x05R\vreviewCount\x120\n" +
+	"\x14reverse_review_count\x18\x03 \x01(\x05R\x12reverseReviewCount\x124\n" +
+	"\x16etymology_review_count\x18\x04 \x01(\x05R\x14etymologyReviewCount\x12C\n" +
+	"\x1eetymology_reverse_review_count\x18\x05 \x01(\x05R\x1betymologyReverseReviewCount\x120\n" +
+	"\x14grammar_review_count\x18\x06 \x01(\x05R\x12grammarReviewCount\"b\n" +
+	"\x0fNotebookSection\x12(\n" +
+	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"notebookId\x12%\n" +
+	"\x0esection_titles\x18\x02 \x03(\tR\rsectionTitles\"\xa8\x01\n" +
+	"\x10StartQuizRequest\x12!\n" +
+	"\fnotebook_ids\x18\x01 \x03(\tR\vnotebookIds\x12+\n" +
+	"\x11include_unstudied\x18\x02 \x01(\bR\x10includeUnstudied\x12D\n" +
+	"\x11notebook_sections\x18\x03 \x03(\v2\x17.api.v1.NotebookSectionR\x10notebookSections\"F\n" +
+	"\x11StartQuizResponse\x121\n" +
+	"\n" +
+	"flashcards\x18\x01 \x03(\v2\x11.api.v1.FlashcardR\n" +
+	"flashcards\"\x83\x02\n" +
+	"\tFlashcard\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x14\n" +
+	"\x05entry\x18\x02 \x01(\tR\x05entry\x12+\n" +
+	"\bexamples\x18\x03 \x03(\v2\x0f.api.v1.ExampleR\bexamples\x12%\n" +
+	"\x0eoriginal_entry\x18\x04 \x01(\tR\roriginalEntry\x12!\n" +
+	"\fconcept_head\x18\x05 \x01(\tR\vconceptHead\x12'\n" +
+	"\x0fconcept_members\x18\x06 \x03(\tR\x0econceptMembers\x12'\n" +
+	"\x0fconcept_meaning\x18\a \x01(\tR\x0econceptMeaning\"U\n" +
+	"\aExample\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x18\n" +
+	"\aspeaker\x18\x02 \x01(\tR\aspeaker\x12\x1c\n" +
+	"\thighlight\x18\x03 \x01(\tR\thighlight\"\xf7\x01\n" +
+	"\n" +
+	"WordDetail\x12\x16\n" +
+	"\x06origin\x18\x01 \x01(\tR\x06origin\x12$\n" +
+	"\rpronunciation\x18\x02 \x01(\tR\rpronunciation\x12$\n" +
+	"\x0epart_of_speech\x18\x03 \x01(\tR\fpartOfSpeech\x12\x1a\n" +
+	"\bsynonyms\x18\x04 \x03(\tR\bsynonyms\x12\x1a\n" +
+	"\bantonyms\x18\x05 \x03(\tR\bantonyms\x12\x12\n" +
+	"\x04memo\x18\x06 \x01(\tR\x04memo\x129\n" +
+	"\forigin_parts\x18\a \x03(\v2\x16.api.v1.WordOriginPartR\voriginParts\"r\n" +
+	"\x0eWordOriginPart\x12\x16\n" +
+	"\x06origin\x18\x01 \x01(\tR\x06origin\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x18\n" +
+	"\ameaning\x18\x04 \x01(\tR\ameaning\"\x98\x01\n" +
+	"\x13SubmitAnswerRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12(\n" +
+	"\x10response_time_ms\x18\x03 \x01(\x03R\x0eresponseTimeMs\x12\x1d\n" +
+	"\n" +
+	"is_skipped\x18\x04 \x01(\bR\tisSkipped\"\x93\x02\n" +
+	"\x14SubmitAnswerResponse\x12\x18\n" +
+	"\acorrect\x18\x01 \x01(\bR\acorrect\x12\x18\n" +
+	"\ameaning\x18\x02 \x01(\tR\ameaning\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x123\n" +
+	"\vword_detail\x18\x04 \x01(\v2\x12.api.v1.WordDetailR\n" +
+	"wordDetail\x12(\n" +
+	"\x10next_review_date\x18\x05 \x01(\tR\x0enextReviewDate\x12\x1d\n" +
+	"\n" +
+	"learned_at\x18\x06 \x01(\tR\tlearnedAt\x12\x16\n" +
+	"\x06images\x18\a \x03(\tR\x06images\x12\x19\n" +
+	"\bsense_id\x18\b \x01(\tR\asenseId\"\\\n" +
+	"\x19BatchSubmitAnswersRequest\x12?\n" +
+	"\aanswers\x18\x01 \x03(\v2\x1b.api.v1.SubmitAnswerRequestB\b\xbaH\x05\x92\x01\x02\b\x01R\aanswers\"X\n" +
+	"\x1aBatchSubmitAnswersResponse\x12:\n" +
+	"\tresponses\x18\x01 \x03(\v2\x1c.api.v1.SubmitAnswerResponseR\tresponses\"\xe1\x01\n" +
+	"\x17StartReverseQuizRequest\x12!\n" +
+	"\fnotebook_ids\x18\x01 \x03(\tR\vnotebookIds\x120\n" +
+	"\x14list_missing_context\x18\x02 \x01(\bR\x12listMissingContext\x12D\n" +
+	"\x11notebook_sections\x18\x03 \x03(\v2\x17.api.v1.NotebookSectionR\x10notebookSections\x12+\n" +
+	"\x11include_unstudied\x18\x04 \x01(\bR\x10includeUnstudied\"T\n" +
+	"\x18StartReverseQuizResponse\x128\n" +
+	"\n" +
+	"flashcards\x18\x01 \x03(\v2\x18.api.v1.ReverseFlashcardR\n" +
+	"flashcards\"\xd6\x02\n" +
+	"\x10ReverseFlashcard\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x18\n" +
+	"\ameaning\x18\x02 \x01(\tR\ameaning\x123\n" +
+	"\bcontexts\x18\x03 \x03(\v2\x17.api.v1.ContextSentenceR\bcontexts\x12#\n" +
+	"\rnotebook_name\x18\x04 \x01(\tR\fnotebookName\x12\x1f\n" +
+	"\vstory_title\x18\x05 \x01(\tR\n" +
+	"storyTitle\x12\x1f\n" +
+	"\vscene_title\x18\x06 \x01(\tR\n" +
+	"sceneTitle\x12!\n" +
+	"\fconcept_head\x18\a \x01(\tR\vconceptHead\x12'\n" +
+	"\x0fconcept_members\x18\b \x03(\tR\x0econceptMembers\x12'\n" +
+	"\x0fconcept_meaning\x18\t \x01(\tR\x0econceptMeaning\"R\n" +
+	"\x0fContextSentence\x12\x18\n" +
+	"\acontext\x18\x01 \x01(\tR\acontext\x12%\n" +
+	"\x0emasked_context\x18\x02 \x01(\tR\rmaskedContext\"\xda\x01\n" +
+	"\x1aSubmitReverseAnswerRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12(\n" +
+	"\x10response_time_ms\x18\x03 \x01(\x03R\x0eresponseTimeMs\x129\n" +
+	"\x19accept_synonym_as_correct\x18\x04 \x01(\bR\x16acceptSynonymAsCorrect\x12\x1d\n" +
+	"\n" +
+	"is_skipped\x18\x05 \x01(\bR\tisSkipped\"\xfe\x02\n" +
+	"\x1bSubmitReverseAnswerResponse\x12\x18\n" +
+	"\acorrect\x18\x01 \x01(\bR\acorrect\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\x12\x18\n" +
+	"\ameaning\x18\x03 \x01(\tR\ameaning\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bcontexts\x18\x05 \x03(\tR\bcontexts\x123\n" +
+	"\vword_detail\x18\x06 \x01(\v2\x12.api.v1.WordDetailR\n" +
+	"wordDetail\x12&\n" +
+	"\x0eclassification\x18\a \x01(\tR\x0eclassification\x12(\n" +
+	"\x10next_review_date\x18\b \x01(\tR\x0enextReviewDate\x12\x1d\n" +
+	"\n" +
+	"learned_at\x18\t \x01(\tR\tlearnedAt\x12\x16\n" +
+	"\x06images\x18\n" +
+	" \x03(\tR\x06images\x12\x19\n" +
+	"\bsense_id\x18\v \x01(\tR\asenseId\"j\n" +
+	" BatchSubmitReverseAnswersRequest\x12F\n" +
+	"\aanswers\x18\x01 \x03(\v2\".api.v1.SubmitReverseAnswerRequestB\b\xbaH\x05\x92\x01\x02\b\x01R\aanswers\"f\n" +
+	"!BatchSubmitReverseAnswersResponse\x12A\n" +
+	"\tresponses\x18\x01 \x03(\v2#.api.v1.SubmitReverseAnswerResponseR\tresponses\"\x1a\n" +
+	"\x18StartFreeformQuizRequest\"\xa9\x02\n" +
+	"\x19StartFreeformQuizResponse\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x01 \x01(\x05R\twordCount\x12 \n" +
+	"\vexpressions\x18\x02 \x03(\tR\vexpressions\x12~\n" +
+	"\x1bexpression_next_review_date\x18\x03 \x03(\v2?.api.v1.StartFreeformQuizResponse.ExpressionNextReviewDateEntryR\x18expressionNextReviewDate\x1aK\n" +
+	"\x1dExpressionNextReviewDateEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
+	"\x1bSubmitFreeformAnswerRequest\x12\x1f\n" +
+	"\x04word\x18\x01 \x01(\tB\v\xbaH\br\x06\x10\x012\x02\\SR\x04word\x12%\n" +
+	"\ameaning\x18\x02 \x01(\tB\v\xbaH\br\x06\x10\x012\x02\\SR\ameaning\x12(\n" +
+	"\x10response_time_ms\x18\x03 \x01(\x03R\x0eresponseTimeMs\"\x87\x03\n" +
+	"\x1cSubmitFreeformAnswerResponse\x12\x18\n" +
+	"\acorrect\x18\x01 \x01(\bR\acorrect\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\x12\x18\n" +
+	"\ameaning\x18\x03 \x01(\tR\ameaning\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
+	"\acontext\x18\x05 \x01(\tR\acontext\x12#\n" +
+	"\rnotebook_name\x18\x06 \x01(\tR\fnotebookName\x123\n" +
+	"\vword_detail\x18\a \x01(\v2\x12.api.v1.WordDetailR\n" +
+	"wordDetail\x12(\n" +
+	"\x10next_review_date\x18\b \x01(\tR\x0enextReviewDate\x12\x1d\n" +
+	"\n" +
+	"learned_at\x18\t \x01(\tR\tlearnedAt\x12\x17\n" +
+	"\anote_id\x18\n" +
+	" \x01(\x03R\x06noteId\x12\x16\n" +
+	"\x06images\x18\v \x03(\tR\x06images\x12\x19\n" +
+	"\bsense_id\x18\f \x01(\tR\asenseId\"\xa6\x03\n" +
+	"\x15OverrideAnswerRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12-\n" +
+	"\tquiz_type\x18\x02 \x01(\x0e2\x10.api.v1.QuizTypeR\bquizType\x12&\n" +
+	"\n" +
+	"learned_at\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\n" +
+	"R\tlearnedAt\x12&\n" +
+	"\fmark_correct\x18\x04 \x01(\bH\x00R\vmarkCorrect\x88\x01\x01\x12-\n" +
+	"\x10next_review_date\x18\x05 \x01(\tH\x01R\x0enextReviewDate\x88\x01\x01\x12\x19\n" +
+	"\bsense_id\x18\x06 \x01(\tR\asenseId\x12,\n" +
+	"\x0fword_expression\x18\a \x01(\tH\x02R\x0ewordExpression\x88\x01\x01\x12(\n" +
+	"\rword_excluded\x18\b \x01(\bH\x03R\fwordExcluded\x88\x01\x01B\x0f\n" +
+	"\r_mark_correctB\x13\n" +
+	"\x11_next_review_dateB\x12\n" +
+	"\x10_word_expressionB\x10\n" +
+	"\x0e_word_excluded\"\xfb\x01\n" +
+	"\x16OverrideAnswerResponse\x12(\n" +
+	"\x10next_review_date\x18\x01 \x01(\tR\x0enextReviewDate\x12)\n" +
+	"\x10original_quality\x18\x02 \x01(\x05R\x0foriginalQuality\x12'\n" +
+	"\x0foriginal_status\x18\x03 \x01(\tR\x0eoriginalStatus\x124\n" +
+	"\x16original_interval_days\x18\x04 \x01(\x05R\x14originalIntervalDays\x12'\n" +
+	"\x0foriginal_grader\x18\x06 \x01(\tR\x0eoriginalGraderJ\x04\b\x05\x10\x06\"\xe8\x02\n" +
+	"\x19UndoOverrideAnswerRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12-\n" +
+	"\tquiz_type\x18\x02 \x01(\x0e2\x10.api.v1.QuizTypeR\bquizType\x12&\n" +
+	"\n" +
+	"learned_at\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\n" +
+	"R\tlearnedAt\x12)\n" +
+	"\x10original_quality\x18\x04 \x01(\x05R\x0foriginalQuality\x12'\n" +
+	"\x0foriginal_status\x18\x05 \x01(\tR\x0eoriginalStatus\x124\n" +
+	"\x16original_interval_days\x18\x06 \x01(\x05R\x14originalIntervalDays\x12\x19\n" +
+	"\bsense_id\x18\b \x01(\tR\asenseId\x12'\n" +
+	"\x0foriginal_grader\x18\t \x01(\tR\x0eoriginalGraderJ\x04\b\a\x10\b\"`\n" +
+	"\x1aUndoOverrideAnswerResponse\x12\x18\n" +
+	"\acorrect\x18\x01 \x01(\bR\acorrect\x12(\n" +
+	"\x10next_review_date\x18\x02 \x01(\tR\x0enextReviewDate\"\x9e\x01\n" +
+	"\x0fSkipWordRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x129\n" +
+	"\n" +
+	"quiz_types\x18\x04 \x03(\x0e2\x10.api.v1.QuizTypeB\b\xbaH\x05\x92\x01\x02\b\x01R\tquizTypes\x12\x1d\n" +
+	"\n" +
+	"skip_until\x18\x03 \x01(\tR\tskipUntilJ\x04\b\x02\x10\x03R\tquiz_type\"\x12\n" +
+	"\x10SkipWordResponse\"\x81\x01\n" +
+	"\x11ResumeWordRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x129\n" +
+	"\n" +
+	"quiz_types\x18\x03 \x03(\x0e2\x10.api.v1.QuizTypeB\b\xbaH\x05\x92\x01\x02\b\x01R\tquizTypesJ\x04\b\x02\x10\x03R\tquiz_type\"\x14\n" +
+	"\x12ResumeWordResponse\"\x84\x02\n" +
+	"\vGraphPrompt\x12/\n" +
+	"\x05shape\x18\x01 \x01(\x0e2\x19.api.v1.GraphPrompt.ShapeR\x05shape\x12'\n" +
+	"\x05nodes\x18\x02 \x03(\v2\x11.api.v1.GraphNodeR\x05nodes\x12'\n" +
+	"\x05edges\x18\x03 \x03(\v2\x11.api.v1.GraphEdgeR\x05edges\x12\"\n" +
+	"\rblank_node_id\x18\x04 \x01(\tR\vblankNodeId\"N\n" +
+	"\x05Shape\x12\x15\n" +
 	"\x11SHAPE_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aCLUSTER\x10\x01\x12\x10\n" +
 	"\fANTONYM_PAIR\x10\x02\x12\x0f\n" +
@@ -5013,7 +6275,7 @@ const file_api_v1_quiz_proto_rawDesc = "" +
 	"\fwindow_hours\x18\x01 \x01(\x05B\n" +
 	"\xbaH\a\x1a\x05\x18\xa8\x01(\x00R\vwindowHours\"E\n" +
 	"\x18StartRelearnQuizResponse\x12)\n" +
-	"\x05cards\x18\x01 \x03(\v2\x13.api.v1.RelearnCardR\x05cards\"\xc7\x04\n" +
+	"\x05cards\x18\x01 \x03(\v2\x13.api.v1.RelearnCardR\x05cards\"\xfe\x04\n" +
 	"\vRelearnCard\x12\x17\n" +
 	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x14\n" +
 	"\x05entry\x18\x02 \x01(\tR\x05entry\x12:\n" +
@@ -5031,7 +6293,10 @@ const file_api_v1_quiz_proto_rawDesc = "" +
 	"\x0eorigin_meaning\x18\f \x01(\tR\roriginMeaning\x12#\n" +
 	"\renglish_forms\x18\r \x03(\tR\fenglishForms\x12;\n" +
 	"\x10origin_direction\x18\x0e \x01(\x0e2\x10.api.v1.QuizTypeR\x0foriginDirection\x12?\n" +
-	"\rrelated_words\x18\x0f \x03(\v2\x1a.api.v1.OriginFamilyMemberR\frelatedWords\"B\n" +
+	"\rrelated_words\x18\x0f \x03(\v2\x1a.api.v1.OriginFamilyMemberR\frelatedWords\x12\x14\n" +
+	"\x05audio\x18\x10 \x01(\tR\x05audio\x12\x1f\n" +
+	"\vnotebook_id\x18\x11 \x01(\tR\n" +
+	"notebookId\"B\n" +
 	"\x12OriginFamilyMember\x12\x12\n" +
 	"\x04word\x18\x01 \x01(\tR\x04word\x12\x18\n" +
 	"\ameaning\x18\x02 \x01(\tR\ameaning\"\x9f\x01\n" +
@@ -5154,7 +6419,114 @@ const file_api_v1_quiz_proto_rawDesc = "" +
 	"\n" +
 	"expression\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
 	"expression\"\x1d\n" +
-	"\x1bResumeEtymologyWordResponse*\xc6\x01\n" +
+	"\x1bResumeEtymologyWordResponse\"\xb1\x01\n" +
+	"\x19StartDictationQuizRequest\x12!\n" +
+	"\fnotebook_ids\x18\x01 \x03(\tR\vnotebookIds\x12+\n" +
+	"\x11include_unstudied\x18\x02 \x01(\bR\x10includeUnstudied\x12D\n" +
+	"\x11notebook_sections\x18\x03 \x03(\v2\x17.api.v1.NotebookSectionR\x10notebookSections\"I\n" +
+	"\x1aStartDictationQuizResponse\x12+\n" +
+	"\x05cards\x18\x01 \x03(\v2\x15.api.v1.DictationCardR\x05cards\"\xda\x01\n" +
+	"\rDictationCard\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x1f\n" +
+	"\vnotebook_id\x18\x02 \x01(\tR\n" +
+	"notebookId\x12\x1f\n" +
+	"\vstory_title\x18\x03 \x01(\tR\n" +
+	"storyTitle\x12\x1f\n" +
+	"\vscene_title\x18\x04 \x01(\tR\n" +
+	"sceneTitle\x12\x18\n" +
+	"\aspeaker\x18\x05 \x01(\tR\aspeaker\x12\x14\n" +
+	"\x05audio\x18\x06 \x01(\tR\x05audio\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\a \x01(\x05R\twordCount\"\xa1\x01\n" +
+	"\x1cSubmitDictationAnswerRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12(\n" +
+	"\x10response_time_ms\x18\x03 \x01(\x03R\x0eresponseTimeMs\x12\x1d\n" +
+	"\n" +
+	"is_skipped\x18\x04 \x01(\bR\tisSkipped\"\xe8\x01\n" +
+	"\x1dSubmitDictationAnswerResponse\x12\x18\n" +
+	"\acorrect\x18\x01 \x01(\bR\acorrect\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\tR\x04line\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x03 \x01(\tR\n" +
+	"expression\x12\x18\n" +
+	"\ameaning\x18\x04 \x01(\tR\ameaning\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12(\n" +
+	"\x10next_review_date\x18\x06 \x01(\tR\x0enextReviewDate\x12\x1d\n" +
+	"\n" +
+	"learned_at\x18\a \x01(\tR\tlearnedAt\"\xb9\x01\n" +
+	"\x1fSubmitReverseAnswerAudioRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12\"\n" +
+	"\x05audio\x18\x02 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05R\x05audio\x12&\n" +
+	"\n" +
+	"media_type\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tmediaType\x12(\n" +
+	"\x10response_time_ms\x18\x04 \x01(\x03R\x0eresponseTimeMs\"\x7f\n" +
+	" SubmitReverseAnswerAudioResponse\x12;\n" +
+	"\x06result\x18\x01 \x01(\v2#.api.v1.SubmitReverseAnswerResponseR\x06result\x12\x1e\n" +
+	"\n" +
+	"transcript\x18\x02 \x01(\tR\n" +
+	"transcript\";\n" +
+	"\x17GenerateMnemonicRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\".\n" +
+	"\x18GenerateMnemonicResponse\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\"\xb2\x01\n" +
+	"\x1aStartWordChoiceQuizRequest\x12!\n" +
+	"\fnotebook_ids\x18\x01 \x03(\tR\vnotebookIds\x12+\n" +
+	"\x11include_unstudied\x18\x02 \x01(\bR\x10includeUnstudied\x12D\n" +
+	"\x11notebook_sections\x18\x03 \x03(\v2\x17.api.v1.NotebookSectionR\x10notebookSections\"K\n" +
+	"\x1bStartWordChoiceQuizResponse\x12,\n" +
+	"\x05cards\x18\x01 \x03(\v2\x16.api.v1.WordChoiceCardR\x05cards\"\xbe\x02\n" +
+	"\x0eWordChoiceCard\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x1f\n" +
+	"\vnotebook_id\x18\x02 \x01(\tR\n" +
+	"notebookId\x12!\n" +
+	"\fconcept_head\x18\x03 \x01(\tR\vconceptHead\x12'\n" +
+	"\x0fconcept_meaning\x18\x04 \x01(\tR\x0econceptMeaning\x12!\n" +
+	"\fconcept_kind\x18\x05 \x01(\tR\vconceptKind\x12\x1a\n" +
+	"\bsentence\x18\x06 \x01(\tR\bsentence\x12\x18\n" +
+	"\aoptions\x18\a \x03(\tR\aoptions\x12\x1e\n" +
+	"\n" +
+	"expression\x18\b \x01(\tR\n" +
+	"expression\x12-\n" +
+	"\x12compare_expression\x18\t \x01(\tR\x11compareExpression\"\xa2\x01\n" +
+	"\x1dSubmitWordChoiceAnswerRequest\x12 \n" +
+	"\anote_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06noteId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12(\n" +
+	"\x10response_time_ms\x18\x03 \x01(\x03R\x0eresponseTimeMs\x12\x1d\n" +
+	"\n" +
+	"is_skipped\x18\x04 \x01(\bR\tisSkipped\"\xa3\x02\n" +
+	"\x1eSubmitWordChoiceAnswerResponse\x12\x18\n" +
+	"\acorrect\x18\x01 \x01(\bR\acorrect\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\x12\x18\n" +
+	"\ameaning\x18\x03 \x01(\tR\ameaning\x12\x18\n" +
+	"\aexample\x18\x04 \x01(\tR\aexample\x122\n" +
+	"\amembers\x18\x05 \x03(\v2\x18.api.v1.WordChoiceMemberR\amembers\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12(\n" +
+	"\x10next_review_date\x18\a \x01(\tR\x0enextReviewDate\x12\x1d\n" +
+	"\n" +
+	"learned_at\x18\b \x01(\tR\tlearnedAt\"L\n" +
+	"\x10WordChoiceMember\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x12\x18\n" +
+	"\ameaning\x18\x02 \x01(\tR\ameaning\"\xce\x01\n" +
+	"!SubmitDiscriminationAnswerRequest\x12(\n" +
+	"\vnotebook_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"notebookId\x12<\n" +
+	"\x05words\x18\x02 \x03(\v2\x1a.api.v1.DiscriminationWordB\n" +
+	"\xbaH\a\x92\x01\x04\b\x02\x10\x02R\x05words\x12'\n" +
+	"\n" +
+	"quiz_types\x18\x03 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\tquizTypes\x12\x18\n" +
+	"\acorrect\x18\x04 \x01(\bR\acorrect\"X\n" +
+	"\x12DiscriminationWord\x12\x19\n" +
+	"\bsense_id\x18\x01 \x01(\tR\asenseId\x12'\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"expression\"W\n" +
+	"\"SubmitDiscriminationAnswerResponse\x121\n" +
+	"\x15moved_to_relearn_pool\x18\x01 \x01(\bR\x12movedToRelearnPool*\xfa\x01\n" +
 	"\bQuizType\x12\x19\n" +
 	"\x15QUIZ_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12QUIZ_TYPE_STANDARD\x10\x01\x12\x15\n" +
@@ -5162,7 +6534,10 @@ const file_api_v1_quiz_proto_rawDesc = "" +
 	"\x12QUIZ_TYPE_FREEFORM\x10\x03\x12\x1e\n" +
 	"\x1aQUIZ_TYPE_ETYMOLOGY_ORIGIN\x10\x04\x12\x15\n" +
 	"\x11QUIZ_TYPE_RELEARN\x10\a\x12\x15\n" +
-	"\x11QUIZ_TYPE_GRAMMAR\x10\b\"\x04\b\x05\x10\x05\"\x04\b\x06\x10\x062\xa6\x10\n" +
+	"\x11QUIZ_TYPE_GRAMMAR\x10\b\x12\x17\n" +
+	"\x13QUIZ_TYPE_DICTATION\x10\t\x12\x19\n" +
+	"\x15QUIZ_TYPE_WORD_CHOICE\x10\n" +
+	"\"\x04\b\x05\x10\x05\"\x04\b\x06\x10\x062\xed\x15\n" +
 	"\vQuizService\x12O\n" +
 	"\x0eGetQuizOptions\x12\x1d.api.v1.GetQuizOptionsRequest\x1a\x1e.api.v1.GetQuizOptionsResponse\x12@\n" +
 	"\tStartQuiz\x12\x18.api.v1.StartQuizRequest\x1a\x19.api.v1.StartQuizResponse\x12I\n" +
@@ -5170,7 +6545,8 @@ const file_api_v1_quiz_proto_rawDesc = "" +
 	"\x12BatchSubmitAnswers\x12!.api.v1.BatchSubmitAnswersRequest\x1a\".api.v1.BatchSubmitAnswersResponse\x12U\n" +
 	"\x10StartReverseQuiz\x12\x1f.api.v1.StartReverseQuizRequest\x1a .api.v1.StartReverseQuizResponse\x12^\n" +
 	"\x13SubmitReverseAnswer\x12\".api.v1.SubmitReverseAnswerRequest\x1a#.api.v1.SubmitReverseAnswerResponse\x12p\n" +
-	"\x19BatchSubmitReverseAnswers\x12(.api.v1.BatchSubmitReverseAnswersRequest\x1a).api.v1.BatchSubmitReverseAnswersResponse\x12X\n" +
+	"\x19BatchSubmitReverseAnswers\x12(.api.v1.BatchSubmitReverseAnswersRequest\x1a).api.v1.BatchSubmitReverseAnswersResponse\x12m\n" +
+	"\x18SubmitReverseAnswerAudio\x12'.api.v1.SubmitReverseAnswerAudioRequest\x1a(.api.v1.SubmitReverseAnswerAudioResponse\x12X\n" +
 	"\x11StartFreeformQuiz\x12 .api.v1.StartFreeformQuizRequest\x1a!.api.v1.StartFreeformQuizResponse\x12a\n" +
 	"\x14SubmitFreeformAnswer\x12#.api.v1.SubmitFreeformAnswerRequest\x1a$.api.v1.SubmitFreeformAnswerResponse\x12O\n" +
 	"\x0eOverrideAnswer\x12\x1d.api.v1.OverrideAnswerRequest\x1a\x1e.api.v1.OverrideAnswerResponse\x12[\n" +
@@ -5187,7 +6563,13 @@ const file_api_v1_quiz_proto_rawDesc = "" +
 	"\x11SubmitGrammarPost\x12 .api.v1.SubmitGrammarPostRequest\x1a!.api.v1.SubmitGrammarPostResponse\x12^\n" +
 	"\x13ListGrammarMistakes\x12\".api.v1.ListGrammarMistakesRequest\x1a#.api.v1.ListGrammarMistakesResponse\x12d\n" +
 	"\x15ExcludeGrammarMistake\x12$.api.v1.ExcludeGrammarMistakeRequest\x1a%.api.v1.ExcludeGrammarMistakeResponse\x12a\n" +
-	"\x14ResumeGrammarMistake\x12#.api.v1.ResumeGrammarMistakeRequest\x1a$.api.v1.ResumeGrammarMistakeResponseB8Z6github.com/at-ishikawa/langner/gen-protos/api/v1;apiv1b\x06proto3"
+	"\x14ResumeGrammarMistake\x12#.api.v1.ResumeGrammarMistakeRequest\x1a$.api.v1.ResumeGrammarMistakeResponse\x12[\n" +
+	"\x12StartDictationQuiz\x12!.api.v1.StartDictationQuizRequest\x1a\".api.v1.StartDictationQuizResponse\x12d\n" +
+	"\x15SubmitDictationAnswer\x12$.api.v1.SubmitDictationAnswerRequest\x1a%.api.v1.SubmitDictationAnswerResponse\x12^\n" +
+	"\x13StartWordChoiceQuiz\x12\".api.v1.StartWordChoiceQuizRequest\x1a#.api.v1.StartWordChoiceQuizResponse\x12g\n" +
+	"\x16SubmitWordChoiceAnswer\x12%.api.v1.SubmitWordChoiceAnswerRequest\x1a&.api.v1.SubmitWordChoiceAnswerResponse\x12U\n" +
+	"\x10GenerateMnemonic\x12\x1f.api.v1.GenerateMnemonicRequest\x1a .api.v1.GenerateMnemonicResponse\x12s\n" +
+	"\x1aSubmitDiscriminationAnswer\x12).api.v1.SubmitDiscriminationAnswerRequest\x1a*.api.v1.SubmitDiscriminationAnswerResponseB8Z6github.com/at-ishikawa/langner/gen-protos/api/v1;apiv1b\x06proto3"
 
 var (
 	file_api_v1_quiz_proto_rawDescOnce sync.Once
@@ -5202,79 +6584,97 @@ func file_api_v1_quiz_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_quiz_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_v1_quiz_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_api_v1_quiz_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_api_v1_quiz_proto_goTypes = []any{
-	(QuizType)(0),                             // 0: api.v1.QuizType
-	(GraphPrompt_Shape)(0),                    // 1: api.v1.GraphPrompt.Shape
-	(GraphNode_Kind)(0),                       // 2: api.v1.GraphNode.Kind
-	(*GetQuizOptionsRequest)(nil),             // 3: api.v1.GetQuizOptionsRequest
-	(*GetQuizOptionsResponse)(nil),            // 4: api.v1.GetQuizOptionsResponse
-	(*NotebookSummary)(nil),                   // 5: api.v1.NotebookSummary
-	(*NotebookSectionSummary)(nil),            // 6: api.v1.NotebookSectionSummary
-	(*NotebookSection)(nil),                   // 7: api.v1.NotebookSection
-	(*StartQuizRequest)(nil),                  // 8: api.v1.StartQuizRequest
-	(*StartQuizResponse)(nil),                 // 9: api.v1.StartQuizResponse
-	(*Flashcard)(nil),                         // 10: api.v1.Flashcard
-	(*Example)(nil),                           // 11: api.v1.Example
-	(*WordDetail)(nil),                        // 12: api.v1.WordDetail
-	(*WordOriginPart)(nil),                    // 13: api.v1.WordOriginPart
-	(*SubmitAnswerRequest)(nil),               // 14: api.v1.SubmitAnswerRequest
-	(*SubmitAnswerResponse)(nil),              // 15: api.v1.SubmitAnswerResponse
-	(*BatchSubmitAnswersRequest)(nil),         // 16: api.v1.BatchSubmitAnswersRequest
-	(*BatchSubmitAnswersResponse)(nil),        // 17: api.v1.BatchSubmitAnswersResponse
-	(*StartReverseQuizRequest)(nil),           // 18: api.v1.StartReverseQuizRequest
-	(*StartReverseQuizResponse)(nil),          // 19: api.v1.StartReverseQuizResponse
-	(*ReverseFlashcard)(nil),                  // 20: api.v1.ReverseFlashcard
-	(*ContextSentence)(nil),                   // 21: api.v1.ContextSentence
-	(*SubmitReverseAnswerRequest)(nil),        // 22: api.v1.SubmitReverseAnswerRequest
-	(*SubmitReverseAnswerResponse)(nil),       // 23: api.v1.SubmitReverseAnswerResponse
-	(*BatchSubmitReverseAnswersRequest)(nil),  // 24: api.v1.BatchSubmitReverseAnswersRequest
-	(*BatchSubmitReverseAnswersResponse)(nil), // 25: api.v1.BatchSubmitReverseAnswersResponse
-	(*StartFreeformQuizRequest)(nil),          // 26: api.v1.StartFreeformQuizRequest
-	(*StartFreeformQuizResponse)(nil),         // 27: api.v1.StartFreeformQuizResponse
-	(*SubmitFreeformAnswerRequest)(nil),       // 28: api.v1.SubmitFreeformAnswerRequest
-	(*SubmitFreeformAnswerResponse)(nil),      // 29: api.v1.SubmitFreeformAnswerResponse
-	(*OverrideAnswerRequest)(nil),             // 30: api.v1.OverrideAnswerRequest
-	(*OverrideAnswerResponse)(nil),            // 31: api.v1.OverrideAnswerResponse
-	(*UndoOverrideAnswerRequest)(nil),         // 32: api.v1.UndoOverrideAnswerRequest
-	(*UndoOverrideAnswerResponse)(nil),        // 33: api.v1.UndoOverrideAnswerResponse
-	(*SkipWordRequest)(nil),                   // 34: api.v1.SkipWordRequest
-	(*SkipWordResponse)(nil),                  // 35: api.v1.SkipWordResponse
-	(*ResumeWordRequest)(nil),                 // 36: api.v1.ResumeWordRequest
-	(*ResumeWordResponse)(nil),                // 37: api.v1.ResumeWordResponse
-	(*GraphPrompt)(nil),                       // 38: api.v1.GraphPrompt
-	(*GraphNode)(nil),                         // 39: api.v1.GraphNode
-	(*GraphEdge)(nil),                         // 40: api.v1.GraphEdge
-	(*StartRelearnQuizRequest)(nil),           // 41: api.v1.StartRelearnQuizRequest
-	(*StartRelearnQuizResponse)(nil),          // 42: api.v1.StartRelearnQuizResponse
-	(*RelearnCard)(nil),                       // 43: api.v1.RelearnCard
-	(*OriginFamilyMember)(nil),                // 44: api.v1.OriginFamilyMember
-	(*SubmitRelearnAnswerRequest)(nil),        // 45: api.v1.SubmitRelearnAnswerRequest
-	(*SubmitRelearnAnswerResponse)(nil),       // 46: api.v1.SubmitRelearnAnswerResponse
-	(*RelearnContextScene)(nil),               // 47: api.v1.RelearnContextScene
-	(*RelearnConversationLine)(nil),           // 48: api.v1.RelearnConversationLine
-	(*BatchSubmitRelearnAnswersRequest)(nil),  // 49: api.v1.BatchSubmitRelearnAnswersRequest
-	(*BatchSubmitRelearnAnswersResponse)(nil), // 50: api.v1.BatchSubmitRelearnAnswersResponse
-	(*StartGrammarQuizRequest)(nil),           // 51: api.v1.StartGrammarQuizRequest
-	(*StartGrammarQuizResponse)(nil),          // 52: api.v1.StartGrammarQuizResponse
-	(*GrammarPostCard)(nil),                   // 53: api.v1.GrammarPostCard
-	(*GrammarBlank)(nil),                      // 54: api.v1.GrammarBlank
-	(*SubmitGrammarPostRequest)(nil),          // 55: api.v1.SubmitGrammarPostRequest
-	(*GrammarBlankAnswer)(nil),                // 56: api.v1.GrammarBlankAnswer
-	(*SubmitGrammarPostResponse)(nil),         // 57: api.v1.SubmitGrammarPostResponse
-	(*GrammarBlankResult)(nil),                // 58: api.v1.GrammarBlankResult
-	(*ListGrammarMistakesRequest)(nil),        // 59: api.v1.ListGrammarMistakesRequest
-	(*ListGrammarMistakesResponse)(nil),       // 60: api.v1.ListGrammarMistakesResponse
-	(*GrammarMistake)(nil),                    // 61: api.v1.GrammarMistake
-	(*ExcludeGrammarMistakeRequest)(nil),      // 62: api.v1.ExcludeGrammarMistakeRequest
-	(*ExcludeGrammarMistakeResponse)(nil),     // 63: api.v1.ExcludeGrammarMistakeResponse
-	(*ResumeGrammarMistakeRequest)(nil),       // 64: api.v1.ResumeGrammarMistakeRequest
-	(*ResumeGrammarMistakeResponse)(nil),      // 65: api.v1.ResumeGrammarMistakeResponse
-	(*ExcludeEtymologyWordRequest)(nil),       // 66: api.v1.ExcludeEtymologyWordRequest
-	(*ExcludeEtymologyWordResponse)(nil),      // 67: api.v1.ExcludeEtymologyWordResponse
-	(*ResumeEtymologyWordRequest)(nil),        // 68: api.v1.ResumeEtymologyWordRequest
-	(*ResumeEtymologyWordResponse)(nil),       // 69: api.v1.ResumeEtymologyWordResponse
-	nil,                                       // 70: api.v1.StartFreeformQuizResponse.ExpressionNextReviewDateEntry
+	(QuizType)(0),                              // 0: api.v1.QuizType
+	(GraphPrompt_Shape)(0),                     // 1: api.v1.GraphPrompt.Shape
+	(GraphNode_Kind)(0),                        // 2: api.v1.GraphNode.Kind
+	(*GetQuizOptionsRequest)(nil),              // 3: api.v1.GetQuizOptionsRequest
+	(*GetQuizOptionsResponse)(nil),             // 4: api.v1.GetQuizOptionsResponse
+	(*NotebookSummary)(nil),                    // 5: api.v1.NotebookSummary
+	(*NotebookSectionSummary)(nil),             // 6: api.v1.NotebookSectionSummary
+	(*NotebookSection)(nil),                    // 7: api.v1.NotebookSection
+	(*StartQuizRequest)(nil),                   // 8: api.v1.StartQuizRequest
+	(*StartQuizResponse)(nil),                  // 9: api.v1.StartQuizResponse
+	(*Flashcard)(nil),                          // 10: api.v1.Flashcard
+	(*Example)(nil),                            // 11: api.v1.Example
+	(*WordDetail)(nil),                         // 12: api.v1.WordDetail
+	(*WordOriginPart)(nil),                     // 13: api.v1.WordOriginPart
+	(*SubmitAnswerRequest)(nil),                // 14: api.v1.SubmitAnswerRequest
+	(*SubmitAnswerResponse)(nil),               // 15: api.v1.SubmitAnswerResponse
+	(*BatchSubmitAnswersRequest)(nil),          // 16: api.v1.BatchSubmitAnswersRequest
+	(*BatchSubmitAnswersResponse)(nil),         // 17: api.v1.BatchSubmitAnswersResponse
+	(*StartReverseQuizRequest)(nil),            // 18: api.v1.StartReverseQuizRequest
+	(*StartReverseQuizResponse)(nil),           // 19: api.v1.StartReverseQuizResponse
+	(*ReverseFlashcard)(nil),                   // 20: api.v1.ReverseFlashcard
+	(*ContextSentence)(nil),                    // 21: api.v1.ContextSentence
+	(*SubmitReverseAnswerRequest)(nil),         // 22: api.v1.SubmitReverseAnswerRequest
+	(*SubmitReverseAnswerResponse)(nil),        // 23: api.v1.SubmitReverseAnswerResponse
+	(*BatchSubmitReverseAnswersRequest)(nil),   // 24: api.v1.BatchSubmitReverseAnswersRequest
+	(*BatchSubmitReverseAnswersResponse)(nil),  // 25: api.v1.BatchSubmitReverseAnswersResponse
+	(*StartFreeformQuizRequest)(nil),           // 26: api.v1.StartFreeformQuizRequest
+	(*StartFreeformQuizResponse)(nil),          // 27: api.v1.StartFreeformQuizResponse
+	(*SubmitFreeformAnswerRequest)(nil),        // 28: api.v1.SubmitFreeformAnswerRequest
+	(*SubmitFreeformAnswerResponse)(nil),       // 29: api.v1.SubmitFreeformAnswerResponse
+	(*OverrideAnswerRequest)(nil),              // 30: api.v1.OverrideAnswerRequest
+	(*OverrideAnswerResponse)(nil),             // 31: api.v1.OverrideAnswerResponse
+	(*UndoOverrideAnswerRequest)(nil),          // 32: api.v1.UndoOverrideAnswerRequest
+	(*UndoOverrideAnswerResponse)(nil),         // 33: api.v1.UndoOverrideAnswerResponse
+	(*SkipWordRequest)(nil),                    // 34: api.v1.SkipWordRequest
+	(*SkipWordResponse)(nil),                   // 35: api.v1.SkipWordResponse
+	(*ResumeWordRequest)(nil),                  // 36: api.v1.ResumeWordRequest
+	(*ResumeWordResponse)(nil),                 // 37: api.v1.ResumeWordResponse
+	(*GraphPrompt)(nil),                        // 38: api.v1.GraphPrompt
+	(*GraphNode)(nil),                          // 39: api.v1.GraphNode
+	(*GraphEdge)(nil),                          // 40: api.v1.GraphEdge
+	(*StartRelearnQuizRequest)(nil),            // 41: api.v1.StartRelearnQuizRequest
+	(*StartRelearnQuizResponse)(nil),           // 42: api.v1.StartRelearnQuizResponse
+	(*RelearnCard)(nil),                        // 43: api.v1.RelearnCard
+	(*OriginFamilyMember)(nil),                 // 44: api.v1.OriginFamilyMember
+	(*SubmitRelearnAnswerRequest)(nil),         // 45: api.v1.SubmitRelearnAnswerRequest
+	(*SubmitRelearnAnswerResponse)(nil),        // 46: api.v1.SubmitRelearnAnswerResponse
+	(*RelearnContextScene)(nil),                // 47: api.v1.RelearnContextScene
+	(*RelearnConversationLine)(nil),            // 48: api.v1.RelearnConversationLine
+	(*BatchSubmitRelearnAnswersRequest)(nil),   // 49: api.v1.BatchSubmitRelearnAnswersRequest
+	(*BatchSubmitRelearnAnswersResponse)(nil),  // 50: api.v1.BatchSubmitRelearnAnswersResponse
+	(*StartGrammarQuizRequest)(nil),            // 51: api.v1.StartGrammarQuizRequest
+	(*StartGrammarQuizResponse)(nil),           // 52: api.v1.StartGrammarQuizResponse
+	(*GrammarPostCard)(nil),                    // 53: api.v1.GrammarPostCard
+	(*GrammarBlank)(nil),                       // 54: api.v1.GrammarBlank
+	(*SubmitGrammarPostRequest)(nil),           // 55: api.v1.SubmitGrammarPostRequest
+	(*GrammarBlankAnswer)(nil),                 // 56: api.v1.GrammarBlankAnswer
+	(*SubmitGrammarPostResponse)(nil),          // 57: api.v1.SubmitGrammarPostResponse
+	(*GrammarBlankResult)(nil),                 // 58: api.v1.GrammarBlankResult
+	(*ListGrammarMistakesRequest)(nil),         // 59: api.v1.ListGrammarMistakesRequest
+	(*ListGrammarMistakesResponse)(nil),        // 60: api.v1.ListGrammarMistakesResponse
+	(*GrammarMistake)(nil),                     // 61: api.v1.GrammarMistake
+	(*ExcludeGrammarMistakeRequest)(nil),       // 62: api.v1.ExcludeGrammarMistakeRequest
+	(*ExcludeGrammarMistakeResponse)(nil),      // 63: api.v1.ExcludeGrammarMistakeResponse
+	(*ResumeGrammarMistakeRequest)(nil),        // 64: api.v1.ResumeGrammarMistakeRequest
+	(*ResumeGrammarMistakeResponse)(nil),       // 65: api.v1.ResumeGrammarMistakeResponse
+	(*ExcludeEtymologyWordRequest)(nil),        // 66: api.v1.ExcludeEtymologyWordRequest
+	(*ExcludeEtymologyWordResponse)(nil),       // 67: api.v1.ExcludeEtymologyWordResponse
+	(*ResumeEtymologyWordRequest)(nil),         // 68: api.v1.ResumeEtymologyWordRequest
+	(*ResumeEtymologyWordResponse)(nil),        // 69: api.v1.ResumeEtymologyWordResponse
+	(*StartDictationQuizRequest)(nil),          // 70: api.v1.StartDictationQuizRequest
+	(*StartDictationQuizResponse)(nil),         // 71: api.v1.StartDictationQuizResponse
+	(*DictationCard)(nil),                      // 72: api.v1.DictationCard
+	(*SubmitDictationAnswerRequest)(nil),       // 73: api.v1.SubmitDictationAnswerRequest
+	(*SubmitDictationAnswerResponse)(nil),      // 74: api.v1.SubmitDictationAnswerResponse
+	(*SubmitReverseAnswerAudioRequest)(nil),    // 75: api.v1.SubmitReverseAnswerAudioRequest
+	(*SubmitReverseAnswerAudioResponse)(nil),   // 76: api.v1.SubmitReverseAnswerAudioResponse
+	(*GenerateMnemonicRequest)(nil),            // 77: api.v1.GenerateMnemonicRequest
+	(*GenerateMnemonicResponse)(nil),           // 78: api.v1.GenerateMnemonicResponse
+	(*StartWordChoiceQuizRequest)(nil),         // 79: api.v1.StartWordChoiceQuizRequest
+	(*StartWordChoiceQuizResponse)(nil),        // 80: api.v1.StartWordChoiceQuizResponse
+	(*WordChoiceCard)(nil),                     // 81: api.v1.WordChoiceCard
+	(*SubmitWordChoiceAnswerRequest)(nil),      // 82: api.v1.SubmitWordChoiceAnswerRequest
+	(*SubmitWordChoiceAnswerResponse)(nil),     // 83: api.v1.SubmitWordChoiceAnswerResponse
+	(*WordChoiceMember)(nil),                   // 84: api.v1.WordChoiceMember
+	(*SubmitDiscriminationAnswerRequest)(nil),  // 85: api.v1.SubmitDiscriminationAnswerRequest
+	(*DiscriminationWord)(nil),                 // 86: api.v1.DiscriminationWord
+	(*SubmitDiscriminationAnswerResponse)(nil), // 87: api.v1.SubmitDiscriminationAnswerResponse
+	nil, // 88: api.v1.StartFreeformQuizResponse.ExpressionNextReviewDateEntry
 }
 var file_api_v1_quiz_proto_depIdxs = []int32{
 	5,  // 0: api.v1.GetQuizOptionsResponse.notebooks:type_name -> api.v1.NotebookSummary
@@ -5292,7 +6692,7 @@ var file_api_v1_quiz_proto_depIdxs = []int32{
 	12, // 12: api.v1.SubmitReverseAnswerResponse.word_detail:type_name -> api.v1.WordDetail
 	22, // 13: api.v1.BatchSubmitReverseAnswersRequest.answers:type_name -> api.v1.SubmitReverseAnswerRequest
 	23, // 14: api.v1.BatchSubmitReverseAnswersResponse.responses:type_name -> api.v1.SubmitReverseAnswerResponse
-	70, // 15: api.v1.StartFreeformQuizResponse.expression_next_review_date:type_name -> api.v1.StartFreeformQuizResponse.ExpressionNextReviewDateEntry
+	88, // 15: api.v1.StartFreeformQuizResponse.expression_next_review_date:type_name -> api.v1.StartFreeformQuizResponse.ExpressionNextReviewDateEntry
 	12, // 16: api.v1.SubmitFreeformAnswerResponse.word_detail:type_name -> api.v1.WordDetail
 	0,  // 17: api.v1.OverrideAnswerRequest.quiz_type:type_name -> api.v1.QuizType
 	0,  // 18: api.v1.UndoOverrideAnswerRequest.quiz_type:type_name -> api.v1.QuizType
@@ -5319,57 +6719,78 @@ var file_api_v1_quiz_proto_depIdxs = []int32{
 	56, // 39: api.v1.SubmitGrammarPostRequest.answers:type_name -> api.v1.GrammarBlankAnswer
 	58, // 40: api.v1.SubmitGrammarPostResponse.results:type_name -> api.v1.GrammarBlankResult
 	61, // 41: api.v1.ListGrammarMistakesResponse.mistakes:type_name -> api.v1.GrammarMistake
-	3,  // 42: api.v1.QuizService.GetQuizOptions:input_type -> api.v1.GetQuizOptionsRequest
-	8,  // 43: api.v1.QuizService.StartQuiz:input_type -> api.v1.StartQuizRequest
-	14, // 44: api.v1.QuizService.SubmitAnswer:input_type -> api.v1.SubmitAnswerRequest
-	16, // 45: api.v1.QuizService.BatchSubmitAnswers:input_type -> api.v1.BatchSubmitAnswersRequest
-	18, // 46: api.v1.QuizService.StartReverseQuiz:input_type -> api.v1.StartReverseQuizRequest
-	22, // 47: api.v1.QuizService.SubmitReverseAnswer:input_type -> api.v1.SubmitReverseAnswerRequest
-	24, // 48: api.v1.QuizService.BatchSubmitReverseAnswers:input_type -> api.v1.BatchSubmitReverseAnswersRequest
-	26, // 49: api.v1.QuizService.StartFreeformQuiz:input_type -> api.v1.StartFreeformQuizRequest
-	28, // 50: api.v1.QuizService.SubmitFreeformAnswer:input_type -> api.v1.SubmitFreeformAnswerRequest
-	30, // 51: api.v1.QuizService.OverrideAnswer:input_type -> api.v1.OverrideAnswerRequest
-	32, // 52: api.v1.QuizService.UndoOverrideAnswer:input_type -> api.v1.UndoOverrideAnswerRequest
-	34, // 53: api.v1.QuizService.SkipWord:input_type -> api.v1.SkipWordRequest
-	36, // 54: api.v1.QuizService.ResumeWord:input_type -> api.v1.ResumeWordRequest
-	66, // 55: api.v1.QuizService.ExcludeEtymologyWord:input_type -> api.v1.ExcludeEtymologyWordRequest
-	68, // 56: api.v1.QuizService.ResumeEtymologyWord:input_type -> api.v1.ResumeEtymologyWordRequest
-	41, // 57: api.v1.QuizService.StartRelearnQuiz:input_type -> api.v1.StartRelearnQuizRequest
-	45, // 58: api.v1.QuizService.SubmitRelearnAnswer:input_type -> api.v1.SubmitRelearnAnswerRequest
-	49, // 59: api.v1.QuizService.BatchSubmitRelearnAnswers:input_type -> api.v1.BatchSubmitRelearnAnswersRequest
-	51, // 60: api.v1.QuizService.StartGrammarQuiz:input_type -> api.v1.StartGrammarQuizRequest
-	55, // 61: api.v1.QuizService.SubmitGrammarPost:input_type -> api.v1.SubmitGrammarPostRequest
-	59, // 62: api.v1.QuizService.ListGrammarMistakes:input_type -> api.v1.ListGrammarMistakesRequest
-	62, // 63: api.v1.QuizService.ExcludeGrammarMistake:input_type -> api.v1.ExcludeGrammarMistakeRequest
-	64, // 64: api.v1.QuizService.ResumeGrammarMistake:input_type -> api.v1.ResumeGrammarMistakeRequest
-	4,  // 65: api.v1.QuizService.GetQuizOptions:output_type -> api.v1.GetQuizOptionsResponse
-	9,  // 66: api.v1.QuizService.StartQuiz:output_type -> api.v1.StartQuizResponse
-	15, // 67: api.v1.QuizService.SubmitAnswer:output_type -> api.v1.SubmitAnswerResponse
-	17, // 68: api.v1.QuizService.BatchSubmitAnswers:output_type -> api.v1.BatchSubmitAnswersResponse
-	19, // 69: api.v1.QuizService.StartReverseQuiz:output_type -> api.v1.StartReverseQuizResponse
-	23, // 70: api.v1.QuizService.SubmitReverseAnswer:output_type -> api.v1.SubmitReverseAnswerResponse
-	25, // 71: api.v1.QuizService.BatchSubmitReverseAnswers:output_type -> api.v1.BatchSubmitReverseAnswersResponse
-	27, // 72: api.v1.QuizService.StartFreeformQuiz:output_type -> api.v1.StartFreeformQuizResponse
-	29, // 73: api.v1.QuizService.SubmitFreeformAnswer:output_type -> api.v1.SubmitFreeformAnswerResponse
-	31, // 74: api.v1.QuizService.OverrideAnswer:output_type -> api.v1.OverrideAnswerResponse
-	33, // 75: api.v1.QuizService.UndoOverrideAnswer:output_type -> api.v1.UndoOverrideAnswerResponse
-	35, // 76: api.v1.QuizService.SkipWord:output_type -> api.v1.SkipWordResponse
-	37, // 77: api.v1.QuizService.ResumeWord:output_type -> api.v1.ResumeWordResponse
-	67, // 78: api.v1.QuizService.ExcludeEtymologyWord:output_type -> api.v1.ExcludeEtymologyWordResponse
-	69, // 79: api.v1.QuizService.ResumeEtymologyWord:output_type -> api.v1.ResumeEtymologyWordResponse
-	42, // 80: api.v1.QuizService.StartRelearnQuiz:output_type -> api.v1.StartRelearnQuizResponse
-	46, // 81: api.v1.QuizService.SubmitRelearnAnswer:output_type -> api.v1.SubmitRelearnAnswerResponse
-	50, // 82: api.v1.QuizService.BatchSubmitRelearnAnswers:output_type -> api.v1.BatchSubmitRelearnAnswersResponse
-	52, // 83: api.v1.QuizService.StartGrammarQuiz:output_type -> api.v1.StartGrammarQuizResponse
-	57, // 84: api.v1.QuizService.SubmitGrammarPost:output_type -> api.v1.SubmitGrammarPostResponse
-	60, // 85: api.v1.QuizService.ListGrammarMistakes:output_type -> api.v1.ListGrammarMistakesResponse
-	63, // 86: api.v1.QuizService.ExcludeGrammarMistake:output_type -> api.v1.ExcludeGrammarMistakeResponse
-	65, // 87: api.v1.QuizService.ResumeGrammarMistake:output_type -> api.v1.ResumeGrammarMistakeResponse
-	65, // [65:88] is the sub-list for method output_type
-	42, // [42:65] is the sub-list for method input_type
-	42, // [42:42] is the sub-list for extension type_name
-	42, // [42:42] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
+	7,  // 42: api.v1.StartDictationQuizRequest.notebook_sections:type_name -> api.v1.NotebookSection
+	72, // 43: api.v1.StartDictationQuizResponse.cards:type_name -> api.v1.DictationCard
+	23, // 44: api.v1.SubmitReverseAnswerAudioResponse.result:type_name -> api.v1.SubmitReverseAnswerResponse
+	7,  // 45: api.v1.StartWordChoiceQuizRequest.notebook_sections:type_name -> api.v1.NotebookSection
+	81, // 46: api.v1.StartWordChoiceQuizResponse.cards:type_name -> api.v1.WordChoiceCard
+	84, // 47: api.v1.SubmitWordChoiceAnswerResponse.members:type_name -> api.v1.WordChoiceMember
+	86, // 48: api.v1.SubmitDiscriminationAnswerRequest.words:type_name -> api.v1.DiscriminationWord
+	3,  // 49: api.v1.QuizService.GetQuizOptions:input_type -> api.v1.GetQuizOptionsRequest
+	8,  // 50: api.v1.QuizService.StartQuiz:input_type -> api.v1.StartQuizRequest
+	14, // 51: api.v1.QuizService.SubmitAnswer:input_type -> api.v1.SubmitAnswerRequest
+	16, // 52: api.v1.QuizService.BatchSubmitAnswers:input_type -> api.v1.BatchSubmitAnswersRequest
+	18, // 53: api.v1.QuizService.StartReverseQuiz:input_type -> api.v1.StartReverseQuizRequest
+	22, // 54: api.v1.QuizService.SubmitReverseAnswer:input_type -> api.v1.SubmitReverseAnswerRequest
+	24, // 55: api.v1.QuizService.BatchSubmitReverseAnswers:input_type -> api.v1.BatchSubmitReverseAnswersRequest
+	75, // 56: api.v1.QuizService.SubmitReverseAnswerAudio:input_type -> api.v1.SubmitReverseAnswerAudioRequest
+	26, // 57: api.v1.QuizService.StartFreeformQuiz:input_type -> api.v1.StartFreeformQuizRequest
+	28, // 58: api.v1.QuizService.SubmitFreeformAnswer:input_type -> api.v1.SubmitFreeformAnswerRequest
+	30, // 59: api.v1.QuizService.OverrideAnswer:input_type -> api.v1.OverrideAnswerRequest
+	32, // 60: api.v1.QuizService.UndoOverrideAnswer:input_type -> api.v1.UndoOverrideAnswerRequest
+	34, // 61: api.v1.QuizService.SkipWord:input_type -> api.v1.SkipWordRequest
+	36, // 62: api.v1.QuizService.ResumeWord:input_type -> api.v1.ResumeWordRequest
+	66, // 63: api.v1.QuizService.ExcludeEtymologyWord:input_type -> api.v1.ExcludeEtymologyWordRequest
+	68, // 64: api.v1.QuizService.ResumeEtymologyWord:input_type -> api.v1.ResumeEtymologyWordRequest
+	41, // 65: api.v1.QuizService.StartRelearnQuiz:input_type -> api.v1.StartRelearnQuizRequest
+	45, // 66: api.v1.QuizService.SubmitRelearnAnswer:input_type -> api.v1.SubmitRelearnAnswerRequest
+	49, // 67: api.v1.QuizService.BatchSubmitRelearnAnswers:input_type -> api.v1.BatchSubmitRelearnAnswersRequest
+	51, // 68: api.v1.QuizService.StartGrammarQuiz:input_type -> api.v1.StartGrammarQuizRequest
+	55, // 69: api.v1.QuizService.SubmitGrammarPost:input_type -> api.v1.SubmitGrammarPostRequest
+	59, // 70: api.v1.QuizService.ListGrammarMistakes:input_type -> api.v1.ListGrammarMistakesRequest
+	62, // 71: api.v1.QuizService.ExcludeGrammarMistake:input_type -> api.v1.ExcludeGrammarMistakeRequest
+	64, // 72: api.v1.QuizService.ResumeGrammarMistake:input_type -> api.v1.ResumeGrammarMistakeRequest
+	70, // 73: api.v1.QuizService.StartDictationQuiz:input_type -> api.v1.StartDictationQuizRequest
+	73, // 74: api.v1.QuizService.SubmitDictationAnswer:input_type -> api.v1.SubmitDictationAnswerRequest
+	79, // 75: api.v1.QuizService.StartWordChoiceQuiz:input_type -> api.v1.StartWordChoiceQuizRequest
+	82, // 76: api.v1.QuizService.SubmitWordChoiceAnswer:input_type -> api.v1.SubmitWordChoiceAnswerRequest
+	77, // 77: api.v1.QuizService.GenerateMnemonic:input_type -> api.v1.GenerateMnemonicRequest
+	85, // 78: api.v1.QuizService.SubmitDiscriminationAnswer:input_type -> api.v1.SubmitDiscriminationAnswerRequest
+	4,  // 79: api.v1.QuizService.GetQuizOptions:output_type -> api.v1.GetQuizOptionsResponse
+	9,  // 80: api.v1.QuizService.StartQuiz:output_type -> api.v1.StartQuizResponse
+	15, // 81: api.v1.QuizService.SubmitAnswer:output_type -> api.v1.SubmitAnswerResponse
+	17, // 82: api.v1.QuizService.BatchSubmitAnswers:output_type -> api.v1.BatchSubmitAnswersResponse
+	19, // 83: api.v1.QuizService.StartReverseQuiz:output_type -> api.v1.StartReverseQuizResponse
+	23, // 84: api.v1.QuizService.SubmitReverseAnswer:output_type -> api.v1.SubmitReverseAnswerResponse
+	25, // 85: api.v1.QuizService.BatchSubmitReverseAnswers:output_type -> api.v1.BatchSubmitReverseAnswersResponse
+	76, // 86: api.v1.QuizService.SubmitReverseAnswerAudio:output_type -> api.v1.SubmitReverseAnswerAudioResponse
+	27, // 87: api.v1.QuizService.StartFreeformQuiz:output_type -> api.v1.StartFreeformQuizResponse
+	29, // 88: api.v1.QuizService.SubmitFreeformAnswer:output_type -> api.v1.SubmitFreeformAnswerResponse
+	31, // 89: api.v1.QuizService.OverrideAnswer:output_type -> api.v1.OverrideAnswerResponse
+	33, // 90: api.v1.QuizService.UndoOverrideAnswer:output_type -> api.v1.UndoOverrideAnswerResponse
+	35, // 91: api.v1.QuizService.SkipWord:output_type -> api.v1.SkipWordResponse
+	37, // 92: api.v1.QuizService.ResumeWord:output_type -> api.v1.ResumeWordResponse
+	67, // 93: api.v1.QuizService.ExcludeEtymologyWord:output_type -> api.v1.ExcludeEtymologyWordResponse
+	69, // 94: api.v1.QuizService.ResumeEtymologyWord:output_type -> api.v1.ResumeEtymologyWordResponse
+	42, // 95: api.v1.QuizService.StartRelearnQuiz:output_type -> api.v1.StartRelearnQuizResponse
+	46, // 96: api.v1.QuizService.SubmitRelearnAnswer:output_type -> api.v1.SubmitRelearnAnswerResponse
+	50, // 97: api.v1.QuizService.BatchSubmitRelearnAnswers:output_type -> api.v1.BatchSubmitRelearnAnswersResponse
+	52, // 98: api.v1.QuizService.StartGrammarQuiz:output_type -> api.v1.StartGrammarQuizResponse
+	57, // 99: api.v1.QuizService.SubmitGrammarPost:output_type -> api.v1.SubmitGrammarPostResponse
+	60, // 100: api.v1.QuizService.ListGrammarMistakes:output_type -> api.v1.ListGrammarMistakesResponse
+	63, // 101: api.v1.QuizService.ExcludeGrammarMistake:output_type -> api.v1.ExcludeGrammarMistakeResponse
+	65, // 102: api.v1.QuizService.ResumeGrammarMistake:output_type -> api.v1.ResumeGrammarMistakeResponse
+	71, // 103: api.v1.QuizService.StartDictationQuiz:output_type -> api.v1.StartDictationQuizResponse
+	74, // 104: api.v1.QuizService.SubmitDictationAnswer:output_type -> api.v1.SubmitDictationAnswerResponse
+	80, // 105: api.v1.QuizService.StartWordChoiceQuiz:output_type -> api.v1.StartWordChoiceQuizResponse
+	83, // 106: api.v1.QuizService.SubmitWordChoiceAnswer:output_type -> api.v1.SubmitWordChoiceAnswerResponse
+	78, // 107: api.v1.QuizService.GenerateMnemonic:output_type -> api.v1.GenerateMnemonicResponse
+	87, // 108: api.v1.QuizService.SubmitDiscriminationAnswer:output_type -> api.v1.SubmitDiscriminationAnswerResponse
+	79, // [79:109] is the sub-list for method output_type
+	49, // [49:79] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_quiz_proto_init() }
@@ -5385,7 +6806,7 @@ func file_api_v1_quiz_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_quiz_proto_rawDesc), len(file_api_v1_quiz_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   68,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
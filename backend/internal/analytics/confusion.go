@@ -0,0 +1,358 @@
+package analytics
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/at-ishikawa/langner/internal/lemma"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// minConfusionMeaningScore is the share of another note's meaning words a
+// wrong answer must contain to count as a confusion with that note.
+const minConfusionMeaningScore = 0.6
+
+// maxConfusionAnswers caps the sample answers kept per confusion pair.
+const maxConfusionAnswers = 3
+
+// ConfusionQuery bundles the arguments of Repository.Confusions.
+type ConfusionQuery struct {
+	Filters Filters
+}
+
+// ConfusionCandidate is a note a wrong answer may have been meant for.
+type ConfusionCandidate struct {
+	ID         string
+	Expression string
+	Meaning    string
+	Example    string
+}
+
+// ConfusionCandidateSource lists the notes of a notebook a wrong answer is
+// matched against. A MetadataResolver that also implements it enables
+// Repository.Confusions; without one no confusions are found.
+type ConfusionCandidateSource interface {
+	ConfusionCandidates(ctx context.Context, notebookID string) []ConfusionCandidate
+}
+
+// NotebookTitleSource names a notebook by its ID. A MetadataResolver that
+// also implements it gives confusion pairs the notebook's display name; the
+// DB logs only carry the ID.
+type NotebookTitleSource interface {
+	NotebookTitle(ctx context.Context, notebookID string) string
+}
+
+// AnsweredAttempt is an attempt with what the learner answered.
+type AnsweredAttempt struct {
+	Attempt
+	Answer string
+}
+
+// ConfusionSeries is one word × quiz type log series fed into
+// FindConfusions.
+type ConfusionSeries struct {
+	ID            string
+	Expression    string
+	NotebookID    string
+	NotebookTitle string
+	QuizType      string
+	// Attempts is the series, newest-first.
+	Attempts []AnsweredAttempt
+}
+
+// ConfusionWord is one side of a confusion pair.
+type ConfusionWord struct {
+	ID         string
+	Expression string
+	Meaning    string
+	Example    string
+}
+
+// Confusion is a pair of notes of one notebook the learner mixes up: a
+// wrong answer for one of them was the other's meaning, or, in a reverse
+// quiz, the other word itself.
+type Confusion struct {
+	NotebookID    string
+	NotebookTitle string
+	// Words are the two notes, ordered by expression.
+	Words          [2]ConfusionWord
+	Count          int
+	LastConfusedAt time.Time
+	QuizTypes      []string
+	// Answers are the distinct wrong answers that matched, newest-first.
+	Answers []string
+	// Resolved is true once every word of the pair has been answered
+	// correctly in a quiz type it was confused in since its last confusion.
+	Resolved bool
+}
+
+// confusionQuizTypes are the quiz types whose answers are a meaning or a
+// word of the notebook's vocabulary.
+var confusionQuizTypes = map[string]bool{
+	string(notebook.QuizTypeNotebook): true,
+	string(notebook.QuizTypeReverse):  true,
+	string(notebook.QuizTypeFreeform): true,
+}
+
+// confusionPairKey identifies a confusion pair by its two words, ordered.
+type confusionPairKey struct {
+	notebookID string
+	first      string
+	second     string
+}
+
+// FindConfusions matches every wrong, answered vocabulary attempt against
+// the other notes of its notebook and returns the resulting pairs:
+// unresolved first, then the most confused, the most recent, and by
+// expression. Candidates are scoped to the notebook rather than to a
+// definitions-book concept: a concept groups notes of one book, so its
+// confusions are already among the notebook's.
+func FindConfusions(series []ConfusionSeries, candidates func(notebookID string) []ConfusionCandidate) []Confusion {
+	index := map[confusionPairKey]int{}
+	var pairs []Confusion
+	// resolvedSeries records, per series with a confusion, whether a
+	// correct attempt followed its last one; pairSeries the series that
+	// make up each pair.
+	resolvedSeries := map[wordKey]bool{}
+	pairSeries := map[confusionPairKey][]wordKey{}
+	byNotebook := map[string][]ConfusionCandidate{}
+
+	for _, s := range series {
+		if !confusionQuizTypes[s.QuizType] {
+			continue
+		}
+		cands, ok := byNotebook[s.NotebookID]
+		if !ok {
+			cands = candidates(s.NotebookID)
+			byNotebook[s.NotebookID] = cands
+		}
+		self, ok := findConfusionCandidate(cands, s.ID, s.Expression)
+		if !ok {
+			self = ConfusionCandidate{ID: s.ID, Expression: s.Expression}
+		}
+		sk := wordKey{notebookID: s.NotebookID, discriminator: seriesDiscriminator(s.ID, s.Expression), quizType: s.QuizType}
+		for i, a := range s.Attempts {
+			if !a.IsWrong {
+				continue
+			}
+			other, ok := matchConfusion(a.Answer, self, cands)
+			if !ok {
+				continue
+			}
+			if _, seen := resolvedSeries[sk]; !seen {
+				// Attempts are newest-first, so the first match is the
+				// series' last confusion.
+				resolvedSeries[sk] = hasCorrectAttempt(s.Attempts[:i])
+			}
+			first, second := confusionWord(self), confusionWord(other)
+			if second.Expression < first.Expression {
+				first, second = second, first
+			}
+			k := confusionPairKey{s.NotebookID, seriesDiscriminator(first.ID, first.Expression), seriesDiscriminator(second.ID, second.Expression)}
+			j, ok := index[k]
+			if !ok {
+				j = len(pairs)
+				index[k] = j
+				pairs = append(pairs, Confusion{
+					NotebookID:    s.NotebookID,
+					NotebookTitle: s.NotebookTitle,
+					Words:         [2]ConfusionWord{first, second},
+				})
+			}
+			p := &pairs[j]
+			p.Count++
+			if a.LearnedAt.After(p.LastConfusedAt) {
+				p.LastConfusedAt = a.LearnedAt
+			}
+			if !slices.Contains(p.QuizTypes, s.QuizType) {
+				p.QuizTypes = append(p.QuizTypes, s.QuizType)
+			}
+			if answer := strings.TrimSpace(a.Answer); !slices.Contains(p.Answers, answer) && len(p.Answers) < maxConfusionAnswers {
+				p.Answers = append(p.Answers, answer)
+			}
+			if !slices.Contains(pairSeries[k], sk) {
+				pairSeries[k] = append(pairSeries[k], sk)
+			}
+		}
+	}
+
+	for k, j := range index {
+		resolved := true
+		for _, sk := range pairSeries[k] {
+			resolved = resolved && resolvedSeries[sk]
+		}
+		pairs[j].Resolved = resolved
+		sort.SliceStable(pairs[j].QuizTypes, func(a, b int) bool {
+			return quizTypeRank(pairs[j].QuizTypes[a]) < quizTypeRank(pairs[j].QuizTypes[b])
+		})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		a, b := pairs[i], pairs[j]
+		if a.Resolved != b.Resolved {
+			return !a.Resolved
+		}
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if !a.LastConfusedAt.Equal(b.LastConfusedAt) {
+			return a.LastConfusedAt.After(b.LastConfusedAt)
+		}
+		if a.Words[0].Expression != b.Words[0].Expression {
+			return a.Words[0].Expression < b.Words[0].Expression
+		}
+		if a.Words[1].Expression != b.Words[1].Expression {
+			return a.Words[1].Expression < b.Words[1].Expression
+		}
+		return a.NotebookID < b.NotebookID
+	})
+	return pairs
+}
+
+// findConfusionsWith runs FindConfusions with the notes resolver lists
+// for each notebook, or returns nil when it can't list them. Pairs are
+// titled with the notebook names resolver knows.
+func findConfusionsWith(ctx context.Context, resolver MetadataResolver, series []ConfusionSeries) []Confusion {
+	source, ok := resolver.(ConfusionCandidateSource)
+	if !ok {
+		return nil
+	}
+	pairs := FindConfusions(series, func(notebookID string) []ConfusionCandidate {
+		return source.ConfusionCandidates(ctx, notebookID)
+	})
+	if titles, ok := resolver.(NotebookTitleSource); ok {
+		byNotebook := map[string]string{}
+		for i, p := range pairs {
+			title, seen := byNotebook[p.NotebookID]
+			if !seen {
+				title = titles.NotebookTitle(ctx, p.NotebookID)
+				byNotebook[p.NotebookID] = title
+			}
+			if title != "" {
+				pairs[i].NotebookTitle = title
+			}
+		}
+	}
+	return pairs
+}
+
+// matchConfusion returns the other note a wrong answer for self was meant
+// for. A reverse-quiz answer that is another note's expression matches it
+// outright; otherwise the answer must cover enough of another note's
+// meaning, and more of it than of self's own meaning.
+func matchConfusion(answer string, self ConfusionCandidate, candidates []ConfusionCandidate) (ConfusionCandidate, bool) {
+	normalized := strings.ToLower(strings.Join(strings.Fields(answer), " "))
+	if normalized == "" {
+		return ConfusionCandidate{}, false
+	}
+	// Another sense of the same spelling is not a confusion.
+	isSelf := func(c ConfusionCandidate) bool {
+		return strings.EqualFold(c.Expression, self.Expression)
+	}
+	for _, c := range candidates {
+		if !isSelf(c) && strings.ToLower(c.Expression) == normalized {
+			return c, true
+		}
+	}
+
+	answerWords := contentWords(normalized)
+	if len(answerWords) == 0 {
+		return ConfusionCandidate{}, false
+	}
+	selfScore := meaningScore(answerWords, contentWords(self.Meaning))
+	var best ConfusionCandidate
+	bestScore := 0.0
+	for _, c := range candidates {
+		if isSelf(c) {
+			continue
+		}
+		score := meaningScore(answerWords, contentWords(c.Meaning))
+		if score > bestScore {
+			best, bestScore = c, score
+		}
+	}
+	if bestScore < minConfusionMeaningScore || bestScore <= selfScore {
+		return ConfusionCandidate{}, false
+	}
+	return best, true
+}
+
+// meaningScore is the share of meaning's words found in answer. A word and
+// its inflections count as the same word when their lemmas overlap, so
+// "influence" matches "influences" and "influenced".
+func meaningScore(answer, meaning []string) float64 {
+	if len(meaning) == 0 {
+		return 0
+	}
+	answerLemmas := map[string]bool{}
+	for _, a := range answer {
+		for _, l := range lemma.Lemmas(a) {
+			answerLemmas[l] = true
+		}
+	}
+	found := 0
+	for _, m := range meaning {
+		if slices.ContainsFunc(lemma.Lemmas(m), func(l string) bool { return answerLemmas[l] }) {
+			found++
+		}
+	}
+	return float64(found) / float64(len(meaning))
+}
+
+// confusionStopWords are left out when comparing an answer with a meaning.
+var confusionStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "that": true,
+	"this": true, "from": true, "into": true, "onto": true, "your": true,
+	"something": true, "someone": true, "somebody": true, "one": true,
+	"ones": true, "are": true, "was": true, "were": true, "been": true,
+	"being": true, "has": true, "have": true, "had": true, "not": true,
+	"its": true, "their": true, "them": true, "they": true, "very": true,
+	"which": true, "who": true, "way": true, "make": true, "thing": true,
+}
+
+// contentWords returns the lowercased words of s longer than two letters
+// that are not stop words.
+func contentWords(s string) []string {
+	var out []string
+	for _, w := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	}) {
+		w = strings.Trim(w, "'")
+		if len(w) <= 2 || confusionStopWords[w] {
+			continue
+		}
+		out = append(out, w)
+	}
+	return out
+}
+
+func findConfusionCandidate(candidates []ConfusionCandidate, id, expression string) (ConfusionCandidate, bool) {
+	for _, c := range candidates {
+		if id != "" && c.ID != "" {
+			if c.ID == id {
+				return c, true
+			}
+			continue
+		}
+		if strings.EqualFold(c.Expression, expression) {
+			return c, true
+		}
+	}
+	return ConfusionCandidate{}, false
+}
+
+func confusionWord(c ConfusionCandidate) ConfusionWord {
+	return ConfusionWord{ID: c.ID, Expression: c.Expression, Meaning: c.Meaning, Example: c.Example}
+}
+
+func hasCorrectAttempt(attempts []AnsweredAttempt) bool {
+	for _, a := range attempts {
+		if !a.IsWrong {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindConfusions(t *testing.T) {
+	newest := time.Date(2026, 6, 5, 0, 0, 0, 0, time.UTC)
+	answered := func(answers ...string) []AnsweredAttempt {
+		// An empty answer stands for a correct attempt.
+		out := make([]AnsweredAttempt, len(answers))
+		for i, a := range answers {
+			out[i] = AnsweredAttempt{
+				Attempt: Attempt{LearnedAt: newest.AddDate(0, 0, -i), IsWrong: a != ""},
+				Answer:  a,
+			}
+		}
+		return out
+	}
+	candidates := map[string][]ConfusionCandidate{
+		"vocab": {
+			{ID: "affect", Expression: "affect", Meaning: "to influence or change something", Example: "The weather affects my mood."},
+			{ID: "effect", Expression: "effect", Meaning: "a result or consequence of an action"},
+			{ID: "ephemeral", Expression: "ephemeral", Meaning: "lasting a very short time"},
+		},
+	}
+	series := []ConfusionSeries{
+		// Answered affect with effect's meaning, twice.
+		{ID: "affect", Expression: "affect", NotebookID: "vocab", QuizType: "notebook", Attempts: answered(
+			"the result of an action", "", "a consequence or result",
+		)},
+		// Typed "affect" for effect in the reverse quiz, not since answered.
+		{ID: "effect", Expression: "effect", NotebookID: "vocab", QuizType: "reverse", Attempts: answered("Affect")},
+		// Plain wrong answers and grammar answers are not confusions.
+		{ID: "ephemeral", Expression: "ephemeral", NotebookID: "vocab", QuizType: "notebook", Attempts: answered("very big", "lasting for ages")},
+		{ID: "effect", Expression: "effect", NotebookID: "vocab", QuizType: "grammar", Attempts: answered("affect")},
+	}
+
+	got := FindConfusions(series, func(notebookID string) []ConfusionCandidate { return candidates[notebookID] })
+
+	assert.Equal(t, []Confusion{
+		{
+			NotebookID: "vocab",
+			Words: [2]ConfusionWord{
+				{ID: "affect", Expression: "affect", Meaning: "to influence or change something", Example: "The weather affects my mood."},
+				{ID: "effect", Expression: "effect", Meaning: "a result or consequence of an action"},
+			},
+			Count:          3,
+			LastConfusedAt: newest,
+			QuizTypes:      []string{"notebook", "reverse"},
+			Answers:        []string{"the result of an action", "a consequence or result", "Affect"},
+		},
+	}, got)
+}
+
+func TestFindConfusions_Resolved(t *testing.T) {
+	day := time.Date(2026, 6, 5, 0, 0, 0, 0, time.UTC)
+	candidates := []ConfusionCandidate{
+		{Expression: "affect", Meaning: "to influence something"},
+		{Expression: "effect", Meaning: "a result"},
+	}
+	series := []ConfusionSeries{{Expression: "effect", NotebookID: "vocab", QuizType: "reverse", Attempts: []AnsweredAttempt{
+		{Attempt: Attempt{LearnedAt: day, IsWrong: false}, Answer: "effect"},
+		{Attempt: Attempt{LearnedAt: day.AddDate(0, 0, -1), IsWrong: true}, Answer: "affect"},
+	}}}
+
+	got := FindConfusions(series, func(string) []ConfusionCandidate { return candidates })
+
+	if assert.Len(t, got, 1) {
+		assert.True(t, got[0].Resolved)
+		assert.Equal(t, day.AddDate(0, 0, -1), got[0].LastConfusedAt)
+	}
+}
+
+func TestMatchConfusion(t *testing.T) {
+	self := ConfusionCandidate{Expression: "affect", Meaning: "to influence or change something"}
+	candidates := []ConfusionCandidate{
+		self,
+		{Expression: "effect", Meaning: "a result or consequence of an action"},
+		{Expression: "afflict", Meaning: "to cause pain or suffering"},
+	}
+	cases := []struct {
+		answer string
+		want   string
+	}{
+		{"results of actions", "effect"},
+		{"caused pain and suffered", "afflict"},
+		{"something that influences a result", ""},
+		{"EFFECT", "effect"},
+		{"affect", ""},
+		{"to cause", ""},
+		{"", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.answer, func(t *testing.T) {
+			got, ok := matchConfusion(tc.answer, self, candidates)
+			assert.Equal(t, tc.want != "", ok)
+			assert.Equal(t, tc.want, got.Expression)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package analytics
+
+import (
+	"github.com/at-ishikawa/langner/internal/frequency"
+)
+
+// CoverageQuery bundles the arguments of Repository.Coverage.
+type CoverageQuery struct {
+	// Words are the words to cover, most frequent first (see
+	// frequency.List.Top).
+	Words   []string
+	Filters Filters
+	// MissingLimit caps Coverage.Missing; 0 lists none.
+	MissingLimit int
+}
+
+// CoverageAttempt is one attempt at an expression fed into
+// ComputeCoverage.
+type CoverageAttempt struct {
+	Expression string
+	Attempt    Attempt
+}
+
+// Coverage is how many of the most frequent words the learner has
+// studied.
+type Coverage struct {
+	// Words is the number of words covered.
+	Words int
+	// Studied counts the words answered at least once in any quiz type.
+	Studied int
+	// Known counts the studied words whose latest answer was correct.
+	Known int
+	// Missing lists the words never studied, most frequent first.
+	Missing []string
+}
+
+// ComputeCoverage matches the attempts to the query's words. An attempt
+// counts for a word when they share a base form (frequency.Forms), so an
+// answer about "run" covers "running" in the list and the other way round.
+func ComputeCoverage(attempts []CoverageAttempt, q CoverageQuery) Coverage {
+	latest := make(map[string]Attempt)
+	for _, a := range attempts {
+		for _, form := range frequency.Forms(a.Expression) {
+			if last, ok := latest[form]; !ok || a.Attempt.LearnedAt.After(last.LearnedAt) {
+				latest[form] = a.Attempt
+			}
+		}
+	}
+
+	coverage := Coverage{Words: len(q.Words)}
+	for _, word := range q.Words {
+		var last Attempt
+		studied := false
+		for _, form := range frequency.Forms(word) {
+			if a, ok := latest[form]; ok && (!studied || a.LearnedAt.After(last.LearnedAt)) {
+				last, studied = a, true
+			}
+		}
+		if !studied {
+			if len(coverage.Missing) < q.MissingLimit {
+				coverage.Missing = append(coverage.Missing, word)
+			}
+			continue
+		}
+		coverage.Studied++
+		if !last.IsWrong {
+			coverage.Known++
+		}
+	}
+	return coverage
+}
@@ -0,0 +1,49 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeCoverage(t *testing.T) {
+	day := time.Date(2026, 6, 5, 0, 0, 0, 0, time.UTC)
+	attempt := func(expression string, daysAgo int, wrong bool) CoverageAttempt {
+		return CoverageAttempt{Expression: expression, Attempt: Attempt{LearnedAt: day.AddDate(0, 0, -daysAgo), IsWrong: wrong}}
+	}
+	attempts := []CoverageAttempt{
+		attempt("Run", 3, true),
+		attempt("running", 1, false),
+		attempt("cities", 2, false),
+		attempt("go", 1, true),
+		attempt("put up with", 1, false),
+	}
+
+	tests := []struct {
+		name  string
+		query CoverageQuery
+		want  Coverage
+	}{
+		{
+			name:  "inflections count for their base form and the latest answer decides",
+			query: CoverageQuery{Words: []string{"the", "run", "city", "went", "of", "and"}, MissingLimit: 2},
+			want:  Coverage{Words: 6, Studied: 3, Known: 2, Missing: []string{"the", "of"}},
+		},
+		{
+			name:  "no missing words without a limit",
+			query: CoverageQuery{Words: []string{"the", "runs"}},
+			want:  Coverage{Words: 2, Studied: 1, Known: 1},
+		},
+		{
+			name:  "no words",
+			query: CoverageQuery{},
+			want:  Coverage{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ComputeCoverage(attempts, tt.query))
+		})
+	}
+}
@@ -13,14 +13,27 @@ import (
 // DBRepository serves analytics queries from the langner PostgreSQL schema.
 // It joins learning_logs with notes (for the expression) and
 // notebook_notes (for the notebook title and scene) so the response
-// matches what the YAML repository produces.
+// matches what the YAML repository produces. Meanings come from the same
+// optional MetadataResolver the YAML repository uses.
 type DBRepository struct {
-	db *sqlx.DB
+	db       *sqlx.DB
+	resolver MetadataResolver
 }
 
 // NewDBRepository returns an analytics Repository backed by the configured DB.
 func NewDBRepository(db *sqlx.DB) *DBRepository {
-	return &DBRepository{db: db}
+	return &DBRepository{db: db, resolver: NoMetadataResolver()}
+}
+
+// WithMetadataResolver returns a copy of the repository that consults
+// the given resolver when building wrong-word cards.
+func (r *DBRepository) WithMetadataResolver(m MetadataResolver) *DBRepository {
+	if m == nil {
+		m = NoMetadataResolver()
+	}
+	cp := *r
+	cp.resolver = m
+	return &cp
 }
 
 // dailyRow is the projection used for one row of the daily summary query.
@@ -94,13 +107,17 @@ func (r *DBRepository) DailySummaries(ctx context.Context, rangeDays int, filter
 // wrongRow is the projection used for one wrong attempt on the requested day.
 type wrongRow struct {
 	NoteID        int64     `db:"note_id"`
+	SenseID       string    `db:"sense_id"`
 	Expression    string    `db:"expression"`
+	Origin        string    `db:"origin"`
+	CorrectionID  string    `db:"correction_id"`
 	NotebookID    string    `db:"notebook_id"`
 	NotebookTitle string    `db:"notebook_title"`
 	SceneTitle    string    `db:"scene_title"`
 	QuizType      string    `db:"quiz_type"`
 	LearnedAt     time.Time `db:"learned_at"`
 	Status        string    `db:"status"`
+	Answer        string    `db:"answer"`
 }
 
 // DayDetail returns the wrong words for the day plus adjacent-day pointers.
@@ -128,7 +145,10 @@ func (r *DBRepository) DayDetail(ctx context.Context, day time.Time, filters Fil
 	wrongQuery := `
 		SELECT
 			ll.note_id,
+			n.sense_id,
 			n."usage" AS expression,
+			ll.origin,
+			ll.correction_id,
 			COALESCE(ll.source_notebook_id, '') AS notebook_id,
 			COALESCE(ll.source_notebook_id, '') AS notebook_title,
 			COALESCE((
@@ -139,7 +159,8 @@ func (r *DBRepository) DayDetail(ctx context.Context, day time.Time, filters Fil
 			), '') AS scene_title,
 			ll.quiz_type,
 			ll.learned_at,
-			ll.status
+			ll.status,
+			ll.answer
 		FROM learning_logs ll
 		JOIN notes n ON n.id = ll.note_id
 		` + whereDay + `
@@ -159,7 +180,18 @@ func (r *DBRepository) DayDetail(ctx context.Context, day time.Time, filters Fil
 		if err != nil {
 			return DayDetail{}, err
 		}
+		// Origin and grammar logs are keyed by their own subject rather than
+		// the note's sense, so resolve against that.
+		id, expressionType := w.SenseID, ""
+		switch {
+		case w.Origin != "":
+			id, expressionType = "", "origin"
+		case w.CorrectionID != "":
+			id = w.CorrectionID
+		}
+		meta := r.resolver.Resolve(ctx, w.NotebookID, id, w.Expression, expressionType, w.QuizType)
 		words = append(words, WrongWord{
+			ID:                    id,
 			NoteID:                w.NoteID,
 			Expression:            w.Expression,
 			NotebookID:            w.NotebookID,
@@ -170,6 +202,13 @@ func (r *DBRepository) DayDetail(ctx context.Context, day time.Time, filters Fil
 			CurrentWrongStreak:    CurrentWrongStreak(attempts),
 			PreviousCorrectStreak: PreviousCorrectStreak(attempts),
 			CurrentStatus:         w.Status,
+			LearnedAt:             w.LearnedAt,
+			Meaning:               meta.Meaning,
+			ExampleSentence:       meta.ExampleSentence,
+			NotebookKind:          meta.NotebookKind,
+			RelatedGroups:         meta.RelatedGroups,
+			DisplayExpression:     meta.DisplayExpression,
+			Answer:                w.Answer,
 		})
 	}
 
@@ -319,16 +358,19 @@ func (r *DBRepository) WordHistory(ctx context.Context, ref WordRef) (WordHistor
 	}
 
 	query := `
-		SELECT status, learned_at, quality, quiz_type
+		SELECT status, learned_at, quality, quiz_type, answer, grader_reason, grader
 		FROM learning_logs
 		WHERE note_id = $1 AND quiz_type = $2
 		ORDER BY learned_at DESC
 	`
 	var rows []struct {
-		Status    string    `db:"status"`
-		LearnedAt time.Time `db:"learned_at"`
-		Quality   int       `db:"quality"`
-		QuizType  string    `db:"quiz_type"`
+		Status       string    `db:"status"`
+		LearnedAt    time.Time `db:"learned_at"`
+		Quality      int       `db:"quality"`
+		QuizType     string    `db:"quiz_type"`
+		Answer       string    `db:"answer"`
+		GraderReason string    `db:"grader_reason"`
+		Grader       string    `db:"grader"`
 	}
 	if err := r.db.SelectContext(ctx, &rows, query, ref.NoteID, ref.QuizType); err != nil {
 		return WordHistory{}, fmt.Errorf("word history: %w", err)
@@ -357,6 +399,9 @@ func (r *DBRepository) WordHistory(ctx context.Context, ref WordRef) (WordHistor
 			Quality:             row.Quality,
 			StreakBeforeWrong:   streakWrong,
 			StreakBeforeCorrect: streakCorrect,
+			Answer:              row.Answer,
+			GraderReason:        row.GraderReason,
+			Grader:              row.Grader,
 		}
 	}
 
@@ -442,6 +487,202 @@ func (r *DBRepository) Trends(ctx context.Context, q TrendsQuery) (TrendsResult,
 	return ComputeTrends(attempts, q), nil
 }
 
+// leechRow is the projection Leeches groups into series.
+type leechRow struct {
+	NoteID     int64     `db:"note_id"`
+	SenseID    string    `db:"sense_id"`
+	Expression string    `db:"expression"`
+	NotebookID string    `db:"notebook_id"`
+	QuizType   string    `db:"quiz_type"`
+	Status     string    `db:"status"`
+	Quality    int       `db:"quality"`
+	LearnedAt  time.Time `db:"learned_at"`
+	// Skipped and InRelearnPool report whether the series' note has a
+	// learning_skips or learning_relearns row for the quiz type.
+	Skipped       bool `db:"skipped"`
+	InRelearnPool bool `db:"in_relearn_pool"`
+}
+
+// Leeches loads every attempt matching the filters, newest-first per
+// (notebook, note, quiz_type) series, and delegates to FindLeeches.
+func (r *DBRepository) Leeches(ctx context.Context, q LeechQuery) ([]Leech, error) {
+	pb := &placeholderBuilder{}
+	conds := []string{}
+	if q.Filters.NotebookID != "" {
+		conds = append(conds, "ll.source_notebook_id = "+pb.next(q.Filters.NotebookID))
+	}
+	if q.Filters.QuizType != "" {
+		conds = append(conds, "ll.quiz_type = "+pb.next(q.Filters.QuizType))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	query := `
+		SELECT
+			ll.note_id,
+			n.sense_id,
+			n."usage" AS expression,
+			COALESCE(ll.source_notebook_id, '') AS notebook_id,
+			ll.quiz_type,
+			ll.status,
+			ll.quality,
+			ll.learned_at,
+			EXISTS (
+				SELECT 1 FROM learning_skips ls
+				WHERE ls.note_id = ll.note_id AND ls.notebook_id = ll.source_notebook_id AND ls.quiz_type = ll.quiz_type
+			) AS skipped,
+			EXISTS (
+				SELECT 1 FROM learning_relearns lr
+				WHERE lr.note_id = ll.note_id AND lr.notebook_id = ll.source_notebook_id AND lr.quiz_type = ll.quiz_type
+			) AS in_relearn_pool
+		FROM learning_logs ll
+		JOIN notes n ON n.id = ll.note_id
+		` + where + `
+		ORDER BY notebook_id, ll.note_id, ll.quiz_type, ll.learned_at DESC
+	`
+	var rows []leechRow
+	if err := r.db.SelectContext(ctx, &rows, query, pb.args...); err != nil {
+		return nil, fmt.Errorf("leeches: %w", err)
+	}
+	var series []LeechSeries
+	for i, row := range rows {
+		if i == 0 || row.NotebookID != rows[i-1].NotebookID || row.NoteID != rows[i-1].NoteID || row.QuizType != rows[i-1].QuizType {
+			series = append(series, LeechSeries{
+				ID:            row.SenseID,
+				Expression:    row.Expression,
+				NotebookID:    row.NotebookID,
+				NotebookTitle: row.NotebookID,
+				QuizType:      row.QuizType,
+				Skipped:       row.Skipped,
+				InRelearnPool: row.InRelearnPool,
+			})
+		}
+		last := &series[len(series)-1]
+		last.Attempts = append(last.Attempts, Attempt{
+			LearnedAt: row.LearnedAt,
+			QuizType:  row.QuizType,
+			IsWrong:   row.Status == statusMisunderstood,
+			Quality:   row.Quality,
+			Status:    row.Status,
+		})
+	}
+	return FindLeeches(series, q.Threshold), nil
+}
+
+// Confusions loads every answered attempt matching the filters,
+// newest-first per (notebook, note, quiz_type) series, and matches the
+// wrong answers against the other notes of each notebook.
+func (r *DBRepository) Confusions(ctx context.Context, q ConfusionQuery) ([]Confusion, error) {
+	pb := &placeholderBuilder{}
+	conds := []string{}
+	if q.Filters.NotebookID != "" {
+		conds = append(conds, "ll.source_notebook_id = "+pb.next(q.Filters.NotebookID))
+	}
+	if q.Filters.QuizType != "" {
+		conds = append(conds, "ll.quiz_type = "+pb.next(q.Filters.QuizType))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	query := `
+		SELECT
+			ll.note_id,
+			n.sense_id,
+			n."usage" AS expression,
+			COALESCE(ll.source_notebook_id, '') AS notebook_id,
+			ll.quiz_type,
+			ll.status,
+			ll.quality,
+			ll.learned_at,
+			ll.answer
+		FROM learning_logs ll
+		JOIN notes n ON n.id = ll.note_id
+		` + where + `
+		ORDER BY notebook_id, ll.note_id, ll.quiz_type, ll.learned_at DESC
+	`
+	var rows []struct {
+		leechRow
+		Answer string `db:"answer"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, pb.args...); err != nil {
+		return nil, fmt.Errorf("confusions: %w", err)
+	}
+	var series []ConfusionSeries
+	for i, row := range rows {
+		if i == 0 || row.NotebookID != rows[i-1].NotebookID || row.NoteID != rows[i-1].NoteID || row.QuizType != rows[i-1].QuizType {
+			series = append(series, ConfusionSeries{
+				ID:            row.SenseID,
+				Expression:    row.Expression,
+				NotebookID:    row.NotebookID,
+				NotebookTitle: row.NotebookID,
+				QuizType:      row.QuizType,
+			})
+		}
+		last := &series[len(series)-1]
+		last.Attempts = append(last.Attempts, AnsweredAttempt{
+			Attempt: Attempt{
+				LearnedAt: row.LearnedAt,
+				QuizType:  row.QuizType,
+				IsWrong:   row.Status == statusMisunderstood,
+				Quality:   row.Quality,
+				Status:    row.Status,
+			},
+			Answer: row.Answer,
+		})
+	}
+	return findConfusionsWith(ctx, r.resolver, series), nil
+}
+
+// Coverage loads every attempt matching the filters and delegates to
+// ComputeCoverage.
+func (r *DBRepository) Coverage(ctx context.Context, q CoverageQuery) (Coverage, error) {
+	pb := &placeholderBuilder{}
+	conds := []string{}
+	if q.Filters.NotebookID != "" {
+		conds = append(conds, "ll.source_notebook_id = "+pb.next(q.Filters.NotebookID))
+	}
+	if q.Filters.QuizType != "" {
+		conds = append(conds, "ll.quiz_type = "+pb.next(q.Filters.QuizType))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	query := `
+		SELECT
+			n."usage" AS expression,
+			ll.quiz_type,
+			ll.status,
+			ll.learned_at
+		FROM learning_logs ll
+		JOIN notes n ON n.id = ll.note_id
+		` + where
+	var rows []struct {
+		Expression string    `db:"expression"`
+		QuizType   string    `db:"quiz_type"`
+		Status     string    `db:"status"`
+		LearnedAt  time.Time `db:"learned_at"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, pb.args...); err != nil {
+		return Coverage{}, fmt.Errorf("coverage: %w", err)
+	}
+	attempts := make([]CoverageAttempt, len(rows))
+	for i, row := range rows {
+		attempts[i] = CoverageAttempt{
+			Expression: row.Expression,
+			Attempt: Attempt{
+				LearnedAt: row.LearnedAt,
+				QuizType:  row.QuizType,
+				IsWrong:   row.Status == statusMisunderstood,
+				Status:    row.Status,
+			},
+		}
+	}
+	return ComputeCoverage(attempts, q), nil
+}
+
 func splitCSV(s string) []string {
 	if s == "" {
 		return nil
@@ -0,0 +1,107 @@
+package analytics
+
+import (
+	"sort"
+	"time"
+)
+
+// LeechThreshold sets when a word × quiz type series counts as a leech:
+// it has lapsed Lapses times, or its current run of wrong answers is
+// WrongStreak long. A zero field disables that criterion.
+type LeechThreshold struct {
+	Lapses      int
+	WrongStreak int
+}
+
+// IsLeech reports whether a series of attempts is a leech. attempts must
+// be newest-first.
+func (t LeechThreshold) IsLeech(attempts []Attempt) bool {
+	if t.Lapses > 0 && Lapses(attempts) >= t.Lapses {
+		return true
+	}
+	return t.WrongStreak > 0 && CurrentWrongStreak(attempts) >= t.WrongStreak
+}
+
+// LeechQuery bundles the arguments of Repository.Leeches.
+type LeechQuery struct {
+	Threshold LeechThreshold
+	Filters   Filters
+}
+
+// LeechSeries is one word × quiz type log series fed into FindLeeches.
+type LeechSeries struct {
+	// ID is the entry's stable source-entry identity (notebook.Note.ID).
+	// Empty for legacy id-less entries.
+	ID            string
+	Expression    string
+	NotebookID    string
+	NotebookTitle string
+	SceneTitle    string
+	QuizType      string
+	// Attempts is the series, newest-first.
+	Attempts []Attempt
+	// Skipped and InRelearnPool report the leech treatment already applied
+	// to the series: excluded from its quiz type, or moved into the relearn
+	// pool.
+	Skipped       bool
+	InRelearnPool bool
+}
+
+// Leech is one word × quiz type series that keeps failing.
+type Leech struct {
+	ID                 string
+	Expression         string
+	NotebookID         string
+	NotebookTitle      string
+	SceneTitle         string
+	QuizType           string
+	Lapses             int
+	CurrentWrongStreak int
+	Attempts           int
+	LastAttemptAt      time.Time
+	Skipped            bool
+	InRelearnPool      bool
+}
+
+// FindLeeches returns the series that are leeches under threshold, worst
+// first: most lapses, then the longest current wrong streak, then by
+// expression.
+func FindLeeches(series []LeechSeries, threshold LeechThreshold) []Leech {
+	var leeches []Leech
+	for _, s := range series {
+		if len(s.Attempts) == 0 || !threshold.IsLeech(s.Attempts) {
+			continue
+		}
+		leeches = append(leeches, Leech{
+			ID:                 s.ID,
+			Expression:         s.Expression,
+			NotebookID:         s.NotebookID,
+			NotebookTitle:      s.NotebookTitle,
+			SceneTitle:         s.SceneTitle,
+			QuizType:           s.QuizType,
+			Lapses:             Lapses(s.Attempts),
+			CurrentWrongStreak: CurrentWrongStreak(s.Attempts),
+			Attempts:           len(s.Attempts),
+			LastAttemptAt:      s.Attempts[0].LearnedAt,
+			Skipped:            s.Skipped,
+			InRelearnPool:      s.InRelearnPool,
+		})
+	}
+	sort.Slice(leeches, func(i, j int) bool {
+		a, b := leeches[i], leeches[j]
+		if a.Lapses != b.Lapses {
+			return a.Lapses > b.Lapses
+		}
+		if a.CurrentWrongStreak != b.CurrentWrongStreak {
+			return a.CurrentWrongStreak > b.CurrentWrongStreak
+		}
+		if a.Expression != b.Expression {
+			return a.Expression < b.Expression
+		}
+		if a.NotebookID != b.NotebookID {
+			return a.NotebookID < b.NotebookID
+		}
+		return a.QuizType < b.QuizType
+	})
+	return leeches
+}
@@ -0,0 +1,52 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeechThreshold_IsLeech(t *testing.T) {
+	cases := []struct {
+		name      string
+		threshold LeechThreshold
+		attempts  []Attempt
+		want      bool
+	}{
+		{"zero threshold never flags", LeechThreshold{}, mkAttempts(true, true, true, true), false},
+		{"lapses reached", LeechThreshold{Lapses: 2}, mkAttempts(false, true, false, true, false), true},
+		{"lapses not reached", LeechThreshold{Lapses: 3}, mkAttempts(false, true, false, true, false), false},
+		{"wrong streak reached", LeechThreshold{WrongStreak: 3}, mkAttempts(true, true, true), true},
+		{"wrong streak broken by a correct answer", LeechThreshold{WrongStreak: 3}, mkAttempts(false, true, true, true), false},
+		{"either criterion is enough", LeechThreshold{Lapses: 5, WrongStreak: 2}, mkAttempts(true, true, false), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.threshold.IsLeech(tc.attempts))
+		})
+	}
+}
+
+func TestFindLeeches(t *testing.T) {
+	newest := time.Date(2026, 6, 5, 0, 0, 0, 0, time.UTC)
+	withDate := func(attempts []Attempt) []Attempt {
+		for i := range attempts {
+			attempts[i].LearnedAt = newest.AddDate(0, 0, -i)
+		}
+		return attempts
+	}
+	series := []LeechSeries{
+		{Expression: "fine", QuizType: "notebook", Attempts: withDate(mkAttempts(false, false))},
+		{Expression: "stuck", QuizType: "reverse", Attempts: withDate(mkAttempts(true, true, true)), InRelearnPool: true},
+		{Expression: "lapsing", QuizType: "notebook", Attempts: withDate(mkAttempts(true, false, true, false, true, false))},
+		{Expression: "empty", QuizType: "notebook"},
+	}
+
+	got := FindLeeches(series, LeechThreshold{Lapses: 3, WrongStreak: 3})
+
+	assert.Equal(t, []Leech{
+		{Expression: "lapsing", QuizType: "notebook", Lapses: 3, CurrentWrongStreak: 1, Attempts: 6, LastAttemptAt: newest},
+		{Expression: "stuck", QuizType: "reverse", CurrentWrongStreak: 3, Attempts: 3, LastAttemptAt: newest, InRelearnPool: true},
+	}, got)
+}
@@ -17,6 +17,9 @@ import (
 // small number of wrong words a single day produces.
 type NotebookMetadataResolver struct {
 	reader *notebook.Reader
+	// source, when set, supplies a current reader per Resolve call so
+	// the lookups follow notebook edits made while the server runs.
+	source notebook.ReaderSource
 }
 
 // NewNotebookMetadataResolver returns a resolver backed by the given
@@ -29,6 +32,16 @@ func NewNotebookMetadataResolver(reader *notebook.Reader) MetadataResolver {
 	return &NotebookMetadataResolver{reader: reader}
 }
 
+// NewWatchedNotebookMetadataResolver returns a resolver that takes a fresh
+// reader from source for every lookup instead of holding one built at
+// startup. Pass nil to disable the lookups.
+func NewWatchedNotebookMetadataResolver(source notebook.ReaderSource) MetadataResolver {
+	if source == nil {
+		return NoMetadataResolver()
+	}
+	return &NotebookMetadataResolver{source: source}
+}
+
 // Resolve looks up the meaning and one example for the given expression.
 // The quiz type drives the lookup path so that words colliding between
 // the vocabulary side and the etymology-origin side (e.g. "gauche" the
@@ -42,7 +55,14 @@ func NewNotebookMetadataResolver(reader *notebook.Reader) MetadataResolver {
 //
 // expressionType is used as a fallback only when the quiz type is
 // missing (legacy callers / no-op resolver).
-func (r *NotebookMetadataResolver) Resolve(_ context.Context, notebookID, id, expression, expressionType, quizType string) WordMetadata {
+func (r *NotebookMetadataResolver) Resolve(ctx context.Context, notebookID, id, expression, expressionType, quizType string) WordMetadata {
+	if r != nil && r.source != nil {
+		reader, err := r.source.Reader()
+		if err != nil {
+			return WordMetadata{}
+		}
+		return (&NotebookMetadataResolver{reader: reader}).Resolve(ctx, notebookID, id, expression, expressionType, quizType)
+	}
 	if r == nil || r.reader == nil || notebookID == "" || expression == "" {
 		return WordMetadata{}
 	}
@@ -149,6 +169,88 @@ func (r *NotebookMetadataResolver) resolveVocab(notebookID, id, expression strin
 	return WordMetadata{}
 }
 
+// ConfusionCandidates lists the vocabulary notes of a notebook with a
+// meaning, from the same sources resolveVocab walks, so Repository.Confusions
+// can match wrong answers against them.
+func (r *NotebookMetadataResolver) ConfusionCandidates(ctx context.Context, notebookID string) []ConfusionCandidate {
+	if r != nil && r.source != nil {
+		reader, err := r.source.Reader()
+		if err != nil {
+			return nil
+		}
+		return (&NotebookMetadataResolver{reader: reader}).ConfusionCandidates(ctx, notebookID)
+	}
+	if r == nil || r.reader == nil || notebookID == "" {
+		return nil
+	}
+	var out []ConfusionCandidate
+	seen := map[string]bool{}
+	add := func(notes []notebook.Note) {
+		for _, n := range notes {
+			key := seriesDiscriminator(n.ID, n.Expression)
+			if strings.TrimSpace(n.Meaning) == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			c := ConfusionCandidate{ID: n.ID, Expression: n.Expression, Meaning: n.Meaning}
+			if len(n.Examples) > 0 {
+				c.Example = n.Examples[0].Text
+			}
+			out = append(out, c)
+		}
+	}
+	if stories, err := r.reader.ReadStoryNotebooks(notebookID); err == nil {
+		for _, s := range stories {
+			for _, scene := range s.Scenes {
+				add(scene.Definitions)
+			}
+		}
+	}
+	if flashcards, err := r.reader.ReadFlashcardNotebooks(notebookID); err == nil {
+		for _, fc := range flashcards {
+			add(fc.Cards)
+		}
+	}
+	if defs, ok := r.reader.GetDefinitionsNotes(notebookID); ok {
+		for _, sessionDefs := range defs {
+			for _, sceneNotes := range sessionDefs {
+				add(sceneNotes)
+			}
+		}
+	}
+	// Definitions come from a map; sort so ties between equally good
+	// matches always resolve the same way.
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Expression != out[j].Expression {
+			return out[i].Expression < out[j].Expression
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// NotebookTitle returns the name of the story, flashcard or etymology
+// notebook with notebookID, or "" when it has none.
+func (r *NotebookMetadataResolver) NotebookTitle(ctx context.Context, notebookID string) string {
+	if r != nil && r.source != nil {
+		reader, err := r.source.Reader()
+		if err != nil {
+			return ""
+		}
+		return (&NotebookMetadataResolver{reader: reader}).NotebookTitle(ctx, notebookID)
+	}
+	if r == nil || r.reader == nil || notebookID == "" {
+		return ""
+	}
+	if idx, ok := r.reader.GetStoryIndexes()[notebookID]; ok && idx.Name != "" {
+		return idx.Name
+	}
+	if idx, ok := r.reader.GetFlashcardIndexes()[notebookID]; ok && idx.Name != "" {
+		return idx.Name
+	}
+	return r.etymologyNotebookName(notebookID)
+}
+
 // etymologyNotebookName returns the display Name for an etymology index by
 // its ID. ReadAllEtymologyDefinitions tags each definition with that Name,
 // so the resolver needs the name to filter back to a single notebook.
@@ -587,3 +587,34 @@ notebooks:
 	got := r.Resolve(context.Background(), "vocab", "", "ephemeral", ExpressionTypeVocabulary, "notebook")
 	assert.Empty(t, got.RelatedGroups, "notebooks without concepts must not synthesize related groups")
 }
+
+func TestNotebookMetadataResolver_ConfusionCandidates(t *testing.T) {
+	root := t.TempDir()
+	flashDir := filepath.Join(root, "flashcards", "vocab")
+	require.NoError(t, os.MkdirAll(flashDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(flashDir, "index.yml"), []byte(
+		"id: vocab\nname: Vocab\nnotebooks:\n  - ./cards.yml\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(flashDir, "cards.yml"), []byte(`- title: Common Words
+  date: 2025-01-01T00:00:00Z
+  cards:
+    - id: effect
+      expression: effect
+      meaning: a result
+    - expression: affect
+      meaning: to influence
+      examples:
+        - "The rain affected the game."
+    - expression: unfinished
+`), 0o644))
+	reader, err := notebook.NewReader(nil, []string{filepath.Join(root, "flashcards")}, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	r := NewNotebookMetadataResolver(reader).(*NotebookMetadataResolver)
+	assert.Equal(t, []ConfusionCandidate{
+		{Expression: "affect", Meaning: "to influence", Example: "The rain affected the game."},
+		{ID: "effect", Expression: "effect", Meaning: "a result"},
+	}, r.ConfusionCandidates(context.Background(), "vocab"))
+	assert.Empty(t, r.ConfusionCandidates(context.Background(), "missing"))
+	assert.Equal(t, "Vocab", r.NotebookTitle(context.Background(), "vocab"))
+	assert.Empty(t, r.NotebookTitle(context.Background(), "missing"))
+}
@@ -32,6 +32,19 @@ type Repository interface {
 	// delegate the aggregation to ComputeTrends so the YAML and DB paths
 	// stay identical.
 	Trends(ctx context.Context, q TrendsQuery) (TrendsResult, error)
+
+	// Leeches returns every word × quiz type series that is a leech under
+	// the query's threshold, worst first (see FindLeeches).
+	Leeches(ctx context.Context, q LeechQuery) ([]Leech, error)
+
+	// Confusions returns the pairs of notes whose meanings the learner's
+	// wrong answers mix up (see FindConfusions). It needs a resolver that
+	// implements ConfusionCandidateSource and returns none otherwise.
+	Confusions(ctx context.Context, q ConfusionQuery) ([]Confusion, error)
+
+	// Coverage returns how many of the query's words the learner has
+	// studied and knows (see ComputeCoverage).
+	Coverage(ctx context.Context, q CoverageQuery) (Coverage, error)
 }
 
 // DayDetail bundles the response of Repository.DayDetail.
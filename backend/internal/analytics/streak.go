@@ -92,3 +92,17 @@ func StreakBeforeAttempt(attempts []Attempt, i int) (streakWrong, streakCorrect
 	}
 	return 0, n
 }
+
+// Lapses counts the times a word was answered wrong right after a correct
+// answer — it had been learned and was then forgotten. A wrong first
+// attempt or a repeated wrong attempt is not a lapse. attempts must be
+// newest-first.
+func Lapses(attempts []Attempt) int {
+	n := 0
+	for i := 0; i+1 < len(attempts); i++ {
+		if attempts[i].IsWrong && !attempts[i+1].IsWrong {
+			n++
+		}
+	}
+	return n
+}
@@ -82,6 +82,28 @@ func TestPreviousCorrectStreak(t *testing.T) {
 	}
 }
 
+func TestLapses(t *testing.T) {
+	cases := []struct {
+		name     string
+		attempts []Attempt
+		want     int
+	}{
+		{"empty", nil, 0},
+		{"never correct", mkAttempts(true, true, true), 0},
+		{"all correct", mkAttempts(false, false), 0},
+		{"one lapse", mkAttempts(true, false, false), 1},
+		{"a wrong run counts once", mkAttempts(true, true, true, false), 1},
+		{"two lapses", mkAttempts(false, true, false, true, false, true), 2},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Lapses(tc.attempts); got != tc.want {
+				t.Fatalf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestStreakBeforeAttempt(t *testing.T) {
 	attempts := mkAttempts(true, true, false, false, true)
 	// index 0 (newest, wrong): previous was wrong, streak=1
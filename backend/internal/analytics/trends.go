@@ -437,6 +437,8 @@ var quizTypeOrder = []string{
 	string(notebook.QuizTypeFreeform),
 	string(notebook.QuizTypeEtymologyOrigin),
 	string(notebook.QuizTypeGrammar),
+	string(notebook.QuizTypeDictation),
+	string(notebook.QuizTypeWordChoice),
 }
 
 var quizTypeLabels = map[string]string{
@@ -445,6 +447,8 @@ var quizTypeLabels = map[string]string{
 	string(notebook.QuizTypeFreeform):        "Freeform",
 	string(notebook.QuizTypeEtymologyOrigin): "Etymology",
 	string(notebook.QuizTypeGrammar):         "Grammar",
+	string(notebook.QuizTypeDictation):       "Dictation",
+	string(notebook.QuizTypeWordChoice):      "Word choice",
 }
 
 func quizTypeLabel(q string) string {
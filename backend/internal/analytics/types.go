@@ -112,6 +112,9 @@ type WrongWord struct {
 	// type, where Expression already IS the display text (L3): the
 	// frontend falls back to Expression when this is empty.
 	DisplayExpression string
+	// Answer is what the learner answered on the wrong attempt. Empty for
+	// attempts recorded before answers were kept.
+	Answer string
 }
 
 // RelatedGroup is one cluster of related entries returned alongside a
@@ -171,4 +174,9 @@ type AttemptEntry struct {
 	Quality             int
 	StreakBeforeWrong   int
 	StreakBeforeCorrect int
+	// Answer is what the learner answered, GraderReason why Grader accepted
+	// or rejected it. Empty for attempts recorded before answers were kept.
+	Answer       string
+	GraderReason string
+	Grader       string
 }
@@ -69,8 +69,16 @@ type yamlAttempt struct {
 	// word that was wrong in two quiz modes on the same instant always sorts
 	// the same way regardless of Go's map-iteration order — each card still
 	// keeps its own recorded QuizType (invariant L3).
-	Seq     int
-	Attempt Attempt
+	Seq int
+	// InRelearnPool is true when the expression was moved into the relearn
+	// pool for this attempt's QuizType (see notebook.LearningHistoryExpression.RelearnAt).
+	InRelearnPool bool
+	// Answer, GraderReason and Grader are the record's answer fields (see
+	// notebook.LearningRecord), shown on the word history panel.
+	Answer       string
+	GraderReason string
+	Grader       string
+	Attempt      Attempt
 }
 
 // allAttempts loads every record from every history file, flattens them,
@@ -156,6 +164,8 @@ func appendExpressionAttempts(
 		{string(notebook.QuizTypeNotebook), exp.LearnedLogs},
 		{string(notebook.QuizTypeReverse), exp.ReverseLogs},
 		{string(notebook.QuizTypeEtymologyOrigin), exp.EtymologyOriginLogs},
+		{string(notebook.QuizTypeDictation), exp.DictationLogs},
+		{string(notebook.QuizTypeWordChoice), exp.WordChoiceLogs},
 	}
 	for _, slot := range slots {
 		for _, rec := range slot.records {
@@ -182,7 +192,11 @@ func appendExpressionAttempts(
 				ExpressionType: exp.Type,
 				QuizType:       quizType,
 				Skipped:        skipped,
+				InRelearnPool:  exp.InRelearnPool(notebook.QuizType(quizType)),
 				IntervalDays:   rec.IntervalDays,
+				Answer:         rec.Answer,
+				GraderReason:   rec.GraderReason,
+				Grader:         rec.Grader,
 				Attempt: Attempt{
 					LearnedAt: rec.LearnedAt.Time,
 					QuizType:  quizType,
@@ -380,6 +394,7 @@ func (r *YAMLRepository) DayDetail(ctx context.Context, day time.Time, filters F
 				Skipped:               hit.Skipped,
 				RelatedGroups:         meta.RelatedGroups,
 				DisplayExpression:     meta.DisplayExpression,
+				Answer:                hit.Answer,
 			},
 		})
 	}
@@ -449,6 +464,9 @@ func (r *YAMLRepository) WordHistory(_ context.Context, ref WordRef) (WordHistor
 			Quality:             m.Attempt.Quality,
 			StreakBeforeWrong:   streakWrong,
 			StreakBeforeCorrect: streakCorrect,
+			Answer:              m.Answer,
+			GraderReason:        m.GraderReason,
+			Grader:              m.Grader,
 		}
 	}
 
@@ -497,6 +515,111 @@ func (r *YAMLRepository) Trends(_ context.Context, q TrendsQuery) (TrendsResult,
 	return ComputeTrends(trendAttempts, q), nil
 }
 
+// Leeches groups every attempt matching the filters into its word × quiz
+// type series and returns the series that are leeches under the threshold.
+func (r *YAMLRepository) Leeches(_ context.Context, q LeechQuery) ([]Leech, error) {
+	attempts, err := r.allAttempts(q.Filters)
+	if err != nil {
+		return nil, err
+	}
+	return FindLeeches(yamlLeechSeries(attempts), q.Threshold), nil
+}
+
+// yamlLeechSeries groups attempts into their word × quiz type series,
+// each newest-first, in the order the series are first encountered.
+func yamlLeechSeries(attempts []yamlAttempt) []LeechSeries {
+	index := map[wordKey]int{}
+	var series []LeechSeries
+	for _, a := range attempts {
+		k := attemptSeriesKey(a)
+		i, ok := index[k]
+		if !ok {
+			i = len(series)
+			index[k] = i
+			series = append(series, LeechSeries{
+				ID:            a.ID,
+				Expression:    a.Expression,
+				NotebookID:    a.NotebookID,
+				NotebookTitle: a.NotebookTitle,
+				SceneTitle:    a.SceneTitle,
+				QuizType:      a.QuizType,
+				Skipped:       a.Skipped,
+				InRelearnPool: a.InRelearnPool,
+			})
+		}
+		series[i].Attempts = append(series[i].Attempts, a.Attempt)
+	}
+	for _, s := range series {
+		sort.SliceStable(s.Attempts, func(i, j int) bool {
+			return s.Attempts[i].LearnedAt.After(s.Attempts[j].LearnedAt)
+		})
+	}
+	return series
+}
+
+// Confusions groups every answered attempt matching the filters into its
+// word × quiz type series and matches the wrong answers against the other
+// notes of each notebook.
+func (r *YAMLRepository) Confusions(ctx context.Context, q ConfusionQuery) ([]Confusion, error) {
+	attempts, err := r.allAttempts(q.Filters)
+	if err != nil {
+		return nil, err
+	}
+	index := map[wordKey]int{}
+	var series []ConfusionSeries
+	for _, a := range attempts {
+		k := attemptSeriesKey(a)
+		i, ok := index[k]
+		if !ok {
+			i = len(series)
+			index[k] = i
+			series = append(series, ConfusionSeries{
+				ID:            a.ID,
+				Expression:    a.Expression,
+				NotebookID:    a.NotebookID,
+				NotebookTitle: a.NotebookTitle,
+				QuizType:      a.QuizType,
+			})
+		}
+		series[i].Attempts = append(series[i].Attempts, AnsweredAttempt{Attempt: a.Attempt, Answer: a.Answer})
+	}
+	for _, s := range series {
+		sort.SliceStable(s.Attempts, func(i, j int) bool {
+			return s.Attempts[i].LearnedAt.After(s.Attempts[j].LearnedAt)
+		})
+	}
+	return findConfusionsWith(ctx, r.resolver, series), nil
+}
+
+// Coverage matches every attempt matching the filters to the query's
+// words.
+func (r *YAMLRepository) Coverage(_ context.Context, q CoverageQuery) (Coverage, error) {
+	attempts, err := r.allAttempts(q.Filters)
+	if err != nil {
+		return Coverage{}, err
+	}
+	coverageAttempts := make([]CoverageAttempt, len(attempts))
+	for i, a := range attempts {
+		coverageAttempts[i] = CoverageAttempt{Expression: a.Expression, Attempt: a.Attempt}
+	}
+	return ComputeCoverage(coverageAttempts, q), nil
+}
+
+// LeechQuizTypes returns the quiz types in which expr is a leech under
+// threshold, in the quiz type order the Trends view uses.
+func LeechQuizTypes(expr notebook.LearningHistoryExpression, threshold LeechThreshold) []string {
+	var attempts []yamlAttempt
+	appendExpressionAttempts(expr, "", "", "", &attempts)
+	var quizTypes []string
+	for _, leech := range FindLeeches(yamlLeechSeries(attempts), threshold) {
+		quizTypes = append(quizTypes, leech.QuizType)
+	}
+	sort.SliceStable(quizTypes, func(i, j int) bool {
+		return quizTypeRank(quizTypes[i]) < quizTypeRank(quizTypes[j])
+	})
+	return quizTypes
+}
+
 func rangeCutoff(rangeDays int) time.Time {
 	if rangeDays <= 0 {
 		return time.Time{}
@@ -492,3 +492,122 @@ func TestYAMLRepository_NotebookFilter(t *testing.T) {
 		t.Fatalf("expected zero rows for unknown notebook, got %d", len(got))
 	}
 }
+
+func TestYAMLRepository_Leeches(t *testing.T) {
+	dir := writeSampleHistory(t)
+	repo := NewYAMLRepository(dir)
+
+	// ephemeral is on a 2-wrong run; thrilled lapsed once in its notebook
+	// series and never in its reverse series.
+	got, err := repo.Leeches(context.Background(), LeechQuery{Threshold: LeechThreshold{WrongStreak: 2}})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "ephemeral", got[0].Expression)
+	assert.Equal(t, "notebook", got[0].QuizType)
+	assert.Equal(t, 1, got[0].Lapses)
+	assert.Equal(t, 2, got[0].CurrentWrongStreak)
+	assert.Equal(t, 3, got[0].Attempts)
+	assert.Equal(t, "2026-06-05", got[0].LastAttemptAt.Format("2006-01-02"))
+
+	got, err = repo.Leeches(context.Background(), LeechQuery{Threshold: LeechThreshold{Lapses: 1}})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "ephemeral", got[0].Expression, "longer wrong streak ranks first on equal lapses")
+	assert.Equal(t, "thrilled", got[1].Expression)
+	assert.Equal(t, "notebook", got[1].QuizType)
+}
+
+func TestYAMLRepository_Coverage(t *testing.T) {
+	dir := writeSampleHistory(t)
+	repo := NewYAMLRepository(dir)
+
+	// Both words were answered wrong last, thrilled in the notebook quiz
+	// after a correct reverse answer the day before.
+	got, err := repo.Coverage(context.Background(), CoverageQuery{
+		Words:        []string{"the", "thrilled", "of", "ephemeral"},
+		MissingLimit: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Coverage{Words: 4, Studied: 2, Known: 0, Missing: []string{"the"}}, got)
+
+	got, err = repo.Coverage(context.Background(), CoverageQuery{
+		Words:   []string{"thrilled"},
+		Filters: Filters{QuizType: "reverse"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Coverage{Words: 1, Studied: 1, Known: 1}, got)
+}
+
+// confusionResolver lists fixed candidates for every notebook.
+type confusionResolver struct {
+	noMetadataResolver
+	candidates []ConfusionCandidate
+}
+
+func (r confusionResolver) ConfusionCandidates(context.Context, string) []ConfusionCandidate {
+	return r.candidates
+}
+
+// titledConfusionResolver also names every notebook.
+type titledConfusionResolver struct {
+	confusionResolver
+	title string
+}
+
+func (r titledConfusionResolver) NotebookTitle(context.Context, string) string {
+	return r.title
+}
+
+func TestYAMLRepository_Confusions(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "flashcards.yml"), []byte(`- metadata:
+    id: flashcards
+    title: Flashcards
+    type: flashcard
+  expressions:
+    - expression: ephemeral
+      learned_logs:
+        - status: misunderstood
+          learned_at: "2026-06-05"
+          quality: 1
+          quiz_type: notebook
+          answer: very happy and excited
+        - status: understood
+          learned_at: "2026-05-20"
+          quality: 4
+          quiz_type: notebook
+          answer: short-lived
+`), 0o600))
+	candidates := []ConfusionCandidate{
+		{Expression: "ephemeral", Meaning: "lasting a very short time"},
+		{Expression: "thrilled", Meaning: "extremely happy and excited"},
+	}
+
+	got, err := NewYAMLRepository(dir).Confusions(context.Background(), ConfusionQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, got, "no candidate source, no confusions")
+
+	repo := NewYAMLRepository(dir).WithMetadataResolver(confusionResolver{candidates: candidates})
+	got, err = repo.Confusions(context.Background(), ConfusionQuery{})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "flashcards", got[0].NotebookID)
+	assert.Equal(t, "ephemeral", got[0].Words[0].Expression)
+	assert.Equal(t, "thrilled", got[0].Words[1].Expression)
+	assert.Equal(t, 1, got[0].Count)
+	assert.Equal(t, []string{"very happy and excited"}, got[0].Answers)
+	assert.False(t, got[0].Resolved)
+
+	got, err = repo.Confusions(context.Background(), ConfusionQuery{Filters: Filters{QuizType: "reverse"}})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	titled := NewYAMLRepository(dir).WithMetadataResolver(titledConfusionResolver{
+		confusionResolver: confusionResolver{candidates: candidates},
+		title:             "Vocabulary",
+	})
+	got, err = titled.Confusions(context.Background(), ConfusionQuery{})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "Vocabulary", got[0].NotebookTitle)
+}
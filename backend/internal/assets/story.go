@@ -20,7 +20,9 @@ type StoryNotebook struct {
 	Event    string
 	Metadata Metadata
 	Date     time.Time
-	Scenes   []StoryScene
+	// YouTubeURL is the video the story was taken from.
+	YouTubeURL string
+	Scenes     []StoryScene
 }
 
 // Metadata contains optional metadata about the story (series, season, episode)
@@ -43,6 +45,10 @@ type StoryScene struct {
 type Conversation struct {
 	Speaker string
 	Quote   string
+	// Timestamp is when the line is said in the story's video, e.g. "1:23",
+	// and VideoURL links to that moment. Both are empty when unknown.
+	Timestamp string
+	VideoURL  string
 }
 
 // StoryNote represents a note/definition to be learned from the story
@@ -59,6 +65,12 @@ type StoryNote struct {
 	Synonyms      []string
 	Antonyms      []string
 	Images        []string
+	// Audio is the pronunciation recording, as the note refers to it.
+	Audio string
+	// Timestamp is when the note is said in the story's video, and
+	// VideoURL links to that moment. Both are empty when unknown.
+	Timestamp string
+	VideoURL  string
 
 	// Concept context. When this note represents a multi-member
 	// definitions concept (after the writer's group-by-concept_key pass),
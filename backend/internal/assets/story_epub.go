@@ -0,0 +1,206 @@
+package assets
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+)
+
+// EPUBInfo identifies a book in its package document.
+type EPUBInfo struct {
+	// Identifier is a unique id of the book, such as "urn:langner:story:friends".
+	Identifier string
+	Title      string
+	Modified   time.Time
+}
+
+const epubStyle = `body { font-family: serif; line-height: 1.5; }
+.speaker { font-style: italic; }
+a.timestamp { font-size: .85em; }
+a.expression { color: inherit; text-decoration: none; }
+a.expression mark { background: #fff2a8; }
+.definition { margin: 0.8em 0; padding-left: 0.6em; border-left: 3px solid #ccc; }
+.term { margin-bottom: 0.2em; }
+.pronunciation, .part-of-speech { color: #666; }
+.field { margin: 0.1em 0; }
+img { max-width: 100%; }
+audio { display: block; margin: 0.3em 0; }
+table { border-collapse: collapse; }
+td, th { border: 1px solid #ccc; padding: 0.2em 0.4em; }
+`
+
+var epubMediaExtensions = map[string]string{
+	"image/png":  "png",
+	"image/jpeg": "jpg",
+	"image/gif":  "gif",
+	"image/webp": "webp",
+	"audio/mpeg": "mp3",
+	"audio/mp4":  "m4a",
+	"audio/ogg":  "ogg",
+	"audio/wav":  "wav",
+	"audio/flac": "flac",
+}
+
+type epubFile struct {
+	name string
+	data []byte
+}
+
+// epubMedia is an image or audio file stored in the book.
+type epubMedia struct {
+	id        string
+	name      string
+	mediaType string
+	data      []byte
+}
+
+// WriteStoryNotebookEPUB writes templateData as an EPUB 3 book with one
+// chapter per notebook. Meanings are shown after each scene, and
+// highlighted expressions in conversations link to them. Images and audio
+// are stored in the book; those that can't be loaded are left out.
+func WriteStoryNotebookEPUB(output io.Writer, info EPUBInfo, templateData StoryTemplate, loadImage ImageLoader, loadAudio AudioLoader) error {
+	tmpl, err := template.New("story-notebook.xhtml.go.tmpl").
+		Funcs(template.FuncMap{"join": strings.Join}).
+		Parse(storyNotebookXHTMLTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse embedded template: %w", err)
+	}
+
+	var media []epubMedia
+	mediaNames := make(map[string]string)
+	mediaCounts := make(map[string]int)
+	doc := newStoryDocument(info.Title, templateData, loadImage, loadAudio, func(src string, data []byte, mediaType string) template.URL {
+		ext, ok := epubMediaExtensions[mediaType]
+		if !ok {
+			return ""
+		}
+		name, ok := mediaNames[src]
+		if !ok {
+			kind, dir := "image", "images"
+			if strings.HasPrefix(mediaType, "audio/") {
+				kind, dir = "audio", "audio"
+			}
+			mediaCounts[kind]++
+			id := fmt.Sprintf("%s-%d", kind, mediaCounts[kind])
+			name = fmt.Sprintf("%s/%s.%s", dir, id, ext)
+			mediaNames[src] = name
+			media = append(media, epubMedia{id: id, name: name, mediaType: mediaType, data: data})
+		}
+		return template.URL(name)
+	})
+
+	chapters := make([][]byte, len(doc.Chapters))
+	for i, chapter := range doc.Chapters {
+		var buf bytes.Buffer
+		data := struct {
+			Language string
+			Chapter  storyChapter
+		}{Language: doc.Language, Chapter: chapter}
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return fmt.Errorf("tmpl.Execute(%s) > %w", chapter.ID, err)
+		}
+		chapters[i] = buf.Bytes()
+	}
+
+	zw := zip.NewWriter(output)
+	// The mimetype entry must come first and be stored uncompressed.
+	mimetype, err := zw.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return fmt.Errorf("create mimetype: %w", err)
+	}
+	if _, err := io.WriteString(mimetype, "application/epub+zip"); err != nil {
+		return fmt.Errorf("write mimetype: %w", err)
+	}
+
+	files := []epubFile{
+		{name: "META-INF/container.xml", data: []byte(epubContainer)},
+		{name: "OEBPS/content.opf", data: epubPackage(info, doc, media)},
+		{name: "OEBPS/nav.xhtml", data: epubNav(doc)},
+		{name: "OEBPS/style.css", data: []byte(epubStyle)},
+	}
+	for i, chapter := range doc.Chapters {
+		files = append(files, epubFile{name: "OEBPS/" + chapter.ID + ".xhtml", data: chapters[i]})
+	}
+	for _, m := range media {
+		files = append(files, epubFile{name: "OEBPS/" + m.name, data: m.data})
+	}
+	for _, file := range files {
+		w, err := zw.Create(file.name)
+		if err != nil {
+			return fmt.Errorf("create %s: %w", file.name, err)
+		}
+		if _, err := w.Write(file.data); err != nil {
+			return fmt.Errorf("write %s: %w", file.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("close epub: %w", err)
+	}
+	return nil
+}
+
+const epubContainer = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`
+
+func epubPackage(info EPUBInfo, doc storyDocument, media []epubMedia) []byte {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="book-id">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+`)
+	fmt.Fprintf(&sb, "    <dc:identifier id=\"book-id\">%s</dc:identifier>\n", xmlText(info.Identifier))
+	fmt.Fprintf(&sb, "    <dc:title>%s</dc:title>\n", xmlText(info.Title))
+	fmt.Fprintf(&sb, "    <dc:language>%s</dc:language>\n", doc.Language)
+	fmt.Fprintf(&sb, "    <meta property=\"dcterms:modified\">%s</meta>\n", info.Modified.UTC().Format("2006-01-02T15:04:05Z"))
+	sb.WriteString(`  </metadata>
+  <manifest>
+    <item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+    <item id="style" href="style.css" media-type="text/css"/>
+`)
+	for _, chapter := range doc.Chapters {
+		fmt.Fprintf(&sb, "    <item id=\"%s\" href=\"%s.xhtml\" media-type=\"application/xhtml+xml\"/>\n", chapter.ID, chapter.ID)
+	}
+	for _, m := range media {
+		fmt.Fprintf(&sb, "    <item id=\"%s\" href=\"%s\" media-type=\"%s\"/>\n", m.id, m.name, m.mediaType)
+	}
+	sb.WriteString("  </manifest>\n  <spine>\n")
+	for _, chapter := range doc.Chapters {
+		fmt.Fprintf(&sb, "    <itemref idref=\"%s\"/>\n", chapter.ID)
+	}
+	sb.WriteString("  </spine>\n</package>\n")
+	return []byte(sb.String())
+}
+
+func epubNav(doc storyDocument) []byte {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="%s" lang="%s">
+<head><meta charset="utf-8"/><title>%s</title></head>
+<body>
+<nav epub:type="toc" id="toc">
+<h1>%s</h1>
+<ol>
+`, doc.Language, doc.Language, xmlText(doc.Title), xmlText(doc.Title))
+	for _, chapter := range doc.Chapters {
+		fmt.Fprintf(&sb, "<li><a href=\"%s.xhtml\">%s</a></li>\n", chapter.ID, xmlText(chapter.Title))
+	}
+	sb.WriteString("</ol>\n</nav>\n</body>\n</html>\n")
+	return []byte(sb.String())
+}
+
+func xmlText(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,77 @@
+package assets
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteStoryNotebookEPUB(t *testing.T) {
+	var buf bytes.Buffer
+	info := EPUBInfo{Identifier: "urn:langner:story:friends", Title: "Friends & Co", Modified: time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)}
+	require.NoError(t, WriteStoryNotebookEPUB(&buf, info, testStoryTemplate(), fakeImageLoader, fakeAudioLoader))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.NotEmpty(t, zr.File)
+	assert.Equal(t, "mimetype", zr.File[0].Name)
+	assert.Equal(t, zip.Store, zr.File[0].Method)
+
+	files := make(map[string]string)
+	var names []string
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		_ = rc.Close()
+		files[f.Name] = string(content)
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{
+		"mimetype",
+		"META-INF/container.xml",
+		"OEBPS/content.opf",
+		"OEBPS/nav.xhtml",
+		"OEBPS/style.css",
+		"OEBPS/notebook-1.xhtml",
+		"OEBPS/images/image-1.png",
+		"OEBPS/audio/audio-1.wav",
+	}, names)
+	assert.Equal(t, "application/epub+zip", files["mimetype"])
+
+	opf := files["OEBPS/content.opf"]
+	assert.Contains(t, opf, `<dc:identifier id="book-id">urn:langner:story:friends</dc:identifier>`)
+	assert.Contains(t, opf, "<dc:title>Friends &amp; Co</dc:title>")
+	assert.Contains(t, opf, `<meta property="dcterms:modified">2026-10-18T09:00:00Z</meta>`)
+	assert.Contains(t, opf, `<item id="image-1" href="images/image-1.png" media-type="image/png"/>`)
+	assert.Contains(t, opf, `<item id="audio-1" href="audio/audio-1.wav" media-type="audio/wav"/>`)
+	assert.Equal(t, "RIFF", files["OEBPS/audio/audio-1.wav"])
+	assert.Contains(t, opf, `<itemref idref="notebook-1"/>`)
+
+	chapter := files["OEBPS/notebook-1.xhtml"]
+	assert.Contains(t, chapter, `<a class="expression" href="#notebook-1-scene-1-word-1"><mark>Break a leg</mark></a>`)
+	assert.Contains(t, chapter, `<img src="images/image-1.png" alt="break a leg"/>`)
+	assert.NotContains(t, chapter, "missing.png")
+	assert.Contains(t, chapter, `<audio controls="controls" src="audio/audio-1.wav"></audio>`)
+	assert.NotContains(t, chapter, "missing.wav")
+	assert.Contains(t, chapter, `<p class="field"><b>Video:</b> <a href="https://www.youtube.com/watch?t=65&amp;v=abc">1:05</a></p>`)
+
+	// EPUB readers reject content documents that aren't well-formed XML.
+	for _, name := range []string{"OEBPS/content.opf", "OEBPS/nav.xhtml", "OEBPS/notebook-1.xhtml", "META-INF/container.xml"} {
+		decoder := xml.NewDecoder(bytes.NewReader([]byte(files[name])))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err, name)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package assets
+
+import (
+	_ "embed"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+//go:embed templates/story-notebook.html.go.tmpl
+var storyNotebookHTMLTemplate string
+
+//go:embed templates/story-notebook.xhtml.go.tmpl
+var storyNotebookXHTMLTemplate string
+
+// ImageLoader returns the content of an image a note refers to, by URL or
+// local path. Images it fails to load are left out of EPUB books and linked
+// by their original URL in HTML.
+type ImageLoader func(src string) ([]byte, error)
+
+// AudioLoader returns the content and media type of the audio a note
+// refers to. Audio it fails to load is left out.
+type AudioLoader func(src string) (data []byte, mediaType string, err error)
+
+// storyDocument is the data of the HTML and EPUB story templates. It is
+// built from a StoryTemplate, with the markdown highlights in quotes turned
+// into links to the definition they belong to.
+type storyDocument struct {
+	Title    string
+	Language string
+	Chapters []storyChapter
+}
+
+type storyChapter struct {
+	ID     string
+	Title  string
+	Scenes []storySceneView
+}
+
+type storySceneView struct {
+	Title         string
+	Conversations []storyConversationView
+	Statements    template.HTML
+	Blockquote    bool
+	Definitions   []storyDefinitionView
+}
+
+type storyConversationView struct {
+	Speaker   string
+	Quote     template.HTML
+	Timestamp string
+	VideoURL  string
+}
+
+type storyDefinitionView struct {
+	ID            string
+	Term          string
+	Pronunciation string
+	PartOfSpeech  string
+	Meaning       string
+	Note          string
+	Examples      []string
+	Origin        string
+	Synonyms      []string
+	Antonyms      []string
+	Images        []storyImage
+	Audio         template.URL
+	Timestamp     string
+	VideoURL      string
+	Members       []ConceptMember
+}
+
+type storyImage struct {
+	Src template.URL
+	Alt string
+}
+
+// highlightPattern matches the **expression** highlights the notebook
+// converter puts around learned expressions in quotes and statements.
+var highlightPattern = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+
+// embedMedia decides the src of an image or audio. Callers return "" to
+// drop it.
+type embedMedia func(src string, data []byte, mediaType string) template.URL
+
+func newStoryDocument(title string, templateData StoryTemplate, loadImage ImageLoader, loadAudio AudioLoader, embed embedMedia) storyDocument {
+	doc := storyDocument{Title: title, Language: "en"}
+	for ni, nb := range templateData.Notebooks {
+		chapter := storyChapter{ID: fmt.Sprintf("notebook-%d", ni+1), Title: nb.Event}
+		if chapter.Title == "" {
+			chapter.Title = fmt.Sprintf("Notebook %d", ni+1)
+		}
+		for si, scene := range nb.Scenes {
+			chapter.Scenes = append(chapter.Scenes, newStorySceneView(fmt.Sprintf("%s-scene-%d", chapter.ID, si+1), scene, loadImage, loadAudio, embed))
+		}
+		doc.Chapters = append(doc.Chapters, chapter)
+	}
+	return doc
+}
+
+func newStorySceneView(sceneID string, scene StoryScene, loadImage ImageLoader, loadAudio AudioLoader, embed embedMedia) storySceneView {
+	view := storySceneView{Title: scene.Title, Blockquote: scene.Type == "blockquote"}
+	anchors := make(map[string]string)
+	for di, note := range scene.Definitions {
+		def := storyDefinitionView{
+			ID:            fmt.Sprintf("%s-word-%d", sceneID, di+1),
+			Term:          note.Expression,
+			Pronunciation: note.Pronunciation,
+			PartOfSpeech:  note.PartOfSpeech,
+			Meaning:       note.Meaning,
+			Note:          note.Note,
+			Examples:      note.Examples,
+			Origin:        note.Origin,
+			Synonyms:      note.Synonyms,
+			Antonyms:      note.Antonyms,
+			Timestamp:     note.Timestamp,
+			VideoURL:      note.VideoURL,
+		}
+		if note.Definition != "" {
+			def.Term = note.Definition
+		}
+		if note.ConceptHead != "" {
+			def.Term = note.ConceptHead
+			def.Meaning = note.ConceptMeaning
+			def.Members = note.ConceptMembers
+		}
+		for _, src := range note.Images {
+			if image, ok := loadStoryImage(src, def.Term, loadImage, embed); ok {
+				def.Images = append(def.Images, image)
+			}
+		}
+		if note.Audio != "" {
+			def.Audio = loadStoryAudio(note.Audio, loadAudio, embed)
+		}
+		for _, key := range []string{note.Expression, note.Definition, note.ConceptHead} {
+			if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+				if _, ok := anchors[key]; !ok {
+					anchors[key] = def.ID
+				}
+			}
+		}
+		for _, member := range note.ConceptMembers {
+			if key := strings.ToLower(member.Name); key != "" {
+				if _, ok := anchors[key]; !ok {
+					anchors[key] = def.ID
+				}
+			}
+		}
+		view.Definitions = append(view.Definitions, def)
+	}
+
+	for _, conv := range scene.Conversations {
+		view.Conversations = append(view.Conversations, storyConversationView{
+			Speaker:   conv.Speaker,
+			Quote:     linkHighlights(conv.Quote, anchors),
+			Timestamp: conv.Timestamp,
+			VideoURL:  conv.VideoURL,
+		})
+	}
+	if len(scene.Statements) > 0 {
+		view.Statements = linkHighlights(strings.Join(scene.Statements, " "), anchors)
+	}
+	return view
+}
+
+// linkHighlights escapes text and turns each **expression** into a link to
+// its definition, or into bold text when the scene doesn't define it.
+func linkHighlights(text string, anchors map[string]string) template.HTML {
+	var sb strings.Builder
+	last := 0
+	for _, loc := range highlightPattern.FindAllStringSubmatchIndex(text, -1) {
+		sb.WriteString(template.HTMLEscapeString(text[last:loc[0]]))
+		expression := text[loc[2]:loc[3]]
+		if id, ok := anchors[strings.ToLower(strings.TrimSpace(expression))]; ok {
+			fmt.Fprintf(&sb, `<a class="expression" href="#%s"><mark>%s</mark></a>`, id, template.HTMLEscapeString(expression))
+		} else {
+			fmt.Fprintf(&sb, "<b>%s</b>", template.HTMLEscapeString(expression))
+		}
+		last = loc[1]
+	}
+	sb.WriteString(template.HTMLEscapeString(text[last:]))
+	return template.HTML(sb.String())
+}
+
+func loadStoryImage(src, alt string, loadImage ImageLoader, embed embedMedia) (storyImage, bool) {
+	var data []byte
+	if loadImage != nil {
+		data, _ = loadImage(src)
+	}
+	mediaType := ""
+	if len(data) > 0 {
+		mediaType = http.DetectContentType(data)
+	}
+	if !strings.HasPrefix(mediaType, "image/") {
+		data, mediaType = nil, ""
+	}
+	url := embed(src, data, mediaType)
+	if url == "" {
+		return storyImage{}, false
+	}
+	return storyImage{Src: url, Alt: alt}, true
+}
+
+func loadStoryAudio(src string, loadAudio AudioLoader, embed embedMedia) template.URL {
+	if loadAudio == nil {
+		return ""
+	}
+	data, mediaType, err := loadAudio(src)
+	if err != nil || len(data) == 0 || !strings.HasPrefix(mediaType, "audio/") {
+		return ""
+	}
+	return embed(src, data, mediaType)
+}
+
+// WriteStoryNotebookHTML writes templateData as a single HTML file: images
+// and audio are inlined as data URLs, each meaning is folded under its
+// expression for self-testing, and highlighted expressions in conversations
+// link to it.
+func WriteStoryNotebookHTML(output io.Writer, title string, templateData StoryTemplate, loadImage ImageLoader, loadAudio AudioLoader) error {
+	tmpl, err := template.New("story-notebook.html.go.tmpl").
+		Funcs(template.FuncMap{"join": strings.Join}).
+		Parse(storyNotebookHTMLTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse embedded template: %w", err)
+	}
+
+	doc := newStoryDocument(title, templateData, loadImage, loadAudio, func(src string, data []byte, mediaType string) template.URL {
+		if data == nil {
+			if strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://") {
+				return template.URL(src)
+			}
+			return ""
+		}
+		return template.URL("data:" + mediaType + ";base64," + base64.StdEncoding.EncodeToString(data))
+	})
+	if err := tmpl.Execute(output, doc); err != nil {
+		return fmt.Errorf("tmpl.Execute() > %w", err)
+	}
+	return nil
+}
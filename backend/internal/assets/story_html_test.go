@@ -0,0 +1,101 @@
+package assets
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pngHeader is enough of a PNG for http.DetectContentType.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func testStoryTemplate() StoryTemplate {
+	return StoryTemplate{Notebooks: []StoryNotebook{{
+		Event: "Episode 1",
+		Scenes: []StoryScene{{
+			Title: "Central Perk",
+			Conversations: []Conversation{
+				{Speaker: "Joey", Quote: "**Break a leg** tonight & **good luck**!", Timestamp: "1:05", VideoURL: "https://www.youtube.com/watch?t=65&v=abc"},
+			},
+			Definitions: []StoryNote{
+				{
+					Expression:    "break a leg",
+					Meaning:       "good luck",
+					Pronunciation: "breɪk ə lɛɡ",
+					Examples:      []string{"Break a leg <tonight>!"},
+					Images:        []string{"leg.png", "https://example.com/missing.png"},
+					Audio:         "audio/break-a-leg.wav",
+					Timestamp:     "1:05",
+					VideoURL:      "https://www.youtube.com/watch?t=65&v=abc",
+				},
+				{
+					Expression: "good luck",
+					Meaning:    "success",
+					Audio:      "audio/missing.wav",
+				},
+			},
+		}},
+	}}}
+}
+
+func fakeImageLoader(src string) ([]byte, error) {
+	if src == "leg.png" {
+		return pngHeader, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func fakeAudioLoader(src string) ([]byte, string, error) {
+	if src == "audio/break-a-leg.wav" {
+		return []byte("RIFF"), "audio/wav", nil
+	}
+	return nil, "", errors.New("not found")
+}
+
+func TestLinkHighlights(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want template.HTML
+	}{
+		{
+			name: "defined expression links to its definition",
+			text: "Oh, **Break a leg**!",
+			want: `Oh, <a class="expression" href="#def-1"><mark>Break a leg</mark></a>!`,
+		},
+		{
+			name: "undefined highlight stays bold",
+			text: "**good luck** <3",
+			want: `<b>good luck</b> &lt;3`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, linkHighlights(tt.text, map[string]string{"break a leg": "def-1"}))
+		})
+	}
+}
+
+func TestWriteStoryNotebookHTML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteStoryNotebookHTML(&buf, "Friends", testStoryTemplate(), fakeImageLoader, fakeAudioLoader))
+	out := buf.String()
+
+	assert.Contains(t, out, "<title>Friends</title>")
+	assert.Contains(t, out, "<h2>Episode 1</h2>")
+	assert.Contains(t, out, `<a class="expression" href="#notebook-1-scene-1-word-1"><mark>Break a leg</mark></a> tonight &amp; <a class="expression" href="#notebook-1-scene-1-word-2"><mark>good luck</mark></a>!`)
+	assert.Contains(t, out, `<details id="notebook-1-scene-1-word-1">`)
+	assert.Contains(t, out, `<summary>break a leg <span class="pronunciation">/breɪk ə lɛɡ/</span></summary>`)
+	assert.Contains(t, out, `good luck</mark></a>! <a class="timestamp" href="https://www.youtube.com/watch?t=65&amp;v=abc">1:05</a></li>`)
+	assert.Contains(t, out, `<dt>Video</dt><dd><a href="https://www.youtube.com/watch?t=65&amp;v=abc">1:05</a></dd>`)
+	assert.Contains(t, out, "Break a leg &lt;tonight&gt;!")
+	assert.Contains(t, out, `<img src="data:image/png;base64,`)
+	assert.Contains(t, out, `<img src="https://example.com/missing.png"`)
+	assert.Contains(t, out, `<audio controls preload="none" src="data:audio/wav;base64,UklGRg=="></audio>`)
+	assert.Equal(t, 1, strings.Count(out, "<audio "), "audio that can't be loaded is left out")
+}
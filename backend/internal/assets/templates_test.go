@@ -170,6 +170,29 @@ func TestWriteStoryNotebook(t *testing.T) {
 			},
 			wantTemplateContents: "\n## Empty Path Test\n\n\n\n---\n\n### Test\n\n\n- _System_: Using embedded template for testing here.\n\n#### Words and phrases\n\n\n- **test phrase** /test-phrase/ [noun]: A phrase for testing\n\n\n \n\n\n- **test phrase 2**: A phrase for testing 2\n\n\n \n\n \n \n \n",
 		},
+		{
+			name:         "links lines and notes to their moment in the video",
+			templatePath: "",
+			templateData: StoryTemplate{
+				Notebooks: []StoryNotebook{
+					{
+						Event: "Video Test",
+						Scenes: []StoryScene{
+							{
+								Title: "Test",
+								Conversations: []Conversation{
+									{Speaker: "Joey", Quote: "How you **doin'**?", Timestamp: "1:05", VideoURL: "https://youtu.be/abc?t=65"},
+								},
+								Definitions: []StoryNote{
+									{Expression: "doin'", Meaning: "doing", Timestamp: "1:05", VideoURL: "https://youtu.be/abc?t=65"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantTemplateContents: "\n## Video Test\n\n\n\n---\n\n### Test\n\n\n- _Joey_: How you **doin'**? ([1:05](https://youtu.be/abc?t=65))\n\n#### Words and phrases\n\n\n- **doin'**: doing\n    - Video: [1:05](https://youtu.be/abc?t=65)\n\n\n \n\n \n \n \n",
+		},
 		{
 			name: "uses filesystem template when available",
 			templatePath: func(t *testing.T) string {
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/at-ishikawa/langner/internal/analytics"
+)
+
+// RunAnalyzeConfusions lists the pairs of words the learner mixes up, the
+// ones still to untangle first.
+func RunAnalyzeConfusions(ctx context.Context, out io.Writer, repo analytics.Repository, query analytics.ConfusionQuery) error {
+	confusions, err := repo.Confusions(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to find confusions: %w", err)
+	}
+	if len(confusions) == 0 {
+		_, _ = fmt.Fprintln(out, "No confusions found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PAIR\tNOTEBOOK\tQUIZ TYPES\tCOUNT\tLAST CONFUSED\tSTATE\tANSWERS")
+	for _, c := range confusions {
+		state := "unresolved"
+		if c.Resolved {
+			state = "resolved"
+		}
+		_, _ = fmt.Fprintf(w, "%s / %s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			c.Words[0].Expression, c.Words[1].Expression, c.NotebookID, strings.Join(c.QuizTypes, ","),
+			c.Count, c.LastConfusedAt.Format("2006-01-02"), state, strings.Join(c.Answers, "; "))
+	}
+	return w.Flush()
+}
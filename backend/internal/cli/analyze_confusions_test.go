@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/analytics"
+)
+
+// fixedConfusionCandidates lists the same notes for every notebook.
+type fixedConfusionCandidates struct {
+	analytics.MetadataResolver
+	candidates []analytics.ConfusionCandidate
+}
+
+func (f fixedConfusionCandidates) ConfusionCandidates(context.Context, string) []analytics.ConfusionCandidate {
+	return f.candidates
+}
+
+func TestRunAnalyzeConfusions(t *testing.T) {
+	const history = `- metadata:
+    id: vocab
+    title: Vocabulary
+    type: flashcard
+  expressions:
+    - expression: affect
+      learned_logs:
+        - status: misunderstood
+          learned_at: "2026-06-05"
+          quality: 1
+          quiz_type: notebook
+          answer: the result of something
+`
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "vocab.yml"), []byte(history), 0o600))
+
+	var out bytes.Buffer
+	require.NoError(t, RunAnalyzeConfusions(context.Background(), &out, analytics.NewYAMLRepository(dir), analytics.ConfusionQuery{}))
+	assert.Equal(t, "No confusions found.\n", out.String())
+
+	repo := analytics.NewYAMLRepository(dir).WithMetadataResolver(fixedConfusionCandidates{
+		MetadataResolver: analytics.NoMetadataResolver(),
+		candidates: []analytics.ConfusionCandidate{
+			{Expression: "affect", Meaning: "to influence"},
+			{Expression: "effect", Meaning: "a result"},
+		},
+	})
+	out.Reset()
+	require.NoError(t, RunAnalyzeConfusions(context.Background(), &out, repo, analytics.ConfusionQuery{}))
+	assert.Contains(t, out.String(), "PAIR")
+	assert.Contains(t, out.String(), "affect / effect  vocab     notebook    1      2026-06-05     unresolved  the result of something")
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/at-ishikawa/langner/internal/analytics"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+// LeechAction is what `langner analyze leeches --action` does to each leech.
+type LeechAction string
+
+const (
+	// LeechActionNone only lists the leeches.
+	LeechActionNone LeechAction = ""
+	// LeechActionSuspend excludes each leech from its quiz type (skipped_at).
+	LeechActionSuspend LeechAction = "suspend"
+	// LeechActionRelearn moves each leech into the Relearn Quiz pool.
+	LeechActionRelearn LeechAction = "relearn"
+	// LeechActionMnemonic writes a generated memory hook into the memo of
+	// each leech's note that has none.
+	LeechActionMnemonic LeechAction = "mnemonic"
+)
+
+// LeechActioner applies leech actions to the learning history.
+// *quiz.Service implements it.
+type LeechActioner interface {
+	SkipWord(info quiz.CardInfo, skipUntil string, quizTypes []notebook.QuizType) error
+	MoveToRelearnPool(info quiz.CardInfo, quizTypes []notebook.QuizType) error
+	GenerateMnemonics(ctx context.Context, target quiz.MnemonicTarget, dryRun bool) ([]quiz.MnemonicResult, error)
+}
+
+// RunAnalyzeLeeches lists the leeches matching query and applies action to
+// each one the action hasn't been applied to yet.
+func RunAnalyzeLeeches(ctx context.Context, out io.Writer, repo analytics.Repository, query analytics.LeechQuery, action LeechAction, actioner LeechActioner) error {
+	leeches, err := repo.Leeches(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to find leeches: %w", err)
+	}
+	if len(leeches) == 0 {
+		_, _ = fmt.Fprintln(out, "No leeches found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "EXPRESSION\tNOTEBOOK\tQUIZ TYPE\tLAPSES\tWRONG STREAK\tATTEMPTS\tLAST ATTEMPT\tSTATE")
+	for _, l := range leeches {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%s\t%s\n",
+			l.Expression, l.NotebookID, l.QuizType, l.Lapses, l.CurrentWrongStreak, l.Attempts,
+			l.LastAttemptAt.Format("2006-01-02"), leechState(l))
+	}
+	_ = w.Flush()
+
+	if action == LeechActionNone {
+		return nil
+	}
+	applied := 0
+	mnemonicDone := make(map[quiz.MnemonicTarget]bool)
+	for _, l := range leeches {
+		info := quiz.CardInfo{NotebookName: l.NotebookID, Expression: l.Expression, ID: l.ID}
+		quizTypes := []notebook.QuizType{notebook.QuizType(l.QuizType)}
+		switch action {
+		case LeechActionSuspend:
+			if l.Skipped {
+				continue
+			}
+			err = actioner.SkipWord(info, "", quizTypes)
+		case LeechActionRelearn:
+			if l.Skipped || l.InRelearnPool {
+				continue
+			}
+			err = actioner.MoveToRelearnPool(info, quizTypes)
+		case LeechActionMnemonic:
+			// A word failing in several quiz types has one note, and so
+			// one memo.
+			target := quiz.MnemonicTarget{NotebookID: l.NotebookID, ID: l.ID, Expression: l.Expression}
+			if mnemonicDone[target] {
+				continue
+			}
+			mnemonicDone[target] = true
+			var results []quiz.MnemonicResult
+			results, err = actioner.GenerateMnemonics(ctx, target, false)
+			if err == nil && len(results) == 0 {
+				continue
+			}
+		default:
+			return fmt.Errorf("unknown leech action %q", action)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s %q (%s): %w", action, l.Expression, l.QuizType, err)
+		}
+		applied++
+	}
+	_, _ = fmt.Fprintf(out, "\nApplied %s to %d of %d leeches.\n", action, applied, len(leeches))
+	return nil
+}
+
+// leechState describes the leech action already applied to l.
+func leechState(l analytics.Leech) string {
+	switch {
+	case l.Skipped:
+		return "suspended"
+	case l.InRelearnPool:
+		return "relearn pool"
+	default:
+		return "-"
+	}
+}
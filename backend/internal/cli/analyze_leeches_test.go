@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/analytics"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+type recordingLeechActioner struct {
+	skipped   []quiz.CardInfo
+	relearned []quiz.CardInfo
+	mnemonics []quiz.MnemonicTarget
+}
+
+func (r *recordingLeechActioner) SkipWord(info quiz.CardInfo, _ string, _ []notebook.QuizType) error {
+	r.skipped = append(r.skipped, info)
+	return nil
+}
+
+func (r *recordingLeechActioner) MoveToRelearnPool(info quiz.CardInfo, _ []notebook.QuizType) error {
+	r.relearned = append(r.relearned, info)
+	return nil
+}
+
+// GenerateMnemonics records the target and reports a memo written for
+// every note but tenacious, which stands for a note that already has one.
+func (r *recordingLeechActioner) GenerateMnemonics(_ context.Context, target quiz.MnemonicTarget, _ bool) ([]quiz.MnemonicResult, error) {
+	r.mnemonics = append(r.mnemonics, target)
+	if target.Expression == "tenacious" {
+		return nil, nil
+	}
+	return []quiz.MnemonicResult{{Expression: target.Expression, Mnemonic: "hook"}}, nil
+}
+
+func TestRunAnalyzeLeeches(t *testing.T) {
+	const history = `- metadata:
+    id: vocab
+    title: Vocabulary
+    type: flashcard
+  expressions:
+    - expression: obstinate
+      learned_logs:
+        - status: misunderstood
+          learned_at: "2026-06-05"
+        - status: misunderstood
+          learned_at: "2026-06-04"
+        - status: misunderstood
+          learned_at: "2026-06-03"
+    - expression: tenacious
+      skipped_at:
+        notebook: "2026-06-06T00:00:00Z"
+      learned_logs:
+        - status: misunderstood
+          learned_at: "2026-06-05"
+        - status: misunderstood
+          learned_at: "2026-06-04"
+        - status: misunderstood
+          learned_at: "2026-06-03"
+    - expression: lucid
+      learned_logs:
+        - status: understood
+          learned_at: "2026-06-05"
+`
+	tests := []struct {
+		name          string
+		action        LeechAction
+		wantSkipped   []string
+		wantRelearned []string
+		wantMnemonics []quiz.MnemonicTarget
+		wantApplied   string
+	}{
+		{name: "list only", action: LeechActionNone},
+		{name: "suspend skips already suspended leeches", action: LeechActionSuspend, wantSkipped: []string{"obstinate"}},
+		{name: "relearn leaves suspended leeches alone", action: LeechActionRelearn, wantRelearned: []string{"obstinate"}},
+		{
+			name:   "mnemonic targets every leech's note",
+			action: LeechActionMnemonic,
+			wantMnemonics: []quiz.MnemonicTarget{
+				{NotebookID: "vocab", Expression: "obstinate"},
+				{NotebookID: "vocab", Expression: "tenacious"},
+			},
+			wantApplied: "Applied mnemonic to 1 of 2 leeches.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "vocab.yml"), []byte(history), 0644))
+			actioner := &recordingLeechActioner{}
+			var out bytes.Buffer
+
+			err := RunAnalyzeLeeches(context.Background(), &out, analytics.NewYAMLRepository(dir), analytics.LeechQuery{
+				Threshold: analytics.LeechThreshold{WrongStreak: 3},
+			}, tt.action, actioner)
+			require.NoError(t, err)
+
+			assert.Contains(t, out.String(), "obstinate")
+			assert.Contains(t, out.String(), "suspended")
+			assert.NotContains(t, out.String(), "lucid")
+			assert.Equal(t, tt.wantSkipped, expressionsOf(actioner.skipped))
+			assert.Equal(t, tt.wantRelearned, expressionsOf(actioner.relearned))
+			assert.Equal(t, tt.wantMnemonics, actioner.mnemonics)
+			if tt.wantApplied != "" {
+				assert.Contains(t, out.String(), tt.wantApplied)
+			}
+			for _, info := range append(actioner.skipped, actioner.relearned...) {
+				assert.Equal(t, "vocab", info.NotebookName)
+			}
+		})
+	}
+}
+
+func expressionsOf(infos []quiz.CardInfo) []string {
+	var out []string
+	for _, info := range infos {
+		out = append(out, info.Expression)
+	}
+	return out
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/at-ishikawa/langner/internal/learning"
 	"github.com/at-ishikawa/langner/internal/notebook"
 	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/versioning"
 	"github.com/fatih/color"
 )
 
@@ -48,6 +49,11 @@ func NewFreeformQuizCLI(
 	}, nil
 }
 
+// SetRecorder records every answer saved by the session (see versioning).
+func (r *FreeformQuizCLI) SetRecorder(recorder versioning.Recorder) {
+	r.svc.SetRecorder(recorder)
+}
+
 // WordCount returns the total number of word definitions loaded.
 func (r *FreeformQuizCLI) WordCount() int {
 	return len(r.freeformCards)
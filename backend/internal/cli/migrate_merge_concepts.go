@@ -223,8 +223,8 @@ func mergeConceptsInExpressionList(
 	return removed, out
 }
 
-// mergeMemberInto folds the member's logs and skip map into the head's
-// entry in out. The head entry is located by name in out. Logs are
+// mergeMemberInto folds the member's logs, skip and relearn maps into the
+// head's entry in out. The head entry is located by name in out. Logs are
 // concatenated and re-sorted newest-first; skip timestamps are unioned
 // with earliest-non-zero winning on a per-quiz-type collision.
 func mergeMemberInto(out []notebook.LearningHistoryExpression, head string, member notebook.LearningHistoryExpression) {
@@ -235,7 +235,10 @@ func mergeMemberInto(out []notebook.LearningHistoryExpression, head string, memb
 		out[i].LearnedLogs = mergeLogsNewestFirst(out[i].LearnedLogs, member.LearnedLogs)
 		out[i].ReverseLogs = mergeLogsNewestFirst(out[i].ReverseLogs, member.ReverseLogs)
 		out[i].EtymologyOriginLogs = mergeLogsNewestFirst(out[i].EtymologyOriginLogs, member.EtymologyOriginLogs)
+		out[i].DictationLogs = mergeLogsNewestFirst(out[i].DictationLogs, member.DictationLogs)
+		out[i].WordChoiceLogs = mergeLogsNewestFirst(out[i].WordChoiceLogs, member.WordChoiceLogs)
 		out[i].SkippedAt = mergeSkippedAt(out[i].SkippedAt, member.SkippedAt)
+		out[i].RelearnAt = mergeSkippedAt(out[i].RelearnAt, member.RelearnAt)
 		return
 	}
 }
@@ -329,4 +332,6 @@ func rewriteLatestIntervalToMin(head *notebook.LearningHistoryExpression, member
 	rewrite(func(e *notebook.LearningHistoryExpression) *[]notebook.LearningRecord { return &e.LearnedLogs })
 	rewrite(func(e *notebook.LearningHistoryExpression) *[]notebook.LearningRecord { return &e.ReverseLogs })
 	rewrite(func(e *notebook.LearningHistoryExpression) *[]notebook.LearningRecord { return &e.EtymologyOriginLogs })
+	rewrite(func(e *notebook.LearningHistoryExpression) *[]notebook.LearningRecord { return &e.DictationLogs })
+	rewrite(func(e *notebook.LearningHistoryExpression) *[]notebook.LearningRecord { return &e.WordChoiceLogs })
 }
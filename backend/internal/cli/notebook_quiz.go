@@ -13,6 +13,7 @@ import (
 	"github.com/at-ishikawa/langner/internal/learning"
 	"github.com/at-ishikawa/langner/internal/notebook"
 	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/versioning"
 	"github.com/fatih/color"
 )
 
@@ -86,6 +87,11 @@ func NewNotebookQuizCLI(
 	}, nil
 }
 
+// SetRecorder records every answer saved by the session (see versioning).
+func (r *NotebookQuizCLI) SetRecorder(recorder versioning.Recorder) {
+	r.svc.SetRecorder(recorder)
+}
+
 // NewFlashcardQuizCLI creates a new notebook quiz interactive CLI for flashcard notebooks
 func NewFlashcardQuizCLI(
 	notebookName string,
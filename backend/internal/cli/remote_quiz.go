@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/quiztui"
+	"github.com/fatih/color"
+)
+
+// RemoteQuizCLI runs a line-by-line quiz session through a quiztui.Backend,
+// which lets the quiz commands talk to a langner-server instead of loading
+// notebooks and learning notes locally.
+type RemoteQuizCLI struct {
+	*InteractiveQuizCLI
+	backend   quiztui.Backend
+	questions []quiztui.Question
+}
+
+// NewRemoteQuizCLI starts a session of mode on backend. For the modes that
+// draw from notebooks, an empty selections quizzes every notebook with
+// something due.
+func NewRemoteQuizCLI(ctx context.Context, backend quiztui.Backend, mode quiztui.Mode, selections []quiztui.Selection) (*RemoteQuizCLI, error) {
+	if len(selections) == 0 && mode != quiztui.ModeFreeform && mode != quiztui.ModeRelearn {
+		notebooks, err := backend.Notebooks(ctx, mode)
+		if err != nil {
+			return nil, fmt.Errorf("backend.Notebooks() > %w", err)
+		}
+		for _, nb := range notebooks {
+			selections = append(selections, quiztui.Selection{NotebookID: nb.ID})
+		}
+		if len(selections) == 0 {
+			return &RemoteQuizCLI{InteractiveQuizCLI: newStdioQuizCLI(), backend: backend}, nil
+		}
+	}
+
+	questions, err := backend.Start(ctx, mode, selections)
+	if err != nil {
+		return nil, fmt.Errorf("backend.Start() > %w", err)
+	}
+	return &RemoteQuizCLI{
+		InteractiveQuizCLI: newStdioQuizCLI(),
+		backend:            backend,
+		questions:          questions,
+	}, nil
+}
+
+// newStdioQuizCLI returns the terminal I/O of an InteractiveQuizCLI without
+// loading any notebook data.
+func newStdioQuizCLI() *InteractiveQuizCLI {
+	return &InteractiveQuizCLI{
+		stdinReader:  bufio.NewReader(os.Stdin),
+		stdoutWriter: os.Stdout,
+		bold:         color.New(color.Bold),
+		italic:       color.New(color.Italic),
+	}
+}
+
+// SetIO replaces stdin and stdout, for tests.
+func (r *RemoteQuizCLI) SetIO(in io.Reader, out io.Writer) {
+	r.stdinReader = bufio.NewReader(in)
+	r.stdoutWriter = out
+}
+
+// ShuffleCards shuffles the questions
+func (r *RemoteQuizCLI) ShuffleCards() {
+	rand.Shuffle(len(r.questions), func(i, j int) {
+		r.questions[i], r.questions[j] = r.questions[j], r.questions[i]
+	})
+}
+
+// GetCardCount returns the number of remaining questions
+func (r *RemoteQuizCLI) GetCardCount() int {
+	return len(r.questions)
+}
+
+// Session asks the next question. Open-ended questions stay in place until
+// "quit" is typed as the first answer.
+func (r *RemoteQuizCLI) Session(ctx context.Context) error {
+	if len(r.questions) == 0 {
+		_, _ = fmt.Fprintln(r.stdoutWriter, "No more cards to practice!")
+		return errEnd
+	}
+	question := r.questions[0]
+	startTime := time.Now()
+
+	if question.Heading != "" && !question.Repeat {
+		_, _ = fmt.Fprintln(r.stdoutWriter, question.Heading)
+	}
+	if !question.Repeat {
+		_, _ = r.bold.Fprintln(r.stdoutWriter, question.Prompt)
+		for i, line := range question.Context {
+			_, _ = fmt.Fprintf(r.stdoutWriter, "  %d. %s\n", i+1, line)
+		}
+	}
+
+	answers := make([]string, len(question.Fields))
+	for i, field := range question.Fields {
+		_, _ = fmt.Fprintf(r.stdoutWriter, "%s: ", field)
+		input, err := r.stdinReader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("error reading input: %w", err)
+		}
+		answers[i] = strings.TrimSpace(input)
+		if i == 0 && question.Repeat {
+			if answers[0] == "quit" || answers[0] == "exit" {
+				_, _ = fmt.Fprintln(r.stdoutWriter, "Practice session ended.")
+				return errEnd
+			}
+			if answers[0] == "" {
+				_, _ = fmt.Fprintf(r.stdoutWriter, "Invalid input: %v\n", ErrEmptyWord)
+				return nil
+			}
+		}
+	}
+
+	result, err := r.backend.Submit(ctx, question, answers, time.Since(startTime))
+	if err != nil {
+		return fmt.Errorf("failed to grade answer: %w", err)
+	}
+	r.displayResult(result)
+
+	if !question.Repeat {
+		r.questions = r.questions[1:]
+	}
+	return nil
+}
+
+func (r *RemoteQuizCLI) displayResult(result quiztui.Result) {
+	expected := ""
+	if result.Expected != "" {
+		expected = fmt.Sprintf(` The answer is "%s"`, r.italic.Sprint(result.Expected))
+	}
+	if result.Correct {
+		_, _ = fmt.Fprint(r.stdoutWriter, "✅ ")
+		_, _ = color.New(color.FgGreen).Fprintf(r.stdoutWriter, "It's correct.%s", expected)
+	} else {
+		_, _ = fmt.Fprint(r.stdoutWriter, "❌ ")
+		_, _ = color.New(color.FgRed).Fprintf(r.stdoutWriter, "It's wrong.%s", expected)
+	}
+	_, _ = fmt.Fprintln(r.stdoutWriter)
+	if result.Reason != "" {
+		_, _ = fmt.Fprintf(r.stdoutWriter, "   Reason: %s\n", result.Reason)
+	}
+	if result.NextReviewDate != "" {
+		_, _ = fmt.Fprintf(r.stdoutWriter, "   Next review: %s\n", result.NextReviewDate)
+	}
+	_, _ = fmt.Fprintln(r.stdoutWriter)
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/quiztui"
+)
+
+type fakeQuizBackend struct {
+	quiztui.Backend
+	notebooks  []quiztui.Notebook
+	questions  []quiztui.Question
+	selections []quiztui.Selection
+	submitted  [][]string
+}
+
+func (b *fakeQuizBackend) Notebooks(_ context.Context, _ quiztui.Mode) ([]quiztui.Notebook, error) {
+	return b.notebooks, nil
+}
+
+func (b *fakeQuizBackend) Start(_ context.Context, _ quiztui.Mode, selections []quiztui.Selection) ([]quiztui.Question, error) {
+	b.selections = selections
+	return b.questions, nil
+}
+
+func (b *fakeQuizBackend) Submit(_ context.Context, _ quiztui.Question, answers []string, _ time.Duration) (quiztui.Result, error) {
+	b.submitted = append(b.submitted, answers)
+	return quiztui.Result{Correct: answers[0] == "good luck", Expected: "good luck", NextReviewDate: "2026-10-21"}, nil
+}
+
+func TestNewRemoteQuizCLI_selections(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       quiztui.Mode
+		selections []quiztui.Selection
+		want       []quiztui.Selection
+	}{
+		{
+			name:       "named notebook",
+			mode:       quiztui.ModeRecognition,
+			selections: []quiztui.Selection{{NotebookID: "friends"}},
+			want:       []quiztui.Selection{{NotebookID: "friends"}},
+		},
+		{
+			name: "every due notebook",
+			mode: quiztui.ModeReverse,
+			want: []quiztui.Selection{{NotebookID: "friends"}, {NotebookID: "office"}},
+		},
+		{
+			name: "freeform picks no notebooks",
+			mode: quiztui.ModeFreeform,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &fakeQuizBackend{notebooks: []quiztui.Notebook{{ID: "friends"}, {ID: "office"}}}
+			_, err := NewRemoteQuizCLI(context.Background(), backend, tt.mode, tt.selections)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, backend.selections)
+		})
+	}
+}
+
+func TestNewRemoteQuizCLI_nothingDue(t *testing.T) {
+	backend := &fakeQuizBackend{questions: []quiztui.Question{{ID: 1}}}
+	remoteCLI, err := NewRemoteQuizCLI(context.Background(), backend, quiztui.ModeRecognition, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, remoteCLI.GetCardCount())
+	assert.Nil(t, backend.selections)
+}
+
+func TestRemoteQuizCLI_Session(t *testing.T) {
+	backend := &fakeQuizBackend{questions: []quiztui.Question{
+		{ID: 1, Heading: "Friends", Prompt: "break a leg", Context: []string{"Joey: Break a leg!"}, Fields: []string{"Meaning"}},
+		{ID: 2, Prompt: "on a roll", Fields: []string{"Meaning"}},
+	}}
+	remoteCLI, err := NewRemoteQuizCLI(context.Background(), backend, quiztui.ModeRecognition, []quiztui.Selection{{NotebookID: "friends"}})
+	require.NoError(t, err)
+	var out bytes.Buffer
+	remoteCLI.SetIO(strings.NewReader("good luck\n\n"), &out)
+
+	require.NoError(t, remoteCLI.Session(context.Background()))
+	assert.Contains(t, out.String(), "Friends\n")
+	assert.Contains(t, out.String(), "  1. Joey: Break a leg!\n")
+	assert.Contains(t, out.String(), "It's correct.")
+	assert.Contains(t, out.String(), "Next review: 2026-10-21")
+	assert.Equal(t, 1, remoteCLI.GetCardCount())
+
+	require.NoError(t, remoteCLI.Session(context.Background()))
+	assert.Contains(t, out.String(), "It's wrong.")
+	assert.Equal(t, [][]string{{"good luck"}, {""}}, backend.submitted)
+
+	assert.ErrorIs(t, remoteCLI.Session(context.Background()), errEnd)
+}
+
+func TestRemoteQuizCLI_Session_freeform(t *testing.T) {
+	backend := &fakeQuizBackend{questions: []quiztui.Question{{Prompt: "Recall a word", Fields: []string{"Word", "Meaning"}, Repeat: true}}}
+	remoteCLI, err := NewRemoteQuizCLI(context.Background(), backend, quiztui.ModeFreeform, nil)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	remoteCLI.SetIO(strings.NewReader("\nbreak a leg\ngood luck\nquit\n"), &out)
+
+	require.NoError(t, remoteCLI.Session(context.Background()))
+	assert.Contains(t, out.String(), "Invalid input: Word cannot be empty")
+	require.NoError(t, remoteCLI.Session(context.Background()))
+	assert.Equal(t, [][]string{{"break a leg", "good luck"}}, backend.submitted)
+	assert.Equal(t, 1, remoteCLI.GetCardCount())
+
+	assert.ErrorIs(t, remoteCLI.Session(context.Background()), errEnd)
+	assert.Contains(t, out.String(), "Practice session ended.")
+}
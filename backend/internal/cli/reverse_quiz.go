@@ -12,6 +12,7 @@ import (
 	"github.com/at-ishikawa/langner/internal/learning"
 	"github.com/at-ishikawa/langner/internal/notebook"
 	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/versioning"
 	"github.com/fatih/color"
 )
 
@@ -76,6 +77,11 @@ func NewReverseQuizCLI(
 	}, nil
 }
 
+// SetRecorder records every answer saved by the session (see versioning).
+func (r *ReverseQuizCLI) SetRecorder(recorder versioning.Recorder) {
+	r.svc.SetRecorder(recorder)
+}
+
 // sortReverseCardsByContextAvailability sorts cards so that words without context come first.
 func sortReverseCardsByContextAvailability(cards []quiz.ReverseCard) []quiz.ReverseCard {
 	var withoutContext, withContext []quiz.ReverseCard
@@ -181,6 +187,7 @@ func (r *ReverseQuizCLI) gradeWithSynonymRetry(
 			Correct: false,
 			Reason:  "empty answer",
 			Quality: int(notebook.QualityWrong),
+			Grader:  notebook.GraderDeterministic,
 		}, nil
 	}
 
@@ -190,6 +197,8 @@ func (r *ReverseQuizCLI) gradeWithSynonymRetry(
 			Correct: false,
 			Reason:  fmt.Sprintf("validation error: %v", err),
 			Quality: int(notebook.QualityWrong),
+			Answer:  userAnswer,
+			Grader:  notebook.GraderDeterministic,
 		}, nil
 	}
 
@@ -223,6 +232,8 @@ func (r *ReverseQuizCLI) gradeWithSynonymRetry(
 			Reason:         grade.Reason + " (accepted on retry)",
 			Quality:        int(notebook.QualityCorrectSlow),
 			Classification: grade.Classification,
+			Answer:         grade.Answer,
+			Grader:         grade.Grader,
 		}, nil
 	}
 
@@ -14,6 +14,9 @@ import (
 type ServerConfig struct {
 	Port int        `mapstructure:"port"`
 	CORS CORSConfig `mapstructure:"cors"`
+	// HotReload keeps parsed notebooks in memory and refreshes them when the
+	// files change on disk, instead of re-parsing every YAML file per request.
+	HotReload bool `mapstructure:"hot_reload"`
 }
 
 type CORSConfig struct {
@@ -31,6 +34,114 @@ type Config struct {
 	Books        BooksConfig        `mapstructure:"books"`
 	Database     DatabaseConfig     `mapstructure:"database"`
 	Quiz         QuizConfig         `mapstructure:"quiz"`
+	Versioning   VersioningConfig   `mapstructure:"versioning"`
+	Storage      StorageConfig      `mapstructure:"storage"`
+	PDF          PDFConfig          `mapstructure:"pdf"`
+	TTS          TTSConfig          `mapstructure:"tts"`
+	STT          STTConfig          `mapstructure:"stt"`
+	Video        VideoConfig        `mapstructure:"video"`
+	Search       SearchConfig       `mapstructure:"search"`
+	Frequency    FrequencyConfig    `mapstructure:"frequency"`
+}
+
+// TTSConfig selects the local text-to-speech program `langner notebooks
+// audio` synthesizes pronunciations with. Backend is "espeak-ng" (default),
+// "piper", or "command" for any other program. Command overrides the
+// program to run and Args its arguments, where {text}, {voice} and {output}
+// are replaced with the expression, Voice and the file to write; the text is
+// written to stdin when no argument contains {text}. Voice is an espeak-ng
+// voice name or the path to a piper model. Player is the program and
+// arguments `langner quiz dictation` plays audio with, the file appended as
+// the last argument; empty picks the first of afplay, ffplay, aplay and
+// paplay found on PATH.
+type TTSConfig struct {
+	Backend string   `mapstructure:"backend" validate:"omitempty,oneof=espeak-ng piper command"`
+	Command string   `mapstructure:"command"`
+	Args    []string `mapstructure:"args"`
+	Voice   string   `mapstructure:"voice"`
+	Player  []string `mapstructure:"player"`
+}
+
+// STTConfig selects the speech-to-text service spoken quiz answers are
+// transcribed with. Mode "whisper" posts the audio to a whisper.cpp
+// compatible server at URL; mode "mock" reads the audio back as text, used
+// by e2e tests; empty disables spoken answers. Language is the language
+// code of the answers, "en" when empty.
+type STTConfig struct {
+	Mode     string `mapstructure:"mode" validate:"omitempty,oneof=whisper mock"`
+	URL      string `mapstructure:"url" validate:"required_if=Mode whisper"`
+	Language string `mapstructure:"language"`
+}
+
+// VideoConfig sets how `langner parse video` turns a video into a story
+// notebook. Command and Args run the program extracting the soundtrack as
+// 16 kHz mono WAV, where {input} and {output} are replaced with the video
+// and the file to write; empty runs ffmpeg. WhisperURL is the whisper.cpp
+// compatible server the soundtrack is transcribed with, stt.url when empty,
+// in stt.language. SceneGapSeconds is the pause between lines that starts
+// a new scene, 10 when zero.
+type VideoConfig struct {
+	Command         string   `mapstructure:"command"`
+	Args            []string `mapstructure:"args"`
+	WhisperURL      string   `mapstructure:"whisper_url"`
+	SceneGapSeconds float64  `mapstructure:"scene_gap_seconds" validate:"gte=0"`
+}
+
+// SearchConfig sets where `langner search` and the SearchNotebooks RPC keep
+// the index of every notebook's lines and notes. IndexFile saves it between
+// runs and is rebuilt when a notebook file changes; empty keeps the index
+// in memory only.
+type SearchConfig struct {
+	IndexFile string `mapstructure:"index_file"`
+}
+
+// FrequencyConfig sets the word-frequency list that ranks how common words
+// are, one word per line from the most frequent (see frequency.ParseList).
+// Without one, only the frequency in the WordsAPI dictionary cache is
+// used.
+type FrequencyConfig struct {
+	ListFile string `mapstructure:"list_file"`
+}
+
+// PDFConfig sets the TrueType fonts PDF exports are typeset in. Styles
+// left empty fall back to FontPath; an empty FontPath uses the bundled
+// DejaVu Sans, which covers IPA, Greek, Cyrillic and accented Latin.
+type PDFConfig struct {
+	FontPath           string `mapstructure:"font_path" validate:"omitempty,file"`
+	BoldFontPath       string `mapstructure:"bold_font_path" validate:"omitempty,file"`
+	ItalicFontPath     string `mapstructure:"italic_font_path" validate:"omitempty,file"`
+	BoldItalicFontPath string `mapstructure:"bold_italic_font_path" validate:"omitempty,file"`
+}
+
+// Storage modes. StorageModeYAML keeps the YAML files as the source of
+// truth, mirrored into the database when one is configured.
+// StorageModePostgres makes the database authoritative: notebooks,
+// learning history and analytics are read from it, answers are written
+// only to it, and `langner export-db` produces YAML from it.
+const (
+	StorageModeYAML     = "yaml"
+	StorageModePostgres = "postgres"
+)
+
+// StorageConfig selects where learning data lives. Mode is one of the
+// StorageMode constants; empty means StorageModeYAML.
+type StorageConfig struct {
+	Mode string `mapstructure:"mode" validate:"omitempty,oneof=yaml postgres"`
+}
+
+// IsPostgres reports whether the database is the source of truth.
+func (c StorageConfig) IsPostgres() bool {
+	return c.Mode == StorageModePostgres
+}
+
+// VersioningConfig turns on recording learning-data writes as git commits.
+// Mode "git" commits every write by the learning repository and every
+// migration into the repository at Directory (created when missing);
+// empty disables versioning. Directory defaults to
+// notebooks.base_directory.
+type VersioningConfig struct {
+	Mode      string `mapstructure:"mode" validate:"omitempty,oneof=git"`
+	Directory string `mapstructure:"directory"`
 }
 
 type QuizConfig struct {
@@ -40,6 +151,19 @@ type QuizConfig struct {
 	// shuffling them. e2e tests rely on this so scenarios can assert which
 	// card appears first.
 	DisableShuffle bool `mapstructure:"disable_shuffle"`
+	// FrequentFirst serves the cards of the most frequent words first,
+	// still shuffled within each frequency band.
+	FrequentFirst bool        `mapstructure:"frequent_first"`
+	Leech         LeechConfig `mapstructure:"leech"`
+}
+
+// LeechConfig sets when a word counts as a leech: it has lapsed (been
+// answered wrong after a correct answer) Lapses times, or its current run
+// of wrong answers is WrongStreak long, in one quiz type. Zero disables
+// that criterion.
+type LeechConfig struct {
+	Lapses      int `mapstructure:"lapses" validate:"gte=0"`
+	WrongStreak int `mapstructure:"wrong_streak" validate:"gte=0"`
 }
 
 type DatabaseConfig struct {
@@ -129,6 +253,10 @@ type OutputsConfig struct {
 	// StoryDirectory when empty so the new command works without a
 	// config change.
 	QuizReviewDirectory string `mapstructure:"quiz_review_directory"`
+	// WorksheetDirectory holds the worksheets generated by `langner
+	// worksheet generate`: the sheet YAML that `langner worksheet grade`
+	// reads back, and its printable questions and answer key.
+	WorksheetDirectory string `mapstructure:"worksheet_directory"`
 }
 
 type DictionariesConfig struct {
@@ -203,6 +331,7 @@ func (loader *ConfigLoader) Load() (*Config, error) {
 	v.SetDefault("outputs.story_directory", filepath.Join("outputs", "story"))
 	v.SetDefault("outputs.flashcard_directory", filepath.Join("outputs", "flashcard"))
 	v.SetDefault("outputs.etymology_directory", filepath.Join("outputs", "etymology"))
+	v.SetDefault("outputs.worksheet_directory", filepath.Join("outputs", "worksheet"))
 	v.SetDefault("openai.model", "gpt-4o-mini")
 	v.SetDefault("books.repo_directory", "ebooks")
 	v.SetDefault("books.repositories_file", "books.yml")
@@ -212,8 +341,11 @@ func (loader *ConfigLoader) Load() (*Config, error) {
 	v.SetDefault("database.username", "user")
 	v.SetDefault("server.port", 8080)
 	v.SetDefault("server.cors.allowed_origins", []string{"http://localhost:3000"})
+	v.SetDefault("server.hot_reload", true)
 	v.SetDefault("quiz.algorithm", "modified_sm2")
 	v.SetDefault("quiz.fixed_intervals", []int{1, 7, 30, 90, 365, 1095, 1825})
+	v.SetDefault("quiz.leech.lapses", 4)
+	v.SetDefault("quiz.leech.wrong_streak", 3)
 
 	// Bind RapidAPI config to environment variables only (not from config file)
 	if err := v.BindEnv("dictionaries.rapidapi.host", "RAPID_API_HOST"); err != nil {
@@ -247,6 +379,9 @@ func (loader *ConfigLoader) Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid configuration format: %w", err)
 	}
 	cfg.Notebooks.applyBaseDirectory()
+	if cfg.Versioning.Directory == "" {
+		cfg.Versioning.Directory = cfg.Notebooks.BaseDirectory
+	}
 
 	if err := loader.validator.Struct(cfg); err != nil {
 		validationErrors := err.(validator.ValidationErrors)
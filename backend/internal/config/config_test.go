@@ -72,6 +72,7 @@ func TestConfigLoader_Load(t *testing.T) {
 					CORS: CORSConfig{
 						AllowedOrigins: []string{"http://localhost:3000"},
 					},
+					HotReload: true,
 				},
 				Notebooks: NotebooksConfig{
 					BaseDirectory:          "notebooks",
@@ -93,6 +94,7 @@ func TestConfigLoader_Load(t *testing.T) {
 					StoryDirectory:     filepath.Join("outputs", "story"),
 					FlashcardDirectory: filepath.Join("outputs", "flashcard"),
 					EtymologyDirectory: filepath.Join("outputs", "etymology"),
+					WorksheetDirectory: filepath.Join("outputs", "worksheet"),
 				},
 				OpenAI: OpenAIConfig{
 					Model: "gpt-4o-mini",
@@ -111,6 +113,10 @@ func TestConfigLoader_Load(t *testing.T) {
 				Quiz: QuizConfig{
 					Algorithm:      "modified_sm2",
 					FixedIntervals: []int{1, 7, 30, 90, 365, 1095, 1825},
+					Leech:          LeechConfig{Lapses: 4, WrongStreak: 3},
+				},
+				Versioning: VersioningConfig{
+					Directory: "notebooks",
 				},
 			},
 		},
@@ -131,6 +137,7 @@ outputs:
 					CORS: CORSConfig{
 						AllowedOrigins: []string{"http://localhost:3000"},
 					},
+					HotReload: true,
 				},
 				Notebooks: NotebooksConfig{
 					BaseDirectory:          "notebooks",
@@ -152,6 +159,7 @@ outputs:
 					StoryDirectory:     "custom/outputs",
 					FlashcardDirectory: filepath.Join("outputs", "flashcard"),
 					EtymologyDirectory: filepath.Join("outputs", "etymology"),
+					WorksheetDirectory: filepath.Join("outputs", "worksheet"),
 				},
 				OpenAI: OpenAIConfig{
 					Model: "gpt-4o-mini",
@@ -170,6 +178,10 @@ outputs:
 				Quiz: QuizConfig{
 					Algorithm:      "modified_sm2",
 					FixedIntervals: []int{1, 7, 30, 90, 365, 1095, 1825},
+					Leech:          LeechConfig{Lapses: 4, WrongStreak: 3},
+				},
+				Versioning: VersioningConfig{
+					Directory: "notebooks",
 				},
 			},
 		},
@@ -184,6 +196,7 @@ outputs:
 					CORS: CORSConfig{
 						AllowedOrigins: []string{"http://localhost:3000"},
 					},
+					HotReload: true,
 				},
 				Notebooks: NotebooksConfig{
 					BaseDirectory:          "notebooks",
@@ -205,6 +218,7 @@ outputs:
 					StoryDirectory:     filepath.Join("outputs", "story"),
 					FlashcardDirectory: filepath.Join("outputs", "flashcard"),
 					EtymologyDirectory: filepath.Join("outputs", "etymology"),
+					WorksheetDirectory: filepath.Join("outputs", "worksheet"),
 				},
 				OpenAI: OpenAIConfig{
 					Model: "gpt-4o-mini",
@@ -223,6 +237,10 @@ outputs:
 				Quiz: QuizConfig{
 					Algorithm:      "modified_sm2",
 					FixedIntervals: []int{1, 7, 30, 90, 365, 1095, 1825},
+					Leech:          LeechConfig{Lapses: 4, WrongStreak: 3},
+				},
+				Versioning: VersioningConfig{
+					Directory: "notebooks",
 				},
 			},
 		},
@@ -248,6 +266,20 @@ outputs:
 `,
 			wantErr: "templates.story_notebook_template must be an existing and readable file",
 		},
+		{
+			name: "unknown storage mode",
+			configContent: `storage:
+  mode: sqlite
+`,
+			wantErr: "invalid configuration",
+		},
+		{
+			name: "whisper speech-to-text without a url",
+			configContent: `stt:
+  mode: whisper
+`,
+			wantErr: "invalid configuration",
+		},
 	}
 
 	for _, tt := range tests {
@@ -34,6 +34,30 @@ type logKey struct {
 	learnedAt        time.Time
 	sourceNotebookID string
 	status           string
+	// origin, originSense and correctionID are part of the key so rows
+	// imported before those columns existed (all '') are replaced by
+	// correctly-keyed ones on the next import instead of being kept.
+	origin       string
+	originSense  string
+	correctionID string
+}
+
+// logSubjectKeys returns the origin/sense and grammar correction a log of
+// quizType on expr is keyed by in learning_logs. Origin entries (type:
+// origin) carry their origin and sense; grammar logs hang off the
+// correction id, which is the entry's id (or, for legacy entries, its
+// expression).
+func logSubjectKeys(expr notebook.LearningHistoryExpression, quizType string) (origin, originSense, correctionID string) {
+	if expr.Type == notebook.LearningExpressionTypeOrigin {
+		origin, originSense = expr.Expression, expr.Sense
+	}
+	if quizType == string(notebook.QuizTypeGrammar) {
+		correctionID = expr.ID
+		if correctionID == "" {
+			correctionID = expr.Expression
+		}
+	}
+	return origin, originSense, correctionID
 }
 
 // logCounter tracks DB log IDs grouped by (note_id, quiz_type, learned_at,
@@ -51,7 +75,7 @@ type logCounter struct {
 func newLogCounter(logs []learning.LearningLog) *logCounter {
 	lc := &logCounter{ids: make(map[logKey][]int64, len(logs))}
 	for _, l := range logs {
-		k := logKey{l.NoteID, l.QuizType, l.LearnedAt.UTC(), l.SourceNotebookID, l.Status}
+		k := logKey{l.NoteID, l.QuizType, l.LearnedAt.UTC(), l.SourceNotebookID, l.Status, l.Origin, l.OriginSense, l.CorrectionID}
 		lc.ids[k] = append(lc.ids[k], l.ID)
 	}
 	return lc
@@ -109,6 +133,26 @@ type LearningSource interface {
 	FindByNotebookID(notebookID string) ([]notebook.LearningHistoryExpression, error)
 }
 
+// GrammarNotebookSource is implemented by learning sources that also hold
+// grammar (journal) histories. Those notebooks have no notes of their own,
+// so the importer asks for them explicitly.
+type GrammarNotebookSource interface {
+	GrammarNotebookIDs() ([]string, error)
+}
+
+// NotebookSource is implemented by note sources that also know the names
+// of their notebooks and the prose of their scenes, which notes don't carry.
+type NotebookSource interface {
+	FindNotebooks(ctx context.Context) ([]notebook.NotebookRecord, error)
+}
+
+// NotebookStore is implemented by note repositories that store notebook
+// names and scene prose (DBNoteRepository), so postgres storage mode can
+// serve notebooks without the YAML files.
+type NotebookStore interface {
+	ReplaceNotebooks(ctx context.Context, notebooks []notebook.NotebookRecord) error
+}
+
 // DictionarySource provides cached dictionary API responses.
 type DictionarySource interface {
 	ReadAll() ([]rapidapi.Response, error)
@@ -159,6 +203,10 @@ type ImportNotesResult struct {
 	// the YAML no longer has that the importer dropped.
 	NotesDeleted         int
 	NotebookNotesDeleted int
+	// Notebooks and NotebookScenes count the notebook names and scene
+	// prose stored next to the notes.
+	Notebooks      int
+	NotebookScenes int
 }
 
 // ImportLearningLogsResult tracks counts for learning log import.
@@ -427,9 +475,40 @@ func (imp *Importer) ImportNotes(ctx context.Context, opts ImportOptions) (*Impo
 		}
 	}
 
+	if err := imp.importNotebooks(ctx, opts, state.result); err != nil {
+		return nil, err
+	}
 	return state.result, nil
 }
 
+// importNotebooks replaces the stored notebook names and scene prose with
+// the source's, when both sides support them.
+func (imp *Importer) importNotebooks(ctx context.Context, opts ImportOptions, result *ImportNotesResult) error {
+	source, ok := imp.noteSource.(NotebookSource)
+	if !ok {
+		return nil
+	}
+	store, ok := imp.noteRepo.(NotebookStore)
+	if !ok {
+		return nil
+	}
+	notebooks, err := source.FindNotebooks(ctx)
+	if err != nil {
+		return fmt.Errorf("read source notebooks: %w", err)
+	}
+	result.Notebooks = len(notebooks)
+	for _, nb := range notebooks {
+		result.NotebookScenes += len(nb.Scenes)
+	}
+	if opts.DryRun {
+		return nil
+	}
+	if err := store.ReplaceNotebooks(ctx, notebooks); err != nil {
+		return fmt.Errorf("replace notebooks: %w", err)
+	}
+	return nil
+}
+
 func (imp *Importer) classifyRecord(src *notebook.NoteRecord, opts ImportOptions, state *classifyState) {
 	key := newNoteKey(src.SenseID, src.Usage, src.Entry)
 	existing := state.noteCache[key]
@@ -572,6 +651,15 @@ func (imp *Importer) ImportLearningLogs(ctx context.Context, opts ImportOptions)
 			notebookIDs[nn.NotebookID] = true
 		}
 	}
+	if grammarSource, ok := imp.learningSource.(GrammarNotebookSource); ok {
+		grammarIDs, err := grammarSource.GrammarNotebookIDs()
+		if err != nil {
+			return nil, fmt.Errorf("find grammar notebooks: %w", err)
+		}
+		for _, id := range grammarIDs {
+			notebookIDs[id] = true
+		}
+	}
 	sortedIDs := make([]string, 0, len(notebookIDs))
 	for id := range notebookIDs {
 		sortedIDs = append(sortedIDs, id)
@@ -646,7 +734,8 @@ func (imp *Importer) ImportLearningLogs(ctx context.Context, opts ImportOptions)
 			if quizType == "" {
 				quizType = "notebook"
 			}
-			key := logKey{n.ID, quizType, rec.LearnedAt.UTC(), expr.notebookID, string(rec.Status)}
+			origin, originSense, correctionID := logSubjectKeys(expr.LearningHistoryExpression, quizType)
+			key := logKey{n.ID, quizType, rec.LearnedAt.UTC(), expr.notebookID, string(rec.Status), origin, originSense, correctionID}
 			if existingLogs.matchSource(key) {
 				result.LearningSkipped++
 				continue
@@ -660,13 +749,20 @@ func (imp *Importer) ImportLearningLogs(ctx context.Context, opts ImportOptions)
 				QuizType:         quizType,
 				IntervalDays:     rec.IntervalDays,
 				SourceNotebookID: expr.notebookID,
+				Origin:           origin,
+				OriginSense:      originSense,
+				CorrectionID:     correctionID,
+				Answer:           rec.Answer,
+				GraderReason:     rec.GraderReason,
+				Grader:           rec.Grader,
 			})
 			result.LearningNew++
 		}
 
 		for _, rec := range expr.ReverseLogs {
 			quizType := "reverse"
-			key := logKey{n.ID, quizType, rec.LearnedAt.UTC(), expr.notebookID, string(rec.Status)}
+			origin, originSense, correctionID := logSubjectKeys(expr.LearningHistoryExpression, quizType)
+			key := logKey{n.ID, quizType, rec.LearnedAt.UTC(), expr.notebookID, string(rec.Status), origin, originSense, correctionID}
 			if existingLogs.matchSource(key) {
 				result.LearningSkipped++
 				continue
@@ -680,21 +776,28 @@ func (imp *Importer) ImportLearningLogs(ctx context.Context, opts ImportOptions)
 				QuizType:         quizType,
 				IntervalDays:     rec.IntervalDays,
 				SourceNotebookID: expr.notebookID,
+				Origin:           origin,
+				OriginSense:      originSense,
+				CorrectionID:     correctionID,
+				Answer:           rec.Answer,
+				GraderReason:     rec.GraderReason,
+				Grader:           rec.Grader,
 			})
 			result.LearningNew++
 		}
 
-		// Etymology log tracks. The QuizType written to learning_logs
-		// preserves the original (etymology_breakdown / etymology_assembly /
-		// etymology_freeform) so downstream queries can split the data
-		// without losing the direction signal.
-		appendEtymologyLogs := func(logs []notebook.LearningRecord, defaultQuizType string) {
+		// Etymology and dictation log tracks. The QuizType written to
+		// learning_logs preserves the original (etymology_breakdown /
+		// etymology_assembly / etymology_freeform) so downstream queries can
+		// split the data without losing the direction signal.
+		appendTrackLogs := func(logs []notebook.LearningRecord, defaultQuizType string) {
 			for _, rec := range logs {
 				quizType := rec.QuizType
 				if quizType == "" {
 					quizType = defaultQuizType
 				}
-				key := logKey{n.ID, quizType, rec.LearnedAt.UTC(), expr.notebookID, string(rec.Status)}
+				origin, originSense, correctionID := logSubjectKeys(expr.LearningHistoryExpression, quizType)
+				key := logKey{n.ID, quizType, rec.LearnedAt.UTC(), expr.notebookID, string(rec.Status), origin, originSense, correctionID}
 				if existingLogs.matchSource(key) {
 					result.LearningSkipped++
 					continue
@@ -708,11 +811,19 @@ func (imp *Importer) ImportLearningLogs(ctx context.Context, opts ImportOptions)
 					QuizType:         quizType,
 					IntervalDays:     rec.IntervalDays,
 					SourceNotebookID: expr.notebookID,
+					Origin:           origin,
+					OriginSense:      originSense,
+					CorrectionID:     correctionID,
+					Answer:           rec.Answer,
+					GraderReason:     rec.GraderReason,
+					Grader:           rec.Grader,
 				})
 				result.LearningNew++
 			}
 		}
-		appendEtymologyLogs(expr.EtymologyOriginLogs, string(notebook.QuizTypeEtymologyOrigin))
+		appendTrackLogs(expr.EtymologyOriginLogs, string(notebook.QuizTypeEtymologyOrigin))
+		appendTrackLogs(expr.DictationLogs, string(notebook.QuizTypeDictation))
+		appendTrackLogs(expr.WordChoiceLogs, string(notebook.QuizTypeWordChoice))
 	}
 
 	if !opts.DryRun && len(newLogs) > 0 {
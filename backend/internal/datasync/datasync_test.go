@@ -499,6 +499,61 @@ func TestImporter_ImportNotes(t *testing.T) {
 	}
 }
 
+// notebookNoteSource is a note source that also reports its notebooks.
+type notebookNoteSource struct {
+	notebooks []notebook.NotebookRecord
+}
+
+func (s notebookNoteSource) FindAll(context.Context) ([]notebook.NoteRecord, error) {
+	return nil, nil
+}
+
+func (s notebookNoteSource) FindNotebooks(context.Context) ([]notebook.NotebookRecord, error) {
+	return s.notebooks, nil
+}
+
+// notebookNoteRepository keeps the notebooks it is asked to store.
+type notebookNoteRepository struct {
+	*mock_notebook.MockNoteRepository
+	replaced []notebook.NotebookRecord
+}
+
+func (r *notebookNoteRepository) ReplaceNotebooks(_ context.Context, notebooks []notebook.NotebookRecord) error {
+	r.replaced = notebooks
+	return nil
+}
+
+func TestImporter_ImportNotes_Notebooks(t *testing.T) {
+	notebooks := []notebook.NotebookRecord{
+		{
+			NotebookType: "story", NotebookID: "friends", Name: "Friends",
+			Scenes: []notebook.NotebookSceneRecord{
+				{Group: "Episode 1", Subgroup: "Opening", Statements: []string{"Central Perk, morning."}},
+			},
+		},
+		{NotebookType: "flashcard", NotebookID: "adjectives", Name: "Adjectives"},
+	}
+
+	for _, dryRun := range []bool{false, true} {
+		t.Run(fmt.Sprintf("dry run %v", dryRun), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			noteRepo := &notebookNoteRepository{MockNoteRepository: mock_notebook.NewMockNoteRepository(ctrl)}
+			noteRepo.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+
+			imp := NewImporter(noteRepo, nil, notebookNoteSource{notebooks: notebooks}, nil, nil, nil, &bytes.Buffer{})
+			got, err := imp.ImportNotes(context.Background(), ImportOptions{DryRun: dryRun})
+			require.NoError(t, err)
+			assert.Equal(t, 2, got.Notebooks)
+			assert.Equal(t, 1, got.NotebookScenes)
+			if dryRun {
+				assert.Nil(t, noteRepo.replaced)
+			} else {
+				assert.Equal(t, notebooks, noteRepo.replaced)
+			}
+		})
+	}
+}
+
 func TestImporter_ImportLearningLogs(t *testing.T) {
 	baseTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
 
@@ -26,8 +26,11 @@ type NotebookStats struct {
 
 // LearningExpressionStats holds learning log counts for an expression.
 type LearningExpressionStats struct {
-	LearnedLogCount int
-	ReverseLogCount int
+	LearnedLogCount         int
+	ReverseLogCount         int
+	EtymologyOriginLogCount int
+	DictationLogCount       int
+	WordChoiceLogCount      int
 }
 
 // DataStats holds aggregated statistics for a dataset.
@@ -96,6 +99,9 @@ func buildLearningStats(learningByNotebook map[string][]notebook.LearningHistory
 			}
 			es.LearnedLogCount += len(expr.LearnedLogs)
 			es.ReverseLogCount += len(expr.ReverseLogs)
+			es.EtymologyOriginLogCount += len(expr.EtymologyOriginLogs)
+			es.DictationLogCount += len(expr.DictationLogs)
+			es.WordChoiceLogCount += len(expr.WordChoiceLogs)
 		}
 		result[nbID] = exprStats
 	}
@@ -179,15 +185,21 @@ func ValidateRoundTrip(
 		sort.Strings(allExprs)
 
 		for _, expr := range allExprs {
-			srcLearned, srcReverse := 0, 0
+			srcLearned, srcReverse, srcOrigin, srcDictation, srcWordChoice := 0, 0, 0, 0, 0
 			if es := srcExprs[expr]; es != nil {
 				srcLearned = es.LearnedLogCount
 				srcReverse = es.ReverseLogCount
+				srcOrigin = es.EtymologyOriginLogCount
+				srcDictation = es.DictationLogCount
+				srcWordChoice = es.WordChoiceLogCount
 			}
-			expLearned, expReverse := 0, 0
+			expLearned, expReverse, expOrigin, expDictation, expWordChoice := 0, 0, 0, 0, 0
 			if es := expExprs[expr]; es != nil {
 				expLearned = es.LearnedLogCount
 				expReverse = es.ReverseLogCount
+				expOrigin = es.EtymologyOriginLogCount
+				expDictation = es.DictationLogCount
+				expWordChoice = es.WordChoiceLogCount
 			}
 
 			if srcLearned != expLearned {
@@ -204,6 +216,27 @@ func ValidateRoundTrip(
 						nbID, expr, srcReverse, expReverse),
 				})
 			}
+			if srcOrigin != expOrigin {
+				result.Mismatches = append(result.Mismatches, ValidationMismatch{
+					Category: "learning_logs",
+					Message: fmt.Sprintf("notebook %q expression %q etymology origin log count mismatch: source=%d, exported=%d",
+						nbID, expr, srcOrigin, expOrigin),
+				})
+			}
+			if srcDictation != expDictation {
+				result.Mismatches = append(result.Mismatches, ValidationMismatch{
+					Category: "learning_logs",
+					Message: fmt.Sprintf("notebook %q expression %q dictation log count mismatch: source=%d, exported=%d",
+						nbID, expr, srcDictation, expDictation),
+				})
+			}
+			if srcWordChoice != expWordChoice {
+				result.Mismatches = append(result.Mismatches, ValidationMismatch{
+					Category: "learning_logs",
+					Message: fmt.Sprintf("notebook %q expression %q word choice log count mismatch: source=%d, exported=%d",
+						nbID, expr, srcWordChoice, expWordChoice),
+				})
+			}
 		}
 	}
 
@@ -261,13 +294,13 @@ func printValidationSummary(writer io.Writer, result *ValidateResult) {
 		if srcExprs != nil {
 			srcExprCount = len(srcExprs)
 			for _, es := range srcExprs {
-				srcLogs += es.LearnedLogCount + es.ReverseLogCount
+				srcLogs += es.LearnedLogCount + es.ReverseLogCount + es.EtymologyOriginLogCount + es.DictationLogCount + es.WordChoiceLogCount
 			}
 		}
 		if expExprs != nil {
 			expExprCount = len(expExprs)
 			for _, es := range expExprs {
-				expLogs += es.LearnedLogCount + es.ReverseLogCount
+				expLogs += es.LearnedLogCount + es.ReverseLogCount + es.EtymologyOriginLogCount + es.DictationLogCount + es.WordChoiceLogCount
 			}
 		}
 		totalSrcLogs += srcLogs
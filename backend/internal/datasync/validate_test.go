@@ -179,6 +179,25 @@ func TestValidateRoundTrip(t *testing.T) {
 			wantMismatches: 1,
 			wantCategories: []string{"learning_logs"},
 		},
+		{
+			name: "etymology origin log count mismatch",
+			sourceNotes:   []notebook.NoteRecord{},
+			exportedNotes: []notebook.NoteRecord{},
+			sourceLearningByNotebook: map[string][]notebook.LearningHistoryExpression{
+				"roots": {
+					{Expression: "pathos", Type: notebook.LearningExpressionTypeOrigin, EtymologyOriginLogs: []notebook.LearningRecord{
+						{Status: "understood"},
+					}},
+				},
+			},
+			exportedLearningByNotebook: map[string][]notebook.LearningHistoryExpression{
+				"roots": {
+					{Expression: "pathos", Type: notebook.LearningExpressionTypeOrigin},
+				},
+			},
+			wantMismatches: 1,
+			wantCategories: []string{"learning_logs"},
+		},
 		{
 			name: "dictionary count mismatch",
 			sourceNotes:                []notebook.NoteRecord{},
@@ -344,6 +363,10 @@ func TestBuildLearningStats(t *testing.T) {
 				LearnedLogs: []notebook.LearningRecord{{Status: "understood"}, {Status: "misunderstood"}},
 				ReverseLogs: []notebook.LearningRecord{{Status: "understood"}},
 			},
+			{
+				Expression:          "pathos",
+				EtymologyOriginLogs: []notebook.LearningRecord{{Status: "understood"}},
+			},
 		},
 	}
 
@@ -351,4 +374,5 @@ func TestBuildLearningStats(t *testing.T) {
 
 	assert.Equal(t, 2, stats["s1"]["break the ice"].LearnedLogCount)
 	assert.Equal(t, 1, stats["s1"]["break the ice"].ReverseLogCount)
+	assert.Equal(t, 1, stats["s1"]["pathos"].EtymologyOriginLogCount)
 }
@@ -0,0 +1,141 @@
+// Package frequency ranks words by how common they are in English, from
+// the frequency score of the WordsAPI dictionary cache and an optional
+// word-frequency list file, so study time can go to the words met most.
+package frequency
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/at-ishikawa/langner/internal/lemma"
+)
+
+// List is a word-frequency list: words and phrases ranked from the most
+// frequent, rank 1, down.
+type List struct {
+	words []string
+	ranks map[string]int
+}
+
+// NewList returns a list ranking words in the given order. Words are
+// matched case-insensitively; a repeated word keeps its first rank.
+func NewList(words []string) *List {
+	list := &List{ranks: make(map[string]int, len(words))}
+	for _, word := range words {
+		word = normalize(word)
+		if _, ok := list.ranks[word]; ok || word == "" {
+			continue
+		}
+		list.words = append(list.words, word)
+		list.ranks[word] = len(list.words)
+	}
+	return list
+}
+
+// LoadList reads a frequency list file (see ParseList).
+func LoadList(path string) (*List, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open(%s) > %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+	list, err := ParseList(file)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return list, nil
+}
+
+// ParseList reads a frequency list with one word or phrase per line, the
+// most frequent first. A line may end with the word's count after a tab, a
+// comma or a space, as in the lists exported from corpora ("the\t23135851"
+// or "of,13151942"); when every line has one, the words are ranked by
+// count instead of by line. Blank lines and lines starting with # are
+// skipped.
+func ParseList(r io.Reader) (*List, error) {
+	type entry struct {
+		word  string
+		count float64
+	}
+	var entries []entry
+	allCounted := true
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		word, count, ok := splitCount(line)
+		if !ok {
+			allCounted = false
+		}
+		entries = append(entries, entry{word: word, count: count})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read frequency list: %w", err)
+	}
+	if allCounted {
+		slices.SortStableFunc(entries, func(a, b entry) int { return cmp.Compare(b.count, a.count) })
+	}
+	words := make([]string, len(entries))
+	for i, e := range entries {
+		words[i] = e.word
+	}
+	return NewList(words), nil
+}
+
+// splitCount splits a trailing count off a list line.
+func splitCount(line string) (string, float64, bool) {
+	i := strings.LastIndexAny(line, "\t, ")
+	if i < 0 {
+		return line, 0, false
+	}
+	count, err := strconv.ParseFloat(strings.TrimSpace(line[i+1:]), 64)
+	if err != nil {
+		return line, 0, false
+	}
+	return strings.TrimSpace(line[:i]), count, true
+}
+
+// Len returns the number of words in the list.
+func (l *List) Len() int {
+	return len(l.words)
+}
+
+// Top returns the n most frequent words, or every word when the list is
+// shorter.
+func (l *List) Top(n int) []string {
+	return l.words[:min(n, len(l.words))]
+}
+
+// Rank returns the rank of word, or of the most frequent base form it may
+// be an inflection of ("cities" ranks as "city" when only that is listed).
+func (l *List) Rank(word string) (int, bool) {
+	if rank, ok := l.ranks[normalize(word)]; ok {
+		return rank, true
+	}
+	best := 0
+	for _, form := range Forms(word) {
+		if rank, ok := l.ranks[form]; ok && (best == 0 || rank < best) {
+			best = rank
+		}
+	}
+	return best, best > 0
+}
+
+// Forms returns the lowercased word and every base form it may be an
+// inflection of. Two words are taken as the same word when their forms
+// overlap, the way the notebook search matches them.
+func Forms(word string) []string {
+	return lemma.Lemmas(normalize(word))
+}
+
+func normalize(word string) string {
+	return strings.Join(strings.Fields(strings.ToLower(word)), " ")
+}
@@ -0,0 +1,78 @@
+package frequency
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseList(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "one word per line in rank order",
+			input: "the\nOf\n\n# comment\nput up with\nthe\n",
+			want:  []string{"the", "of", "put up with"},
+		},
+		{
+			name:  "counts reorder the words",
+			input: "of\t13151942\nthe\t23135851\nand,12997637\nin 8469404\n",
+			want:  []string{"the", "of", "and", "in"},
+		},
+		{
+			name:  "counts are ignored unless every line has one",
+			input: "of 200\nthe\nand 300\n",
+			want:  []string{"of", "the", "and"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := ParseList(strings.NewReader(tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, list.Top(list.Len()))
+		})
+	}
+}
+
+func TestLoadList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	require.NoError(t, os.WriteFile(path, []byte("the\nof\nand\n"), 0644))
+
+	list, err := LoadList(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"the", "of"}, list.Top(2))
+	assert.Equal(t, []string{"the", "of", "and"}, list.Top(10))
+
+	_, err = LoadList(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
+
+func TestList_Rank(t *testing.T) {
+	list := NewList([]string{"the", "city", "go", "went", "put up with"})
+	tests := []struct {
+		word     string
+		wantRank int
+		wantOK   bool
+	}{
+		{"The", 1, true},
+		{"cities", 2, true},
+		{"went", 4, true},
+		{"goes", 3, true},
+		{"Put  up with", 5, true},
+		{"tolerate", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			rank, ok := list.Rank(tt.word)
+			assert.Equal(t, tt.wantRank, rank)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
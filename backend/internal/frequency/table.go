@@ -0,0 +1,139 @@
+package frequency
+
+import (
+	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+)
+
+// Band groups words by how common they are.
+type Band string
+
+const (
+	// BandUnknown is a word neither the list nor the dictionary ranks.
+	BandUnknown Band = ""
+	// BandVeryCommon is roughly the 1,000 most frequent words.
+	BandVeryCommon Band = "very_common"
+	// BandCommon is roughly the words ranked 1,001 to 5,000.
+	BandCommon Band = "common"
+	// BandUncommon is roughly the words ranked 5,001 to 20,000.
+	BandUncommon Band = "uncommon"
+	// BandRare is every less frequent word.
+	BandRare Band = "rare"
+)
+
+// The upper rank of each band in a frequency list, and the lowest Zipf
+// score of each band in WordsAPI's frequency, which is the base-10
+// logarithm of the word's occurrences per billion words.
+const (
+	veryCommonRank = 1000
+	commonRank     = 5000
+	uncommonRank   = 20000
+
+	veryCommonZipf = 5.0
+	commonZipf     = 4.0
+	uncommonZipf   = 3.0
+)
+
+// Frequent reports whether the band is very common or common, the words
+// worth learning first.
+func (b Band) Frequent() bool {
+	return b == BandVeryCommon || b == BandCommon
+}
+
+// Priority orders bands from the most frequent, 0, to unknown.
+func (b Band) Priority() int {
+	switch b {
+	case BandVeryCommon:
+		return 0
+	case BandCommon:
+		return 1
+	case BandUncommon:
+		return 2
+	case BandRare:
+		return 3
+	}
+	return 4
+}
+
+// Frequency is how common a word is.
+type Frequency struct {
+	// Rank is the word's rank in the frequency list, 0 when it isn't
+	// listed.
+	Rank int
+	// Zipf is WordsAPI's frequency of the word, 0 when unknown.
+	Zipf float64
+	Band Band
+}
+
+// Table looks up how common words are, in the frequency list when it
+// ranks the word and in the WordsAPI dictionary cache otherwise.
+type Table struct {
+	list       *List
+	dictionary map[string]rapidapi.Response
+}
+
+// NewTable returns a table over the list and the dictionary cache. Either
+// may be nil.
+func NewTable(list *List, dictionary map[string]rapidapi.Response) *Table {
+	return &Table{list: list, dictionary: dictionary}
+}
+
+// Lookup returns how common the word or phrase is.
+func (t *Table) Lookup(expression string) Frequency {
+	var f Frequency
+	if t == nil {
+		return f
+	}
+	if t.list != nil {
+		f.Rank, _ = t.list.Rank(expression)
+	}
+	f.Zipf = t.zipf(expression)
+	switch {
+	case f.Rank > 0:
+		f.Band = rankBand(f.Rank)
+	case f.Zipf > 0:
+		f.Band = ZipfBand(f.Zipf)
+	}
+	return f
+}
+
+// Band returns the band of the word or phrase.
+func (t *Table) Band(expression string) Band {
+	return t.Lookup(expression).Band
+}
+
+// zipf returns the WordsAPI frequency of the expression or, failing that,
+// of the base form it may be an inflection of.
+func (t *Table) zipf(expression string) float64 {
+	for _, form := range append([]string{expression}, Forms(expression)...) {
+		if response, ok := t.dictionary[form]; ok && response.Frequency > 0 {
+			return response.Frequency
+		}
+	}
+	return 0
+}
+
+func rankBand(rank int) Band {
+	switch {
+	case rank <= veryCommonRank:
+		return BandVeryCommon
+	case rank <= commonRank:
+		return BandCommon
+	case rank <= uncommonRank:
+		return BandUncommon
+	}
+	return BandRare
+}
+
+// ZipfBand returns the band of a word with WordsAPI frequency zipf, for
+// a word looked up after the table was built.
+func ZipfBand(zipf float64) Band {
+	switch {
+	case zipf >= veryCommonZipf:
+		return BandVeryCommon
+	case zipf >= commonZipf:
+		return BandCommon
+	case zipf >= uncommonZipf:
+		return BandUncommon
+	}
+	return BandRare
+}
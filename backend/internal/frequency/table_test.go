@@ -0,0 +1,57 @@
+package frequency
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+)
+
+func TestTable_Lookup(t *testing.T) {
+	words := make([]string, 6000)
+	for i := range words {
+		words[i] = fmt.Sprintf("filler%d", i)
+	}
+	words[0] = "house"
+	words[2999] = "tolerate"
+	list := NewList(words)
+	dictionary := map[string]rapidapi.Response{
+		"house":       {Word: "house", Frequency: 5.6},
+		"run":         {Word: "run", Frequency: 5.2},
+		"lugubrious":  {Word: "lugubrious", Frequency: 1.9},
+		"serendipity": {Word: "serendipity", Frequency: 3.1},
+	}
+	table := NewTable(list, dictionary)
+
+	tests := []struct {
+		name       string
+		table      *Table
+		expression string
+		want       Frequency
+	}{
+		{"the list ranks first", table, "house", Frequency{Rank: 1, Zipf: 5.6, Band: BandVeryCommon}},
+		{"common by rank", table, "tolerated", Frequency{Rank: 3000, Band: BandCommon}},
+		{"an inflection takes the dictionary frequency of its base form", table, "running", Frequency{Zipf: 5.2, Band: BandVeryCommon}},
+		{"uncommon by zipf", table, "serendipity", Frequency{Zipf: 3.1, Band: BandUncommon}},
+		{"rare by zipf", table, "lugubrious", Frequency{Zipf: 1.9, Band: BandRare}},
+		{"unknown", table, "zzyzx", Frequency{}},
+		{"dictionary only", NewTable(nil, dictionary), "house", Frequency{Zipf: 5.6, Band: BandVeryCommon}},
+		{"nil table", nil, "house", Frequency{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.table.Lookup(tt.expression))
+		})
+	}
+}
+
+func TestBand_Priority(t *testing.T) {
+	bands := []Band{BandVeryCommon, BandCommon, BandUncommon, BandRare, BandUnknown}
+	for i := 1; i < len(bands); i++ {
+		assert.Less(t, bands[i-1].Priority(), bands[i].Priority())
+	}
+	assert.True(t, BandCommon.Frequent())
+	assert.False(t, BandUncommon.Frequent())
+}
@@ -12,6 +12,76 @@ type Client interface {
 	ValidateWordForm(ctx context.Context, params ValidateWordFormRequest) (ValidateWordFormResponse, error)
 	LookupWord(ctx context.Context, params LookupWordRequest) (LookupWordResponse, error)
 	GradeCorrection(ctx context.Context, params GradeCorrectionRequest) (GradeCorrectionResponse, error)
+	GenerateMnemonic(ctx context.Context, params GenerateMnemonicRequest) (GenerateMnemonicResponse, error)
+	GenerateExamples(ctx context.Context, params GenerateExamplesRequest) (GenerateExamplesResponse, error)
+	GradeWordChoice(ctx context.Context, params GradeWordChoiceRequest) (GradeWordChoiceResponse, error)
+}
+
+// GenerateMnemonicRequest holds what a memory hook for a word is built from.
+type GenerateMnemonicRequest struct {
+	Expression   string           `json:"expression"`
+	Meaning      string           `json:"meaning"`
+	PartOfSpeech string           `json:"part_of_speech,omitempty"`
+	Origins      []MnemonicOrigin `json:"origins,omitempty"`  // resolved origin parts of the word
+	Contexts     []string         `json:"contexts,omitempty"` // scene lines or examples using the word
+}
+
+// MnemonicOrigin is one origin part of a word with its meaning.
+type MnemonicOrigin struct {
+	Origin   string `json:"origin"`
+	Language string `json:"language,omitempty"`
+	Meaning  string `json:"meaning,omitempty"`
+}
+
+// GenerateMnemonicResponse holds a short memory hook for a word.
+type GenerateMnemonicResponse struct {
+	Mnemonic string `json:"mnemonic"`
+}
+
+// GenerateExamplesRequest holds the note to write example sentences for.
+type GenerateExamplesRequest struct {
+	Expression   string `json:"expression"`
+	Meaning      string `json:"meaning"`
+	PartOfSpeech string `json:"part_of_speech,omitempty"`
+	Count        int    `json:"count"` // how many sentences to write
+}
+
+// GenerateExamplesResponse holds the generated example sentences.
+type GenerateExamplesResponse struct {
+	Examples []GeneratedExample `json:"examples"`
+}
+
+// GeneratedExample is one example sentence. Highlight is the form of the
+// expression exactly as it appears in Text, e.g. "went" for "go".
+type GeneratedExample struct {
+	Text      string `json:"text"`
+	Highlight string `json:"highlight"`
+}
+
+// GradeWordChoiceRequest holds a word choice question over the members of a
+// synonym or antonym concept and the user's answer. With a Sentence, the
+// user picked which member fits its blank; without one, they explained how
+// Expected differs from the single entry of Others.
+type GradeWordChoiceRequest struct {
+	ConceptKind    string             `json:"concept_kind"`               // synonym or antonym
+	Sentence       string             `json:"sentence,omitempty"`         // example of Expected with it blanked as ___
+	Expected       WordChoiceOption   `json:"expected"`                   // the member the question is about
+	Others         []WordChoiceOption `json:"others"`                     // the other members offered
+	UserAnswer     string             `json:"user_answer"`                // the chosen member, or the explained difference
+	ResponseTimeMs int64              `json:"response_time_ms,omitempty"` // for quality assessment
+}
+
+// WordChoiceOption is one member of a concept with its meaning.
+type WordChoiceOption struct {
+	Expression string `json:"expression"`
+	Meaning    string `json:"meaning"`
+}
+
+// GradeWordChoiceResponse holds the result of grading a word choice answer.
+type GradeWordChoiceResponse struct {
+	Correct bool   `json:"correct"` // whether the answer fits the sentence, or tells the members apart
+	Reason  string `json:"reason"`  // brief explanation of the grade
+	Quality int    `json:"quality"` // 1-5 SM-2 quality based on correctness + response time
 }
 
 // GradeCorrectionRequest holds parameters for grading a grammar correction.
@@ -5,6 +5,7 @@ package mock
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/at-ishikawa/langner/internal/inference"
@@ -124,3 +125,45 @@ func (c *Client) LookupWord(_ context.Context, params inference.LookupWordReques
 		},
 	}, nil
 }
+
+// GenerateMnemonic returns a fixed hook naming the word and its meaning, so
+// tests can assert what was written back to the note.
+func (c *Client) GenerateMnemonic(_ context.Context, params inference.GenerateMnemonicRequest) (inference.GenerateMnemonicResponse, error) {
+	return inference.GenerateMnemonicResponse{
+		Mnemonic: "(mock mnemonic: " + params.Expression + " means " + params.Meaning + ")",
+	}, nil
+}
+
+// GenerateExamples returns Count fixed sentences using the expression
+// verbatim, highlighted, so tests can assert what was written back.
+func (c *Client) GenerateExamples(_ context.Context, params inference.GenerateExamplesRequest) (inference.GenerateExamplesResponse, error) {
+	examples := make([]inference.GeneratedExample, 0, params.Count)
+	for i := 1; i <= params.Count; i++ {
+		examples = append(examples, inference.GeneratedExample{
+			Text:      fmt.Sprintf("Mock example %d uses %s in a sentence.", i, params.Expression),
+			Highlight: params.Expression,
+		})
+	}
+	return inference.GenerateExamplesResponse{Examples: examples}, nil
+}
+
+// GradeWordChoice deterministically grades a word choice answer: a fit
+// question is correct when the expected member was picked, a difference
+// question whenever the explanation is not a wrong-answer sentinel.
+func (c *Client) GradeWordChoice(_ context.Context, params inference.GradeWordChoiceRequest) (inference.GradeWordChoiceResponse, error) {
+	isCorrect := !isWrongAnswer(params.UserAnswer)
+	if params.Sentence != "" {
+		isCorrect = isCorrect && strings.EqualFold(strings.TrimSpace(params.UserAnswer), params.Expected.Expression)
+	}
+	reason := "mock grader: answer tells the members apart"
+	quality := 3
+	if !isCorrect {
+		reason = "mock grader: answer does not tell the members apart"
+		quality = 1
+	}
+	return inference.GradeWordChoiceResponse{
+		Correct: isCorrect,
+		Reason:  reason,
+		Quality: quality,
+	}, nil
+}
@@ -1191,3 +1191,363 @@ Grade this correction.`, params.Sentence, params.Incorrect, params.Correct, note
 
 	return decoded, nil
 }
+
+func (client *Client) GenerateMnemonic(
+	ctx context.Context,
+	params inference.GenerateMnemonicRequest,
+) (inference.GenerateMnemonicResponse, error) {
+	var result inference.GenerateMnemonicResponse
+	if err := retry.Do(
+		func() error {
+			response, err := client.generateMnemonic(ctx, params)
+			if err != nil {
+				if !isRetryableError(err) {
+					return retry.Unrecoverable(err)
+				}
+				return err
+			}
+			result = response
+			return nil
+		},
+		retry.Context(ctx),
+		retry.Attempts(client.maxRetryAttempts+1),
+		retry.DelayType(func(n uint, err error, config *retry.Config) time.Duration {
+			return retry.BackOffDelay(n, err, config)
+		}),
+	); err != nil {
+		return inference.GenerateMnemonicResponse{}, err
+	}
+	return result, nil
+}
+
+func (client *Client) generateMnemonic(
+	ctx context.Context,
+	params inference.GenerateMnemonicRequest,
+) (inference.GenerateMnemonicResponse, error) {
+	systemPrompt := `You write memory hooks for an English vocabulary learner.
+
+Given a word, its meaning, the meanings of its origin parts and the sentences
+the learner met it in, write ONE short mnemonic that makes the meaning easy to
+recall from the word.
+
+RULES:
+- At most two sentences, under 30 words in total.
+- Prefer a hook built on the origin parts when they are given (e.g. "bene-
+  (good) + -dict (say): a benediction is saying good things").
+- Otherwise use a vivid image, a sound-alike, or the scene the learner met
+  the word in.
+- Do not just restate the definition, and do not use the word's own
+  derivatives to explain it.
+
+OUTPUT FORMAT (JSON only):
+{
+  "mnemonic": "<the memory hook>"
+}
+
+Do NOT include any text outside the JSON.`
+
+	var userMessage strings.Builder
+	fmt.Fprintf(&userMessage, "Word: %s\nMeaning: %s\n", params.Expression, params.Meaning)
+	if params.PartOfSpeech != "" {
+		fmt.Fprintf(&userMessage, "Part of speech: %s\n", params.PartOfSpeech)
+	}
+	if len(params.Origins) > 0 {
+		userMessage.WriteString("Origin parts:\n")
+		for _, o := range params.Origins {
+			fmt.Fprintf(&userMessage, "- %s", o.Origin)
+			if o.Language != "" {
+				fmt.Fprintf(&userMessage, " (%s)", o.Language)
+			}
+			if o.Meaning != "" {
+				fmt.Fprintf(&userMessage, ": %s", o.Meaning)
+			}
+			userMessage.WriteString("\n")
+		}
+	}
+	if len(params.Contexts) > 0 {
+		userMessage.WriteString("Contexts:\n")
+		for _, c := range params.Contexts {
+			fmt.Fprintf(&userMessage, "- %s\n", c)
+		}
+	}
+	userMessage.WriteString("\nWrite the mnemonic.")
+
+	requestBody := ChatCompletionRequest{
+		Model:       client.model,
+		Temperature: 0.7,
+		Messages: []Message{
+			{Role: RoleSystem, Content: systemPrompt},
+			{Role: RoleUser, Content: userMessage.String()},
+		},
+	}
+
+	response, err := client.httpClient.R().
+		SetContext(ctx).
+		SetBody(requestBody).
+		SetResult(&ChatCompletionResponse{}).
+		Post("/chat/completions")
+	if err != nil {
+		return inference.GenerateMnemonicResponse{}, fmt.Errorf("httpClient.Post > %w", err)
+	}
+	if response.IsError() {
+		return inference.GenerateMnemonicResponse{}, fmt.Errorf("response error %d: %s", response.StatusCode(), response.String())
+	}
+
+	responseBody := response.Result().(*ChatCompletionResponse)
+	if responseBody == nil || len(responseBody.Choices) == 0 {
+		return inference.GenerateMnemonicResponse{}, fmt.Errorf("empty response body or choices: %s", response.String())
+	}
+
+	content := responseBody.Choices[0].Message.Content
+	if content == "" {
+		return inference.GenerateMnemonicResponse{}, fmt.Errorf("empty response content: %s", response.String())
+	}
+
+	slog.Default().Debug("generateMnemonic response",
+		"request", requestBody,
+		"response", content,
+	)
+
+	var decoded inference.GenerateMnemonicResponse
+	if err := json.NewDecoder(strings.NewReader(content)).Decode(&decoded); err != nil {
+		return inference.GenerateMnemonicResponse{}, fmt.Errorf("json.Unmarshal(%s) > %w", content, err)
+	}
+
+	return decoded, nil
+}
+
+func (client *Client) GenerateExamples(
+	ctx context.Context,
+	params inference.GenerateExamplesRequest,
+) (inference.GenerateExamplesResponse, error) {
+	var result inference.GenerateExamplesResponse
+	if err := retry.Do(
+		func() error {
+			response, err := client.generateExamples(ctx, params)
+			if err != nil {
+				if !isRetryableError(err) {
+					return retry.Unrecoverable(err)
+				}
+				return err
+			}
+			result = response
+			return nil
+		},
+		retry.Context(ctx),
+		retry.Attempts(client.maxRetryAttempts+1),
+		retry.DelayType(func(n uint, err error, config *retry.Config) time.Duration {
+			return retry.BackOffDelay(n, err, config)
+		}),
+	); err != nil {
+		return inference.GenerateExamplesResponse{}, err
+	}
+	return result, nil
+}
+
+func (client *Client) generateExamples(
+	ctx context.Context,
+	params inference.GenerateExamplesRequest,
+) (inference.GenerateExamplesResponse, error) {
+	systemPrompt := `You write example sentences for an English vocabulary learner.
+
+Given a word or phrase and the meaning the learner is studying, write natural
+example sentences that use it in exactly that meaning.
+
+RULES:
+- Each sentence is one everyday sentence of 8 to 20 words.
+- Use the expression in the given meaning and part of speech only.
+- Vary the inflected form and the situation across sentences.
+- "highlight" is the expression exactly as it appears in that sentence,
+  including its inflection (e.g. "went" for "go", "gave up" for "give up").
+  It must be a verbatim substring of "text".
+
+OUTPUT FORMAT (JSON only):
+{
+  "examples": [
+    {"text": "<sentence>", "highlight": "<surface form in the sentence>"}
+  ]
+}
+
+Do NOT include any text outside the JSON.`
+
+	var userMessage strings.Builder
+	fmt.Fprintf(&userMessage, "Expression: %s\nMeaning: %s\n", params.Expression, params.Meaning)
+	if params.PartOfSpeech != "" {
+		fmt.Fprintf(&userMessage, "Part of speech: %s\n", params.PartOfSpeech)
+	}
+	fmt.Fprintf(&userMessage, "\nWrite %d example sentences.", params.Count)
+
+	requestBody := ChatCompletionRequest{
+		Model:       client.model,
+		Temperature: 0.7,
+		Messages: []Message{
+			{Role: RoleSystem, Content: systemPrompt},
+			{Role: RoleUser, Content: userMessage.String()},
+		},
+	}
+
+	response, err := client.httpClient.R().
+		SetContext(ctx).
+		SetBody(requestBody).
+		SetResult(&ChatCompletionResponse{}).
+		Post("/chat/completions")
+	if err != nil {
+		return inference.GenerateExamplesResponse{}, fmt.Errorf("httpClient.Post > %w", err)
+	}
+	if response.IsError() {
+		return inference.GenerateExamplesResponse{}, fmt.Errorf("response error %d: %s", response.StatusCode(), response.String())
+	}
+
+	responseBody := response.Result().(*ChatCompletionResponse)
+	if responseBody == nil || len(responseBody.Choices) == 0 {
+		return inference.GenerateExamplesResponse{}, fmt.Errorf("empty response body or choices: %s", response.String())
+	}
+
+	content := responseBody.Choices[0].Message.Content
+	if content == "" {
+		return inference.GenerateExamplesResponse{}, fmt.Errorf("empty response content: %s", response.String())
+	}
+
+	slog.Default().Debug("generateExamples response",
+		"request", requestBody,
+		"response", content,
+	)
+
+	var decoded inference.GenerateExamplesResponse
+	if err := json.NewDecoder(strings.NewReader(content)).Decode(&decoded); err != nil {
+		return inference.GenerateExamplesResponse{}, fmt.Errorf("json.Unmarshal(%s) > %w", content, err)
+	}
+
+	return decoded, nil
+}
+
+func (client *Client) GradeWordChoice(
+	ctx context.Context,
+	params inference.GradeWordChoiceRequest,
+) (inference.GradeWordChoiceResponse, error) {
+	var result inference.GradeWordChoiceResponse
+	if err := retry.Do(
+		func() error {
+			response, err := client.gradeWordChoice(ctx, params)
+			if err != nil {
+				if !isRetryableError(err) {
+					return retry.Unrecoverable(err)
+				}
+				return err
+			}
+			result = response
+			return nil
+		},
+		retry.Context(ctx),
+		retry.Attempts(client.maxRetryAttempts+1),
+		retry.DelayType(func(n uint, err error, config *retry.Config) time.Duration {
+			return retry.BackOffDelay(n, err, config)
+		}),
+	); err != nil {
+		return inference.GradeWordChoiceResponse{}, err
+	}
+	return result, nil
+}
+
+func (client *Client) gradeWordChoice(
+	ctx context.Context,
+	params inference.GradeWordChoiceRequest,
+) (inference.GradeWordChoiceResponse, error) {
+	systemPrompt := `You grade a vocabulary quiz that tells apart close words: the members of
+a group of synonyms, or of a group of antonyms.
+
+The question is one of two kinds:
+- FIT: the user was shown a sentence with a blank (___) and the group's
+  members, and picked the member that fits the blank best. The sentence was
+  written for the expected member.
+- DIFFERENCE: the user was shown two members and explained how the expected
+  member differs from the other one, in meaning, register, connotation or usage.
+
+GRADING RULES:
+- FIT: mark "correct": true when the chosen member is the expected one, or
+  another member that fits the sentence just as naturally and with the same
+  meaning. Mark false when the chosen member changes the meaning of the
+  sentence, sounds unnatural there, or is not a member of the group.
+- DIFFERENCE: mark "correct": true when the explanation names a real
+  difference between the two members that is consistent with their meanings.
+  It does not need to be complete. Mark false when it is wrong, describes only
+  what they share, or just restates one meaning.
+
+QUALITY ASSESSMENT (1-5):
+- If incorrect: quality = 1
+- If correct, judge response time: fast = 5, normal = 4, slow = 3.
+
+REASON:
+- ONE short sentence addressed to the learner ("you") on what sets the
+  expected member apart, citing the words.
+
+OUTPUT FORMAT (JSON only):
+{
+  "correct": true | false,
+  "reason": "<brief explanation>",
+  "quality": <1-5>
+}
+
+Do NOT include any text outside the JSON.`
+
+	var userMessage strings.Builder
+	kind := "DIFFERENCE"
+	if params.Sentence != "" {
+		kind = "FIT"
+	}
+	fmt.Fprintf(&userMessage, "Question: %s\nGroup: %s\n", kind, params.ConceptKind)
+	if params.Sentence != "" {
+		fmt.Fprintf(&userMessage, "Sentence: %s\n", params.Sentence)
+	}
+	fmt.Fprintf(&userMessage, "Expected member: %s — %s\n", params.Expected.Expression, params.Expected.Meaning)
+	for _, other := range params.Others {
+		fmt.Fprintf(&userMessage, "Other member: %s — %s\n", other.Expression, other.Meaning)
+	}
+	if params.ResponseTimeMs > 0 {
+		fmt.Fprintf(&userMessage, "Response time: %dms\n", params.ResponseTimeMs)
+	}
+	fmt.Fprintf(&userMessage, "User's answer: %s\n\nGrade this answer.", params.UserAnswer)
+
+	requestBody := ChatCompletionRequest{
+		Model:       client.model,
+		Temperature: 0.1,
+		Messages: []Message{
+			{Role: RoleSystem, Content: systemPrompt},
+			{Role: RoleUser, Content: userMessage.String()},
+		},
+	}
+
+	response, err := client.httpClient.R().
+		SetContext(ctx).
+		SetBody(requestBody).
+		SetResult(&ChatCompletionResponse{}).
+		Post("/chat/completions")
+	if err != nil {
+		return inference.GradeWordChoiceResponse{}, fmt.Errorf("httpClient.Post > %w", err)
+	}
+	if response.IsError() {
+		return inference.GradeWordChoiceResponse{}, fmt.Errorf("response error %d: %s", response.StatusCode(), response.String())
+	}
+
+	responseBody := response.Result().(*ChatCompletionResponse)
+	if responseBody == nil || len(responseBody.Choices) == 0 {
+		return inference.GradeWordChoiceResponse{}, fmt.Errorf("empty response body or choices: %s", response.String())
+	}
+
+	content := responseBody.Choices[0].Message.Content
+	if content == "" {
+		return inference.GradeWordChoiceResponse{}, fmt.Errorf("empty response content: %s", response.String())
+	}
+
+	slog.Default().Debug("gradeWordChoice response",
+		"request", requestBody,
+		"response", content,
+	)
+
+	var decoded inference.GradeWordChoiceResponse
+	if err := json.NewDecoder(strings.NewReader(content)).Decode(&decoded); err != nil {
+		return inference.GradeWordChoiceResponse{}, fmt.Errorf("json.Unmarshal(%s) > %w", content, err)
+	}
+
+	return decoded, nil
+}
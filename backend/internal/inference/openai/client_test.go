@@ -748,6 +748,312 @@ func TestClient_ValidateWordForm(t *testing.T) {
 	}
 }
 
+func TestClient_GenerateMnemonic(t *testing.T) {
+	tests := []struct {
+		name              string
+		mockServerHandler func(t *testing.T, w http.ResponseWriter, r *http.Request)
+		wantResponse      inference.GenerateMnemonicResponse
+		wantErrorString   string
+	}{
+		{
+			name: "mnemonic from origin parts and context",
+			mockServerHandler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				var body ChatCompletionRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				require.Len(t, body.Messages, 2)
+				assert.Contains(t, body.Messages[1].Content, "Word: benediction")
+				assert.Contains(t, body.Messages[1].Content, "- bene (Latin): well")
+				assert.Contains(t, body.Messages[1].Content, "- The priest gave a benediction.")
+
+				mockResponse := ChatCompletionResponse{
+					Choices: []Choice{
+						{
+							Message: ChoiceMessage{
+								Role:    RoleAssistant,
+								Content: `{"mnemonic": "bene (well) + dict (say): saying good things"}`,
+							},
+						},
+					},
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(mockResponse)
+			},
+			wantResponse: inference.GenerateMnemonicResponse{
+				Mnemonic: "bene (well) + dict (say): saying good things",
+			},
+		},
+		{
+			name: "invalid JSON in response content",
+			mockServerHandler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				mockResponse := ChatCompletionResponse{
+					Choices: []Choice{
+						{
+							Message: ChoiceMessage{
+								Role:    RoleAssistant,
+								Content: "not valid json",
+							},
+						},
+					},
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(mockResponse)
+			},
+			wantErrorString: "json.Unmarshal",
+		},
+		{
+			name: "non-retryable HTTP 400 error",
+			mockServerHandler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error": "bad request"}`))
+			},
+			wantErrorString: "response error 400",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tt.mockServerHandler(t, w, r)
+			}))
+			defer server.Close()
+
+			client := &Client{
+				httpClient: resty.New().SetBaseURL(server.URL),
+				model:      "gpt-4",
+			}
+
+			got, err := client.GenerateMnemonic(context.Background(), inference.GenerateMnemonicRequest{
+				Expression: "benediction",
+				Meaning:    "a blessing",
+				Origins: []inference.MnemonicOrigin{
+					{Origin: "bene", Language: "Latin", Meaning: "well"},
+				},
+				Contexts: []string{"The priest gave a benediction."},
+			})
+
+			if tt.wantErrorString != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrorString)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResponse, got)
+		})
+	}
+}
+
+func TestClient_GenerateExamples(t *testing.T) {
+	tests := []struct {
+		name              string
+		mockServerHandler func(t *testing.T, w http.ResponseWriter, r *http.Request)
+		wantResponse      inference.GenerateExamplesResponse
+		wantErrorString   string
+	}{
+		{
+			name: "examples with inflected highlights",
+			mockServerHandler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				var body ChatCompletionRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				require.Len(t, body.Messages, 2)
+				assert.Contains(t, body.Messages[1].Content, "Expression: give up")
+				assert.Contains(t, body.Messages[1].Content, "Part of speech: phrasal verb")
+				assert.Contains(t, body.Messages[1].Content, "Write 2 example sentences.")
+
+				mockResponse := ChatCompletionResponse{
+					Choices: []Choice{
+						{
+							Message: ChoiceMessage{
+								Role:    RoleAssistant,
+								Content: `{"examples": [{"text": "She gave up smoking last year.", "highlight": "gave up"}, {"text": "Never give up on your dreams.", "highlight": "give up"}]}`,
+							},
+						},
+					},
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(mockResponse)
+			},
+			wantResponse: inference.GenerateExamplesResponse{
+				Examples: []inference.GeneratedExample{
+					{Text: "She gave up smoking last year.", Highlight: "gave up"},
+					{Text: "Never give up on your dreams.", Highlight: "give up"},
+				},
+			},
+		},
+		{
+			name: "invalid JSON in response content",
+			mockServerHandler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				mockResponse := ChatCompletionResponse{
+					Choices: []Choice{
+						{
+							Message: ChoiceMessage{
+								Role:    RoleAssistant,
+								Content: "not valid json",
+							},
+						},
+					},
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(mockResponse)
+			},
+			wantErrorString: "json.Unmarshal",
+		},
+		{
+			name: "non-retryable HTTP 400 error",
+			mockServerHandler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error": "bad request"}`))
+			},
+			wantErrorString: "response error 400",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tt.mockServerHandler(t, w, r)
+			}))
+			defer server.Close()
+
+			client := &Client{
+				httpClient: resty.New().SetBaseURL(server.URL),
+				model:      "gpt-4",
+			}
+
+			got, err := client.GenerateExamples(context.Background(), inference.GenerateExamplesRequest{
+				Expression:   "give up",
+				Meaning:      "to stop trying or doing something",
+				PartOfSpeech: "phrasal verb",
+				Count:        2,
+			})
+
+			if tt.wantErrorString != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrorString)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResponse, got)
+		})
+	}
+}
+
+func TestClient_GradeWordChoice(t *testing.T) {
+	tests := []struct {
+		name              string
+		request           inference.GradeWordChoiceRequest
+		mockServerHandler func(t *testing.T, w http.ResponseWriter, r *http.Request)
+		wantResponse      inference.GradeWordChoiceResponse
+		wantErrorString   string
+	}{
+		{
+			name: "fit question",
+			request: inference.GradeWordChoiceRequest{
+				ConceptKind: "synonym",
+				Sentence:    "The ___ meal left everyone full.",
+				Expected:    inference.WordChoiceOption{Expression: "hearty", Meaning: "large and satisfying"},
+				Others:      []inference.WordChoiceOption{{Expression: "cordial", Meaning: "warm and friendly"}},
+				UserAnswer:  "cordial",
+			},
+			mockServerHandler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				var body ChatCompletionRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				require.Len(t, body.Messages, 2)
+				assert.Contains(t, body.Messages[1].Content, "Question: FIT")
+				assert.Contains(t, body.Messages[1].Content, "Sentence: The ___ meal left everyone full.")
+				assert.Contains(t, body.Messages[1].Content, "Expected member: hearty — large and satisfying")
+				assert.Contains(t, body.Messages[1].Content, "Other member: cordial — warm and friendly")
+				assert.Contains(t, body.Messages[1].Content, "User's answer: cordial")
+
+				mockResponse := ChatCompletionResponse{
+					Choices: []Choice{
+						{
+							Message: ChoiceMessage{
+								Role:    RoleAssistant,
+								Content: `{"correct": false, "reason": "You picked 'cordial', which describes people, not meals.", "quality": 1}`,
+							},
+						},
+					},
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(mockResponse)
+			},
+			wantResponse: inference.GradeWordChoiceResponse{
+				Correct: false,
+				Reason:  "You picked 'cordial', which describes people, not meals.",
+				Quality: 1,
+			},
+		},
+		{
+			name: "difference question",
+			request: inference.GradeWordChoiceRequest{
+				ConceptKind: "antonym",
+				Expected:    inference.WordChoiceOption{Expression: "frugal", Meaning: "careful with money"},
+				Others:      []inference.WordChoiceOption{{Expression: "lavish", Meaning: "spending a lot"}},
+				UserAnswer:  "frugal avoids spending, lavish spends freely",
+			},
+			mockServerHandler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				var body ChatCompletionRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Contains(t, body.Messages[1].Content, "Question: DIFFERENCE")
+				assert.NotContains(t, body.Messages[1].Content, "Sentence:")
+
+				mockResponse := ChatCompletionResponse{
+					Choices: []Choice{
+						{
+							Message: ChoiceMessage{
+								Role:    RoleAssistant,
+								Content: `{"correct": true, "reason": "Right: frugal saves, lavish spends.", "quality": 4}`,
+							},
+						},
+					},
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(mockResponse)
+			},
+			wantResponse: inference.GradeWordChoiceResponse{
+				Correct: true,
+				Reason:  "Right: frugal saves, lavish spends.",
+				Quality: 4,
+			},
+		},
+		{
+			name: "non-retryable HTTP 400 error",
+			mockServerHandler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error": "bad request"}`))
+			},
+			wantErrorString: "response error 400",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tt.mockServerHandler(t, w, r)
+			}))
+			defer server.Close()
+
+			client := &Client{
+				httpClient: resty.New().SetBaseURL(server.URL),
+				model:      "gpt-4",
+			}
+
+			got, err := client.GradeWordChoice(context.Background(), tt.request)
+
+			if tt.wantErrorString != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrorString)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResponse, got)
+		})
+	}
+}
+
 func TestNewClient(t *testing.T) {
 	client := NewClient("test-key", "gpt-4", 3)
 	assert.NotNil(t, client)
@@ -0,0 +1,326 @@
+// Package jsonschema generates JSON Schemas (draft 2020-12) from Go types
+// using their yaml struct tags, so editors running a YAML language server
+// can validate the notebook files the types are decoded from.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Draft is the JSON Schema dialect written to every generated document.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document or subschema.
+type Schema = map[string]any
+
+// Schemaer is implemented by types whose YAML form differs from their Go
+// shape, typically because they have a custom UnmarshalYAML. The returned
+// schema replaces the one derived by reflection.
+type Schemaer interface {
+	JSONSchema() map[string]any
+}
+
+var (
+	schemaerType = reflect.TypeOf((*Schemaer)(nil)).Elem()
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// Generate returns a schema document for the YAML form of each value in
+// values. A single value is the document's root; several become a oneOf,
+// for files that accept more than one shape. Named struct types are
+// emitted once under $defs and referenced, and every object rejects keys
+// that aren't fields so a misspelt key is reported.
+func Generate(id, title, description string, values ...any) Schema {
+	g := &generator{defs: make(map[string]Schema), names: make(map[reflect.Type]string)}
+	roots := make([]any, 0, len(values))
+	for _, v := range values {
+		roots = append(roots, g.schemaFor(reflect.TypeOf(v)))
+	}
+
+	doc := Schema{
+		"$schema": Draft,
+		"$id":     id,
+		"title":   title,
+	}
+	if description != "" {
+		doc["description"] = description
+	}
+	if len(roots) == 1 {
+		for k, v := range roots[0].(Schema) {
+			doc[k] = v
+		}
+	} else {
+		doc["oneOf"] = roots
+	}
+	if len(g.defs) > 0 {
+		defs := make(Schema, len(g.defs))
+		for name, def := range g.defs {
+			defs[name] = def
+		}
+		doc["$defs"] = defs
+	}
+	return doc
+}
+
+// Marshal encodes a schema as indented JSON with a trailing newline. Keys
+// are sorted by encoding/json, so the output is stable across runs.
+func Marshal(s Schema) ([]byte, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+type generator struct {
+	defs  map[string]Schema
+	names map[reflect.Type]string
+}
+
+func (g *generator) schemaFor(t reflect.Type) Schema {
+	if t.Implements(schemaerType) {
+		return reflect.Zero(t).Interface().(Schemaer).JSONSchema()
+	}
+	if reflect.PointerTo(t).Implements(schemaerType) {
+		return reflect.New(t).Interface().(Schemaer).JSONSchema()
+	}
+	if t == timeType {
+		return Schema{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schemaFor(t.Elem())
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return Schema{"type": "array", "items": g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return Schema{"$ref": "#/$defs/" + g.define(t)}
+	default:
+		return Schema{}
+	}
+}
+
+// define registers a named struct type under $defs and returns its key.
+// Two types sharing a name in different packages get the package prefixed.
+func (g *generator) define(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+	name := t.Name()
+	if _, taken := g.defs[name]; taken {
+		name = pathBase(t.PkgPath()) + "." + name
+	}
+	g.names[t] = name
+	g.defs[name] = Schema{} // placeholder so recursive types terminate
+	g.defs[name] = g.structSchema(t)
+	return name
+}
+
+func (g *generator) structSchema(t reflect.Type) Schema {
+	properties := make(Schema)
+	g.addFields(t, properties)
+	s := Schema{
+		"type":                 "object",
+		"additionalProperties": false,
+	}
+	if len(properties) > 0 {
+		s["properties"] = properties
+	}
+	return s
+}
+
+// addFields mirrors how gopkg.in/yaml.v3 maps struct fields to keys:
+// unexported non-embedded and `yaml:"-"` fields are skipped, `,inline` fields merge
+// into the parent, and an untagged field uses its lowercased name.
+func (g *generator) addFields(t reflect.Type, properties Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if hasOption(opts, "inline") {
+			inner := field.Type
+			if inner.Kind() == reflect.Pointer {
+				inner = inner.Elem()
+			}
+			if inner.Kind() == reflect.Struct {
+				g.addFields(inner, properties)
+			}
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		properties[name] = g.schemaFor(field.Type)
+	}
+}
+
+func hasOption(opts, option string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+func pathBase(pkgPath string) string {
+	if i := strings.LastIndex(pkgPath, "/"); i >= 0 {
+		return pkgPath[i+1:]
+	}
+	return pkgPath
+}
+
+// Validate checks value, as decoded from YAML or JSON into interface{},
+// against a schema document produced by Generate and returns one message
+// per violation. It understands only the keywords Generate emits: $ref,
+// oneOf, type, properties, additionalProperties, items and required.
+func Validate(doc Schema, value any) []string {
+	v := validator{doc: doc}
+	v.validate(doc, value, "$")
+	return v.errs
+}
+
+type validator struct {
+	doc  Schema
+	errs []string
+}
+
+func (v *validator) validate(s Schema, value any, path string) {
+	if ref, ok := s["$ref"].(string); ok {
+		defs, _ := v.doc["$defs"].(map[string]any)
+		target, ok := defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any)
+		if !ok {
+			v.errs = append(v.errs, fmt.Sprintf("%s: unresolved $ref %s", path, ref))
+			return
+		}
+		v.validate(target, value, path)
+		return
+	}
+	if oneOf, ok := s["oneOf"].([]any); ok {
+		for _, alt := range oneOf {
+			sub := validator{doc: v.doc}
+			sub.validate(alt.(map[string]any), value, path)
+			if len(sub.errs) == 0 {
+				return
+			}
+		}
+		v.errs = append(v.errs, fmt.Sprintf("%s: matches none of the accepted shapes", path))
+		return
+	}
+
+	typ, _ := s["type"].(string)
+	if value == nil || typ == "" {
+		return
+	}
+	switch typ {
+	case "object":
+		m, ok := value.(map[string]any)
+		if !ok {
+			v.errs = append(v.errs, fmt.Sprintf("%s: expected a mapping", path))
+			return
+		}
+		properties, _ := s["properties"].(map[string]any)
+		for _, key := range requiredKeys(s) {
+			if _, ok := m[key]; !ok {
+				v.errs = append(v.errs, fmt.Sprintf("%s: missing required key %q", path, key))
+			}
+		}
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if prop, ok := properties[key].(map[string]any); ok {
+				v.validate(prop, m[key], path+"."+key)
+				continue
+			}
+			switch additional := s["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					v.errs = append(v.errs, fmt.Sprintf("%s: unknown key %q", path, key))
+				}
+			case map[string]any:
+				v.validate(additional, m[key], path+"."+key)
+			}
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			v.errs = append(v.errs, fmt.Sprintf("%s: expected a sequence", path))
+			return
+		}
+		if itemSchema, ok := s["items"].(map[string]any); ok {
+			for i, item := range items {
+				v.validate(itemSchema, item, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	case "string":
+		switch value.(type) {
+		case string, time.Time:
+		default:
+			v.errs = append(v.errs, fmt.Sprintf("%s: expected a string", path))
+		}
+	case "integer":
+		switch n := value.(type) {
+		case int, int64, uint64:
+		case float64:
+			if n != float64(int64(n)) {
+				v.errs = append(v.errs, fmt.Sprintf("%s: expected an integer", path))
+			}
+		default:
+			v.errs = append(v.errs, fmt.Sprintf("%s: expected an integer", path))
+		}
+	case "number":
+		switch value.(type) {
+		case int, int64, uint64, float64:
+		default:
+			v.errs = append(v.errs, fmt.Sprintf("%s: expected a number", path))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.errs = append(v.errs, fmt.Sprintf("%s: expected a boolean", path))
+		}
+	}
+}
+
+func requiredKeys(s Schema) []string {
+	switch required := s["required"].(type) {
+	case []string:
+		return required
+	case []any:
+		keys := make([]string, 0, len(required))
+		for _, key := range required {
+			if k, ok := key.(string); ok {
+				keys = append(keys, k)
+			}
+		}
+		return keys
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type testScene struct {
+	Title       string     `yaml:"scene"`
+	Definitions []testNote `yaml:"definitions,omitempty"`
+	Internal    string     `yaml:"-"`
+	hidden      string
+}
+
+type testNote struct {
+	testBase   `yaml:",inline"`
+	Expression string    `yaml:"expression"`
+	Meaning    string    `yaml:"meaning,omitempty"`
+	Count      int       `yaml:"count,omitempty"`
+	Score      float64   `yaml:"score,omitempty"`
+	Done       bool      `yaml:"done,omitempty"`
+	LearnedAt  time.Time `yaml:"learned_at,omitempty"`
+	Example    testText  `yaml:"example,omitempty"`
+	Tags       map[string]string
+}
+
+type testBase struct {
+	ID string `yaml:"id,omitempty"`
+}
+
+type testText string
+
+func (testText) JSONSchema() map[string]any {
+	return map[string]any{"oneOf": []any{
+		map[string]any{"type": "string"},
+		map[string]any{"type": "object", "additionalProperties": false, "required": []any{"text"},
+			"properties": map[string]any{"text": map[string]any{"type": "string"}}},
+	}}
+}
+
+type testFlat struct {
+	Origins []string `yaml:"origins"`
+}
+
+func TestGenerate(t *testing.T) {
+	doc := Generate("https://example.com/scene.schema.json", "Scene", "Scenes of a story.", []testScene{})
+
+	assert.Equal(t, Draft, doc["$schema"])
+	assert.Equal(t, "https://example.com/scene.schema.json", doc["$id"])
+	assert.Equal(t, "Scene", doc["title"])
+	assert.Equal(t, "Scenes of a story.", doc["description"])
+	assert.Equal(t, "array", doc["type"])
+	assert.Equal(t, Schema{"$ref": "#/$defs/testScene"}, doc["items"])
+
+	defs := doc["$defs"].(Schema)
+	scene := defs["testScene"].(Schema)
+	assert.Equal(t, false, scene["additionalProperties"])
+	assert.Equal(t, Schema{
+		"scene":       Schema{"type": "string"},
+		"definitions": Schema{"type": "array", "items": Schema{"$ref": "#/$defs/testNote"}},
+	}, scene["properties"])
+
+	note := defs["testNote"].(Schema)["properties"].(Schema)
+	assert.Equal(t, Schema{"type": "string"}, note["id"], "inline fields merge into the parent")
+	assert.Equal(t, Schema{"type": "integer"}, note["count"])
+	assert.Equal(t, Schema{"type": "number"}, note["score"])
+	assert.Equal(t, Schema{"type": "boolean"}, note["done"])
+	assert.Equal(t, Schema{"type": "string"}, note["learned_at"])
+	assert.Equal(t, Schema{"type": "object", "additionalProperties": Schema{"type": "string"}}, note["tags"], "untagged fields use the lowercased name")
+	assert.Contains(t, note["example"], "oneOf", "Schemaer overrides reflection")
+	assert.NotContains(t, defs, "testBase")
+}
+
+func TestGenerate_oneOf(t *testing.T) {
+	doc := Generate("id", "title", "", []testScene{}, testFlat{})
+
+	assert.NotContains(t, doc, "description")
+	assert.NotContains(t, doc, "type")
+	assert.Equal(t, []any{
+		Schema{"type": "array", "items": Schema{"$ref": "#/$defs/testScene"}},
+		Schema{"$ref": "#/$defs/testFlat"},
+	}, doc["oneOf"])
+}
+
+func TestMarshal(t *testing.T) {
+	doc := Generate("id", "title", "", testFlat{})
+	first, err := Marshal(doc)
+	require.NoError(t, err)
+	second, err := Marshal(Generate("id", "title", "", testFlat{}))
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, byte('\n'), first[len(first)-1])
+	assert.True(t, json.Valid(first))
+}
+
+func TestValidate(t *testing.T) {
+	doc := Generate("id", "title", "", []testScene{}, testFlat{})
+
+	tests := []struct {
+		name string
+		yaml string
+		want []string
+	}{
+		{
+			name: "valid scenes",
+			yaml: `
+- scene: Central Perk
+  definitions:
+    - id: n1
+      expression: break a leg
+      count: 2
+      score: 0.5
+      done: true
+      learned_at: 2025-01-02
+      example: {text: "Break a leg tonight!"}
+      tags: {level: easy}
+`,
+		},
+		{
+			name: "valid flat shape",
+			yaml: "origins: [spect]\n",
+		},
+		{
+			name: "misspelt key",
+			yaml: `
+- scene: Central Perk
+  defintions:
+    - expression: break a leg
+`,
+			want: []string{"$: matches none of the accepted shapes"},
+		},
+		{
+			name: "scalar where a mapping is expected",
+			yaml: "- scene: [a, b]\n",
+			want: []string{"$: matches none of the accepted shapes"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value any
+			require.NoError(t, yaml.Unmarshal([]byte(tt.yaml), &value))
+			assert.Equal(t, tt.want, Validate(doc, value))
+		})
+	}
+}
+
+func TestValidate_reportsPaths(t *testing.T) {
+	doc := Generate("id", "title", "", []testScene{})
+
+	var value any
+	require.NoError(t, yaml.Unmarshal([]byte(`
+- scene: Central Perk
+  definitions:
+    - expresion: break a leg
+      count: many
+      example: {highlight: leg}
+`), &value))
+
+	assert.Equal(t, []string{
+		"$[0].definitions[0].count: expected an integer",
+		"$[0].definitions[0].example: matches none of the accepted shapes",
+		`$[0].definitions[0]: unknown key "expresion"`,
+	}, Validate(doc, value))
+}
+
+func TestValidate_afterJSONRoundTrip(t *testing.T) {
+	data, err := Marshal(Generate("id", "title", "", []testScene{}))
+	require.NoError(t, err)
+	var doc Schema
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	var value any
+	require.NoError(t, yaml.Unmarshal([]byte("- scene: x\n  extra: y\n"), &value))
+	assert.Equal(t, []string{`$[0]: unknown key "extra"`}, Validate(doc, value))
+}
@@ -0,0 +1,162 @@
+package learning
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// RepositoryHistorySource serves learning history from note and learning
+// log repositories instead of YAML files. It rebuilds the histories the
+// way WriteAll exports them, so readers see the same shape either way.
+//
+// When the log repository can fingerprint its tables (see
+// DBLearningRepository.HistoryVersion) the rebuilt histories are cached
+// and reused until the fingerprint changes; callers must treat them as
+// read-only, like a WatchedReader snapshot.
+type RepositoryHistorySource struct {
+	notes notebook.NoteRepository
+	logs  LearningRepository
+
+	mu        sync.Mutex
+	version   string
+	histories map[string][]notebook.LearningHistory
+}
+
+// skipFinder is implemented by log repositories that store skips apart
+// from the logs (DBLearningRepository).
+type skipFinder interface {
+	FindSkips(ctx context.Context) ([]LearningSkip, error)
+}
+
+// relearnFinder is implemented by log repositories that store the relearn
+// pool apart from the logs (DBLearningRepository).
+type relearnFinder interface {
+	FindRelearns(ctx context.Context) ([]LearningRelearn, error)
+}
+
+// historyVersioner is implemented by log repositories that can tell
+// whether anything a learning history is built from has changed.
+type historyVersioner interface {
+	HistoryVersion(ctx context.Context) (string, error)
+}
+
+// NewRepositoryHistorySource returns a notebook.LearningHistorySource
+// backed by the given repositories, typically the database ones.
+func NewRepositoryHistorySource(notes notebook.NoteRepository, logs LearningRepository) *RepositoryHistorySource {
+	return &RepositoryHistorySource{notes: notes, logs: logs}
+}
+
+// LearningHistories implements notebook.LearningHistorySource.
+func (s *RepositoryHistorySource) LearningHistories() (map[string][]notebook.LearningHistory, error) {
+	ctx := context.Background()
+	versioner, ok := s.logs.(historyVersioner)
+	if !ok {
+		return s.build(ctx)
+	}
+
+	version, err := versioner.HistoryVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("logs.HistoryVersion() > %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.histories != nil && s.version == version {
+		return s.histories, nil
+	}
+	histories, err := s.build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.version = version
+	s.histories = histories
+	return histories, nil
+}
+
+// build loads every note, log, skip and relearn entry and rebuilds the
+// histories.
+func (s *RepositoryHistorySource) build(ctx context.Context) (map[string][]notebook.LearningHistory, error) {
+	notes, err := s.notes.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("notes.FindAll() > %w", err)
+	}
+	logs, err := s.logs.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("logs.FindAll() > %w", err)
+	}
+	histories := buildLearningHistories(notes, logs)
+	if finder, ok := s.logs.(skipFinder); ok {
+		skips, err := finder.FindSkips(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("logs.FindSkips() > %w", err)
+		}
+		applySkips(histories, notes, skips)
+	}
+	if finder, ok := s.logs.(relearnFinder); ok {
+		relearns, err := finder.FindRelearns(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("logs.FindRelearns() > %w", err)
+		}
+		applyRelearns(histories, notes, relearns)
+	}
+	return histories, nil
+}
+
+// applySkips sets each skip on the expressions its note was rebuilt as in
+// the skip's notebook.
+func applySkips(histories map[string][]notebook.LearningHistory, notes []notebook.NoteRecord, skips []LearningSkip) {
+	entryByID := noteEntries(notes)
+	for _, skip := range skips {
+		skippedAt := skip.SkippedAt.Format(time.RFC3339)
+		forEachNoteExpression(histories[skip.NotebookID], entryByID, skip.NoteID, func(expr *notebook.LearningHistoryExpression) {
+			expr.SkippedAt = expr.SkippedAt.Set(notebook.QuizType(skip.QuizType), skippedAt)
+		})
+	}
+}
+
+// applyRelearns sets each relearn pool entry on the expressions its note
+// was rebuilt as in the entry's notebook.
+func applyRelearns(histories map[string][]notebook.LearningHistory, notes []notebook.NoteRecord, relearns []LearningRelearn) {
+	entryByID := noteEntries(notes)
+	for _, relearn := range relearns {
+		relearnAt := relearn.RelearnAt.Format(time.RFC3339)
+		forEachNoteExpression(histories[relearn.NotebookID], entryByID, relearn.NoteID, func(expr *notebook.LearningHistoryExpression) {
+			expr.RelearnAt = expr.RelearnAt.Set(notebook.QuizType(relearn.QuizType), relearnAt)
+		})
+	}
+}
+
+func noteEntries(notes []notebook.NoteRecord) map[int64]string {
+	entryByID := make(map[int64]string, len(notes))
+	for _, note := range notes {
+		entryByID[note.ID] = note.Entry
+	}
+	return entryByID
+}
+
+// forEachNoteExpression calls fn with every expression of histories the
+// note was rebuilt as.
+func forEachNoteExpression(histories []notebook.LearningHistory, entryByID map[int64]string, noteID int64, fn func(*notebook.LearningHistoryExpression)) {
+	entry, ok := entryByID[noteID]
+	if !ok {
+		return
+	}
+	for i := range histories {
+		history := &histories[i]
+		for j := range history.Expressions {
+			if history.Expressions[j].Expression == entry {
+				fn(&history.Expressions[j])
+			}
+		}
+		for k := range history.Scenes {
+			for j := range history.Scenes[k].Expressions {
+				if history.Scenes[k].Expressions[j].Expression == entry {
+					fn(&history.Scenes[k].Expressions[j])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package learning
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+type stubNoteRepository struct {
+	notebook.NoteRepository
+	notes []notebook.NoteRecord
+	err   error
+}
+
+func (r stubNoteRepository) FindAll(context.Context) ([]notebook.NoteRecord, error) {
+	return r.notes, r.err
+}
+
+type stubLearningRepository struct {
+	LearningRepository
+	logs []LearningLog
+	err  error
+}
+
+func (r stubLearningRepository) FindAll(context.Context) ([]LearningLog, error) {
+	return r.logs, r.err
+}
+
+type stubDBLearningRepository struct {
+	stubLearningRepository
+	skips    []LearningSkip
+	relearns []LearningRelearn
+	version  string
+	findAlls int
+}
+
+func (r *stubDBLearningRepository) FindAll(ctx context.Context) ([]LearningLog, error) {
+	r.findAlls++
+	return r.stubLearningRepository.FindAll(ctx)
+}
+
+func (r *stubDBLearningRepository) FindSkips(context.Context) ([]LearningSkip, error) {
+	return r.skips, nil
+}
+
+func (r *stubDBLearningRepository) FindRelearns(context.Context) ([]LearningRelearn, error) {
+	return r.relearns, nil
+}
+
+func (r *stubDBLearningRepository) HistoryVersion(context.Context) (string, error) {
+	return r.version, nil
+}
+
+func TestRepositoryHistorySource_LearningHistories(t *testing.T) {
+	learnedAt := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	notes := []notebook.NoteRecord{{
+		ID: 1, Usage: "break the ice", Entry: "break the ice",
+		NotebookNotes: []notebook.NotebookNote{
+			{NotebookType: "flashcard", NotebookID: "idioms", Group: "Common"},
+		},
+	}}
+	logs := []LearningLog{
+		{NoteID: 1, Status: "understood", LearnedAt: learnedAt, QuizType: "notebook", SourceNotebookID: "idioms"},
+	}
+
+	t.Run("rebuilds histories by notebook", func(t *testing.T) {
+		source := NewRepositoryHistorySource(stubNoteRepository{notes: notes}, stubLearningRepository{logs: logs})
+		got, err := source.LearningHistories()
+		require.NoError(t, err)
+		require.Len(t, got["idioms"], 1)
+		assert.Equal(t, "flashcard", got["idioms"][0].Metadata.Type)
+		require.Len(t, got["idioms"][0].Expressions, 1)
+		assert.Equal(t, "break the ice", got["idioms"][0].Expressions[0].Expression)
+		assert.Len(t, got["idioms"][0].Expressions[0].LearnedLogs, 1)
+	})
+
+	t.Run("note error", func(t *testing.T) {
+		source := NewRepositoryHistorySource(stubNoteRepository{err: errors.New("boom")}, stubLearningRepository{logs: logs})
+		_, err := source.LearningHistories()
+		assert.Error(t, err)
+	})
+
+	t.Run("log error", func(t *testing.T) {
+		source := NewRepositoryHistorySource(stubNoteRepository{notes: notes}, stubLearningRepository{err: errors.New("boom")})
+		_, err := source.LearningHistories()
+		assert.Error(t, err)
+	})
+
+	t.Run("applies skips", func(t *testing.T) {
+		logs := &stubDBLearningRepository{
+			stubLearningRepository: stubLearningRepository{logs: logs},
+			skips: []LearningSkip{
+				{NoteID: 1, NotebookID: "idioms", QuizType: "reverse", SkippedAt: learnedAt},
+			},
+		}
+		source := NewRepositoryHistorySource(stubNoteRepository{notes: notes}, logs)
+		got, err := source.LearningHistories()
+		require.NoError(t, err)
+		skippedAt := got["idioms"][0].Expressions[0].SkippedAt
+		assert.True(t, skippedAt.IsSkipped(notebook.QuizTypeReverse))
+		assert.False(t, skippedAt.IsSkipped(notebook.QuizTypeNotebook))
+	})
+
+	t.Run("applies relearns", func(t *testing.T) {
+		logs := &stubDBLearningRepository{
+			stubLearningRepository: stubLearningRepository{logs: logs},
+			relearns: []LearningRelearn{
+				{NoteID: 1, NotebookID: "idioms", QuizType: "notebook", RelearnAt: learnedAt},
+			},
+		}
+		source := NewRepositoryHistorySource(stubNoteRepository{notes: notes}, logs)
+		got, err := source.LearningHistories()
+		require.NoError(t, err)
+		expr := got["idioms"][0].Expressions[0]
+		assert.True(t, expr.InRelearnPool(notebook.QuizTypeNotebook))
+		assert.False(t, expr.InRelearnPool(notebook.QuizTypeReverse))
+	})
+
+	t.Run("reuses histories until the version changes", func(t *testing.T) {
+		logs := &stubDBLearningRepository{
+			stubLearningRepository: stubLearningRepository{logs: logs},
+			version:                "1",
+		}
+		source := NewRepositoryHistorySource(stubNoteRepository{notes: notes}, logs)
+		for range 2 {
+			_, err := source.LearningHistories()
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, logs.findAlls)
+
+		logs.version = "2"
+		_, err := source.LearningHistories()
+		require.NoError(t, err)
+		assert.Equal(t, 2, logs.findAlls)
+	})
+}
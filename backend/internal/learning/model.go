@@ -16,7 +16,22 @@ type LearningLog struct {
 	// log belongs to (denormalised cache of notes.concept_key). Set at
 	// log-write time so "all logs for a concept" is a single index
 	// lookup, with no join required.
-	ConceptKey       string    `db:"concept_key"`
+	ConceptKey string `db:"concept_key"`
+	// Origin and OriginSense identify the etymology origin an
+	// etymology_origin log was answered under (OriginSense only for
+	// same-session multi-sense origins). CorrectionID is the grammar
+	// correction a grammar log drilled. All three are empty for
+	// vocabulary logs.
+	Origin       string `db:"origin"`
+	OriginSense  string `db:"origin_sense"`
+	CorrectionID string `db:"correction_id"`
+	// Answer is what the learner answered, GraderReason why Grader
+	// (notebook.GraderModel, GraderDeterministic or GraderOverride)
+	// accepted or rejected it. Empty for logs recorded before answers
+	// were kept.
+	Answer           string    `db:"answer"`
+	GraderReason     string    `db:"grader_reason"`
+	Grader           string    `db:"grader"`
 	EasinessFactor   *float64  `db:"easiness_factor"` // kept for DB compatibility; derived from logs at runtime
 	SourceNotebookID string    `db:"source_notebook_id"`
 	CreatedAt        time.Time `db:"created_at"`
@@ -35,3 +50,24 @@ type LearningLog struct {
 	IsCorrect        bool   `db:"-"`
 	LearningNotesDir string `db:"-"`
 }
+
+// LearningRelearn keeps a leech note in the relearn pool of one quiz type
+// in one notebook, the database form of a LearningHistoryExpression's
+// RelearnAt entry.
+type LearningRelearn struct {
+	NoteID     int64     `db:"note_id"`
+	NotebookID string    `db:"notebook_id"`
+	QuizType   string    `db:"quiz_type"`
+	RelearnAt  time.Time `db:"relearn_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// LearningSkip excludes a note from one quiz type in one notebook, the
+// database form of a LearningHistoryExpression's SkippedAt entry.
+type LearningSkip struct {
+	NoteID     int64     `db:"note_id"`
+	NotebookID string    `db:"notebook_id"`
+	QuizType   string    `db:"quiz_type"`
+	SkippedAt  time.Time `db:"skipped_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
@@ -55,9 +55,34 @@ func (m *MultiLearningRepository) UpdateLog(ctx context.Context, in UpdateLogInp
 		Status:       res.NewStatus,
 		Quality:      res.NewQuality,
 		IntervalDays: res.NewIntervalDays,
+		Grader:       res.NewGrader,
 	}
 	if _, err := m.secondary.UpdateLog(ctx, secondaryIn); err != nil {
 		slog.Warn("secondary learning override failed", "error", err)
 	}
 	return res, nil
 }
+
+// UpdateSkips writes the skips to both stores, primary first; like the
+// other writes, a secondary failure is only logged.
+func (m *MultiLearningRepository) UpdateSkips(ctx context.Context, in UpdateSkipsInput) error {
+	if err := m.primary.UpdateSkips(ctx, in); err != nil {
+		return err
+	}
+	if err := m.secondary.UpdateSkips(ctx, in); err != nil {
+		slog.Warn("secondary learning skip write failed", "error", err)
+	}
+	return nil
+}
+
+// MoveToRelearnPool writes the relearn pool entries to both stores, primary
+// first; a secondary failure is only logged.
+func (m *MultiLearningRepository) MoveToRelearnPool(ctx context.Context, in MoveToRelearnPoolInput) error {
+	if err := m.primary.MoveToRelearnPool(ctx, in); err != nil {
+		return err
+	}
+	if err := m.secondary.MoveToRelearnPool(ctx, in); err != nil {
+		slog.Warn("secondary learning relearn write failed", "error", err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jmoiron/sqlx"
 
 	"github.com/at-ishikawa/langner/internal/database"
+	"github.com/at-ishikawa/langner/internal/notebook"
 )
 
 // UpdateLogInput identifies a single learning log entry and the
@@ -49,6 +50,7 @@ type UpdateLogMirror struct {
 	Status       string
 	Quality      int
 	IntervalDays int
+	Grader       string
 }
 
 // UpdateLogResult reports the pre-change values and the recomputed
@@ -63,7 +65,36 @@ type UpdateLogResult struct {
 	NewStatus            string
 	NewIntervalDays      int
 	NewNextReviewDate    string
-	Found                bool
+	// OriginalGrader and NewGrader are the log's grader before and after
+	// the change; an override always leaves notebook.GraderOverride.
+	OriginalGrader string
+	NewGrader      string
+	Found          bool
+}
+
+// UpdateSkipsInput identifies the expressions to exclude from (or return
+// to) the given quiz types. Expressions lists Expression and, for a
+// definitions concept, its sibling members, which are skipped together.
+// A zero SkippedAt resumes the quiz types instead of skipping them, which
+// also takes the expressions out of their relearn pools.
+type UpdateSkipsInput struct {
+	NotebookName string
+	StoryTitle   string
+	SceneTitle   string
+	Expression   string
+	Expressions  []string
+	QuizTypes    []notebook.QuizType
+	SkippedAt    time.Time
+}
+
+// MoveToRelearnPoolInput identifies the leech to keep in the relearn pool
+// of the given quiz types from RelearnAt on.
+type MoveToRelearnPoolInput struct {
+	NotebookName string
+	Expression   string
+	ID           string
+	QuizTypes    []notebook.QuizType
+	RelearnAt    time.Time
 }
 
 // LearningRepository defines operations for managing learning logs.
@@ -80,6 +111,12 @@ type LearningRepository interface {
 	// BatchDelete removes rows whose IDs appear in ids. Used by the
 	// reconcile pass to drop DB-only logs that no longer exist in YAML.
 	BatchDelete(ctx context.Context, ids []int64) error
+	// UpdateSkips skips or resumes expressions for quiz types. Used by
+	// SkipWord and ResumeWord.
+	UpdateSkips(ctx context.Context, in UpdateSkipsInput) error
+	// MoveToRelearnPool moves a leech into the relearn pool of quiz types.
+	// Used by the leech actions.
+	MoveToRelearnPool(ctx context.Context, in MoveToRelearnPoolInput) error
 }
 
 // DBLearningRepository implements LearningRepository using PostgreSQL.
@@ -112,16 +149,44 @@ func (r *DBLearningRepository) Create(ctx context.Context, log *LearningLog) err
 		log.NoteID = noteID
 	}
 
-	query := `INSERT INTO learning_logs (note_id, status, learned_at, quality, response_time_ms, quiz_type, interval_days, source_notebook_id, concept_key)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	query := `INSERT INTO learning_logs (note_id, status, learned_at, quality, response_time_ms, quiz_type, interval_days, source_notebook_id, concept_key, origin, origin_sense, correction_id, answer, grader_reason, grader)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
 	_, err := r.db.ExecContext(ctx, query,
-		log.NoteID, log.Status, log.LearnedAt, log.Quality, log.ResponseTimeMs, log.QuizType, log.IntervalDays, log.SourceNotebookID, log.ConceptKey)
+		log.NoteID, log.Status, log.LearnedAt, log.Quality, log.ResponseTimeMs, log.QuizType, log.IntervalDays, log.SourceNotebookID, log.ConceptKey,
+		log.Origin, log.OriginSense, log.CorrectionID, log.Answer, log.GraderReason, log.Grader)
 	if err != nil {
 		return fmt.Errorf("insert learning log: %w", err)
 	}
+	if log.Status != string(notebook.LearnedStatusMisunderstood) {
+		if err := r.leaveRelearnPool(ctx, log); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// leaveRelearnPool deletes the relearn pool rows a correct answer ends
+// (see notebook.RelearnPoolsLeftBy).
+func (r *DBLearningRepository) leaveRelearnPool(ctx context.Context, log *LearningLog) error {
+	query, args, err := sqlx.In(`DELETE FROM learning_relearns WHERE note_id = ? AND notebook_id = ? AND quiz_type IN (?)`,
+		log.NoteID, log.SourceNotebookID, quizTypeNames(notebook.RelearnPoolsLeftBy(notebook.QuizType(log.QuizType))))
+	if err != nil {
+		return fmt.Errorf("build relearn delete: %w", err)
+	}
+	if _, err := r.db.ExecContext(ctx, r.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("delete learning relearns: %w", err)
+	}
+	return nil
+}
+
+func quizTypeNames(quizTypes []notebook.QuizType) []string {
+	names := make([]string, len(quizTypes))
+	for i, qt := range quizTypes {
+		names[i] = string(qt)
+	}
+	return names
+}
+
 // ensureNoteExists finds an existing note by usage/entry or creates one.
 // Uses Definition as entry if set, otherwise Expression. Stores Expression as usage.
 //
@@ -177,8 +242,8 @@ func (r *DBLearningRepository) BatchCreate(ctx context.Context, logs []*Learning
 		return nil
 	}
 
-	columns := []string{"note_id", "status", "learned_at", "quality", "response_time_ms", "quiz_type", "interval_days", "source_notebook_id", "concept_key"}
-	const chunkSize = 5000 // 5000 * 9 columns = 45000 placeholders, well under 65535
+	columns := []string{"note_id", "status", "learned_at", "quality", "response_time_ms", "quiz_type", "interval_days", "source_notebook_id", "concept_key", "origin", "origin_sense", "correction_id", "answer", "grader_reason", "grader"}
+	const chunkSize = 4000 // 4000 * 15 columns = 60000 placeholders, under 65535
 
 	return database.RunInTx(ctx, r.db, func(ctx context.Context, tx *sqlx.Tx) error {
 		for i := 0; i < len(logs); i += chunkSize {
@@ -191,7 +256,7 @@ func (r *DBLearningRepository) BatchCreate(ctx context.Context, logs []*Learning
 			query := database.BuildMultiRowInsert("learning_logs", columns, len(chunk))
 			var args []interface{}
 			for _, l := range chunk {
-				args = append(args, l.NoteID, l.Status, l.LearnedAt, l.Quality, l.ResponseTimeMs, l.QuizType, l.IntervalDays, l.SourceNotebookID, l.ConceptKey)
+				args = append(args, l.NoteID, l.Status, l.LearnedAt, l.Quality, l.ResponseTimeMs, l.QuizType, l.IntervalDays, l.SourceNotebookID, l.ConceptKey, l.Origin, l.OriginSense, l.CorrectionID, l.Answer, l.GraderReason, l.Grader)
 			}
 			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
 				return fmt.Errorf("insert learning logs: %w", err)
@@ -261,11 +326,12 @@ func (r *DBLearningRepository) UpdateLog(ctx context.Context, in UpdateLogInput)
 		Status       string    `db:"status"`
 		Quality      int       `db:"quality"`
 		IntervalDays int       `db:"interval_days"`
+		Grader       string    `db:"grader"`
 		LearnedAt    time.Time `db:"learned_at"`
 	}
 	var cur currentRow
 	err := r.db.GetContext(ctx, &cur, `
-		SELECT id, status, quality, interval_days, learned_at
+		SELECT id, status, quality, interval_days, grader, learned_at
 		FROM learning_logs
 		WHERE note_id = $1 AND quiz_type = $2 AND DATE(learned_at) = $3::date
 		ORDER BY learned_at DESC LIMIT 1`,
@@ -279,12 +345,13 @@ func (r *DBLearningRepository) UpdateLog(ctx context.Context, in UpdateLogInput)
 	}
 
 	newStatus, newQuality, newIntervalDays := computeOverrideValues(in, cur.Status, cur.Quality, cur.IntervalDays)
+	newGrader := overrideGrader(in, cur.Grader)
 
 	if _, err := r.db.ExecContext(ctx, `
 		UPDATE learning_logs
-		SET status = $1, quality = $2, interval_days = $3
-		WHERE id = $4`,
-		newStatus, newQuality, newIntervalDays, cur.ID); err != nil {
+		SET status = $1, quality = $2, interval_days = $3, grader = $4
+		WHERE id = $5`,
+		newStatus, newQuality, newIntervalDays, newGrader, cur.ID); err != nil {
 		return UpdateLogResult{}, fmt.Errorf("update learning_logs: %w", err)
 	}
 
@@ -296,6 +363,8 @@ func (r *DBLearningRepository) UpdateLog(ctx context.Context, in UpdateLogInput)
 		NewStatus:            newStatus,
 		NewIntervalDays:      newIntervalDays,
 		NewNextReviewDate:    cur.LearnedAt.AddDate(0, 0, newIntervalDays).Format("2006-01-02"),
+		OriginalGrader:       cur.Grader,
+		NewGrader:            newGrader,
 		Found:                true,
 	}, nil
 }
@@ -326,6 +395,20 @@ func computeOverrideValues(in UpdateLogInput, curStatus string, curQuality, curI
 	return "misunderstood", 1, 1
 }
 
+// overrideGrader returns the grader a log has after UpdateLog: the
+// mirrored one when MirrorValues is set, the override when the answer is
+// marked, and otherwise the current one.
+func overrideGrader(in UpdateLogInput, curGrader string) string {
+	switch {
+	case in.MirrorValues != nil:
+		return in.MirrorValues.Grader
+	case in.MarkCorrect != nil:
+		return notebook.GraderOverride
+	default:
+		return curGrader
+	}
+}
+
 // BatchDelete removes the rows whose IDs are in the slice. Used by the
 // importer's reconcile pass to drop DB-only logs whose YAML counterpart
 // has disappeared.
@@ -352,3 +435,118 @@ func (r *DBLearningRepository) BatchDelete(ctx context.Context, ids []int64) err
 		return nil
 	})
 }
+
+// UpdateSkips upserts (or, for a zero in.SkippedAt, deletes) one
+// learning_skips row per note and quiz type; resuming deletes the
+// learning_relearns rows too.
+func (r *DBLearningRepository) UpdateSkips(ctx context.Context, in UpdateSkipsInput) error {
+	return database.RunInTx(ctx, r.db, func(ctx context.Context, tx *sqlx.Tx) error {
+		noteIDs, err := findNotebookNotes(ctx, tx, in.NotebookName, in.Expression, in.Expressions)
+		if err != nil {
+			return err
+		}
+
+		for _, noteID := range noteIDs {
+			for _, qt := range in.QuizTypes {
+				if in.SkippedAt.IsZero() {
+					if _, err := tx.ExecContext(ctx, `DELETE FROM learning_skips WHERE note_id = $1 AND notebook_id = $2 AND quiz_type = $3`,
+						noteID, in.NotebookName, string(qt)); err != nil {
+						return fmt.Errorf("delete learning skip: %w", err)
+					}
+					if _, err := tx.ExecContext(ctx, `DELETE FROM learning_relearns WHERE note_id = $1 AND notebook_id = $2 AND quiz_type = $3`,
+						noteID, in.NotebookName, string(qt)); err != nil {
+						return fmt.Errorf("delete learning relearn: %w", err)
+					}
+					continue
+				}
+				if _, err := tx.ExecContext(ctx, `
+					INSERT INTO learning_skips (note_id, notebook_id, quiz_type, skipped_at) VALUES ($1, $2, $3, $4)
+					ON CONFLICT (note_id, notebook_id, quiz_type) DO UPDATE SET skipped_at = EXCLUDED.skipped_at`,
+					noteID, in.NotebookName, string(qt), in.SkippedAt); err != nil {
+					return fmt.Errorf("upsert learning skip: %w", err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// MoveToRelearnPool upserts one learning_relearns row per note and quiz
+// type.
+func (r *DBLearningRepository) MoveToRelearnPool(ctx context.Context, in MoveToRelearnPoolInput) error {
+	return database.RunInTx(ctx, r.db, func(ctx context.Context, tx *sqlx.Tx) error {
+		noteIDs, err := findNotebookNotes(ctx, tx, in.NotebookName, in.Expression, []string{in.Expression})
+		if err != nil {
+			return err
+		}
+		for _, noteID := range noteIDs {
+			for _, qt := range in.QuizTypes {
+				if _, err := tx.ExecContext(ctx, `
+					INSERT INTO learning_relearns (note_id, notebook_id, quiz_type, relearn_at) VALUES ($1, $2, $3, $4)
+					ON CONFLICT (note_id, notebook_id, quiz_type) DO UPDATE SET relearn_at = EXCLUDED.relearn_at`,
+					noteID, in.NotebookName, string(qt), in.RelearnAt); err != nil {
+					return fmt.Errorf("upsert learning relearn: %w", err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// findNotebookNotes resolves expressions to their notes within the
+// notebook, the same way the learning history rebuilt from the database
+// names them, so every note a card reads as is found. It fails when there
+// is none, naming expression.
+func findNotebookNotes(ctx context.Context, tx *sqlx.Tx, notebookName, expression string, expressions []string) ([]int64, error) {
+	var noteIDs []int64
+	query, args, err := sqlx.In(`SELECT DISTINCT n.id FROM notes n
+		JOIN notebook_notes nn ON n.id = nn.note_id
+		WHERE nn.notebook_id = ? AND (n.entry IN (?) OR n."usage" IN (?))
+		ORDER BY n.id`, notebookName, expressions, expressions)
+	if err != nil {
+		return nil, fmt.Errorf("build note query: %w", err)
+	}
+	if err := tx.SelectContext(ctx, &noteIDs, tx.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("find notes: %w", err)
+	}
+	if len(noteIDs) == 0 {
+		return nil, fmt.Errorf("no note for %q in notebook %q", expression, notebookName)
+	}
+	return noteIDs, nil
+}
+
+// FindRelearns returns every learning_relearns row.
+func (r *DBLearningRepository) FindRelearns(ctx context.Context) ([]LearningRelearn, error) {
+	var relearns []LearningRelearn
+	if err := r.db.SelectContext(ctx, &relearns,
+		"SELECT note_id, notebook_id, quiz_type, relearn_at, updated_at FROM learning_relearns ORDER BY note_id, notebook_id, quiz_type"); err != nil {
+		return nil, fmt.Errorf("load learning relearns: %w", err)
+	}
+	return relearns, nil
+}
+
+// FindSkips returns every learning_skips row.
+func (r *DBLearningRepository) FindSkips(ctx context.Context) ([]LearningSkip, error) {
+	var skips []LearningSkip
+	if err := r.db.SelectContext(ctx, &skips,
+		"SELECT note_id, notebook_id, quiz_type, skipped_at, updated_at FROM learning_skips ORDER BY note_id, notebook_id, quiz_type"); err != nil {
+		return nil, fmt.Errorf("load learning skips: %w", err)
+	}
+	return skips, nil
+}
+
+// HistoryVersion fingerprints the tables a learning history is rebuilt
+// from. Every row change bumps a table's updated_at (or, for deletes, its
+// row count), so an unchanged version means a cached history is current.
+func (r *DBLearningRepository) HistoryVersion(ctx context.Context) (string, error) {
+	var version string
+	if err := r.db.GetContext(ctx, &version, `SELECT concat_ws(':',
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM notes),
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM notebook_notes),
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM learning_logs),
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM learning_skips),
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM learning_relearns))`); err != nil {
+		return "", fmt.Errorf("load learning history version: %w", err)
+	}
+	return version, nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
 )
 
 func TestDBLearningRepository_FindAll(t *testing.T) {
@@ -85,7 +87,35 @@ func TestDBLearningRepository_Create(t *testing.T) {
 			log:  &LearningLog{NoteID: 10, Status: "understood", LearnedAt: now, Quality: 4, ResponseTimeMs: 1500, QuizType: "notebook", IntervalDays: 7, SourceNotebookID: "nb-1"},
 			setupMock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO learning_logs").
-					WithArgs(int64(10), "understood", now, 4, 1500, "notebook", 7, "nb-1", "").
+					WithArgs(int64(10), "understood", now, 4, 1500, "notebook", 7, "nb-1", "", "", "", "", "", "", "").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`DELETE FROM learning_relearns WHERE note_id = \$1 AND notebook_id = \$2 AND quiz_type IN \(\$3\)`).
+					WithArgs(int64(10), "nb-1", "notebook").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "correct freeform answer leaves the notebook and reverse relearn pools",
+			log:  &LearningLog{NoteID: 10, Status: "usable", LearnedAt: now, Quality: 4, ResponseTimeMs: 1500, QuizType: "freeform", IntervalDays: 7, SourceNotebookID: "nb-1"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO learning_logs").
+					WithArgs(int64(10), "usable", now, 4, 1500, "freeform", 7, "nb-1", "", "", "", "", "", "", "").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`DELETE FROM learning_relearns WHERE note_id = \$1 AND notebook_id = \$2 AND quiz_type IN \(\$3, \$4, \$5\)`).
+					WithArgs(int64(10), "nb-1", "freeform", "notebook", "reverse").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "etymology origin and grammar keys are written",
+			log: &LearningLog{
+				NoteID: 12, Status: "misunderstood", LearnedAt: now, Quality: 1, ResponseTimeMs: 2000,
+				QuizType: "etymology_origin", IntervalDays: 1, SourceNotebookID: "roots",
+				Origin: "pathos", OriginSense: "disease",
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO learning_logs").
+					WithArgs(int64(12), "misunderstood", now, 1, 2000, "etymology_origin", 1, "roots", "", "pathos", "disease", "", "", "", "").
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
@@ -114,8 +144,11 @@ func TestDBLearningRepository_Create(t *testing.T) {
 					WithArgs("serendipity", "serendipity", "").
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(61)))
 				mock.ExpectExec("INSERT INTO learning_logs").
-					WithArgs(int64(61), "understood", now, 4, 1500, "notebook", 7, "nb-1", "").
+					WithArgs(int64(61), "understood", now, 4, 1500, "notebook", 7, "nb-1", "", "", "", "", "", "", "").
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("DELETE FROM learning_relearns").
+					WithArgs(int64(61), "nb-1", "notebook").
+					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 		},
 		{
@@ -138,7 +171,7 @@ func TestDBLearningRepository_Create(t *testing.T) {
 					WithArgs("cardiology", "cardiology", "").
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(42)))
 				mock.ExpectExec("INSERT INTO learning_logs").
-					WithArgs(int64(42), "misunderstood", now, 1, 3000, "notebook", 1, "wpme", "").
+					WithArgs(int64(42), "misunderstood", now, 1, 3000, "notebook", 1, "wpme", "", "", "", "", "", "", "").
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
@@ -183,10 +216,10 @@ func TestDBLearningRepository_BatchCreate(t *testing.T) {
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
-				mock.ExpectExec("INSERT INTO learning_logs \\(note_id, status, learned_at, quality, response_time_ms, quiz_type, interval_days, source_notebook_id, concept_key\\) VALUES \\(\\$1, \\$2, \\$3, \\$4, \\$5, \\$6, \\$7, \\$8, \\$9\\), \\(\\$10, \\$11, \\$12, \\$13, \\$14, \\$15, \\$16, \\$17, \\$18\\)").
+				mock.ExpectExec("INSERT INTO learning_logs \\(note_id, status, learned_at, quality, response_time_ms, quiz_type, interval_days, source_notebook_id, concept_key, origin, origin_sense, correction_id, answer, grader_reason, grader\\) VALUES \\(\\$1, \\$2, \\$3, \\$4, \\$5, \\$6, \\$7, \\$8, \\$9, \\$10, \\$11, \\$12, \\$13, \\$14, \\$15\\), \\(\\$16, \\$17, \\$18, \\$19, \\$20, \\$21, \\$22, \\$23, \\$24, \\$25, \\$26, \\$27, \\$28, \\$29, \\$30\\)").
 					WithArgs(
-						int64(10), "understood", now, 4, 1500, "notebook", 7, "nb-1", "",
-						int64(11), "misunderstood", now, 1, 3000, "freeform", 1, "nb-2", "",
+						int64(10), "understood", now, 4, 1500, "notebook", 7, "nb-1", "", "", "", "", "", "", "",
+						int64(11), "misunderstood", now, 1, 3000, "freeform", 1, "nb-2", "", "", "", "", "", "", "",
 					).
 					WillReturnResult(sqlmock.NewResult(1, 2))
 				mock.ExpectCommit()
@@ -248,12 +281,12 @@ func TestDBLearningRepository_UpdateLog_MarkCorrect(t *testing.T) {
 	repo := NewDBLearningRepository(sqlxDB)
 
 	learnedAt := time.Date(2026, 6, 29, 10, 0, 0, 0, time.UTC)
-	mock.ExpectQuery(`SELECT id, status, quality, interval_days, learned_at FROM learning_logs`).
+	mock.ExpectQuery(`SELECT id, status, quality, interval_days, grader, learned_at FROM learning_logs`).
 		WithArgs(int64(42), "notebook", "2026-06-29").
-		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "quality", "interval_days", "learned_at"}).
-			AddRow(int64(7), "misunderstood", 1, 1, learnedAt))
-	mock.ExpectExec(`UPDATE learning_logs SET status = \$1, quality = \$2, interval_days = \$3 WHERE id = \$4`).
-		WithArgs("understood", 4, 1, int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "quality", "interval_days", "grader", "learned_at"}).
+			AddRow(int64(7), "misunderstood", 1, 1, "model", learnedAt))
+	mock.ExpectExec(`UPDATE learning_logs SET status = \$1, quality = \$2, interval_days = \$3, grader = \$4 WHERE id = \$5`).
+		WithArgs("understood", 4, 1, "override", int64(7)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	markCorrect := true
@@ -269,6 +302,8 @@ func TestDBLearningRepository_UpdateLog_MarkCorrect(t *testing.T) {
 	assert.Equal(t, 1, res.OriginalQuality)
 	assert.Equal(t, "understood", res.NewStatus)
 	assert.Equal(t, 4, res.NewQuality)
+	assert.Equal(t, "model", res.OriginalGrader)
+	assert.Equal(t, "override", res.NewGrader, "a marked answer is graded by the override")
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -284,12 +319,12 @@ func TestDBLearningRepository_UpdateLog_Mirror(t *testing.T) {
 	repo := NewDBLearningRepository(sqlxDB)
 
 	learnedAt := time.Date(2026, 6, 29, 10, 0, 0, 0, time.UTC)
-	mock.ExpectQuery(`SELECT id, status, quality, interval_days, learned_at FROM learning_logs`).
+	mock.ExpectQuery(`SELECT id, status, quality, interval_days, grader, learned_at FROM learning_logs`).
 		WithArgs(int64(42), "notebook", "2026-06-29").
-		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "quality", "interval_days", "learned_at"}).
-			AddRow(int64(7), "misunderstood", 1, 1, learnedAt))
-	mock.ExpectExec(`UPDATE learning_logs SET status = \$1, quality = \$2, interval_days = \$3 WHERE id = \$4`).
-		WithArgs("understood", 4, 3, int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "quality", "interval_days", "grader", "learned_at"}).
+			AddRow(int64(7), "misunderstood", 1, 1, "model", learnedAt))
+	mock.ExpectExec(`UPDATE learning_logs SET status = \$1, quality = \$2, interval_days = \$3, grader = \$4 WHERE id = \$5`).
+		WithArgs("understood", 4, 3, "deterministic", int64(7)).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	res, err := repo.UpdateLog(context.Background(), UpdateLogInput{
@@ -300,11 +335,13 @@ func TestDBLearningRepository_UpdateLog_Mirror(t *testing.T) {
 			Status:       "understood",
 			Quality:      4,
 			IntervalDays: 3,
+			Grader:       "deterministic",
 		},
 	})
 	require.NoError(t, err)
 	assert.True(t, res.Found)
 	assert.Equal(t, 3, res.NewIntervalDays, "MirrorValues overrides any markCorrect-derived interval")
+	assert.Equal(t, "deterministic", res.NewGrader)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -319,7 +356,7 @@ func TestDBLearningRepository_UpdateLog_NoMatch(t *testing.T) {
 	sqlxDB := sqlx.NewDb(db, "pgx")
 	repo := NewDBLearningRepository(sqlxDB)
 
-	mock.ExpectQuery(`SELECT id, status, quality, interval_days, learned_at FROM learning_logs`).
+	mock.ExpectQuery(`SELECT id, status, quality, interval_days, grader, learned_at FROM learning_logs`).
 		WithArgs(int64(42), "notebook", "2026-06-29").
 		WillReturnError(fmt.Errorf("sql: no rows in result set"))
 
@@ -334,3 +371,153 @@ func TestDBLearningRepository_UpdateLog_NoMatch(t *testing.T) {
 	assert.False(t, res.Found)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestDBLearningRepository_UpdateSkips(t *testing.T) {
+	skippedAt := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		in        UpdateSkipsInput
+		setupMock func(mock sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "skips every quiz type of the matching notes",
+			in: UpdateSkipsInput{
+				NotebookName: "idioms", Expression: "break the ice", Expressions: []string{"break the ice"},
+				QuizTypes: []notebook.QuizType{notebook.QuizTypeNotebook, notebook.QuizTypeReverse}, SkippedAt: skippedAt,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT DISTINCT n.id FROM notes n`).
+					WithArgs("idioms", "break the ice", "break the ice").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(42)))
+				mock.ExpectExec(`INSERT INTO learning_skips`).
+					WithArgs(int64(42), "idioms", "notebook", skippedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO learning_skips`).
+					WithArgs(int64(42), "idioms", "reverse", skippedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "resume deletes the skips and relearn pool entries",
+			in: UpdateSkipsInput{
+				NotebookName: "idioms", Expression: "break the ice", Expressions: []string{"break the ice"},
+				QuizTypes: []notebook.QuizType{notebook.QuizTypeNotebook},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT DISTINCT n.id FROM notes n`).
+					WithArgs("idioms", "break the ice", "break the ice").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(42)))
+				mock.ExpectExec(`DELETE FROM learning_skips WHERE note_id = \$1 AND notebook_id = \$2 AND quiz_type = \$3`).
+					WithArgs(int64(42), "idioms", "notebook").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM learning_relearns WHERE note_id = \$1 AND notebook_id = \$2 AND quiz_type = \$3`).
+					WithArgs(int64(42), "idioms", "notebook").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "no note in the notebook",
+			in: UpdateSkipsInput{
+				NotebookName: "idioms", Expression: "unknown", Expressions: []string{"unknown"},
+				QuizTypes: []notebook.QuizType{notebook.QuizTypeNotebook}, SkippedAt: skippedAt,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT DISTINCT n.id FROM notes n`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			repo := NewDBLearningRepository(sqlx.NewDb(db, "pgx"))
+			tt.setupMock(mock)
+
+			err = repo.UpdateSkips(context.Background(), tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDBLearningRepository_MoveToRelearnPool(t *testing.T) {
+	relearnAt := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		in        MoveToRelearnPoolInput
+		setupMock func(mock sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "upserts one row per quiz type",
+			in: MoveToRelearnPoolInput{
+				NotebookName: "idioms", Expression: "break the ice",
+				QuizTypes: []notebook.QuizType{notebook.QuizTypeNotebook, notebook.QuizTypeReverse}, RelearnAt: relearnAt,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT DISTINCT n.id FROM notes n`).
+					WithArgs("idioms", "break the ice", "break the ice").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(42)))
+				mock.ExpectExec(`INSERT INTO learning_relearns`).
+					WithArgs(int64(42), "idioms", "notebook", relearnAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO learning_relearns`).
+					WithArgs(int64(42), "idioms", "reverse", relearnAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "no note in the notebook",
+			in: MoveToRelearnPoolInput{
+				NotebookName: "idioms", Expression: "unknown",
+				QuizTypes: []notebook.QuizType{notebook.QuizTypeNotebook}, RelearnAt: relearnAt,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT DISTINCT n.id FROM notes n`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			repo := NewDBLearningRepository(sqlx.NewDb(db, "pgx"))
+			tt.setupMock(mock)
+
+			err = repo.MoveToRelearnPool(context.Background(), tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,13 +2,17 @@ package learning
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/versioning"
 )
 
 // YAMLLearningRepository reads learning history from YAML files and writes
@@ -17,6 +21,7 @@ type YAMLLearningRepository struct {
 	directory  string
 	outputDir  string
 	calculator notebook.IntervalCalculator
+	recorder   versioning.Recorder
 }
 
 // NewYAMLLearningRepository creates a new YAMLLearningRepository for reading.
@@ -24,12 +29,23 @@ func NewYAMLLearningRepository(directory string, calculator notebook.IntervalCal
 	if calculator == nil {
 		calculator = &notebook.SM2Calculator{}
 	}
-	return &YAMLLearningRepository{directory: directory, calculator: calculator}
+	return &YAMLLearningRepository{directory: directory, calculator: calculator, recorder: versioning.NopRecorder()}
 }
 
 // NewYAMLLearningRepositoryWriter creates a new YAMLLearningRepository for writing.
 func NewYAMLLearningRepositoryWriter(outputDir string) *YAMLLearningRepository {
-	return &YAMLLearningRepository{outputDir: outputDir}
+	return &YAMLLearningRepository{outputDir: outputDir, recorder: versioning.NopRecorder()}
+}
+
+// WithRecorder returns a copy of the repository that records each write
+// to the learning history (new answers and overrides) through recorder.
+func (r *YAMLLearningRepository) WithRecorder(recorder versioning.Recorder) *YAMLLearningRepository {
+	if recorder == nil {
+		recorder = versioning.NopRecorder()
+	}
+	cp := *r
+	cp.recorder = recorder
+	return &cp
 }
 
 // FindByNotebookID reads learning YAML files, filters by notebook ID, and
@@ -46,7 +62,7 @@ func (r *YAMLLearningRepository) FindByNotebookID(notebookID string) ([]notebook
 			if h.Metadata.NotebookID != notebookID {
 				continue
 			}
-			if h.Metadata.Type == "flashcard" {
+			if h.Metadata.Type == "flashcard" || h.Metadata.Type == grammarHistoryType {
 				result = append(result, h.Expressions...)
 				continue
 			}
@@ -59,8 +75,61 @@ func (r *YAMLLearningRepository) FindByNotebookID(notebookID string) ([]notebook
 	return result, nil
 }
 
+// grammarHistoryType is the Metadata.Type of a journal's flat grammar block,
+// whose entries are keyed by correction id instead of a vocabulary note.
+const grammarHistoryType = "grammar"
+
+// GrammarNotebookIDs lists the notebooks holding grammar (journal) history.
+// Their entries hang off correction ids, not vocabulary notes, so the DB
+// importer can't find them through the notes table and walks them here.
+func (r *YAMLLearningRepository) GrammarNotebookIDs() ([]string, error) {
+	histories, err := notebook.NewLearningHistories(r.directory)
+	if err != nil {
+		return nil, fmt.Errorf("load learning histories: %w", err)
+	}
+	seen := make(map[string]bool)
+	var ids []string
+	for _, fileHistories := range histories {
+		for _, h := range fileHistories {
+			if h.Metadata.Type != grammarHistoryType || seen[h.Metadata.NotebookID] {
+				continue
+			}
+			seen[h.Metadata.NotebookID] = true
+			ids = append(ids, h.Metadata.NotebookID)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
 // WriteAll converts learning logs to LearningHistory YAML files grouped by notebook.
 func (r *YAMLLearningRepository) WriteAll(notes []notebook.NoteRecord, logs []LearningLog) error {
+	historiesByNotebook := buildLearningHistories(notes, logs)
+	notebookIDs := make([]string, 0, len(historiesByNotebook))
+	for nbID := range historiesByNotebook {
+		notebookIDs = append(notebookIDs, nbID)
+	}
+	sort.Strings(notebookIDs)
+
+	for _, nbID := range notebookIDs {
+		dir := filepath.Join(r.outputDir, "learning_notes")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("create directory %s: %w", dir, err)
+		}
+
+		filePath := filepath.Join(dir, nbID+".yml")
+		if err := notebook.WriteYamlFile(filePath, historiesByNotebook[nbID]); err != nil {
+			return fmt.Errorf("write learning history %s: %w", nbID, err)
+		}
+	}
+
+	return nil
+}
+
+// buildLearningHistories converts learning logs to LearningHistory entries
+// keyed by notebook ID, the layout NewLearningHistories reads from YAML.
+// Notebooks without any history are omitted.
+func buildLearningHistories(notes []notebook.NoteRecord, logs []LearningLog) map[string][]notebook.LearningHistory {
 	noteByID := make(map[int64]*notebook.NoteRecord, len(notes))
 	for i := range notes {
 		noteByID[notes[i].ID] = &notes[i]
@@ -72,7 +141,15 @@ func (r *YAMLLearningRepository) WriteAll(notes []notebook.NoteRecord, logs []Le
 		notebookID string
 	}
 	logsByNoteNotebook := make(map[noteNotebook][]LearningLog)
+	// Grammar logs are keyed by correction id and have no notebook_notes
+	// row to place them, so they are written as each notebook's flat
+	// journal block instead.
+	grammarLogs := make(map[string][]LearningLog)
 	for _, log := range logs {
+		if log.CorrectionID != "" {
+			grammarLogs[log.SourceNotebookID] = append(grammarLogs[log.SourceNotebookID], log)
+			continue
+		}
 		key := noteNotebook{log.NoteID, log.SourceNotebookID}
 		logsByNoteNotebook[key] = append(logsByNoteNotebook[key], log)
 	}
@@ -100,8 +177,14 @@ func (r *YAMLLearningRepository) WriteAll(notes []notebook.NoteRecord, logs []Le
 		}
 	}
 
-	sort.Strings(notebookIDs)
+	for nbID := range grammarLogs {
+		if _, ok := notebookMap[nbID]; !ok {
+			notebookMap[nbID] = &notebookInfo{}
+			notebookIDs = append(notebookIDs, nbID)
+		}
+	}
 
+	result := make(map[string][]notebook.LearningHistory)
 	for _, nbID := range notebookIDs {
 		info := notebookMap[nbID]
 
@@ -125,30 +208,24 @@ func (r *YAMLLearningRepository) WriteAll(notes []notebook.NoteRecord, logs []Le
 
 		var histories []notebook.LearningHistory
 		if info.isFlashcard {
-			histories = r.buildFlashcardHistories(nbID, uniqueNoteIDs, noteByID, filteredLogs)
+			histories = buildFlashcardHistories(nbID, uniqueNoteIDs, noteByID, filteredLogs)
 		} else {
-			histories = r.buildStoryHistories(nbID, uniqueNoteIDs, noteByID, filteredLogs)
+			histories = buildStoryHistories(nbID, uniqueNoteIDs, noteByID, filteredLogs)
+		}
+		if grammar, ok := buildGrammarHistory(nbID, grammarLogs[nbID], noteByID); ok {
+			histories = append(histories, grammar)
 		}
 
 		if len(histories) == 0 {
 			continue
 		}
-
-		dir := filepath.Join(r.outputDir, "learning_notes")
-		if err := os.MkdirAll(dir, 0755); err != nil {
-			return fmt.Errorf("create directory %s: %w", dir, err)
-		}
-
-		filePath := filepath.Join(dir, nbID+".yml")
-		if err := notebook.WriteYamlFile(filePath, histories); err != nil {
-			return fmt.Errorf("write learning history %s: %w", nbID, err)
-		}
+		result[nbID] = histories
 	}
 
-	return nil
+	return result
 }
 
-func (r *YAMLLearningRepository) buildFlashcardHistories(
+func buildFlashcardHistories(
 	nbID string,
 	noteIDs []int64,
 	noteByID map[int64]*notebook.NoteRecord,
@@ -208,8 +285,7 @@ func (r *YAMLLearningRepository) buildFlashcardHistories(
 				logs = logsByNoteID[noteID]
 				logsClaimed[noteID] = true
 			}
-			expr := buildExpression(note.Entry, logs)
-			expressions = append(expressions, expr)
+			expressions = append(expressions, buildExpressions(note.Entry, logs)...)
 		}
 
 		histories = append(histories, notebook.LearningHistory{
@@ -225,7 +301,7 @@ func (r *YAMLLearningRepository) buildFlashcardHistories(
 	return histories
 }
 
-func (r *YAMLLearningRepository) buildStoryHistories(
+func buildStoryHistories(
 	nbID string,
 	noteIDs []int64,
 	noteByID map[int64]*notebook.NoteRecord,
@@ -311,8 +387,7 @@ func (r *YAMLLearningRepository) buildStoryHistories(
 					logs = logsByNoteID[noteID]
 					logsClaimed[noteID] = true
 				}
-				expr := buildExpression(note.Entry, logs)
-				expressions = append(expressions, expr)
+				expressions = append(expressions, buildExpressions(note.Entry, logs)...)
 			}
 
 			learningScenes = append(learningScenes, notebook.LearningScene{
@@ -335,6 +410,79 @@ func (r *YAMLLearningRepository) buildStoryHistories(
 	return histories
 }
 
+// buildGrammarHistory rebuilds a notebook's flat journal block from its
+// grammar logs, one entry per correction id.
+func buildGrammarHistory(nbID string, logs []LearningLog, noteByID map[int64]*notebook.NoteRecord) (notebook.LearningHistory, bool) {
+	if len(logs) == 0 {
+		return notebook.LearningHistory{}, false
+	}
+	byCorrection := make(map[string][]LearningLog)
+	var correctionIDs []string
+	for _, log := range logs {
+		if _, ok := byCorrection[log.CorrectionID]; !ok {
+			correctionIDs = append(correctionIDs, log.CorrectionID)
+		}
+		byCorrection[log.CorrectionID] = append(byCorrection[log.CorrectionID], log)
+	}
+	sort.Strings(correctionIDs)
+
+	expressions := make([]notebook.LearningHistoryExpression, 0, len(correctionIDs))
+	for _, correctionID := range correctionIDs {
+		entry := correctionID
+		if note := noteByID[byCorrection[correctionID][0].NoteID]; note != nil && note.Usage != "" {
+			entry = note.Usage
+		}
+		expr := buildExpression(entry, byCorrection[correctionID])
+		expr.ID = correctionID
+		expressions = append(expressions, expr)
+	}
+	return notebook.LearningHistory{
+		Metadata: notebook.LearningHistoryMetadata{
+			NotebookID: nbID,
+			Title:      notebook.JournalStoryTitle,
+			Type:       grammarHistoryType,
+		},
+		Expressions: expressions,
+	}, true
+}
+
+// buildExpressions rebuilds the expressions of one note. Logs keyed by an
+// etymology origin restore the origin entry they were quizzed under, one per
+// origin and sense, so the etymology quiz finds them again after a round
+// trip; every other log stays under the note's own entry.
+func buildExpressions(entry string, logs []LearningLog) []notebook.LearningHistoryExpression {
+	type originKey struct{ origin, sense string }
+	var entryLogs []LearningLog
+	logsByOrigin := make(map[originKey][]LearningLog)
+	for _, log := range logs {
+		if log.Origin == "" {
+			entryLogs = append(entryLogs, log)
+			continue
+		}
+		key := originKey{log.Origin, log.OriginSense}
+		logsByOrigin[key] = append(logsByOrigin[key], log)
+	}
+	origins := make([]originKey, 0, len(logsByOrigin))
+	for key := range logsByOrigin {
+		origins = append(origins, key)
+	}
+	sort.Slice(origins, func(i, j int) bool {
+		if origins[i].origin != origins[j].origin {
+			return origins[i].origin < origins[j].origin
+		}
+		return origins[i].sense < origins[j].sense
+	})
+
+	expressions := []notebook.LearningHistoryExpression{buildExpression(entry, entryLogs)}
+	for _, key := range origins {
+		expr := buildExpression(key.origin, logsByOrigin[key])
+		expr.Type = notebook.LearningExpressionTypeOrigin
+		expr.Sense = key.sense
+		expressions = append(expressions, expr)
+	}
+	return expressions
+}
+
 func buildExpression(
 	entry string,
 	logs []LearningLog,
@@ -347,13 +495,17 @@ func buildExpression(
 	// into learned_logs (e.g. gauche with 1 source learned log + 7
 	// etymology logs exported as 8 learned logs). Match each YAML slot
 	// to the quiz_type values that get stored there at import time.
-	var learnedLogs, reverseLogs, originLogs []LearningLog
+	var learnedLogs, reverseLogs, originLogs, dictationLogs, wordChoiceLogs []LearningLog
 	for _, log := range logs {
 		switch log.QuizType {
 		case string(notebook.QuizTypeReverse):
 			reverseLogs = append(reverseLogs, log)
 		case string(notebook.QuizTypeEtymologyOrigin):
 			originLogs = append(originLogs, log)
+		case string(notebook.QuizTypeDictation):
+			dictationLogs = append(dictationLogs, log)
+		case string(notebook.QuizTypeWordChoice):
+			wordChoiceLogs = append(wordChoiceLogs, log)
 		default:
 			// notebook (standard) and freeform (vocabulary, not
 			// etymology) land in learned_logs in the YAML convention.
@@ -370,13 +522,18 @@ func buildExpression(
 	sortDescByLearnedAt(learnedLogs)
 	sortDescByLearnedAt(reverseLogs)
 	sortDescByLearnedAt(originLogs)
+	sortDescByLearnedAt(dictationLogs)
+	sortDescByLearnedAt(wordChoiceLogs)
 
-	return notebook.LearningHistoryExpression{
+	expr := notebook.LearningHistoryExpression{
 		Expression:          entry,
 		LearnedLogs:         convertToRecords(learnedLogs),
 		ReverseLogs:         convertToRecords(reverseLogs),
 		EtymologyOriginLogs: convertToRecords(originLogs),
+		DictationLogs:       convertToRecords(dictationLogs),
+		WordChoiceLogs:      convertToRecords(wordChoiceLogs),
 	}
+	return expr
 }
 
 func convertToRecords(logs []LearningLog) []notebook.LearningRecord {
@@ -392,6 +549,9 @@ func convertToRecords(logs []LearningLog) []notebook.LearningRecord {
 			ResponseTimeMs: int64(log.ResponseTimeMs),
 			QuizType:       log.QuizType,
 			IntervalDays:   log.IntervalDays,
+			Answer:         log.Answer,
+			GraderReason:   log.GraderReason,
+			Grader:         log.Grader,
 		}
 	}
 	return records
@@ -406,7 +566,8 @@ func (r *YAMLLearningRepository) Create(_ context.Context, log *LearningLog) err
 	if err != nil {
 		return fmt.Errorf("load learning histories: %w", err)
 	}
-	updater := notebook.NewLearningHistoryUpdater(learningHistories[log.NotebookName], r.calculator)
+	updater := notebook.NewLearningHistoryUpdater(learningHistories[log.NotebookName], r.calculator).
+		WithAnswer(notebook.LogAnswer{Answer: log.Answer, GraderReason: log.GraderReason, Grader: log.Grader})
 	quizType := notebook.QuizType(log.QuizType)
 	// Freeform requires active recall of both the word and its meaning, so a
 	// correct answer means the user can actually use the word, not just
@@ -426,6 +587,14 @@ func (r *YAMLLearningRepository) Create(_ context.Context, log *LearningLog) err
 	if err := notebook.WriteYamlFile(notePath, updater.GetHistory()); err != nil {
 		return fmt.Errorf("write learning history for %q: %w", log.NotebookName, err)
 	}
+	result := "wrong"
+	if log.IsCorrect {
+		result = "correct"
+	}
+	message := fmt.Sprintf("Record %s answer for %q in %s (%s)", log.QuizType, log.Expression, log.NotebookName, result)
+	if err := r.recorder.Record(message, notePath); err != nil {
+		return fmt.Errorf("record learning history change for %q: %w", log.NotebookName, err)
+	}
 	return nil
 }
 
@@ -461,6 +630,7 @@ func (r *YAMLLearningRepository) UpdateLog(_ context.Context, in UpdateLogInput)
 			OriginalQuality:      in.MirrorValues.Quality,
 			OriginalStatus:       in.MirrorValues.Status,
 			OriginalIntervalDays: in.MirrorValues.IntervalDays,
+			OriginalGrader:       in.MirrorValues.Grader,
 		})
 		if !undo.Found {
 			return UpdateLogResult{}, nil
@@ -493,6 +663,9 @@ func (r *YAMLLearningRepository) UpdateLog(_ context.Context, in UpdateLogInput)
 	if err := notebook.WriteYamlFile(notePath, updater.GetHistory()); err != nil {
 		return UpdateLogResult{}, fmt.Errorf("write learning history for %q: %w", in.NotebookName, err)
 	}
+	if err := r.recorder.Record(overrideMessage(in), notePath); err != nil {
+		return UpdateLogResult{}, fmt.Errorf("record learning history change for %q: %w", in.NotebookName, err)
+	}
 	// Read back the just-written entry so the caller can mirror the
 	// exact bytes onto the secondary store. The updater's result
 	// already carries originals; we re-resolve the expression to read
@@ -503,7 +676,7 @@ func (r *YAMLLearningRepository) UpdateLog(_ context.Context, in UpdateLogInput)
 	} else {
 		expr = updater.FindExpressionByID(in.ID, in.Expression, in.OriginalExpression)
 	}
-	var newStatus string
+	var newStatus, newGrader string
 	var newQuality, newInterval int
 	if expr != nil {
 		logs := expr.GetLogsForQuizType(notebook.QuizType(in.QuizType))
@@ -513,6 +686,7 @@ func (r *YAMLLearningRepository) UpdateLog(_ context.Context, in UpdateLogInput)
 				newStatus = string(l.Status)
 				newQuality = l.Quality
 				newInterval = l.IntervalDays
+				newGrader = l.Grader
 				break
 			}
 		}
@@ -525,10 +699,26 @@ func (r *YAMLLearningRepository) UpdateLog(_ context.Context, in UpdateLogInput)
 		NewStatus:            newStatus,
 		NewIntervalDays:      newInterval,
 		NewNextReviewDate:    res.NewNextReviewDate,
+		OriginalGrader:       res.OriginalGrader,
+		NewGrader:            newGrader,
 		Found:                true,
 	}, nil
 }
 
+// overrideMessage describes an UpdateLog call as a history commit subject.
+func overrideMessage(in UpdateLogInput) string {
+	switch {
+	case in.MirrorValues != nil:
+		return fmt.Sprintf("Undo override of %s answer for %q in %s", in.QuizType, in.Expression, in.NotebookName)
+	case in.MarkCorrect != nil && *in.MarkCorrect:
+		return fmt.Sprintf("Override %s answer for %q in %s as correct", in.QuizType, in.Expression, in.NotebookName)
+	case in.MarkCorrect != nil:
+		return fmt.Sprintf("Override %s answer for %q in %s as wrong", in.QuizType, in.Expression, in.NotebookName)
+	default:
+		return fmt.Sprintf("Update %s answer for %q in %s", in.QuizType, in.Expression, in.NotebookName)
+	}
+}
+
 // formatLearnedAt picks the same string format the updater's
 // indexLogByLearnedAt accepts. Prefers RFC3339 so micro-second-precise
 // timestamps round-trip; falls back to YYYY-MM-DD for older logs the
@@ -543,6 +733,91 @@ func formatLearnedAt(t time.Time) string {
 	return t.Format(time.RFC3339)
 }
 
+// UpdateSkips writes the skips (or, for a zero in.SkippedAt, clears them
+// along with the relearn pool entries) on the notebook's learning history
+// file. Only that file is read and
+// written, keeping the Skip/Resume RPCs off the full-directory load.
+//
+// Skipping an expression with no learning history yet seeds a stub entry
+// for it — SetSkippedAt needs an entry to attach to, but a skip must not
+// invent a review log.
+func (r *YAMLLearningRepository) UpdateSkips(_ context.Context, in UpdateSkipsInput) error {
+	notePath := filepath.Join(r.directory, in.NotebookName+".yml")
+	history, err := notebook.ReadLearningHistoryFile(notePath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("load learning history for %q: %w", in.NotebookName, err)
+	}
+
+	updater := notebook.NewLearningHistoryUpdater(history, r.calculator)
+	action := "Resume"
+	if in.SkippedAt.IsZero() {
+		for _, expr := range in.Expressions {
+			for _, qt := range in.QuizTypes {
+				updater.ClearSkippedAt(expr, "", qt)
+				updater.ClearRelearnAt(expr, "", qt)
+			}
+		}
+	} else {
+		action = "Skip"
+		for _, expr := range in.Expressions {
+			updater.EnsureExpressionStubForSkip(in.NotebookName, in.StoryTitle, in.SceneTitle, expr, "")
+		}
+		skippedAt := in.SkippedAt.Format(time.RFC3339)
+		for _, expr := range in.Expressions {
+			for _, qt := range in.QuizTypes {
+				if !updater.SetSkippedAt(expr, "", qt, skippedAt) {
+					return fmt.Errorf("record skip for expression %q (%s) in notebook %q", expr, qt, in.NotebookName)
+				}
+			}
+		}
+	}
+
+	if err := notebook.WriteYamlFile(notePath, updater.GetHistory()); err != nil {
+		return fmt.Errorf("write learning history for %q: %w", in.NotebookName, err)
+	}
+	message := fmt.Sprintf("%s %q in %s for %s", action, in.Expression, in.NotebookName, joinQuizTypes(in.QuizTypes))
+	if err := r.recorder.Record(message, notePath); err != nil {
+		return fmt.Errorf("record learning history change for %q: %w", in.NotebookName, err)
+	}
+	return nil
+}
+
+// MoveToRelearnPool writes the relearn pool entries on the notebook's
+// learning history file.
+func (r *YAMLLearningRepository) MoveToRelearnPool(_ context.Context, in MoveToRelearnPoolInput) error {
+	notePath := filepath.Join(r.directory, in.NotebookName+".yml")
+	history, err := notebook.ReadLearningHistoryFile(notePath)
+	if err != nil {
+		return fmt.Errorf("load learning history for %q: %w", in.NotebookName, err)
+	}
+
+	updater := notebook.NewLearningHistoryUpdater(history, r.calculator)
+	relearnAt := in.RelearnAt.Format(time.RFC3339)
+	for _, qt := range in.QuizTypes {
+		if !updater.SetRelearnAt(in.Expression, in.ID, qt, relearnAt) {
+			return fmt.Errorf("move expression %q (%s) in notebook %q to the relearn pool", in.Expression, qt, in.NotebookName)
+		}
+	}
+
+	if err := notebook.WriteYamlFile(notePath, updater.GetHistory()); err != nil {
+		return fmt.Errorf("write learning history for %q: %w", in.NotebookName, err)
+	}
+	message := fmt.Sprintf("Move %q in %s to the relearn pool for %s", in.Expression, in.NotebookName, joinQuizTypes(in.QuizTypes))
+	if err := r.recorder.Record(message, notePath); err != nil {
+		return fmt.Errorf("record learning history change for %q: %w", in.NotebookName, err)
+	}
+	return nil
+}
+
+// joinQuizTypes renders quiz types for a history commit message.
+func joinQuizTypes(quizTypes []notebook.QuizType) string {
+	names := make([]string, len(quizTypes))
+	for i, qt := range quizTypes {
+		names[i] = string(qt)
+	}
+	return strings.Join(names, ", ")
+}
+
 func (r *YAMLLearningRepository) FindAll(_ context.Context) ([]LearningLog, error) {
 	return nil, fmt.Errorf("FindAll is not supported for YAML learning repository")
 }
@@ -667,3 +667,151 @@ func TestYAMLLearningRepository_WriteAll_RoutesQuizTypesToCorrectSlots(t *testin
 	assert.Len(t, expr.EtymologyOriginLogs, 1,
 		"etymology_origin quiz_type → EtymologyOriginLogs")
 }
+
+func TestYAMLLearningRepository_WriteAll_GrammarAndOriginLogs(t *testing.T) {
+	baseTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	notes := []notebook.NoteRecord{
+		{
+			ID:    1,
+			Entry: "pathology",
+			NotebookNotes: []notebook.NotebookNote{
+				{NotebookType: "story", NotebookID: "roots", Group: "Session 1", Subgroup: "pathos"},
+			},
+		},
+		// Auto note created for a correction id; it has no notebook_notes.
+		{ID: 2, Usage: "c-1", Entry: "c-1"},
+	}
+	logs := []LearningLog{
+		// The origin log comes first: it must not pull the word's own logs
+		// under the origin.
+		{NoteID: 1, Status: "understood", LearnedAt: baseTime, QuizType: "etymology_origin", SourceNotebookID: "roots", Origin: "pathos", OriginSense: "feeling"},
+		{NoteID: 1, Status: "understood", LearnedAt: baseTime, QuizType: "notebook", SourceNotebookID: "roots"},
+		{NoteID: 1, Status: "misunderstood", LearnedAt: baseTime, QuizType: "reverse", SourceNotebookID: "roots"},
+		{NoteID: 2, Status: "misunderstood", LearnedAt: baseTime, QuizType: "grammar", SourceNotebookID: "diary", CorrectionID: "c-1"},
+		{NoteID: 2, Status: "understood", LearnedAt: baseTime.Add(time.Hour), QuizType: "grammar", SourceNotebookID: "diary", CorrectionID: "c-1"},
+	}
+
+	outputDir := t.TempDir()
+	require.NoError(t, NewYAMLLearningRepositoryWriter(outputDir).WriteAll(notes, logs))
+
+	var roots []notebook.LearningHistory
+	readYAMLHelper(t, filepath.Join(outputDir, "learning_notes", "roots.yml"), &roots)
+	require.Len(t, roots, 1)
+	require.Len(t, roots[0].Scenes, 1)
+	require.Len(t, roots[0].Scenes[0].Expressions, 2)
+	word := roots[0].Scenes[0].Expressions[0]
+	assert.Equal(t, "pathology", word.Expression)
+	assert.Empty(t, word.Type)
+	assert.Len(t, word.LearnedLogs, 1)
+	assert.Len(t, word.ReverseLogs, 1)
+	assert.Empty(t, word.EtymologyOriginLogs)
+	origin := roots[0].Scenes[0].Expressions[1]
+	assert.Equal(t, "pathos", origin.Expression)
+	assert.Equal(t, notebook.LearningExpressionTypeOrigin, origin.Type)
+	assert.Equal(t, "feeling", origin.Sense)
+	assert.Len(t, origin.EtymologyOriginLogs, 1)
+	assert.Empty(t, origin.LearnedLogs)
+
+	var diary []notebook.LearningHistory
+	readYAMLHelper(t, filepath.Join(outputDir, "learning_notes", "diary.yml"), &diary)
+	require.Len(t, diary, 1)
+	assert.Equal(t, "grammar", diary[0].Metadata.Type)
+	assert.Equal(t, notebook.JournalStoryTitle, diary[0].Metadata.Title)
+	require.Len(t, diary[0].Expressions, 1)
+	assert.Equal(t, "c-1", diary[0].Expressions[0].ID)
+	assert.Len(t, diary[0].Expressions[0].LearnedLogs, 2)
+
+	// The exported grammar block is found again on the next import.
+	repo := NewYAMLLearningRepository(filepath.Join(outputDir, "learning_notes"), nil)
+	ids, err := repo.GrammarNotebookIDs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"diary"}, ids)
+	exprs, err := repo.FindByNotebookID("diary")
+	require.NoError(t, err)
+	require.Len(t, exprs, 1)
+	assert.Equal(t, "c-1", exprs[0].ID)
+}
+
+func TestYAMLLearningRepository_UpdateSkips(t *testing.T) {
+	dir := t.TempDir()
+	repo := NewYAMLLearningRepository(dir, nil)
+	in := UpdateSkipsInput{
+		NotebookName: "idioms",
+		StoryTitle:   "Common",
+		Expression:   "break the ice",
+		Expressions:  []string{"break the ice"},
+		QuizTypes:    []notebook.QuizType{notebook.QuizTypeNotebook, notebook.QuizTypeReverse},
+		SkippedAt:    time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC),
+	}
+	readExpression := func() notebook.LearningHistoryExpression {
+		t.Helper()
+		histories, err := notebook.ReadLearningHistoryFile(filepath.Join(dir, "idioms.yml"))
+		require.NoError(t, err)
+		require.Len(t, histories, 1)
+		require.Len(t, histories[0].Expressions, 1)
+		return histories[0].Expressions[0]
+	}
+
+	require.NoError(t, repo.UpdateSkips(t.Context(), in))
+	expr := readExpression()
+	assert.Empty(t, expr.LearnedLogs, "a skip must not invent a review log")
+	assert.True(t, expr.SkippedAt.IsSkipped(notebook.QuizTypeNotebook))
+	assert.True(t, expr.SkippedAt.IsSkipped(notebook.QuizTypeReverse))
+
+	in.SkippedAt = time.Time{}
+	in.QuizTypes = []notebook.QuizType{notebook.QuizTypeReverse}
+	require.NoError(t, repo.UpdateSkips(t.Context(), in))
+	expr = readExpression()
+	assert.True(t, expr.SkippedAt.IsSkipped(notebook.QuizTypeNotebook), "resume leaves other quiz types skipped")
+	assert.False(t, expr.SkippedAt.IsSkipped(notebook.QuizTypeReverse))
+}
+
+func TestYAMLLearningRepository_MoveToRelearnPool(t *testing.T) {
+	dir := t.TempDir()
+	repo := NewYAMLLearningRepository(dir, nil)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "idioms.yml"), []byte(`- metadata:
+    id: idioms
+    title: Common
+    type: flashcard
+  expressions:
+    - expression: break the ice
+      learned_logs:
+        - status: misunderstood
+          learned_at: "2026-06-01"
+`), 0o644))
+	readExpression := func() notebook.LearningHistoryExpression {
+		t.Helper()
+		histories, err := notebook.ReadLearningHistoryFile(filepath.Join(dir, "idioms.yml"))
+		require.NoError(t, err)
+		return histories[0].Expressions[0]
+	}
+
+	require.NoError(t, repo.MoveToRelearnPool(t.Context(), MoveToRelearnPoolInput{
+		NotebookName: "idioms",
+		Expression:   "break the ice",
+		QuizTypes:    []notebook.QuizType{notebook.QuizTypeNotebook, notebook.QuizTypeReverse},
+		RelearnAt:    time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC),
+	}))
+	expr := readExpression()
+	assert.True(t, expr.InRelearnPool(notebook.QuizTypeNotebook))
+	assert.True(t, expr.InRelearnPool(notebook.QuizTypeReverse))
+
+	require.NoError(t, repo.UpdateSkips(t.Context(), UpdateSkipsInput{
+		NotebookName: "idioms",
+		Expression:   "break the ice",
+		Expressions:  []string{"break the ice"},
+		QuizTypes:    []notebook.QuizType{notebook.QuizTypeReverse},
+	}))
+	expr = readExpression()
+	assert.True(t, expr.InRelearnPool(notebook.QuizTypeNotebook))
+	assert.False(t, expr.InRelearnPool(notebook.QuizTypeReverse), "resuming a quiz type leaves its relearn pool")
+
+	err := repo.MoveToRelearnPool(t.Context(), MoveToRelearnPoolInput{
+		NotebookName: "idioms",
+		Expression:   "unknown",
+		QuizTypes:    []notebook.QuizType{notebook.QuizTypeNotebook},
+		RelearnAt:    time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,120 @@
+// Package lemma reduces English words to the base forms they may be
+// inflections of, so the notebook search and the frequency ranks match
+// "went" to "go" and "cities" to "city".
+package lemma
+
+import "strings"
+
+// minStem is the shortest stem a suffix is stripped down to, so short
+// words like "bed" or "sing" aren't read as inflections of "b" or "s".
+// Words that are no longer than it are never stripped.
+const minStem = 3
+
+// Lemmas returns the lowercased word followed by every base form it may be
+// an inflection of: plurals and third person ("watches" -> "watch",
+// "cities" -> "city"), past tenses ("liked" -> "like", "stopped" -> "stop"),
+// -ing forms ("running" -> "run") and, last, irregular forms ("went" ->
+// "go", "lives" -> "live" and "life").
+// Two words are taken as forms of the same word when their lemmas overlap.
+// The rules are a heuristic over English spelling, not a dictionary, so
+// the candidates include non-words such as "watche".
+func Lemmas(word string) []string {
+	word = strings.ToLower(strings.ReplaceAll(word, "’", "'"))
+	word = strings.TrimSuffix(word, "'s")
+	word = strings.Trim(word, "'")
+	if word == "" {
+		return nil
+	}
+	lemmas := []string{word}
+	seen := map[string]bool{word: true}
+	add := func(lemma string) {
+		if len(lemma) < minStem || seen[lemma] {
+			return
+		}
+		seen[lemma] = true
+		lemmas = append(lemmas, lemma)
+	}
+	if len(word) > minStem {
+		for _, rule := range suffixRules {
+			stem, ok := strings.CutSuffix(word, rule.suffix)
+			if !ok || strings.HasSuffix(stem, "s") && rule.suffix == "s" {
+				// "glass" and "less" aren't plurals.
+				continue
+			}
+			if rule.replacement != "" {
+				add(stem + rule.replacement)
+				continue
+			}
+			add(stem)
+			if !rule.verbal {
+				continue
+			}
+			add(stem + "e")
+			if n := len(stem); n >= 2 && stem[n-1] == stem[n-2] && !isVowel(stem[n-1]) {
+				add(stem[:n-1])
+			}
+		}
+	}
+	// An irregular form can be a regular one too: "lives" is also a form
+	// of "live", and "left" and "saw" are words of their own.
+	if lemma, ok := irregularLemmas[word]; ok && !seen[lemma] {
+		lemmas = append(lemmas, lemma)
+	}
+	return lemmas
+}
+
+// suffixRules strip an inflectional suffix, replacing it when replacement
+// is set. For the verbal -ed and -ing, the stem plus "e" ("liked") and the
+// stem without a doubled final consonant ("stopped") are candidates too.
+var suffixRules = []struct {
+	suffix      string
+	replacement string
+	verbal      bool
+}{
+	{suffix: "ies", replacement: "y"},
+	{suffix: "ied", replacement: "y"},
+	{suffix: "ier", replacement: "y"},
+	{suffix: "iest", replacement: "y"},
+	{suffix: "es"},
+	{suffix: "s"},
+	{suffix: "ed", verbal: true},
+	{suffix: "ing", verbal: true},
+}
+
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiou", b) >= 0
+}
+
+// irregularLemmas maps the irregular forms of common English words to their
+// base form.
+var irregularLemmas = map[string]string{
+	"am": "be", "is": "be", "are": "be", "was": "be", "were": "be", "been": "be", "being": "be",
+	"has": "have", "had": "have",
+	"does": "do", "did": "do", "done": "do",
+	"went": "go", "gone": "go", "goes": "go", "going": "go", "doing": "do",
+	"dying": "die", "lying": "lie", "tying": "tie",
+	"got": "get", "gotten": "get",
+	"made": "make", "took": "take", "taken": "take",
+	"came": "come", "saw": "see", "seen": "see",
+	"knew": "know", "known": "know", "gave": "give", "given": "give",
+	"found": "find", "thought": "think", "told": "tell", "said": "say",
+	"became": "become", "left": "leave", "felt": "feel", "brought": "bring",
+	"began": "begin", "begun": "begin", "kept": "keep", "held": "hold",
+	"wrote": "write", "written": "write", "stood": "stand", "heard": "hear",
+	"meant": "mean", "met": "meet", "ran": "run", "paid": "pay", "sat": "sit",
+	"spoke": "speak", "spoken": "speak", "led": "lead", "grew": "grow", "grown": "grow",
+	"lost": "lose", "fell": "fall", "fallen": "fall", "sent": "send", "built": "build",
+	"understood": "understand", "drew": "draw", "drawn": "draw",
+	"broke": "break", "broken": "break", "spent": "spend", "rose": "rise", "risen": "rise",
+	"drove": "drive", "driven": "drive", "bought": "buy", "wore": "wear", "worn": "wear",
+	"chose": "choose", "chosen": "choose", "sought": "seek", "threw": "throw", "thrown": "throw",
+	"caught": "catch", "dealt": "deal", "won": "win", "forgot": "forget", "forgotten": "forget",
+	"sold": "sell", "fought": "fight", "taught": "teach", "ate": "eat", "eaten": "eat",
+	"sang": "sing", "sung": "sing", "swam": "swim", "swum": "swim", "flew": "fly", "flown": "fly",
+	"slept": "sleep", "woke": "wake", "woken": "wake", "hid": "hide", "hidden": "hide",
+	"bit": "bite", "bitten": "bite", "shook": "shake", "shaken": "shake",
+	"stole": "steal", "stolen": "steal", "froze": "freeze", "frozen": "freeze",
+	"men": "man", "women": "woman", "children": "child", "feet": "foot",
+	"teeth": "tooth", "mice": "mouse", "people": "person", "lives": "life",
+	"better": "good", "best": "good", "worse": "bad", "worst": "bad",
+}
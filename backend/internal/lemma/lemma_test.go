@@ -0,0 +1,33 @@
+package lemma
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLemmas(t *testing.T) {
+	tests := []struct {
+		word string
+		want []string
+	}{
+		{word: "Went", want: []string{"went", "go"}},
+		{word: "lives", want: []string{"lives", "liv", "live", "life"}},
+		{word: "saw", want: []string{"saw", "see"}},
+		{word: "left", want: []string{"left", "leave"}},
+		{word: "cities", want: []string{"cities", "city", "citi", "citie"}},
+		{word: "watches", want: []string{"watches", "watch", "watche"}},
+		{word: "liked", want: []string{"liked", "lik", "like"}},
+		{word: "glass", want: []string{"glass"}},
+		{word: "stopped", want: []string{"stopped", "stopp", "stoppe", "stop"}},
+		{word: "running", want: []string{"running", "runn", "runne", "run"}},
+		{word: "Tom’s", want: []string{"tom"}},
+		{word: "bed", want: []string{"bed"}},
+		{word: "'", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			assert.Equal(t, tt.want, Lemmas(tt.word))
+		})
+	}
+}
@@ -56,6 +56,21 @@ func (mr *MockClientMockRecorder) AnswerMeanings(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnswerMeanings", reflect.TypeOf((*MockClient)(nil).AnswerMeanings), ctx, params)
 }
 
+// GradeWordChoice mocks base method.
+func (m *MockClient) GradeWordChoice(ctx context.Context, params inference.GradeWordChoiceRequest) (inference.GradeWordChoiceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GradeWordChoice", ctx, params)
+	ret0, _ := ret[0].(inference.GradeWordChoiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GradeWordChoice indicates an expected call of GradeWordChoice.
+func (mr *MockClientMockRecorder) GradeWordChoice(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GradeWordChoice", reflect.TypeOf((*MockClient)(nil).GradeWordChoice), ctx, params)
+}
+
 // LookupWord mocks base method.
 func (m *MockClient) LookupWord(ctx context.Context, params inference.LookupWordRequest) (inference.LookupWordResponse, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +101,36 @@ func (mr *MockClientMockRecorder) GradeCorrection(ctx, params any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GradeCorrection", reflect.TypeOf((*MockClient)(nil).GradeCorrection), ctx, params)
 }
 
+// GenerateExamples mocks base method.
+func (m *MockClient) GenerateExamples(ctx context.Context, params inference.GenerateExamplesRequest) (inference.GenerateExamplesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateExamples", ctx, params)
+	ret0, _ := ret[0].(inference.GenerateExamplesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateExamples indicates an expected call of GenerateExamples.
+func (mr *MockClientMockRecorder) GenerateExamples(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateExamples", reflect.TypeOf((*MockClient)(nil).GenerateExamples), ctx, params)
+}
+
+// GenerateMnemonic mocks base method.
+func (m *MockClient) GenerateMnemonic(ctx context.Context, params inference.GenerateMnemonicRequest) (inference.GenerateMnemonicResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateMnemonic", ctx, params)
+	ret0, _ := ret[0].(inference.GenerateMnemonicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateMnemonic indicates an expected call of GenerateMnemonic.
+func (mr *MockClientMockRecorder) GenerateMnemonic(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateMnemonic", reflect.TypeOf((*MockClient)(nil).GenerateMnemonic), ctx, params)
+}
+
 // ValidateWordForm mocks base method.
 func (m *MockClient) ValidateWordForm(ctx context.Context, params inference.ValidateWordFormRequest) (inference.ValidateWordFormResponse, error) {
 	m.ctrl.T.Helper()
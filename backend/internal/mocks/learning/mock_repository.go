@@ -98,6 +98,20 @@ func (mr *MockLearningRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockLearningRepository)(nil).FindAll), ctx)
 }
 
+// MoveToRelearnPool mocks base method.
+func (m *MockLearningRepository) MoveToRelearnPool(ctx context.Context, in learning.MoveToRelearnPoolInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToRelearnPool", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToRelearnPool indicates an expected call of MoveToRelearnPool.
+func (mr *MockLearningRepositoryMockRecorder) MoveToRelearnPool(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToRelearnPool", reflect.TypeOf((*MockLearningRepository)(nil).MoveToRelearnPool), ctx, in)
+}
+
 // UpdateLog mocks base method.
 func (m *MockLearningRepository) UpdateLog(ctx context.Context, in learning.UpdateLogInput) (learning.UpdateLogResult, error) {
 	m.ctrl.T.Helper()
@@ -112,3 +126,17 @@ func (mr *MockLearningRepositoryMockRecorder) UpdateLog(ctx, in any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLog", reflect.TypeOf((*MockLearningRepository)(nil).UpdateLog), ctx, in)
 }
+
+// UpdateSkips mocks base method.
+func (m *MockLearningRepository) UpdateSkips(ctx context.Context, in learning.UpdateSkipsInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSkips", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSkips indicates an expected call of UpdateSkips.
+func (mr *MockLearningRepositoryMockRecorder) UpdateSkips(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSkips", reflect.TypeOf((*MockLearningRepository)(nil).UpdateSkips), ctx, in)
+}
@@ -0,0 +1,178 @@
+package notebook
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// audioMediaTypes are the audio formats a note's `audio` file can be in.
+var audioMediaTypes = map[string]string{
+	".wav":  "audio/wav",
+	".mp3":  "audio/mpeg",
+	".m4a":  "audio/mp4",
+	".ogg":  "audio/ogg",
+	".opus": "audio/ogg",
+	".flac": "audio/flac",
+}
+
+// AudioMediaType returns the media type of an audio file by its extension,
+// or "" when it isn't a supported audio format.
+func AudioMediaType(name string) string {
+	return audioMediaTypes[strings.ToLower(filepath.Ext(name))]
+}
+
+// ResolveAudioPath returns the file a note's `audio` refers to. The path is
+// relative to notebookDir, the directory of the notebook's index.yml, and
+// may not leave it.
+func ResolveAudioPath(notebookDir, audio string) (string, error) {
+	if audio == "" {
+		return "", fmt.Errorf("no audio")
+	}
+	cleaned := path.Clean(filepath.ToSlash(audio))
+	if path.IsAbs(cleaned) || filepath.IsAbs(audio) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("audio %s must be relative to the notebook directory", audio)
+	}
+	if AudioMediaType(cleaned) == "" {
+		return "", fmt.Errorf("audio %s is not a supported audio file", audio)
+	}
+	return filepath.Join(notebookDir, filepath.FromSlash(cleaned)), nil
+}
+
+// NotebookDirectory returns the directory of the index.yml of the story,
+// book or flashcard notebook with id.
+func (f Reader) NotebookDirectory(id string) (string, bool) {
+	if index, ok := f.indexes[id]; ok && index.Path != "" {
+		return index.Path, true
+	}
+	if index, ok := f.flashcardIndexes[id]; ok && index.Path != "" {
+		return index.Path, true
+	}
+	return "", false
+}
+
+// DefinitionsBookPath returns the index directory of the definitions book
+// with id, or the file of a standalone one.
+func (f Reader) DefinitionsBookPath(id string) (string, bool) {
+	path, ok := f.definitionsPaths[id]
+	return path, ok && path != ""
+}
+
+// AudioDirectories returns the directories the audio of a note of the
+// notebook with id is relative to: the directory of the notebook's index.yml
+// and that of the definitions book with id. A definitions book annotating a
+// book shares its id, so a note's audio may be under either.
+func (f Reader) AudioDirectories(id string) []string {
+	var dirs []string
+	if dir, ok := f.NotebookDirectory(id); ok {
+		dirs = append(dirs, dir)
+	}
+	if path, ok := f.DefinitionsBookPath(id); ok {
+		if filepath.Ext(path) == ".yml" {
+			path = filepath.Dir(path)
+		}
+		if !slices.Contains(dirs, path) {
+			dirs = append(dirs, path)
+		}
+	}
+	return dirs
+}
+
+// ReadAudio returns the content and media type of the audio a note of the
+// notebook with id refers to.
+func (f Reader) ReadAudio(id, audio string) ([]byte, string, error) {
+	dirs := f.AudioDirectories(id)
+	if len(dirs) == 0 {
+		return nil, "", fmt.Errorf("notebook %s not found", id)
+	}
+	for i, dir := range dirs {
+		audioPath, err := ResolveAudioPath(dir, audio)
+		if err != nil {
+			return nil, "", err
+		}
+		data, err := os.ReadFile(audioPath)
+		if os.IsNotExist(err) && i < len(dirs)-1 {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("read audio: %w", err)
+		}
+		return data, AudioMediaType(audioPath), nil
+	}
+	return nil, "", fmt.Errorf("notebook %s not found", id)
+}
+
+// AudioEntry is a source vocabulary entry that has no audio yet.
+type AudioEntry struct {
+	ID         string
+	Expression string
+	Definition string
+}
+
+// Text returns what is spoken for the entry: the dictionary form when the
+// entry has one, else the expression.
+func (e AudioEntry) Text() string {
+	if e.Definition != "" {
+		return e.Definition
+	}
+	return e.Expression
+}
+
+// Key returns the name the entry's audio is cached under: its id, or the
+// slug of its text for entries without one.
+func (e AudioEntry) Key() string {
+	if e.ID != "" {
+		return e.ID
+	}
+	return Slugify(e.Text())
+}
+
+// AddAudioToSourceYAML calls audioFor for every vocabulary entry of one
+// source-notebook file that lacks an `audio` key, and inserts the returned
+// path as `audio:` after the entry's expression. Entries audioFor returns
+// "" for are left unchanged. Like AddIDsToSourceYAML the edit is add-only:
+// the new lines are spliced into the original text, and the original bytes
+// are returned when nothing is added.
+func AddAudioToSourceYAML(data []byte, audioFor func(AudioEntry) (string, error)) ([]byte, int, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, 0, fmt.Errorf("unmarshal source yaml: %w", err)
+	}
+
+	var plan []lineInsertion
+	for _, m := range collectEntryMappings(&doc) {
+		if mappingHasKey(m, "audio") {
+			continue
+		}
+		exprKey := mappingKeyNode(m, "expression")
+		if exprKey == nil {
+			continue
+		}
+		entry := AudioEntry{
+			ID:         mappingScalarValue(m, "id"),
+			Expression: strings.TrimSpace(mappingScalarValue(m, "expression")),
+			Definition: strings.TrimSpace(mappingScalarValue(m, "definition")),
+		}
+		if entry.Key() == "" {
+			continue
+		}
+		audio, err := audioFor(entry)
+		if err != nil {
+			return nil, 0, err
+		}
+		if audio == "" {
+			continue
+		}
+		plan = append(plan, lineInsertion{afterLine: exprKey.Line, indent: exprKey.Column - 1, line: "audio: " + audio})
+	}
+
+	if len(plan) == 0 {
+		return data, 0, nil
+	}
+	return insertLines(data, plan), len(plan), nil
+}
@@ -0,0 +1,126 @@
+package notebook
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveAudioPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		audio   string
+		want    string
+		wantErr string
+	}{
+		{name: "relative path", audio: "audio/ephemeral.wav", want: filepath.Join("notebooks", "vocab", "audio", "ephemeral.wav")},
+		{name: "recording", audio: "./recordings/candid.MP3", want: filepath.Join("notebooks", "vocab", "recordings", "candid.MP3")},
+		{name: "empty", audio: "", wantErr: "no audio"},
+		{name: "absolute path", audio: "/etc/passwd.wav", wantErr: "must be relative to the notebook directory"},
+		{name: "outside the notebook", audio: "audio/../../secret.wav", wantErr: "must be relative to the notebook directory"},
+		{name: "not audio", audio: "index.yml", wantErr: "is not a supported audio file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveAudioPath(filepath.Join("notebooks", "vocab"), tt.audio)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReader_ReadAudio(t *testing.T) {
+	dir := t.TempDir()
+	notebookDir := filepath.Join(dir, "vocab")
+	require.NoError(t, os.MkdirAll(filepath.Join(notebookDir, "audio"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "index.yml"), []byte("id: vocab\nname: Vocabulary\nnotebooks:\n  - ./cards.yml\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "audio", "ephemeral.wav"), []byte("RIFF"), 0644))
+
+	// A definitions book sharing the notebook's id keeps its audio apart.
+	defsDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(defsDir, "audio"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(defsDir, "vocab.yml"), []byte("- metadata:\n    notebook: cards.yml\n  scenes: []\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(defsDir, "audio", "colossal.wav"), []byte("RIFF colossal"), 0644))
+
+	reader, err := NewReader(nil, []string{dir}, nil, []string{defsDir}, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{notebookDir, defsDir}, reader.AudioDirectories("vocab"))
+
+	data, mediaType, err := reader.ReadAudio("vocab", "audio/ephemeral.wav")
+	require.NoError(t, err)
+	assert.Equal(t, "RIFF", string(data))
+	assert.Equal(t, "audio/wav", mediaType)
+
+	data, _, err = reader.ReadAudio("vocab", "audio/colossal.wav")
+	require.NoError(t, err)
+	assert.Equal(t, "RIFF colossal", string(data))
+
+	_, _, err = reader.ReadAudio("vocab", "audio/candid.wav")
+	assert.Error(t, err)
+	_, _, err = reader.ReadAudio("unknown", "audio/ephemeral.wav")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "notebook unknown not found")
+}
+
+func TestAddAudioToSourceYAML(t *testing.T) {
+	src := "# Flashcards\n" +
+		"- title: Week 1\n" +
+		"  cards:\n" +
+		"    - id: break-the-ice\n" +
+		"      expression: break the ice\n" +
+		"      meaning: 'to start a conversation'\n" +
+		"\n" +
+		"    - expression: went\n" +
+		"      definition: go\n" +
+		"    - expression: candid\n" +
+		"      audio: recordings/candid.mp3\n" +
+		"    - expression: \"!!!\"\n"
+
+	var entries []AudioEntry
+	got, added, err := AddAudioToSourceYAML([]byte(src), func(entry AudioEntry) (string, error) {
+		entries = append(entries, entry)
+		return "audio/" + entry.Key() + ".wav", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, added)
+	assert.Equal(t, []AudioEntry{
+		{ID: "break-the-ice", Expression: "break the ice"},
+		{Expression: "went", Definition: "go"},
+	}, entries)
+	assert.Equal(t, "# Flashcards\n"+
+		"- title: Week 1\n"+
+		"  cards:\n"+
+		"    - id: break-the-ice\n"+
+		"      expression: break the ice\n"+
+		"      audio: audio/break-the-ice.wav\n"+
+		"      meaning: 'to start a conversation'\n"+
+		"\n"+
+		"    - expression: went\n"+
+		"      audio: audio/go.wav\n"+
+		"      definition: go\n"+
+		"    - expression: candid\n"+
+		"      audio: recordings/candid.mp3\n"+
+		"    - expression: \"!!!\"\n", string(got))
+}
+
+func TestAddAudioToSourceYAML_NothingAdded(t *testing.T) {
+	src := "- title: Week 1\n  cards:\n    - expression: candid\n"
+
+	got, added, err := AddAudioToSourceYAML([]byte(src), func(AudioEntry) (string, error) { return "", nil })
+	require.NoError(t, err)
+	assert.Zero(t, added)
+	assert.Equal(t, src, string(got))
+
+	_, _, err = AddAudioToSourceYAML([]byte(src), func(AudioEntry) (string, error) { return "", fmt.Errorf("espeak-ng failed") })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "espeak-ng failed")
+}
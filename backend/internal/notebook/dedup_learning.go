@@ -70,6 +70,8 @@ func mergeIDLessInList(list *[]LearningHistoryExpression, calculator IntervalCal
 			target.LearnedLogs = mergeSeries(target.LearnedLogs, src.LearnedLogs, calculator)
 			target.ReverseLogs = mergeSeries(target.ReverseLogs, src.ReverseLogs, calculator)
 			target.EtymologyOriginLogs = mergeSeries(target.EtymologyOriginLogs, src.EtymologyOriginLogs, calculator)
+			target.DictationLogs = mergeSeries(target.DictationLogs, src.DictationLogs, calculator)
+			target.WordChoiceLogs = mergeSeries(target.WordChoiceLogs, src.WordChoiceLogs, calculator)
 			for qt, at := range src.SkippedAt {
 				if at == "" {
 					continue
@@ -81,6 +83,17 @@ func mergeIDLessInList(list *[]LearningHistoryExpression, calculator IntervalCal
 					target.SkippedAt[qt] = at
 				}
 			}
+			for qt, at := range src.RelearnAt {
+				if at == "" {
+					continue
+				}
+				if target.RelearnAt == nil {
+					target.RelearnAt = make(SkippedAtMap)
+				}
+				if target.RelearnAt[qt] == "" {
+					target.RelearnAt[qt] = at
+				}
+			}
 			remove[j] = true
 		}
 	}
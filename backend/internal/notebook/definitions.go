@@ -131,6 +131,7 @@ type DefinitionsMap map[string]map[string]map[string][]Note
 // definitionsIndex represents an index.yml for a definitions directory.
 type definitionsIndex struct {
 	ID        string   `yaml:"id"`
+	Name      string   `yaml:"name,omitempty"`
 	Notebooks []string `yaml:"notebooks"`
 }
 
@@ -139,6 +140,7 @@ type definitionsIndex struct {
 // notebook file list without going through the full DefinitionsMap loader.
 type DefinitionsIndex struct {
 	ID        string
+	Name      string
 	Notebooks []string
 }
 
@@ -204,9 +206,17 @@ func loadDefinitionsFile(path string, bookID string, result DefinitionsMap, raw
 // `latest date` map (populated from each definition file's metadata.date —
 // the max wins per book).
 func NewDefinitionsMap(directories []string) (DefinitionsMap, map[string][]Definitions, map[string]time.Time, error) {
+	result, raw, dates, _, err := newDefinitionsMap(directories)
+	return result, raw, dates, err
+}
+
+// newDefinitionsMap is NewDefinitionsMap that also returns where each book
+// was loaded from: its index directory, or the file of a standalone book.
+func newDefinitionsMap(directories []string) (DefinitionsMap, map[string][]Definitions, map[string]time.Time, map[string]string, error) {
 	result := make(DefinitionsMap)
 	raw := make(map[string][]Definitions)
 	dates := make(map[string]time.Time)
+	paths := make(map[string]string)
 
 	for _, dir := range directories {
 		if dir == "" {
@@ -236,6 +246,7 @@ func NewDefinitionsMap(directories []string) (DefinitionsMap, map[string][]Defin
 
 			indexDir := filepath.Dir(path)
 			indexedDirs[indexDir] = true
+			paths[idx.ID] = indexDir
 
 			for _, nbPath := range idx.Notebooks {
 				nbFullPath := filepath.Join(indexDir, nbPath)
@@ -247,7 +258,7 @@ func NewDefinitionsMap(directories []string) (DefinitionsMap, map[string][]Defin
 			return nil
 		})
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("walk definitions directory %s (index pass): %w", dir, err)
+			return nil, nil, nil, nil, fmt.Errorf("walk definitions directory %s (index pass): %w", dir, err)
 		}
 
 		// Second pass: load standalone .yml files (not in indexed directories)
@@ -269,15 +280,16 @@ func NewDefinitionsMap(directories []string) (DefinitionsMap, map[string][]Defin
 
 			// Book ID from filename
 			bookID := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+			paths[bookID] = path
 			return loadDefinitionsFile(path, bookID, result, raw, dates)
 		})
 
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("walk definitions directory %s: %w", dir, err)
+			return nil, nil, nil, nil, fmt.Errorf("walk definitions directory %s: %w", dir, err)
 		}
 	}
 
-	return result, raw, dates, nil
+	return result, raw, dates, paths, nil
 }
 
 // MergeDefinitionsIntoNotebooks merges definitions from the definitions map into story notebooks
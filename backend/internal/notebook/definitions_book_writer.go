@@ -22,8 +22,10 @@ import (
 // one row per concept, with the head's display fields and a "Family:"
 // line listing every member.
 type DefinitionsBookWriter struct {
-	reader       *Reader
-	templatePath string
+	reader                *Reader
+	templatePath          string
+	pdfOptions            pdf.Options
+	skipGeneratedExamples bool
 }
 
 // NewDefinitionsBookWriter constructs the writer. templatePath is the
@@ -32,6 +34,18 @@ func NewDefinitionsBookWriter(reader *Reader, templatePath string) *DefinitionsB
 	return &DefinitionsBookWriter{reader: reader, templatePath: templatePath}
 }
 
+// SetPDFOptions sets the fonts used when generatePDF is true. The title is
+// filled in from the book's index.
+func (w *DefinitionsBookWriter) SetPDFOptions(opts pdf.Options) {
+	w.pdfOptions = opts
+}
+
+// SetSkipGeneratedExamples leaves examples written by `notebooks enrich
+// --examples` out of the output.
+func (w *DefinitionsBookWriter) SetSkipGeneratedExamples(skip bool) {
+	w.skipGeneratedExamples = skip
+}
+
 // OutputDefinitionsBook writes the markdown for a single definitions
 // book and, if generatePDF is true, converts it to PDF via the same
 // pipeline the story / flashcard writers use.
@@ -60,7 +74,11 @@ func (w DefinitionsBookWriter) OutputDefinitionsBook(bookID string, outputDirect
 		}
 		assetsScenes := make([]assets.StoryScene, 0, len(def.Scenes))
 		for _, scene := range def.Scenes {
-			notes := collapseDefinitionConceptsForExport(scene.Expressions, byExpr, byHead)
+			expressions := scene.Expressions
+			if w.skipGeneratedExamples {
+				expressions = withoutGeneratedExamples(expressions)
+			}
+			notes := collapseDefinitionConceptsForExport(expressions, byExpr, byHead)
 			if len(notes) == 0 {
 				continue
 			}
@@ -93,7 +111,9 @@ func (w DefinitionsBookWriter) OutputDefinitionsBook(bookID string, outputDirect
 	fmt.Printf("Definitions book written to: %s\n", outputFilename)
 
 	if generatePDF {
-		pdfPath, err := pdf.ConvertMarkdownToPDF(outputFilename)
+		pdfOptions := w.pdfOptions
+		pdfOptions.Title = w.reader.indexes[bookID].Name
+		pdfPath, err := pdf.ConvertMarkdownToPDF(outputFilename, pdfOptions)
 		if err != nil {
 			return fmt.Errorf("ConvertMarkdownToPDF(%s): %w", outputFilename, err)
 		}
@@ -0,0 +1,512 @@
+package notebook
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// EtymologyGraphNodeKind is what a node of an etymology graph stands for.
+type EtymologyGraphNodeKind string
+
+const (
+	EtymologyGraphNodeOrigin  EtymologyGraphNodeKind = "origin"
+	EtymologyGraphNodeWord    EtymologyGraphNodeKind = "word"
+	EtymologyGraphNodeConcept EtymologyGraphNodeKind = "concept"
+)
+
+// EtymologyGraphEdgeKind is what an edge of an etymology graph is built from.
+type EtymologyGraphEdgeKind string
+
+const (
+	// EtymologyGraphEdgeOriginPart links a word to an origin it lists in
+	// origin_parts.
+	EtymologyGraphEdgeOriginPart EtymologyGraphEdgeKind = "origin_part"
+	// EtymologyGraphEdgeMember links a concept to an origin it lists as a
+	// member.
+	EtymologyGraphEdgeMember EtymologyGraphEdgeKind = "member"
+	// EtymologyGraphEdgeRelation links two concepts of a relation.
+	EtymologyGraphEdgeRelation EtymologyGraphEdgeKind = "relation"
+)
+
+// EtymologyGraphNode is an origin sense, a word built from one, or a concept.
+type EtymologyGraphNode struct {
+	ID    string
+	Kind  EtymologyGraphNodeKind
+	Label string
+	// Meaning is the origin's, word's or concept's meaning.
+	Meaning string
+	// Language and OriginType are set on origins only.
+	Language   string
+	OriginType string
+	// SessionTitle is the session an origin or word was declared in.
+	SessionTitle string
+	// NotebookID is the notebook a word is defined in.
+	NotebookID string
+}
+
+// EtymologyGraphEdge connects two nodes by ID. Label is the relation type of
+// a relation edge and the from_form of an origin part edge.
+type EtymologyGraphEdge struct {
+	Source   string
+	Target   string
+	Kind     EtymologyGraphEdgeKind
+	Label    string
+	Directed bool
+}
+
+// EtymologyGraph is the word family graph of one etymology notebook. Nodes
+// are ordered origins, words, then concepts.
+type EtymologyGraph struct {
+	NotebookID string
+	Nodes      []EtymologyGraphNode
+	Edges      []EtymologyGraphEdge
+}
+
+// ReadEtymologyGraph builds the graph of an etymology notebook: its origin
+// senses, the words whose origin_parts bind to them, its concepts with their
+// members, and the relations between concepts. Words bind to an origin the
+// same way the etymology browse page binds them, by (origin, session title),
+// so a word only appears under the sense declared in its own session.
+func (r *Reader) ReadEtymologyGraph(ctx context.Context, etymologyID string) (EtymologyGraph, error) {
+	origins, err := r.ReadEtymologyNotebook(etymologyID)
+	if err != nil {
+		return EtymologyGraph{}, err
+	}
+	graph := EtymologyGraph{NotebookID: etymologyID}
+
+	originIDs := make(map[string]bool)
+	for _, o := range origins {
+		id := etymologyGraphOriginID(o.Origin, o.SessionTitle)
+		if originIDs[id] {
+			continue
+		}
+		originIDs[id] = true
+		graph.Nodes = append(graph.Nodes, EtymologyGraphNode{
+			ID:           id,
+			Kind:         EtymologyGraphNodeOrigin,
+			Label:        o.Origin,
+			Meaning:      o.Meaning,
+			Language:     o.Language,
+			OriginType:   o.Type,
+			SessionTitle: o.SessionTitle,
+		})
+	}
+
+	type word struct {
+		node  EtymologyGraphNode
+		parts []OriginPartRef
+	}
+	var words []word
+	wordIDs := make(map[string]bool)
+	addWord := func(expression, meaning string, parts []OriginPartRef, notebookID, sessionTitle string) {
+		if sessionTitle == "" {
+			return
+		}
+		id := "word:" + notebookID + "/" + sessionTitle + "/" + strings.ToLower(strings.TrimSpace(expression))
+		if wordIDs[id] {
+			return
+		}
+		var bound []OriginPartRef
+		for _, part := range parts {
+			if originIDs[etymologyGraphOriginID(part.Origin, sessionTitle)] {
+				bound = append(bound, part)
+			}
+		}
+		if len(bound) == 0 {
+			return
+		}
+		wordIDs[id] = true
+		words = append(words, word{
+			node: EtymologyGraphNode{
+				ID:           id,
+				Kind:         EtymologyGraphNodeWord,
+				Label:        expression,
+				Meaning:      meaning,
+				SessionTitle: sessionTitle,
+				NotebookID:   notebookID,
+			},
+			parts: bound,
+		})
+	}
+	// Words embedded in etymology sessions and words of definitions books;
+	// story and flashcard words carry no session title to bind with.
+	for _, def := range r.ReadEtymologyNotebookDefinitions() {
+		addWord(def.GetExpression(), def.Meaning, def.OriginParts, def.NotebookName, def.SessionTitle)
+	}
+	for _, bookID := range r.GetDefinitionsBookIDs() {
+		if _, isStory := r.indexes[bookID]; isStory {
+			continue
+		}
+		if _, isFlashcard := r.flashcardIndexes[bookID]; isFlashcard {
+			continue
+		}
+		defs, _ := r.GetDefinitionsNotes(bookID)
+		for sessionTitle, scenes := range defs {
+			for _, notes := range scenes {
+				for _, note := range notes {
+					addWord(note.Expression, note.Meaning, note.OriginParts, bookID, sessionTitle)
+				}
+			}
+		}
+	}
+	// The sources above are read from maps, so order words for a stable
+	// output.
+	sort.Slice(words, func(i, j int) bool {
+		a, b := words[i].node, words[j].node
+		if a.NotebookID != b.NotebookID {
+			return a.NotebookID < b.NotebookID
+		}
+		if a.SessionTitle != b.SessionTitle {
+			return a.SessionTitle < b.SessionTitle
+		}
+		return a.Label < b.Label
+	})
+	for _, w := range words {
+		graph.Nodes = append(graph.Nodes, w.node)
+		for _, part := range w.parts {
+			graph.Edges = append(graph.Edges, EtymologyGraphEdge{
+				Source:   w.node.ID,
+				Target:   etymologyGraphOriginID(part.Origin, w.node.SessionTitle),
+				Kind:     EtymologyGraphEdgeOriginPart,
+				Label:    part.FromForm,
+				Directed: true,
+			})
+		}
+	}
+
+	conceptRows, err := NewYAMLSemanticConceptSource(r).FindAll(ctx)
+	if err != nil {
+		return EtymologyGraph{}, fmt.Errorf("read concepts: %w", err)
+	}
+	conceptIDs := make(map[string]bool)
+	seenEdges := make(map[EtymologyGraphEdge]bool)
+	addEdge := func(edge EtymologyGraphEdge) {
+		if seenEdges[edge] {
+			return
+		}
+		seenEdges[edge] = true
+		graph.Edges = append(graph.Edges, edge)
+	}
+	for _, row := range conceptRows {
+		if row.NotebookID != etymologyID {
+			continue
+		}
+		id := "concept:" + row.Key
+		if !conceptIDs[id] {
+			conceptIDs[id] = true
+			graph.Nodes = append(graph.Nodes, EtymologyGraphNode{
+				ID:      id,
+				Kind:    EtymologyGraphNodeConcept,
+				Label:   row.Key,
+				Meaning: row.Meaning,
+			})
+		}
+		for _, m := range row.Members {
+			target := etymologyGraphOriginID(m.Origin, row.SessionTitle)
+			if !originIDs[target] {
+				continue
+			}
+			addEdge(EtymologyGraphEdge{Source: id, Target: target, Kind: EtymologyGraphEdgeMember, Directed: true})
+		}
+	}
+
+	relationRows, err := NewYAMLConceptRelationSource(r).FindAll(ctx)
+	if err != nil {
+		return EtymologyGraph{}, fmt.Errorf("read concept relations: %w", err)
+	}
+	for _, rel := range relationRows {
+		if rel.NotebookID != etymologyID {
+			continue
+		}
+		from, to := "concept:"+rel.FromKey, "concept:"+rel.ToKey
+		// An endpoint not declared in this book was already reported by
+		// the validator.
+		if !conceptIDs[from] || !conceptIDs[to] {
+			continue
+		}
+		addEdge(EtymologyGraphEdge{Source: from, Target: to, Kind: EtymologyGraphEdgeRelation, Label: rel.Type, Directed: rel.IsDirected})
+	}
+	return graph, nil
+}
+
+// etymologyGraphOriginID is the node ID of an origin sense.
+func etymologyGraphOriginID(origin, sessionTitle string) string {
+	return "origin:" + sessionTitle + "/" + strings.ToLower(strings.TrimSpace(origin))
+}
+
+// GraphFormat is the file format an etymology graph is written in.
+type GraphFormat string
+
+const (
+	GraphFormatDOT       GraphFormat = "dot"
+	GraphFormatGraphML   GraphFormat = "graphml"
+	GraphFormatJSONGraph GraphFormat = "json-graph"
+)
+
+// GraphFormats lists every GraphFormat, in the order shown in help text.
+var GraphFormats = []GraphFormat{GraphFormatDOT, GraphFormatGraphML, GraphFormatJSONGraph}
+
+// WriteEtymologyGraph writes the graph to w as Graphviz DOT, GraphML, or
+// JSON Graph Format.
+func WriteEtymologyGraph(w io.Writer, graph EtymologyGraph, format GraphFormat) error {
+	switch format {
+	case GraphFormatDOT:
+		return writeEtymologyGraphDOT(w, graph)
+	case GraphFormatGraphML:
+		return writeEtymologyGraphML(w, graph)
+	case GraphFormatJSONGraph:
+		return writeEtymologyJSONGraph(w, graph)
+	}
+	return fmt.Errorf("unsupported graph format %q", format)
+}
+
+// etymologyGraphShapes gives each node kind its own Graphviz shape.
+var etymologyGraphShapes = map[EtymologyGraphNodeKind]string{
+	EtymologyGraphNodeOrigin:  "box",
+	EtymologyGraphNodeWord:    "ellipse",
+	EtymologyGraphNodeConcept: "diamond",
+}
+
+func writeEtymologyGraphDOT(w io.Writer, graph EtymologyGraph) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph %s {\n", dotQuote(graph.NotebookID))
+	for _, n := range graph.Nodes {
+		label := n.Label
+		if n.Meaning != "" {
+			label += "\n" + n.Meaning
+		}
+		fmt.Fprintf(&sb, "  %s [label=%s, shape=%s, kind=%s];\n", dotQuote(n.ID), dotQuote(label), etymologyGraphShapes[n.Kind], n.Kind)
+	}
+	for _, e := range graph.Edges {
+		attrs := []string{"kind=" + string(e.Kind)}
+		if e.Label != "" {
+			attrs = append(attrs, "label="+dotQuote(e.Label))
+		}
+		if !e.Directed {
+			attrs = append(attrs, "dir=none")
+		}
+		if e.Kind == EtymologyGraphEdgeMember {
+			attrs = append(attrs, "style=dashed")
+		}
+		fmt.Fprintf(&sb, "  %s -> %s [%s];\n", dotQuote(e.Source), dotQuote(e.Target), strings.Join(attrs, ", "))
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// dotQuote quotes s as a DOT ID.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source   string        `xml:"source,attr"`
+	Target   string        `xml:"target,attr"`
+	Directed bool          `xml:"directed,attr"`
+	Data     []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// graphMLKeys declares the attributes written on nodes and edges.
+var graphMLKeys = []graphMLKey{
+	{ID: "kind", For: "node", AttrName: "kind", AttrType: "string"},
+	{ID: "label", For: "node", AttrName: "label", AttrType: "string"},
+	{ID: "meaning", For: "node", AttrName: "meaning", AttrType: "string"},
+	{ID: "language", For: "node", AttrName: "language", AttrType: "string"},
+	{ID: "origin_type", For: "node", AttrName: "origin_type", AttrType: "string"},
+	{ID: "session_title", For: "node", AttrName: "session_title", AttrType: "string"},
+	{ID: "notebook_id", For: "node", AttrName: "notebook_id", AttrType: "string"},
+	{ID: "edge_kind", For: "edge", AttrName: "kind", AttrType: "string"},
+	{ID: "edge_label", For: "edge", AttrName: "label", AttrType: "string"},
+}
+
+func writeEtymologyGraphML(w io.Writer, graph EtymologyGraph) error {
+	doc := graphMLDocument{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys:  graphMLKeys,
+		Graph: graphMLGraph{ID: graph.NotebookID, EdgeDefault: "directed"},
+	}
+	data := func(pairs ...string) []graphMLData {
+		var out []graphMLData
+		for i := 0; i+1 < len(pairs); i += 2 {
+			if pairs[i+1] != "" {
+				out = append(out, graphMLData{Key: pairs[i], Value: pairs[i+1]})
+			}
+		}
+		return out
+	}
+	for _, n := range graph.Nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID: n.ID,
+			Data: data(
+				"kind", string(n.Kind),
+				"label", n.Label,
+				"meaning", n.Meaning,
+				"language", n.Language,
+				"origin_type", n.OriginType,
+				"session_title", n.SessionTitle,
+				"notebook_id", n.NotebookID,
+			),
+		})
+	}
+	for _, e := range graph.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			Source:   e.Source,
+			Target:   e.Target,
+			Directed: e.Directed,
+			Data:     data("edge_kind", string(e.Kind), "edge_label", e.Label),
+		})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("encode graphml: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// jsonGraphDocument is a JSON Graph Format (v2) document.
+type jsonGraphDocument struct {
+	Graph jsonGraph `json:"graph"`
+}
+
+type jsonGraph struct {
+	ID       string                   `json:"id"`
+	Directed bool                     `json:"directed"`
+	Nodes    map[string]jsonGraphNode `json:"nodes"`
+	Edges    []jsonGraphEdge          `json:"edges"`
+}
+
+type jsonGraphNode struct {
+	Label    string            `json:"label"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+type jsonGraphEdge struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Relation string `json:"relation"`
+	Directed bool   `json:"directed"`
+	Label    string `json:"label,omitempty"`
+}
+
+func writeEtymologyJSONGraph(w io.Writer, graph EtymologyGraph) error {
+	doc := jsonGraphDocument{Graph: jsonGraph{
+		ID:       graph.NotebookID,
+		Directed: true,
+		Nodes:    make(map[string]jsonGraphNode, len(graph.Nodes)),
+		Edges:    make([]jsonGraphEdge, 0, len(graph.Edges)),
+	}}
+	for _, n := range graph.Nodes {
+		metadata := map[string]string{"kind": string(n.Kind)}
+		for key, value := range map[string]string{
+			"meaning":       n.Meaning,
+			"language":      n.Language,
+			"origin_type":   n.OriginType,
+			"session_title": n.SessionTitle,
+			"notebook_id":   n.NotebookID,
+		} {
+			if value != "" {
+				metadata[key] = value
+			}
+		}
+		doc.Graph.Nodes[n.ID] = jsonGraphNode{Label: n.Label, Metadata: metadata}
+	}
+	for _, e := range graph.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, jsonGraphEdge{
+			Source:   e.Source,
+			Target:   e.Target,
+			Relation: string(e.Kind),
+			Directed: e.Directed,
+			Label:    e.Label,
+		})
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("encode json graph: %w", err)
+	}
+	return nil
+}
+
+// graphFormatExtensions is the file extension each GraphFormat is written
+// with.
+var graphFormatExtensions = map[GraphFormat]string{
+	GraphFormatDOT:       "dot",
+	GraphFormatGraphML:   "graphml",
+	GraphFormatJSONGraph: "json",
+}
+
+// ExportEtymologyGraph writes the graph of the etymology notebook in
+// outputDirectory and returns its path.
+func (writer EtymologyNotebookWriter) ExportEtymologyGraph(ctx context.Context, etymologyID, outputDirectory string, format GraphFormat) (string, error) {
+	ext, ok := graphFormatExtensions[format]
+	if !ok {
+		return "", fmt.Errorf("unsupported graph format %q", format)
+	}
+	graph, err := writer.reader.ReadEtymologyGraph(ctx, etymologyID)
+	if err != nil {
+		return "", fmt.Errorf("reader.ReadEtymologyGraph(%s) > %w", etymologyID, err)
+	}
+
+	if err := os.MkdirAll(outputDirectory, 0755); err != nil {
+		return "", fmt.Errorf("os.MkdirAll(%s) > %w", outputDirectory, err)
+	}
+	outputFilename := filepath.Join(outputDirectory, etymologyID+"."+ext)
+	output, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("os.Create(%s) > %w", outputFilename, err)
+	}
+	defer func() {
+		_ = output.Close()
+	}()
+	if err := WriteEtymologyGraph(output, graph, format); err != nil {
+		return "", fmt.Errorf("WriteEtymologyGraph(%s) > %w", outputFilename, err)
+	}
+	return outputFilename, nil
+}
@@ -0,0 +1,180 @@
+package notebook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEtymologyGraphReader(t *testing.T) *Reader {
+	t.Helper()
+	dir := t.TempDir()
+	etymDir := filepath.Join(dir, "etymology", "book")
+	require.NoError(t, os.MkdirAll(etymDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(etymDir, "index.yml"), []byte(`id: book
+kind: Etymology
+name: Book
+notebooks:
+  - ./session1.yml
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(etymDir, "session1.yml"), []byte(`metadata:
+  title: "Session 1"
+origins:
+  - origin: spect
+    type: root
+    language: Latin
+    meaning: to look
+  - origin: in
+    type: prefix
+    language: Latin
+    meaning: into
+  - origin: ex
+    type: prefix
+    language: Latin
+    meaning: out
+definitions:
+  - expression: inspect
+    meaning: to look into
+    origin_parts:
+      - origin: in
+      - origin: spect
+        from_form: spect
+  - expression: unrelated
+    meaning: bound to no origin of this session
+    origin_parts:
+      - origin: graph
+concepts:
+  - key: inward
+    meaning: into
+    members:
+      - origin: in
+  - key: outward
+    meaning: out
+    members:
+      - origin: ex
+  - key: sight
+    meaning: seeing
+    members:
+      - origin: spect
+relations:
+  - type: antonym
+    between: [inward, outward]
+  - type: causes
+    from: sight
+    to: inward
+  - type: similar_to
+    between: [inward, missing]
+`), 0o644))
+	reader, err := NewReader(nil, nil, nil, nil, []string{filepath.Join(dir, "etymology")}, nil)
+	require.NoError(t, err)
+	return reader
+}
+
+func TestReader_ReadEtymologyGraph(t *testing.T) {
+	reader := newEtymologyGraphReader(t)
+
+	graph, err := reader.ReadEtymologyGraph(context.Background(), "book")
+	require.NoError(t, err)
+	assert.Equal(t, "book", graph.NotebookID)
+
+	var ids []string
+	for _, n := range graph.Nodes {
+		ids = append(ids, n.ID)
+	}
+	assert.Equal(t, []string{
+		"origin:Session 1/spect",
+		"origin:Session 1/in",
+		"origin:Session 1/ex",
+		"word:book/Session 1/inspect",
+		"concept:inward",
+		"concept:outward",
+		"concept:sight",
+	}, ids)
+	assert.Equal(t, EtymologyGraphNode{
+		ID: "origin:Session 1/in", Kind: EtymologyGraphNodeOrigin, Label: "in", Meaning: "into",
+		Language: "Latin", OriginType: "prefix", SessionTitle: "Session 1",
+	}, graph.Nodes[1])
+
+	assert.Equal(t, []EtymologyGraphEdge{
+		{Source: "word:book/Session 1/inspect", Target: "origin:Session 1/in", Kind: EtymologyGraphEdgeOriginPart, Directed: true},
+		{Source: "word:book/Session 1/inspect", Target: "origin:Session 1/spect", Kind: EtymologyGraphEdgeOriginPart, Label: "spect", Directed: true},
+		{Source: "concept:inward", Target: "origin:Session 1/in", Kind: EtymologyGraphEdgeMember, Directed: true},
+		{Source: "concept:outward", Target: "origin:Session 1/ex", Kind: EtymologyGraphEdgeMember, Directed: true},
+		{Source: "concept:sight", Target: "origin:Session 1/spect", Kind: EtymologyGraphEdgeMember, Directed: true},
+		{Source: "concept:inward", Target: "concept:outward", Kind: EtymologyGraphEdgeRelation, Label: "antonym"},
+		{Source: "concept:sight", Target: "concept:inward", Kind: EtymologyGraphEdgeRelation, Label: "causes", Directed: true},
+	}, graph.Edges)
+
+	_, err = reader.ReadEtymologyGraph(context.Background(), "missing")
+	assert.Error(t, err)
+}
+
+func TestWriteEtymologyGraph(t *testing.T) {
+	graph := EtymologyGraph{
+		NotebookID: "book",
+		Nodes: []EtymologyGraphNode{
+			{ID: "origin:S/in", Kind: EtymologyGraphNodeOrigin, Label: "in", Meaning: "into", Language: "Latin", SessionTitle: "S"},
+			{ID: "word:book/S/inspect", Kind: EtymologyGraphNodeWord, Label: `"inspect"`, SessionTitle: "S", NotebookID: "book"},
+			{ID: "concept:inward", Kind: EtymologyGraphNodeConcept, Label: "inward"},
+			{ID: "concept:outward", Kind: EtymologyGraphNodeConcept, Label: "outward"},
+		},
+		Edges: []EtymologyGraphEdge{
+			{Source: "word:book/S/inspect", Target: "origin:S/in", Kind: EtymologyGraphEdgeOriginPart, Directed: true},
+			{Source: "concept:inward", Target: "origin:S/in", Kind: EtymologyGraphEdgeMember, Directed: true},
+			{Source: "concept:inward", Target: "concept:outward", Kind: EtymologyGraphEdgeRelation, Label: "antonym"},
+		},
+	}
+
+	t.Run("dot", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteEtymologyGraph(&buf, graph, GraphFormatDOT))
+		assert.Equal(t, `digraph "book" {
+  "origin:S/in" [label="in\ninto", shape=box, kind=origin];
+  "word:book/S/inspect" [label="\"inspect\"", shape=ellipse, kind=word];
+  "concept:inward" [label="inward", shape=diamond, kind=concept];
+  "concept:outward" [label="outward", shape=diamond, kind=concept];
+  "word:book/S/inspect" -> "origin:S/in" [kind=origin_part];
+  "concept:inward" -> "origin:S/in" [kind=member, style=dashed];
+  "concept:inward" -> "concept:outward" [kind=relation, label="antonym", dir=none];
+}
+`, buf.String())
+	})
+
+	t.Run("graphml", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteEtymologyGraph(&buf, graph, GraphFormatGraphML))
+		out := buf.String()
+		assert.Contains(t, out, `<graph id="book" edgedefault="directed">`)
+		assert.Contains(t, out, `<key id="meaning" for="node" attr.name="meaning" attr.type="string"></key>`)
+		assert.Contains(t, out, `<node id="word:book/S/inspect">`)
+		assert.Contains(t, out, `<data key="label">&#34;inspect&#34;</data>`)
+		assert.Contains(t, out, `<edge source="concept:inward" target="concept:outward" directed="false">`)
+		assert.Contains(t, out, `<data key="edge_label">antonym</data>`)
+	})
+
+	t.Run("json-graph", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteEtymologyGraph(&buf, graph, GraphFormatJSONGraph))
+		var doc jsonGraphDocument
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+		assert.True(t, doc.Graph.Directed)
+		assert.Len(t, doc.Graph.Nodes, 4)
+		assert.Equal(t, jsonGraphNode{
+			Label:    "in",
+			Metadata: map[string]string{"kind": "origin", "meaning": "into", "language": "Latin", "session_title": "S"},
+		}, doc.Graph.Nodes["origin:S/in"])
+		assert.Equal(t, jsonGraphEdge{
+			Source: "concept:inward", Target: "concept:outward", Relation: "relation", Label: "antonym",
+		}, doc.Graph.Edges[2])
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		assert.Error(t, WriteEtymologyGraph(&bytes.Buffer{}, graph, GraphFormat("svg")))
+	})
+}
@@ -17,6 +17,7 @@ type EtymologyNotebookWriter struct {
 	templatePath           string
 	definitionsDirectories []string
 	learningHistories      map[string][]LearningHistory
+	pdfOptions             pdf.Options
 }
 
 // NewEtymologyNotebookWriter creates a new EtymologyNotebookWriter
@@ -29,6 +30,12 @@ func NewEtymologyNotebookWriter(reader *Reader, templatePath string, definitions
 	}
 }
 
+// SetPDFOptions sets the fonts used when generatePDF is true. The title is
+// filled in from the etymology index.
+func (writer *EtymologyNotebookWriter) SetPDFOptions(opts pdf.Options) {
+	writer.pdfOptions = opts
+}
+
 // OutputEtymologyNotebook generates markdown (and optionally PDF) output from etymology notebooks.
 // It merges origins from the etymology directory with definitions from the definitions/books directory.
 func (writer EtymologyNotebookWriter) OutputEtymologyNotebook(
@@ -77,7 +84,9 @@ func (writer EtymologyNotebookWriter) OutputEtymologyNotebook(
 	fmt.Printf("Etymology notebook written to: %s\n", outputFilename)
 
 	if generatePDF {
-		pdfPath, err := pdf.ConvertMarkdownToPDF(outputFilename)
+		pdfOptions := writer.pdfOptions
+		pdfOptions.Title = etymIndex.Name
+		pdfPath, err := pdf.ConvertMarkdownToPDF(outputFilename, pdfOptions)
 		if err != nil {
 			return fmt.Errorf("ConvertMarkdownToPDF(%s) > %w", outputFilename, err)
 		}
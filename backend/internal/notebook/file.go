@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"gopkg.in/yaml.v3"
 )
 
 func readYamlFile[T any](path string) (T, error) {
+	if result, ok, err := readYamlFileCached[T](path); ok {
+		return result, err
+	}
+
 	var result T
 
 	file, err := os.Open(path)
@@ -60,6 +65,7 @@ func WriteYamlFile[T any](path string, data T) error {
 		cleanup()
 		return fmt.Errorf("rename(%s -> %s)> %w", tmpPath, path, err)
 	}
+	invalidateCachedYAMLFile(path)
 	return nil
 }
 
@@ -159,3 +165,43 @@ func loadYamlFilesAsMap[T any](dir string, filter func(path string, info os.File
 	}
 	return filesMap, nil
 }
+
+// CollectYAMLFiles returns every .yml file under the given directories,
+// de-duplicated and sorted for deterministic processing.
+func CollectYAMLFiles(dirs []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var files []string
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if !info.IsDir() {
+			continue
+		}
+		err = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || filepath.Ext(path) != ".yml" {
+				return nil
+			}
+			if !seen[path] {
+				seen[path] = true
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
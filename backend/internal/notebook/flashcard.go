@@ -28,6 +28,11 @@ type Reader struct {
 	definitionsMap   DefinitionsMap
 	definitionsRaw   map[string][]Definitions
 	definitionsDates map[string]time.Time
+	// definitionsPaths and grammarsPaths record where each definitions book
+	// and grammars notebook was loaded from, so a WatchedReader can reload
+	// just the one whose files changed.
+	definitionsPaths map[string]string
+	grammarsPaths    map[string]string
 }
 
 // walkIndexFiles walks a directory and loads index.yml files into the provided map
@@ -117,7 +122,7 @@ func NewReader(
 		}
 	}
 
-	definitionsMap, definitionsRaw, definitionsDates, err := NewDefinitionsMap(definitionsDirectories)
+	definitionsMap, definitionsRaw, definitionsDates, definitionsPaths, err := newDefinitionsMap(definitionsDirectories)
 	if err != nil {
 		return nil, fmt.Errorf("NewDefinitionsMap: %w", err)
 	}
@@ -137,6 +142,8 @@ func NewReader(
 		definitionsMap:   definitionsMap,
 		definitionsRaw:   definitionsRaw,
 		definitionsDates: definitionsDates,
+		definitionsPaths: definitionsPaths,
+		grammarsPaths:    make(map[string]string),
 	}, nil
 }
 
@@ -323,7 +330,7 @@ func (f Reader) ReadAllNotes(storyID string, learningHistories map[string][]Lear
 				if !definition.needsToLearn() {
 					continue
 				}
-				if err := definition.SetDetails(f.dictionaryMap, ""); err != nil {
+				if err := definition.SetDetails(f.dictionaryMap, notebook.YouTubeURL); err != nil {
 					return nil, fmt.Errorf("definition.SetDetails() > %w", err)
 				}
 				if definition.Meaning == "" && len(definition.Images) == 0 {
@@ -346,6 +353,9 @@ func (f Reader) ReadFlashcardNotebooks(flashcardID string) ([]FlashcardNotebook,
 	if !ok {
 		return nil, fmt.Errorf("flashcard %s not found", flashcardID)
 	}
+	if index.preloaded != nil {
+		return cloneFlashcardNotebooks(index.preloaded), nil
+	}
 
 	result := make([]FlashcardNotebook, 0)
 	for _, notebookPath := range index.NotebookPaths {
@@ -16,7 +16,10 @@ type FlashcardNotebook struct {
 	Title       string    `yaml:"title"`
 	Description string    `yaml:"description,omitempty"`
 	Date        time.Time `yaml:"date"`
-	Cards       []Note    `yaml:"cards"`
+	// YouTubeURL is the video the cards were taken from, which their
+	// youtube_time_seconds link into.
+	YouTubeURL string `yaml:"youtube_url,omitempty"`
+	Cards      []Note `yaml:"cards"`
 }
 
 // FlashcardIndex represents an index file for flashcard directories.
@@ -29,6 +32,7 @@ type FlashcardIndex struct {
 	// internal fields (not loaded from YAML)
 	Path      string              `yaml:"-"` // directory containing this index
 	Notebooks []FlashcardNotebook `yaml:"-"` // loaded notebooks (populated by reader)
+	preloaded []FlashcardNotebook // built in memory instead of read from NotebookPaths
 }
 
 // Validate validates a FlashcardNotebook and returns any validation errors.
@@ -157,7 +161,7 @@ func FilterFlashcardNotebooks(
 			}
 
 			// Set details from dictionary
-			if err := card.SetDetails(dictionaryMap, ""); err != nil {
+			if err := card.SetDetails(dictionaryMap, notebook.YouTubeURL); err != nil {
 				return nil, fmt.Errorf("card.SetDetails() > %w", err)
 			}
 
@@ -13,8 +13,10 @@ import (
 
 // FlashcardNotebookWriter handles writing flashcard notebooks to various output formats
 type FlashcardNotebookWriter struct {
-	reader       *Reader
-	templatePath string
+	reader                *Reader
+	templatePath          string
+	pdfOptions            pdf.Options
+	skipGeneratedExamples bool
 }
 
 func NewFlashcardNotebookWriter(reader *Reader, templatePath string) *FlashcardNotebookWriter {
@@ -24,6 +26,18 @@ func NewFlashcardNotebookWriter(reader *Reader, templatePath string) *FlashcardN
 	}
 }
 
+// SetPDFOptions sets the fonts used when generatePDF is true. The title is
+// filled in from the flashcard index.
+func (writer *FlashcardNotebookWriter) SetPDFOptions(opts pdf.Options) {
+	writer.pdfOptions = opts
+}
+
+// SetSkipGeneratedExamples leaves examples written by `notebooks enrich
+// --examples` out of the output.
+func (writer *FlashcardNotebookWriter) SetSkipGeneratedExamples(skip bool) {
+	writer.skipGeneratedExamples = skip
+}
+
 func (writer FlashcardNotebookWriter) OutputFlashcardNotebooks(
 	flashcardID string,
 	dictionaryMap map[string]rapidapi.Response,
@@ -48,6 +62,9 @@ func (writer FlashcardNotebookWriter) OutputFlashcardNotebooks(
 	if err != nil {
 		return fmt.Errorf("FilterFlashcardNotebooks() > %w", err)
 	}
+	if writer.skipGeneratedExamples {
+		notebooks = flashcardNotebooksWithoutGeneratedExamples(notebooks)
+	}
 
 	// Create output directory if it doesn't exist
 	if err := os.MkdirAll(outputDirectory, 0755); err != nil {
@@ -74,7 +91,9 @@ func (writer FlashcardNotebookWriter) OutputFlashcardNotebooks(
 	fmt.Printf("Flashcard notebook written to: %s\n", outputFilename)
 
 	if generatePDF {
-		pdfPath, err := pdf.ConvertMarkdownToPDF(outputFilename)
+		pdfOptions := writer.pdfOptions
+		pdfOptions.Title = writer.reader.flashcardIndexes[flashcardID].Name
+		pdfPath, err := pdf.ConvertMarkdownToPDF(outputFilename, pdfOptions)
 		if err != nil {
 			return fmt.Errorf("ConvertMarkdownToPDF(%s) > %w", outputFilename, err)
 		}
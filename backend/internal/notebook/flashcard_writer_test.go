@@ -148,7 +148,10 @@ func TestOutputFlashcardNotebooks(t *testing.T) {
 			Title: "Common Words",
 			Date:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 			Cards: []Note{
-				{Expression: "hello", Meaning: "a greeting"},
+				{Expression: "hello", Meaning: "a greeting", Examples: Examples{
+					{Text: "Hello, everyone."},
+					{Text: "She said hello warmly.", Highlight: "hello", Generated: true},
+				}},
 			},
 		},
 	}))
@@ -178,6 +181,22 @@ func TestOutputFlashcardNotebooks(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("skip generated examples", func(t *testing.T) {
+		skipDir := t.TempDir()
+		skipping := NewFlashcardNotebookWriter(reader, "")
+		skipping.SetSkipGeneratedExamples(true)
+		require.NoError(t, skipping.OutputFlashcardNotebooks("test-flashcard", map[string]rapidapi.Response{}, map[string][]LearningHistory{}, false, skipDir, false))
+		got, err := os.ReadFile(filepath.Join(skipDir, "test-flashcard.md"))
+		require.NoError(t, err)
+		assert.Contains(t, string(got), "Hello, everyone.")
+		assert.NotContains(t, string(got), "She said hello warmly.")
+
+		require.NoError(t, writer.OutputFlashcardNotebooks("test-flashcard", map[string]rapidapi.Response{}, map[string][]LearningHistory{}, false, skipDir, false))
+		got, err = os.ReadFile(filepath.Join(skipDir, "test-flashcard.md"))
+		require.NoError(t, err)
+		assert.Contains(t, string(got), "She said hello warmly.")
+	})
+
 	t.Run("notebook not found", func(t *testing.T) {
 		err := writer.OutputFlashcardNotebooks("nonexistent", map[string]rapidapi.Response{}, map[string][]LearningHistory{}, false, outputDir, false)
 		assert.Error(t, err)
@@ -0,0 +1,85 @@
+package notebook
+
+import "strings"
+
+// AddExamplesToSourceYAML calls examplesFor for every vocabulary entry of
+// one source-notebook file that has no `examples` key, and inserts the
+// returned examples after the entry's expression as {text, highlight,
+// generated} mappings. Entries examplesFor returns none for are left
+// unchanged. The edit is add-only like that of AddMemosToSourceYAML.
+func AddExamplesToSourceYAML(data []byte, examplesFor func(Note) (Examples, error)) ([]byte, int, error) {
+	return addToSourceEntries(data, "examples", func(_ sceneEntry, note Note, indent int) (string, error) {
+		examples, err := examplesFor(note)
+		if err != nil {
+			return "", err
+		}
+		return examplesBlock(examples, indent)
+	})
+}
+
+// examplesBlock renders an `examples:` key with one mapping per example.
+// insertLines indents only the first line, so the following ones carry
+// indent themselves.
+func examplesBlock(examples Examples, indent int) (string, error) {
+	pad := strings.Repeat(" ", indent)
+	var lines []string
+	for _, ex := range examples {
+		text, err := inlineYAMLScalar(ex.Text)
+		if err != nil {
+			return "", err
+		}
+		if text == "" {
+			continue
+		}
+		lines = append(lines, pad+"  - text: "+text)
+		if highlight, err := inlineYAMLScalar(ex.Highlight); err != nil {
+			return "", err
+		} else if highlight != "" {
+			lines = append(lines, pad+"    highlight: "+highlight)
+		}
+		if ex.Generated {
+			lines = append(lines, pad+"    generated: true")
+		}
+	}
+	if len(lines) == 0 {
+		return "", nil
+	}
+	return "examples:\n" + strings.Join(lines, "\n"), nil
+}
+
+// withoutGeneratedExamples returns copies of notes with their generated
+// examples dropped, leaving the reader's cached notes untouched.
+func withoutGeneratedExamples(notes []Note) []Note {
+	if notes == nil {
+		return nil
+	}
+	out := make([]Note, len(notes))
+	for i, note := range notes {
+		note.Examples = note.Examples.WithoutGenerated()
+		out[i] = note
+	}
+	return out
+}
+
+func storyNotebooksWithoutGeneratedExamples(notebooks []StoryNotebook) []StoryNotebook {
+	out := make([]StoryNotebook, len(notebooks))
+	for i, nb := range notebooks {
+		scenes := make([]StoryScene, len(nb.Scenes))
+		for j, scene := range nb.Scenes {
+			scene.Definitions = withoutGeneratedExamples(scene.Definitions)
+			scenes[j] = scene
+		}
+		nb.Scenes = scenes
+		out[i] = nb
+	}
+	return out
+}
+
+func flashcardNotebooksWithoutGeneratedExamples(notebooks []FlashcardNotebook) []FlashcardNotebook {
+	out := make([]FlashcardNotebook, len(notebooks))
+	for i, nb := range notebooks {
+		nb.Cards = withoutGeneratedExamples(nb.Cards)
+		out[i] = nb
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+package notebook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestAddExamplesToSourceYAML(t *testing.T) {
+	src := "- title: Flashcards\n" +
+		"  cards:\n" +
+		"    - expression: go\n" +
+		"      meaning: to move\n" +
+		"    - expression: candid\n" +
+		"      examples:\n" +
+		"        - He was candid.\n" +
+		"    - expression: sure\n"
+
+	var seen []string
+	got, added, err := AddExamplesToSourceYAML([]byte(src), func(note Note) (Examples, error) {
+		seen = append(seen, note.Expression)
+		if note.Expression == "sure" {
+			return nil, nil
+		}
+		return Examples{
+			{Text: "She went home: early.", Highlight: "went", Generated: true},
+			{Text: "Let's go.", Highlight: "go", Generated: true},
+		}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, added)
+	assert.Equal(t, []string{"go", "sure"}, seen)
+	assert.Equal(t, "- title: Flashcards\n"+
+		"  cards:\n"+
+		"    - expression: go\n"+
+		"      examples:\n"+
+		"        - text: 'She went home: early.'\n"+
+		"          highlight: went\n"+
+		"          generated: true\n"+
+		"        - text: Let's go.\n"+
+		"          highlight: go\n"+
+		"          generated: true\n"+
+		"      meaning: to move\n"+
+		"    - expression: candid\n"+
+		"      examples:\n"+
+		"        - He was candid.\n"+
+		"    - expression: sure\n", string(got))
+
+	var notebooks []FlashcardNotebook
+	require.NoError(t, yaml.Unmarshal(got, &notebooks))
+	assert.Equal(t, Examples{
+		{Text: "She went home: early.", Highlight: "went", Generated: true},
+		{Text: "Let's go.", Highlight: "go", Generated: true},
+	}, notebooks[0].Cards[0].Examples)
+
+	unchanged, added, err := AddExamplesToSourceYAML([]byte(src), func(Note) (Examples, error) { return nil, nil })
+	require.NoError(t, err)
+	assert.Equal(t, 0, added)
+	assert.Equal(t, src, string(unchanged))
+}
+
+func TestExamples_WithoutGenerated(t *testing.T) {
+	examples := Examples{{Text: "Mine."}, {Text: "Generated.", Highlight: "x", Generated: true}}
+	assert.Equal(t, Examples{{Text: "Mine."}}, examples.WithoutGenerated())
+	assert.Nil(t, Examples{{Text: "Generated.", Generated: true}}.WithoutGenerated())
+}
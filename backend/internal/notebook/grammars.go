@@ -337,6 +337,7 @@ func (f Reader) LoadGrammars(grammarsDirectories []string) error {
 			}
 			ensureUniqueCorrectionIDs(index.ID, byTitle)
 			f.grammarsMap[index.ID] = byTitle
+			f.grammarsPaths[index.ID] = filepath.Dir(path)
 			return nil
 		})
 		if err != nil {
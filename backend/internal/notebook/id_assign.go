@@ -134,12 +134,7 @@ func AddIDsToSourceYAML(data []byte, used map[string]bool) ([]byte, int, error)
 	// comments, block scalars — is preserved exactly. yaml.Node is used only
 	// to locate entries and their line/column; encoding whole documents with
 	// yaml.v3 reformats hand-authored files, which is not acceptable here.
-	type insertion struct {
-		afterLine int // 1-based source line to insert after
-		indent    int // leading spaces for the new line = key column - 1
-		id        string
-	}
-	var plan []insertion
+	var plan []lineInsertion
 	for _, m := range entries {
 		if mappingHasKey(m, "id") {
 			continue
@@ -154,28 +149,39 @@ func AddIDsToSourceYAML(data []byte, used map[string]bool) ([]byte, int, error)
 		}
 		slug := nextUniqueSlug(base, used)
 		used[slug] = true
-		plan = append(plan, insertion{afterLine: exprKey.Line, indent: exprKey.Column - 1, id: slug})
+		plan = append(plan, lineInsertion{afterLine: exprKey.Line, indent: exprKey.Column - 1, line: "id: " + slug})
 	}
 
 	if len(plan) == 0 {
 		return data, 0, nil
 	}
+	return insertLines(data, plan), len(plan), nil
+}
+
+// lineInsertion is one new line to splice into a source file.
+type lineInsertion struct {
+	afterLine int // 1-based source line to insert after
+	indent    int // leading spaces for the new line = key column - 1
+	line      string
+}
 
-	// Apply bottom-to-top so earlier line numbers stay valid as lines shift.
-	sort.Slice(plan, func(i, j int) bool { return plan[i].afterLine > plan[j].afterLine })
+// insertLines splices plan into the original text verbatim, applying it
+// bottom-to-top so earlier line numbers stay valid as lines shift.
+func insertLines(data []byte, plan []lineInsertion) []byte {
+	sort.SliceStable(plan, func(i, j int) bool { return plan[i].afterLine > plan[j].afterLine })
 	lines := strings.Split(string(data), "\n")
 	for _, ins := range plan {
 		if ins.afterLine < 1 || ins.afterLine > len(lines) {
 			continue
 		}
-		newLine := strings.Repeat(" ", ins.indent) + "id: " + ins.id
+		newLine := strings.Repeat(" ", ins.indent) + ins.line
 		out := make([]string, 0, len(lines)+1)
 		out = append(out, lines[:ins.afterLine]...)
 		out = append(out, newLine)
 		out = append(out, lines[ins.afterLine:]...)
 		lines = out
 	}
-	return []byte(strings.Join(lines, "\n")), len(plan), nil
+	return []byte(strings.Join(lines, "\n"))
 }
 
 // CollectExistingIDs returns the ids already present on entries in one source
@@ -0,0 +1,57 @@
+package notebook
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AddNotebookToIndexYAML lists notebookPath under the `notebooks:` key of
+// an index.yml. Like AddIDsToSourceYAML the edit is add-only: the new item
+// is spliced into the original text after the last listed notebook, so
+// comments and formatting are kept, and the original bytes are returned
+// with added=false when the notebook is already listed.
+func AddNotebookToIndexYAML(data []byte, notebookPath string) (out []byte, added bool, err error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, false, fmt.Errorf("unmarshal index yaml: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, false, fmt.Errorf("index yaml is not a mapping")
+	}
+	root := doc.Content[0]
+	item := "- " + notebookPath
+
+	var key, notebooks *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "notebooks" {
+			key, notebooks = root.Content[i], root.Content[i+1]
+		}
+	}
+	if key == nil {
+		text := string(data)
+		if text != "" && !strings.HasSuffix(text, "\n") {
+			text += "\n"
+		}
+		return []byte(text + "notebooks:\n  " + item + "\n"), true, nil
+	}
+
+	switch {
+	case notebooks.Kind == yaml.ScalarNode && notebooks.Tag == "!!null":
+		return insertLines(data, []lineInsertion{{afterLine: key.Line, indent: key.Column + 1, line: item}}), true, nil
+	case notebooks.Kind != yaml.SequenceNode || notebooks.Style&yaml.FlowStyle != 0:
+		return nil, false, fmt.Errorf("notebooks at line %d is not a block list", key.Line)
+	}
+
+	for _, listed := range notebooks.Content {
+		if filepath.Clean(listed.Value) == filepath.Clean(notebookPath) {
+			return data, false, nil
+		}
+	}
+	last := notebooks.Content[len(notebooks.Content)-1]
+	lines := strings.Split(string(data), "\n")
+	indent := len(lines[last.Line-1]) - len(strings.TrimLeft(lines[last.Line-1], " "))
+	return insertLines(data, []lineInsertion{{afterLine: last.Line, indent: indent, line: item}}), true, nil
+}
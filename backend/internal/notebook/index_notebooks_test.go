@@ -0,0 +1,75 @@
+package notebook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddNotebookToIndexYAML(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		want      string
+		wantAdded bool
+		wantErr   string
+	}{
+		{
+			name: "appends after the last notebook, keeping comments",
+			data: `# Friends, season 1
+id: friends
+name: Friends
+notebooks:
+    - ./episode-1.yml # pilot
+    - ./episode-2.yml
+kind: story
+`,
+			want: `# Friends, season 1
+id: friends
+name: Friends
+notebooks:
+    - ./episode-1.yml # pilot
+    - ./episode-2.yml
+    - ./episode-3.yml
+kind: story
+`,
+			wantAdded: true,
+		},
+		{
+			name:      "already listed without the ./ prefix",
+			data:      "id: friends\nnotebooks:\n  - episode-3.yml\n",
+			want:      "id: friends\nnotebooks:\n  - episode-3.yml\n",
+			wantAdded: false,
+		},
+		{
+			name:      "empty notebooks key",
+			data:      "id: friends\nnotebooks:\nname: Friends\n",
+			want:      "id: friends\nnotebooks:\n  - ./episode-3.yml\nname: Friends\n",
+			wantAdded: true,
+		},
+		{
+			name:      "no notebooks key",
+			data:      "id: friends\nname: Friends",
+			want:      "id: friends\nname: Friends\nnotebooks:\n  - ./episode-3.yml\n",
+			wantAdded: true,
+		},
+		{
+			name:    "flow list",
+			data:    "id: friends\nnotebooks: [./episode-1.yml]\n",
+			wantErr: "is not a block list",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, added, err := AddNotebookToIndexYAML([]byte(tt.data), "./episode-3.yml")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAdded, added)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
@@ -28,6 +28,13 @@ func NewLearningHistories(directory string) (map[string][]LearningHistory, error
 	})
 }
 
+// LearningHistorySource loads every notebook's learning history keyed by
+// notebook ID, the shape NewLearningHistories returns for a directory.
+// It lets readers serve history from storage other than YAML files.
+type LearningHistorySource interface {
+	LearningHistories() (map[string][]LearningHistory, error)
+}
+
 // ReadLearningHistoryFile loads a single notebook's learning history YAML.
 // Use this on the hot path of Skip/Resume RPCs to avoid the cost of
 // NewLearningHistories, which walks and parses every file in the directory.
@@ -186,6 +193,39 @@ type LearningRecord struct {
 	QuizType         string        `yaml:"quiz_type,omitempty"`         // "freeform" or "notebook"
 	IntervalDays     int           `yaml:"interval_days,omitempty"`     // days until next review
 	OverrideInterval int           `yaml:"override_interval,omitempty"` // manually-set interval (non-zero = user override)
+	// Answer is what the learner typed (or said), and GraderReason why
+	// Grader accepted or rejected it. All three are empty for logs
+	// recorded before answers were kept.
+	Answer       string `yaml:"answer,omitempty"`
+	GraderReason string `yaml:"grader_reason,omitempty"`
+	Grader       string `yaml:"grader,omitempty"`
+}
+
+// Graders of a LearningRecord: an inference model, a deterministic rule
+// such as a blank answer or a word-by-word comparison, or the learner
+// overriding either afterwards.
+const (
+	GraderModel         = "model"
+	GraderDeterministic = "deterministic"
+	GraderOverride      = "override"
+)
+
+// LogAnswer is the answer a new learning record is graded on.
+type LogAnswer struct {
+	Answer       string
+	GraderReason string
+	Grader       string
+}
+
+// applyTo stamps the answer onto record, leaving it as is when there
+// is no answer to record.
+func (a LogAnswer) applyTo(record *LearningRecord) {
+	if a == (LogAnswer{}) {
+		return
+	}
+	record.Answer = a.Answer
+	record.GraderReason = a.GraderReason
+	record.Grader = a.Grader
 }
 
 type LearningHistoryExpression struct {
@@ -224,12 +264,35 @@ type LearningHistoryExpression struct {
 	EtymologyOriginLogs           []LearningRecord `yaml:"etymology_origin_logs,omitempty"`
 	EtymologyOriginEasinessFactor float64          `yaml:"-"` // derived on the fly from logs
 
+	// Dictation quiz fields — the listening series of a word, recorded when
+	// a conversation line teaching it is played and typed back.
+	DictationLogs           []LearningRecord `yaml:"dictation_logs,omitempty"`
+	DictationEasinessFactor float64          `yaml:"-"` // derived on the fly from logs
+
+	// Word choice quiz fields — the series of a synonym or antonym concept
+	// member, recorded when it is told apart from the other members.
+	WordChoiceLogs           []LearningRecord `yaml:"word_choice_logs,omitempty"`
+	WordChoiceEasinessFactor float64          `yaml:"-"` // derived on the fly from logs
+
 	// SkippedAt records, per quiz type, when the user excluded this expression
 	// from that quiz mode. A word skipped only from `reverse` will still appear
 	// in `notebook` (standard) and `freeform`. Legacy plain-string YAML values
 	// (a single timestamp meaning "skipped from everywhere") are upgraded to a
 	// fully-populated map at unmarshal time.
 	SkippedAt SkippedAtMap `yaml:"skipped_at,omitempty"`
+
+	// RelearnAt records, per quiz type, when the expression was moved into
+	// the relearn pool as a leech. Such a word is re-drilled by the relearn
+	// quiz while its latest answer in that quiz type is wrong, however long
+	// ago the miss was; the next correct answer, or resuming the quiz type,
+	// clears the entry.
+	RelearnAt SkippedAtMap `yaml:"relearn_at,omitempty"`
+}
+
+// InRelearnPool reports whether the expression was moved into the relearn
+// pool for quizType.
+func (exp LearningHistoryExpression) InRelearnPool(quizType QuizType) bool {
+	return exp.RelearnAt[string(quizType)] != ""
 }
 
 // LearningExpressionType is the discriminator for LearningHistoryExpression.Type.
@@ -284,6 +347,18 @@ func allQuizTypeKeys() []string {
 		string(QuizTypeFreeform),
 		string(QuizTypeEtymologyOrigin),
 		string(QuizTypeGrammar),
+		string(QuizTypeDictation),
+		string(QuizTypeWordChoice),
+	}
+}
+
+// JSONSchema describes both accepted YAML forms for schema export.
+func (SkippedAtMap) JSONSchema() map[string]any {
+	return map[string]any{
+		"oneOf": []any{
+			map[string]any{"type": "string"},
+			map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}},
+		},
 	}
 }
 
@@ -395,6 +470,10 @@ func (exp LearningHistoryExpression) GetLogsForQuizType(quizType QuizType) []Lea
 		return exp.ReverseLogs
 	case QuizTypeEtymologyOrigin:
 		return exp.EtymologyOriginLogs
+	case QuizTypeDictation:
+		return exp.DictationLogs
+	case QuizTypeWordChoice:
+		return exp.WordChoiceLogs
 	default:
 		return exp.LearnedLogs
 	}
@@ -407,6 +486,10 @@ func (exp *LearningHistoryExpression) SetLogsForQuizType(quizType QuizType, logs
 		exp.ReverseLogs = logs
 	case QuizTypeEtymologyOrigin:
 		exp.EtymologyOriginLogs = logs
+	case QuizTypeDictation:
+		exp.DictationLogs = logs
+	case QuizTypeWordChoice:
+		exp.WordChoiceLogs = logs
 	default:
 		exp.LearnedLogs = logs
 	}
@@ -461,6 +544,16 @@ func (exp LearningHistoryExpression) GetEasinessFactorForQuizType(quizType QuizT
 			return DefaultEasinessFactor
 		}
 		return exp.EtymologyOriginEasinessFactor
+	case QuizTypeDictation:
+		if exp.DictationEasinessFactor == 0 {
+			return DefaultEasinessFactor
+		}
+		return exp.DictationEasinessFactor
+	case QuizTypeWordChoice:
+		if exp.WordChoiceEasinessFactor == 0 {
+			return DefaultEasinessFactor
+		}
+		return exp.WordChoiceEasinessFactor
 	default:
 		if exp.EasinessFactor == 0 {
 			return DefaultEasinessFactor
@@ -496,6 +589,9 @@ func (exp *LearningHistoryExpression) AddRecordWithQualityForReverse(
 	tentative.IntervalDays, _ = calculator.NextIntervalForWrite(exp.ReverseLogs, tentative)
 
 	exp.ReverseLogs = append([]LearningRecord{tentative}, exp.ReverseLogs...)
+	if isCorrect {
+		exp.leaveRelearnPool(quizType)
+	}
 }
 
 // NeedsEtymologyReview returns true if the expression needs etymology quiz review
@@ -627,7 +723,37 @@ func (exp LearningHistoryExpression) NeedsReverseReview() bool {
 	return elapsedDays >= threshold
 }
 
-// AddRecordWithQuality adds a new learning record with quality data.
+// ReviewDueAt returns when the log series of quizType becomes due for review,
+// using the same interval rules as NeedsForwardReview and NeedsReverseReview:
+// a misunderstood answer is due right away, otherwise after the stored
+// interval or the correct-streak fallback. ok is false when the series has no
+// logs, i.e. the expression has never been quizzed in that mode.
+func (exp LearningHistoryExpression) ReviewDueAt(quizType QuizType) (dueAt time.Time, ok bool) {
+	logs := exp.GetLogsForQuizType(quizType)
+	if len(logs) == 0 {
+		return time.Time{}, false
+	}
+
+	lastLog := logs[0]
+	if lastLog.Status == LearnedStatusMisunderstood {
+		return lastLog.LearnedAt.Time, true
+	}
+
+	threshold := lastLog.IntervalDays
+	if threshold == 0 {
+		correctCount := 0
+		for _, log := range logs {
+			if log.Status != LearnedStatusMisunderstood && log.Status != LearnedStatusLearning {
+				correctCount++
+			}
+		}
+		threshold = GetThresholdDaysFromCount(correctCount)
+	}
+	return lastLog.LearnedAt.Add(time.Duration(threshold) * 24 * time.Hour), true
+}
+
+// AddRecordWithQuality adds a new learning record with quality data to the
+// log series quizType is stored in (see GetLogsForQuizType).
 func (exp *LearningHistoryExpression) AddRecordWithQuality(
 	calculator IntervalCalculator,
 	isCorrect, isKnownWord bool,
@@ -651,9 +777,21 @@ func (exp *LearningHistoryExpression) AddRecordWithQuality(
 		ResponseTimeMs: responseTimeMs,
 		QuizType:       string(quizType),
 	}
-	tentative.IntervalDays, _ = calculator.NextIntervalForWrite(exp.LearnedLogs, tentative)
+	logs := exp.GetLogsForQuizType(quizType)
+	tentative.IntervalDays, _ = calculator.NextIntervalForWrite(logs, tentative)
+
+	exp.SetLogsForQuizType(quizType, append([]LearningRecord{tentative}, logs...))
+	if isCorrect {
+		exp.leaveRelearnPool(quizType)
+	}
+}
 
-	exp.LearnedLogs = append([]LearningRecord{tentative}, exp.LearnedLogs...)
+// leaveRelearnPool takes the expression out of the relearn pools a correct
+// answer in quizType ends (see RelearnPoolsLeftBy).
+func (exp *LearningHistoryExpression) leaveRelearnPool(quizType QuizType) {
+	for _, qt := range RelearnPoolsLeftBy(quizType) {
+		exp.RelearnAt.Clear(qt)
+	}
 }
 
 // IsExpressionSkipped checks whether a note is excluded from the given quiz
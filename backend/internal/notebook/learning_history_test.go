@@ -714,6 +714,7 @@ func TestLearningHistoryExpression_AddRecordWithQuality(t *testing.T) {
 		responseTimeMs int64
 		quizType       QuizType
 		wantStatus     LearnedStatus
+		wantRelearnAt  SkippedAtMap
 	}{
 		{
 			name: "correct known word",
@@ -763,6 +764,47 @@ func TestLearningHistoryExpression_AddRecordWithQuality(t *testing.T) {
 			quizType:       QuizTypeFreeform,
 			wantStatus:     LearnedStatusUnderstood,
 		},
+		{
+			name: "correct answer leaves the relearn pool of its quiz type",
+			expression: LearningHistoryExpression{
+				Expression: "hello",
+				RelearnAt:  SkippedAtMap{"notebook": "2025-06-01T00:00:00Z", "dictation": "2025-06-01T00:00:00Z"},
+			},
+			isCorrect:      true,
+			isKnownWord:    true,
+			quality:        int(QualityCorrect),
+			responseTimeMs: 3000,
+			quizType:       QuizTypeNotebook,
+			wantStatus:     LearnedStatusUnderstood,
+			wantRelearnAt:  SkippedAtMap{"dictation": "2025-06-01T00:00:00Z"},
+		},
+		{
+			name: "correct freeform answer leaves the notebook and reverse pools",
+			expression: LearningHistoryExpression{
+				Expression: "hello",
+				RelearnAt:  SkippedAtMap{"notebook": "2025-06-01T00:00:00Z", "reverse": "2025-06-01T00:00:00Z"},
+			},
+			isCorrect:      true,
+			isKnownWord:    false,
+			quality:        int(QualityCorrect),
+			responseTimeMs: 3000,
+			quizType:       QuizTypeFreeform,
+			wantStatus:     LearnedStatusCanBeUsed,
+			wantRelearnAt:  SkippedAtMap{},
+		},
+		{
+			name: "wrong answer stays in the relearn pool",
+			expression: LearningHistoryExpression{
+				Expression: "hello",
+				RelearnAt:  SkippedAtMap{"notebook": "2025-06-01T00:00:00Z"},
+			},
+			isCorrect:      false,
+			quality:        int(QualityWrong),
+			responseTimeMs: 3000,
+			quizType:       QuizTypeNotebook,
+			wantStatus:     LearnedStatusMisunderstood,
+			wantRelearnAt:  SkippedAtMap{"notebook": "2025-06-01T00:00:00Z"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -774,6 +816,7 @@ func TestLearningHistoryExpression_AddRecordWithQuality(t *testing.T) {
 			assert.Equal(t, tt.wantStatus, exp.LearnedLogs[0].Status)
 			assert.Equal(t, tt.quality, exp.LearnedLogs[0].Quality)
 			assert.Equal(t, string(tt.quizType), exp.LearnedLogs[0].QuizType)
+			assert.Equal(t, tt.wantRelearnAt, exp.RelearnAt)
 		})
 	}
 }
@@ -1078,6 +1121,79 @@ func TestLearningHistoryExpression_NeedsEtymologyReview(t *testing.T) {
 	}
 }
 
+func TestLearningHistoryExpression_ReviewDueAt(t *testing.T) {
+	learnedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expr     LearningHistoryExpression
+		quizType QuizType
+		wantDue  time.Time
+		wantOK   bool
+	}{
+		{
+			name:     "no logs - never quizzed",
+			expr:     LearningHistoryExpression{Expression: "test"},
+			quizType: QuizTypeNotebook,
+		},
+		{
+			name: "stored interval",
+			expr: LearningHistoryExpression{
+				Expression: "test",
+				LearnedLogs: []LearningRecord{
+					{Status: LearnedStatusUnderstood, LearnedAt: NewDate(learnedAt), IntervalDays: 6},
+				},
+			},
+			quizType: QuizTypeNotebook,
+			wantDue:  learnedAt.AddDate(0, 0, 6),
+			wantOK:   true,
+		},
+		{
+			name: "misunderstood - due when answered",
+			expr: LearningHistoryExpression{
+				Expression: "test",
+				ReverseLogs: []LearningRecord{
+					{Status: LearnedStatusMisunderstood, LearnedAt: NewDate(learnedAt), IntervalDays: 1},
+				},
+			},
+			quizType: QuizTypeReverse,
+			wantDue:  learnedAt,
+			wantOK:   true,
+		},
+		{
+			name: "no stored interval - falls back to correct count",
+			expr: LearningHistoryExpression{
+				Expression: "test",
+				EtymologyOriginLogs: []LearningRecord{
+					{Status: LearnedStatusUnderstood, LearnedAt: NewDate(learnedAt)},
+					{Status: LearnedStatusUnderstood, LearnedAt: NewDate(learnedAt.AddDate(0, 0, -3))},
+				},
+			},
+			quizType: QuizTypeEtymologyOrigin,
+			wantDue:  learnedAt.AddDate(0, 0, GetThresholdDaysFromCount(2)),
+			wantOK:   true,
+		},
+		{
+			name: "other series are ignored",
+			expr: LearningHistoryExpression{
+				Expression: "test",
+				LearnedLogs: []LearningRecord{
+					{Status: LearnedStatusUnderstood, LearnedAt: NewDate(learnedAt), IntervalDays: 6},
+				},
+			},
+			quizType: QuizTypeReverse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDue, gotOK := tt.expr.ReviewDueAt(tt.quizType)
+			assert.Equal(t, tt.wantOK, gotOK)
+			assert.True(t, tt.wantDue.Equal(gotDue), "want %s, got %s", tt.wantDue, gotDue)
+		})
+	}
+}
+
 func TestLearningHistoryExpression_GetLogsForQuizType_Etymology(t *testing.T) {
 	originLogs := []LearningRecord{{Status: LearnedStatusUnderstood, Quality: 4}}
 	learnedLogs := []LearningRecord{{Status: LearnedStatusCanBeUsed, Quality: 5}}
@@ -1098,6 +1214,33 @@ func TestLearningHistoryExpression_GetLogsForQuizType_Etymology(t *testing.T) {
 	})
 }
 
+func TestLearningHistoryExpression_LogsForQuizType_Dictation(t *testing.T) {
+	dictationLogs := []LearningRecord{{Status: LearnedStatusMisunderstood, Quality: 1}}
+	learnedLogs := []LearningRecord{{Status: LearnedStatusUnderstood, Quality: 4}}
+
+	expr := LearningHistoryExpression{
+		LearnedLogs:             learnedLogs,
+		DictationLogs:           dictationLogs,
+		DictationEasinessFactor: 2.2,
+	}
+
+	t.Run("dictation returns dictation logs", func(t *testing.T) {
+		assert.Equal(t, dictationLogs, expr.GetLogsForQuizType(QuizTypeDictation))
+	})
+
+	t.Run("dictation returns dictation EF", func(t *testing.T) {
+		assert.InDelta(t, 2.2, expr.GetEasinessFactorForQuizType(QuizTypeDictation), 0.001)
+	})
+
+	t.Run("setting dictation logs leaves learned logs alone", func(t *testing.T) {
+		updated := expr
+		replaced := []LearningRecord{{Status: LearnedStatusUnderstood, Quality: 5}}
+		updated.SetLogsForQuizType(QuizTypeDictation, replaced)
+		assert.Equal(t, replaced, updated.DictationLogs)
+		assert.Equal(t, learnedLogs, updated.LearnedLogs)
+	})
+}
+
 func TestLearningHistoryExpression_GetEasinessFactorForQuizType_Etymology(t *testing.T) {
 	expr := LearningHistoryExpression{
 		EtymologyOriginEasinessFactor: 2.1,
@@ -19,6 +19,7 @@ func normalizeQuotes(s string) string {
 type LearningHistoryUpdater struct {
 	history    []LearningHistory
 	calculator IntervalCalculator
+	answer     LogAnswer
 }
 
 // NewLearningHistoryUpdater creates a new updater with the given history and calculator.
@@ -32,6 +33,22 @@ func NewLearningHistoryUpdater(history []LearningHistory, calculator IntervalCal
 	}
 }
 
+// WithAnswer records answer on every log the updater adds afterwards.
+func (u *LearningHistoryUpdater) WithAnswer(answer LogAnswer) *LearningHistoryUpdater {
+	u.answer = answer
+	return u
+}
+
+// stampAnswer records the updater's answer on the newest log of list,
+// the one just added to expr.
+func (u *LearningHistoryUpdater) stampAnswer(expr *LearningHistoryExpression, list logList) {
+	logs := getLogsByList(expr, list)
+	if len(logs) == 0 {
+		return
+	}
+	u.answer.applyTo(&logs[0])
+}
+
 // findOrCreateStory finds an existing story or creates a new one.
 // flatType is a non-empty string (e.g. "flashcard", "etymology") when the
 // history should use top-level Expressions instead of nested Scenes.
@@ -118,6 +135,7 @@ func (u *LearningHistoryUpdater) UpdateOrCreateExpressionWithQuality(
 					exp.ID = id
 				}
 				exp.AddRecordWithQuality(u.calculator, isCorrect, isKnownWord, quality, responseTimeMs, quizType)
+				u.stampAnswer(&exp, quizType.PrimaryLogList())
 				u.history[hi].Expressions[ei] = exp
 				return true
 			}
@@ -137,6 +155,7 @@ func (u *LearningHistoryUpdater) UpdateOrCreateExpressionWithQuality(
 					exp.ID = id
 				}
 				exp.AddRecordWithQuality(u.calculator, isCorrect, isKnownWord, quality, responseTimeMs, quizType)
+				u.stampAnswer(&exp, quizType.PrimaryLogList())
 				u.history[hi].Scenes[si].Expressions[ei] = exp
 				return true
 			}
@@ -175,6 +194,7 @@ func (u *LearningHistoryUpdater) UpdateOrCreateExpressionWithQualityForReverse(
 					exp.ID = id
 				}
 				exp.AddRecordWithQualityForReverse(u.calculator, isCorrect, isKnownWord, quality, responseTimeMs, quizType)
+				u.stampAnswer(&exp, listReverse)
 				u.history[hi].Expressions[ei] = exp
 				return true
 			}
@@ -194,6 +214,7 @@ func (u *LearningHistoryUpdater) UpdateOrCreateExpressionWithQualityForReverse(
 					exp.ID = id
 				}
 				exp.AddRecordWithQualityForReverse(u.calculator, isCorrect, isKnownWord, quality, responseTimeMs, quizType)
+				u.stampAnswer(&exp, listReverse)
 				u.history[hi].Scenes[si].Expressions[ei] = exp
 				return true
 			}
@@ -222,6 +243,7 @@ func (u *LearningHistoryUpdater) createNewExpressionWithQualityForReverse(
 		ReverseLogs: []LearningRecord{},
 	}
 	newExpression.AddRecordWithQualityForReverse(u.calculator, isCorrect, isKnownWord, quality, responseTimeMs, quizType)
+	u.stampAnswer(&newExpression, listReverse)
 
 	if len(newExpression.ReverseLogs) == 0 {
 		return
@@ -259,8 +281,9 @@ func (u *LearningHistoryUpdater) createNewExpressionWithQuality(
 		LearnedLogs: []LearningRecord{},
 	}
 	newExpression.AddRecordWithQuality(u.calculator, isCorrect, isKnownWord, quality, responseTimeMs, quizType)
+	u.stampAnswer(&newExpression, quizType.PrimaryLogList())
 
-	if len(newExpression.LearnedLogs) == 0 {
+	if len(newExpression.GetLogsForQuizType(quizType)) == 0 {
 		return
 	}
 
@@ -403,6 +426,7 @@ type OverrideLogResult struct {
 	OriginalQuality      int
 	OriginalStatus       string
 	OriginalIntervalDays int
+	OriginalGrader       string
 	NewNextReviewDate    string
 	Found                bool
 }
@@ -429,6 +453,7 @@ func (u *LearningHistoryUpdater) OverrideLog(in OverrideLogInput) OverrideLogRes
 		OriginalQuality:      logs[idx].Quality,
 		OriginalStatus:       string(logs[idx].Status),
 		OriginalIntervalDays: logs[idx].IntervalDays,
+		OriginalGrader:       logs[idx].Grader,
 	}
 
 	if in.MarkCorrect != nil {
@@ -458,6 +483,7 @@ type UndoOverrideLogInput struct {
 	OriginalQuality      int
 	OriginalStatus       string
 	OriginalIntervalDays int
+	OriginalGrader       string
 	// SessionTitle and Sense — see OverrideLogInput.
 	SessionTitle string
 	Sense        string
@@ -491,6 +517,7 @@ func (u *LearningHistoryUpdater) UndoOverrideLog(in UndoOverrideLogInput) UndoOv
 	logs[idx].Status = LearnedStatus(in.OriginalStatus)
 	logs[idx].IntervalDays = in.OriginalIntervalDays
 	logs[idx].OverrideInterval = 0
+	logs[idx].Grader = in.OriginalGrader
 	setLogsByList(expr, primary, logs)
 	mirrorOverrideToPair(expr, in.QuizType, logs[idx])
 
@@ -505,7 +532,10 @@ func (u *LearningHistoryUpdater) UndoOverrideLog(in UndoOverrideLogInput) UndoOv
 // Standard / reverse / etymology-non-freeform use the binary
 // understood/misunderstood pair; freeform variants use can-be-used to
 // match the higher bar the freeform write path sets (active recall).
+// The log's grader becomes the override, while its answer and the
+// original grader's reason are kept.
 func applyMark(log *LearningRecord, markCorrect bool, quizType QuizType) {
+	log.Grader = GraderOverride
 	if !markCorrect {
 		log.Quality = 1
 		log.Status = LearnedStatusMisunderstood
@@ -560,6 +590,7 @@ func mirrorOverrideToPair(expr *LearningHistoryExpression, quizType QuizType, up
 	pairLogs[idx].Status = updated.Status
 	pairLogs[idx].IntervalDays = updated.IntervalDays
 	pairLogs[idx].OverrideInterval = updated.OverrideInterval
+	pairLogs[idx].Grader = updated.Grader
 	setLogsByList(expr, pair, pairLogs)
 }
 
@@ -573,6 +604,8 @@ const (
 	listLearned logList = iota
 	listReverse
 	listEtymologyOrigin
+	listDictation
+	listWordChoice
 )
 
 // PrimaryLogList returns the log list a single OverrideLog call mutates
@@ -585,6 +618,10 @@ func (qt QuizType) PrimaryLogList() logList {
 		return listReverse
 	case QuizTypeEtymologyOrigin:
 		return listEtymologyOrigin
+	case QuizTypeDictation:
+		return listDictation
+	case QuizTypeWordChoice:
+		return listWordChoice
 	default:
 		return listLearned
 	}
@@ -596,6 +633,10 @@ func getLogsByList(expr *LearningHistoryExpression, list logList) []LearningReco
 		return expr.ReverseLogs
 	case listEtymologyOrigin:
 		return expr.EtymologyOriginLogs
+	case listDictation:
+		return expr.DictationLogs
+	case listWordChoice:
+		return expr.WordChoiceLogs
 	default:
 		return expr.LearnedLogs
 	}
@@ -607,6 +648,10 @@ func setLogsByList(expr *LearningHistoryExpression, list logList, logs []Learnin
 		expr.ReverseLogs = logs
 	case listEtymologyOrigin:
 		expr.EtymologyOriginLogs = logs
+	case listDictation:
+		expr.DictationLogs = logs
+	case listWordChoice:
+		expr.WordChoiceLogs = logs
 	default:
 		expr.LearnedLogs = logs
 	}
@@ -623,6 +668,30 @@ func (u *LearningHistoryUpdater) SetSkippedAt(expression, id string, quizType Qu
 	return true
 }
 
+// SetRelearnAt moves the expression into the relearn pool for the given
+// quiz type at the given timestamp. Returns false if the expression isn't
+// found in any history.
+func (u *LearningHistoryUpdater) SetRelearnAt(expression, id string, quizType QuizType, relearnAt string) bool {
+	expr := u.FindExpressionByID(id, expression)
+	if expr == nil {
+		return false
+	}
+	expr.RelearnAt = expr.RelearnAt.Set(quizType, relearnAt)
+	return true
+}
+
+// ClearRelearnAt takes the expression out of the relearn pool for the
+// given quiz type. Returns false if the expression isn't found in any
+// history.
+func (u *LearningHistoryUpdater) ClearRelearnAt(expression, id string, quizType QuizType) bool {
+	expr := u.FindExpressionByID(id, expression)
+	if expr == nil {
+		return false
+	}
+	expr.RelearnAt.Clear(quizType)
+	return true
+}
+
 // EnsureExpressionStubForSkip creates a learned-log-free stub for the
 // expression at (notebookID, storyTitle, sceneTitle) when no entry exists
 // yet. The stub holds only the expression name; SetSkippedAt then writes
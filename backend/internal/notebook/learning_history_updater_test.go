@@ -1073,3 +1073,84 @@ func TestLearningHistoryUpdater_IDRouting(t *testing.T) {
 		assert.Len(t, got[0].LearnedLogs, 2, "the new log is appended onto the existing series")
 	})
 }
+
+func TestLearningHistoryUpdater_WithAnswer(t *testing.T) {
+	answer := LogAnswer{Answer: "a bank of a river", GraderReason: "Matches the meaning.", Grader: GraderModel}
+
+	t.Run("stamps the answer on the new log only", func(t *testing.T) {
+		history := []LearningHistory{{
+			Metadata: LearningHistoryMetadata{NotebookID: "nb", Title: "flashcards", Type: "flashcard"},
+			Expressions: []LearningHistoryExpression{
+				{Expression: "bank", ID: "bank-river", LearnedLogs: []LearningRecord{
+					{Status: LearnedStatusUnderstood, LearnedAt: NewDate(time.Now().AddDate(0, 0, -3)), Quality: 4},
+				}},
+			},
+		}}
+		updater := NewLearningHistoryUpdater(history, &SM2Calculator{}).WithAnswer(answer)
+
+		found := updater.UpdateOrCreateExpressionWithQuality(
+			"nb", "flashcards", "", "bank", "", "bank-river",
+			true, true, 4, 0, QuizTypeNotebook,
+		)
+		require.True(t, found)
+
+		logs := updater.GetHistory()[0].Expressions[0].LearnedLogs
+		require.Len(t, logs, 2)
+		assert.Equal(t, "a bank of a river", logs[0].Answer)
+		assert.Equal(t, "Matches the meaning.", logs[0].GraderReason)
+		assert.Equal(t, GraderModel, logs[0].Grader)
+		assert.Empty(t, logs[1].Answer, "older logs keep no answer")
+	})
+
+	t.Run("reverse answer on a new expression", func(t *testing.T) {
+		history := []LearningHistory{{
+			Metadata: LearningHistoryMetadata{NotebookID: "nb", Title: "flashcards", Type: "flashcard"},
+		}}
+		updater := NewLearningHistoryUpdater(history, &SM2Calculator{}).WithAnswer(answer)
+
+		updater.UpdateOrCreateExpressionWithQualityForReverse(
+			"nb", "flashcards", "", "bank", "", "bank-river",
+			false, false, 1, 0, QuizTypeReverse,
+		)
+
+		exprs := updater.GetHistory()[0].Expressions
+		require.Len(t, exprs, 1)
+		require.Len(t, exprs[0].ReverseLogs, 1)
+		assert.Equal(t, "a bank of a river", exprs[0].ReverseLogs[0].Answer)
+	})
+
+	t.Run("override keeps the answer and undo restores the grader", func(t *testing.T) {
+		learnedAt := NewDate(time.Date(2026, 6, 29, 10, 0, 0, 0, time.UTC))
+		history := []LearningHistory{{
+			Metadata: LearningHistoryMetadata{NotebookID: "nb", Title: "flashcards", Type: "flashcard"},
+			Expressions: []LearningHistoryExpression{
+				{Expression: "bank", ID: "bank-river", LearnedLogs: []LearningRecord{{
+					Status: LearnedStatusMisunderstood, LearnedAt: learnedAt, Quality: 1, IntervalDays: 1,
+					Answer: "a shore", GraderReason: "Too vague.", Grader: GraderModel,
+				}}},
+			},
+		}}
+		updater := NewLearningHistoryUpdater(history, &SM2Calculator{})
+
+		markCorrect := true
+		res := updater.OverrideLog(OverrideLogInput{
+			ID: "bank-river", Expression: "bank", QuizType: QuizTypeNotebook,
+			LearnedAt: "2026-06-29", MarkCorrect: &markCorrect,
+		})
+		require.True(t, res.Found)
+		assert.Equal(t, GraderModel, res.OriginalGrader)
+
+		log := updater.GetHistory()[0].Expressions[0].LearnedLogs[0]
+		assert.Equal(t, GraderOverride, log.Grader)
+		assert.Equal(t, "a shore", log.Answer)
+		assert.Equal(t, "Too vague.", log.GraderReason)
+
+		undo := updater.UndoOverrideLog(UndoOverrideLogInput{
+			ID: "bank-river", Expression: "bank", QuizType: QuizTypeNotebook, LearnedAt: "2026-06-29",
+			OriginalQuality: res.OriginalQuality, OriginalStatus: res.OriginalStatus,
+			OriginalIntervalDays: res.OriginalIntervalDays, OriginalGrader: res.OriginalGrader,
+		})
+		require.True(t, undo.Found)
+		assert.Equal(t, GraderModel, updater.GetHistory()[0].Expressions[0].LearnedLogs[0].Grader)
+	})
+}
@@ -0,0 +1,168 @@
+package notebook
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MemoEntry is a source vocabulary entry that has no memo yet.
+type MemoEntry struct {
+	Note Note
+	// Contexts are the lines of the entry's scene, conversation quotes and
+	// statements, that mention it. Empty for flashcards and definitions
+	// books, whose files carry no scene text.
+	Contexts []string
+}
+
+// AddMemosToSourceYAML calls memoFor for every vocabulary entry of one
+// source-notebook file that lacks a `memo` key, and inserts the returned
+// text as `memo:` after the entry's expression. Entries memoFor returns ""
+// for are left unchanged. Like AddAudioToSourceYAML the edit is add-only:
+// the new lines are spliced into the original text, and the original bytes
+// are returned when nothing is added.
+func AddMemosToSourceYAML(data []byte, memoFor func(MemoEntry) (string, error)) ([]byte, int, error) {
+	return addToSourceEntries(data, "memo", func(e sceneEntry, note Note, _ int) (string, error) {
+		memo, err := memoFor(MemoEntry{Note: note, Contexts: mentioningLines(e.sceneLines, note)})
+		if err != nil {
+			return "", err
+		}
+		value, err := inlineYAMLScalar(memo)
+		if err != nil || value == "" {
+			return "", err
+		}
+		return "memo: " + value, nil
+	})
+}
+
+// addToSourceEntries calls linesFor for every vocabulary entry of one
+// source-notebook file that lacks key, and splices the returned lines after
+// the entry's expression. linesFor gets the entry decoded and the column
+// its keys are indented to; entries it returns "" for are left unchanged.
+func addToSourceEntries(data []byte, key string, linesFor func(e sceneEntry, note Note, indent int) (string, error)) ([]byte, int, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, 0, fmt.Errorf("unmarshal source yaml: %w", err)
+	}
+
+	var plan []lineInsertion
+	for _, e := range collectSceneEntries(&doc) {
+		if mappingHasKey(e.mapping, key) {
+			continue
+		}
+		exprKey := mappingKeyNode(e.mapping, "expression")
+		if exprKey == nil {
+			continue
+		}
+		var note Note
+		if err := e.mapping.Decode(&note); err != nil {
+			return nil, 0, fmt.Errorf("decode entry at line %d: %w", exprKey.Line, err)
+		}
+		note.Expression = strings.TrimSpace(note.Expression)
+		if note.Expression == "" {
+			continue
+		}
+		lines, err := linesFor(e, note, exprKey.Column-1)
+		if err != nil {
+			return nil, 0, err
+		}
+		if lines == "" {
+			continue
+		}
+		plan = append(plan, lineInsertion{afterLine: exprKey.Line, indent: exprKey.Column - 1, line: lines})
+	}
+
+	if len(plan) == 0 {
+		return data, 0, nil
+	}
+	return insertLines(data, plan), len(plan), nil
+}
+
+// inlineYAMLScalar renders s as a single-line YAML scalar, collapsing any
+// line breaks so the spliced line never becomes a block scalar.
+func inlineYAMLScalar(s string) (string, error) {
+	s = strings.Join(strings.Fields(s), " ")
+	if s == "" {
+		return "", nil
+	}
+	out, err := yaml.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal %q: %w", s, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// sceneEntry is a vocabulary-entry mapping with the text of the scene it
+// belongs to.
+type sceneEntry struct {
+	mapping    *yaml.Node
+	sceneLines []string
+}
+
+// collectSceneEntries returns the same mappings as collectEntryMappings,
+// each with the conversation quotes and statements of the nearest
+// enclosing mapping that has any.
+func collectSceneEntries(n *yaml.Node) []sceneEntry {
+	var out []sceneEntry
+	var walk func(node *yaml.Node, lines []string)
+	walk = func(node *yaml.Node, lines []string) {
+		if node == nil {
+			return
+		}
+		if node.Kind == yaml.MappingNode {
+			if mappingHasKey(node, "conversations") || mappingHasKey(node, "statements") {
+				lines = sceneText(node)
+			}
+			if mappingHasKey(node, "expression") {
+				out = append(out, sceneEntry{mapping: node, sceneLines: lines})
+			}
+		}
+		for _, c := range node.Content {
+			walk(c, lines)
+		}
+	}
+	walk(n, nil)
+	return out
+}
+
+// sceneText returns the conversation quotes and statements of a scene
+// mapping, in file order.
+func sceneText(scene *yaml.Node) []string {
+	var lines []string
+	for i := 0; i+1 < len(scene.Content); i += 2 {
+		value := scene.Content[i+1]
+		if value.Kind != yaml.SequenceNode {
+			continue
+		}
+		switch scene.Content[i].Value {
+		case "conversations":
+			for _, c := range value.Content {
+				if quote := mappingScalarValue(c, "quote"); quote != "" {
+					lines = append(lines, quote)
+				}
+			}
+		case "statements":
+			for _, s := range value.Content {
+				if s.Kind == yaml.ScalarNode && s.Value != "" {
+					lines = append(lines, s.Value)
+				}
+			}
+		}
+	}
+	return lines
+}
+
+// mentioningLines returns the lines that contain the note's expression or
+// its dictionary form, case-insensitively.
+func mentioningLines(lines []string, note Note) []string {
+	var out []string
+	for _, line := range lines {
+		lower := strings.ToLower(line)
+		if strings.Contains(lower, strings.ToLower(note.Expression)) ||
+			(note.Definition != "" && strings.Contains(lower, strings.ToLower(note.Definition))) {
+			out = append(out, strings.TrimSpace(line))
+		}
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package notebook
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddMemosToSourceYAML(t *testing.T) {
+	src := "- event: Pilot\n" +
+		"  scenes:\n" +
+		"    - scene: Office\n" +
+		"      conversations:\n" +
+		"        - speaker: Ann\n" +
+		"          quote: \"Let's break the ice with a game.\"\n" +
+		"        - speaker: Bob\n" +
+		"          quote: Sure.\n" +
+		"      definitions:\n" +
+		"        - id: break-the-ice\n" +
+		"          expression: break the ice\n" +
+		"          meaning: 'to start a conversation'\n" +
+		"          origin_parts:\n" +
+		"            - origin: glacies\n" +
+		"              language: Latin\n" +
+		"        - expression: candid\n" +
+		"          memo: hand-written\n" +
+		"        - expression: sure\n"
+
+	var entries []MemoEntry
+	got, added, err := AddMemosToSourceYAML([]byte(src), func(entry MemoEntry) (string, error) {
+		entries = append(entries, entry)
+		if entry.Note.Expression == "sure" {
+			return "", nil
+		}
+		return "Picture a ship\nsmashing ice: talk: starts", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, added)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "break-the-ice", entries[0].Note.ID)
+	assert.Equal(t, "to start a conversation", entries[0].Note.Meaning)
+	assert.Equal(t, []OriginPartRef{{Origin: "glacies", Language: "Latin"}}, entries[0].Note.OriginParts)
+	assert.Equal(t, []string{"Let's break the ice with a game."}, entries[0].Contexts)
+	assert.Equal(t, []string{"Sure."}, entries[1].Contexts)
+	assert.Equal(t, "- event: Pilot\n"+
+		"  scenes:\n"+
+		"    - scene: Office\n"+
+		"      conversations:\n"+
+		"        - speaker: Ann\n"+
+		"          quote: \"Let's break the ice with a game.\"\n"+
+		"        - speaker: Bob\n"+
+		"          quote: Sure.\n"+
+		"      definitions:\n"+
+		"        - id: break-the-ice\n"+
+		"          expression: break the ice\n"+
+		"          memo: 'Picture a ship smashing ice: talk: starts'\n"+
+		"          meaning: 'to start a conversation'\n"+
+		"          origin_parts:\n"+
+		"            - origin: glacies\n"+
+		"              language: Latin\n"+
+		"        - expression: candid\n"+
+		"          memo: hand-written\n"+
+		"        - expression: sure\n", string(got))
+}
+
+func TestAddMemosToSourceYAML_NothingAdded(t *testing.T) {
+	src := "- title: Week 1\n  cards:\n    - expression: candid\n"
+
+	got, added, err := AddMemosToSourceYAML([]byte(src), func(entry MemoEntry) (string, error) {
+		assert.Empty(t, entry.Contexts)
+		return "  ", nil
+	})
+	require.NoError(t, err)
+	assert.Zero(t, added)
+	assert.Equal(t, src, string(got))
+
+	_, _, err = AddMemosToSourceYAML([]byte(src), func(MemoEntry) (string, error) { return "", fmt.Errorf("rate limited") })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rate limited")
+}
@@ -0,0 +1,221 @@
+package notebook
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+)
+
+// NewReaderFromNotes builds a Reader over note and notebook records instead
+// of notebook directories, for deployments where the database is the source
+// of truth. Story, book and flashcard notebooks are rebuilt from each
+// record's notebook_notes rows the same way YAMLNoteRepository.WriteAll lays
+// them out, named and given their scene prose by the notebook records, so a
+// Reader built here serves the same notebooks as one over the exported
+// YAML. Definitions books are imported as book notes and served as book
+// notebooks. Etymology notebooks, journals and grammar annotations are not
+// stored in the database and are absent.
+func NewReaderFromNotes(notes []NoteRecord, notebooks []NotebookRecord, dictionaryMap map[string]rapidapi.Response) *Reader {
+	indexes := make(map[string]Index)
+	flashcardIndexes := make(map[string]FlashcardIndex)
+
+	records := make(map[notebookKey]NotebookRecord, len(notebooks))
+	for _, nb := range notebooks {
+		records[notebookKey{notebookType: nb.NotebookType, notebookID: nb.NotebookID}] = nb
+	}
+	keys, grouped := groupNotesByNotebook(notes)
+	for key := range records {
+		if _, ok := grouped[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		record := records[key]
+		name := cmp.Or(record.Name, key.notebookID)
+		switch key.notebookType {
+		case "story", "book":
+			stories := withSceneProse(buildStoryNotebooks(grouped[key]), record.Scenes)
+			indexes[key.notebookID] = Index{
+				IsBook:    key.notebookType == "book",
+				Kind:      key.notebookType,
+				ID:        key.notebookID,
+				Name:      name,
+				Notebooks: [][]StoryNotebook{stories},
+				preloaded: stories,
+			}
+		case "flashcard":
+			cards := buildFlashcardNotebooks(grouped[key])
+			flashcardIndexes[key.notebookID] = FlashcardIndex{
+				ID:        key.notebookID,
+				Name:      name,
+				Notebooks: cards,
+				preloaded: cards,
+			}
+		}
+	}
+
+	return &Reader{
+		indexes:          indexes,
+		flashcardIndexes: flashcardIndexes,
+		etymologyIndexes: make(map[string]EtymologyIndex),
+		grammarsMap:      make(map[string]map[string]map[int][]Correction),
+		journalIDs:       make(map[string]struct{}),
+		dictionaryMap:    dictionaryMap,
+		definitionsMap:   make(DefinitionsMap),
+		definitionsRaw:   make(map[string][]Definitions),
+		definitionsDates: make(map[string]time.Time),
+		definitionsPaths: make(map[string]string),
+		grammarsPaths:    make(map[string]string),
+	}
+}
+
+// withSceneProse lays the scenes out in the order of their scene records,
+// with the conversations and statements the records carry. Scenes that
+// have notes but no record follow in the order the notes put them.
+func withSceneProse(notebooks []StoryNotebook, scenes []NotebookSceneRecord) []StoryNotebook {
+	if len(scenes) == 0 {
+		return notebooks
+	}
+	type sceneKey struct{ event, scene string }
+	definitions := make(map[sceneKey][]Note)
+	for _, nb := range notebooks {
+		for _, scene := range nb.Scenes {
+			definitions[sceneKey{nb.Event, scene.Title}] = scene.Definitions
+		}
+	}
+
+	var result []StoryNotebook
+	eventIndex := make(map[string]int)
+	placed := make(map[sceneKey]bool)
+	addScene := func(event string, scene StoryScene) {
+		i, ok := eventIndex[event]
+		if !ok {
+			i = len(result)
+			eventIndex[event] = i
+			result = append(result, StoryNotebook{Event: event})
+		}
+		result[i].Scenes = append(result[i].Scenes, scene)
+	}
+	for _, record := range scenes {
+		key := sceneKey{record.Group, record.Subgroup}
+		if placed[key] {
+			continue
+		}
+		placed[key] = true
+		addScene(record.Group, StoryScene{
+			Title:         record.Subgroup,
+			Conversations: record.Conversations,
+			Statements:    record.Statements,
+			Definitions:   definitions[key],
+		})
+	}
+	for _, nb := range notebooks {
+		for _, scene := range nb.Scenes {
+			if !placed[sceneKey{nb.Event, scene.Title}] {
+				addScene(nb.Event, scene)
+			}
+		}
+	}
+	return result
+}
+
+// cloneStoryNotebooks copies notebooks down to the definitions of each
+// scene, so callers may modify what a cached Reader returns.
+func cloneStoryNotebooks(notebooks []StoryNotebook) []StoryNotebook {
+	result := slices.Clone(notebooks)
+	for i := range result {
+		result[i].Scenes = slices.Clone(result[i].Scenes)
+		for j := range result[i].Scenes {
+			result[i].Scenes[j].Definitions = slices.Clone(result[i].Scenes[j].Definitions)
+		}
+	}
+	return result
+}
+
+// cloneFlashcardNotebooks copies notebooks down to their cards, so callers
+// may modify what a cached Reader returns.
+func cloneFlashcardNotebooks(notebooks []FlashcardNotebook) []FlashcardNotebook {
+	result := slices.Clone(notebooks)
+	for i := range result {
+		result[i].Cards = slices.Clone(result[i].Cards)
+	}
+	return result
+}
+
+// NoteReaderSource is a ReaderSource that builds a Reader from the notes
+// and notebooks of a NoteRepository, so edits made through the repository
+// are visible to the next request. When the repository can fingerprint its
+// tables (see DBNoteRepository.NotesVersion) the Reader is cached and
+// reused until the fingerprint changes.
+type NoteReaderSource struct {
+	repository    NoteRepository
+	dictionaryMap map[string]rapidapi.Response
+
+	mu      sync.Mutex
+	version string
+	reader  *Reader
+}
+
+// notebookFinder is implemented by note repositories that store notebook
+// names and scene prose (DBNoteRepository, YAMLNoteRepository).
+type notebookFinder interface {
+	FindNotebooks(ctx context.Context) ([]NotebookRecord, error)
+}
+
+// notesVersioner is implemented by note repositories that can tell whether
+// anything a Reader is built from has changed.
+type notesVersioner interface {
+	NotesVersion(ctx context.Context) (string, error)
+}
+
+// NewNoteReaderSource returns a ReaderSource backed by repository.
+func NewNoteReaderSource(repository NoteRepository, dictionaryMap map[string]rapidapi.Response) *NoteReaderSource {
+	return &NoteReaderSource{repository: repository, dictionaryMap: dictionaryMap}
+}
+
+// Reader implements ReaderSource.
+func (s *NoteReaderSource) Reader() (*Reader, error) {
+	ctx := context.Background()
+	versioner, ok := s.repository.(notesVersioner)
+	if !ok {
+		return s.build(ctx)
+	}
+
+	version, err := versioner.NotesVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository.NotesVersion() > %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.reader != nil && s.version == version {
+		return s.reader, nil
+	}
+	reader, err := s.build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.version = version
+	s.reader = reader
+	return reader, nil
+}
+
+// build loads every note and notebook and builds a Reader over them.
+func (s *NoteReaderSource) build(ctx context.Context) (*Reader, error) {
+	notes, err := s.repository.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository.FindAll() > %w", err)
+	}
+	var notebooks []NotebookRecord
+	if finder, ok := s.repository.(notebookFinder); ok {
+		notebooks, err = finder.FindNotebooks(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("repository.FindNotebooks() > %w", err)
+		}
+	}
+	return NewReaderFromNotes(notes, notebooks, s.dictionaryMap), nil
+}
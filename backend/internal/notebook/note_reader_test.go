@@ -0,0 +1,168 @@
+package notebook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReaderFromNotes(t *testing.T) {
+	notes := []NoteRecord{
+		{
+			SenseID: "tricky", Usage: "tricky", Entry: "tricky", Meaning: "difficult to deal with",
+			NotebookNotes: []NotebookNote{
+				{NotebookType: "story", NotebookID: "friends", Group: "Episode 1", Subgroup: "Scene 1"},
+				{NotebookType: "flashcard", NotebookID: "adjectives", Group: "Common"},
+			},
+		},
+		{
+			Usage: "ran", Entry: "run", Meaning: "move fast",
+			NotebookNotes: []NotebookNote{
+				{NotebookType: "story", NotebookID: "friends", Group: "Episode 1", Subgroup: "Scene 2"},
+			},
+		},
+		{
+			Usage: "verbose", Entry: "verbose", Meaning: "using more words than needed",
+			NotebookNotes: []NotebookNote{
+				{NotebookType: "book", NotebookID: "word-power", Group: "Session 1", Subgroup: "Words"},
+			},
+		},
+	}
+
+	notebooks := []NotebookRecord{
+		{
+			NotebookType: "story", NotebookID: "friends", Name: "Friends",
+			Scenes: []NotebookSceneRecord{
+				{Group: "Episode 1", Subgroup: "Opening", Statements: []string{"Central Perk, morning."}},
+				{Group: "Episode 1", Subgroup: "Scene 1", Conversations: []Conversation{{Speaker: "Ross", Quote: "That's tricky."}}},
+			},
+		},
+	}
+
+	reader := NewReaderFromNotes(notes, notebooks, nil)
+
+	stories, err := reader.ReadStoryNotebooks("friends")
+	require.NoError(t, err)
+	assert.Equal(t, []StoryNotebook{
+		{
+			Event: "Episode 1",
+			Scenes: []StoryScene{
+				{Title: "Opening", Statements: []string{"Central Perk, morning."}},
+				{
+					Title:         "Scene 1",
+					Conversations: []Conversation{{Speaker: "Ross", Quote: "That's tricky."}},
+					Definitions:   []Note{{ID: "tricky", Expression: "tricky", Meaning: "difficult to deal with"}},
+				},
+				{Title: "Scene 2", Definitions: []Note{{Expression: "ran", Definition: "run", Meaning: "move fast"}}},
+			},
+		},
+	}, stories)
+	assert.Equal(t, "Friends", reader.GetStoryIndexes()["friends"].Name)
+	assert.Equal(t, "adjectives", reader.GetFlashcardIndexes()["adjectives"].Name, "a notebook without a record is named by its ID")
+
+	// Reading again returns the same notebooks rather than accumulating.
+	again, err := reader.ReadStoryNotebooks("friends")
+	require.NoError(t, err)
+	assert.Equal(t, stories, again)
+
+	// Changing what was read leaves the reader's notebooks alone.
+	again[0].Scenes[1].Definitions[0].Meaning = "changed"
+	stories, err = reader.ReadStoryNotebooks("friends")
+	require.NoError(t, err)
+	assert.Equal(t, "difficult to deal with", stories[0].Scenes[1].Definitions[0].Meaning)
+
+	assert.True(t, reader.IsBook("word-power"))
+	assert.False(t, reader.IsBook("friends"))
+
+	cards, err := reader.ReadFlashcardNotebooks("adjectives")
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	assert.Equal(t, "Common", cards[0].Title)
+	assert.Equal(t, "tricky", cards[0].Cards[0].Expression)
+
+	all, err := reader.ReadAllFlashcardNotebooks()
+	require.NoError(t, err)
+	assert.Len(t, all["adjectives"].Notebooks, 1)
+}
+
+type stubNoteRepository struct {
+	NoteRepository
+	notes []NoteRecord
+	err   error
+}
+
+func (r stubNoteRepository) FindAll(context.Context) ([]NoteRecord, error) {
+	return r.notes, r.err
+}
+
+// versionedNoteRepository counts the loads and reports version as the
+// fingerprint of its notes.
+type versionedNoteRepository struct {
+	stubNoteRepository
+	notebooks []NotebookRecord
+	version   string
+	loads     int
+}
+
+func (r *versionedNoteRepository) FindAll(ctx context.Context) ([]NoteRecord, error) {
+	r.loads++
+	return r.stubNoteRepository.FindAll(ctx)
+}
+
+func (r *versionedNoteRepository) FindNotebooks(context.Context) ([]NotebookRecord, error) {
+	return r.notebooks, nil
+}
+
+func (r *versionedNoteRepository) NotesVersion(context.Context) (string, error) {
+	return r.version, nil
+}
+
+func TestNoteReaderSource_Reader(t *testing.T) {
+	t.Run("builds a reader from the repository", func(t *testing.T) {
+		source := NewNoteReaderSource(stubNoteRepository{notes: []NoteRecord{
+			{Usage: "tricky", Entry: "tricky", NotebookNotes: []NotebookNote{
+				{NotebookType: "story", NotebookID: "friends", Group: "Episode 1", Subgroup: "Scene 1"},
+			}},
+		}}, nil)
+		reader, err := source.Reader()
+		require.NoError(t, err)
+		_, err = reader.ReadStoryNotebooks("friends")
+		assert.NoError(t, err)
+	})
+
+	t.Run("reuses the reader while the notes are unchanged", func(t *testing.T) {
+		repository := &versionedNoteRepository{
+			stubNoteRepository: stubNoteRepository{notes: []NoteRecord{
+				{Usage: "tricky", Entry: "tricky", NotebookNotes: []NotebookNote{
+					{NotebookType: "flashcard", NotebookID: "adjectives", Group: "Common"},
+				}},
+			}},
+			notebooks: []NotebookRecord{{NotebookType: "flashcard", NotebookID: "adjectives", Name: "Adjectives"}},
+			version:   "1",
+		}
+		source := NewNoteReaderSource(repository, nil)
+
+		first, err := source.Reader()
+		require.NoError(t, err)
+		assert.Equal(t, "Adjectives", first.GetFlashcardIndexes()["adjectives"].Name)
+		second, err := source.Reader()
+		require.NoError(t, err)
+		assert.Same(t, first, second)
+		assert.Equal(t, 1, repository.loads)
+
+		repository.version = "2"
+		third, err := source.Reader()
+		require.NoError(t, err)
+		assert.NotSame(t, first, third)
+		assert.Equal(t, 2, repository.loads)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		source := NewNoteReaderSource(stubNoteRepository{err: errors.New("connection refused")}, nil)
+		_, err := source.Reader()
+		assert.Error(t, err)
+	})
+}
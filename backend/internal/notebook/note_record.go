@@ -1,6 +1,9 @@
 package notebook
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // NoteRecord represents a vocabulary word or phrase in the database.
 type NoteRecord struct {
@@ -64,3 +67,33 @@ type NotebookNote struct {
 	CreatedAt    time.Time `db:"created_at"`
 	UpdatedAt    time.Time `db:"updated_at"`
 }
+
+// NotebookRecord is a notebook in the database: the name shown for it and,
+// for story and book notebooks, the prose of its scenes. Notes link to it
+// by (NotebookType, NotebookID) through notebook_notes.
+type NotebookRecord struct {
+	NotebookType string                `db:"notebook_type"`
+	NotebookID   string                `db:"notebook_id"`
+	Name         string                `db:"name"`
+	CreatedAt    time.Time             `db:"created_at"`
+	UpdatedAt    time.Time             `db:"updated_at"`
+	Scenes       []NotebookSceneRecord `db:"-"`
+}
+
+// NotebookSceneRecord is the prose of one story scene, named by the same
+// (Group, Subgroup) as the notebook_notes of its definitions.
+type NotebookSceneRecord struct {
+	ID                int64           `db:"id"`
+	NotebookType      string          `db:"notebook_type"`
+	NotebookID        string          `db:"notebook_id"`
+	Group             string          `db:"group"`
+	Subgroup          string          `db:"subgroup"`
+	SortOrder         int             `db:"sort_order"`
+	ConversationsJSON json.RawMessage `db:"conversations"`
+	StatementsJSON    json.RawMessage `db:"statements"`
+	CreatedAt         time.Time       `db:"created_at"`
+	UpdatedAt         time.Time       `db:"updated_at"`
+
+	Conversations []Conversation `db:"-"`
+	Statements    []string       `db:"-"`
+}
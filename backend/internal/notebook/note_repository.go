@@ -2,6 +2,7 @@ package notebook
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -175,6 +176,12 @@ func (r *DBNoteRepository) Delete(ctx context.Context, notebookID string, expres
 			if _, err := tx.ExecContext(ctx, `DELETE FROM notebook_notes WHERE note_id = $1 AND notebook_id = $2`, noteID, notebookID); err != nil {
 				return fmt.Errorf("delete notebook note link: %w", err)
 			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM learning_skips WHERE note_id = $1 AND notebook_id = $2`, noteID, notebookID); err != nil {
+				return fmt.Errorf("delete learning skips: %w", err)
+			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM learning_relearns WHERE note_id = $1 AND notebook_id = $2`, noteID, notebookID); err != nil {
+				return fmt.Errorf("delete learning relearns: %w", err)
+			}
 
 			// Check if the note still has any remaining notebook_notes links
 			var remaining int
@@ -356,3 +363,115 @@ func (r *DBNoteRepository) BatchDeleteNotebookNotes(ctx context.Context, ids []i
 		return nil
 	})
 }
+
+// FindNotebooks returns every notebook with the prose of its scenes, the
+// scenes in the order of the source notebook.
+func (r *DBNoteRepository) FindNotebooks(ctx context.Context) ([]NotebookRecord, error) {
+	var notebooks []NotebookRecord
+	if err := r.db.SelectContext(ctx, &notebooks, "SELECT * FROM notebooks ORDER BY notebook_type, notebook_id"); err != nil {
+		return nil, fmt.Errorf("load notebooks: %w", err)
+	}
+	var scenes []NotebookSceneRecord
+	if err := r.db.SelectContext(ctx, &scenes, "SELECT * FROM notebook_scenes ORDER BY notebook_type, notebook_id, sort_order"); err != nil {
+		return nil, fmt.Errorf("load notebook scenes: %w", err)
+	}
+
+	byKey := make(map[notebookKey]*NotebookRecord, len(notebooks))
+	for i := range notebooks {
+		byKey[notebookKey{notebookType: notebooks[i].NotebookType, notebookID: notebooks[i].NotebookID}] = &notebooks[i]
+	}
+	for _, scene := range scenes {
+		nb := byKey[notebookKey{notebookType: scene.NotebookType, notebookID: scene.NotebookID}]
+		if nb == nil {
+			continue
+		}
+		if err := json.Unmarshal(scene.ConversationsJSON, &scene.Conversations); err != nil {
+			return nil, fmt.Errorf("decode conversations of scene %d: %w", scene.ID, err)
+		}
+		if err := json.Unmarshal(scene.StatementsJSON, &scene.Statements); err != nil {
+			return nil, fmt.Errorf("decode statements of scene %d: %w", scene.ID, err)
+		}
+		nb.Scenes = append(nb.Scenes, scene)
+	}
+	return notebooks, nil
+}
+
+// ReplaceNotebooks replaces every notebook and scene with the given ones.
+// import-db mirrors the YAML notebooks this way on every run, so notebooks
+// and scenes removed from YAML drop out of the database too.
+func (r *DBNoteRepository) ReplaceNotebooks(ctx context.Context, notebooks []NotebookRecord) error {
+	// Rows per INSERT, well below PostgreSQL's 65535 bind parameters.
+	const chunkSize = 5000
+	return database.RunInTx(ctx, r.db, func(ctx context.Context, tx *sqlx.Tx) error {
+		for _, table := range []string{"notebook_scenes", "notebooks"} {
+			if _, err := tx.ExecContext(ctx, "DELETE FROM "+table); err != nil {
+				return fmt.Errorf("delete from %s: %w", table, err)
+			}
+		}
+
+		var notebookRows, sceneRows [][]interface{}
+		for _, nb := range notebooks {
+			notebookRows = append(notebookRows, []interface{}{nb.NotebookType, nb.NotebookID, nb.Name})
+			for i, scene := range nb.Scenes {
+				conversations, err := jsonArray(scene.Conversations)
+				if err != nil {
+					return fmt.Errorf("encode conversations of %s/%s: %w", nb.NotebookID, scene.Subgroup, err)
+				}
+				statements, err := jsonArray(scene.Statements)
+				if err != nil {
+					return fmt.Errorf("encode statements of %s/%s: %w", nb.NotebookID, scene.Subgroup, err)
+				}
+				sceneRows = append(sceneRows, []interface{}{nb.NotebookType, nb.NotebookID, scene.Group, scene.Subgroup, i, conversations, statements})
+			}
+		}
+
+		inserts := []struct {
+			table   string
+			columns []string
+			rows    [][]interface{}
+		}{
+			{"notebooks", []string{"notebook_type", "notebook_id", "name"}, notebookRows},
+			{"notebook_scenes", []string{"notebook_type", "notebook_id", `"group"`, "subgroup", "sort_order", "conversations", "statements"}, sceneRows},
+		}
+		for _, insert := range inserts {
+			for i := 0; i < len(insert.rows); i += chunkSize {
+				chunk := insert.rows[i:min(i+chunkSize, len(insert.rows))]
+				var args []interface{}
+				for _, row := range chunk {
+					args = append(args, row...)
+				}
+				q := database.BuildMultiRowInsert(insert.table, insert.columns, len(chunk))
+				if _, err := tx.ExecContext(ctx, q, args...); err != nil {
+					return fmt.Errorf("insert %s: %w", insert.table, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// jsonArray encodes values as a JSON array, [] rather than null when there
+// are none.
+func jsonArray[T any](values []T) ([]byte, error) {
+	if values == nil {
+		values = []T{}
+	}
+	return json.Marshal(values)
+}
+
+// NotesVersion fingerprints the tables a Reader is built from, the way
+// DBLearningRepository.HistoryVersion does for learning histories, so
+// NoteReaderSource can reuse a Reader while nothing has changed.
+func (r *DBNoteRepository) NotesVersion(ctx context.Context) (string, error) {
+	var version string
+	if err := r.db.GetContext(ctx, &version, `SELECT concat_ws(':',
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM notes),
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM notebook_notes),
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM note_images),
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM note_references),
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM notebooks),
+		(SELECT concat(COUNT(*), '/', MAX(updated_at)) FROM notebook_scenes))`); err != nil {
+		return "", fmt.Errorf("load notes version: %w", err)
+	}
+	return version, nil
+}
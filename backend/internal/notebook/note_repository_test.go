@@ -268,6 +268,12 @@ func TestDBNoteRepository_Delete(t *testing.T) {
 				mock.ExpectExec(`DELETE FROM notebook_notes WHERE note_id = \$1 AND notebook_id = \$2`).
 					WithArgs(int64(42), "test-book").
 					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM learning_skips WHERE note_id = \$1 AND notebook_id = \$2`).
+					WithArgs(int64(42), "test-book").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`DELETE FROM learning_relearns WHERE note_id = \$1 AND notebook_id = \$2`).
+					WithArgs(int64(42), "test-book").
+					WillReturnResult(sqlmock.NewResult(0, 0))
 				mock.ExpectQuery("SELECT COUNT").
 					WithArgs(int64(42)).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
@@ -298,6 +304,12 @@ func TestDBNoteRepository_Delete(t *testing.T) {
 				mock.ExpectExec(`DELETE FROM notebook_notes WHERE note_id = \$1 AND notebook_id = \$2`).
 					WithArgs(int64(42), "test-book").
 					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM learning_skips WHERE note_id = \$1 AND notebook_id = \$2`).
+					WithArgs(int64(42), "test-book").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`DELETE FROM learning_relearns WHERE note_id = \$1 AND notebook_id = \$2`).
+					WithArgs(int64(42), "test-book").
+					WillReturnResult(sqlmock.NewResult(0, 0))
 				mock.ExpectQuery("SELECT COUNT").
 					WithArgs(int64(42)).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
@@ -648,3 +660,53 @@ func TestDBNoteRepository_BatchUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestDBNoteRepository_FindNotebooks(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT \* FROM notebooks ORDER BY notebook_type, notebook_id`).
+		WillReturnRows(sqlmock.NewRows([]string{"notebook_type", "notebook_id", "name", "created_at", "updated_at"}).
+			AddRow("story", "friends", "Friends", now, now))
+	mock.ExpectQuery(`SELECT \* FROM notebook_scenes ORDER BY notebook_type, notebook_id, sort_order`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "notebook_type", "notebook_id", "group", "subgroup", "sort_order", "conversations", "statements", "created_at", "updated_at"}).
+			AddRow(1, "story", "friends", "Episode 1", "Opening", 0, []byte(`[{"speaker":"Ross","quote":"Hi."}]`), []byte(`["Central Perk."]`), now, now))
+
+	got, err := NewDBNoteRepository(sqlx.NewDb(db, "pgx")).FindNotebooks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "Friends", got[0].Name)
+	require.Len(t, got[0].Scenes, 1)
+	assert.Equal(t, []Conversation{{Speaker: "Ross", Quote: "Hi."}}, got[0].Scenes[0].Conversations)
+	assert.Equal(t, []string{"Central Perk."}, got[0].Scenes[0].Statements)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDBNoteRepository_ReplaceNotebooks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM notebook_scenes`).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM notebooks`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO notebooks \(notebook_type, notebook_id, name\)`).
+		WithArgs("story", "friends", "Friends", "flashcard", "adjectives", "Adjectives").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO notebook_scenes`).
+		WithArgs("story", "friends", "Episode 1", "Opening", 0, []byte(`[]`), []byte(`["Central Perk."]`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = NewDBNoteRepository(sqlx.NewDb(db, "pgx")).ReplaceNotebooks(context.Background(), []NotebookRecord{
+		{
+			NotebookType: "story", NotebookID: "friends", Name: "Friends",
+			Scenes: []NotebookSceneRecord{{Group: "Episode 1", Subgroup: "Opening", Statements: []string{"Central Perk."}}},
+		},
+		{NotebookType: "flashcard", NotebookID: "adjectives", Name: "Adjectives"},
+	})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
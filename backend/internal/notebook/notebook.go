@@ -69,6 +69,11 @@ type Note struct {
 	Memo          string   `yaml:"memo,omitempty"`
 	Note          string   `yaml:"note,omitempty"`
 
+	// Audio is a recording of the pronunciation, relative to the directory
+	// of the notebook's index.yml (e.g. "audio/ephemeral.wav"). `langner
+	// notebooks audio` synthesizes it for notes without one.
+	Audio string `yaml:"audio,omitempty"`
+
 	// Deprecated: Use References
 	Reference string `yaml:"reference,omitempty"`
 
@@ -98,9 +103,12 @@ type Note struct {
 // {text, highlight}. Highlight names the exact surface word/phrase to bold in
 // standard quizzes and mask in reverse quizzes — required for irregular
 // inflections the lemma can't derive (e.g. lemma "go", highlight "went").
+// Generated marks a sentence written by `notebooks enrich --examples` rather
+// than taken from the source, so exports can leave it out.
 type Example struct {
 	Text      string `yaml:"text,omitempty"`
 	Highlight string `yaml:"highlight,omitempty"`
+	Generated bool   `yaml:"generated,omitempty"`
 }
 
 // UnmarshalYAML accepts a scalar string or a {text, highlight} mapping so
@@ -123,13 +131,32 @@ func (e *Example) UnmarshalYAML(node *yaml.Node) error {
 // unchanged files stay byte-stable; only examples with a highlight serialize
 // as a {text, highlight} mapping.
 func (e Example) MarshalYAML() (interface{}, error) {
-	if e.Highlight == "" {
+	if e.Highlight == "" && !e.Generated {
 		return e.Text, nil
 	}
 	type rawExample Example
 	return rawExample(e), nil
 }
 
+// JSONSchema describes the scalar-or-mapping YAML form for schema export.
+func (Example) JSONSchema() map[string]any {
+	return map[string]any{
+		"oneOf": []any{
+			map[string]any{"type": "string"},
+			map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"required":             []any{"text"},
+				"properties": map[string]any{
+					"text":      map[string]any{"type": "string"},
+					"highlight": map[string]any{"type": "string"},
+					"generated": map[string]any{"type": "boolean"},
+				},
+			},
+		},
+	}
+}
+
 // Examples is a list of usage sentences supporting the mixed scalar/mapping
 // YAML form via Example's (Un)MarshalYAML.
 type Examples []Example
@@ -147,6 +174,18 @@ func (exs Examples) Texts() []string {
 	return out
 }
 
+// WithoutGenerated returns the examples not marked Generated, as a new slice
+// so the caller's notes are left untouched.
+func (exs Examples) WithoutGenerated() Examples {
+	var out Examples
+	for _, ex := range exs {
+		if !ex.Generated {
+			out = append(out, ex)
+		}
+	}
+	return out
+}
+
 // ExamplesFromStrings builds an Examples list from plain sentence strings with
 // no highlight, used when importing examples from a dictionary or record.
 func ExamplesFromStrings(texts []string) Examples {
@@ -170,6 +209,11 @@ func (d Date) MarshalYAML() (interface{}, error) {
 	return d.Format(time.RFC3339), nil
 }
 
+// JSONSchema describes Date's YAML form, a date or timestamp string.
+func (Date) JSONSchema() map[string]any {
+	return map[string]any{"type": "string"}
+}
+
 // UnmarshalYAML implements the yaml.Unmarshaler interface
 func (d *Date) UnmarshalYAML(value *yaml.Node) error {
 	// First try the new YYYY-MM-DD format
@@ -239,7 +283,11 @@ func (note Note) lastLearnedAt() time.Time {
 	return note.LearnedLogs[0].LearnedAt.Time
 }
 
+// SetDetails fills note from its dictionary entry and links it to its
+// moment in youTubeURL, the video of its notebook ("" when there is none).
 func (note *Note) SetDetails(dictionaryMap map[string]rapidapi.Response, youTubeURL string) error {
+	_, note.YoutubeURL = VideoMoment(youTubeURL, note.YouTubeTimeSeconds)
+
 	def := note.Definition
 	if def == "" {
 		def = note.Expression
@@ -261,9 +309,6 @@ func (note *Note) SetDetails(dictionaryMap map[string]rapidapi.Response, youTube
 		if len(note.Examples) == 0 {
 			note.Examples = ExamplesFromStrings(definition.Examples)
 		}
-		if note.YouTubeTimeSeconds > 0 {
-			note.YoutubeURL = fmt.Sprintf("%s?t=%d", youTubeURL, note.YouTubeTimeSeconds)
-		}
 	} else if len(note.Statements) == 0 {
 		if note.Level == ExpressionLevelNew && note.Meaning == "" && len(note.Images) == 0 && len(note.Synonyms) == 0 {
 			return fmt.Errorf("there is no meaning, images, nor statements for word: %+v", note)
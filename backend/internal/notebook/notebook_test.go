@@ -82,12 +82,13 @@ func TestNewDate(t *testing.T) {
 
 func TestNote_setDetails(t *testing.T) {
 	tests := []struct {
-		name          string
-		note          Note
-		dictionaryMap map[string]rapidapi.Response
-		youTubeURL    string
-		wantErr       bool
-		wantMeaning   string
+		name           string
+		note           Note
+		dictionaryMap  map[string]rapidapi.Response
+		youTubeURL     string
+		wantErr        bool
+		wantMeaning    string
+		wantYouTubeURL string
 	}{
 		{
 			name: "set details from dictionary",
@@ -212,8 +213,21 @@ func TestNote_setDetails(t *testing.T) {
 					},
 				},
 			},
-			youTubeURL:  "https://youtube.com/watch?v=abc",
-			wantMeaning: "a greeting",
+			youTubeURL:     "https://youtube.com/watch?v=abc",
+			wantMeaning:    "a greeting",
+			wantYouTubeURL: "https://youtube.com/watch?t=42&v=abc",
+		},
+		{
+			name: "sets youtube URL for words not in dictionary",
+			note: Note{
+				Expression:         "hello",
+				Meaning:            "a greeting",
+				YouTubeTimeSeconds: 42,
+			},
+			dictionaryMap:  map[string]rapidapi.Response{},
+			youTubeURL:     "https://youtube.com/watch?v=abc",
+			wantMeaning:    "a greeting",
+			wantYouTubeURL: "https://youtube.com/watch?t=42&v=abc",
 		},
 	}
 
@@ -231,6 +245,7 @@ func TestNote_setDetails(t *testing.T) {
 			if tt.wantMeaning != "" {
 				assert.Equal(t, tt.wantMeaning, note.Meaning)
 			}
+			assert.Equal(t, tt.wantYouTubeURL, note.YoutubeURL)
 		})
 	}
 }
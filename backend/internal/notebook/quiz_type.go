@@ -14,6 +14,18 @@ const (
 	// etymology modes; the origin carries exactly one learning-log series.
 	QuizTypeEtymologyOrigin QuizType = "etymology_origin"
 	QuizTypeGrammar         QuizType = "grammar"
+	// QuizTypeDictation plays a conversation line of a story scene and asks
+	// the learner to type what they heard. It keeps its own log series on
+	// the words the line teaches (DictationLogs), apart from the reading
+	// quizzes, so hearing a word and recognising it in print are scheduled
+	// independently.
+	QuizTypeDictation QuizType = "dictation"
+	// QuizTypeWordChoice drills the members of a synonym or antonym concept
+	// of a definitions book: a sentence asks which member fits best, or a
+	// member without an example asks how it differs from another. It keeps
+	// its own log series (WordChoiceLogs), so telling near-synonyms apart is
+	// scheduled apart from recalling each one's meaning.
+	QuizTypeWordChoice QuizType = "word_choice"
 )
 
 // Quality represents the quality of a response in the SM-2 algorithm
@@ -25,3 +37,13 @@ const (
 	QualityCorrect      Quality = 4 // Correct at normal speed
 	QualityCorrectFast  Quality = 5 // Correct and fast (instant recall)
 )
+
+// RelearnPoolsLeftBy returns the quiz types whose relearn pool a correct
+// answer in quizType takes a word out of: quizType itself and, for
+// freeform, the recognition and reverse series it is recorded in as well.
+func RelearnPoolsLeftBy(quizType QuizType) []QuizType {
+	if quizType == QuizTypeFreeform {
+		return []QuizType{QuizTypeFreeform, QuizTypeNotebook, QuizTypeReverse}
+	}
+	return []QuizType{quizType}
+}
@@ -0,0 +1,85 @@
+package notebook
+
+// YAMLFormat describes one kind of notebook YAML file for JSON Schema
+// export. Values holds a zero value of each Go shape the file is decoded
+// into; a file accepting several shapes lists all of them.
+type YAMLFormat struct {
+	// Name is the schema's file stem, e.g. "story" for story.schema.json.
+	Name        string
+	Title       string
+	Description string
+	Values      []any
+}
+
+// YAMLFormats lists every notebook YAML format the readers in this package
+// decode, in a stable order.
+func YAMLFormats() []YAMLFormat {
+	return []YAMLFormat{
+		{
+			Name:        "story-index",
+			Title:       "Story index",
+			Description: "index.yml of a story, book or journal directory.",
+			Values:      []any{Index{}},
+		},
+		{
+			Name:        "story",
+			Title:       "Story notebook",
+			Description: "Episodes of a story, book or journal with their scenes and definitions.",
+			Values:      []any{[]StoryNotebook{}},
+		},
+		{
+			Name:        "flashcard-index",
+			Title:       "Flashcard index",
+			Description: "index.yml of a flashcard directory.",
+			Values:      []any{FlashcardIndex{}},
+		},
+		{
+			Name:        "flashcard",
+			Title:       "Flashcard notebook",
+			Description: "Titled groups of flashcards.",
+			Values:      []any{[]FlashcardNotebook{}},
+		},
+		{
+			Name:        "definitions-index",
+			Title:       "Definitions index",
+			Description: "index.yml of a definitions book.",
+			Values:      []any{definitionsIndex{}},
+		},
+		{
+			Name:        "definitions",
+			Title:       "Definitions",
+			Description: "Definitions attached to the scenes of a story or book, or a standalone definitions book.",
+			Values:      []any{[]Definitions{}},
+		},
+		{
+			Name:        "etymology-index",
+			Title:       "Etymology index",
+			Description: "index.yml of an etymology notebook.",
+			Values:      []any{EtymologyIndex{}},
+		},
+		{
+			Name:        "etymology",
+			Title:       "Etymology session",
+			Description: "Origins, concepts and relations of an etymology session, in the scenes shape or the legacy flat shape (see examples/etymology/SCHEMA.md).",
+			Values:      []any{[]EtymologyNotebookEntry{}, etymologySessionFile{}},
+		},
+		{
+			Name:        "grammar-index",
+			Title:       "Grammar index",
+			Description: "index.yml of a grammar annotations directory.",
+			Values:      []any{grammarsIndex{}},
+		},
+		{
+			Name:        "grammar",
+			Title:       "Grammar corrections",
+			Description: "Grammar corrections attached to the scenes of a journal.",
+			Values:      []any{[]GrammarEntry{}},
+		},
+		{
+			Name:        "learning-history",
+			Title:       "Learning history",
+			Description: "Quiz results and review schedule of one notebook.",
+			Values:      []any{[]LearningHistory{}},
+		},
+	}
+}
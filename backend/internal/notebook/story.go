@@ -16,10 +16,13 @@ import (
 )
 
 type StoryNotebook struct {
-	Event    string       `yaml:"event"`
-	Metadata Metadata     `yaml:"metadata,omitempty"`
-	Date     time.Time    `yaml:"date"`
-	Scenes   []StoryScene `yaml:"scenes"`
+	Event    string    `yaml:"event"`
+	Metadata Metadata  `yaml:"metadata,omitempty"`
+	Date     time.Time `yaml:"date"`
+	// YouTubeURL is the video the story was taken from. The time_seconds
+	// of its conversations link into it.
+	YouTubeURL string       `yaml:"youtube_url,omitempty"`
+	Scenes     []StoryScene `yaml:"scenes"`
 }
 
 type Metadata struct {
@@ -37,8 +40,15 @@ type StoryScene struct {
 }
 
 type Conversation struct {
-	Speaker string `yaml:"speaker"`
-	Quote   string `yaml:"quote"`
+	Speaker string `yaml:"speaker" json:"speaker"`
+	Quote   string `yaml:"quote" json:"quote"`
+	// Audio is a recording of the line, relative to the directory of the
+	// story's index.yml, played by the dictation quiz instead of a
+	// synthesized voice.
+	Audio string `yaml:"audio,omitempty" json:"audio,omitempty"`
+	// TimeSeconds is when the line is said, in seconds from the start of
+	// the story's video.
+	TimeSeconds int `yaml:"time_seconds,omitempty" json:"time_seconds,omitempty"`
 }
 
 func (reader *Reader) ReadStoryNotebooks(storyID string) ([]StoryNotebook, error) {
@@ -46,6 +56,9 @@ func (reader *Reader) ReadStoryNotebooks(storyID string) ([]StoryNotebook, error
 	if !ok {
 		return nil, fmt.Errorf("story %s not found", storyID)
 	}
+	if index.preloaded != nil {
+		return cloneStoryNotebooks(index.preloaded), nil
+	}
 
 	result := make([]StoryNotebook, 0)
 	notebookPaths := make([]string, 0)
@@ -346,7 +359,7 @@ func FilterStoryNotebooks(storyNotebooks []StoryNotebook, learningHistory []Lear
 						continue
 					}
 				}
-				if err := definition.SetDetails(dictionaryMap, ""); err != nil {
+				if err := definition.SetDetails(dictionaryMap, notebook.YouTubeURL); err != nil {
 					return nil, fmt.Errorf("definition.SetDetails() > %w", err)
 				}
 				definitions = append(definitions, definition)
@@ -386,8 +399,10 @@ func FilterStoryNotebooks(storyNotebooks []StoryNotebook, learningHistory []Lear
 }
 
 type StoryNotebookWriter struct {
-	reader       *Reader
-	templatePath string
+	reader                *Reader
+	templatePath          string
+	pdfOptions            pdf.Options
+	skipGeneratedExamples bool
 }
 
 func NewStoryNotebookWriter(reader *Reader, templatePath string) *StoryNotebookWriter {
@@ -397,6 +412,18 @@ func NewStoryNotebookWriter(reader *Reader, templatePath string) *StoryNotebookW
 	}
 }
 
+// SetPDFOptions sets the fonts used when generatePDF is true. The title is
+// filled in from the story's index.
+func (writer *StoryNotebookWriter) SetPDFOptions(opts pdf.Options) {
+	writer.pdfOptions = opts
+}
+
+// SetSkipGeneratedExamples leaves examples written by `notebooks enrich
+// --examples` out of the output.
+func (writer *StoryNotebookWriter) SetSkipGeneratedExamples(skip bool) {
+	writer.skipGeneratedExamples = skip
+}
+
 func (writer StoryNotebookWriter) OutputStoryNotebooks(
 	storyID string,
 	dictionaryMap map[string]rapidapi.Response,
@@ -405,20 +432,9 @@ func (writer StoryNotebookWriter) OutputStoryNotebooks(
 	outputDirectory string,
 	generatePDF bool,
 ) error {
-	notebooks, err := writer.reader.ReadStoryNotebooks(storyID)
-	if err != nil {
-		return fmt.Errorf("readStoryNotebooks() > %w", err)
-	}
-	if len(notebooks) == 0 {
-		return fmt.Errorf("no story notebooks found for %s", storyID)
-	}
-	learningHistory := learningHistories[storyID]
-
-	// For books, preserve index order instead of sorting by date
-	preserveOrder := writer.reader.IsBook(storyID)
-	notebooks, err = FilterStoryNotebooks(notebooks, learningHistory, dictionaryMap, sortDesc, true, false, preserveOrder, QuizTypeNotebook)
+	templateData, err := writer.storyTemplateData(storyID, dictionaryMap, learningHistories, sortDesc)
 	if err != nil {
-		return fmt.Errorf("filterStoryNotebooks() > %w", err)
+		return err
 	}
 
 	// Create output directory if it doesn't exist
@@ -437,15 +453,6 @@ func (writer StoryNotebookWriter) OutputStoryNotebooks(
 		_ = output.Close()
 	}()
 
-	// Convert notebooks to assets format with marker conversion for
-	// markdown output. When the underlying book declares concepts:, group
-	// member entries into one row per concept so the markdown / PDF
-	// output reads as one block per family instead of one row per word.
-	byExpr, byHead := writer.reader.GetDefinitionsBookConceptInfo(storyID)
-	converter := newAssetsStoryConverter()
-	converter.conceptByExpression = byExpr
-	converter.conceptByHead = byHead
-	templateData := converter.convertToAssetsStoryTemplate(notebooks)
 	if err := assets.WriteStoryNotebook(output, writer.templatePath, templateData); err != nil {
 		return fmt.Errorf("assets.WriteStoryNotebook(%s, %s, ) > %w", outputFilename, writer.templatePath, err)
 	}
@@ -453,7 +460,9 @@ func (writer StoryNotebookWriter) OutputStoryNotebooks(
 	fmt.Printf("Story notebook written to: %s\n", outputFilename)
 
 	if generatePDF {
-		pdfPath, err := pdf.ConvertMarkdownToPDF(outputFilename)
+		pdfOptions := writer.pdfOptions
+		pdfOptions.Title = writer.reader.indexes[storyID].Name
+		pdfPath, err := pdf.ConvertMarkdownToPDF(outputFilename, pdfOptions)
 		if err != nil {
 			return fmt.Errorf("ConvertMarkdownToPDF(%s) > %w", outputFilename, err)
 		}
@@ -463,6 +472,44 @@ func (writer StoryNotebookWriter) OutputStoryNotebooks(
 	return nil
 }
 
+// storyTemplateData reads and filters the story's notebooks and converts
+// them to the template data every output format is rendered from.
+func (writer StoryNotebookWriter) storyTemplateData(
+	storyID string,
+	dictionaryMap map[string]rapidapi.Response,
+	learningHistories map[string][]LearningHistory,
+	sortDesc bool,
+) (assets.StoryTemplate, error) {
+	notebooks, err := writer.reader.ReadStoryNotebooks(storyID)
+	if err != nil {
+		return assets.StoryTemplate{}, fmt.Errorf("readStoryNotebooks() > %w", err)
+	}
+	if len(notebooks) == 0 {
+		return assets.StoryTemplate{}, fmt.Errorf("no story notebooks found for %s", storyID)
+	}
+	learningHistory := learningHistories[storyID]
+
+	// For books, preserve index order instead of sorting by date
+	preserveOrder := writer.reader.IsBook(storyID)
+	notebooks, err = FilterStoryNotebooks(notebooks, learningHistory, dictionaryMap, sortDesc, true, false, preserveOrder, QuizTypeNotebook)
+	if err != nil {
+		return assets.StoryTemplate{}, fmt.Errorf("filterStoryNotebooks() > %w", err)
+	}
+	if writer.skipGeneratedExamples {
+		notebooks = storyNotebooksWithoutGeneratedExamples(notebooks)
+	}
+
+	// Convert notebooks to assets format with marker conversion for
+	// markdown output. When the underlying book declares concepts:, group
+	// member entries into one row per concept so the markdown / PDF
+	// output reads as one block per family instead of one row per word.
+	byExpr, byHead := writer.reader.GetDefinitionsBookConceptInfo(storyID)
+	converter := newAssetsStoryConverter()
+	converter.conceptByExpression = byExpr
+	converter.conceptByHead = byHead
+	return converter.convertToAssetsStoryTemplate(notebooks), nil
+}
+
 // Validate validates a StoryScene and its definitions against conversations and statements
 func (scene *StoryScene) Validate(location string) []ValidationError {
 	var errors []ValidationError
@@ -626,7 +673,7 @@ func (converter assetsStoryConverter) convertToAssetsStoryTemplate(notebooks []S
 func (converter assetsStoryConverter) convertStoryNotebook(nb StoryNotebook) assets.StoryNotebook {
 	assetsScenes := make([]assets.StoryScene, len(nb.Scenes))
 	for i, scene := range nb.Scenes {
-		assetsScenes[i] = converter.convertStoryScene(scene)
+		assetsScenes[i] = converter.convertStoryScene(scene, nb.YouTubeURL)
 	}
 	return assets.StoryNotebook{
 		Event: nb.Event,
@@ -635,12 +682,13 @@ func (converter assetsStoryConverter) convertStoryNotebook(nb StoryNotebook) ass
 			Season:  nb.Metadata.Season,
 			Episode: nb.Metadata.Episode,
 		},
-		Date:   nb.Date,
-		Scenes: assetsScenes,
+		Date:       nb.Date,
+		YouTubeURL: nb.YouTubeURL,
+		Scenes:     assetsScenes,
 	}
 }
 
-func (converter assetsStoryConverter) convertStoryScene(scene StoryScene) assets.StoryScene {
+func (converter assetsStoryConverter) convertStoryScene(scene StoryScene, youTubeURL string) assets.StoryScene {
 	assetsConversations := make([]assets.Conversation, len(scene.Conversations))
 	for i, conv := range scene.Conversations {
 		// First strip any remaining {{ }} markers (backward compat), then highlight definitions
@@ -650,6 +698,7 @@ func (converter assetsStoryConverter) convertStoryScene(scene StoryScene) assets
 			Speaker: conv.Speaker,
 			Quote:   convertedQuote,
 		}
+		assetsConversations[i].Timestamp, assetsConversations[i].VideoURL = VideoMoment(youTubeURL, conv.TimeSeconds)
 	}
 
 	// Convert statements: strip markers then highlight definitions
@@ -680,7 +729,9 @@ func (converter assetsStoryConverter) convertStoryScene(scene StoryScene) assets
 			Synonyms:      note.Synonyms,
 			Antonyms:      note.Antonyms,
 			Images:        note.Images,
+			Audio:         note.Audio,
 		}
+		entry.Timestamp, entry.VideoURL = VideoMoment(youTubeURL, scene.TimeSecondsOf(note))
 		head, isMember := "", false
 		if converter.conceptByExpression != nil {
 			head, isMember = converter.conceptByExpression[note.Expression]
@@ -0,0 +1,106 @@
+package notebook
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/assets"
+	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+)
+
+// ExportFormat is the file format a story notebook is written in.
+type ExportFormat string
+
+const (
+	ExportFormatMarkdown ExportFormat = "markdown"
+	ExportFormatHTML     ExportFormat = "html"
+	ExportFormatEPUB     ExportFormat = "epub"
+)
+
+// ExportFormats lists every ExportFormat, in the order shown in help text.
+var ExportFormats = []ExportFormat{ExportFormatMarkdown, ExportFormatHTML, ExportFormatEPUB}
+
+// ExportStoryNotebook writes the story as a single-file HTML page or an
+// EPUB book in outputDirectory and returns its path. Both are built from
+// the same template data as the markdown output, with images and audio
+// embedded.
+func (writer StoryNotebookWriter) ExportStoryNotebook(
+	storyID string,
+	dictionaryMap map[string]rapidapi.Response,
+	learningHistories map[string][]LearningHistory,
+	sortDesc bool,
+	outputDirectory string,
+	format ExportFormat,
+) (string, error) {
+	if format != ExportFormatHTML && format != ExportFormatEPUB {
+		return "", fmt.Errorf("unsupported export format %q", format)
+	}
+	templateData, err := writer.storyTemplateData(storyID, dictionaryMap, learningHistories, sortDesc)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(outputDirectory, 0755); err != nil {
+		return "", fmt.Errorf("os.MkdirAll(%s) > %w", outputDirectory, err)
+	}
+	outputFilename := strings.TrimSpace(filepath.Join(outputDirectory, storyID+"."+string(format)))
+	output, err := os.Create(outputFilename)
+	if err != nil {
+		return "", fmt.Errorf("os.Create(%s) > %w", outputFilename, err)
+	}
+	defer func() {
+		_ = output.Close()
+	}()
+
+	loadAudio := func(src string) ([]byte, string, error) {
+		return writer.reader.ReadAudio(storyID, src)
+	}
+	title := writer.reader.indexes[storyID].Name
+	if title == "" {
+		title = storyID
+	}
+	switch format {
+	case ExportFormatHTML:
+		if err := assets.WriteStoryNotebookHTML(output, title, templateData, loadNotebookImage, loadAudio); err != nil {
+			return "", fmt.Errorf("assets.WriteStoryNotebookHTML(%s) > %w", outputFilename, err)
+		}
+	case ExportFormatEPUB:
+		info := assets.EPUBInfo{
+			Identifier: "urn:langner:story:" + storyID,
+			Title:      title,
+			Modified:   time.Now(),
+		}
+		if err := assets.WriteStoryNotebookEPUB(output, info, templateData, loadNotebookImage, loadAudio); err != nil {
+			return "", fmt.Errorf("assets.WriteStoryNotebookEPUB(%s) > %w", outputFilename, err)
+		}
+	}
+	if err := output.Close(); err != nil {
+		return "", fmt.Errorf("close %s: %w", outputFilename, err)
+	}
+	return outputFilename, nil
+}
+
+// loadNotebookImage reads an image a note refers to, downloading it when
+// it's a URL.
+func loadNotebookImage(src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return os.ReadFile(src)
+	}
+
+	resp, err := http.Get(src) //nolint:gosec // URLs come from user's notebook data
+	if err != nil {
+		fmt.Printf("Warning: failed to download image %s: %v\n", src, err)
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		fmt.Printf("Warning: failed to download image %s: HTTP %d\n", src, resp.StatusCode)
+		return nil, fmt.Errorf("HTTP %d for %s", resp.StatusCode, src)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -11,6 +11,10 @@ type Index struct {
 	NotebookPaths []string `yaml:"notebooks"`
 
 	Notebooks [][]StoryNotebook `yaml:"-"`
+
+	// preloaded holds notebooks built in memory (see NewReaderFromNotes)
+	// instead of read from NotebookPaths.
+	preloaded []StoryNotebook
 }
 
 func (index Index) GetNotebookPath(i int) string {
@@ -209,7 +209,8 @@ func (v *Validator) Fix() (*ValidationResult, error) {
 	// reflects the actual chain of answers (each log's interval threaded
 	// into the next via RecalculateAll, with the early-review guard
 	// preventing growth on too-soon correct answers). Touches all four
-	// slots: LearnedLogs / ReverseLogs / EtymologyOriginLogs. Reports each
+	// slots: LearnedLogs / ReverseLogs / EtymologyOriginLogs / DictationLogs /
+	// WordChoiceLogs. Reports each
 	// interval drift as a warning so
 	// the run shows which logs got corrected; logs whose recalculated
 	// value matches stored stay untouched in the output.
@@ -849,11 +850,20 @@ func (v *Validator) mergeDuplicateScenes(
 						base.Expressions[idx].EtymologyOriginLogs = append(base.Expressions[idx].EtymologyOriginLogs, e.EtymologyOriginLogs...)
 						_, base.Expressions[idx].EtymologyOriginLogs, _ = recalculateLearningLogs(base.Expressions[idx].EtymologyOriginLogs, v.calculator)
 					}
+					if len(e.DictationLogs) > 0 {
+						base.Expressions[idx].DictationLogs = append(base.Expressions[idx].DictationLogs, e.DictationLogs...)
+						_, base.Expressions[idx].DictationLogs, _ = recalculateLearningLogs(base.Expressions[idx].DictationLogs, v.calculator)
+					}
+					if len(e.WordChoiceLogs) > 0 {
+						base.Expressions[idx].WordChoiceLogs = append(base.Expressions[idx].WordChoiceLogs, e.WordChoiceLogs...)
+						_, base.Expressions[idx].WordChoiceLogs, _ = recalculateLearningLogs(base.Expressions[idx].WordChoiceLogs, v.calculator)
+					}
 					// Merge skip state: a skip recorded on either copy must
 					// survive the merge. Without this, a word skipped in the
 					// "__index_N" copy but logged in the human-title copy (or
 					// vice-versa) would silently lose its skip.
 					base.Expressions[idx].SkippedAt = mergeSkippedAt(base.Expressions[idx].SkippedAt, e.SkippedAt)
+					base.Expressions[idx].RelearnAt = mergeSkippedAt(base.Expressions[idx].RelearnAt, e.RelearnAt)
 					result.AddWarning(ValidationError{
 						File:    filePath,
 						Message: fmt.Sprintf("Merged duplicate expression %q from duplicate scene in %s", ek, storyTitle),
@@ -999,6 +1009,8 @@ func latestLogTime(e *LearningHistoryExpression) time.Time {
 	consider(e.LearnedLogs)
 	consider(e.ReverseLogs)
 	consider(e.EtymologyOriginLogs)
+	consider(e.DictationLogs)
+	consider(e.WordChoiceLogs)
 	return latest
 }
 
@@ -1022,6 +1034,7 @@ func (v *Validator) mergeOriginExpressionInto(base, other *LearningHistoryExpres
 		_, base.EtymologyOriginLogs, _ = recalculateLearningLogs(base.EtymologyOriginLogs, v.calculator)
 	}
 	base.SkippedAt = mergeSkippedAt(base.SkippedAt, other.SkippedAt)
+	base.RelearnAt = mergeSkippedAt(base.RelearnAt, other.RelearnAt)
 }
 
 // consolidateEtymologyOriginScenes repairs an etymology origin whose
@@ -1402,13 +1415,15 @@ func (v *Validator) fixConsistency(
 					}
 
 					// Only keep expressions that either:
-					// 1. Have any logs (learned, reverse, or etymology), OR
+					// 1. Have any logs (learned, reverse, etymology, dictation, or
+					//    word choice), OR
 					// 2. Exist in the story (even with empty logs), OR
 					// 3. Are skipped from at least one quiz mode — the
 					//    notebook detail page seeds skip-only stubs that
-					//    would otherwise be dropped on the next --fix.
-					hasLogs := len(expr.LearnedLogs) > 0 || len(expr.ReverseLogs) > 0 || len(expr.EtymologyOriginLogs) > 0
-					hasSkip := expr.SkippedAt.IsSkippedAny()
+					//    would otherwise be dropped on the next --fix —
+					//    or were moved into the relearn pool.
+					hasLogs := len(expr.LearnedLogs) > 0 || len(expr.ReverseLogs) > 0 || len(expr.EtymologyOriginLogs) > 0 || len(expr.DictationLogs) > 0 || len(expr.WordChoiceLogs) > 0
+					hasSkip := expr.SkippedAt.IsSkippedAny() || len(expr.RelearnAt) > 0
 					if hasLogs || existsInStory || hasSkip {
 						validExpressions = append(validExpressions, expr)
 					} else {
@@ -1480,6 +1495,8 @@ func (v *Validator) recalculateAllIntervals(files []learningHistoryFile, result
 				expr.ReverseLogs = recalcSlice(expr.ReverseLogs, file.path, label, "reverse_logs")
 				expr.EtymologyOriginLogs = recalcSlice(
 					expr.EtymologyOriginLogs, file.path, label, "etymology_origin_logs")
+				expr.DictationLogs = recalcSlice(expr.DictationLogs, file.path, label, "dictation_logs")
+				expr.WordChoiceLogs = recalcSlice(expr.WordChoiceLogs, file.path, label, "word_choice_logs")
 			}
 			// Flashcard shape: top-level expressions.
 			for eIdx := range history.Expressions {
@@ -2192,6 +2209,8 @@ func (v *Validator) migrateEtymologyShape(files []learningHistoryFile, result *V
 					existing.LearnedLogs = append(existing.LearnedLogs, expr.LearnedLogs...)
 					existing.ReverseLogs = append(existing.ReverseLogs, expr.ReverseLogs...)
 					existing.EtymologyOriginLogs = append(existing.EtymologyOriginLogs, expr.EtymologyOriginLogs...)
+					existing.DictationLogs = append(existing.DictationLogs, expr.DictationLogs...)
+					existing.WordChoiceLogs = append(existing.WordChoiceLogs, expr.WordChoiceLogs...)
 					for k, val := range expr.SkippedAt {
 						if existing.SkippedAt == nil {
 							existing.SkippedAt = make(SkippedAtMap)
@@ -2200,6 +2219,14 @@ func (v *Validator) migrateEtymologyShape(files []learningHistoryFile, result *V
 							existing.SkippedAt[k] = val
 						}
 					}
+					for k, val := range expr.RelearnAt {
+						if existing.RelearnAt == nil {
+							existing.RelearnAt = make(SkippedAtMap)
+						}
+						if _, dup := existing.RelearnAt[k]; !dup {
+							existing.RelearnAt[k] = val
+						}
+					}
 				}
 			}
 			result.AddWarning(ValidationError{
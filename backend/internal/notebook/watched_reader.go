@@ -0,0 +1,491 @@
+package notebook
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+)
+
+// ReaderSource hands out notebook readers. Long-running processes use a
+// WatchedReader; everything else builds a fresh Reader per call.
+type ReaderSource interface {
+	Reader() (*Reader, error)
+}
+
+// ReaderDirectories lists every directory a fully-loaded Reader reads:
+// the NewReader directories plus the opt-in journals and grammars.
+type ReaderDirectories struct {
+	Stories     []string
+	Journals    []string
+	Flashcards  []string
+	Books       []string
+	Definitions []string
+	Etymology   []string
+	Grammars    []string
+}
+
+// All returns every configured directory, skipping empty entries.
+func (dirs ReaderDirectories) All() []string {
+	var all []string
+	for _, group := range [][]string{
+		dirs.Stories, dirs.Journals, dirs.Flashcards, dirs.Books,
+		dirs.Definitions, dirs.Etymology, dirs.Grammars,
+	} {
+		for _, dir := range group {
+			if dir != "" {
+				all = append(all, dir)
+			}
+		}
+	}
+	return all
+}
+
+// NewReaderFromDirectories builds a Reader with journals and grammars loaded,
+// the superset every quiz mode, the notebook RPCs and analytics read from.
+func NewReaderFromDirectories(dirs ReaderDirectories, dictionaryMap map[string]rapidapi.Response) (*Reader, error) {
+	reader, err := NewReader(dirs.Stories, dirs.Flashcards, dirs.Books, dirs.Definitions, dirs.Etymology, dictionaryMap)
+	if err != nil {
+		return nil, err
+	}
+	if err := reader.LoadJournals(dirs.Journals); err != nil {
+		return nil, fmt.Errorf("reader.LoadJournals() > %w", err)
+	}
+	if err := reader.LoadGrammars(dirs.Grammars); err != nil {
+		return nil, fmt.Errorf("reader.LoadGrammars() > %w", err)
+	}
+	return reader, nil
+}
+
+// WatchedReader keeps a parsed Reader snapshot in memory and refreshes it when
+// files under the notebook directories change, so a server neither re-parses
+// every YAML file per request nor serves notebooks that were edited after it
+// started.
+//
+// Files are cached individually: an edit re-parses only the files that
+// changed, and on the next Reader call the snapshot reloads only the
+// notebooks those files belong to (their index, definitions or grammars),
+// keeping every other notebook as parsed.
+type WatchedReader struct {
+	dirs          ReaderDirectories
+	dictionaryMap map[string]rapidapi.Response
+	cache         *yamlNodeCache
+	watcher       *fsnotify.Watcher
+
+	mu       sync.Mutex
+	snapshot *Reader
+	// stale forces a full rebuild, when changes may have been lost.
+	stale bool
+	// changed holds the paths changed since the snapshot was built.
+	changed map[string]struct{}
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewWatchedReader starts watching the given directories (recursively,
+// including learningNotesDirectory so learning-history reads are cached too)
+// and builds the first snapshot. Only one WatchedReader should be active per
+// process; Close stops it.
+func NewWatchedReader(dirs ReaderDirectories, learningNotesDirectory string, dictionaryMap map[string]rapidapi.Response) (*WatchedReader, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("fsnotify.NewWatcher() > %w", err)
+	}
+
+	roots := dirs.All()
+	if learningNotesDirectory != "" {
+		roots = append(roots, learningNotesDirectory)
+	}
+	w := &WatchedReader{
+		dirs:          dirs,
+		dictionaryMap: dictionaryMap,
+		cache:         newYAMLNodeCache(roots),
+		watcher:       watcher,
+		stale:         true,
+		done:          make(chan struct{}),
+	}
+	for _, root := range roots {
+		if err := w.watchTree(root); err != nil {
+			_ = watcher.Close()
+			return nil, err
+		}
+	}
+
+	activeYAMLCache.Store(w.cache)
+	if _, err := w.Reader(); err != nil {
+		activeYAMLCache.CompareAndSwap(w.cache, nil)
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	w.wg.Add(1)
+	go w.run()
+	return w, nil
+}
+
+// Reader returns a private copy of the current snapshot. The copy shares the
+// parsed definitions and grammars but owns its index maps, which Reader
+// methods fill in lazily, so concurrent requests never write to the same map.
+func (w *WatchedReader) Reader() (*Reader, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	switch {
+	case w.stale || w.snapshot == nil:
+		reader, err := NewReaderFromDirectories(w.dirs, w.dictionaryMap)
+		if err != nil {
+			return nil, err
+		}
+		w.snapshot = reader
+		w.stale = false
+		w.changed = nil
+	case len(w.changed) > 0:
+		changed := slices.Sorted(maps.Keys(w.changed))
+		reader, err := w.snapshot.refresh(w.dirs, changed)
+		if err != nil {
+			return nil, err
+		}
+		w.snapshot = reader
+		w.changed = nil
+	}
+	return w.snapshot.clone(), nil
+}
+
+// Close stops watching and detaches the file cache.
+func (w *WatchedReader) Close() error {
+	close(w.done)
+	err := w.watcher.Close()
+	w.wg.Wait()
+	activeYAMLCache.CompareAndSwap(w.cache, nil)
+	return err
+}
+
+func (w *WatchedReader) run() {
+	defer w.wg.Done()
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			w.handleEvent(event)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			// An overflowed event queue means changes may have been lost;
+			// the mod-time check in the cache still catches edited files,
+			// and rebuilding the whole snapshot picks up added ones.
+			slog.Warn("notebook watcher error", "error", err)
+			w.markStale()
+		}
+	}
+}
+
+func (w *WatchedReader) handleEvent(event fsnotify.Event) {
+	if event.Has(fsnotify.Chmod) && !event.Has(fsnotify.Write) {
+		return
+	}
+	slog.Debug("notebook file changed", "path", event.Name, "op", event.Op.String())
+
+	w.cache.invalidate(event.Name)
+	if event.Has(fsnotify.Create) {
+		if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+			if err := w.watchTree(event.Name); err != nil {
+				slog.Warn("failed to watch new notebook directory", "path", event.Name, "error", err)
+			}
+		}
+	}
+	w.markChanged(event.Name)
+}
+
+func (w *WatchedReader) markChanged(path string) {
+	w.mu.Lock()
+	if w.changed == nil {
+		w.changed = make(map[string]struct{})
+	}
+	w.changed[filepath.Clean(path)] = struct{}{}
+	w.mu.Unlock()
+}
+
+func (w *WatchedReader) markStale() {
+	w.mu.Lock()
+	w.stale = true
+	w.mu.Unlock()
+}
+
+// watchTree adds root and every directory below it; fsnotify only watches a
+// single directory level. A missing root is skipped like the index walkers do.
+func (w *WatchedReader) watchTree(root string) error {
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if err := w.watcher.Add(path); err != nil {
+			return fmt.Errorf("watcher.Add(%s) > %w", path, err)
+		}
+		return nil
+	})
+}
+
+// clone copies the maps that Reader methods write to (ReadStoryNotebooks,
+// ReadFlashcardNotebooks and ReadEtymologyNotebook memoise into the indexes)
+// and drops anything memoised, leaving the read-only parsed data shared.
+func (r *Reader) clone() *Reader {
+	indexes := make(map[string]Index, len(r.indexes))
+	for id, index := range r.indexes {
+		index.Notebooks = nil
+		indexes[id] = index
+	}
+	flashcardIndexes := make(map[string]FlashcardIndex, len(r.flashcardIndexes))
+	for id, index := range r.flashcardIndexes {
+		index.Notebooks = nil
+		flashcardIndexes[id] = index
+	}
+	etymologyIndexes := make(map[string]EtymologyIndex, len(r.etymologyIndexes))
+	for id, index := range r.etymologyIndexes {
+		index.Origins = nil
+		etymologyIndexes[id] = index
+	}
+	grammarsMap := make(map[string]map[string]map[int][]Correction, len(r.grammarsMap))
+	for id, byTitle := range r.grammarsMap {
+		grammarsMap[id] = byTitle
+	}
+	journalIDs := make(map[string]struct{}, len(r.journalIDs))
+	for id := range r.journalIDs {
+		journalIDs[id] = struct{}{}
+	}
+
+	return &Reader{
+		indexes:          indexes,
+		flashcardIndexes: flashcardIndexes,
+		etymologyIndexes: etymologyIndexes,
+		grammarsMap:      grammarsMap,
+		journalIDs:       journalIDs,
+		dictionaryMap:    r.dictionaryMap,
+		definitionsMap:   r.definitionsMap,
+		definitionsRaw:   r.definitionsRaw,
+		definitionsDates: r.definitionsDates,
+		definitionsPaths: r.definitionsPaths,
+		grammarsPaths:    r.grammarsPaths,
+	}
+}
+
+// refresh returns a copy of r in which the notebooks the changed paths
+// belong to are loaded again. A path inside a known notebook reloads that
+// notebook; a new or removed directory or index file reloads the notebooks
+// below it. Other notebooks keep their parsed data, shared with r.
+func (r *Reader) refresh(dirs ReaderDirectories, changed []string) (*Reader, error) {
+	next := &Reader{
+		indexes:          maps.Clone(r.indexes),
+		flashcardIndexes: maps.Clone(r.flashcardIndexes),
+		etymologyIndexes: maps.Clone(r.etymologyIndexes),
+		grammarsMap:      maps.Clone(r.grammarsMap),
+		journalIDs:       maps.Clone(r.journalIDs),
+		dictionaryMap:    r.dictionaryMap,
+		definitionsMap:   maps.Clone(r.definitionsMap),
+		definitionsRaw:   maps.Clone(r.definitionsRaw),
+		definitionsDates: maps.Clone(r.definitionsDates),
+		definitionsPaths: maps.Clone(r.definitionsPaths),
+		grammarsPaths:    maps.Clone(r.grammarsPaths),
+	}
+	indexRoots := slices.Concat(dirs.Stories, dirs.Journals, dirs.Flashcards, dirs.Books, dirs.Etymology)
+	for _, path := range changed {
+		if withinAny(path, indexRoots) {
+			if scope, ok := notebookScope(path, next.indexPaths(), false); ok {
+				if err := next.reloadIndexes(dirs, scope); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if withinAny(path, dirs.Definitions) {
+			if scope, ok := notebookScope(path, slices.Collect(maps.Values(next.definitionsPaths)), true); ok {
+				if err := next.reloadDefinitions(scope); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if withinAny(path, dirs.Grammars) {
+			if scope, ok := notebookScope(path, slices.Collect(maps.Values(next.grammarsPaths)), false); ok {
+				if err := next.reloadGrammars(scope); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return next, nil
+}
+
+// indexPaths returns the directory of every story, book, journal,
+// flashcard and etymology notebook.
+func (r *Reader) indexPaths() []string {
+	paths := make([]string, 0, len(r.indexes)+len(r.flashcardIndexes)+len(r.etymologyIndexes))
+	for _, index := range r.indexes {
+		paths = append(paths, index.Path)
+	}
+	for _, index := range r.flashcardIndexes {
+		paths = append(paths, index.Path)
+	}
+	for _, index := range r.etymologyIndexes {
+		paths = append(paths, index.Path)
+	}
+	return paths
+}
+
+// reloadIndexes drops the indexes loaded from scope and walks it again the
+// way NewReaderFromDirectories walks each kind of directory.
+func (r *Reader) reloadIndexes(dirs ReaderDirectories, scope string) error {
+	for id, index := range r.indexes {
+		if withinPath(filepath.Clean(index.Path), scope) {
+			delete(r.indexes, id)
+			delete(r.journalIDs, id)
+		}
+	}
+	for id, index := range r.flashcardIndexes {
+		if withinPath(filepath.Clean(index.Path), scope) {
+			delete(r.flashcardIndexes, id)
+		}
+	}
+	for id, index := range r.etymologyIndexes {
+		if withinPath(filepath.Clean(index.Path), scope) {
+			delete(r.etymologyIndexes, id)
+		}
+	}
+
+	if withinAny(scope, slices.Concat(dirs.Stories, dirs.Flashcards, dirs.Etymology)) {
+		if err := walkEtymologyIndexFiles(scope, r.etymologyIndexes); err != nil {
+			return fmt.Errorf("walkEtymologyIndexFiles(%s) > %w", scope, err)
+		}
+	}
+	if withinAny(scope, dirs.Stories) {
+		if err := walkIndexFiles(scope, r.indexes, false); err != nil {
+			return fmt.Errorf("walkIndexFiles(story, %s) > %w", scope, err)
+		}
+	}
+	if withinAny(scope, dirs.Flashcards) {
+		if err := walkIndexFiles(scope, r.flashcardIndexes, false); err != nil {
+			return fmt.Errorf("walkIndexFiles(flashcard, %s) > %w", scope, err)
+		}
+	}
+	if withinAny(scope, dirs.Books) {
+		if err := walkIndexFiles(scope, r.indexes, true); err != nil {
+			return fmt.Errorf("walkIndexFiles(books, %s) > %w", scope, err)
+		}
+	}
+	for id := range r.etymologyIndexes {
+		delete(r.indexes, id)
+	}
+	if withinAny(scope, dirs.Journals) {
+		if err := r.LoadJournals([]string{scope}); err != nil {
+			return fmt.Errorf("reader.LoadJournals() > %w", err)
+		}
+	}
+	return nil
+}
+
+// reloadDefinitions drops the definitions books loaded from scope and
+// loads it again.
+func (r *Reader) reloadDefinitions(scope string) error {
+	for id, source := range r.definitionsPaths {
+		if withinPath(source, scope) {
+			delete(r.definitionsMap, id)
+			delete(r.definitionsRaw, id)
+			delete(r.definitionsDates, id)
+			delete(r.definitionsPaths, id)
+		}
+	}
+	definitionsMap, definitionsRaw, definitionsDates, definitionsPaths, err := newDefinitionsMap([]string{scope})
+	if err != nil {
+		return fmt.Errorf("newDefinitionsMap(%s) > %w", scope, err)
+	}
+	maps.Copy(r.definitionsMap, definitionsMap)
+	maps.Copy(r.definitionsRaw, definitionsRaw)
+	maps.Copy(r.definitionsDates, definitionsDates)
+	maps.Copy(r.definitionsPaths, definitionsPaths)
+	return nil
+}
+
+// reloadGrammars drops the grammars notebooks loaded from scope and loads
+// it again.
+func (r *Reader) reloadGrammars(scope string) error {
+	for id, source := range r.grammarsPaths {
+		if withinPath(source, scope) {
+			delete(r.grammarsMap, id)
+			delete(r.grammarsPaths, id)
+		}
+	}
+	if err := r.LoadGrammars([]string{scope}); err != nil {
+		return fmt.Errorf("reader.LoadGrammars() > %w", err)
+	}
+	return nil
+}
+
+// notebookScope returns what to reload for a change at path: the innermost
+// known notebook path contains, or path itself when it is, or held, a
+// directory of notebooks. A new index.yml reloads its directory. When
+// standalone is set, as for definitions books, a new YAML file is a
+// notebook of its own unless its directory has an index.yml. ok is false
+// when the change cannot affect any notebook, like a notebook file no index
+// lists yet.
+func notebookScope(path string, known []string, standalone bool) (string, bool) {
+	scope := ""
+	for _, source := range known {
+		source = filepath.Clean(source)
+		if withinPath(path, source) && len(source) > len(scope) {
+			scope = source
+		}
+	}
+	if scope != "" {
+		return scope, true
+	}
+	for _, source := range known {
+		if withinPath(filepath.Clean(source), path) {
+			return path, true
+		}
+	}
+
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return path, true
+	}
+	if filepath.Base(path) == "index.yml" {
+		return filepath.Dir(path), true
+	}
+	if standalone && filepath.Ext(path) == ".yml" {
+		if _, err := os.Stat(filepath.Join(filepath.Dir(path), "index.yml")); err == nil {
+			return filepath.Dir(path), true
+		}
+		return path, true
+	}
+	return "", false
+}
+
+// withinPath reports whether path is root or lies below it.
+func withinPath(path, root string) bool {
+	return path == root || strings.HasPrefix(path, root+string(filepath.Separator))
+}
+
+func withinAny(path string, roots []string) bool {
+	for _, root := range roots {
+		if root != "" && withinPath(path, filepath.Clean(root)) {
+			return true
+		}
+	}
+	return false
+}
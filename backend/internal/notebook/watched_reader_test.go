@@ -0,0 +1,182 @@
+package notebook
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeWatchedStory(t *testing.T, storyDir, meaning string) {
+	t.Helper()
+	require.NoError(t, WriteYamlFile(filepath.Join(storyDir, "stories.yml"), []StoryNotebook{
+		{
+			Event: "Episode 1",
+			Date:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Scenes: []StoryScene{
+				{
+					Title:         "Scene 1",
+					Conversations: []Conversation{{Speaker: "A", Quote: "A {{ tricky }} situation."}},
+					Definitions:   []Note{{Expression: "tricky", Meaning: meaning}},
+				},
+			},
+		},
+	}))
+}
+
+func TestWatchedReader(t *testing.T) {
+	storiesDir := t.TempDir()
+	storyDir := filepath.Join(storiesDir, "test-story")
+	require.NoError(t, os.MkdirAll(storyDir, 0755))
+	require.NoError(t, WriteYamlFile(filepath.Join(storyDir, "index.yml"), Index{
+		Kind: "story", ID: "test-story", Name: "Test Story",
+		NotebookPaths: []string{"stories.yml"},
+	}))
+	writeWatchedStory(t, storyDir, "difficult to deal with")
+
+	watched, err := NewWatchedReader(ReaderDirectories{Stories: []string{storiesDir}}, "", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, watched.Close())
+		assert.Nil(t, activeYAMLCache.Load())
+	})
+
+	readMeaning := func() string {
+		reader, err := watched.Reader()
+		require.NoError(t, err)
+		stories, err := reader.ReadStoryNotebooks("test-story")
+		require.NoError(t, err)
+		require.Len(t, stories, 1)
+		return stories[0].Scenes[0].Definitions[0].Meaning
+	}
+
+	t.Run("reads from the snapshot", func(t *testing.T) {
+		assert.Equal(t, "difficult to deal with", readMeaning())
+	})
+
+	t.Run("readers do not share memoised state", func(t *testing.T) {
+		first, err := watched.Reader()
+		require.NoError(t, err)
+		second, err := watched.Reader()
+		require.NoError(t, err)
+
+		_, err = first.ReadStoryNotebooks("test-story")
+		require.NoError(t, err)
+		assert.Len(t, first.GetStoryIndexes()["test-story"].Notebooks, 1)
+		assert.Empty(t, second.GetStoryIndexes()["test-story"].Notebooks)
+	})
+
+	t.Run("returned values are not shared between reads", func(t *testing.T) {
+		reader, err := watched.Reader()
+		require.NoError(t, err)
+		stories, err := reader.ReadStoryNotebooks("test-story")
+		require.NoError(t, err)
+		stories[0].Scenes[0].Definitions[0].Meaning = "mutated"
+
+		assert.Equal(t, "difficult to deal with", readMeaning())
+	})
+
+	t.Run("picks up an edited notebook", func(t *testing.T) {
+		writeWatchedStory(t, storyDir, "hard to handle")
+		assert.Equal(t, "hard to handle", readMeaning())
+	})
+
+	t.Run("picks up a notebook added while running", func(t *testing.T) {
+		newDir := filepath.Join(storiesDir, "new-story")
+		require.NoError(t, os.MkdirAll(newDir, 0755))
+		writeWatchedStory(t, newDir, "new meaning")
+		require.NoError(t, WriteYamlFile(filepath.Join(newDir, "index.yml"), Index{
+			Kind: "story", ID: "new-story", Name: "New Story",
+			NotebookPaths: []string{"stories.yml"},
+		}))
+
+		assert.Eventually(t, func() bool {
+			reader, err := watched.Reader()
+			if err != nil {
+				return false
+			}
+			_, ok := reader.GetStoryIndexes()["new-story"]
+			return ok
+		}, 5*time.Second, 20*time.Millisecond)
+	})
+}
+
+func TestReader_refresh(t *testing.T) {
+	storiesDir := t.TempDir()
+	definitionsDir := t.TempDir()
+	writeIndex := func(id, name string) {
+		t.Helper()
+		dir := filepath.Join(storiesDir, id)
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, WriteYamlFile(filepath.Join(dir, "index.yml"), Index{
+			Kind: "story", ID: id, Name: name,
+			NotebookPaths: []string{"stories.yml"},
+		}))
+	}
+	writeDefinitions := func(bookID, meaning string) {
+		t.Helper()
+		require.NoError(t, WriteYamlFile(filepath.Join(definitionsDir, bookID+".yml"), []Definitions{
+			{
+				Metadata: DefinitionsMetadata{Title: "Chapter 1"},
+				Scenes: []DefinitionsScene{
+					{Expressions: []Note{{Expression: "tricky", Meaning: meaning}}},
+				},
+			},
+		}))
+	}
+	writeIndex("first", "First")
+	writeIndex("second", "Second")
+	writeDefinitions("book", "difficult")
+	writeDefinitions("other-book", "difficult")
+
+	dirs := ReaderDirectories{Stories: []string{storiesDir}, Definitions: []string{definitionsDir}}
+	reader, err := NewReaderFromDirectories(dirs, nil)
+	require.NoError(t, err)
+
+	t.Run("reloads only the notebook whose file changed", func(t *testing.T) {
+		writeIndex("first", "First renamed")
+		writeIndex("second", "Second renamed")
+
+		refreshed, err := reader.refresh(dirs, []string{filepath.Join(storiesDir, "first", "stories.yml")})
+		require.NoError(t, err)
+		assert.Equal(t, "First renamed", refreshed.GetStoryIndexes()["first"].Name)
+		assert.Equal(t, "Second", refreshed.GetStoryIndexes()["second"].Name)
+		assert.Equal(t, "First", reader.GetStoryIndexes()["first"].Name, "the previous snapshot is left as is")
+	})
+
+	t.Run("adds and drops notebook directories", func(t *testing.T) {
+		writeIndex("third", "Third")
+		require.NoError(t, os.RemoveAll(filepath.Join(storiesDir, "second")))
+
+		refreshed, err := reader.refresh(dirs, []string{
+			filepath.Join(storiesDir, "second"),
+			filepath.Join(storiesDir, "third"),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, refreshed.GetStoryIndexes(), "third")
+		assert.NotContains(t, refreshed.GetStoryIndexes(), "second")
+		assert.Contains(t, refreshed.GetStoryIndexes(), "first")
+	})
+
+	t.Run("reloads one definitions book", func(t *testing.T) {
+		writeDefinitions("book", "hard")
+		writeDefinitions("other-book", "hard")
+
+		refreshed, err := reader.refresh(dirs, []string{filepath.Join(definitionsDir, "book.yml")})
+		require.NoError(t, err)
+		assert.Equal(t, "hard", refreshed.definitionsMap["book"]["Chapter 1"]["__index_0"][0].Meaning)
+		assert.Equal(t, "difficult", refreshed.definitionsMap["other-book"]["Chapter 1"]["__index_0"][0].Meaning)
+	})
+}
+
+func TestYAMLNodeCache_covers(t *testing.T) {
+	cache := newYAMLNodeCache([]string{"/data/stories", ""})
+
+	assert.True(t, cache.covers("/data/stories/a/index.yml"))
+	assert.True(t, cache.covers("/data/stories"))
+	assert.False(t, cache.covers("/data/stories-old/index.yml"))
+	assert.False(t, cache.covers("/data/learning_notes/a.yml"))
+}
@@ -0,0 +1,147 @@
+package notebook
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// activeYAMLCache is the parse cache readYamlFile consults. It is nil unless a
+// WatchedReader is running, so the CLI and tests keep reading straight from
+// disk.
+var activeYAMLCache atomic.Pointer[yamlNodeCache]
+
+// yamlNodeCache keeps the parsed yaml.Node tree of every file read under its
+// roots. Decoding from a node skips scanning and parsing, which is most of
+// the cost of a read, and still produces fresh values per call so callers
+// can mutate what they get back without affecting other requests.
+//
+// Entries are dropped by the watcher when a file changes and are also
+// validated against the file's mod time and size, so an event fsnotify
+// misses can never serve stale content.
+type yamlNodeCache struct {
+	roots []string
+
+	mu      sync.RWMutex
+	entries map[string]yamlNodeCacheEntry
+}
+
+type yamlNodeCacheEntry struct {
+	modTime time.Time
+	size    int64
+	node    *yaml.Node
+}
+
+func newYAMLNodeCache(roots []string) *yamlNodeCache {
+	cleaned := make([]string, 0, len(roots))
+	for _, root := range roots {
+		if root == "" {
+			continue
+		}
+		cleaned = append(cleaned, filepath.Clean(root))
+	}
+	return &yamlNodeCache{
+		roots:   cleaned,
+		entries: make(map[string]yamlNodeCacheEntry),
+	}
+}
+
+// covers reports whether path lives under one of the watched roots. Files
+// outside them are never cached because nothing would invalidate them.
+func (c *yamlNodeCache) covers(path string) bool {
+	path = filepath.Clean(path)
+	for _, root := range c.roots {
+		if withinPath(path, root) {
+			return true
+		}
+	}
+	return false
+}
+
+// load returns the parsed document of path, parsing it only when it is not
+// cached or has changed on disk since it was cached.
+func (c *yamlNodeCache) load(path string) (*yaml.Node, error) {
+	key := filepath.Clean(path)
+	info, err := os.Stat(key)
+	if err != nil {
+		c.invalidate(key)
+		return nil, fmt.Errorf("os.Open(%s)> %w", path, err)
+	}
+
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok && entry.modTime.Equal(info.ModTime()) && entry.size == info.Size() {
+		return entry.node, nil
+	}
+
+	file, err := os.Open(key)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open(%s)> %w", path, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var node yaml.Node
+	if err := yaml.NewDecoder(file).Decode(&node); err != nil {
+		return nil, fmt.Errorf("yaml.NewDecoder().Decode()> %w", err)
+	}
+
+	c.mu.Lock()
+	c.entries[key] = yamlNodeCacheEntry{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		node:    &node,
+	}
+	c.mu.Unlock()
+	return &node, nil
+}
+
+// invalidate drops path, and every cached file below it when path is a
+// directory that was removed or renamed.
+func (c *yamlNodeCache) invalidate(path string) {
+	key := filepath.Clean(path)
+	prefix := key + string(filepath.Separator)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+	for cached := range c.entries {
+		if strings.HasPrefix(cached, prefix) {
+			delete(c.entries, cached)
+		}
+	}
+}
+
+// readYamlFileCached decodes path through the active cache. ok is false when
+// no cache is active or path is outside its roots.
+func readYamlFileCached[T any](path string) (result T, ok bool, err error) {
+	cache := activeYAMLCache.Load()
+	if cache == nil || !cache.covers(path) {
+		return result, false, nil
+	}
+	node, err := cache.load(path)
+	if err != nil {
+		return result, true, err
+	}
+	if err := node.Decode(&result); err != nil {
+		return result, true, fmt.Errorf("yaml.NewDecoder().Decode()> %w", err)
+	}
+	return result, true, nil
+}
+
+// invalidateCachedYAMLFile drops path from the active cache, if any. Writers
+// call it so a read straight after a write never sees the old content, even
+// before the watcher delivers the change event.
+func invalidateCachedYAMLFile(path string) {
+	if cache := activeYAMLCache.Load(); cache != nil {
+		cache.invalidate(path)
+	}
+}
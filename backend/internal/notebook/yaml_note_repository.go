@@ -211,6 +211,56 @@ func (r *YAMLNoteRepository) FindAll(ctx context.Context) ([]NoteRecord, error)
 	return result, nil
 }
 
+// FindNotebooks returns the story, book and flashcard notebooks with their
+// index names, and the prose of every story scene, for import-db to store
+// next to the notes FindAll returns.
+func (r *YAMLNoteRepository) FindNotebooks(ctx context.Context) ([]NotebookRecord, error) {
+	if r.reader == nil {
+		return nil, nil
+	}
+	storyIndexes, err := r.reader.ReadAllStoryNotebooks()
+	if err != nil {
+		return nil, fmt.Errorf("read all story notebooks: %w", err)
+	}
+	flashcardIndexes, err := r.reader.ReadAllFlashcardNotebooks()
+	if err != nil {
+		return nil, fmt.Errorf("read all flashcard notebooks: %w", err)
+	}
+
+	var notebooks []NotebookRecord
+	for _, indexID := range sortedKeys(storyIndexes) {
+		index := storyIndexes[indexID]
+		nb := NotebookRecord{NotebookType: "story", NotebookID: indexID, Name: index.Name}
+		if index.IsBook {
+			nb.NotebookType = "book"
+		}
+		for _, storyNotebooks := range index.Notebooks {
+			for _, sn := range storyNotebooks {
+				for _, scene := range sn.Scenes {
+					if len(scene.Conversations) == 0 && len(scene.Statements) == 0 {
+						continue
+					}
+					nb.Scenes = append(nb.Scenes, NotebookSceneRecord{
+						Group:         sn.Event,
+						Subgroup:      scene.Title,
+						Conversations: scene.Conversations,
+						Statements:    scene.Statements,
+					})
+				}
+			}
+		}
+		notebooks = append(notebooks, nb)
+	}
+	for _, flashcardID := range sortedKeys(flashcardIndexes) {
+		notebooks = append(notebooks, NotebookRecord{
+			NotebookType: "flashcard",
+			NotebookID:   flashcardID,
+			Name:         flashcardIndexes[flashcardID].Name,
+		})
+	}
+	return notebooks, nil
+}
+
 func convertNoteToRecord(note Note, notebookType, notebookID, group, subgroup string) NoteRecord {
 	entry := note.Definition
 	if entry == "" {
@@ -244,6 +294,27 @@ func convertNoteToRecord(note Note, notebookType, notebookID, group, subgroup st
 
 // WriteAll converts NoteRecords to YAML files grouped by notebook.
 func (r *YAMLNoteRepository) WriteAll(notes []NoteRecord) error {
+	keys, grouped := groupNotesByNotebook(notes)
+	for _, key := range keys {
+		entries := grouped[key]
+		switch key.notebookType {
+		case "story", "book":
+			if err := r.writeStoryNotebook(key, entries); err != nil {
+				return fmt.Errorf("write story notebook %s: %w", key.notebookID, err)
+			}
+		case "flashcard":
+			if err := r.writeFlashcardNotebook(key, entries); err != nil {
+				return fmt.Errorf("write flashcard notebook %s: %w", key.notebookID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// groupNotesByNotebook converts NoteRecords to notes grouped by the
+// notebooks they belong to, with the keys sorted for deterministic output.
+func groupNotesByNotebook(notes []NoteRecord) ([]notebookKey, map[notebookKey][]noteWithNN) {
 	grouped := make(map[notebookKey][]noteWithNN)
 	var keys []notebookKey
 	seenKeys := make(map[notebookKey]bool)
@@ -267,26 +338,44 @@ func (r *YAMLNoteRepository) WriteAll(notes []NoteRecord) error {
 		}
 		return keys[i].notebookID < keys[j].notebookID
 	})
+	return keys, grouped
+}
 
-	for _, key := range keys {
-		entries := grouped[key]
-		switch key.notebookType {
-		case "story", "book":
-			if err := r.writeStoryNotebook(key, entries); err != nil {
-				return fmt.Errorf("write story notebook %s: %w", key.notebookID, err)
-			}
-		case "flashcard":
-			if err := r.writeFlashcardNotebook(key, entries); err != nil {
-				return fmt.Errorf("write flashcard notebook %s: %w", key.notebookID, err)
-			}
-		}
+func (r *YAMLNoteRepository) writeStoryNotebook(key notebookKey, entries []noteWithNN) error {
+	storyNotebooks := buildStoryNotebooks(entries)
+
+	// Determine directory name based on type
+	dirName := "stories"
+	if key.notebookType == "book" {
+		dirName = "books"
+	}
+	dir := filepath.Join(r.outputDir, dirName, key.notebookID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", dir, err)
+	}
+
+	// Write index.yml
+	index := Index{
+		ID:            key.notebookID,
+		Kind:          key.notebookType,
+		Name:          key.notebookID,
+		NotebookPaths: []string{"./notebooks.yml"},
+	}
+	if err := WriteYamlFile(filepath.Join(dir, "index.yml"), index); err != nil {
+		return fmt.Errorf("write index.yml: %w", err)
+	}
+
+	// Write notebooks.yml
+	if err := WriteYamlFile(filepath.Join(dir, "notebooks.yml"), storyNotebooks); err != nil {
+		return fmt.Errorf("write notebooks.yml: %w", err)
 	}
 
 	return nil
 }
 
-func (r *YAMLNoteRepository) writeStoryNotebook(key notebookKey, entries []noteWithNN) error {
-	// Group by Group (Event) then Subgroup (Scene Title)
+// buildStoryNotebooks groups a story notebook's notes by Group (Event) then
+// Subgroup (Scene Title), both in insertion order.
+func buildStoryNotebooks(entries []noteWithNN) []StoryNotebook {
 	type sceneKey struct {
 		event string
 		scene string
@@ -352,38 +441,38 @@ func (r *YAMLNoteRepository) writeStoryNotebook(key notebookKey, entries []noteW
 			Scenes: storyScenes,
 		})
 	}
+	return storyNotebooks
+}
 
-	// Determine directory name based on type
-	dirName := "stories"
-	if key.notebookType == "book" {
-		dirName = "books"
-	}
-	dir := filepath.Join(r.outputDir, dirName, key.notebookID)
+func (r *YAMLNoteRepository) writeFlashcardNotebook(key notebookKey, entries []noteWithNN) error {
+	flashcardNotebooks := buildFlashcardNotebooks(entries)
+
+	dir := filepath.Join(r.outputDir, "flashcards", key.notebookID)
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return fmt.Errorf("create directory %s: %w", dir, err)
 	}
 
 	// Write index.yml
-	index := Index{
+	index := FlashcardIndex{
 		ID:            key.notebookID,
-		Kind:          key.notebookType,
 		Name:          key.notebookID,
-		NotebookPaths: []string{"./notebooks.yml"},
+		NotebookPaths: []string{"./cards.yml"},
 	}
 	if err := WriteYamlFile(filepath.Join(dir, "index.yml"), index); err != nil {
 		return fmt.Errorf("write index.yml: %w", err)
 	}
 
-	// Write notebooks.yml
-	if err := WriteYamlFile(filepath.Join(dir, "notebooks.yml"), storyNotebooks); err != nil {
-		return fmt.Errorf("write notebooks.yml: %w", err)
+	// Write cards.yml
+	if err := WriteYamlFile(filepath.Join(dir, "cards.yml"), flashcardNotebooks); err != nil {
+		return fmt.Errorf("write cards.yml: %w", err)
 	}
 
 	return nil
 }
 
-func (r *YAMLNoteRepository) writeFlashcardNotebook(key notebookKey, entries []noteWithNN) error {
-	// Group by Group (Title)
+// buildFlashcardNotebooks groups a flashcard notebook's notes by Group
+// (Title) in insertion order.
+func buildFlashcardNotebooks(entries []noteWithNN) []FlashcardNotebook {
 	titleOrder := make(map[string]int)
 	var titleCounter int
 	notebookMap := make(map[string][]Note)
@@ -413,28 +502,7 @@ func (r *YAMLNoteRepository) writeFlashcardNotebook(key notebookKey, entries []n
 			Cards: notebookMap[title],
 		})
 	}
-
-	dir := filepath.Join(r.outputDir, "flashcards", key.notebookID)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("create directory %s: %w", dir, err)
-	}
-
-	// Write index.yml
-	index := FlashcardIndex{
-		ID:            key.notebookID,
-		Name:          key.notebookID,
-		NotebookPaths: []string{"./cards.yml"},
-	}
-	if err := WriteYamlFile(filepath.Join(dir, "index.yml"), index); err != nil {
-		return fmt.Errorf("write index.yml: %w", err)
-	}
-
-	// Write cards.yml
-	if err := WriteYamlFile(filepath.Join(dir, "cards.yml"), flashcardNotebooks); err != nil {
-		return fmt.Errorf("write cards.yml: %w", err)
-	}
-
-	return nil
+	return flashcardNotebooks
 }
 
 func convertRecordToNote(rec NoteRecord) Note {
@@ -468,6 +468,46 @@ func TestYAMLNoteRepository_FindAll_NilReaderReturnsEmpty(t *testing.T) {
 	assert.Empty(t, got)
 }
 
+func TestYAMLNoteRepository_FindNotebooks(t *testing.T) {
+	env := newTestFlashcardEnv(t)
+	storyDir := env.createStoryIndex("my-story", "My Story", []string{"./season01.yml"})
+	env.createCardFile(storyDir, "season01.yml", `- event: "Episode 1"
+  scenes:
+    - scene: "Opening Scene"
+      conversations:
+        - speaker: "Alice"
+          quote: "Let's {{ break the ice }}."
+      definitions:
+        - expression: "break the ice"
+          meaning: "to initiate social interaction"
+    - scene: "Words only"
+      definitions:
+        - expression: "call it a day"
+          meaning: "to stop working"
+`)
+	flashcardEnv := newTestFlashcardEnv(t)
+	flashcardDir := flashcardEnv.createFlashcardIndex("adjectives", "Adjectives", []string{"./cards.yml"})
+	flashcardEnv.createCardFile(flashcardDir, "cards.yml", `- title: "Common"
+  cards:
+    - expression: "tricky"
+      meaning: "difficult"
+`)
+	reader, err := NewReader([]string{env.tempDir}, []string{flashcardEnv.tempDir}, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	got, err := NewYAMLNoteRepository(reader).FindNotebooks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []NotebookRecord{
+		{
+			NotebookType: "story", NotebookID: "my-story", Name: "My Story",
+			Scenes: []NotebookSceneRecord{
+				{Group: "Episode 1", Subgroup: "Opening Scene", Conversations: []Conversation{{Speaker: "Alice", Quote: "Let's {{ break the ice }}."}}},
+			},
+		},
+		{NotebookType: "flashcard", NotebookID: "adjectives", Name: "Adjectives"},
+	}, got)
+}
+
 func TestConvertRecordToNote(t *testing.T) {
 	tests := []struct {
 		name string
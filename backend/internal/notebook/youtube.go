@@ -0,0 +1,75 @@
+package notebook
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// YouTubeLink returns videoURL linked to seconds into the video by its t
+// parameter, or videoURL itself when seconds is zero. It returns "" when
+// there is no video.
+func YouTubeLink(videoURL string, seconds int) string {
+	if videoURL == "" {
+		return ""
+	}
+	if seconds <= 0 {
+		return videoURL
+	}
+	u, err := url.Parse(videoURL)
+	if err != nil {
+		return videoURL
+	}
+	query := u.Query()
+	query.Set("t", strconv.Itoa(seconds))
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// FormatTimestamp formats seconds from the start of a video as m:ss, or
+// h:mm:ss from an hour on.
+func FormatTimestamp(seconds int) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+	h, m, s := seconds/3600, seconds%3600/60, seconds%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
+
+// TimeSecondsOf returns when note is said in the video of the scene: its
+// own youtube_time_seconds, or else the time of the first conversation
+// its expression appears in. Zero means the time isn't known.
+func (scene StoryScene) TimeSecondsOf(note Note) int {
+	if note.YouTubeTimeSeconds > 0 {
+		return note.YouTubeTimeSeconds
+	}
+	for _, expression := range []string{note.Expression, note.Definition} {
+		expression = strings.TrimSpace(expression)
+		if expression == "" {
+			continue
+		}
+		pattern := buildValidatePattern(expression)
+		for _, conv := range scene.Conversations {
+			if conv.TimeSeconds > 0 && pattern.MatchString(conv.Quote) {
+				return conv.TimeSeconds
+			}
+		}
+	}
+	return 0
+}
+
+// VideoMoment returns the timestamp of seconds into a video and the link
+// to it, each empty when unknown.
+func VideoMoment(videoURL string, seconds int) (timestamp, link string) {
+	if seconds <= 0 {
+		return "", ""
+	}
+	if videoURL != "" {
+		link = YouTubeLink(videoURL, seconds)
+	}
+	return FormatTimestamp(seconds), link
+}
@@ -0,0 +1,102 @@
+package notebook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYouTubeLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		videoURL string
+		seconds  int
+		want     string
+	}{
+		{
+			name:     "a watch URL keeps its video",
+			videoURL: "https://www.youtube.com/watch?v=X5oCPvGe-4M",
+			seconds:  83,
+			want:     "https://www.youtube.com/watch?t=83&v=X5oCPvGe-4M",
+		},
+		{
+			name:     "a short URL",
+			videoURL: "https://youtu.be/X5oCPvGe-4M",
+			seconds:  83,
+			want:     "https://youtu.be/X5oCPvGe-4M?t=83",
+		},
+		{
+			name:     "an existing time is replaced",
+			videoURL: "https://youtu.be/X5oCPvGe-4M?t=10",
+			seconds:  83,
+			want:     "https://youtu.be/X5oCPvGe-4M?t=83",
+		},
+		{
+			name:     "no time",
+			videoURL: "https://youtu.be/X5oCPvGe-4M",
+			want:     "https://youtu.be/X5oCPvGe-4M",
+		},
+		{
+			name:    "no video",
+			seconds: 83,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, YouTubeLink(tt.videoURL, tt.seconds))
+		})
+	}
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	assert.Equal(t, "0:00", FormatTimestamp(0))
+	assert.Equal(t, "1:23", FormatTimestamp(83))
+	assert.Equal(t, "1:01:05", FormatTimestamp(3665))
+}
+
+func TestStoryScene_TimeSecondsOf(t *testing.T) {
+	scene := StoryScene{
+		Conversations: []Conversation{
+			{Speaker: "Ross", Quote: "We were on a break!", TimeSeconds: 40},
+			{Speaker: "Joey", Quote: "How you {{ doin' }}?", TimeSeconds: 65},
+			{Speaker: "Rachel", Quote: "Could you be any more annoying?"},
+		},
+	}
+	tests := []struct {
+		name string
+		note Note
+		want int
+	}{
+		{name: "the line the expression is said in", note: Note{Expression: "doin'"}, want: 65},
+		{name: "matched by its definition", note: Note{Expression: "break", Definition: "on a break"}, want: 40},
+		{name: "its own time comes first", note: Note{Expression: "doin'", YouTubeTimeSeconds: 70}, want: 70},
+		{name: "a line without a time", note: Note{Expression: "annoying"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, scene.TimeSecondsOf(tt.note))
+		})
+	}
+}
+
+func TestConvertToAssetsStoryTemplate_VideoLinks(t *testing.T) {
+	got := ConvertToAssetsStoryTemplate([]StoryNotebook{{
+		Event:      "Episode 1",
+		YouTubeURL: "https://youtu.be/abc",
+		Scenes: []StoryScene{{
+			Title: "Central Perk",
+			Conversations: []Conversation{
+				{Speaker: "Joey", Quote: "How you doin'?", TimeSeconds: 65},
+				{Speaker: "Rachel", Quote: "Hi."},
+			},
+			Definitions: []Note{{Expression: "doin'", Meaning: "doing"}},
+		}},
+	}})
+
+	scene := got.Notebooks[0].Scenes[0]
+	assert.Equal(t, "1:05", scene.Conversations[0].Timestamp)
+	assert.Equal(t, "https://youtu.be/abc?t=65", scene.Conversations[0].VideoURL)
+	assert.Empty(t, scene.Conversations[1].VideoURL)
+	assert.Equal(t, "1:05", scene.Definitions[0].Timestamp)
+	assert.Equal(t, "https://youtu.be/abc?t=65", scene.Definitions[0].VideoURL)
+}
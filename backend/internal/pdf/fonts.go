@@ -0,0 +1,91 @@
+package pdf
+
+import (
+	"embed"
+	"fmt"
+	"os"
+
+	"github.com/mandolyte/mdtopdf"
+
+	"github.com/at-ishikawa/langner/internal/config"
+)
+
+//go:embed fonts/*.ttf
+var bundledFonts embed.FS
+
+// fontFamily is the name every styler refers to once the TrueType fonts
+// are registered, so headings, quotes and code all share one script coverage.
+const fontFamily = "langner"
+
+// fontStyles lists the fpdf style of each font and where it comes from.
+var fontStyles = []struct {
+	style   string
+	bundled string
+	path    func(config.PDFConfig) string
+}{
+	{style: "", bundled: "fonts/DejaVuSansCondensed.ttf", path: func(c config.PDFConfig) string { return c.FontPath }},
+	{style: "B", bundled: "fonts/DejaVuSansCondensed-Bold.ttf", path: func(c config.PDFConfig) string { return c.BoldFontPath }},
+	{style: "I", bundled: "fonts/DejaVuSansCondensed-Oblique.ttf", path: func(c config.PDFConfig) string { return c.ItalicFontPath }},
+	{style: "BI", bundled: "fonts/DejaVuSansCondensed-BoldOblique.ttf", path: func(c config.PDFConfig) string { return c.BoldItalicFontPath }},
+}
+
+// loadFonts returns the font bytes of each style. Without a configured
+// FontPath the bundled fonts are used; otherwise styles without their own
+// path reuse FontPath so that every glyph comes from the same typeface.
+func loadFonts(fonts config.PDFConfig) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(fontStyles))
+	if fonts.FontPath == "" {
+		for _, fs := range fontStyles {
+			data, err := bundledFonts.ReadFile(fs.bundled)
+			if err != nil {
+				return nil, fmt.Errorf("read bundled font %s: %w", fs.bundled, err)
+			}
+			result[fs.style] = data
+		}
+		return result, nil
+	}
+
+	regular, err := os.ReadFile(fonts.FontPath)
+	if err != nil {
+		return nil, fmt.Errorf("read font %s: %w", fonts.FontPath, err)
+	}
+	for _, fs := range fontStyles {
+		path := fs.path(fonts)
+		if path == "" || path == fonts.FontPath {
+			result[fs.style] = regular
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read font %s: %w", path, err)
+		}
+		result[fs.style] = data
+	}
+	return result, nil
+}
+
+// useFonts registers fonts with the renderer and points every styler at
+// them. The core PDF fonts mdtopdf defaults to only cover Latin-1.
+func useFonts(r *mdtopdf.PdfRenderer, fonts map[string][]byte) error {
+	// The page-count alias must be set before the fonts are added so that
+	// fpdf keeps every digit in the font subsets for the footer.
+	r.Pdf.AliasNbPages("")
+	for _, fs := range fontStyles {
+		r.Pdf.AddUTF8FontFromBytes(fontFamily, fs.style, fonts[fs.style])
+	}
+	if err := r.Pdf.Error(); err != nil {
+		return fmt.Errorf("register fonts: %w", err)
+	}
+
+	for _, s := range []*mdtopdf.Styler{
+		&r.Normal, &r.Link, &r.Backtick, &r.Code,
+		&r.H1, &r.H2, &r.H3, &r.H4, &r.H5, &r.H6,
+		&r.Blockquote, &r.THeader, &r.TBody,
+	} {
+		s.Font = fontFamily
+	}
+	// The root paragraph state copied Normal before the fonts changed.
+	r.UpdateParagraphStyler(r.Normal)
+	r.Pdf.SetFont(fontFamily, r.Normal.Style, r.Normal.Size)
+	return nil
+}
@@ -0,0 +1,68 @@
+package pdf
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/mandolyte/mdtopdf"
+)
+
+const (
+	// headerTop is where the title line is drawn and contentTop where the
+	// body starts below it, in points.
+	headerTop  = 20.0
+	contentTop = 48.0
+	footerY    = -30.0
+	decorSize  = 9.0
+)
+
+// markdownTitle returns the first "# " heading, falling back to the file
+// name without its extension.
+func markdownTitle(content []byte, markdownPath string) string {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if heading, ok := strings.CutPrefix(scanner.Text(), "# "); ok {
+			if heading = strings.TrimSpace(heading); heading != "" {
+				return heading
+			}
+		}
+	}
+	return strings.TrimSuffix(filepath.Base(markdownPath), filepath.Ext(markdownPath))
+}
+
+// addPageDecorations prints title above a rule at the top of every page and
+// "Page N of M" at the bottom; useFonts has registered the page-count
+// alias. The renderer has already added the first
+// page, so its header is drawn here directly.
+func addPageDecorations(r *mdtopdf.PdfRenderer, title string) {
+	pdf := r.Pdf
+	pdf.SetTopMargin(contentTop)
+
+	header := func() {
+		r.SetPageBackground("", r.BackgroundColor)
+		left, _, right, _ := pdf.GetMargins()
+		width, _ := pdf.GetPageSize()
+		pdf.SetFont(fontFamily, "", decorSize)
+		pdf.SetTextColor(110, 110, 110)
+		pdf.SetDrawColor(190, 190, 190)
+		pdf.SetXY(left, headerTop)
+		pdf.CellFormat(width-left-right, decorSize+4, title, "B", 0, "L", false, 0, "")
+	}
+	pdf.SetHeaderFuncMode(header, true)
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(footerY)
+		pdf.SetFont(fontFamily, "", decorSize)
+		pdf.SetTextColor(110, 110, 110)
+		pdf.CellFormat(0, decorSize+4, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	header()
+	left, _, _, _ := pdf.GetMargins()
+	pdf.SetXY(left, contentTop)
+	pdf.SetFont(fontFamily, r.Normal.Style, r.Normal.Size)
+	pdf.SetTextColor(r.Normal.TextColor.Red, r.Normal.TextColor.Green, r.Normal.TextColor.Blue)
+	pdf.SetDrawColor(0, 0, 0)
+}
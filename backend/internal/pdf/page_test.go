@@ -0,0 +1,31 @@
+package pdf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownTitle(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "first top-level heading",
+			content: "intro\n\n## Session\n\n# Greek roots — λόγος\n\n# Later\n",
+			want:    "Greek roots — λόγος",
+		},
+		{
+			name:    "file name without a heading",
+			content: "## Episode 1\n\ntext\n",
+			want:    "friends-s01",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, markdownTitle([]byte(tt.content), "/tmp/out/friends-s01.md"))
+		})
+	}
+}
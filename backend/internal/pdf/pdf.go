@@ -10,14 +10,24 @@ import (
 	"strings"
 
 	"github.com/mandolyte/mdtopdf"
+
+	"github.com/at-ishikawa/langner/internal/config"
 )
 
 // boldPattern matches **bold** text in markdown
 var boldPattern = regexp.MustCompile(`\*\*([^*]+)\*\*`)
 
+// Options customizes a PDF export.
+type Options struct {
+	// Title is printed in the header of every page. When empty, the first
+	// "# " heading of the markdown is used, and then the file name.
+	Title string
+	Fonts config.PDFConfig
+}
+
 // ConvertMarkdownToPDF converts a markdown file to PDF using mdtopdf package
 // The PDF file will be created in the same directory as the markdown file
-func ConvertMarkdownToPDF(markdownPath string) (string, error) {
+func ConvertMarkdownToPDF(markdownPath string, opts Options) (string, error) {
 	if !strings.HasSuffix(markdownPath, ".md") {
 		return "", fmt.Errorf("input file must have .md extension: %s", markdownPath)
 	}
@@ -27,8 +37,14 @@ func ConvertMarkdownToPDF(markdownPath string) (string, error) {
 		return "", fmt.Errorf("os.ReadFile(%s) > %w", markdownPath, err)
 	}
 
-	// Preprocess: normalize smart punctuation to ASCII (default PDF fonts don't support Unicode)
-	content = normalizeSmartPunctuation(content)
+	fonts, err := loadFonts(opts.Fonts)
+	if err != nil {
+		return "", err
+	}
+	title := opts.Title
+	if title == "" {
+		title = markdownTitle(content, markdownPath)
+	}
 
 	// Preprocess: remove bold markers in blockquotes (mdtopdf doesn't handle them well)
 	content = convertBoldToItalicInBlockquotes(content)
@@ -58,6 +74,10 @@ func ConvertMarkdownToPDF(markdownPath string) (string, error) {
 
 	renderer := mdtopdf.NewPdfRenderer("P", "A4", tmpPath, "", nil, mdtopdf.LIGHT)
 	renderer.UpdateBlockquoteStyler()
+	if err := useFonts(renderer, fonts); err != nil {
+		return "", err
+	}
+	addPageDecorations(renderer, title)
 	if err := renderer.Process(content); err != nil {
 		return "", fmt.Errorf("renderer.Process() > %w", err)
 	}
@@ -78,21 +98,6 @@ func ConvertMarkdownToPDF(markdownPath string) (string, error) {
 	return absPath, nil
 }
 
-// normalizeSmartPunctuation replaces Unicode smart quotes/punctuation with ASCII equivalents.
-// The default PDF fonts (Latin-1) don't support these characters, causing garbled output.
-func normalizeSmartPunctuation(content []byte) []byte {
-	replacer := strings.NewReplacer(
-		"\u2018", "'", // LEFT SINGLE QUOTATION MARK
-		"\u2019", "'", // RIGHT SINGLE QUOTATION MARK
-		"\u201C", "\"", // LEFT DOUBLE QUOTATION MARK
-		"\u201D", "\"", // RIGHT DOUBLE QUOTATION MARK
-		"\u2013", "-", // EN DASH
-		"\u2014", "--", // EM DASH
-		"\u2026", "...", // HORIZONTAL ELLIPSIS
-	)
-	return []byte(replacer.Replace(string(content)))
-}
-
 // imageURLPattern matches markdown image URLs: ![alt](https://...)
 var imageURLPattern = regexp.MustCompile(`!\[([^\]]*)\]\((https?://[^)]+)\)`)
 
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/at-ishikawa/langner/internal/config"
 	"github.com/at-ishikawa/langner/internal/pdf"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,7 @@ func TestConvertMarkdownToPDF(t *testing.T) {
 	tests := []struct {
 		name          string
 		markdownPath  string
+		opts          pdf.Options
 		setupFile     func(t *testing.T) string
 		wantErr       bool
 		wantErrMsg    string
@@ -62,6 +64,41 @@ func TestConvertMarkdownToPDF(t *testing.T) {
 				assert.True(t, filepath.Ext(pdfPath) == ".pdf", "PDF file should have .pdf extension")
 			},
 		},
+		{
+			name: "unicode text uses the bundled font",
+			setupFile: func(t *testing.T) string {
+				return writeMarkdown(t, "# pathos\n\n/ˈpeɪθɒs/ — from Greek πάθος “suffering”; French *naïveté*, **Ångström**.\n\n> Latin cōgitō\n")
+			},
+			validateAfter: func(t *testing.T, pdfPath string) {
+				content, err := os.ReadFile(pdfPath)
+				require.NoError(t, err)
+				assert.Contains(t, string(content), "/FontFile2")
+				assert.Contains(t, string(content), "/BaseFont /utf8langnerBI")
+			},
+		},
+		{
+			name: "configured font path",
+			opts: pdf.Options{Title: "Greek roots", Fonts: config.PDFConfig{FontPath: filepath.Join("fonts", "DejaVuSansCondensed-Oblique.ttf")}},
+			setupFile: func(t *testing.T) string {
+				return writeMarkdown(t, "Plain **bold** text: λόγος\n")
+			},
+			validateAfter: func(t *testing.T, pdfPath string) {
+				content, err := os.ReadFile(pdfPath)
+				require.NoError(t, err)
+				// Every style falls back to the configured oblique face.
+				assert.Regexp(t, `/FontName /utf8langner\s[^>]*/ItalicAngle -11`, string(content))
+				assert.Regexp(t, `/FontName /utf8langnerB\s[^>]*/ItalicAngle -11`, string(content))
+			},
+		},
+		{
+			name: "missing font file",
+			opts: pdf.Options{Fonts: config.PDFConfig{FontPath: "no-such-font.ttf"}},
+			setupFile: func(t *testing.T) string {
+				return writeMarkdown(t, "# Test\n")
+			},
+			wantErr:    true,
+			wantErrMsg: "read font no-such-font.ttf",
+		},
 	}
 
 	for _, tt := range tests {
@@ -73,7 +110,7 @@ func TestConvertMarkdownToPDF(t *testing.T) {
 				mdPath = tt.markdownPath
 			}
 
-			pdfPath, err := pdf.ConvertMarkdownToPDF(mdPath)
+			pdfPath, err := pdf.ConvertMarkdownToPDF(mdPath, tt.opts)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -94,3 +131,10 @@ func TestConvertMarkdownToPDF(t *testing.T) {
 		})
 	}
 }
+
+func writeMarkdown(t *testing.T, content string) string {
+	t.Helper()
+	mdPath := filepath.Join(t.TempDir(), "test.md")
+	require.NoError(t, os.WriteFile(mdPath, []byte(content), 0644))
+	return mdPath
+}
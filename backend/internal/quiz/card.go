@@ -73,6 +73,9 @@ type NotebookSummary struct {
 	EtymologyReviewCount        int
 	EtymologyReverseReviewCount int
 	GrammarReviewCount          int
+	// FrequentReviewCount is how many of the ReviewCount words are very
+	// common or common (see frequency.Band.Frequent).
+	FrequentReviewCount int
 	// VocabularyCount is the STRUCTURAL number of vocabulary entries the
 	// notebook has (definitions in a definitions book, scene definitions
 	// across a story/journal, or flashcard cards) — how many words COULD be
@@ -110,6 +113,11 @@ type GradeResult struct {
 	Reason         string
 	Quality        int
 	Classification string // inference classification (e.g., "same_word", "synonym", "wrong")
+	// Answer is what the learner answered and Grader who graded it
+	// (notebook.GraderModel or GraderDeterministic); both are recorded
+	// with Reason on the learning log the result is saved as.
+	Answer string
+	Grader string
 }
 
 // NotFoundError is returned when a requested notebook does not exist.
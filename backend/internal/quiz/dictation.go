@@ -0,0 +1,513 @@
+package quiz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/tts"
+)
+
+// DictationCard is one conversation line of a story scene to play and have
+// typed back, chosen for a word the line teaches. Line is the reference the
+// answer is graded against, so it is never shown before the answer.
+type DictationCard struct {
+	ID            string // stable source-entry identity of the word (Note.ID); "" for legacy
+	NotebookName  string
+	StoryTitle    string
+	SceneTitle    string
+	Speaker       string
+	Line          string
+	Expression    string // the word as spoken in the line (Note.Expression)
+	Entry         string // the word's canonical form (Note.Definition if set, else Note.Expression)
+	OriginalEntry string // Note.Expression when it differs from Entry
+	Meaning       string
+	// Audio is the line's audio relative to the notebook directory: the
+	// recording attached to the conversation, else a synthesized one.
+	// AudioFile is the same file on disk.
+	Audio     string
+	AudioFile string
+}
+
+// WordCount returns how many words the line has, a hint shown while
+// listening.
+func (c DictationCard) WordCount() int {
+	return len(dictationWords(c.Line))
+}
+
+// dictationLinesDirectory is where synthesized lines are cached, within the
+// audio directory of a notebook.
+const dictationLinesDirectory = "lines"
+
+// LoadDictationCards loads one line per due word of the given story
+// notebooks, or of every story notebook when notebookIDs is empty. A word is
+// due when its dictation series is due, or — before it has one — once it
+// has been answered correctly in another quiz, unless includeUnstudied
+// also lets in words never answered. Lines without a recording are
+// synthesized when a synthesizer is set, and lines that end up without
+// audio are left out. Returns *NotFoundError if any notebook ID does not
+// exist.
+func (s *Service) LoadDictationCards(ctx context.Context, notebookIDs []string, includeUnstudied bool, sectionTitlesByID map[string][]string) ([]DictationCard, error) {
+	reader, err := s.newReader()
+	if err != nil {
+		return nil, fmt.Errorf("newReader() > %w", err)
+	}
+	learningHistories, err := s.loadLearningHistories()
+	if err != nil {
+		return nil, fmt.Errorf("s.loadLearningHistories() > %w", err)
+	}
+
+	storyIndexes := reader.GetStoryIndexes()
+	if len(notebookIDs) == 0 {
+		for id := range storyIndexes {
+			notebookIDs = append(notebookIDs, id)
+		}
+		sort.Strings(notebookIDs)
+	}
+
+	audio := s.newDictationAudio(reader)
+	now := time.Now()
+	cards := make([]DictationCard, 0)
+	for _, notebookID := range notebookIDs {
+		// Only stories have conversations to listen to; other notebooks
+		// simply have no lines.
+		if _, ok := storyIndexes[notebookID]; !ok {
+			if _, ok := reader.NotebookDirectory(notebookID); ok {
+				continue
+			}
+			if _, ok := reader.GetDefinitionsNotes(notebookID); ok {
+				continue
+			}
+			return nil, &NotFoundError{NotebookID: notebookID}
+		}
+		candidates, err := dictationCandidates(reader, notebookID, sectionTitlesByID[notebookID])
+		if err != nil {
+			return nil, err
+		}
+		for _, card := range candidates {
+			if !seriesDue(learningHistories[notebookID], card.StoryTitle, card.SceneTitle, card.ID, card.Entry, card.OriginalEntry, notebook.QuizTypeDictation, includeUnstudied, now) {
+				continue
+			}
+			if !audio.resolve(ctx, &card) {
+				continue
+			}
+			cards = append(cards, card)
+		}
+	}
+	if !s.disableShuffle {
+		rand.Shuffle(len(cards), func(i, j int) {
+			cards[i], cards[j] = cards[j], cards[i]
+		})
+	}
+	return cards, nil
+}
+
+// dictationCandidates returns, for every word of a story notebook, the
+// first conversation line it is spoken in.
+func dictationCandidates(reader *notebook.Reader, notebookID string, sectionFilter []string) ([]DictationCard, error) {
+	stories, err := reader.ReadStoryNotebooks(notebookID)
+	if err != nil {
+		return nil, fmt.Errorf("ReadStoryNotebooks(%s) > %w", notebookID, err)
+	}
+
+	var cards []DictationCard
+	for _, story := range stories {
+		if !inSectionFilter(sectionFilter, story.Event) {
+			continue
+		}
+		for _, scene := range story.Scenes {
+			for _, definition := range scene.Definitions {
+				conv, ok := dictationConversation(scene, definition)
+				if !ok {
+					continue
+				}
+				entry := definition.Definition
+				originalEntry := ""
+				if entry == "" {
+					entry = definition.Expression
+				} else {
+					originalEntry = definition.Expression
+				}
+				cards = append(cards, DictationCard{
+					ID:            definition.ID,
+					NotebookName:  notebookID,
+					StoryTitle:    story.Event,
+					SceneTitle:    scene.Title,
+					Speaker:       conv.Speaker,
+					Line:          notebook.ConvertMarkersInText(conv.Quote, nil, notebook.ConversionStylePlain, ""),
+					Expression:    definition.Expression,
+					Entry:         entry,
+					OriginalEntry: originalEntry,
+					Meaning:       definition.Meaning,
+					Audio:         conv.Audio,
+				})
+			}
+		}
+	}
+	return cards, nil
+}
+
+// dictationConversation returns the first spoken line of the scene the
+// definition appears in.
+func dictationConversation(scene notebook.StoryScene, definition notebook.Note) (notebook.Conversation, bool) {
+	for _, conv := range scene.Conversations {
+		if strings.TrimSpace(conv.Quote) == "" {
+			continue
+		}
+		if containsExpression(strings.ToLower(conv.Quote), definition.Expression, definition.Definition) {
+			return conv, true
+		}
+	}
+	return notebook.Conversation{}, false
+}
+
+// seriesDue reports whether a story word is due for quizType, a quiz
+// practising words already learned. Once the word has history in quizType
+// its own SR interval decides; before that a word answered correctly in
+// another quiz is due, and any other word only with includeUnstudied.
+func seriesDue(histories []notebook.LearningHistory, storyTitle, sceneTitle, id, entry, originalEntry string, quizType notebook.QuizType, includeUnstudied bool, now time.Time) bool {
+	expr := findStoryExpression(histories, storyTitle, sceneTitle, id, entry, originalEntry)
+	if expr == nil {
+		return includeUnstudied
+	}
+	if expr.SkippedAt.IsSkipped(quizType) {
+		return false
+	}
+	if dueAt, ok := expr.ReviewDueAt(quizType); ok {
+		return !now.Before(dueAt)
+	}
+	return includeUnstudied || expr.HasAnyCorrectAnswer()
+}
+
+// findStoryExpression returns the learning history of a story word, matched
+// like the reading quizzes match it (see notebook.MatchesEntry).
+func findStoryExpression(histories []notebook.LearningHistory, storyTitle, sceneTitle, id, expression, originalExpression string) *notebook.LearningHistoryExpression {
+	for hi := range histories {
+		h := &histories[hi]
+		if h.Metadata.Title != storyTitle {
+			continue
+		}
+		for si := range h.Scenes {
+			if h.Scenes[si].Metadata.Title != sceneTitle {
+				continue
+			}
+			for ei := range h.Scenes[si].Expressions {
+				if notebook.MatchesEntry(&h.Scenes[si].Expressions[ei], id, expression, originalExpression) {
+					return &h.Scenes[si].Expressions[ei]
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// dictationAudio finds or synthesizes the audio of dictation lines.
+type dictationAudio struct {
+	reader *notebook.Reader
+	cache  *tts.Cache
+	// failed stops synthesizing after the first failure, so a missing
+	// text-to-speech program is reported once instead of once per line.
+	failed bool
+}
+
+func (s *Service) newDictationAudio(reader *notebook.Reader) *dictationAudio {
+	audio := &dictationAudio{reader: reader}
+	if s.synthesizer != nil {
+		audio.cache = tts.NewCache(s.synthesizer)
+	}
+	return audio
+}
+
+// resolve sets the audio of card, synthesizing the line when it has no
+// recording, and reports whether the card has audio to play.
+func (a *dictationAudio) resolve(ctx context.Context, card *DictationCard) bool {
+	dir, ok := a.reader.NotebookDirectory(card.NotebookName)
+	if !ok {
+		return false
+	}
+	if card.Audio != "" {
+		file, err := notebook.ResolveAudioPath(dir, card.Audio)
+		if err != nil {
+			slog.Warn("skipping dictation line with invalid audio", "notebook", card.NotebookName, "audio", card.Audio, "error", err)
+			return false
+		}
+		if _, err := os.Stat(file); err != nil {
+			slog.Warn("skipping dictation line with missing audio", "notebook", card.NotebookName, "audio", card.Audio, "error", err)
+			return false
+		}
+		card.AudioFile = file
+		return true
+	}
+	if a.cache == nil || a.failed {
+		return false
+	}
+	audio, _, err := a.cache.Audio(ctx, dir, dictationAudioKey(card.Line), card.Line)
+	if err != nil {
+		slog.Warn("cannot synthesize dictation lines; only lines with a recording are quizzed", "error", err)
+		a.failed = true
+		return false
+	}
+	file, err := notebook.ResolveAudioPath(dir, audio)
+	if err != nil {
+		return false
+	}
+	card.Audio = audio
+	card.AudioFile = file
+	return true
+}
+
+// dictationAudioKey is the cache key of a synthesized line: the same line
+// is synthesized once however many words it teaches.
+func dictationAudioKey(line string) string {
+	sum := sha256.Sum256([]byte(strings.Join(strings.Fields(line), " ")))
+	return dictationLinesDirectory + "/" + hex.EncodeToString(sum[:8])
+}
+
+// dictationTargetWeight is how much more a word of the target expression
+// counts than any other word of the line when grading.
+const dictationTargetWeight = 3
+
+// dictationPassScore is the weighted share of the line that must be heard
+// right for an answer to count as correct.
+const dictationPassScore = 0.8
+
+// dictationWords splits text into lowercase words, dropping punctuation but
+// keeping apostrophes within words ("don't").
+func dictationWords(text string) []string {
+	text = strings.NewReplacer("’", "'", "‘", "'").Replace(strings.ToLower(text))
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+	out := words[:0]
+	for _, w := range words {
+		if w = strings.Trim(w, "'"); w != "" {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+// targetSpan returns the index range of the first occurrence of any of
+// targets, as words, within words.
+func targetSpan(words []string, targets ...string) (start, end int, ok bool) {
+	for _, target := range targets {
+		tw := dictationWords(target)
+		if len(tw) == 0 {
+			continue
+		}
+		for i := 0; i+len(tw) <= len(words); i++ {
+			match := true
+			for j := range tw {
+				if words[i+j] != tw[j] {
+					match = false
+					break
+				}
+			}
+			if match {
+				return i, i + len(tw), true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// GradeDictation grades a typed line against the line that was played, by
+// word-level edit distance. Each word of the line costs its weight when it
+// is missed or misheard, and each extra word costs one; words of the target
+// expression weigh dictationTargetWeight, so mishearing the word being
+// learned costs more than a dropped article. The answer is correct when
+// every target word was heard and the weighted score reaches
+// dictationPassScore; the quality grows with the score.
+func GradeDictation(card DictationCard, answer string) GradeResult {
+	ref := dictationWords(card.Line)
+	hyp := dictationWords(answer)
+	if len(ref) == 0 {
+		return GradeResult{Correct: false, Reason: "The line has no words to compare.", Quality: int(notebook.QualityWrong), Answer: answer, Grader: notebook.GraderDeterministic}
+	}
+	if len(hyp) == 0 {
+		return GradeResult{Correct: false, Reason: "No answer provided.", Quality: int(notebook.QualityWrong), Answer: answer, Grader: notebook.GraderDeterministic}
+	}
+
+	weights := make([]int, len(ref))
+	for i := range weights {
+		weights[i] = 1
+	}
+	start, end, hasTarget := targetSpan(ref, card.Expression, card.Entry)
+	if hasTarget {
+		for i := start; i < end; i++ {
+			weights[i] = dictationTargetWeight
+		}
+	}
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+
+	// d[i][j] is the cost of turning the first i reference words into the
+	// first j typed words.
+	d := make([][]int, len(ref)+1)
+	for i := range d {
+		d[i] = make([]int, len(hyp)+1)
+	}
+	for i := 1; i <= len(ref); i++ {
+		d[i][0] = d[i-1][0] + weights[i-1]
+	}
+	for j := 1; j <= len(hyp); j++ {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ref); i++ {
+		for j := 1; j <= len(hyp); j++ {
+			substitute := d[i-1][j-1]
+			if ref[i-1] != hyp[j-1] {
+				substitute += weights[i-1]
+			}
+			d[i][j] = min(substitute, d[i-1][j]+weights[i-1], d[i][j-1]+1)
+		}
+	}
+
+	// Walk the cheapest alignment back to find the reference words that
+	// were missed or misheard.
+	missed := make([]bool, len(ref))
+	for i, j := len(ref), len(hyp); i > 0 || j > 0; {
+		switch {
+		case i > 0 && j > 0 && ref[i-1] == hyp[j-1] && d[i][j] == d[i-1][j-1]:
+			i, j = i-1, j-1
+		case i > 0 && j > 0 && d[i][j] == d[i-1][j-1]+weights[i-1]:
+			missed[i-1] = true
+			i, j = i-1, j-1
+		case i > 0 && d[i][j] == d[i-1][j]+weights[i-1]:
+			missed[i-1] = true
+			i--
+		default:
+			j--
+		}
+	}
+
+	score := 1 - float64(d[len(ref)][len(hyp)])/float64(total)
+	var missedWords []string
+	targetHeard := true
+	for i, m := range missed {
+		if !m {
+			continue
+		}
+		missedWords = append(missedWords, ref[i])
+		if hasTarget && i >= start && i < end {
+			targetHeard = false
+		}
+	}
+
+	result := GradeResult{Correct: targetHeard && score >= dictationPassScore, Answer: answer, Grader: notebook.GraderDeterministic}
+	switch {
+	case !result.Correct:
+		result.Quality = int(notebook.QualityWrong)
+	case d[len(ref)][len(hyp)] == 0:
+		result.Quality = int(notebook.QualityCorrectFast)
+	case score >= 0.9:
+		result.Quality = int(notebook.QualityCorrect)
+	default:
+		result.Quality = int(notebook.QualityCorrectSlow)
+	}
+
+	switch {
+	case d[len(ref)][len(hyp)] == 0:
+		result.Reason = "Every word heard."
+	case !targetHeard:
+		result.Reason = fmt.Sprintf("Misheard the target %q.", strings.Join(ref[start:end], " "))
+	case len(missedWords) > 0:
+		result.Reason = fmt.Sprintf("Missed: %s.", strings.Join(missedWords, ", "))
+	default:
+		result.Reason = "Heard every word, with extra words typed."
+	}
+	return result
+}
+
+// SaveDictationResult records a dictation answer in the dictation series of
+// the word the line was chosen for.
+func (s *Service) SaveDictationResult(ctx context.Context, card DictationCard, result GradeResult, responseTimeMs int64) error {
+	status := "misunderstood"
+	if result.Correct {
+		status = "understood"
+	}
+	log := &learning.LearningLog{
+		Status: status, LearnedAt: time.Now(), Quality: result.Quality,
+		ResponseTimeMs: int(responseTimeMs), QuizType: string(notebook.QuizTypeDictation),
+		SourceNotebookID: card.NotebookName, NotebookName: card.NotebookName,
+		StoryTitle: card.StoryTitle, SceneTitle: card.SceneTitle,
+		Expression: card.Entry, OriginalExpression: card.OriginalEntry, SenseID: card.ID,
+		Answer: result.Answer, GraderReason: result.Reason, Grader: result.Grader,
+		IsCorrect: result.Correct, LearningNotesDir: s.notebooksConfig.LearningNotesDirectory,
+	}
+	if err := s.learningRepository.Create(ctx, log); err != nil {
+		return fmt.Errorf("save dictation learning log for %q: %w", card.NotebookName, err)
+	}
+	return nil
+}
+
+// CardInfoFromDictationCard returns the CardInfo that addresses the word a
+// dictation card was chosen for, so Override and Skip reach its series.
+func CardInfoFromDictationCard(card DictationCard) CardInfo {
+	return CardInfo{
+		NotebookName:       card.NotebookName,
+		StoryTitle:         card.StoryTitle,
+		SceneTitle:         card.SceneTitle,
+		Expression:         card.Entry,
+		OriginalExpression: card.OriginalEntry,
+		ID:                 card.ID,
+	}
+}
+
+// relearnDictationIndex indexes the dictation line of every story word, so
+// a dictation miss in the Relearn pool can be replayed.
+type relearnDictationIndex struct {
+	byID           map[string]DictationCard
+	byNotebookExpr map[string]DictationCard
+}
+
+func buildRelearnDictationIndex(reader *notebook.Reader) (*relearnDictationIndex, error) {
+	index := &relearnDictationIndex{
+		byID:           make(map[string]DictationCard),
+		byNotebookExpr: make(map[string]DictationCard),
+	}
+	for notebookID := range reader.GetStoryIndexes() {
+		cards, err := dictationCandidates(reader, notebookID, nil)
+		if err != nil {
+			// Like the summaries, one malformed story must not hide the
+			// lines of every other one.
+			slog.Warn("skipping story in relearn dictation lines", "notebook", notebookID, "error", err)
+			continue
+		}
+		for _, card := range cards {
+			if card.ID != "" {
+				index.byID[card.ID] = card
+			}
+			for _, e := range []string{card.Entry, card.OriginalEntry} {
+				if e = strings.ToLower(strings.TrimSpace(e)); e != "" {
+					index.byNotebookExpr[notebookID+relearnKeySep+e] = card
+				}
+			}
+		}
+	}
+	return index, nil
+}
+
+// resolve returns the line of a dictation candidate, by id first and then
+// by its notebook and expression for legacy id-less entries.
+func (i *relearnDictationIndex) resolve(c relearnCandidate) (DictationCard, bool) {
+	if c.id != "" {
+		if card, ok := i.byID[c.id]; ok {
+			return card, true
+		}
+	}
+	card, ok := i.byNotebookExpr[c.notebookName+relearnKeySep+strings.ToLower(strings.TrimSpace(c.expression))]
+	return card, ok
+}
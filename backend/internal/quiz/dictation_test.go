@@ -0,0 +1,306 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+func TestGradeDictation(t *testing.T) {
+	card := DictationCard{
+		Line:       "I can't put up with this noise anymore.",
+		Expression: "put up with",
+		Entry:      "put up with",
+	}
+	tests := []struct {
+		name        string
+		answer      string
+		wantCorrect bool
+		wantQuality notebook.Quality
+		wantReason  string
+	}{
+		{
+			name:        "every word heard, ignoring case and punctuation",
+			answer:      "i can’t put up with this noise anymore",
+			wantCorrect: true,
+			wantQuality: notebook.QualityCorrectFast,
+			wantReason:  "Every word heard.",
+		},
+		{
+			name:        "one plain word missed",
+			answer:      "I can't put up with this noise",
+			wantCorrect: true,
+			wantQuality: notebook.QualityCorrect,
+			wantReason:  "Missed: anymore.",
+		},
+		{
+			name:        "an extra word typed",
+			answer:      "Well, I can't put up with this noise anymore.",
+			wantCorrect: true,
+			wantQuality: notebook.QualityCorrect,
+			wantReason:  "Heard every word, with extra words typed.",
+		},
+		{
+			name:        "a word of the target missed",
+			answer:      "I can't put up this noise anymore.",
+			wantCorrect: false,
+			wantQuality: notebook.QualityWrong,
+			wantReason:  `Misheard the target "put up with".`,
+		},
+		{
+			name:        "too many plain words misheard",
+			answer:      "I can put up with the noise",
+			wantCorrect: false,
+			wantQuality: notebook.QualityWrong,
+			wantReason:  "Missed: can't, this, anymore.",
+		},
+		{
+			name:        "no answer",
+			answer:      "  ",
+			wantCorrect: false,
+			wantQuality: notebook.QualityWrong,
+			wantReason:  "No answer provided.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GradeDictation(card, tt.answer)
+			assert.Equal(t, tt.wantCorrect, got.Correct)
+			assert.Equal(t, int(tt.wantQuality), got.Quality)
+			assert.Equal(t, tt.wantReason, got.Reason)
+		})
+	}
+}
+
+// fakeSynthesizer synthesizes every text into the same bytes and counts
+// the calls.
+type fakeSynthesizer struct {
+	err   error
+	calls int
+}
+
+func (s *fakeSynthesizer) Synthesize(_ context.Context, text string) ([]byte, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []byte("RIFF " + text), nil
+}
+
+func (s *fakeSynthesizer) Extension() string { return ".wav" }
+
+// newDictationFixture writes a story notebook whose kitchen line has a
+// recording and whose office lines don't, and a learning history in which,
+// relative to now:
+//
+//   - "put up with" was answered correctly in the reading quiz
+//   - "touch base" has never been quizzed
+//   - "reschedule" was heard correctly today with a 30-day interval
+func newDictationFixture(t *testing.T) (storiesDir, learningDir string) {
+	t.Helper()
+	storiesDir = t.TempDir()
+	learningDir = t.TempDir()
+
+	notebookDir := filepath.Join(storiesDir, "drama")
+	require.NoError(t, os.MkdirAll(filepath.Join(notebookDir, "audio"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "index.yml"), []byte(
+		"id: drama\nname: Office Drama\nnotebooks:\n  - ./episode1.yml\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "audio", "kitchen.wav"), []byte("RIFF"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "episode1.yml"), []byte(`- event: "Episode 1"
+  date: 2025-01-15T00:00:00Z
+  scenes:
+    - scene: "Kitchen"
+      conversations:
+        - speaker: "Ann"
+          quote: "Good morning."
+        - speaker: "Bob"
+          quote: "I can't {{ put up with }} this noise anymore."
+          audio: audio/kitchen.wav
+      definitions:
+        - expression: "put up with"
+          meaning: "to tolerate"
+    - scene: "Office"
+      conversations:
+        - speaker: "Ann"
+          quote: "Let's touch base tomorrow."
+        - speaker: "Bob"
+          quote: "We need to reschedule the meeting."
+      definitions:
+        - expression: "touch base"
+          meaning: "to talk briefly"
+        - expression: "reschedule"
+          meaning: "to change the time of"
+`), 0o644))
+
+	now := time.Now()
+	require.NoError(t, os.WriteFile(filepath.Join(learningDir, "drama.yml"), []byte(fmt.Sprintf(`- metadata:
+    notebook_id: drama
+    title: "Episode 1"
+  scenes:
+    - metadata:
+        title: "Kitchen"
+      expressions:
+        - expression: "put up with"
+          learned_logs:
+            - status: understood
+              learned_at: %q
+              interval_days: 7
+    - metadata:
+        title: "Office"
+      expressions:
+        - expression: "reschedule"
+          learned_logs:
+            - status: understood
+              learned_at: %q
+              interval_days: 7
+          dictation_logs:
+            - status: understood
+              learned_at: %q
+              interval_days: 30
+              quiz_type: dictation
+`, now.AddDate(0, 0, -10).Format(time.RFC3339), now.AddDate(0, 0, -10).Format(time.RFC3339), now.Format(time.RFC3339))), 0o644))
+	return storiesDir, learningDir
+}
+
+func newDictationService(storiesDir, learningDir string) *Service {
+	quizCfg := config.QuizConfig{Algorithm: "modified_sm2", FixedIntervals: []int{1, 7, 30, 90, 365}, DisableShuffle: true}
+	calc := notebook.NewIntervalCalculator(quizCfg.Algorithm, quizCfg.FixedIntervals)
+	return NewService(config.NotebooksConfig{
+		StoriesDirectories:     []string{storiesDir},
+		LearningNotesDirectory: learningDir,
+	}, nil, nil, learning.NewYAMLLearningRepository(learningDir, calc), quizCfg)
+}
+
+func TestService_LoadDictationCards(t *testing.T) {
+	tests := []struct {
+		name             string
+		includeUnstudied bool
+		synthesizer      *fakeSynthesizer
+		wantExpressions  []string
+		wantSynthesized  int
+	}{
+		{
+			name:            "studied words with a recording",
+			wantExpressions: []string{"put up with"},
+		},
+		{
+			name:             "unstudied words need a synthesizer",
+			includeUnstudied: true,
+			wantExpressions:  []string{"put up with"},
+		},
+		{
+			name:             "unstudied words are synthesized",
+			includeUnstudied: true,
+			synthesizer:      &fakeSynthesizer{},
+			wantExpressions:  []string{"put up with", "touch base"},
+			wantSynthesized:  1,
+		},
+		{
+			name:             "a failing synthesizer leaves only recorded lines",
+			includeUnstudied: true,
+			synthesizer:      &fakeSynthesizer{err: errors.New("espeak-ng not found")},
+			wantExpressions:  []string{"put up with"},
+			wantSynthesized:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storiesDir, learningDir := newDictationFixture(t)
+			svc := newDictationService(storiesDir, learningDir)
+			if tt.synthesizer != nil {
+				svc.SetSynthesizer(tt.synthesizer)
+			}
+
+			cards, err := svc.LoadDictationCards(context.Background(), nil, tt.includeUnstudied, nil)
+			require.NoError(t, err)
+			var expressions []string
+			for _, card := range cards {
+				expressions = append(expressions, card.Expression)
+				assert.FileExists(t, card.AudioFile)
+			}
+			assert.Equal(t, tt.wantExpressions, expressions)
+			if tt.synthesizer != nil {
+				assert.Equal(t, tt.wantSynthesized, tt.synthesizer.calls)
+			}
+
+			kitchen := cards[0]
+			assert.Equal(t, "Bob", kitchen.Speaker)
+			assert.Equal(t, "I can't put up with this noise anymore.", kitchen.Line)
+			assert.Equal(t, "audio/kitchen.wav", kitchen.Audio)
+			assert.Equal(t, 8, kitchen.WordCount())
+			if len(cards) > 1 {
+				assert.Equal(t, "audio/"+dictationAudioKey("Let's touch base tomorrow.")+".wav", cards[1].Audio)
+			}
+		})
+	}
+}
+
+func TestService_LoadDictationCards_NotFound(t *testing.T) {
+	storiesDir, learningDir := newDictationFixture(t)
+	svc := newDictationService(storiesDir, learningDir)
+
+	_, err := svc.LoadDictationCards(context.Background(), []string{"missing"}, false, nil)
+	var notFound *NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestService_SaveDictationResult(t *testing.T) {
+	ctx := context.Background()
+	storiesDir, learningDir := newDictationFixture(t)
+	svc := newDictationService(storiesDir, learningDir)
+
+	cards, err := svc.LoadDictationCards(ctx, []string{"drama"}, false, nil)
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	card := cards[0]
+
+	result := GradeDictation(card, "I can't put up with this noise anymore")
+	require.NoError(t, svc.SaveDictationResult(ctx, card, result, 1500))
+
+	learnedAt, nextReviewDate := svc.GetLatestLearnedInfo("drama", card.ID, card.Entry, notebook.QuizTypeDictation)
+	assert.Equal(t, time.Now().Format("2006-01-02"), learnedAt)
+	assert.NotEmpty(t, nextReviewDate)
+
+	histories, err := notebook.NewLearningHistories(learningDir)
+	require.NoError(t, err)
+	expr := findStoryExpression(histories["drama"], "Episode 1", "Kitchen", card.ID, card.Entry, card.OriginalEntry)
+	require.NotNil(t, expr)
+	require.Len(t, expr.DictationLogs, 1, "the answer goes to its own series")
+	assert.Len(t, expr.LearnedLogs, 1, "the reading series is untouched")
+
+	cards, err = svc.LoadDictationCards(ctx, []string{"drama"}, false, nil)
+	require.NoError(t, err)
+	assert.Empty(t, cards, "a line heard correctly is not due again today")
+}
+
+func TestLoadRelearnPool_DictationMiss(t *testing.T) {
+	ctx := context.Background()
+	storiesDir, learningDir := newDictationFixture(t)
+	svc := newDictationService(storiesDir, learningDir)
+
+	cards, err := svc.LoadDictationCards(ctx, []string{"drama"}, false, nil)
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	require.NoError(t, svc.SaveDictationResult(ctx, cards[0], GradeDictation(cards[0], "I can't stand this noise"), 1500))
+
+	pool, err := svc.LoadRelearnPool(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, pool, 1)
+	card := pool[0]
+	assert.True(t, card.IsDictation())
+	assert.Equal(t, "put up with", card.Entry)
+	assert.Equal(t, "drama", card.NotebookName)
+	assert.Equal(t, "audio/kitchen.wav", card.Audio)
+	assert.True(t, GradeDictation(card.DictationCard(), "I can't put up with this noise anymore.").Correct)
+}
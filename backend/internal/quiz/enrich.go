@@ -0,0 +1,59 @@
+package quiz
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// sourceEnrichment is a pass writing generated content into the notes of
+// the source notebooks, such as mnemonics or example sentences.
+type sourceEnrichment struct {
+	// enrich edits the content of one file and returns the expressions of
+	// the notes it added content to.
+	enrich func(file string, data []byte) ([]byte, []string, error)
+	// message names the change recording the expressions written.
+	message func(expressions []string) string
+}
+
+// enrichSourceFiles runs e over files and records the files it wrote as
+// one change. Book chapters holding raw prose don't always parse as strict
+// YAML; they carry no entries, so they are skipped like assign-ids does.
+// With dryRun no file is written. When e fails part-way, the files already
+// written are still recorded before the error is returned.
+func (s *Service) enrichSourceFiles(files []string, dryRun bool, e sourceEnrichment) (err error) {
+	var written, expressions []string
+	defer func() {
+		if len(written) == 0 {
+			return
+		}
+		if recordErr := s.recorder.Record(e.message(expressions), written...); recordErr != nil && err == nil {
+			err = fmt.Errorf("failed to record change: %w", recordErr)
+		}
+	}()
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", file, err)
+		}
+		var doc yaml.Node
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			continue
+		}
+
+		out, added, err := e.enrich(file, data)
+		if err != nil {
+			return err
+		}
+		if len(added) == 0 || dryRun {
+			continue
+		}
+		if err := os.WriteFile(file, out, 0o644); err != nil {
+			return fmt.Errorf("write %s: %w", file, err)
+		}
+		written = append(written, file)
+		expressions = append(expressions, added...)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package quiz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/lemma"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// generatedExampleCount is how many sentences are asked for per note; the
+// ones validGeneratedExamples rejects are dropped.
+const generatedExampleCount = 3
+
+// ExampleResult is the examples GenerateExamples wrote to one note.
+type ExampleResult struct {
+	File       string
+	Expression string
+	// Examples is empty on a dry run.
+	Examples notebook.Examples
+}
+
+// GenerateExamples writes LLM-generated example sentences into every
+// flashcard and definitions note that has a meaning but no examples, so
+// reverse quizzes have a sentence to mask. Sentences that don't hold a form
+// of the expression are dropped, and the rest are marked generated so
+// exports can leave them out. Notes with examples are never touched. With
+// dryRun the notes are listed without calling the model or writing any
+// file.
+func (s *Service) GenerateExamples(ctx context.Context, dryRun bool) (results []ExampleResult, err error) {
+	if !dryRun && s.openaiClient == nil {
+		return nil, fmt.Errorf("no inference client configured")
+	}
+
+	var dirs []string
+	dirs = append(dirs, s.notebooksConfig.FlashcardsDirectories...)
+	dirs = append(dirs, s.notebooksConfig.DefinitionsDirectories...)
+	files, err := notebook.CollectYAMLFiles(dirs)
+	if err != nil {
+		return nil, fmt.Errorf("collect source files: %w", err)
+	}
+
+	err = s.enrichSourceFiles(files, dryRun, sourceEnrichment{
+		enrich: func(file string, data []byte) ([]byte, []string, error) {
+			var fileResults []ExampleResult
+			examplesFor := func(note notebook.Note) (notebook.Examples, error) {
+				// A dictionary-backed note takes its meaning, and any
+				// examples, from the dictionary.
+				_ = note.SetDetails(s.dictionaryMap, "")
+				meaning := strings.TrimSpace(note.Meaning)
+				if meaning == "" || len(note.Examples) > 0 {
+					return nil, nil
+				}
+				if dryRun {
+					fileResults = append(fileResults, ExampleResult{File: file, Expression: note.Expression})
+					return notebook.Examples{{Text: "-"}}, nil
+				}
+				response, err := s.openaiClient.GenerateExamples(ctx, inference.GenerateExamplesRequest{
+					Expression:   note.Expression,
+					Meaning:      meaning,
+					PartOfSpeech: note.PartOfSpeech,
+					Count:        generatedExampleCount,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("generate examples for %q: %w", note.Expression, err)
+				}
+				examples := validGeneratedExamples(note, response.Examples)
+				if len(examples) > 0 {
+					fileResults = append(fileResults, ExampleResult{File: file, Expression: note.Expression, Examples: examples})
+				}
+				return examples, nil
+			}
+			out, _, err := notebook.AddExamplesToSourceYAML(data, examplesFor)
+			if err != nil {
+				return nil, nil, fmt.Errorf("add examples in %s: %w", file, err)
+			}
+			results = append(results, fileResults...)
+			expressions := make([]string, len(fileResults))
+			for i, result := range fileResults {
+				expressions[i] = result.Expression
+			}
+			return out, expressions, nil
+		},
+		message: func(expressions []string) string {
+			if len(expressions) == 1 {
+				return fmt.Sprintf("Generate examples for %q", expressions[0])
+			}
+			return fmt.Sprintf("Generate examples for %d note(s)", len(expressions))
+		},
+	})
+	return results, err
+}
+
+// validGeneratedExamples keeps the distinct sentences whose highlight occurs
+// in the text as a whole word, the same match reverse quizzes mask, and is a
+// form of the note's expression or dictionary form, and marks them
+// generated. A highlight is a form of a word when a word of each shares a
+// lemma, so "went" stands for "go" and "broke the ice" for "break the ice".
+func validGeneratedExamples(note notebook.Note, generated []inference.GeneratedExample) notebook.Examples {
+	forms := wordLemmas(note.Expression + " " + note.Definition)
+	var out notebook.Examples
+	seen := make(map[string]bool)
+	for _, g := range generated {
+		text := strings.Join(strings.Fields(g.Text), " ")
+		highlight := strings.TrimSpace(g.Highlight)
+		if text == "" || highlight == "" || seen[text] || maskOccurrences(text, highlight) == text {
+			continue
+		}
+		if !sharesLemma(wordLemmas(highlight), forms) {
+			continue
+		}
+		seen[text] = true
+		out = append(out, notebook.Example{Text: text, Highlight: highlight, Generated: true})
+		if len(out) == generatedExampleCount {
+			break
+		}
+	}
+	return out
+}
+
+// wordLemmas returns the lemmas of every word of text.
+func wordLemmas(text string) map[string]bool {
+	lemmas := make(map[string]bool)
+	for _, word := range strings.Fields(text) {
+		for _, l := range lemma.Lemmas(strings.Trim(word, ".,!?;:\"")) {
+			lemmas[l] = true
+		}
+	}
+	return lemmas
+}
+
+func sharesLemma(a, b map[string]bool) bool {
+	for l := range a {
+		if b[l] {
+			return true
+		}
+	}
+	return false
+}
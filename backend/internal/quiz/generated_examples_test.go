@@ -0,0 +1,185 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+	"github.com/at-ishikawa/langner/internal/inference"
+	mock_inference "github.com/at-ishikawa/langner/internal/mocks/inference"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+func TestService_GenerateExamples(t *testing.T) {
+	const cards = `- title: "Flashcards"
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: go
+      meaning: to move or travel
+      part_of_speech: verb
+    - expression: candid
+      meaning: truthful and straightforward
+      examples:
+        - He was candid.
+    - expression: ephemeral
+    - expression: sanguine
+      dictionary_number: 1
+`
+
+	newService := func(t *testing.T, client inference.Client) (*Service, string) {
+		flashcardsDir := t.TempDir()
+		notebookDir := filepath.Join(flashcardsDir, "vocab")
+		require.NoError(t, os.MkdirAll(notebookDir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "index.yml"), []byte(
+			"id: vocab\nname: \"Vocabulary\"\nnotebooks:\n  - ./cards.yml\n"), 0644))
+		cardsPath := filepath.Join(notebookDir, "cards.yml")
+		require.NoError(t, os.WriteFile(cardsPath, []byte(cards), 0644))
+		svc := NewService(config.NotebooksConfig{
+			FlashcardsDirectories: []string{flashcardsDir},
+		}, client, make(map[string]rapidapi.Response), nil, config.QuizConfig{})
+		return svc, cardsPath
+	}
+
+	t.Run("writes validated examples to notes without any", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mock_inference.NewMockClient(ctrl)
+		client.EXPECT().GenerateExamples(gomock.Any(), inference.GenerateExamplesRequest{
+			Expression:   "go",
+			Meaning:      "to move or travel",
+			PartOfSpeech: "verb",
+			Count:        3,
+		}).Return(inference.GenerateExamplesResponse{Examples: []inference.GeneratedExample{
+			{Text: "She went home early.", Highlight: "went"},
+			{Text: "They are going nowhere.", Highlight: "go"},
+			{Text: "We go to school by bus.", Highlight: "go"},
+			{Text: "She left early.", Highlight: "left"},
+		}}, nil)
+		svc, cardsPath := newService(t, client)
+
+		results, err := svc.GenerateExamples(context.Background(), false)
+		require.NoError(t, err)
+		assert.Equal(t, []ExampleResult{{File: cardsPath, Expression: "go", Examples: notebook.Examples{
+			{Text: "She went home early.", Highlight: "went", Generated: true},
+			{Text: "We go to school by bus.", Highlight: "go", Generated: true},
+		}}}, results)
+
+		got, err := os.ReadFile(cardsPath)
+		require.NoError(t, err)
+		assert.Contains(t, string(got), "    - expression: go\n"+
+			"      examples:\n"+
+			"        - text: She went home early.\n"+
+			"          highlight: went\n"+
+			"          generated: true\n"+
+			"        - text: We go to school by bus.\n"+
+			"          highlight: go\n"+
+			"          generated: true\n"+
+			"      meaning: to move or travel\n")
+		assert.Contains(t, string(got), "        - He was candid.\n")
+	})
+
+	t.Run("uses the meaning of a dictionary-backed note", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mock_inference.NewMockClient(ctrl)
+		client.EXPECT().GenerateExamples(gomock.Any(), gomock.Any()).Return(inference.GenerateExamplesResponse{}, nil)
+		client.EXPECT().GenerateExamples(gomock.Any(), inference.GenerateExamplesRequest{
+			Expression:   "sanguine",
+			Meaning:      "optimistic in a difficult situation",
+			PartOfSpeech: "adjective",
+			Count:        3,
+		}).Return(inference.GenerateExamplesResponse{Examples: []inference.GeneratedExample{
+			{Text: "She stayed sanguine.", Highlight: "sanguine"},
+		}}, nil)
+		svc, cardsPath := newService(t, client)
+		svc.dictionaryMap = map[string]rapidapi.Response{
+			"sanguine": {Results: []rapidapi.Result{{Definition: "optimistic in a difficult situation", PartOfSpeech: "adjective"}}},
+		}
+
+		results, err := svc.GenerateExamples(context.Background(), false)
+		require.NoError(t, err)
+		assert.Equal(t, []ExampleResult{{File: cardsPath, Expression: "sanguine", Examples: notebook.Examples{
+			{Text: "She stayed sanguine.", Highlight: "sanguine", Generated: true},
+		}}}, results)
+	})
+
+	t.Run("no valid sentence leaves the note alone", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mock_inference.NewMockClient(ctrl)
+		client.EXPECT().GenerateExamples(gomock.Any(), gomock.Any()).Return(inference.GenerateExamplesResponse{
+			Examples: []inference.GeneratedExample{{Text: "They are going nowhere.", Highlight: "go"}},
+		}, nil)
+		svc, cardsPath := newService(t, client)
+
+		results, err := svc.GenerateExamples(context.Background(), false)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+
+		got, err := os.ReadFile(cardsPath)
+		require.NoError(t, err)
+		assert.Equal(t, cards, string(got))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, cardsPath := newService(t, mock_inference.NewMockClient(ctrl))
+
+		results, err := svc.GenerateExamples(context.Background(), true)
+		require.NoError(t, err)
+		assert.Equal(t, []ExampleResult{{File: cardsPath, Expression: "go"}}, results)
+
+		got, err := os.ReadFile(cardsPath)
+		require.NoError(t, err)
+		assert.Equal(t, cards, string(got))
+	})
+	t.Run("records the files written before a model failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mock_inference.NewMockClient(ctrl)
+		client.EXPECT().GenerateExamples(gomock.Any(), gomock.Any()).Return(inference.GenerateExamplesResponse{
+			Examples: []inference.GeneratedExample{{Text: "We go to school by bus.", Highlight: "go"}},
+		}, nil)
+		client.EXPECT().GenerateExamples(gomock.Any(), gomock.Any()).
+			Return(inference.GenerateExamplesResponse{}, errors.New("rate limited"))
+		svc, cardsPath := newService(t, client)
+		morePath := filepath.Join(filepath.Dir(cardsPath), "more.yml")
+		more := `- title: "More"
+  date: 2025-01-16T00:00:00Z
+  cards:
+    - expression: laconic
+      meaning: using very few words
+`
+		require.NoError(t, os.WriteFile(morePath, []byte(more), 0644))
+		recorder := &recordingRecorder{}
+		svc.SetRecorder(recorder)
+
+		results, err := svc.GenerateExamples(context.Background(), false)
+		require.Error(t, err)
+		assert.Equal(t, []ExampleResult{{File: cardsPath, Expression: "go", Examples: notebook.Examples{
+			{Text: "We go to school by bus.", Highlight: "go", Generated: true},
+		}}}, results)
+		assert.Equal(t, []string{`Generate examples for "go"`}, recorder.messages)
+		assert.Equal(t, [][]string{{cardsPath}}, recorder.paths)
+
+		got, err := os.ReadFile(morePath)
+		require.NoError(t, err)
+		assert.Equal(t, more, string(got))
+	})
+}
+
+func TestValidGeneratedExamples(t *testing.T) {
+	got := validGeneratedExamples(notebook.Note{Expression: "break the ice"}, []inference.GeneratedExample{
+		{Text: "He broke the ice with a joke.", Highlight: "broke the ice"},
+		{Text: "The ice melted.", Highlight: "melted"},
+		{Text: "They were breaking the ice.", Highlight: "breaking the ice"},
+	})
+	assert.Equal(t, notebook.Examples{
+		{Text: "He broke the ice with a joke.", Highlight: "broke the ice", Generated: true},
+		{Text: "They were breaking the ice.", Highlight: "breaking the ice", Generated: true},
+	}, got)
+}
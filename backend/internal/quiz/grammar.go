@@ -62,9 +62,9 @@ func (s *Service) LoadGrammarPosts(notebookID string, entryTitles []string) ([]G
 		name = index.Name
 	}
 
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
-		return nil, fmt.Errorf("NewLearningHistories() > %w", err)
+		return nil, fmt.Errorf("s.loadLearningHistories() > %w", err)
 	}
 	expByMistake := grammarExpressionsByID(learningHistories[notebookID])
 
@@ -161,9 +161,9 @@ func (s *Service) LoadGrammarMistakes(notebookID string, entryTitles []string) (
 	if err != nil {
 		return nil, fmt.Errorf("ReadStoryNotebooks(%s) > %w", notebookID, err)
 	}
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
-		return nil, fmt.Errorf("NewLearningHistories() > %w", err)
+		return nil, fmt.Errorf("s.loadLearningHistories() > %w", err)
 	}
 	expByMistake := grammarExpressionsByID(learningHistories[notebookID])
 
@@ -240,9 +240,9 @@ func (s *Service) LoadGrammarStorySummaries() ([]NotebookSummary, error) {
 	if err != nil {
 		return nil, fmt.Errorf("newReader() > %w", err)
 	}
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
-		return nil, fmt.Errorf("NewLearningHistories() > %w", err)
+		return nil, fmt.Errorf("s.loadLearningHistories() > %w", err)
 	}
 	storyIndexes := reader.GetStoryIndexes()
 
@@ -325,6 +325,8 @@ func deterministicGrammarGrade(answer, correct, incorrect string) (GradeResult,
 			Correct: false,
 			Reason:  "No answer provided.",
 			Quality: int(notebook.QualityWrong),
+			Answer:  answer,
+			Grader:  notebook.GraderDeterministic,
 		}, true
 	}
 	nc := normalizeCorrection(correct)
@@ -336,6 +338,8 @@ func deterministicGrammarGrade(answer, correct, incorrect string) (GradeResult,
 			Correct: true,
 			Reason:  "Matches the correction.",
 			Quality: int(notebook.QualityCorrect),
+			Answer:  answer,
+			Grader:  notebook.GraderDeterministic,
 		}, true
 	}
 	return GradeResult{}, false
@@ -363,6 +367,8 @@ func (s *Service) GradeGrammarBlank(ctx context.Context, content string, blank G
 		Correct: response.Correct,
 		Reason:  response.Reason,
 		Quality: response.Quality,
+		Answer:  answer,
+		Grader:  notebook.GraderModel,
 	}, nil
 }
 
@@ -384,6 +390,10 @@ func (s *Service) SaveGrammarBlank(ctx context.Context, notebookID, senseID stri
 		StoryTitle:       notebook.JournalStoryTitle,
 		Expression:       senseID,
 		SenseID:          senseID,
+		CorrectionID:     senseID,
+		Answer:           result.Answer,
+		GraderReason:     result.Reason,
+		Grader:           result.Grader,
 		IsCorrect:        result.Correct,
 		LearningNotesDir: s.notebooksConfig.LearningNotesDirectory,
 	}
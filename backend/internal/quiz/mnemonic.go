@@ -0,0 +1,168 @@
+package quiz
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// maxMnemonicContexts caps the scene lines and examples sent per word.
+const maxMnemonicContexts = 3
+
+// MnemonicTarget narrows GenerateMnemonics to one note: the entry with ID,
+// or, when ID is empty, the ones with Expression. NotebookID limits the
+// search to the files of that story, book, flashcard notebook or
+// definitions book. The zero value targets every note.
+type MnemonicTarget struct {
+	NotebookID string
+	ID         string
+	Expression string
+}
+
+func (t MnemonicTarget) matches(note notebook.Note) bool {
+	if t.ID != "" {
+		return note.ID == t.ID
+	}
+	if t.Expression != "" {
+		return strings.EqualFold(note.Expression, t.Expression)
+	}
+	return true
+}
+
+// MnemonicResult is one memo GenerateMnemonics wrote.
+type MnemonicResult struct {
+	File       string
+	Expression string
+	// Mnemonic is empty on a dry run.
+	Mnemonic string
+}
+
+// GenerateMnemonics writes an LLM-generated memory hook into the `memo` of
+// every story, book, flashcard and definitions note matched by target
+// that has none yet and has a meaning to hook to. The hook is built from
+// the meaning, the meanings of the note's origin parts and the scene lines
+// or examples using the word. Notes with a memo, hand-written or not, are
+// never touched, and the files are edited add-only so nothing else is
+// reformatted. With dryRun the notes are listed without calling the model
+// or writing any file. An unknown target.NotebookID returns *NotFoundError.
+func (s *Service) GenerateMnemonics(ctx context.Context, target MnemonicTarget, dryRun bool) (results []MnemonicResult, err error) {
+	if !dryRun && s.openaiClient == nil {
+		return nil, fmt.Errorf("no inference client configured")
+	}
+	reader, err := s.newReader()
+	if err != nil {
+		return nil, fmt.Errorf("load notebooks: %w", err)
+	}
+	originMap := buildOriginMap(reader)
+
+	var dirs []string
+	dirs = append(dirs, s.notebooksConfig.StoriesDirectories...)
+	dirs = append(dirs, s.notebooksConfig.FlashcardsDirectories...)
+	dirs = append(dirs, s.notebooksConfig.BooksDirectories...)
+	dirs = append(dirs, s.notebooksConfig.DefinitionsDirectories...)
+	files, err := mnemonicFiles(reader, dirs, target.NotebookID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.enrichSourceFiles(files, dryRun, sourceEnrichment{
+		enrich: func(file string, data []byte) ([]byte, []string, error) {
+			var fileResults []MnemonicResult
+			memoFor := func(entry notebook.MemoEntry) (string, error) {
+				// A dictionary-backed note takes its meaning from the dictionary;
+				// one SetDetails can't resolve has none and is skipped below.
+				_ = entry.Note.SetDetails(s.dictionaryMap, "")
+				note := entry.Note
+				if !target.matches(note) || strings.TrimSpace(note.Meaning) == "" {
+					return "", nil
+				}
+				if dryRun {
+					fileResults = append(fileResults, MnemonicResult{File: file, Expression: note.Expression})
+					return "-", nil
+				}
+				response, err := s.openaiClient.GenerateMnemonic(ctx, mnemonicRequest(entry, originMap))
+				if err != nil {
+					return "", fmt.Errorf("generate mnemonic for %q: %w", note.Expression, err)
+				}
+				mnemonic := strings.Join(strings.Fields(response.Mnemonic), " ")
+				if mnemonic != "" {
+					fileResults = append(fileResults, MnemonicResult{File: file, Expression: note.Expression, Mnemonic: mnemonic})
+				}
+				return mnemonic, nil
+			}
+			out, _, err := notebook.AddMemosToSourceYAML(data, memoFor)
+			if err != nil {
+				return nil, nil, fmt.Errorf("add memos in %s: %w", file, err)
+			}
+			results = append(results, fileResults...)
+			expressions := make([]string, len(fileResults))
+			for i, result := range fileResults {
+				expressions[i] = result.Expression
+			}
+			return out, expressions, nil
+		},
+		message: func(expressions []string) string {
+			if len(expressions) == 1 {
+				return fmt.Sprintf("Generate a mnemonic for %q", expressions[0])
+			}
+			return fmt.Sprintf("Generate mnemonics for %d note(s)", len(expressions))
+		},
+	})
+	return results, err
+}
+
+// mnemonicFiles returns the source files GenerateMnemonics edits: those of
+// the notebook with notebookID, or of every directory when it is empty.
+func mnemonicFiles(reader *notebook.Reader, dirs []string, notebookID string) ([]string, error) {
+	if notebookID != "" {
+		dir, ok := reader.NotebookDirectory(notebookID)
+		if !ok {
+			dir, ok = reader.DefinitionsBookPath(notebookID)
+		}
+		if !ok {
+			return nil, &NotFoundError{NotebookID: notebookID}
+		}
+		if filepath.Ext(dir) == ".yml" {
+			return []string{dir}, nil
+		}
+		dirs = []string{dir}
+	}
+	files, err := notebook.CollectYAMLFiles(dirs)
+	if err != nil {
+		return nil, fmt.Errorf("collect source files: %w", err)
+	}
+	return files, nil
+}
+
+// mnemonicRequest builds the inference request for a note: its resolved
+// origin parts, and the scene lines mentioning it followed by its own
+// examples.
+func mnemonicRequest(entry notebook.MemoEntry, originMap map[string]notebook.EtymologyOrigin) inference.GenerateMnemonicRequest {
+	note := entry.Note
+	request := inference.GenerateMnemonicRequest{
+		Expression:   note.Expression,
+		Meaning:      strings.TrimSpace(note.Meaning),
+		PartOfSpeech: note.PartOfSpeech,
+	}
+	for _, part := range resolveOriginParts(note.OriginParts, originMap) {
+		request.Origins = append(request.Origins, inference.MnemonicOrigin{
+			Origin:   part.Origin,
+			Language: part.Language,
+			Meaning:  part.Meaning,
+		})
+	}
+	seen := make(map[string]bool)
+	for _, line := range append(append([]string(nil), entry.Contexts...), note.Examples.Texts()...) {
+		line = strings.TrimSpace(line)
+		if line == "" || seen[line] || len(request.Contexts) == maxMnemonicContexts {
+			continue
+		}
+		seen[line] = true
+		request.Contexts = append(request.Contexts, line)
+	}
+	return request
+}
@@ -0,0 +1,164 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+	"github.com/at-ishikawa/langner/internal/inference"
+	mock_inference "github.com/at-ishikawa/langner/internal/mocks/inference"
+)
+
+// recordingRecorder keeps every Record call for the tests to inspect.
+type recordingRecorder struct {
+	messages []string
+	paths    [][]string
+}
+
+func (r *recordingRecorder) Record(message string, paths ...string) error {
+	r.messages = append(r.messages, message)
+	r.paths = append(r.paths, paths)
+	return nil
+}
+
+func TestService_GenerateMnemonics(t *testing.T) {
+	const cards = `- title: "Flashcards"
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - id: obstinate
+      expression: obstinate
+      meaning: stubbornly refusing to change one's mind
+      examples:
+        - He remained obstinate.
+    - expression: candid
+      memo: my own hook
+      meaning: truthful and straightforward
+    - expression: ephemeral
+    - expression: sanguine
+      dictionary_number: 1
+`
+
+	newService := func(t *testing.T, client inference.Client) (*Service, string) {
+		flashcardsDir := t.TempDir()
+		notebookDir := filepath.Join(flashcardsDir, "vocab")
+		require.NoError(t, os.MkdirAll(notebookDir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "index.yml"), []byte(
+			"id: vocab\nname: \"Vocabulary\"\nnotebooks:\n  - ./cards.yml\n"), 0644))
+		cardsPath := filepath.Join(notebookDir, "cards.yml")
+		require.NoError(t, os.WriteFile(cardsPath, []byte(cards), 0644))
+		svc := NewService(config.NotebooksConfig{
+			FlashcardsDirectories: []string{flashcardsDir},
+		}, client, make(map[string]rapidapi.Response), nil, config.QuizConfig{})
+		return svc, cardsPath
+	}
+
+	t.Run("writes a memo to notes without one", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mock_inference.NewMockClient(ctrl)
+		client.EXPECT().GenerateMnemonic(gomock.Any(), inference.GenerateMnemonicRequest{
+			Expression: "obstinate",
+			Meaning:    "stubbornly refusing to change one's mind",
+			Contexts:   []string{"He remained obstinate."},
+		}).Return(inference.GenerateMnemonicResponse{Mnemonic: "An obstacle\nthat won't move."}, nil)
+		svc, cardsPath := newService(t, client)
+
+		results, err := svc.GenerateMnemonics(context.Background(), MnemonicTarget{}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []MnemonicResult{
+			{File: cardsPath, Expression: "obstinate", Mnemonic: "An obstacle that won't move."},
+		}, results)
+
+		got, err := os.ReadFile(cardsPath)
+		require.NoError(t, err)
+		assert.Contains(t, string(got), "      expression: obstinate\n      memo: An obstacle that won't move.\n      meaning:")
+		assert.Contains(t, string(got), "      memo: my own hook\n")
+	})
+
+	t.Run("uses the meaning of a dictionary-backed note", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mock_inference.NewMockClient(ctrl)
+		client.EXPECT().GenerateMnemonic(gomock.Any(), inference.GenerateMnemonicRequest{
+			Expression:   "sanguine",
+			Meaning:      "optimistic in a difficult situation",
+			PartOfSpeech: "adjective",
+			Contexts:     []string{"She is sanguine about the future."},
+		}).Return(inference.GenerateMnemonicResponse{Mnemonic: "Sunny blood."}, nil)
+		svc, cardsPath := newService(t, client)
+		svc.dictionaryMap = map[string]rapidapi.Response{
+			"sanguine": {Results: []rapidapi.Result{{
+				Definition:   "optimistic in a difficult situation",
+				PartOfSpeech: "adjective",
+				Examples:     []string{"She is sanguine about the future."},
+			}}},
+		}
+
+		results, err := svc.GenerateMnemonics(context.Background(), MnemonicTarget{Expression: "sanguine"}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []MnemonicResult{{File: cardsPath, Expression: "sanguine", Mnemonic: "Sunny blood."}}, results)
+	})
+
+	t.Run("target and dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, cardsPath := newService(t, mock_inference.NewMockClient(ctrl))
+
+		results, err := svc.GenerateMnemonics(context.Background(), MnemonicTarget{Expression: "Obstinate"}, true)
+		require.NoError(t, err)
+		assert.Equal(t, []MnemonicResult{{File: cardsPath, Expression: "obstinate"}}, results)
+
+		results, err = svc.GenerateMnemonics(context.Background(), MnemonicTarget{ID: "candid"}, true)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+
+		got, err := os.ReadFile(cardsPath)
+		require.NoError(t, err)
+		assert.Equal(t, cards, string(got))
+	})
+	t.Run("unknown notebook", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc, _ := newService(t, mock_inference.NewMockClient(ctrl))
+
+		_, err := svc.GenerateMnemonics(context.Background(), MnemonicTarget{NotebookID: "missing"}, true)
+		var notFoundErr *NotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("records the files written before a model failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mock_inference.NewMockClient(ctrl)
+		client.EXPECT().GenerateMnemonic(gomock.Any(), gomock.Any()).
+			Return(inference.GenerateMnemonicResponse{Mnemonic: "An obstacle that won't move."}, nil)
+		client.EXPECT().GenerateMnemonic(gomock.Any(), gomock.Any()).
+			Return(inference.GenerateMnemonicResponse{}, errors.New("rate limited"))
+		svc, cardsPath := newService(t, client)
+		morePath := filepath.Join(filepath.Dir(cardsPath), "more.yml")
+		more := `- title: "More"
+  date: 2025-01-16T00:00:00Z
+  cards:
+    - expression: laconic
+      meaning: using very few words
+`
+		require.NoError(t, os.WriteFile(morePath, []byte(more), 0644))
+		recorder := &recordingRecorder{}
+		svc.SetRecorder(recorder)
+
+		results, err := svc.GenerateMnemonics(context.Background(), MnemonicTarget{}, false)
+		require.Error(t, err)
+		assert.Equal(t, []MnemonicResult{
+			{File: cardsPath, Expression: "obstinate", Mnemonic: "An obstacle that won't move."},
+		}, results)
+		assert.Equal(t, []string{`Generate a mnemonic for "obstinate"`}, recorder.messages)
+		assert.Equal(t, [][]string{{cardsPath}}, recorder.paths)
+
+		got, err := os.ReadFile(morePath)
+		require.NoError(t, err)
+		assert.Equal(t, more, string(got))
+	})
+}
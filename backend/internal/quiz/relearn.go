@@ -1,6 +1,7 @@
 package quiz
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -50,6 +51,9 @@ type RelearnContextScene struct {
 //	QuizTypeGrammar           correction: show Content with Incorrect struck
 //	                          through, ask for the fix — the live grammar
 //	                          quiz's own inline-correction card, reused as-is.
+//	QuizTypeDictation         listening: play the line the word was missed
+//	                          in, ask what was heard — the live dictation
+//	                          quiz's card, reused as-is.
 //
 // A word failed in several quiz types yields one card per type. Nothing about a
 // RelearnCard is ever persisted — the Relearn Quiz writes no learning history
@@ -101,6 +105,10 @@ type RelearnCard struct {
 	Content   string
 	Incorrect string
 
+	// Audio is the line a dictation card plays, relative to the directory of
+	// notebook NotebookName. Empty for other formats.
+	Audio string
+
 	// Answering-screen hints.
 	Examples []Example        // recognition
 	Contexts []ReverseContext // reverse (masked)
@@ -112,16 +120,18 @@ type RelearnCard struct {
 
 	// Grading inputs — one populated per Format. An etymology-origin card is an
 	// origin-bearing recognition miss, so it is graded through vocabCard too.
-	vocabCard   Card
-	reverseCard ReverseCard
-	grammarCard GrammarBlank
+	vocabCard     Card
+	reverseCard   ReverseCard
+	grammarCard   GrammarBlank
+	dictationCard DictationCard
 }
 
-// VocabCard, ReverseCard, GrammarCard return the card the matching pure grader
-// consumes for this Format.
-func (c RelearnCard) VocabCard() Card           { return c.vocabCard }
-func (c RelearnCard) ReverseCard() ReverseCard  { return c.reverseCard }
-func (c RelearnCard) GrammarCard() GrammarBlank { return c.grammarCard }
+// VocabCard, ReverseCard, GrammarCard, DictationCard return the card the
+// matching pure grader consumes for this Format.
+func (c RelearnCard) VocabCard() Card              { return c.vocabCard }
+func (c RelearnCard) ReverseCard() ReverseCard     { return c.reverseCard }
+func (c RelearnCard) GrammarCard() GrammarBlank    { return c.grammarCard }
+func (c RelearnCard) DictationCard() DictationCard { return c.dictationCard }
 
 // IsEtymology reports whether the card's Format is the etymology mode.
 func (c RelearnCard) IsEtymology() bool {
@@ -133,6 +143,11 @@ func (c RelearnCard) IsGrammar() bool {
 	return c.Format == notebook.QuizTypeGrammar
 }
 
+// IsDictation reports whether the card's Format is the dictation mode.
+func (c RelearnCard) IsDictation() bool {
+	return c.Format == notebook.QuizTypeDictation
+}
+
 // relearnKeySep separates the fields of the internal de-dup key ((format,
 // notebook, expression)). It is the ASCII Unit Separator (0x1F), which cannot
 // appear in notebook names or expressions.
@@ -167,9 +182,11 @@ type relearnCandidate struct {
 // via NeedsForwardReview), so missing it again there re-records a fresh miss that
 // brings it back into the window. A word deliberately excluded from a quiz mode
 // (per-quiz-type skipped_at set via SkipWord) never enters the pool, matching the
-// normal card loaders (quiz-ui-invariants U1).
+// normal card loaders (quiz-ui-invariants U1). A leech moved into the pool
+// (per-quiz-type relearn_at set via MoveToRelearnPool) ignores the window and
+// stays until its latest answer in that quiz type is correct.
 func (s *Service) LoadRelearnPool(windowStart time.Time) ([]RelearnCard, error) {
-	histories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	histories, err := s.loadLearningHistories()
 	if err != nil {
 		return nil, fmt.Errorf("load learning histories: %w", err)
 	}
@@ -204,8 +221,9 @@ func (s *Service) LoadRelearnPool(windowStart time.Time) ([]RelearnCard, error)
 			// while drops out here). It is not lost: the live grammar quiz still
 			// serves it (misunderstood is always due via NeedsForwardReview), and
 			// missing it again there re-records a fresh miss that brings it back
-			// into the window.
-			if latest.LearnedAt.Before(windowStart) {
+			// into the window. A leech moved into the relearn pool (RelearnAt)
+			// is the exception: it stays until it is answered correctly.
+			if latest.LearnedAt.Before(windowStart) && !expr.InRelearnPool(sp.format) {
 				continue
 			}
 			// Key by id when present so same-spelling homographs stay
@@ -324,6 +342,12 @@ func (s *Service) LoadRelearnPool(windowStart time.Time) ([]RelearnCard, error)
 	etymByWord := map[string]*etymPending{}
 	var etymOrder []string
 
+	// Dictation misses replay the line the word is spoken in. The lines are
+	// indexed only when the pool has a dictation miss, and their audio is
+	// looked up only for the lines that are drilled.
+	var dictationLines *relearnDictationIndex
+	dictationAudio := s.newDictationAudio(reader)
+
 	cards := make([]RelearnCard, 0, len(candidates))
 	for _, c := range candidates {
 		if c.format == notebook.QuizTypeGrammar {
@@ -361,6 +385,23 @@ func (s *Service) LoadRelearnPool(windowStart time.Time) ([]RelearnCard, error)
 			}
 			continue
 		}
+		if c.format == notebook.QuizTypeDictation {
+			if dictationLines == nil {
+				dictationLines, err = buildRelearnDictationIndex(reader)
+				if err != nil {
+					return nil, err
+				}
+			}
+			dc, ok := dictationLines.resolve(c)
+			if !ok || !dictationAudio.resolve(context.Background(), &dc) {
+				continue // no line with audio to play
+			}
+			cards = append(cards, RelearnCard{
+				Format: c.format, Entry: dc.Entry, Meaning: dc.Meaning, NotebookName: c.notebookName,
+				Audio: dc.Audio, dictationCard: dc,
+			})
+			continue
+		}
 		// Resolve by id first (mirrors MatchesEntry: an id-bearing failed
 		// entry resolves to its own card, so same-spelling homographs never
 		// collide). Fall back to the sense-less expression lookups for
@@ -543,7 +584,8 @@ type relearnSeriesSpec struct {
 // SaveGrammarBlank), so it gets a single series mapped to QuizTypeGrammar
 // instead of the vocab series; reusing this one check (rather than re-deriving
 // "is this a grammar entry" from the expression shape) is what keeps this
-// classification symmetric with the writer (L2).
+// classification symmetric with the writer (L2). DictationLogs replay as a
+// dictation card: hearing a word is a separate skill from reading it.
 func relearnSeries(metadataType string, expr notebook.LearningHistoryExpression) []relearnSeriesSpec {
 	if metadataType == string(notebook.QuizTypeGrammar) {
 		return []relearnSeriesSpec{
@@ -553,6 +595,7 @@ func relearnSeries(metadataType string, expr notebook.LearningHistoryExpression)
 	return []relearnSeriesSpec{
 		{logs: expr.LearnedLogs, format: notebook.QuizTypeNotebook},
 		{logs: expr.ReverseLogs, format: notebook.QuizTypeReverse},
+		{logs: expr.DictationLogs, format: notebook.QuizTypeDictation},
 	}
 }
 
@@ -563,12 +606,14 @@ func relearnSeries(metadataType string, expr notebook.LearningHistoryExpression)
 // the word is the stronger recall test, the same both-directions rule the origin
 // family card uses (buildEtymologyOriginCard). Grammar candidates are keyed by
 // correction span, not expression, so they are passed through untouched (two
-// distinct blanks of one post must each survive). latestWrong keeps the most
-// recent of the merged series so window ageing is unaffected.
+// distinct blanks of one post must each survive), and so are dictation
+// candidates, which drill listening rather than either reading direction.
+// latestWrong keeps the most recent of the merged series so window ageing is
+// unaffected.
 func collapseVocabDirections(candidates map[string]relearnCandidate) map[string]relearnCandidate {
 	out := make(map[string]relearnCandidate, len(candidates))
 	for _, c := range candidates {
-		if c.format == notebook.QuizTypeGrammar {
+		if c.format == notebook.QuizTypeGrammar || c.format == notebook.QuizTypeDictation {
 			out[string(c.format)+relearnKeySep+c.notebookName+relearnKeySep+strings.ToLower(strings.TrimSpace(c.expression))+relearnKeySep+c.id] = c
 			continue
 		}
@@ -12,9 +12,12 @@ import (
 
 	"github.com/at-ishikawa/langner/internal/config"
 	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+	"github.com/at-ishikawa/langner/internal/frequency"
 	"github.com/at-ishikawa/langner/internal/inference"
 	"github.com/at-ishikawa/langner/internal/learning"
 	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/tts"
+	"github.com/at-ishikawa/langner/internal/versioning"
 )
 
 // Service owns all quiz business logic shared between the CLI and RPC handler.
@@ -25,6 +28,22 @@ type Service struct {
 	learningRepository learning.LearningRepository
 	calculator         notebook.IntervalCalculator
 	disableShuffle     bool
+	// readerSource, when set, replaces the per-call NewReader with a shared
+	// snapshot (see notebook.WatchedReader).
+	readerSource notebook.ReaderSource
+	// historySource, when set, replaces reading the learning notes
+	// directory (see learning.RepositoryHistorySource).
+	historySource notebook.LearningHistorySource
+	// recorder versions the learning-history writes the service makes
+	// itself (the relearn pool, and skips when no learningRepository is
+	// set); answers and skips go through learningRepository.
+	recorder versioning.Recorder
+	// synthesizer, when set, voices dictation lines that have no recording.
+	synthesizer tts.Synthesizer
+	// frequency ranks how common words are; frequentFirst orders quiz
+	// cards by it.
+	frequency     *frequency.Table
+	frequentFirst bool
 }
 
 // NewService creates a new Service.
@@ -37,10 +56,61 @@ func NewService(notebooksConfig config.NotebooksConfig, openaiClient inference.C
 		learningRepository: learningRepo,
 		calculator:         notebook.NewIntervalCalculator(quizCfg.Algorithm, quizCfg.FixedIntervals),
 		disableShuffle:     quizCfg.DisableShuffle,
+		recorder:           versioning.NopRecorder(),
+		frequency:          frequency.NewTable(nil, dictionaryMap),
+		frequentFirst:      quizCfg.FrequentFirst,
 	}
 }
 
+// SetRecorder records the service's learning-history writes. A plain
+// YAML learning repository is switched to the same recorder so answers
+// are versioned too; other repositories are expected to be wired by the
+// caller (see learning.YAMLLearningRepository.WithRecorder).
+func (s *Service) SetRecorder(recorder versioning.Recorder) {
+	if recorder == nil {
+		recorder = versioning.NopRecorder()
+	}
+	s.recorder = recorder
+	if repo, ok := s.learningRepository.(*learning.YAMLLearningRepository); ok {
+		s.learningRepository = repo.WithRecorder(recorder)
+	}
+}
+
+// SetReaderSource makes the service read notebooks from source instead of
+// re-parsing every directory per call.
+func (s *Service) SetReaderSource(source notebook.ReaderSource) {
+	s.readerSource = source
+}
+
+// SetSynthesizer makes the dictation quiz synthesize the conversation lines
+// that have no recording with synthesizer.
+func (s *Service) SetSynthesizer(synthesizer tts.Synthesizer) {
+	s.synthesizer = synthesizer
+}
+
+// SetFrequencyList ranks words by list, falling back to the frequency in
+// the dictionary cache for the words it doesn't list.
+func (s *Service) SetFrequencyList(list *frequency.List) {
+	s.frequency = frequency.NewTable(list, s.dictionaryMap)
+}
+
+// SetLearningHistorySource makes the service read learning history from
+// source instead of the learning notes directory.
+func (s *Service) SetLearningHistorySource(source notebook.LearningHistorySource) {
+	s.historySource = source
+}
+
+func (s *Service) loadLearningHistories() (map[string][]notebook.LearningHistory, error) {
+	if s.historySource != nil {
+		return s.historySource.LearningHistories()
+	}
+	return notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+}
+
 func (s *Service) newReader() (*notebook.Reader, error) {
+	if s.readerSource != nil {
+		return s.readerSource.Reader()
+	}
 	reader, err := notebook.NewReader(
 		s.notebooksConfig.StoriesDirectories,
 		s.notebooksConfig.FlashcardsDirectories,
@@ -84,7 +154,7 @@ func (s *Service) LoadNotebookSummaries(includeUnstudied bool) ([]NotebookSummar
 		return nil, fmt.Errorf("failed to initialize notebook reader: %w", err)
 	}
 
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load learning histories: %w", err)
 	}
@@ -126,6 +196,7 @@ func (s *Service) LoadNotebookSummaries(includeUnstudied bool) ([]NotebookSummar
 			NotebookID:           id,
 			Name:                 index.Name,
 			ReviewCount:          countStoryDefinitions(filtered),
+			FrequentReviewCount:  countFrequentEntries(s.frequency, storyDefinitionEntries(filtered)),
 			ReverseReviewCount:   reverseCount,
 			EtymologyReviewCount: etymCount,
 			VocabularyCount:      countStoryDefinitions(stories),
@@ -166,6 +237,7 @@ func (s *Service) LoadNotebookSummaries(includeUnstudied bool) ([]NotebookSummar
 			NotebookID:           id,
 			Name:                 index.Name,
 			ReviewCount:          countFlashcardCards(filtered),
+			FrequentReviewCount:  countFrequentEntries(s.frequency, flashcardEntries(filtered)),
 			ReverseReviewCount:   reverseCount,
 			EtymologyReviewCount: etymCount,
 			VocabularyCount:      countFlashcardCards(notebooks),
@@ -189,20 +261,30 @@ func (s *Service) LoadNotebookSummaries(includeUnstudied bool) ([]NotebookSummar
 			continue
 		}
 		conceptHeads := definitionConceptHeads(reader, nbID)
-		reviewCount := countDefinitionNotes(defs, learningHistories[nbID], false, includeUnstudied, conceptHeads)
+		due := dueDefinitionNotes(defs, learningHistories[nbID], false, includeUnstudied, conceptHeads)
+		reviewCount := len(due)
 		reverseCount := countDefinitionNotes(defs, learningHistories[nbID], true, includeUnstudied, conceptHeads)
 		if reviewCount == 0 && reverseCount == 0 {
 			continue
 		}
+		dueEntries := make(map[string]struct{}, len(due))
+		for _, note := range due {
+			entry := note.Definition
+			if entry == "" {
+				entry = note.Expression
+			}
+			dueEntries[strings.ToLower(entry)] = struct{}{}
+		}
 		summaries = append(summaries, NotebookSummary{
-			NotebookID:         nbID,
-			Name:               nbID,
-			ReviewCount:        reviewCount,
-			ReverseReviewCount: reverseCount,
-			VocabularyCount:    countDefinitionEntries(defs, conceptHeads),
-			Kind:               "Books",
-			LatestDate:         reader.GetDefinitionsLatestDate(nbID),
-			Sections:           definitionsSectionSummaries(defs, learningHistories[nbID], includeUnstudied, conceptHeads),
+			NotebookID:          nbID,
+			Name:                nbID,
+			ReviewCount:         reviewCount,
+			FrequentReviewCount: countFrequentEntries(s.frequency, dueEntries),
+			ReverseReviewCount:  reverseCount,
+			VocabularyCount:     countDefinitionEntries(defs, conceptHeads),
+			Kind:                "Books",
+			LatestDate:          reader.GetDefinitionsLatestDate(nbID),
+			Sections:            definitionsSectionSummaries(defs, learningHistories[nbID], includeUnstudied, conceptHeads),
 		})
 	}
 
@@ -323,7 +405,7 @@ func (s *Service) LoadCards(notebookIDs []string, includeUnstudied bool, section
 		return nil, fmt.Errorf("failed to initialize notebook reader: %w", err)
 	}
 
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load learning histories: %w", err)
 	}
@@ -381,9 +463,28 @@ func (s *Service) LoadCards(notebookIDs []string, includeUnstudied bool, section
 			cards[i], cards[j] = cards[j], cards[i]
 		})
 	}
+	if s.frequentFirst {
+		sortByFrequency(s.frequency, cards, func(card Card) string { return card.Entry })
+	}
 	return cards, nil
 }
 
+// sortByFrequency stably moves the cards of more frequent words first, so
+// the cards within a frequency band keep their shuffled order.
+func sortByFrequency[C any](table *frequency.Table, cards []C, entry func(C) string) {
+	priorities := make(map[string]int, len(cards))
+	priority := func(card C) int {
+		key := entry(card)
+		p, ok := priorities[key]
+		if !ok {
+			p = table.Band(key).Priority()
+			priorities[key] = p
+		}
+		return p
+	}
+	sort.SliceStable(cards, func(i, j int) bool { return priority(cards[i]) < priority(cards[j]) })
+}
+
 // inSectionFilter reports whether title is allowed by filter. An empty
 // filter means "no filter" (all sections allowed).
 func inSectionFilter(filter []string, title string) bool {
@@ -538,7 +639,7 @@ func (s *Service) GradeNotebookAnswer(ctx context.Context, card Card, answer str
 	// Relearn for a word the learner never typed must record a normal miss, not a
 	// pass the model might return for a blank.
 	if strings.TrimSpace(answer) == "" {
-		return GradeResult{Correct: false, Reason: "No answer provided.", Quality: int(notebook.QualityWrong)}, nil
+		return GradeResult{Correct: false, Reason: "No answer provided.", Quality: int(notebook.QualityWrong), Answer: answer, Grader: notebook.GraderDeterministic}, nil
 	}
 	results, err := s.openaiClient.AnswerMeanings(ctx, inference.AnswerMeaningsRequest{
 		Expressions: []inference.Expression{
@@ -565,6 +666,8 @@ func (s *Service) GradeNotebookAnswer(ctx context.Context, card Card, answer str
 		Correct: isCorrect,
 		Reason:  reason,
 		Quality: quality,
+		Answer:  answer,
+		Grader:  notebook.GraderModel,
 	}, nil
 }
 
@@ -598,6 +701,7 @@ func (s *Service) SaveResult(ctx context.Context, card Card, result GradeResult,
 		SourceNotebookID: card.NotebookName, NotebookName: card.NotebookName,
 		StoryTitle: card.StoryTitle, SceneTitle: card.SceneTitle,
 		Expression: expression, OriginalExpression: originalExpression, SenseID: senseID,
+		Answer: result.Answer, GraderReason: result.Reason, Grader: result.Grader,
 		IsCorrect: result.Correct, LearningNotesDir: s.notebooksConfig.LearningNotesDirectory,
 	}
 	if err := s.learningRepository.Create(ctx, log); err != nil {
@@ -606,6 +710,41 @@ func (s *Service) SaveResult(ctx context.Context, card Card, result GradeResult,
 	return nil
 }
 
+// SaveEtymologyOriginResult records an etymology-origin answer for the word
+// card was built from, asked under origin (and originSense for same-session
+// multi-sense origins). It goes through the learning repository like every
+// other quiz mode: the YAML store keeps the word's single
+// etymology_origin_logs series, and the DB store additionally keys the row by
+// origin/sense so per-origin analytics don't have to re-read YAML.
+func (s *Service) SaveEtymologyOriginResult(ctx context.Context, card Card, origin, originSense string, result GradeResult, responseTimeMs int64) error {
+	status := "misunderstood"
+	if result.Correct {
+		status = "understood"
+	}
+	expression := card.Entry
+	originalExpression := card.OriginalEntry
+	senseID := card.ID
+	if card.ConceptHead != "" {
+		expression = card.ConceptHead
+		originalExpression = ""
+		senseID = ""
+	}
+	log := &learning.LearningLog{
+		Status: status, LearnedAt: time.Now(), Quality: result.Quality,
+		ResponseTimeMs: int(responseTimeMs), QuizType: string(notebook.QuizTypeEtymologyOrigin),
+		SourceNotebookID: card.NotebookName, NotebookName: card.NotebookName,
+		StoryTitle: card.StoryTitle, SceneTitle: card.SceneTitle,
+		Expression: expression, OriginalExpression: originalExpression, SenseID: senseID,
+		Origin: origin, OriginSense: originSense,
+		Answer: result.Answer, GraderReason: result.Reason, Grader: result.Grader,
+		IsCorrect: result.Correct, LearningNotesDir: s.notebooksConfig.LearningNotesDirectory,
+	}
+	if err := s.learningRepository.Create(ctx, log); err != nil {
+		return fmt.Errorf("save etymology origin learning log for %q: %w", card.NotebookName, err)
+	}
+	return nil
+}
+
 // storyHasContent reports whether any scene carries prose or dialogue worth
 // rendering in the content reader. Flashcards and definitions-only books
 // return false because they have neither statements nor conversations.
@@ -692,6 +831,12 @@ func storyHasContent(stories []notebook.StoryNotebook) bool {
 }
 
 func countStoryDefinitions(stories []notebook.StoryNotebook) int {
+	return len(storyDefinitionEntries(stories))
+}
+
+// storyDefinitionEntries returns the distinct lowercased entries of the
+// stories' definitions.
+func storyDefinitionEntries(stories []notebook.StoryNotebook) map[string]struct{} {
 	seen := make(map[string]struct{})
 	for _, story := range stories {
 		for _, scene := range story.Scenes {
@@ -704,7 +849,18 @@ func countStoryDefinitions(stories []notebook.StoryNotebook) int {
 			}
 		}
 	}
-	return len(seen)
+	return seen
+}
+
+// countFrequentEntries counts the entries that are frequent words.
+func countFrequentEntries(table *frequency.Table, entries map[string]struct{}) int {
+	count := 0
+	for entry := range entries {
+		if table.Band(entry).Frequent() {
+			count++
+		}
+	}
+	return count
 }
 
 // countDefinitionEntries returns the STRUCTURAL number of vocabulary entries a
@@ -825,6 +981,11 @@ func countReverseFlashcardCards(notebooks []notebook.FlashcardNotebook, historie
 }
 
 func countFlashcardCards(notebooks []notebook.FlashcardNotebook) int {
+	return len(flashcardEntries(notebooks))
+}
+
+// flashcardEntries returns the distinct lowercased entries of the cards.
+func flashcardEntries(notebooks []notebook.FlashcardNotebook) map[string]struct{} {
 	seen := make(map[string]struct{})
 	for _, nb := range notebooks {
 		for _, card := range nb.Cards {
@@ -835,7 +996,7 @@ func countFlashcardCards(notebooks []notebook.FlashcardNotebook) int {
 			seen[strings.ToLower(entry)] = struct{}{}
 		}
 	}
-	return len(seen)
+	return seen
 }
 
 func buildFromConversations(scene *notebook.StoryScene, definition *notebook.Note) ([]Example, []inference.Context) {
@@ -937,7 +1098,7 @@ func (s *Service) LoadReverseCards(notebookIDs []string, listMissingContext, inc
 		return nil, fmt.Errorf("failed to initialize notebook reader: %w", err)
 	}
 
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load learning histories: %w", err)
 	}
@@ -994,6 +1155,14 @@ func (s *Service) LoadReverseCards(notebookIDs []string, listMissingContext, inc
 			cards[i], cards[j] = cards[j], cards[i]
 		})
 	}
+	if s.frequentFirst {
+		sortByFrequency(s.frequency, cards, func(card ReverseCard) string {
+			if card.AltForm != "" {
+				return card.AltForm
+			}
+			return card.Expression
+		})
+	}
 	applyForwardMask(cards)
 	return cards, nil
 }
@@ -1409,7 +1578,7 @@ func (s *Service) GradeReverseAnswer(ctx context.Context, card ReverseCard, answ
 	// "unanswered → incorrect" path (quiz-ui-invariants U1). Without this a blank
 	// reverse answer was sent to ValidateWordForm, which could classify it correct.
 	if strings.TrimSpace(answer) == "" {
-		return GradeResult{Correct: false, Reason: "No answer provided.", Quality: int(notebook.QualityWrong)}, nil
+		return GradeResult{Correct: false, Reason: "No answer provided.", Quality: int(notebook.QualityWrong), Answer: answer, Grader: notebook.GraderDeterministic}, nil
 	}
 	var contextStr string
 	if len(card.Contexts) > 0 {
@@ -1444,6 +1613,8 @@ func (s *Service) GradeReverseAnswer(ctx context.Context, card ReverseCard, answ
 		Reason:         validation.Reason,
 		Quality:        quality,
 		Classification: string(validation.Classification),
+		Answer:         answer,
+		Grader:         notebook.GraderModel,
 	}, nil
 }
 
@@ -1466,6 +1637,7 @@ func (s *Service) SaveReverseResult(ctx context.Context, card ReverseCard, resul
 		SourceNotebookID: card.NotebookName, NotebookName: card.NotebookName,
 		StoryTitle: card.StoryTitle, SceneTitle: card.SceneTitle,
 		Expression: expression, OriginalExpression: expression, SenseID: senseID,
+		Answer: result.Answer, GraderReason: result.Reason, Grader: result.Grader,
 		IsCorrect: result.Correct, LearningNotesDir: s.notebooksConfig.LearningNotesDirectory,
 	}
 	if err := s.learningRepository.Create(ctx, log); err != nil {
@@ -1534,7 +1706,7 @@ func (s *Service) LoadAllWords() ([]FreeformCard, error) {
 	}
 
 	// Also load from definitions-only books
-	learningHistories, _ := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, _ := s.loadLearningHistories()
 	for _, nbID := range reader.GetDefinitionsBookIDs() {
 		if _, isStory := storyIndexes[nbID]; isStory {
 			continue
@@ -1680,7 +1852,7 @@ func (s *Service) loadStoryWords(reader *notebook.Reader, notebookID string, ori
 		return nil, err
 	}
 
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load learning histories: %w", err)
 	}
@@ -1727,7 +1899,7 @@ func (s *Service) loadFlashcardWords(reader *notebook.Reader, notebookID string,
 		return nil, err
 	}
 
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load learning histories: %w", err)
 	}
@@ -1781,6 +1953,8 @@ func (s *Service) GradeFreeformAnswer(ctx context.Context, word, meaning string,
 			Word:    word,
 			Meaning: meaning,
 			Reason:  fmt.Sprintf("Word '%s' not found in any notebook", word),
+			Answer:  freeformAnswer(word, meaning),
+			Grader:  notebook.GraderDeterministic,
 		}, nil
 	}
 
@@ -1847,9 +2021,16 @@ func (s *Service) GradeFreeformAnswer(ctx context.Context, word, meaning string,
 		NotebookName: notebookName,
 		Quality:      quality,
 		MatchedCard:  &matchingCards[0],
+		Answer:       freeformAnswer(word, meaning),
+		Grader:       notebook.GraderModel,
 	}, nil
 }
 
+// freeformAnswer is how a freeform answer is recorded in learning logs.
+func freeformAnswer(word, meaning string) string {
+	return word + ": " + meaning
+}
+
 // FreeformGradeResult holds the outcome of grading a freeform answer.
 type FreeformGradeResult struct {
 	Correct      bool
@@ -1860,6 +2041,9 @@ type FreeformGradeResult struct {
 	NotebookName string
 	Quality      int
 	MatchedCard  *FreeformCard
+	// Answer and Grader — see GradeResult.
+	Answer string
+	Grader string
 }
 
 // SaveFreeformResult updates learning history via the repository.
@@ -1881,6 +2065,7 @@ func (s *Service) SaveFreeformResult(ctx context.Context, card FreeformCard, res
 		SourceNotebookID: card.NotebookName, NotebookName: card.NotebookName,
 		StoryTitle: card.StoryTitle, SceneTitle: card.SceneTitle,
 		Expression: expression, OriginalExpression: expression, SenseID: senseID,
+		Answer: result.Answer, GraderReason: result.Reason, Grader: result.Grader,
 		IsCorrect: result.Correct, LearningNotesDir: s.notebooksConfig.LearningNotesDirectory,
 	}
 	if err := s.learningRepository.Create(ctx, log); err != nil {
@@ -1910,7 +2095,7 @@ func (s *Service) GetFreeformNextReviewDates(cards []FreeformCard) (map[string]s
 	if s.disableShuffle {
 		return map[string]string{}, nil
 	}
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load learning histories: %w", err)
 	}
@@ -2002,7 +2187,7 @@ func findMatchingCards(cards []FreeformCard, word string) []FreeformCard {
 // GetLatestLearnedInfo returns the learned_at and next_review_date for the latest log
 // of a given expression in a specific notebook.
 func (s *Service) GetLatestLearnedInfo(notebookName, id, expression string, quizType notebook.QuizType) (learnedAt string, nextReviewDate string) {
-	learningHistories, err := notebook.NewLearningHistories(s.notebooksConfig.LearningNotesDirectory)
+	learningHistories, err := s.loadLearningHistories()
 	if err != nil {
 		return "", ""
 	}
@@ -2115,11 +2300,16 @@ func trailingInt(s string) (int, bool) {
 // loaders apply, so the badge over-counted any word the user had
 // excluded from that quiz mode.
 func countDefinitionNotes(defs map[string]map[string][]notebook.Note, histories []notebook.LearningHistory, isReverse, includeUnstudied bool, conceptHeads map[string]string) int {
+	return len(dueDefinitionNotes(defs, histories, isReverse, includeUnstudied, conceptHeads))
+}
+
+// dueDefinitionNotes returns the notes countDefinitionNotes counts.
+func dueDefinitionNotes(defs map[string]map[string][]notebook.Note, histories []notebook.LearningHistory, isReverse, includeUnstudied bool, conceptHeads map[string]string) []*notebook.Note {
 	quizType := notebook.QuizTypeNotebook
 	if isReverse {
 		quizType = notebook.QuizTypeReverse
 	}
-	count := 0
+	var due []*notebook.Note
 	// Dedupe by concept so the badge reflects what the quiz actually
 	// surfaces (one card per concept) rather than counting head+members
 	// independently. The first head-or-member encountered for a concept
@@ -2141,12 +2331,12 @@ func countDefinitionNotes(defs map[string]map[string][]notebook.Note, histories
 					seenConcept[conceptKey] = true
 				}
 				if shouldIncludeDefinition(histories, storyTitle, sceneTitle, note, includeUnstudied, quizType, conceptHeads) {
-					count++
+					due = append(due, note)
 				}
 			}
 		}
 	}
-	return count
+	return due
 }
 
 // shouldIncludeDefinition is the single source of truth for whether a
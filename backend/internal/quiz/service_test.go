@@ -16,6 +16,7 @@ import (
 
 	"github.com/at-ishikawa/langner/internal/config"
 	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+	"github.com/at-ishikawa/langner/internal/frequency"
 	"github.com/at-ishikawa/langner/internal/inference"
 	"github.com/at-ishikawa/langner/internal/learning"
 	mock_inference "github.com/at-ishikawa/langner/internal/mocks/inference"
@@ -165,6 +166,37 @@ func TestService_LoadNotebookSummaries_WithFixtures(t *testing.T) {
 	assert.Equal(t, 1, vocabSummary.VocabularyCount)
 }
 
+func TestService_LoadNotebookSummaries_FrequentReviewCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc, _ := newTestServiceWithFixtures(t, mock_inference.NewMockClient(ctrl))
+	svc.SetFrequencyList(frequency.NewList([]string{"the", "serendipity"}))
+
+	summaries, err := svc.LoadNotebookSummaries(true)
+	require.NoError(t, err)
+
+	summaryMap := make(map[string]NotebookSummary)
+	for _, s := range summaries {
+		summaryMap[s.NotebookID] = s
+	}
+	// "preposterous" isn't in the list, "serendipity" ranks second.
+	assert.Equal(t, 1, summaryMap["test-story"].ReviewCount)
+	assert.Equal(t, 0, summaryMap["test-story"].FrequentReviewCount)
+	assert.Equal(t, 1, summaryMap["test-vocab"].ReviewCount)
+	assert.Equal(t, 1, summaryMap["test-vocab"].FrequentReviewCount)
+}
+
+func TestSortByFrequency(t *testing.T) {
+	table := frequency.NewTable(frequency.NewList([]string{"the", "house"}), map[string]rapidapi.Response{
+		"ubiquitous": {Frequency: 3.5},
+	})
+	cards := []string{"zyzzyva", "ubiquitous", "houses", "obscure", "the"}
+
+	sortByFrequency(table, cards, func(card string) string { return card })
+
+	// Unknown words keep their shuffled order after the ranked ones.
+	assert.Equal(t, []string{"houses", "the", "ubiquitous", "zyzzyva", "obscure"}, cards)
+}
+
 // The standalone etymology-origin quiz was removed, but etymology notebooks
 // must stay browsable from the Learn hub's Etymology tab. LoadNotebookSummaries
 // therefore still lists each etymology notebook with Kind "Etymology" and its
@@ -1716,6 +1748,44 @@ func TestService_SaveResult_ConceptHeadRedirectsLog(t *testing.T) {
 		"member name must not appear as a separate row")
 }
 
+func TestService_SaveEtymologyOriginResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	learningDir := t.TempDir()
+
+	svc := NewService(config.NotebooksConfig{
+		LearningNotesDirectory: learningDir,
+	}, mock_inference.NewMockClient(ctrl), make(map[string]rapidapi.Response),
+		learning.NewYAMLLearningRepository(learningDir, nil), config.QuizConfig{})
+
+	card := Card{
+		ID:           "pathology-1",
+		NotebookName: "roots",
+		StoryTitle:   "Session 1",
+		SceneTitle:   "pathos",
+		Entry:        "pathology",
+	}
+	require.NoError(t, svc.SaveEtymologyOriginResult(context.Background(), card, "pathos", "disease",
+		GradeResult{Correct: false, Quality: 1}, 1000))
+
+	histories, err := notebook.NewLearningHistories(learningDir)
+	require.NoError(t, err)
+	var got *notebook.LearningHistoryExpression
+	for _, h := range histories["roots"] {
+		for _, scene := range h.Scenes {
+			for i := range scene.Expressions {
+				if scene.Expressions[i].Expression == "pathology" {
+					got = &scene.Expressions[i]
+				}
+			}
+		}
+	}
+	require.NotNil(t, got, "the answer must be written under the word")
+	assert.Empty(t, got.LearnedLogs)
+	require.Len(t, got.EtymologyOriginLogs, 1)
+	assert.Equal(t, string(notebook.QuizTypeEtymologyOrigin), got.EtymologyOriginLogs[0].QuizType)
+	assert.Equal(t, notebook.LearnedStatusMisunderstood, got.EtymologyOriginLogs[0].Status)
+}
+
 // ---------- helper functions (package-internal) ----------
 
 func TestExtractAnswerResult(t *testing.T) {
@@ -2396,3 +2466,24 @@ func TestService_DefinitionsBookSummaryMatchesLoad(t *testing.T) {
 		})
 	}
 }
+
+type stubHistorySource map[string][]notebook.LearningHistory
+
+func (s stubHistorySource) LearningHistories() (map[string][]notebook.LearningHistory, error) {
+	return s, nil
+}
+
+func TestService_SetLearningHistorySource(t *testing.T) {
+	dir := t.TempDir()
+	svc := NewService(config.NotebooksConfig{LearningNotesDirectory: dir}, nil, nil, nil, config.QuizConfig{})
+
+	got, err := svc.loadLearningHistories()
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	source := stubHistorySource{"idioms": {{Metadata: notebook.LearningHistoryMetadata{NotebookID: "idioms", Type: "flashcard"}}}}
+	svc.SetLearningHistorySource(source)
+	got, err = svc.loadLearningHistories()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]notebook.LearningHistory(source), got)
+}
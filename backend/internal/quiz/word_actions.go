@@ -2,31 +2,13 @@ package quiz
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"io/fs"
-	"path/filepath"
 	"time"
 
 	"github.com/at-ishikawa/langner/internal/learning"
 	"github.com/at-ishikawa/langner/internal/notebook"
 )
 
-// loadSingleLearningHistory reads only the YAML for the requested notebook
-// instead of walking the entire learning_notes directory. The previous
-// implementation called NewLearningHistories on every Skip/Resume RPC,
-// re-parsing every notebook's YAML; toggling the "All" master in the UI
-// fires 3 parallel RPCs, tripling the cost. Returns an empty slice if the
-// notebook's history file doesn't exist yet (a freshly-imported word).
-func loadSingleLearningHistory(dir, notebookName string) ([]notebook.LearningHistory, error) {
-	path := filepath.Join(dir, notebookName+".yml")
-	hist, err := notebook.ReadLearningHistoryFile(path)
-	if errors.Is(err, fs.ErrNotExist) {
-		return nil, nil
-	}
-	return hist, err
-}
-
 // CardInfo holds the minimal information needed to identify a word
 // in the learning history for skip/resume/override operations.
 //
@@ -69,6 +51,7 @@ type CardInfo struct {
 	OriginalQuality      int
 	OriginalStatus       string
 	OriginalIntervalDays int
+	OriginalGrader       string
 }
 
 // CardInfoFromCard converts a Card to CardInfo. OriginalExpression
@@ -111,7 +94,8 @@ func CardInfoFromReverseCard(card ReverseCard) CardInfo {
 }
 
 // SkipWord excludes a word from each of the given quiz types in a single
-// read-modify-write of the notebook's learning history YAML. Batching avoids
+// learning repository write (one read-modify-write of the notebook's
+// learning history YAML, or one database transaction). Batching avoids
 // the race that bit the per-type API: when the UI's "All" toggle issued one
 // RPC per type concurrently, every handler read the same pre-update file
 // and the last writer overwrote the others, dropping skips.
@@ -121,9 +105,6 @@ func CardInfoFromReverseCard(card ReverseCard) CardInfo {
 // parameter is accepted for RPC compatibility but is not honored —
 // exclusion is permanent until ResumeWord clears the slot.
 //
-// If the expression has no learning history yet, SkipWord seeds an entry so
-// the skip has somewhere to live, then writes the skips onto it.
-//
 // When the expression belongs to a definitions concept (see Card.ConceptHead),
 // the skip propagates to every sibling member of the concept in the same
 // notebook — that's the "skip union" guarantee the read-side collapse
@@ -134,37 +115,29 @@ func (s *Service) SkipWord(info CardInfo, skipUntil string, quizTypes []notebook
 	if len(quizTypes) == 0 {
 		return fmt.Errorf("at least one quiz type is required to skip a word")
 	}
-	history, err := loadSingleLearningHistory(s.notebooksConfig.LearningNotesDirectory, info.NotebookName)
-	if err != nil {
-		return fmt.Errorf("failed to load learning history for %q: %w", info.NotebookName, err)
-	}
-
-	updater := notebook.NewLearningHistoryUpdater(history, s.calculator)
-
-	expressions := s.conceptMembersOrSelf(info.NotebookName, info.Expression)
-
-	// Create a learned-log-free stub for each member if the expression has
-	// no history yet — SetSkippedAt needs an entry to attach to, but we
-	// must not invent a fake "quality 5" review log just because the user
-	// skipped the word.
-	for _, expr := range expressions {
-		updater.EnsureExpressionStubForSkip(info.NotebookName, info.StoryTitle, info.SceneTitle, expr, "")
-	}
-
-	skippedAt := time.Now().Format(time.RFC3339)
-	for _, expr := range expressions {
-		for _, qt := range quizTypes {
-			if !updater.SetSkippedAt(expr, "", qt, skippedAt) {
-				return fmt.Errorf("failed to record skip for expression %q (%s) in notebook %q", expr, qt, info.NotebookName)
-			}
-		}
+	if err := s.historyRepository().UpdateSkips(context.Background(), learning.UpdateSkipsInput{
+		NotebookName: info.NotebookName,
+		StoryTitle:   info.StoryTitle,
+		SceneTitle:   info.SceneTitle,
+		Expression:   info.Expression,
+		Expressions:  s.conceptMembersOrSelf(info.NotebookName, info.Expression),
+		QuizTypes:    quizTypes,
+		SkippedAt:    time.Now(),
+	}); err != nil {
+		return fmt.Errorf("failed to skip %q in %q: %w", info.Expression, info.NotebookName, err)
 	}
+	return nil
+}
 
-	notePath := filepath.Join(s.notebooksConfig.LearningNotesDirectory, info.NotebookName+".yml")
-	if err := notebook.WriteYamlFile(notePath, updater.GetHistory()); err != nil {
-		return fmt.Errorf("failed to save learning history for %q: %w", info.NotebookName, err)
+// historyRepository returns the repository skips and relearn pool moves
+// are written through: the configured learning repository, so a
+// database-backed server stores them where it reads them, or the learning
+// notes directory when none is set.
+func (s *Service) historyRepository() learning.LearningRepository {
+	if s.learningRepository != nil {
+		return s.learningRepository
 	}
-	return nil
+	return learning.NewYAMLLearningRepository(s.notebooksConfig.LearningNotesDirectory, s.calculator).WithRecorder(s.recorder)
 }
 
 // conceptMembersOrSelf returns the list of concept-sibling expressions for
@@ -186,7 +159,7 @@ func (s *Service) conceptMembersOrSelf(notebookName, expression string) []string
 }
 
 // ResumeWord clears skips for each of the given quiz types so the word
-// reappears in those modes. Other quiz types' skips are left intact, so a
+// reappears in those modes, and takes it out of their relearn pools. Other quiz types' skips are left intact, so a
 // word excluded from multiple modes only resumes the ones the caller lists.
 // Batched into a single read-modify-write for the same race-free reason as
 // SkipWord.
@@ -194,25 +167,61 @@ func (s *Service) ResumeWord(info CardInfo, quizTypes []notebook.QuizType) error
 	if len(quizTypes) == 0 {
 		return fmt.Errorf("at least one quiz type is required to resume a word")
 	}
-	history, err := loadSingleLearningHistory(s.notebooksConfig.LearningNotesDirectory, info.NotebookName)
-	if err != nil {
-		return fmt.Errorf("failed to load learning history for %q: %w", info.NotebookName, err)
+	if err := s.historyRepository().UpdateSkips(context.Background(), learning.UpdateSkipsInput{
+		NotebookName: info.NotebookName,
+		StoryTitle:   info.StoryTitle,
+		SceneTitle:   info.SceneTitle,
+		Expression:   info.Expression,
+		Expressions:  s.conceptMembersOrSelf(info.NotebookName, info.Expression),
+		QuizTypes:    quizTypes,
+	}); err != nil {
+		return fmt.Errorf("failed to resume %q in %q: %w", info.Expression, info.NotebookName, err)
 	}
+	return nil
+}
 
-	updater := notebook.NewLearningHistoryUpdater(history, s.calculator)
-	for _, expr := range s.conceptMembersOrSelf(info.NotebookName, info.Expression) {
-		for _, qt := range quizTypes {
-			updater.ClearSkippedAt(expr, "", qt)
-		}
+// MoveToRelearnPool moves a leech into the Relearn Quiz pool for the given
+// quiz types: LoadRelearnPool keeps serving it there, regardless of the
+// recent-miss window, until it is answered correctly in that quiz type or
+// the quiz type is resumed.
+func (s *Service) MoveToRelearnPool(info CardInfo, quizTypes []notebook.QuizType) error {
+	if len(quizTypes) == 0 {
+		return fmt.Errorf("at least one quiz type is required to move a word to the relearn pool")
 	}
-
-	notePath := filepath.Join(s.notebooksConfig.LearningNotesDirectory, info.NotebookName+".yml")
-	if err := notebook.WriteYamlFile(notePath, updater.GetHistory()); err != nil {
-		return fmt.Errorf("failed to save learning history for %q: %w", info.NotebookName, err)
+	if err := s.historyRepository().MoveToRelearnPool(context.Background(), learning.MoveToRelearnPoolInput{
+		NotebookName: info.NotebookName,
+		Expression:   info.Expression,
+		ID:           info.ID,
+		QuizTypes:    quizTypes,
+		RelearnAt:    time.Now(),
+	}); err != nil {
+		return fmt.Errorf("failed to move %q in %q to the relearn pool: %w", info.Expression, info.NotebookName, err)
 	}
 	return nil
 }
 
+// MoveConfusionToRelearnPool moves the words of a confusion pair missed in
+// the discrimination drill into the Relearn Quiz pool for quizTypes. A word
+// never studied has no history to pool and is left alone. It reports
+// whether any word was moved.
+func (s *Service) MoveConfusionToRelearnPool(words []CardInfo, quizTypes []notebook.QuizType) (bool, error) {
+	learningHistories, err := s.loadLearningHistories()
+	if err != nil {
+		return false, fmt.Errorf("s.loadLearningHistories() > %w", err)
+	}
+	moved := false
+	for _, info := range words {
+		if notebook.FindExpressionInHistories(learningHistories[info.NotebookName], info.ID, info.Expression) == nil {
+			continue
+		}
+		if err := s.MoveToRelearnPool(info, quizTypes); err != nil {
+			return moved, err
+		}
+		moved = true
+	}
+	return moved, nil
+}
+
 // OverrideResult captures the pre-change values of the affected log
 // plus the recomputed next-review date. Surfaces the original* fields
 // the frontend needs to render an "Undo" button after a Mark-as-Correct.
@@ -221,6 +230,7 @@ type OverrideResult struct {
 	OriginalQuality      int
 	OriginalStatus       string
 	OriginalIntervalDays int
+	OriginalGrader       string
 }
 
 // OverrideAnswer rewrites the log identified by (info, quizType,
@@ -271,12 +281,13 @@ func (s *Service) OverrideAnswer(info CardInfo, quizType notebook.QuizType) (Ove
 		OriginalQuality:      res.OriginalQuality,
 		OriginalStatus:       res.OriginalStatus,
 		OriginalIntervalDays: res.OriginalIntervalDays,
+		OriginalGrader:       res.OriginalGrader,
 	}, nil
 }
 
 // UndoOverrideAnswer restores a previously overridden log to the
 // captured pre-override values (passed via info.OriginalQuality /
-// OriginalStatus / OriginalIntervalDays). Returns the new next-review
+// OriginalStatus / OriginalIntervalDays / OriginalGrader). Returns the new next-review
 // date and whether the restored entry is now considered correct.
 //
 // Implementation note: undo is just an override with MirrorValues
@@ -302,6 +313,7 @@ func (s *Service) UndoOverrideAnswer(info CardInfo, quizType notebook.QuizType)
 			Status:       info.OriginalStatus,
 			Quality:      info.OriginalQuality,
 			IntervalDays: info.OriginalIntervalDays,
+			Grader:       info.OriginalGrader,
 		},
 	})
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -125,3 +126,63 @@ func TestService_SkipWord_PreservesExistingLogs(t *testing.T) {
 	assert.Equal(t, 1, expr.LearnedLogs[0].Quality)
 	assert.Contains(t, expr.SkippedAt, "reverse")
 }
+
+// TestService_MoveToRelearnPool_KeepsLeechPastWindow verifies that a leech
+// moved into the relearn pool is served by LoadRelearnPool even though its
+// latest miss is older than the recent-miss window, until it is resumed.
+func TestService_MoveToRelearnPool_KeepsLeechPastWindow(t *testing.T) {
+	flashcardsDir := t.TempDir()
+	learningDir := t.TempDir()
+
+	notebookDir := filepath.Join(flashcardsDir, "vocab")
+	require.NoError(t, os.MkdirAll(notebookDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "index.yml"), []byte(
+		"id: vocab\nname: \"Vocabulary\"\nnotebooks:\n  - ./cards.yml\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "cards.yml"), []byte(`- title: "Flashcards"
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: obstinate
+      meaning: stubbornly refusing to change one's mind
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(learningDir, "vocab.yml"), []byte(`- metadata:
+    id: vocab
+    title: "Flashcards"
+    type: flashcard
+  expressions:
+    - expression: obstinate
+      learned_logs:
+        - status: misunderstood
+          learned_at: "2026-05-01T00:00:00Z"
+`), 0644))
+
+	ctrl := gomock.NewController(t)
+	svc := NewService(config.NotebooksConfig{
+		FlashcardsDirectories:  []string{flashcardsDir},
+		LearningNotesDirectory: learningDir,
+	}, mock_inference.NewMockClient(ctrl), make(map[string]rapidapi.Response),
+		learning.NewYAMLLearningRepository(learningDir, nil), config.QuizConfig{})
+
+	windowStart := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	cards, err := svc.LoadRelearnPool(windowStart)
+	require.NoError(t, err)
+	require.Empty(t, cards, "a miss older than the window ages out of the pool")
+
+	require.NoError(t, svc.MoveToRelearnPool(
+		CardInfo{NotebookName: "vocab", Expression: "obstinate"},
+		[]notebook.QuizType{notebook.QuizTypeNotebook},
+	))
+
+	cards, err = svc.LoadRelearnPool(windowStart)
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	assert.Equal(t, "obstinate", cards[0].Entry)
+	assert.Equal(t, notebook.QuizTypeNotebook, cards[0].Format)
+
+	require.NoError(t, svc.ResumeWord(
+		CardInfo{NotebookName: "vocab", Expression: "obstinate"},
+		[]notebook.QuizType{notebook.QuizTypeNotebook},
+	))
+	cards, err = svc.LoadRelearnPool(windowStart)
+	require.NoError(t, err)
+	assert.Empty(t, cards, "resuming the quiz type takes the leech out of the pool")
+}
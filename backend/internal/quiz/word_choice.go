@@ -0,0 +1,374 @@
+package quiz
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// WordChoiceOption is one member of a concept offered in a word choice
+// question, with its meaning.
+type WordChoiceOption struct {
+	Expression string
+	Meaning    string
+}
+
+// WordChoiceCard is one question about a member of a synonym or antonym
+// concept of a definitions book. With a Sentence it asks which of Options
+// fits the blank; without one it asks how the member differs from Compare.
+type WordChoiceCard struct {
+	ID             string // stable source-entry identity of the member (Note.ID); "" for legacy
+	NotebookName   string
+	StoryTitle     string
+	SceneTitle     string
+	ConceptHead    string
+	ConceptMeaning string
+	ConceptKind    notebook.ConceptKind
+	Expression     string // the member as listed in the concept (Note.Expression)
+	Entry          string // Note.Definition if set, else Note.Expression
+	OriginalEntry  string // Note.Expression when it differs from Entry
+	Meaning        string
+	// Sentence is an example of the member with it blanked out; Example is
+	// the same sentence unmasked, shown once answered.
+	Sentence string
+	Example  string
+	// Options are every member of the concept, the asked one included, in
+	// declaration order.
+	Options []WordChoiceOption
+	// Compare is the member a difference question is asked against.
+	Compare WordChoiceOption
+}
+
+// IsDifference reports whether the card asks for the difference between
+// two members rather than which one fits a sentence.
+func (c WordChoiceCard) IsDifference() bool {
+	return c.Sentence == ""
+}
+
+// wordChoiceConceptKinds are the concept kinds whose members are told
+// apart. Family members share one series, and visualization groups are
+// not about meaning.
+var wordChoiceConceptKinds = map[notebook.ConceptKind]bool{
+	notebook.ConceptKindSynonym: true,
+	notebook.ConceptKindAntonym: true,
+}
+
+// wordChoiceMember is a concept member resolved to its note.
+type wordChoiceMember struct {
+	note       notebook.Note
+	storyTitle string
+	sceneTitle string
+}
+
+// LoadWordChoiceCards loads one question per due member of the synonym and
+// antonym concepts of the given definitions books, or of every definitions
+// book when notebookIDs is empty. A member is due when its word choice
+// series is due, or — before it has one — once it has been answered
+// correctly in another quiz, unless includeUnstudied also lets in members
+// never answered. Returns *NotFoundError if any notebook ID does not exist.
+func (s *Service) LoadWordChoiceCards(notebookIDs []string, includeUnstudied bool, sectionTitlesByID map[string][]string) ([]WordChoiceCard, error) {
+	reader, err := s.newReader()
+	if err != nil {
+		return nil, fmt.Errorf("newReader() > %w", err)
+	}
+	learningHistories, err := s.loadLearningHistories()
+	if err != nil {
+		return nil, fmt.Errorf("s.loadLearningHistories() > %w", err)
+	}
+
+	if len(notebookIDs) == 0 {
+		notebookIDs = reader.GetDefinitionsBookIDs()
+		sort.Strings(notebookIDs)
+	}
+
+	now := time.Now()
+	cards := make([]WordChoiceCard, 0)
+	for _, notebookID := range notebookIDs {
+		// Only definitions books declare concepts; other notebooks simply
+		// have no questions.
+		if _, ok := reader.GetDefinitionsBook(notebookID); !ok {
+			if _, ok := reader.GetStoryIndexes()[notebookID]; ok {
+				continue
+			}
+			if _, ok := reader.NotebookDirectory(notebookID); ok {
+				continue
+			}
+			return nil, &NotFoundError{NotebookID: notebookID}
+		}
+		for _, card := range wordChoiceCandidates(reader, notebookID, sectionTitlesByID[notebookID]) {
+			if !seriesDue(learningHistories[notebookID], card.StoryTitle, card.SceneTitle, card.ID, card.Entry, card.OriginalEntry, notebook.QuizTypeWordChoice, includeUnstudied, now) {
+				continue
+			}
+			cards = append(cards, card)
+		}
+	}
+	if !s.disableShuffle {
+		rand.Shuffle(len(cards), func(i, j int) {
+			cards[i], cards[j] = cards[j], cards[i]
+		})
+	}
+	return cards, nil
+}
+
+// wordChoiceCandidates returns a question for every member of a synonym or
+// antonym concept of a definitions book that has at least one other member
+// with a meaning.
+func wordChoiceCandidates(reader *notebook.Reader, notebookID string, sectionFilter []string) []WordChoiceCard {
+	books, ok := reader.GetDefinitionsBook(notebookID)
+	if !ok {
+		return nil
+	}
+	members := make(map[string]wordChoiceMember)
+	for _, def := range books {
+		session := def.Metadata.Notebook
+		if session == "" {
+			session = def.Metadata.Title
+		}
+		for _, scene := range def.Scenes {
+			for _, note := range scene.Expressions {
+				if note.Meaning == "" {
+					continue
+				}
+				if _, ok := members[note.Expression]; ok {
+					continue
+				}
+				members[note.Expression] = wordChoiceMember{note: note, storyTitle: session, sceneTitle: scene.Metadata.Title}
+			}
+		}
+	}
+
+	var cards []WordChoiceCard
+	seen := make(map[string]bool)
+	for _, def := range books {
+		for _, concept := range def.Concepts {
+			kind := concept.ResolvedKind()
+			if !wordChoiceConceptKinds[kind] || seen[concept.Head] {
+				continue
+			}
+			seen[concept.Head] = true
+
+			var resolved []wordChoiceMember
+			var options []WordChoiceOption
+			for _, e := range concept.Expressions {
+				member, ok := members[e]
+				if !ok {
+					continue
+				}
+				resolved = append(resolved, member)
+				options = append(options, WordChoiceOption{Expression: member.note.Expression, Meaning: member.note.Meaning})
+			}
+			if len(resolved) < 2 {
+				continue
+			}
+			for i, member := range resolved {
+				if !inSectionFilter(sectionFilter, member.storyTitle) {
+					continue
+				}
+				note := member.note
+				entry := note.Definition
+				originalEntry := ""
+				if entry == "" {
+					entry = note.Expression
+				} else {
+					originalEntry = note.Expression
+				}
+				card := WordChoiceCard{
+					ID:             note.ID,
+					NotebookName:   notebookID,
+					StoryTitle:     member.storyTitle,
+					SceneTitle:     member.sceneTitle,
+					ConceptHead:    concept.Head,
+					ConceptMeaning: concept.Meaning,
+					ConceptKind:    kind,
+					Expression:     note.Expression,
+					Entry:          entry,
+					OriginalEntry:  originalEntry,
+					Meaning:        note.Meaning,
+					Options:        options,
+				}
+				card.Sentence, card.Example = wordChoiceSentence(note)
+				if card.IsDifference() {
+					// Compare with the next member, so a concept's
+					// difference questions go round all of its members.
+					card.Compare = options[(i+1)%len(options)]
+				}
+				cards = append(cards, card)
+			}
+		}
+	}
+	return cards
+}
+
+// wordChoiceSentence returns the first example of note with the note
+// blanked out, and the example itself.
+func wordChoiceSentence(note notebook.Note) (sentence, example string) {
+	for _, ex := range note.Examples {
+		for _, target := range []string{ex.Highlight, note.Expression, note.Definition} {
+			if target == "" {
+				continue
+			}
+			if masked := maskOccurrences(ex.Text, target); masked != ex.Text {
+				return masked, ex.Text
+			}
+		}
+	}
+	return "", ""
+}
+
+// GradeWordChoice grades a word choice answer. Picking the member the
+// sentence was written for is correct without a model call; any other
+// answer, and every difference explanation, is graded by the model, and by
+// GradeWordChoiceDeterministic when no model is configured or it fails.
+func (s *Service) GradeWordChoice(ctx context.Context, card WordChoiceCard, answer string, responseTimeMs int64) GradeResult {
+	if result, ok := deterministicWordChoiceGrade(card, answer); ok {
+		return result
+	}
+	if s.openaiClient == nil {
+		return GradeWordChoiceDeterministic(card, answer)
+	}
+	request := inference.GradeWordChoiceRequest{
+		ConceptKind:    string(card.ConceptKind),
+		Sentence:       card.Sentence,
+		Expected:       inference.WordChoiceOption{Expression: card.Expression, Meaning: card.Meaning},
+		UserAnswer:     answer,
+		ResponseTimeMs: responseTimeMs,
+	}
+	if card.IsDifference() {
+		request.Others = []inference.WordChoiceOption{{Expression: card.Compare.Expression, Meaning: card.Compare.Meaning}}
+	} else {
+		for _, option := range card.Options {
+			if option.Expression != card.Expression {
+				request.Others = append(request.Others, inference.WordChoiceOption{Expression: option.Expression, Meaning: option.Meaning})
+			}
+		}
+	}
+	response, err := s.openaiClient.GradeWordChoice(ctx, request)
+	if err != nil {
+		slog.Warn("grading the word choice answer without the model", "expression", card.Expression, "error", err)
+		return GradeWordChoiceDeterministic(card, answer)
+	}
+	return GradeResult{
+		Correct: response.Correct,
+		Reason:  response.Reason,
+		Quality: response.Quality,
+		Answer:  answer,
+		Grader:  notebook.GraderModel,
+	}
+}
+
+// deterministicWordChoiceGrade decides the answers that need no model: an
+// empty one, and the expected member picked for a sentence. Returns
+// (result, true) when decided, (zero, false) when the model should judge.
+func deterministicWordChoiceGrade(card WordChoiceCard, answer string) (GradeResult, bool) {
+	normalized := normalizeCorrection(answer)
+	if normalized == "" {
+		return GradeResult{Correct: false, Reason: "No answer provided.", Quality: int(notebook.QualityWrong), Answer: answer, Grader: notebook.GraderDeterministic}, true
+	}
+	if !card.IsDifference() && isWordChoiceMember(normalized, card.Expression, card.Entry) {
+		return GradeResult{Correct: true, Reason: fmt.Sprintf("%q fits the sentence.", card.Expression), Quality: int(notebook.QualityCorrect), Answer: answer, Grader: notebook.GraderDeterministic}, true
+	}
+	return GradeResult{}, false
+}
+
+// GradeWordChoiceDeterministic grades a word choice answer without a model.
+// A sentence accepts only the member it was written for. A difference
+// explanation must use a word of each member's meaning, so it at least
+// says something about both.
+func GradeWordChoiceDeterministic(card WordChoiceCard, answer string) GradeResult {
+	if result, ok := deterministicWordChoiceGrade(card, answer); ok {
+		return result
+	}
+	result := GradeResult{Correct: false, Quality: int(notebook.QualityWrong), Answer: answer, Grader: notebook.GraderDeterministic}
+	if !card.IsDifference() {
+		result.Reason = fmt.Sprintf("The sentence was written for %q: %s.", card.Expression, card.Meaning)
+		return result
+	}
+	answerWords := strings.Fields(strings.ToLower(answer))
+	if sharesMeaningWord(answerWords, card.Meaning) && sharesMeaningWord(answerWords, card.Compare.Meaning) {
+		result.Correct = true
+		result.Quality = int(notebook.QualityCorrectSlow)
+		result.Reason = fmt.Sprintf("Covers both %q and %q.", card.Expression, card.Compare.Expression)
+		return result
+	}
+	result.Reason = fmt.Sprintf("%q means %s; %q means %s.", card.Expression, card.Meaning, card.Compare.Expression, card.Compare.Meaning)
+	return result
+}
+
+// isWordChoiceMember reports whether a normalized answer names one of the
+// given forms of a member.
+func isWordChoiceMember(normalized string, forms ...string) bool {
+	for _, form := range forms {
+		if form != "" && normalized == normalizeCorrection(form) {
+			return true
+		}
+	}
+	return false
+}
+
+// minWordChoiceMeaningWord is the length a meaning word needs to be worth
+// looking for in an explanation; shorter ones are mostly function words.
+const minWordChoiceMeaningWord = 4
+
+// sharesMeaningWord reports whether answerWords contain a word of meaning,
+// or an inflection of one.
+func sharesMeaningWord(answerWords []string, meaning string) bool {
+	for _, m := range strings.FieldsFunc(strings.ToLower(meaning), isWordSeparator) {
+		if len(m) < minWordChoiceMeaningWord {
+			continue
+		}
+		for _, a := range answerWords {
+			a = strings.TrimFunc(a, isWordSeparator)
+			if a == m || (len(a) >= minWordChoiceMeaningWord && (strings.HasPrefix(a, m) || strings.HasPrefix(m, a))) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isWordSeparator(r rune) bool {
+	return !isWordChar(r)
+}
+
+// SaveWordChoiceResult records a word choice answer in the word choice
+// series of the member the card asked about.
+func (s *Service) SaveWordChoiceResult(ctx context.Context, card WordChoiceCard, result GradeResult, responseTimeMs int64) error {
+	status := "misunderstood"
+	if result.Correct {
+		status = "understood"
+	}
+	log := &learning.LearningLog{
+		Status: status, LearnedAt: time.Now(), Quality: result.Quality,
+		ResponseTimeMs: int(responseTimeMs), QuizType: string(notebook.QuizTypeWordChoice),
+		SourceNotebookID: card.NotebookName, NotebookName: card.NotebookName,
+		StoryTitle: card.StoryTitle, SceneTitle: card.SceneTitle,
+		Expression: card.Entry, OriginalExpression: card.OriginalEntry, SenseID: card.ID,
+		Answer: result.Answer, GraderReason: result.Reason, Grader: result.Grader,
+		IsCorrect: result.Correct, LearningNotesDir: s.notebooksConfig.LearningNotesDirectory,
+	}
+	if err := s.learningRepository.Create(ctx, log); err != nil {
+		return fmt.Errorf("save word choice learning log for %q: %w", card.NotebookName, err)
+	}
+	return nil
+}
+
+// CardInfoFromWordChoiceCard returns the CardInfo that addresses the member
+// a word choice card asked about, so Override and Skip reach its series.
+func CardInfoFromWordChoiceCard(card WordChoiceCard) CardInfo {
+	return CardInfo{
+		NotebookName:       card.NotebookName,
+		StoryTitle:         card.StoryTitle,
+		SceneTitle:         card.SceneTitle,
+		Expression:         card.Entry,
+		OriginalExpression: card.OriginalEntry,
+		ID:                 card.ID,
+	}
+}
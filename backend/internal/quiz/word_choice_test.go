@@ -0,0 +1,324 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/learning"
+	mock_inference "github.com/at-ishikawa/langner/internal/mocks/inference"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// newWordChoiceFixture writes a definitions book with a synonym concept, an
+// antonym concept and a family concept, and a learning history in which,
+// relative to now:
+//
+//   - "hearty" was answered correctly in the standard quiz
+//   - "cordial" has never been quizzed
+//   - "frugal" was told apart correctly today with a 30-day interval
+//   - "lavish" is skipped from the word choice quiz
+func newWordChoiceFixture(t *testing.T) (defsDir, learningDir string) {
+	t.Helper()
+	defsDir = t.TempDir()
+	learningDir = t.TempDir()
+
+	bookDir := filepath.Join(defsDir, "nuance-book")
+	require.NoError(t, os.MkdirAll(bookDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(bookDir, "index.yml"), []byte(`id: nuance-book
+notebooks:
+  - ./session1.yml
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(bookDir, "session1.yml"), []byte(`- metadata:
+    title: "Session 1"
+  scenes:
+    - metadata:
+        index: 0
+        title: "adjectives"
+      expressions:
+        - expression: "hearty"
+          meaning: "large and satisfying"
+          examples:
+            - "We had a hearty breakfast before the hike."
+        - expression: "cordial"
+          meaning: "warm and friendly"
+        - expression: "frugal"
+          meaning: "careful not to spend money"
+        - expression: "lavish"
+          meaning: "spending a great deal of money"
+        - expression: "cardiac"
+          meaning: "of the heart"
+        - expression: "cardiology"
+          meaning: "the study of the heart"
+  concepts:
+    - head: "hearty"
+      kind: synonym
+      meaning: "warm"
+      expressions:
+        - "hearty"
+        - "cordial"
+    - head: "frugal"
+      kind: antonym
+      meaning: "spending"
+      expressions:
+        - "frugal"
+        - "lavish"
+    - head: "cardiology"
+      meaning: "the heart"
+      expressions:
+        - "cardiology"
+        - "cardiac"
+`), 0o644))
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	require.NoError(t, os.WriteFile(filepath.Join(learningDir, "nuance-book.yml"), []byte(`- metadata:
+    notebook_id: nuance-book
+    title: "Session 1"
+  scenes:
+    - metadata:
+        title: "adjectives"
+      expressions:
+        - expression: "hearty"
+          learned_logs:
+            - status: "understood"
+              learned_at: "2025-01-01T00:00:00Z"
+              quality: 4
+              interval_days: 1
+              quiz_type: "notebook"
+        - expression: "frugal"
+          learned_logs:
+            - status: "understood"
+              learned_at: "2025-01-01T00:00:00Z"
+              quality: 4
+              interval_days: 1
+              quiz_type: "notebook"
+          word_choice_logs:
+            - status: "understood"
+              learned_at: "`+now+`"
+              quality: 4
+              interval_days: 30
+              quiz_type: "word_choice"
+        - expression: "lavish"
+          learned_logs:
+            - status: "understood"
+              learned_at: "2025-01-01T00:00:00Z"
+              quality: 4
+              interval_days: 1
+              quiz_type: "notebook"
+          skipped_at:
+            word_choice: "2025-01-02T00:00:00Z"
+`), 0o644))
+	return defsDir, learningDir
+}
+
+func newWordChoiceService(defsDir, learningDir string, client inference.Client) *Service {
+	return NewService(config.NotebooksConfig{
+		DefinitionsDirectories: []string{defsDir},
+		LearningNotesDirectory: learningDir,
+	}, client, make(map[string]rapidapi.Response),
+		learning.NewYAMLLearningRepository(learningDir, nil), config.QuizConfig{DisableShuffle: true})
+}
+
+func TestService_LoadWordChoiceCards(t *testing.T) {
+	defsDir, learningDir := newWordChoiceFixture(t)
+	svc := newWordChoiceService(defsDir, learningDir, nil)
+
+	t.Run("studied members only", func(t *testing.T) {
+		cards, err := svc.LoadWordChoiceCards([]string{"nuance-book"}, false, nil)
+		require.NoError(t, err)
+		require.Len(t, cards, 1)
+		got := cards[0]
+		assert.Equal(t, "hearty", got.Expression)
+		assert.Equal(t, notebook.ConceptKindSynonym, got.ConceptKind)
+		assert.Equal(t, "Session 1", got.StoryTitle)
+		assert.Equal(t, "adjectives", got.SceneTitle)
+		assert.Equal(t, "We had a ______ breakfast before the hike.", got.Sentence)
+		assert.Equal(t, "We had a hearty breakfast before the hike.", got.Example)
+		assert.False(t, got.IsDifference())
+		assert.Equal(t, []WordChoiceOption{
+			{Expression: "hearty", Meaning: "large and satisfying"},
+			{Expression: "cordial", Meaning: "warm and friendly"},
+		}, got.Options)
+	})
+
+	t.Run("unstudied members too, without skipped or not yet due ones", func(t *testing.T) {
+		cards, err := svc.LoadWordChoiceCards(nil, true, nil)
+		require.NoError(t, err)
+		var got []string
+		for _, c := range cards {
+			got = append(got, c.Expression)
+		}
+		sort.Strings(got)
+		assert.Equal(t, []string{"cordial", "hearty"}, got)
+		for _, c := range cards {
+			if c.Expression == "cordial" {
+				assert.True(t, c.IsDifference(), "cordial has no example to blank")
+				assert.Equal(t, WordChoiceOption{Expression: "hearty", Meaning: "large and satisfying"}, c.Compare)
+			}
+		}
+	})
+
+	t.Run("section filter", func(t *testing.T) {
+		cards, err := svc.LoadWordChoiceCards([]string{"nuance-book"}, true, map[string][]string{"nuance-book": {"Session 2"}})
+		require.NoError(t, err)
+		assert.Empty(t, cards)
+	})
+
+	t.Run("unknown notebook", func(t *testing.T) {
+		_, err := svc.LoadWordChoiceCards([]string{"missing"}, false, nil)
+		var notFound *NotFoundError
+		require.True(t, errors.As(err, &notFound))
+	})
+}
+
+func TestGradeWordChoiceDeterministic(t *testing.T) {
+	fit := WordChoiceCard{
+		Expression: "hearty", Entry: "hearty", Meaning: "large and satisfying",
+		Sentence: "We had a ______ breakfast.",
+	}
+	difference := WordChoiceCard{
+		Expression: "frugal", Entry: "frugal", Meaning: "careful not to spend money",
+		Compare: WordChoiceOption{Expression: "lavish", Meaning: "spending a great deal of money"},
+	}
+	tests := []struct {
+		name        string
+		card        WordChoiceCard
+		answer      string
+		wantCorrect bool
+		wantQuality notebook.Quality
+		wantReason  string
+	}{
+		{
+			name:        "expected member, ignoring case",
+			card:        fit,
+			answer:      " Hearty ",
+			wantCorrect: true,
+			wantQuality: notebook.QualityCorrect,
+			wantReason:  `"hearty" fits the sentence.`,
+		},
+		{
+			name:        "another member",
+			card:        fit,
+			answer:      "cordial",
+			wantCorrect: false,
+			wantQuality: notebook.QualityWrong,
+			wantReason:  `The sentence was written for "hearty": large and satisfying.`,
+		},
+		{
+			name:        "no answer",
+			card:        fit,
+			answer:      "",
+			wantCorrect: false,
+			wantQuality: notebook.QualityWrong,
+			wantReason:  "No answer provided.",
+		},
+		{
+			name:        "difference covering both meanings",
+			card:        difference,
+			answer:      "frugal people are careful with money, lavish ones keep spending",
+			wantCorrect: true,
+			wantQuality: notebook.QualityCorrectSlow,
+			wantReason:  `Covers both "frugal" and "lavish".`,
+		},
+		{
+			name:        "difference covering one meaning",
+			card:        difference,
+			answer:      "frugal is being careful",
+			wantCorrect: false,
+			wantQuality: notebook.QualityWrong,
+			wantReason:  `"frugal" means careful not to spend money; "lavish" means spending a great deal of money.`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GradeWordChoiceDeterministic(tt.card, tt.answer)
+			assert.Equal(t, tt.wantCorrect, got.Correct)
+			assert.Equal(t, int(tt.wantQuality), got.Quality)
+			assert.Equal(t, tt.wantReason, got.Reason)
+			assert.Equal(t, notebook.GraderDeterministic, got.Grader)
+		})
+	}
+}
+
+func TestService_GradeWordChoice(t *testing.T) {
+	card := WordChoiceCard{
+		ConceptKind: notebook.ConceptKindSynonym,
+		Expression:  "hearty", Entry: "hearty", Meaning: "large and satisfying",
+		Sentence: "We had a ______ breakfast.",
+		Options: []WordChoiceOption{
+			{Expression: "hearty", Meaning: "large and satisfying"},
+			{Expression: "cordial", Meaning: "warm and friendly"},
+		},
+	}
+
+	t.Run("expected member needs no model", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		svc := NewService(config.NotebooksConfig{}, mock_inference.NewMockClient(ctrl), nil, nil, config.QuizConfig{})
+		got := svc.GradeWordChoice(context.Background(), card, "hearty", 1000)
+		assert.True(t, got.Correct)
+		assert.Equal(t, notebook.GraderDeterministic, got.Grader)
+	})
+
+	t.Run("another member is graded by the model", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mock_inference.NewMockClient(ctrl)
+		client.EXPECT().GradeWordChoice(gomock.Any(), inference.GradeWordChoiceRequest{
+			ConceptKind:    "synonym",
+			Sentence:       "We had a ______ breakfast.",
+			Expected:       inference.WordChoiceOption{Expression: "hearty", Meaning: "large and satisfying"},
+			Others:         []inference.WordChoiceOption{{Expression: "cordial", Meaning: "warm and friendly"}},
+			UserAnswer:     "cordial",
+			ResponseTimeMs: 1000,
+		}).Return(inference.GradeWordChoiceResponse{Correct: false, Reason: "Cordial describes people.", Quality: 1}, nil)
+		svc := NewService(config.NotebooksConfig{}, client, nil, nil, config.QuizConfig{})
+
+		got := svc.GradeWordChoice(context.Background(), card, "cordial", 1000)
+		assert.Equal(t, GradeResult{Correct: false, Reason: "Cordial describes people.", Quality: 1, Answer: "cordial", Grader: notebook.GraderModel}, got)
+	})
+
+	t.Run("model failure falls back to the deterministic grade", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mock_inference.NewMockClient(ctrl)
+		client.EXPECT().GradeWordChoice(gomock.Any(), gomock.Any()).Return(inference.GradeWordChoiceResponse{}, errors.New("unavailable"))
+		svc := NewService(config.NotebooksConfig{}, client, nil, nil, config.QuizConfig{})
+
+		got := svc.GradeWordChoice(context.Background(), card, "cordial", 1000)
+		assert.False(t, got.Correct)
+		assert.Equal(t, notebook.GraderDeterministic, got.Grader)
+	})
+}
+
+func TestService_SaveWordChoiceResult(t *testing.T) {
+	learningDir := t.TempDir()
+	svc := NewService(config.NotebooksConfig{LearningNotesDirectory: learningDir}, nil, nil,
+		learning.NewYAMLLearningRepository(learningDir, nil), config.QuizConfig{})
+
+	card := WordChoiceCard{
+		NotebookName: "nuance-book",
+		StoryTitle:   "Session 1",
+		SceneTitle:   "adjectives",
+		Expression:   "hearty",
+		Entry:        "hearty",
+	}
+	require.NoError(t, svc.SaveWordChoiceResult(context.Background(), card,
+		GradeResult{Correct: true, Quality: 4, Answer: "hearty", Grader: notebook.GraderDeterministic}, 1000))
+
+	histories, err := notebook.NewLearningHistories(learningDir)
+	require.NoError(t, err)
+	expr := findStoryExpression(histories["nuance-book"], "Session 1", "adjectives", "", "hearty", "")
+	require.NotNil(t, expr)
+	require.Len(t, expr.WordChoiceLogs, 1)
+	assert.Equal(t, "word_choice", expr.WordChoiceLogs[0].QuizType)
+	assert.Empty(t, expr.LearnedLogs, "word choice answers keep their own series")
+}
@@ -0,0 +1,175 @@
+package quiz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// WorksheetItem is one question of a printed worksheet: a word whose
+// spaced-repetition track QuizType comes due before the worksheet horizon.
+//
+//	QuizTypeNotebook         cloze: Context.MaskedContext with the word blanked
+//	                         out, or, for a word without a usable sentence, a
+//	                         prompt to write its meaning
+//	QuizTypeReverse          production: the meaning, with Context as a hint
+//	                         when the word has a usable sentence
+//	QuizTypeEtymologyOrigin  matching: the word is matched against Origin
+type WorksheetItem struct {
+	QuizType notebook.QuizType
+	Card     FreeformCard
+	// Context is an example sentence with every form of the word masked by
+	// maskWord. Empty when no sentence can be shown without giving the
+	// answer away.
+	Context ReverseContext
+	// Origin is the word's primary origin, set for QuizTypeEtymologyOrigin.
+	Origin WordOriginPart
+	DueAt  time.Time
+}
+
+// worksheetQuizTypes are the learning-log series a worksheet draws from, in
+// the order the sections are printed.
+var worksheetQuizTypes = []notebook.QuizType{
+	notebook.QuizTypeNotebook,
+	notebook.QuizTypeReverse,
+	notebook.QuizTypeEtymologyOrigin,
+}
+
+// LoadWorksheetItems returns one item per studied word and quiz type that is
+// due for review by horizon, most overdue first. Words never quizzed in a
+// mode, and words excluded from it via SkipWord, are left out: a worksheet
+// only reviews what the interactive quizzes would schedule. notebookIDs
+// restricts the words to those notebooks when non-empty, and limit caps the
+// number of items when positive.
+func (s *Service) LoadWorksheetItems(horizon time.Time, notebookIDs []string, limit int) ([]WorksheetItem, error) {
+	histories, err := s.loadLearningHistories()
+	if err != nil {
+		return nil, fmt.Errorf("load learning histories: %w", err)
+	}
+	words, err := s.LoadAllWords()
+	if err != nil {
+		return nil, fmt.Errorf("load words: %w", err)
+	}
+
+	var items []WorksheetItem
+	seen := make(map[string]bool)
+	for _, card := range words {
+		if len(notebookIDs) > 0 && !slices.Contains(notebookIDs, card.NotebookName) {
+			continue
+		}
+		expr := notebook.FindExpressionInHistories(histories[card.NotebookName], card.ID, card.ConceptHead, card.Expression, card.OriginalExpression)
+		if expr == nil {
+			continue
+		}
+		for _, quizType := range worksheetQuizTypes {
+			if expr.SkippedAt.IsSkipped(quizType) {
+				continue
+			}
+			dueAt, ok := expr.ReviewDueAt(quizType)
+			if !ok || dueAt.After(horizon) {
+				continue
+			}
+			key := string(quizType) + relearnKeySep + card.NotebookName + relearnKeySep + strings.ToLower(expr.Expression) + relearnKeySep + expr.ID
+			if seen[key] {
+				continue
+			}
+
+			item := WorksheetItem{QuizType: quizType, Card: card, DueAt: dueAt}
+			if quizType == notebook.QuizTypeEtymologyOrigin {
+				origin, ok := primaryOriginPart(card)
+				if !ok {
+					continue
+				}
+				item.Origin = origin
+			} else {
+				item.Context, _ = worksheetContext(card)
+			}
+			seen[key] = true
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if !items[i].DueAt.Equal(items[j].DueAt) {
+			return items[i].DueAt.Before(items[j].DueAt)
+		}
+		return strings.ToLower(items[i].Card.Expression) < strings.ToLower(items[j].Card.Expression)
+	})
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+// worksheetContext picks the first sentence of the card that still reads
+// without the answer once the word is masked.
+func worksheetContext(card FreeformCard) (ReverseContext, bool) {
+	altForm := ""
+	if !strings.EqualFold(card.OriginalExpression, card.Expression) {
+		altForm = card.OriginalExpression
+	}
+	for _, c := range card.Contexts {
+		if rc, ok := reverseHintContext(c.Context, card.Expression, altForm, ""); ok && rc.MaskedContext != rc.Context {
+			return rc, true
+		}
+	}
+	for _, ex := range card.Examples {
+		if rc, ok := reverseHintContext(ex.Text, card.Expression, altForm, ex.Highlight); ok && rc.MaskedContext != rc.Context {
+			return rc, true
+		}
+	}
+	return ReverseContext{}, false
+}
+
+// SaveWorksheetResult records the result of a worksheet item graded on paper
+// in the learning-log series of its quiz type. There is no response time for
+// a paper answer, so a correct answer is recorded with QualityCorrect. The
+// learner marks the paper, so the log's grader is an override.
+func (s *Service) SaveWorksheetResult(ctx context.Context, item WorksheetItem, correct bool) error {
+	result := GradeResult{Correct: correct, Quality: int(notebook.QualityWrong), Reason: "Marked on paper.", Grader: notebook.GraderOverride}
+	if correct {
+		result.Quality = int(notebook.QualityCorrect)
+	}
+
+	card := item.Card
+	switch item.QuizType {
+	case notebook.QuizTypeNotebook:
+		return s.SaveResult(ctx, worksheetVocabCard(card), result, 0)
+	case notebook.QuizTypeReverse:
+		return s.SaveReverseResult(ctx, ReverseCard{
+			ID:           card.ID,
+			NotebookName: card.NotebookName,
+			StoryTitle:   card.StoryTitle,
+			SceneTitle:   card.SceneTitle,
+			Expression:   card.Expression,
+			Meaning:      card.Meaning,
+			ConceptHead:  card.ConceptHead,
+		}, result, 0)
+	case notebook.QuizTypeEtymologyOrigin:
+		return s.SaveEtymologyOriginResult(ctx, worksheetVocabCard(card), item.Origin.Origin, "", result, 0)
+	default:
+		return fmt.Errorf("unsupported worksheet quiz type %q", item.QuizType)
+	}
+}
+
+func worksheetVocabCard(card FreeformCard) Card {
+	originalEntry := card.OriginalExpression
+	if strings.EqualFold(originalEntry, card.Expression) {
+		originalEntry = ""
+	}
+	return Card{
+		ID:            card.ID,
+		NotebookName:  card.NotebookName,
+		StoryTitle:    card.StoryTitle,
+		SceneTitle:    card.SceneTitle,
+		Entry:         card.Expression,
+		OriginalEntry: originalEntry,
+		Meaning:       card.Meaning,
+		ConceptHead:   card.ConceptHead,
+	}
+}
@@ -0,0 +1,261 @@
+package quiz
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// newWorksheetFixture writes a flashcard notebook with five words, an
+// etymology notebook defining the origin one of them comes from, and a
+// learning history in which, relative to now:
+//
+//   - "ephemeral" was answered 6 days ago with a 7-day interval
+//   - "resilient" was answered today with a 30-day interval
+//   - "benevolent" has never been quizzed
+//   - "candid" is due in both directions but excluded from recognition
+//   - "benediction" is overdue for its etymology origin
+func newWorksheetFixture(t *testing.T) *Service {
+	t.Helper()
+	flashcardsDir := t.TempDir()
+	etymologyDir := t.TempDir()
+	learningDir := t.TempDir()
+
+	notebookDir := filepath.Join(flashcardsDir, "vocab")
+	require.NoError(t, os.MkdirAll(notebookDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "index.yml"), []byte(
+		"id: vocab\nname: Vocabulary\nnotebooks:\n  - ./cards.yml\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(notebookDir, "cards.yml"), []byte(`- title: "Flashcards"
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: ephemeral
+      meaning: lasting a very short time
+      examples:
+        - Fame is ephemeral.
+    - expression: resilient
+      meaning: able to recover quickly
+    - expression: benevolent
+      meaning: well meaning and kindly
+    - expression: candid
+      meaning: truthful and straightforward
+      examples:
+        - Let me be candid with you.
+    - expression: benediction
+      meaning: a blessing
+      origin_parts:
+        - origin: bene
+          language: Latin
+`), 0o644))
+
+	etymNotebookDir := filepath.Join(etymologyDir, "roots")
+	require.NoError(t, os.MkdirAll(etymNotebookDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(etymNotebookDir, "index.yml"), []byte(
+		"id: roots\nkind: Etymology\nname: Roots\nnotebooks:\n  - ./session1.yml\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(etymNotebookDir, "session1.yml"), []byte(`metadata:
+  title: "Session 1"
+origins:
+  - origin: bene
+    type: root
+    language: Latin
+    meaning: well
+`), 0o644))
+
+	now := time.Now()
+	daysAgo := func(days int) string { return now.AddDate(0, 0, -days).Format(time.RFC3339) }
+	require.NoError(t, os.WriteFile(filepath.Join(learningDir, "vocab.yml"), []byte(`- metadata:
+    id: vocab
+    title: flashcards
+    type: flashcard
+  expressions:
+    - expression: ephemeral
+      learned_logs:
+        - status: understood
+          learned_at: "`+daysAgo(6)+`"
+          interval_days: 7
+    - expression: resilient
+      learned_logs:
+        - status: understood
+          learned_at: "`+daysAgo(0)+`"
+          interval_days: 30
+    - expression: candid
+      learned_logs:
+        - status: misunderstood
+          learned_at: "`+daysAgo(1)+`"
+      reverse_logs:
+        - status: misunderstood
+          learned_at: "`+daysAgo(1)+`"
+      skipped_at:
+        notebook: "2025-01-01"
+    - expression: benediction
+      etymology_origin_logs:
+        - status: understood
+          learned_at: "`+daysAgo(20)+`"
+          interval_days: 10
+`), 0o644))
+
+	return NewService(config.NotebooksConfig{
+		FlashcardsDirectories:  []string{flashcardsDir},
+		EtymologyDirectories:   []string{etymologyDir},
+		LearningNotesDirectory: learningDir,
+	}, nil, nil, learning.NewYAMLLearningRepository(learningDir, nil), config.QuizConfig{})
+}
+
+func TestService_LoadWorksheetItems(t *testing.T) {
+	type wantItem struct {
+		quizType   notebook.QuizType
+		expression string
+	}
+	tests := []struct {
+		name        string
+		days        int
+		notebookIDs []string
+		limit       int
+		want        []wantItem
+	}{
+		{
+			name: "due today",
+			days: 0,
+			want: []wantItem{
+				{notebook.QuizTypeEtymologyOrigin, "benediction"},
+				{notebook.QuizTypeReverse, "candid"},
+			},
+		},
+		{
+			name: "due within a week, most overdue first",
+			days: 7,
+			want: []wantItem{
+				{notebook.QuizTypeEtymologyOrigin, "benediction"},
+				{notebook.QuizTypeReverse, "candid"},
+				{notebook.QuizTypeNotebook, "ephemeral"},
+			},
+		},
+		{
+			name:  "limit",
+			days:  7,
+			limit: 1,
+			want: []wantItem{
+				{notebook.QuizTypeEtymologyOrigin, "benediction"},
+			},
+		},
+		{
+			name:        "other notebook",
+			days:        7,
+			notebookIDs: []string{"other"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newWorksheetFixture(t)
+			items, err := svc.LoadWorksheetItems(time.Now().AddDate(0, 0, tt.days), tt.notebookIDs, tt.limit)
+			require.NoError(t, err)
+
+			var got []wantItem
+			for _, item := range items {
+				got = append(got, wantItem{item.QuizType, item.Card.Expression})
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_LoadWorksheetItems_Prompts(t *testing.T) {
+	svc := newWorksheetFixture(t)
+	items, err := svc.LoadWorksheetItems(time.Now().AddDate(0, 0, 7), nil, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	byExpression := make(map[string]WorksheetItem)
+	for _, item := range items {
+		byExpression[item.Card.Expression] = item
+	}
+	assert.Equal(t, ReverseContext{Context: "Fame is ephemeral.", MaskedContext: "Fame is ______."}, byExpression["ephemeral"].Context)
+	assert.Equal(t, ReverseContext{Context: "Let me be candid with you.", MaskedContext: "Let me be ______ with you."}, byExpression["candid"].Context)
+	assert.Equal(t, "bene", byExpression["benediction"].Origin.Origin)
+	assert.Equal(t, "well", byExpression["benediction"].Origin.Meaning)
+}
+
+func TestWorksheetContext(t *testing.T) {
+	tests := []struct {
+		name   string
+		card   FreeformCard
+		want   ReverseContext
+		wantOK bool
+	}{
+		{
+			name: "masks the original expression",
+			card: FreeformCard{
+				Expression:         "break the ice",
+				OriginalExpression: "broke the ice",
+				Contexts:           []inference.Context{{Context: "He broke the ice with a joke."}},
+			},
+			want:   ReverseContext{Context: "He broke the ice with a joke.", MaskedContext: "He ______ with a joke."},
+			wantOK: true,
+		},
+		{
+			name: "skips sentences that would give the answer away",
+			card: FreeformCard{
+				Expression: "evict",
+				Contexts:   []inference.Context{{Context: "They were evicted."}},
+				Examples:   []Example{{Text: "The landlord evicted them.", Highlight: "evicted"}},
+			},
+			want:   ReverseContext{Context: "The landlord evicted them.", MaskedContext: "The landlord ______ them."},
+			wantOK: true,
+		},
+		{
+			name: "no sentence",
+			card: FreeformCard{Expression: "candid"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := worksheetContext(tt.card)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_SaveWorksheetResult(t *testing.T) {
+	svc := newWorksheetFixture(t)
+	items, err := svc.LoadWorksheetItems(time.Now().AddDate(0, 0, 7), nil, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	ctx := context.Background()
+	for _, item := range items {
+		require.NoError(t, svc.SaveWorksheetResult(ctx, item, item.Card.Expression != "candid"))
+	}
+
+	histories, err := svc.loadLearningHistories()
+	require.NoError(t, err)
+	find := func(expression string) *notebook.LearningHistoryExpression {
+		expr := notebook.FindExpressionInHistories(histories["vocab"], "", expression)
+		require.NotNil(t, expr, expression)
+		return expr
+	}
+
+	ephemeral := find("ephemeral").LearnedLogs
+	require.Len(t, ephemeral, 2)
+	assert.Equal(t, int(notebook.QualityCorrect), ephemeral[0].Quality)
+	assert.Equal(t, string(notebook.QuizTypeNotebook), ephemeral[0].QuizType)
+
+	candid := find("candid").ReverseLogs
+	require.Len(t, candid, 2)
+	assert.Equal(t, notebook.LearnedStatusMisunderstood, candid[0].Status)
+	assert.Equal(t, int(notebook.QualityWrong), candid[0].Quality)
+
+	benediction := find("benediction").EtymologyOriginLogs
+	require.Len(t, benediction, 2)
+	assert.Equal(t, int(notebook.QualityCorrect), benediction[0].Quality)
+	assert.Equal(t, string(notebook.QuizTypeEtymologyOrigin), benediction[0].QuizType)
+}
@@ -29,8 +29,9 @@ import (
 // the user can drill exactly the words / origins they got wrong that
 // day without re-reading the entire notebook.
 type Writer struct {
-	repo   analytics.Repository
-	source SourceContent
+	repo       analytics.Repository
+	source     SourceContent
+	pdfOptions pdf.Options
 }
 
 // SourceContent supplies the per-session source-notebook content the
@@ -128,6 +129,11 @@ func NewWriterWithSource(repo analytics.Repository, source SourceContent) *Write
 	return &Writer{repo: repo, source: source}
 }
 
+// SetPDFOptions sets the fonts used when Output generates a PDF.
+func (w *Writer) SetPDFOptions(opts pdf.Options) {
+	w.pdfOptions = opts
+}
+
 // NewReaderSource wraps a notebook.Reader as a SourceContent so the
 // CLI can hand the writer everything it needs in one shot. nil reader
 // yields an empty source (no conversations, no concepts).
@@ -303,7 +309,7 @@ func (w *Writer) Output(ctx context.Context, day time.Time, outputDirectory stri
 		return "", fmt.Errorf("write %s: %w", filename, err)
 	}
 	if generatePDF {
-		if _, err := pdf.ConvertMarkdownToPDF(filename); err != nil {
+		if _, err := pdf.ConvertMarkdownToPDF(filename, w.pdfOptions); err != nil {
 			return filename, fmt.Errorf("ConvertMarkdownToPDF(%s): %w", filename, err)
 		}
 	}
@@ -1079,6 +1085,9 @@ func totalEntriesAcross(groups []quizReviewGroup) int {
 // bolded side together tell the reader what they missed without
 // adding a separate label to scan past.
 //
+// The learner's recorded answer, when there is one, is struck through
+// under the entry so the sheet shows what they confused it with.
+//
 // suppressExample skips the Example: line when the session already
 // rendered conversations (the quote was bolded inside the dialogue).
 //
@@ -1095,6 +1104,9 @@ func writeEntry(sb *strings.Builder, w analytics.WrongWord, suppressExample bool
 		meaning = "**" + meaning + "**"
 	}
 	fmt.Fprintf(sb, "- %s: %s\n", expression, meaning)
+	if answer := strings.TrimSpace(w.Answer); answer != "" {
+		fmt.Fprintf(sb, "    - Your answer: ~~%s~~\n", answer)
+	}
 	if !suppressExample && w.ExampleSentence != "" {
 		fmt.Fprintf(sb, "    - Example: *%s*\n", w.ExampleSentence)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -67,6 +68,18 @@ func (s *stubRepo) Trends(context.Context, analytics.TrendsQuery) (analytics.Tre
 	return analytics.TrendsResult{}, nil
 }
 
+func (s *stubRepo) Leeches(context.Context, analytics.LeechQuery) ([]analytics.Leech, error) {
+	return nil, nil
+}
+
+func (s *stubRepo) Confusions(context.Context, analytics.ConfusionQuery) ([]analytics.Confusion, error) {
+	return nil, nil
+}
+
+func (s *stubRepo) Coverage(context.Context, analytics.CoverageQuery) (analytics.Coverage, error) {
+	return analytics.Coverage{}, nil
+}
+
 func TestWriter_SingleFileWithEveryNotebook(t *testing.T) {
 	day, _ := time.Parse("2006-01-02", "2026-06-16")
 	repo := &stubRepo{
@@ -482,6 +495,39 @@ func TestWriter_PreservesExampleWhenNoConversation(t *testing.T) {
 		"Example: line stays when the source has no conversations — it's the only carrier of the usage")
 }
 
+func TestWriter_ShowsRecordedAnswer(t *testing.T) {
+	day, _ := time.Parse("2006-01-02", "2026-06-17")
+	repo := &stubRepo{
+		detail: analytics.DayDetail{
+			WrongWords: []analytics.WrongWord{
+				{
+					NotebookID:    "wpme",
+					NotebookTitle: "Session 5",
+					Expression:    "geriatrics",
+					QuizType:      "reverse",
+					Meaning:       "medicine of the elderly",
+					Answer:        "pediatrics",
+				},
+				{
+					NotebookID:    "wpme",
+					NotebookTitle: "Session 5",
+					Expression:    "obstetrics",
+					QuizType:      "reverse",
+					Meaning:       "medicine of childbirth",
+				},
+			},
+		},
+	}
+	writer := NewWriterWithSource(repo, &stubSource{})
+	written, err := writer.Output(context.Background(), day, t.TempDir(), false)
+	require.NoError(t, err)
+	body, err := os.ReadFile(written)
+	require.NoError(t, err)
+	out := string(body)
+	assert.Contains(t, out, "- **geriatrics**: medicine of the elderly\n    - Your answer: ~~pediatrics~~\n")
+	assert.Equal(t, 1, strings.Count(out, "Your answer:"), "attempts without a recorded answer show no answer line")
+}
+
 // TestWriter_RendersEtymologyConceptsWithHighlight pins the etymology
 // context block: when a session has concepts/relations, they render as
 // a table next to the failure list, and members whose origin name
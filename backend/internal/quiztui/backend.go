@@ -0,0 +1,149 @@
+// Package quiztui is the full-screen terminal front end for every quiz
+// mode. The screens talk to a Backend rather than to quiz.Service directly,
+// so the same UI can drive a local service or a remote server.
+package quiztui
+
+import (
+	"context"
+	"time"
+)
+
+// Mode is a quiz mode offered on the start screen.
+type Mode string
+
+const (
+	ModeRecognition Mode = "recognition"
+	ModeReverse     Mode = "reverse"
+	ModeFreeform    Mode = "freeform"
+	ModeEtymology   Mode = "etymology"
+	ModeGrammar     Mode = "grammar"
+	ModeRelearn     Mode = "relearn"
+)
+
+// Modes lists the modes in start-screen order.
+var Modes = []Mode{ModeRecognition, ModeReverse, ModeFreeform, ModeEtymology, ModeGrammar, ModeRelearn}
+
+var modeTitles = map[Mode]string{
+	ModeRecognition: "Recognition",
+	ModeReverse:     "Reverse",
+	ModeFreeform:    "Freeform",
+	ModeEtymology:   "Etymology origins",
+	ModeGrammar:     "Grammar",
+	ModeRelearn:     "Relearn",
+}
+
+var modeDescriptions = map[Mode]string{
+	ModeRecognition: "see a word, type its meaning",
+	ModeReverse:     "see a meaning, type the word",
+	ModeFreeform:    "recall any word and its meaning",
+	ModeEtymology:   "type the meanings of the words built on an origin",
+	ModeGrammar:     "correct the mistakes in your journal entries",
+	ModeRelearn:     "re-drill words missed in the last day (nothing is recorded)",
+}
+
+// Title is the mode's display name.
+func (m Mode) Title() string {
+	return modeTitles[m]
+}
+
+// Description is a one-line explanation of the mode.
+func (m Mode) Description() string {
+	return modeDescriptions[m]
+}
+
+// selectsNotebooks reports whether the mode starts from a notebook picker.
+// Freeform draws from every notebook and relearn from recent mistakes.
+func (m Mode) selectsNotebooks() bool {
+	return m != ModeFreeform && m != ModeRelearn
+}
+
+// Notebook is a notebook offered by the picker, with the number of items
+// due in the selected mode.
+type Notebook struct {
+	ID       string
+	Name     string
+	Due      int
+	Sections []Section
+}
+
+// Section is a story event, book session or journal entry of a notebook.
+type Section struct {
+	Title string
+	Due   int
+}
+
+// Selection is a picked notebook; empty Sections means the whole notebook.
+type Selection struct {
+	NotebookID string
+	Sections   []string
+}
+
+// Question is one prompt of a session.
+type Question struct {
+	// ID identifies the question to the backend that issued it.
+	ID int64
+	// Heading locates the prompt: notebook, story and scene, or an origin.
+	Heading string
+	// Prompt is the word, meaning or mistaken span being asked about.
+	Prompt string
+	// Context holds supporting lines: examples, masked contexts or the
+	// journal entry a mistake appears in.
+	Context []string
+	// Fields labels the inputs to fill in; most modes have one.
+	Fields []string
+	// Repeat keeps the question in place after it's answered, for the
+	// open-ended freeform mode.
+	Repeat bool
+}
+
+// Result is the graded outcome of one answer.
+type Result struct {
+	Correct bool
+	// Expected is the reference answer shown in feedback.
+	Expected string
+	Reason   string
+	// NextReviewDate and LearnedAt identify the recorded log; LearnedAt is
+	// what Override and Undo target.
+	NextReviewDate string
+	LearnedAt      string
+	// Recorded is false when nothing was written (relearn answers, an
+	// unmatched freeform word), so there is nothing to override or skip.
+	Recorded bool
+	// Override holds the pre-override log while an override is in effect.
+	Override *Override
+	// Excluded is true once the word has been skipped from the mode.
+	Excluded bool
+
+	// Ref is the backend's handle on the recorded item.
+	Ref any
+}
+
+// Override is the log as it was before an override, kept so it can be
+// restored by UndoOverride.
+type Override struct {
+	OriginalQuality      int
+	OriginalStatus       string
+	OriginalIntervalDays int
+	OriginalGrader       string
+}
+
+// Backend loads, grades and records quiz sessions.
+type Backend interface {
+	// Notebooks lists the notebooks the mode can draw from.
+	Notebooks(ctx context.Context, mode Mode) ([]Notebook, error)
+	// Start loads the questions of a session. selections is empty for
+	// modes that don't pick notebooks.
+	Start(ctx context.Context, mode Mode, selections []Selection) ([]Question, error)
+	// Submit grades and records an answer. An empty answer is recorded as
+	// "don't know".
+	Submit(ctx context.Context, question Question, answers []string, responseTime time.Duration) (Result, error)
+	// Override flips a recorded result between correct and incorrect and
+	// returns the updated result.
+	Override(ctx context.Context, result Result) (Result, error)
+	// UndoOverride restores the log an Override changed.
+	UndoOverride(ctx context.Context, result Result) (Result, error)
+	// Exclude skips the word from the mode until Resume is called.
+	Exclude(ctx context.Context, result Result) (Result, error)
+	// Resume reverses Exclude.
+	Resume(ctx context.Context, result Result) (Result, error)
+}
@@ -0,0 +1,710 @@
+package quiztui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/tui"
+)
+
+// DefaultBatchSize is how many answers are collected before the feedback
+// screen, matching the web UI's batches.
+const DefaultBatchSize = 10
+
+type screen int
+
+const (
+	screenModes screen = iota
+	screenLoading
+	screenNotebooks
+	screenQuestion
+	screenFeedback
+	screenDone
+)
+
+// App is the quiz TUI model: mode picker, notebook and section picker,
+// question screen and batch feedback screen.
+type App struct {
+	ctx       context.Context
+	backend   Backend
+	batchSize int
+	now       func() time.Time
+
+	screen  screen
+	status  string
+	err     error
+	loading string
+
+	modeCursor int
+	mode       Mode
+
+	notebooks         []Notebook
+	expanded          map[string]bool
+	selectedNotebooks map[string]bool
+	selectedSections  map[string]map[string]bool
+	pickerCursor      int
+
+	questions []Question
+	current   int
+	inputs    []tui.TextInput
+	field     int
+	shownAt   time.Time
+	ended     bool
+
+	answers        []*answer
+	batchStart     int
+	feedbackCursor int
+	busy           bool
+}
+
+// answer is one submitted answer and, once graded, its result.
+type answer struct {
+	question Question
+	values   []string
+	result   Result
+	pending  bool
+	err      error
+}
+
+type notebooksLoadedMsg struct {
+	notebooks []Notebook
+	err       error
+}
+
+type questionsLoadedMsg struct {
+	questions []Question
+	err       error
+}
+
+type gradedMsg struct {
+	index  int
+	result Result
+	err    error
+}
+
+type actionDoneMsg struct {
+	index  int
+	result Result
+	err    error
+}
+
+// NewApp returns the quiz TUI over backend.
+func NewApp(ctx context.Context, backend Backend) *App {
+	return &App{
+		ctx:       ctx,
+		backend:   backend,
+		batchSize: DefaultBatchSize,
+		now:       time.Now,
+	}
+}
+
+// WithBatchSize returns a copy that shows feedback every size answers.
+func (m *App) WithBatchSize(size int) *App {
+	copied := *m
+	if size > 0 {
+		copied.batchSize = size
+	}
+	return &copied
+}
+
+// Init implements tui.Model.
+func (m *App) Init() tui.Cmd {
+	return nil
+}
+
+// Update implements tui.Model.
+func (m *App) Update(msg tui.Msg) (tui.Model, tui.Cmd) {
+	switch msg := msg.(type) {
+	case tui.KeyMsg:
+		if msg.Type == tui.KeyCtrlC {
+			return m, tui.Quit
+		}
+		m.status = ""
+		switch m.screen {
+		case screenModes:
+			return m.updateModes(msg)
+		case screenNotebooks:
+			return m.updateNotebooks(msg)
+		case screenQuestion:
+			return m.updateQuestion(msg)
+		case screenFeedback:
+			return m.updateFeedback(msg)
+		case screenDone:
+			return m.updateDone(msg)
+		}
+	case notebooksLoadedMsg:
+		if msg.err != nil {
+			return m.backToModes(msg.err, "")
+		}
+		if len(msg.notebooks) == 0 {
+			return m.backToModes(nil, fmt.Sprintf("Nothing is due in %s.", m.mode.Title()))
+		}
+		m.notebooks = msg.notebooks
+		m.expanded = make(map[string]bool)
+		m.selectedNotebooks = make(map[string]bool)
+		m.selectedSections = make(map[string]map[string]bool)
+		m.pickerCursor = 0
+		m.screen = screenNotebooks
+	case questionsLoadedMsg:
+		if msg.err != nil {
+			return m.backToModes(msg.err, "")
+		}
+		if len(msg.questions) == 0 {
+			return m.backToModes(nil, fmt.Sprintf("Nothing is due in %s.", m.mode.Title()))
+		}
+		m.questions = msg.questions
+		m.current = 0
+		m.answers = nil
+		m.batchStart = 0
+		m.ended = false
+		m.showQuestion()
+	case gradedMsg:
+		a := m.answers[msg.index]
+		a.pending = false
+		a.result = msg.result
+		a.err = msg.err
+	case actionDoneMsg:
+		m.busy = false
+		if msg.err != nil {
+			m.err = msg.err
+		} else {
+			m.answers[msg.index].result = msg.result
+		}
+	}
+	return m, nil
+}
+
+func (m *App) backToModes(err error, status string) (tui.Model, tui.Cmd) {
+	m.screen = screenModes
+	m.err = err
+	m.status = status
+	return m, nil
+}
+
+func (m *App) updateModes(key tui.KeyMsg) (tui.Model, tui.Cmd) {
+	switch key.String() {
+	case "up", "k":
+		if m.modeCursor > 0 {
+			m.modeCursor--
+		}
+	case "down", "j":
+		if m.modeCursor < len(Modes)-1 {
+			m.modeCursor++
+		}
+	case "enter":
+		m.mode = Modes[m.modeCursor]
+		m.err = nil
+		m.screen = screenLoading
+		if m.mode.selectsNotebooks() {
+			m.loading = "Loading notebooks…"
+			mode := m.mode
+			return m, func() tui.Msg {
+				notebooks, err := m.backend.Notebooks(m.ctx, mode)
+				return notebooksLoadedMsg{notebooks: notebooks, err: err}
+			}
+		}
+		return m, m.start(nil)
+	case "q", "esc":
+		return m, tui.Quit
+	}
+	return m, nil
+}
+
+func (m *App) start(selections []Selection) tui.Cmd {
+	m.screen = screenLoading
+	m.loading = "Loading questions…"
+	mode := m.mode
+	return func() tui.Msg {
+		questions, err := m.backend.Start(m.ctx, mode, selections)
+		return questionsLoadedMsg{questions: questions, err: err}
+	}
+}
+
+// pickerRow is a notebook (section < 0) or one of its sections.
+type pickerRow struct {
+	notebook int
+	section  int
+}
+
+func (m *App) pickerRows() []pickerRow {
+	var rows []pickerRow
+	for i, nb := range m.notebooks {
+		rows = append(rows, pickerRow{notebook: i, section: -1})
+		if m.expanded[nb.ID] {
+			for j := range nb.Sections {
+				rows = append(rows, pickerRow{notebook: i, section: j})
+			}
+		}
+	}
+	return rows
+}
+
+func (m *App) updateNotebooks(key tui.KeyMsg) (tui.Model, tui.Cmd) {
+	rows := m.pickerRows()
+	row := rows[m.pickerCursor]
+	nb := m.notebooks[row.notebook]
+	switch key.String() {
+	case "up", "k":
+		if m.pickerCursor > 0 {
+			m.pickerCursor--
+		}
+	case "down", "j":
+		if m.pickerCursor < len(rows)-1 {
+			m.pickerCursor++
+		}
+	case "right", "l":
+		if len(nb.Sections) > 0 {
+			m.expanded[nb.ID] = true
+		}
+	case "left", "h":
+		if m.expanded[nb.ID] {
+			m.expanded[nb.ID] = false
+			m.pickerCursor = m.rowIndex(row.notebook)
+		}
+	case " ":
+		if row.section < 0 {
+			m.selectedNotebooks[nb.ID] = !m.selectedNotebooks[nb.ID]
+			delete(m.selectedSections, nb.ID)
+		} else {
+			title := nb.Sections[row.section].Title
+			if m.selectedSections[nb.ID] == nil {
+				m.selectedSections[nb.ID] = make(map[string]bool)
+			}
+			m.selectedSections[nb.ID][title] = !m.selectedSections[nb.ID][title]
+			m.selectedNotebooks[nb.ID] = false
+		}
+	case "a":
+		all := !m.allSelected()
+		m.selectedSections = make(map[string]map[string]bool)
+		for _, nb := range m.notebooks {
+			m.selectedNotebooks[nb.ID] = all
+		}
+	case "enter":
+		selections := m.selections()
+		if len(selections) == 0 {
+			selections = []Selection{{NotebookID: nb.ID}}
+			if row.section >= 0 {
+				selections[0].Sections = []string{nb.Sections[row.section].Title}
+			}
+		}
+		return m, m.start(selections)
+	case "esc":
+		m.screen = screenModes
+	case "q":
+		return m, tui.Quit
+	}
+	return m, nil
+}
+
+// rowIndex returns the picker row of notebook i.
+func (m *App) rowIndex(i int) int {
+	for idx, row := range m.pickerRows() {
+		if row.notebook == i && row.section < 0 {
+			return idx
+		}
+	}
+	return 0
+}
+
+func (m *App) allSelected() bool {
+	for _, nb := range m.notebooks {
+		if !m.selectedNotebooks[nb.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+// selections lists the picked notebooks in picker order; a notebook with
+// only some sections picked is narrowed to them.
+func (m *App) selections() []Selection {
+	var selections []Selection
+	for _, nb := range m.notebooks {
+		if m.selectedNotebooks[nb.ID] {
+			selections = append(selections, Selection{NotebookID: nb.ID})
+			continue
+		}
+		var titles []string
+		for _, sec := range nb.Sections {
+			if m.selectedSections[nb.ID][sec.Title] {
+				titles = append(titles, sec.Title)
+			}
+		}
+		if len(titles) > 0 {
+			selections = append(selections, Selection{NotebookID: nb.ID, Sections: titles})
+		}
+	}
+	return selections
+}
+
+func (m *App) showQuestion() {
+	q := m.questions[m.current]
+	m.inputs = make([]tui.TextInput, len(q.Fields))
+	m.field = 0
+	m.shownAt = m.now()
+	m.screen = screenQuestion
+}
+
+func (m *App) updateQuestion(key tui.KeyMsg) (tui.Model, tui.Cmd) {
+	q := m.questions[m.current]
+	switch key.Type {
+	case tui.KeyTab, tui.KeyDown:
+		m.field = (m.field + 1) % len(m.inputs)
+	case tui.KeyShiftTab, tui.KeyUp:
+		m.field = (m.field + len(m.inputs) - 1) % len(m.inputs)
+	case tui.KeyEscape:
+		m.ended = true
+		if len(m.answers) > m.batchStart {
+			m.openFeedback()
+		} else {
+			m.screen = screenDone
+		}
+	case tui.KeyEnter:
+		if m.field < len(m.inputs)-1 {
+			m.field++
+			return m, nil
+		}
+		return m, m.submit(q)
+	default:
+		m.inputs[m.field] = m.inputs[m.field].Update(key)
+	}
+	return m, nil
+}
+
+// submit records the answer, grades it in the background and moves on,
+// so the learner isn't kept waiting on the grader.
+func (m *App) submit(q Question) tui.Cmd {
+	values := make([]string, len(m.inputs))
+	for i, input := range m.inputs {
+		values[i] = input.Value()
+	}
+	if q.Repeat && strings.TrimSpace(values[0]) == "" {
+		m.status = "Type a word first."
+		return nil
+	}
+	elapsed := m.now().Sub(m.shownAt)
+	index := len(m.answers)
+	m.answers = append(m.answers, &answer{question: q, values: values, pending: true})
+	cmd := func() tui.Msg {
+		result, err := m.backend.Submit(m.ctx, q, values, elapsed)
+		return gradedMsg{index: index, result: result, err: err}
+	}
+
+	if !q.Repeat {
+		m.current++
+	}
+	if len(m.answers)-m.batchStart >= m.batchSize || m.current >= len(m.questions) {
+		m.openFeedback()
+	} else {
+		m.showQuestion()
+	}
+	return cmd
+}
+
+func (m *App) openFeedback() {
+	m.feedbackCursor = m.batchStart
+	m.screen = screenFeedback
+}
+
+func (m *App) pendingCount() int {
+	count := 0
+	for _, a := range m.answers {
+		if a.pending {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *App) updateFeedback(key tui.KeyMsg) (tui.Model, tui.Cmd) {
+	a := m.answers[m.feedbackCursor]
+	switch key.String() {
+	case "up", "k":
+		if m.feedbackCursor > m.batchStart {
+			m.feedbackCursor--
+		}
+	case "down", "j":
+		if m.feedbackCursor < len(m.answers)-1 {
+			m.feedbackCursor++
+		}
+	case "o", "u":
+		if !m.actionable(a) {
+			return m, nil
+		}
+		undo := a.result.Override != nil
+		if key.String() == "u" && !undo {
+			m.status = "Nothing to undo."
+			return m, nil
+		}
+		return m, m.action(m.feedbackCursor, func(r Result) (Result, error) {
+			if undo {
+				return m.backend.UndoOverride(m.ctx, r)
+			}
+			return m.backend.Override(m.ctx, r)
+		})
+	case "x":
+		if !m.actionable(a) {
+			return m, nil
+		}
+		resume := a.result.Excluded
+		return m, m.action(m.feedbackCursor, func(r Result) (Result, error) {
+			if resume {
+				return m.backend.Resume(m.ctx, r)
+			}
+			return m.backend.Exclude(m.ctx, r)
+		})
+	case "enter":
+		m.batchStart = len(m.answers)
+		if m.ended || m.current >= len(m.questions) {
+			m.screen = screenDone
+		} else {
+			m.showQuestion()
+		}
+	case "q":
+		if n := m.pendingCount(); n > 0 {
+			m.status = fmt.Sprintf("Still grading %d answer(s); ctrl+c quits without waiting.", n)
+			return m, nil
+		}
+		return m, tui.Quit
+	}
+	return m, nil
+}
+
+// actionable reports whether override/exclude can run on a, setting the
+// status line when it can't.
+func (m *App) actionable(a *answer) bool {
+	switch {
+	case m.busy:
+		m.status = "Please wait…"
+	case a.pending:
+		m.status = "Still grading this answer."
+	case a.err != nil || !a.result.Recorded:
+		m.status = "This answer wasn't recorded."
+	default:
+		return true
+	}
+	return false
+}
+
+func (m *App) action(index int, run func(Result) (Result, error)) tui.Cmd {
+	m.busy = true
+	m.err = nil
+	result := m.answers[index].result
+	return func() tui.Msg {
+		updated, err := run(result)
+		return actionDoneMsg{index: index, result: updated, err: err}
+	}
+}
+
+func (m *App) updateDone(key tui.KeyMsg) (tui.Model, tui.Cmd) {
+	switch key.String() {
+	case "enter":
+		m.screen = screenModes
+	case "q", "esc":
+		if n := m.pendingCount(); n > 0 {
+			m.status = fmt.Sprintf("Still grading %d answer(s); ctrl+c quits without waiting.", n)
+			return m, nil
+		}
+		return m, tui.Quit
+	}
+	return m, nil
+}
+
+// View implements tui.Model.
+func (m *App) View() string {
+	var b strings.Builder
+	title := "langner quiz"
+	if m.screen != screenModes && m.mode != "" {
+		title += " · " + m.mode.Title()
+	}
+	b.WriteString(tui.Bold(title) + "\n\n")
+
+	var hints string
+	switch m.screen {
+	case screenModes:
+		hints = m.viewModes(&b)
+	case screenLoading:
+		b.WriteString(m.loading + "\n")
+		hints = "ctrl+c quit"
+	case screenNotebooks:
+		hints = m.viewNotebooks(&b)
+	case screenQuestion:
+		hints = m.viewQuestion(&b)
+	case screenFeedback:
+		hints = m.viewFeedback(&b)
+	case screenDone:
+		hints = m.viewDone(&b)
+	}
+
+	b.WriteString("\n")
+	if m.status != "" {
+		b.WriteString(tui.Yellow(m.status) + "\n")
+	}
+	if m.err != nil {
+		b.WriteString(tui.Red("Error: "+m.err.Error()) + "\n")
+	}
+	b.WriteString(tui.Faint(hints))
+	return b.String()
+}
+
+func cursorLine(selected bool, line string) string {
+	if selected {
+		return tui.Reverse("› "+line) + "\n"
+	}
+	return "  " + line + "\n"
+}
+
+func (m *App) viewModes(b *strings.Builder) string {
+	b.WriteString("Choose a quiz:\n\n")
+	for i, mode := range Modes {
+		b.WriteString(cursorLine(i == m.modeCursor, fmt.Sprintf("%-18s %s", mode.Title(), mode.Description())))
+	}
+	return "↑/↓ move · enter start · q quit"
+}
+
+func checkbox(checked bool) string {
+	if checked {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+func (m *App) viewNotebooks(b *strings.Builder) string {
+	b.WriteString("Choose notebooks (nothing checked starts the highlighted one):\n\n")
+	for i, row := range m.pickerRows() {
+		nb := m.notebooks[row.notebook]
+		var line string
+		if row.section < 0 {
+			fold := " "
+			if len(nb.Sections) > 0 {
+				fold = "▸"
+				if m.expanded[nb.ID] {
+					fold = "▾"
+				}
+			}
+			line = fmt.Sprintf("%s %s %s (%d due)", fold, checkbox(m.selectedNotebooks[nb.ID]), nb.Name, nb.Due)
+		} else {
+			sec := nb.Sections[row.section]
+			line = fmt.Sprintf("    %s %s (%d due)", checkbox(m.selectedSections[nb.ID][sec.Title]), sec.Title, sec.Due)
+		}
+		b.WriteString(cursorLine(i == m.pickerCursor, line))
+	}
+	return "↑/↓ move · →/← sections · space check · a all · enter start · esc back"
+}
+
+func (m *App) viewQuestion(b *strings.Builder) string {
+	q := m.questions[m.current]
+	if q.Repeat {
+		fmt.Fprintf(b, "Answer %d\n", len(m.answers)+1)
+	} else {
+		fmt.Fprintf(b, "Question %d of %d\n", m.current+1, len(m.questions))
+	}
+	if q.Heading != "" {
+		b.WriteString(tui.Faint(q.Heading) + "\n")
+	}
+	b.WriteString("\n  " + tui.Bold(q.Prompt) + "\n\n")
+	for _, line := range q.Context {
+		b.WriteString("  " + line + "\n")
+	}
+	if len(q.Context) > 0 {
+		b.WriteString("\n")
+	}
+	for i, label := range q.Fields {
+		value := m.inputs[i].Value()
+		if i == m.field {
+			value = m.inputs[i].View()
+		}
+		fmt.Fprintf(b, "%s: %s\n", label, value)
+	}
+	if len(q.Fields) > 1 {
+		return "tab next field · enter submit · esc finish"
+	}
+	return "enter submit (empty = don't know) · esc finish"
+}
+
+func (m *App) viewFeedback(b *strings.Builder) string {
+	batch := m.answers[m.batchStart:]
+	correct := 0
+	for _, a := range batch {
+		if !a.pending && a.err == nil && a.result.Correct {
+			correct++
+		}
+	}
+	fmt.Fprintf(b, "Answers %d–%d: %d of %d correct\n\n", m.batchStart+1, len(m.answers), correct, len(batch))
+	for i, a := range batch {
+		index := m.batchStart + i
+		b.WriteString(cursorLine(index == m.feedbackCursor, answerSummary(a)))
+		for _, line := range answerDetails(a) {
+			b.WriteString("      " + line + "\n")
+		}
+	}
+	return "↑/↓ move · o override/undo · x exclude/resume · enter continue · q quit"
+}
+
+func answerSummary(a *answer) string {
+	mark := "…"
+	switch {
+	case a.pending:
+	case a.err != nil:
+		mark = tui.Red("!")
+	case a.result.Correct:
+		mark = tui.Green("✓")
+	default:
+		mark = tui.Red("✗")
+	}
+	given := strings.Join(a.values, " = ")
+	if strings.TrimSpace(given) == "" {
+		given = tui.Faint("(don't know)")
+	}
+	line := fmt.Sprintf("%s %s → %s", mark, a.question.Prompt, given)
+	if a.question.Repeat {
+		line = fmt.Sprintf("%s %s", mark, given)
+	}
+	if a.result.Override != nil {
+		line += " " + tui.Yellow("(overridden)")
+	}
+	if a.result.Excluded {
+		line += " " + tui.Yellow("(excluded)")
+	}
+	return line
+}
+
+func answerDetails(a *answer) []string {
+	if a.pending {
+		return []string{tui.Faint("grading…")}
+	}
+	if a.err != nil {
+		return []string{tui.Red(a.err.Error())}
+	}
+	var lines []string
+	if a.result.Expected != "" {
+		lines = append(lines, "answer: "+a.result.Expected)
+	}
+	if a.result.Reason != "" {
+		lines = append(lines, tui.Faint(a.result.Reason))
+	}
+	if a.result.NextReviewDate != "" {
+		lines = append(lines, tui.Faint("next review: "+a.result.NextReviewDate))
+	}
+	return lines
+}
+
+func (m *App) viewDone(b *strings.Builder) string {
+	correct, graded := 0, 0
+	for _, a := range m.answers {
+		if a.pending || a.err != nil {
+			continue
+		}
+		graded++
+		if a.result.Correct {
+			correct++
+		}
+	}
+	fmt.Fprintf(b, "Session finished: %d of %d correct.\n", correct, graded)
+	if n := m.pendingCount(); n > 0 {
+		fmt.Fprintf(b, "%d answer(s) still grading…\n", n)
+	}
+	return "enter choose another quiz · q quit"
+}
@@ -0,0 +1,303 @@
+package quiztui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/tui"
+)
+
+type fakeBackend struct {
+	notebooks  []Notebook
+	questions  []Question
+	started    []Selection
+	startMode  Mode
+	submitted  [][]string
+	overridden int
+	undone     int
+	excluded   int
+	resumed    int
+}
+
+func (b *fakeBackend) Notebooks(ctx context.Context, mode Mode) ([]Notebook, error) {
+	return b.notebooks, nil
+}
+
+func (b *fakeBackend) Start(ctx context.Context, mode Mode, selections []Selection) ([]Question, error) {
+	b.startMode = mode
+	b.started = selections
+	return b.questions, nil
+}
+
+func (b *fakeBackend) Submit(ctx context.Context, question Question, answers []string, responseTime time.Duration) (Result, error) {
+	b.submitted = append(b.submitted, answers)
+	if answers[0] == "broken" {
+		return Result{}, errors.New("grader unavailable")
+	}
+	return Result{Correct: answers[0] == "good", Expected: "good", Recorded: answers[0] != "unrecorded"}, nil
+}
+
+func (b *fakeBackend) Override(ctx context.Context, result Result) (Result, error) {
+	b.overridden++
+	result.Correct = !result.Correct
+	result.Override = &Override{OriginalQuality: 1}
+	return result, nil
+}
+
+func (b *fakeBackend) UndoOverride(ctx context.Context, result Result) (Result, error) {
+	b.undone++
+	result.Correct = !result.Correct
+	result.Override = nil
+	return result, nil
+}
+
+func (b *fakeBackend) Exclude(ctx context.Context, result Result) (Result, error) {
+	b.excluded++
+	result.Excluded = true
+	return result, nil
+}
+
+func (b *fakeBackend) Resume(ctx context.Context, result Result) (Result, error) {
+	b.resumed++
+	result.Excluded = false
+	return result, nil
+}
+
+// press sends keys to the app, running each returned command to completion
+// before the next key, and reports whether the app asked to quit.
+func press(t *testing.T, m *App, keys ...string) bool {
+	t.Helper()
+	for _, key := range keys {
+		model, cmd := m.Update(tui.Key(key))
+		require.Same(t, m, model)
+		if run(m, cmd) {
+			return true
+		}
+	}
+	return false
+}
+
+func run(m *App, cmd tui.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+	msg := cmd()
+	if msg == tui.Quit() {
+		return true
+	}
+	_, next := m.Update(msg)
+	return run(m, next)
+}
+
+func questions(n int) []Question {
+	qs := make([]Question, n)
+	for i := range qs {
+		qs[i] = Question{ID: int64(i + 1), Prompt: "word", Fields: []string{"Meaning"}}
+	}
+	return qs
+}
+
+func TestApp_recognitionSession(t *testing.T) {
+	backend := &fakeBackend{
+		notebooks: []Notebook{{ID: "a", Name: "A", Due: 3}, {ID: "b", Name: "B", Due: 1}},
+		questions: questions(3),
+	}
+	app := NewApp(context.Background(), backend).WithBatchSize(2)
+
+	press(t, app, "enter")
+	require.Equal(t, screenNotebooks, app.screen)
+	assert.Contains(t, app.View(), "A (3 due)")
+
+	press(t, app, "down", "enter")
+	assert.Equal(t, ModeRecognition, backend.startMode)
+	assert.Equal(t, []Selection{{NotebookID: "b"}}, backend.started)
+	require.Equal(t, screenQuestion, app.screen)
+	assert.Contains(t, app.View(), "Question 1 of 3")
+
+	press(t, app, "good", "enter", "wrong", "enter")
+	require.Equal(t, screenFeedback, app.screen)
+	assert.Equal(t, [][]string{{"good"}, {"wrong"}}, backend.submitted)
+	assert.Contains(t, app.View(), "1 of 2 correct")
+
+	press(t, app, "enter")
+	require.Equal(t, screenQuestion, app.screen)
+	assert.Contains(t, app.View(), "Question 3 of 3")
+
+	press(t, app, "enter")
+	require.Equal(t, screenFeedback, app.screen)
+	assert.Equal(t, []string{""}, backend.submitted[2])
+	assert.Contains(t, app.View(), "(don't know)")
+
+	press(t, app, "enter")
+	require.Equal(t, screenDone, app.screen)
+	assert.Contains(t, app.View(), "Session finished: 1 of 3 correct.")
+	assert.True(t, press(t, app, "q"))
+}
+
+func TestApp_pickerSelections(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []string
+		want []Selection
+	}{
+		{
+			name: "highlighted notebook",
+			keys: []string{"enter"},
+			want: []Selection{{NotebookID: "a"}},
+		},
+		{
+			name: "highlighted section",
+			keys: []string{"right", "down", "down", "enter"},
+			want: []Selection{{NotebookID: "a", Sections: []string{"Two"}}},
+		},
+		{
+			name: "checked sections and notebook",
+			keys: []string{"right", "down", " ", "down", "down", " ", "enter"},
+			want: []Selection{{NotebookID: "a", Sections: []string{"One"}}, {NotebookID: "b"}},
+		},
+		{
+			name: "checking a notebook replaces its sections",
+			keys: []string{"right", "down", " ", "up", " ", "enter"},
+			want: []Selection{{NotebookID: "a"}},
+		},
+		{
+			name: "all",
+			keys: []string{"a", "enter"},
+			want: []Selection{{NotebookID: "a"}, {NotebookID: "b"}},
+		},
+		{
+			name: "collapse returns to the notebook row",
+			keys: []string{"right", "down", "left", "down", "enter"},
+			want: []Selection{{NotebookID: "b"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &fakeBackend{
+				notebooks: []Notebook{
+					{ID: "a", Name: "A", Due: 2, Sections: []Section{{Title: "One", Due: 1}, {Title: "Two", Due: 1}}},
+					{ID: "b", Name: "B", Due: 1},
+				},
+				questions: questions(1),
+			}
+			app := NewApp(context.Background(), backend)
+			press(t, app, "down", "enter")
+			require.Equal(t, screenNotebooks, app.screen)
+
+			press(t, app, tt.keys...)
+			assert.Equal(t, ModeReverse, backend.startMode)
+			assert.Equal(t, tt.want, backend.started)
+		})
+	}
+}
+
+func TestApp_feedbackActions(t *testing.T) {
+	backend := &fakeBackend{
+		notebooks: []Notebook{{ID: "a", Name: "A", Due: 2}},
+		questions: questions(2),
+	}
+	app := NewApp(context.Background(), backend)
+	press(t, app, "enter", "enter", "wrong", "enter", "unrecorded", "enter")
+	require.Equal(t, screenFeedback, app.screen)
+
+	press(t, app, "u")
+	assert.Equal(t, "Nothing to undo.", app.status)
+
+	press(t, app, "o")
+	assert.True(t, app.answers[0].result.Correct)
+	assert.Contains(t, app.View(), "(overridden)")
+	press(t, app, "o")
+	assert.False(t, app.answers[0].result.Correct)
+	press(t, app, "o", "u")
+	assert.Equal(t, 2, backend.overridden)
+	assert.Equal(t, 2, backend.undone)
+
+	press(t, app, "x")
+	assert.True(t, app.answers[0].result.Excluded)
+	press(t, app, "x")
+	assert.False(t, app.answers[0].result.Excluded)
+	assert.Equal(t, 1, backend.excluded)
+	assert.Equal(t, 1, backend.resumed)
+
+	press(t, app, "down", "o", "x")
+	assert.Equal(t, "This answer wasn't recorded.", app.status)
+	assert.Equal(t, 2, backend.overridden)
+	assert.Equal(t, 1, backend.excluded)
+}
+
+func TestApp_pendingAnswers(t *testing.T) {
+	backend := &fakeBackend{
+		notebooks: []Notebook{{ID: "a", Name: "A", Due: 1}},
+		questions: questions(1),
+	}
+	app := NewApp(context.Background(), backend)
+	press(t, app, "enter", "enter")
+
+	// Hold the grading command back to see the screen before it finishes.
+	_, cmd := app.Update(tui.Key("enter"))
+	require.NotNil(t, cmd)
+	require.Equal(t, screenFeedback, app.screen)
+	assert.Contains(t, app.View(), "grading…")
+
+	press(t, app, "o")
+	assert.Equal(t, "Still grading this answer.", app.status)
+	assert.False(t, press(t, app, "q"))
+	assert.Contains(t, app.status, "Still grading 1 answer(s)")
+
+	run(app, cmd)
+	assert.True(t, press(t, app, "q"))
+}
+
+func TestApp_freeformRepeats(t *testing.T) {
+	backend := &fakeBackend{
+		questions: []Question{{ID: 1, Fields: []string{"Word", "Meaning"}, Repeat: true}},
+	}
+	app := NewApp(context.Background(), backend).WithBatchSize(2)
+	press(t, app, "down", "down", "enter")
+	require.Equal(t, screenQuestion, app.screen)
+	assert.Nil(t, backend.started)
+
+	press(t, app, "enter", "enter")
+	assert.Equal(t, "Type a word first.", app.status)
+	assert.Empty(t, backend.submitted)
+
+	press(t, app, "shift+tab", "good", "tab", "sure", "enter", "broken", "enter", "enter")
+	assert.Equal(t, [][]string{{"good", "sure"}, {"broken", ""}}, backend.submitted)
+	require.Equal(t, screenFeedback, app.screen)
+	assert.Contains(t, app.View(), "grader unavailable")
+
+	press(t, app, "enter")
+	require.Equal(t, screenQuestion, app.screen)
+	assert.Contains(t, app.View(), "Answer 3")
+
+	press(t, app, "esc")
+	assert.Equal(t, screenDone, app.screen)
+	assert.Contains(t, app.View(), "Session finished: 1 of 1 correct.")
+}
+
+func TestApp_escEndsSessionWithFeedback(t *testing.T) {
+	backend := &fakeBackend{
+		notebooks: []Notebook{{ID: "a", Name: "A", Due: 5}},
+		questions: questions(5),
+	}
+	app := NewApp(context.Background(), backend)
+	press(t, app, "enter", "enter", "good", "enter", "esc")
+	require.Equal(t, screenFeedback, app.screen)
+
+	press(t, app, "enter")
+	assert.Equal(t, screenDone, app.screen)
+	press(t, app, "enter")
+	assert.Equal(t, screenModes, app.screen)
+}
+
+func TestApp_nothingDue(t *testing.T) {
+	app := NewApp(context.Background(), &fakeBackend{})
+	press(t, app, "enter")
+	assert.Equal(t, screenModes, app.screen)
+	assert.Equal(t, "Nothing is due in Recognition.", app.status)
+}
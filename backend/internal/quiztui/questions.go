@@ -0,0 +1,121 @@
+package quiztui
+
+import (
+	"strings"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+func heading(parts ...string) string {
+	var nonEmpty []string
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, " › ")
+}
+
+func exampleLines(examples []quiz.Example) []string {
+	lines := make([]string, 0, len(examples))
+	for _, ex := range examples {
+		if ex.Speaker != "" {
+			lines = append(lines, ex.Speaker+": "+ex.Text)
+		} else {
+			lines = append(lines, ex.Text)
+		}
+	}
+	return lines
+}
+
+func maskedContextLines(contexts []quiz.ReverseContext) []string {
+	lines := make([]string, 0, len(contexts))
+	for _, c := range contexts {
+		lines = append(lines, c.MaskedContext)
+	}
+	return lines
+}
+
+func recognitionQuestion(card quiz.Card) Question {
+	return Question{
+		Heading: heading(card.NotebookName, card.StoryTitle, card.SceneTitle),
+		Prompt:  card.Entry,
+		Context: exampleLines(card.Examples),
+		Fields:  []string{"Meaning"},
+	}
+}
+
+func reverseQuestion(card quiz.ReverseCard) Question {
+	prompt := card.Meaning
+	if card.ConceptMeaning != "" {
+		prompt = card.ConceptMeaning
+	}
+	return Question{
+		Heading: heading(card.NotebookName, card.StoryTitle, card.SceneTitle),
+		Prompt:  prompt,
+		Context: maskedContextLines(card.Contexts),
+		Fields:  []string{"Word"},
+	}
+}
+
+func etymologyQuestion(item etymologyItem) Question {
+	origin := item.card.WordDetail.OriginParts[0]
+	return Question{
+		Heading: heading(item.card.NotebookName, originLabel(origin.Origin, origin.Language, origin.Meaning)),
+		Prompt:  item.card.Entry,
+		Context: exampleLines(item.card.Examples),
+		Fields:  []string{"Meaning"},
+	}
+}
+
+func originLabel(origin, language, meaning string) string {
+	label := origin
+	if language != "" {
+		label += " (" + language + ")"
+	}
+	if meaning != "" {
+		label += " = " + meaning
+	}
+	return label
+}
+
+// relearnAsksWord reports whether a relearn card is drilled in the
+// production direction: show the meaning, type the word.
+func relearnAsksWord(card quiz.RelearnCard) bool {
+	return card.Format == notebook.QuizTypeReverse ||
+		card.IsEtymology() && card.Direction == notebook.QuizTypeReverse
+}
+
+func relearnQuestion(card quiz.RelearnCard) Question {
+	q := Question{Heading: card.NotebookName}
+	if card.IsEtymology() {
+		q.Heading = heading(card.NotebookName, originLabel(card.OriginText, card.Language, card.OriginMeaning))
+	}
+	switch {
+	case card.IsGrammar():
+		q.Prompt = card.Incorrect
+		q.Context = []string{card.Content}
+		q.Fields = []string{"Correction"}
+	case relearnAsksWord(card):
+		q.Prompt = card.Meaning
+		q.Context = maskedContextLines(card.Contexts)
+		q.Fields = []string{"Word"}
+	default:
+		q.Prompt = card.Entry
+		q.Context = exampleLines(card.Examples)
+		q.Fields = []string{"Meaning"}
+	}
+	return q
+}
+
+func relearnExpected(card quiz.RelearnCard) string {
+	switch {
+	case card.IsGrammar():
+		return card.GrammarCard().Correct
+	case relearnAsksWord(card):
+		return card.Entry
+	default:
+		return card.Meaning
+	}
+}
@@ -0,0 +1,441 @@
+package quiztui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+	"github.com/at-ishikawa/langner/gen-protos/api/v1/apiv1connect"
+	"github.com/at-ishikawa/langner/internal/inference"
+)
+
+// ErrModeNotServed is returned for a mode the server has no RPCs for.
+var ErrModeNotServed = errors.New("this quiz mode is not available from a server")
+
+// RemoteBackend runs sessions on a langner-server over Connect, so every
+// write goes through the server process and no OpenAI key is needed
+// locally.
+type RemoteBackend struct {
+	client           apiv1connect.QuizServiceClient
+	includeUnstudied bool
+
+	mu sync.Mutex
+	// items holds the server card behind each question, keyed by the
+	// server's note id.
+	items map[int64]any
+}
+
+// remoteRef identifies a recorded result to the server.
+type remoteRef struct {
+	noteID   int64
+	senseID  string
+	quizType apiv1.QuizType
+}
+
+type remoteFreeformItem struct{}
+
+// NewRemoteBackend returns a Backend over client.
+func NewRemoteBackend(client apiv1connect.QuizServiceClient) *RemoteBackend {
+	return &RemoteBackend{
+		client: client,
+		items:  make(map[int64]any),
+	}
+}
+
+// WithIncludeUnstudied returns a copy that also offers words never answered.
+func (b *RemoteBackend) WithIncludeUnstudied(includeUnstudied bool) *RemoteBackend {
+	return &RemoteBackend{
+		client:           b.client,
+		includeUnstudied: includeUnstudied,
+		items:            make(map[int64]any),
+	}
+}
+
+// Notebooks implements Backend.
+func (b *RemoteBackend) Notebooks(ctx context.Context, mode Mode) ([]Notebook, error) {
+	if mode == ModeEtymology {
+		return nil, ErrModeNotServed
+	}
+	res, err := b.client.GetQuizOptions(ctx, connect.NewRequest(&apiv1.GetQuizOptionsRequest{
+		IncludeUnstudied: b.includeUnstudied,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("GetQuizOptions() > %w", err)
+	}
+
+	var notebooks []Notebook
+	for _, s := range res.Msg.GetNotebooks() {
+		due := summaryDue(mode, int(s.GetReviewCount()), int(s.GetReverseReviewCount()), int(s.GetEtymologyReviewCount()), int(s.GetGrammarReviewCount()))
+		if due == 0 {
+			continue
+		}
+		nb := Notebook{ID: s.GetNotebookId(), Name: s.GetName(), Due: due}
+		for _, sec := range s.GetSections() {
+			if d := summaryDue(mode, int(sec.GetReviewCount()), int(sec.GetReverseReviewCount()), int(sec.GetEtymologyReviewCount()), int(sec.GetGrammarReviewCount())); d > 0 {
+				nb.Sections = append(nb.Sections, Section{Title: sec.GetTitle(), Due: d})
+			}
+		}
+		notebooks = append(notebooks, nb)
+	}
+	return notebooks, nil
+}
+
+func toNotebookSections(selections []Selection) []*apiv1.NotebookSection {
+	sections := make([]*apiv1.NotebookSection, 0, len(selections))
+	for _, sel := range selections {
+		sections = append(sections, &apiv1.NotebookSection{NotebookId: sel.NotebookID, SectionTitles: sel.Sections})
+	}
+	return sections
+}
+
+// Start implements Backend.
+func (b *RemoteBackend) Start(ctx context.Context, mode Mode, selections []Selection) ([]Question, error) {
+	items := make(map[int64]any)
+	var questions []Question
+
+	switch mode {
+	case ModeRecognition:
+		res, err := b.client.StartQuiz(ctx, connect.NewRequest(&apiv1.StartQuizRequest{
+			IncludeUnstudied: b.includeUnstudied,
+			NotebookSections: toNotebookSections(selections),
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("StartQuiz() > %w", err)
+		}
+		for _, card := range res.Msg.GetFlashcards() {
+			items[card.GetNoteId()] = card
+			questions = append(questions, Question{
+				ID:      card.GetNoteId(),
+				Prompt:  card.GetEntry(),
+				Context: protoExampleLines(card.GetExamples()),
+				Fields:  []string{"Meaning"},
+			})
+		}
+	case ModeReverse:
+		res, err := b.client.StartReverseQuiz(ctx, connect.NewRequest(&apiv1.StartReverseQuizRequest{
+			IncludeUnstudied: b.includeUnstudied,
+			NotebookSections: toNotebookSections(selections),
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("StartReverseQuiz() > %w", err)
+		}
+		for _, card := range res.Msg.GetFlashcards() {
+			items[card.GetNoteId()] = card
+			prompt := card.GetMeaning()
+			if card.GetConceptMeaning() != "" {
+				prompt = card.GetConceptMeaning()
+			}
+			questions = append(questions, Question{
+				ID:      card.GetNoteId(),
+				Heading: heading(card.GetNotebookName(), card.GetStoryTitle(), card.GetSceneTitle()),
+				Prompt:  prompt,
+				Context: protoMaskedContextLines(card.GetContexts()),
+				Fields:  []string{"Word"},
+			})
+		}
+	case ModeFreeform:
+		res, err := b.client.StartFreeformQuiz(ctx, connect.NewRequest(&apiv1.StartFreeformQuizRequest{}))
+		if err != nil {
+			return nil, fmt.Errorf("StartFreeformQuiz() > %w", err)
+		}
+		// Freeform answers get their note ids on submit, so the single
+		// open question uses an id the server never issues.
+		items[0] = remoteFreeformItem{}
+		questions = append(questions, Question{
+			Heading: fmt.Sprintf("%d words in your notebooks", res.Msg.GetWordCount()),
+			Prompt:  "Recall a word and its meaning",
+			Fields:  []string{"Word", "Meaning"},
+			Repeat:  true,
+		})
+	case ModeGrammar:
+		res, err := b.client.StartGrammarQuiz(ctx, connect.NewRequest(&apiv1.StartGrammarQuizRequest{
+			IncludeUnstudied: b.includeUnstudied,
+			NotebookSections: toNotebookSections(selections),
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("StartGrammarQuiz() > %w", err)
+		}
+		for _, post := range res.Msg.GetPosts() {
+			for _, blank := range post.GetBlanks() {
+				items[blank.GetNoteId()] = blank
+				questions = append(questions, Question{
+					ID:      blank.GetNoteId(),
+					Heading: heading(post.GetNotebookId(), post.GetTitle()),
+					Prompt:  blank.GetIncorrect(),
+					Context: []string{post.GetPostText()},
+					Fields:  []string{"Correction"},
+				})
+			}
+		}
+	case ModeRelearn:
+		res, err := b.client.StartRelearnQuiz(ctx, connect.NewRequest(&apiv1.StartRelearnQuizRequest{}))
+		if err != nil {
+			return nil, fmt.Errorf("StartRelearnQuiz() > %w", err)
+		}
+		for _, card := range res.Msg.GetCards() {
+			// The terminal quiz plays no audio; see ServiceBackend.
+			if card.GetSourceQuizType() == apiv1.QuizType_QUIZ_TYPE_DICTATION {
+				continue
+			}
+			items[card.GetNoteId()] = card
+			questions = append(questions, protoRelearnQuestion(card))
+		}
+	case ModeEtymology:
+		return nil, ErrModeNotServed
+	default:
+		return nil, fmt.Errorf("unknown quiz mode %q", mode)
+	}
+
+	b.mu.Lock()
+	b.items = items
+	b.mu.Unlock()
+	return questions, nil
+}
+
+func protoExampleLines(examples []*apiv1.Example) []string {
+	lines := make([]string, 0, len(examples))
+	for _, ex := range examples {
+		if ex.GetSpeaker() != "" {
+			lines = append(lines, ex.GetSpeaker()+": "+ex.GetText())
+		} else {
+			lines = append(lines, ex.GetText())
+		}
+	}
+	return lines
+}
+
+func protoMaskedContextLines(contexts []*apiv1.ContextSentence) []string {
+	lines := make([]string, 0, len(contexts))
+	for _, c := range contexts {
+		lines = append(lines, c.GetMaskedContext())
+	}
+	return lines
+}
+
+// protoRelearnAsksWord is relearnAsksWord for a card from the server.
+func protoRelearnAsksWord(card *apiv1.RelearnCard) bool {
+	return card.GetSourceQuizType() == apiv1.QuizType_QUIZ_TYPE_REVERSE ||
+		card.GetSourceQuizType() == apiv1.QuizType_QUIZ_TYPE_ETYMOLOGY_ORIGIN && card.GetOriginDirection() == apiv1.QuizType_QUIZ_TYPE_REVERSE
+}
+
+func protoRelearnQuestion(card *apiv1.RelearnCard) Question {
+	q := Question{ID: card.GetNoteId()}
+	if card.GetSourceQuizType() == apiv1.QuizType_QUIZ_TYPE_ETYMOLOGY_ORIGIN {
+		q.Heading = originLabel(card.GetOriginText(), card.GetLanguage(), card.GetOriginMeaning())
+	}
+	switch {
+	case card.GetSourceQuizType() == apiv1.QuizType_QUIZ_TYPE_GRAMMAR:
+		q.Prompt = card.GetIncorrect()
+		q.Context = []string{card.GetContent()}
+		q.Fields = []string{"Correction"}
+	case protoRelearnAsksWord(card):
+		q.Prompt = card.GetMeaning()
+		q.Context = protoMaskedContextLines(card.GetContexts())
+		q.Fields = []string{"Word"}
+	default:
+		q.Prompt = card.GetEntry()
+		q.Context = protoExampleLines(card.GetExamples())
+		q.Fields = []string{"Meaning"}
+	}
+	return q
+}
+
+// Submit implements Backend.
+func (b *RemoteBackend) Submit(ctx context.Context, question Question, answers []string, responseTime time.Duration) (Result, error) {
+	b.mu.Lock()
+	item, ok := b.items[question.ID]
+	b.mu.Unlock()
+	if !ok {
+		return Result{}, fmt.Errorf("question %d not found", question.ID)
+	}
+	answer := ""
+	if len(answers) > 0 {
+		answer = strings.TrimSpace(answers[0])
+	}
+	ms := responseTime.Milliseconds()
+	skipped := answer == ""
+
+	switch it := item.(type) {
+	case *apiv1.Flashcard:
+		res, err := b.client.SubmitAnswer(ctx, connect.NewRequest(&apiv1.SubmitAnswerRequest{
+			NoteId: it.GetNoteId(), Answer: answer, ResponseTimeMs: ms, IsSkipped: skipped,
+		}))
+		if err != nil {
+			return Result{}, fmt.Errorf("SubmitAnswer() > %w", err)
+		}
+		r := res.Msg
+		return Result{
+			Correct: r.GetCorrect(), Expected: r.GetMeaning(), Reason: r.GetReason(),
+			NextReviewDate: r.GetNextReviewDate(), LearnedAt: r.GetLearnedAt(), Recorded: true,
+			Ref: remoteRef{noteID: it.GetNoteId(), senseID: r.GetSenseId(), quizType: apiv1.QuizType_QUIZ_TYPE_STANDARD},
+		}, nil
+	case *apiv1.ReverseFlashcard:
+		res, err := b.client.SubmitReverseAnswer(ctx, connect.NewRequest(&apiv1.SubmitReverseAnswerRequest{
+			NoteId: it.GetNoteId(), Answer: answer, ResponseTimeMs: ms, IsSkipped: skipped,
+		}))
+		if err != nil {
+			return Result{}, fmt.Errorf("SubmitReverseAnswer() > %w", err)
+		}
+		r := res.Msg
+		// The server doesn't record a synonym, so there is nothing to
+		// override or skip.
+		if r.GetClassification() == string(inference.ClassificationSynonym) {
+			return Result{Correct: false, Expected: r.GetExpression(), Reason: r.GetReason()}, nil
+		}
+		return Result{
+			Correct: r.GetCorrect(), Expected: r.GetExpression(), Reason: r.GetReason(),
+			NextReviewDate: r.GetNextReviewDate(), LearnedAt: r.GetLearnedAt(), Recorded: true,
+			Ref: remoteRef{noteID: it.GetNoteId(), senseID: r.GetSenseId(), quizType: apiv1.QuizType_QUIZ_TYPE_REVERSE},
+		}, nil
+	case *apiv1.GrammarBlank:
+		res, err := b.client.SubmitGrammarPost(ctx, connect.NewRequest(&apiv1.SubmitGrammarPostRequest{
+			Answers: []*apiv1.GrammarBlankAnswer{{NoteId: it.GetNoteId(), Answer: answer, ResponseTimeMs: ms, IsSkipped: skipped}},
+		}))
+		if err != nil {
+			return Result{}, fmt.Errorf("SubmitGrammarPost() > %w", err)
+		}
+		if len(res.Msg.GetResults()) == 0 {
+			return Result{}, fmt.Errorf("SubmitGrammarPost() returned no result for blank %d", it.GetNoteId())
+		}
+		r := res.Msg.GetResults()[0]
+		return Result{
+			Correct: r.GetCorrect(), Expected: r.GetCorrectAnswer(),
+			Reason:         strings.TrimSpace(r.GetAssessment() + " " + r.GetReason()),
+			NextReviewDate: r.GetNextReviewDate(), LearnedAt: r.GetLearnedAt(), Recorded: true,
+			Ref: remoteRef{noteID: it.GetNoteId(), senseID: r.GetSenseId(), quizType: apiv1.QuizType_QUIZ_TYPE_GRAMMAR},
+		}, nil
+	case *apiv1.RelearnCard:
+		res, err := b.client.SubmitRelearnAnswer(ctx, connect.NewRequest(&apiv1.SubmitRelearnAnswerRequest{
+			NoteId: it.GetNoteId(), Answer: answer, ResponseTimeMs: ms, IsSkipped: skipped,
+		}))
+		if err != nil {
+			return Result{}, fmt.Errorf("SubmitRelearnAnswer() > %w", err)
+		}
+		r := res.Msg
+		expected := r.GetMeaning()
+		switch {
+		case it.GetSourceQuizType() == apiv1.QuizType_QUIZ_TYPE_GRAMMAR:
+			expected = r.GetCorrectAnswer()
+		case protoRelearnAsksWord(it):
+			expected = it.GetEntry()
+		}
+		return Result{Correct: r.GetCorrect(), Expected: expected, Reason: r.GetReason()}, nil
+	case remoteFreeformItem:
+		meaning := ""
+		if len(answers) > 1 {
+			meaning = strings.TrimSpace(answers[1])
+		}
+		if meaning == "" {
+			return Result{Correct: false, Reason: "no meaning was given"}, nil
+		}
+		res, err := b.client.SubmitFreeformAnswer(ctx, connect.NewRequest(&apiv1.SubmitFreeformAnswerRequest{
+			Word: answer, Meaning: meaning, ResponseTimeMs: ms,
+		}))
+		if err != nil {
+			return Result{}, fmt.Errorf("SubmitFreeformAnswer() > %w", err)
+		}
+		r := res.Msg
+		result := Result{Correct: r.GetCorrect(), Expected: r.GetWord() + ": " + r.GetMeaning(), Reason: r.GetReason()}
+		if r.GetNoteId() == 0 {
+			return result, nil
+		}
+		result.NextReviewDate = r.GetNextReviewDate()
+		result.LearnedAt = r.GetLearnedAt()
+		result.Recorded = true
+		result.Ref = remoteRef{noteID: r.GetNoteId(), senseID: r.GetSenseId(), quizType: apiv1.QuizType_QUIZ_TYPE_FREEFORM}
+		return result, nil
+	default:
+		return Result{}, fmt.Errorf("unsupported question %d", question.ID)
+	}
+}
+
+// Override implements Backend.
+func (b *RemoteBackend) Override(ctx context.Context, result Result) (Result, error) {
+	ref, ok := result.Ref.(remoteRef)
+	if !ok {
+		return result, fmt.Errorf("result was not recorded")
+	}
+	markCorrect := !result.Correct
+	res, err := b.client.OverrideAnswer(ctx, connect.NewRequest(&apiv1.OverrideAnswerRequest{
+		NoteId:      ref.noteID,
+		QuizType:    ref.quizType,
+		LearnedAt:   result.LearnedAt,
+		MarkCorrect: &markCorrect,
+		SenseId:     ref.senseID,
+	}))
+	if err != nil {
+		return result, fmt.Errorf("OverrideAnswer() > %w", err)
+	}
+	result.Correct = markCorrect
+	result.NextReviewDate = res.Msg.GetNextReviewDate()
+	result.Override = &Override{
+		OriginalQuality:      int(res.Msg.GetOriginalQuality()),
+		OriginalStatus:       res.Msg.GetOriginalStatus(),
+		OriginalIntervalDays: int(res.Msg.GetOriginalIntervalDays()),
+		OriginalGrader:       res.Msg.GetOriginalGrader(),
+	}
+	return result, nil
+}
+
+// UndoOverride implements Backend.
+func (b *RemoteBackend) UndoOverride(ctx context.Context, result Result) (Result, error) {
+	ref, ok := result.Ref.(remoteRef)
+	if !ok || result.Override == nil {
+		return result, fmt.Errorf("result was not overridden")
+	}
+	res, err := b.client.UndoOverrideAnswer(ctx, connect.NewRequest(&apiv1.UndoOverrideAnswerRequest{
+		NoteId:               ref.noteID,
+		QuizType:             ref.quizType,
+		LearnedAt:            result.LearnedAt,
+		OriginalQuality:      int32(result.Override.OriginalQuality),
+		OriginalStatus:       result.Override.OriginalStatus,
+		OriginalIntervalDays: int32(result.Override.OriginalIntervalDays),
+		OriginalGrader:       result.Override.OriginalGrader,
+		SenseId:              ref.senseID,
+	}))
+	if err != nil {
+		return result, fmt.Errorf("UndoOverrideAnswer() > %w", err)
+	}
+	result.Correct = res.Msg.GetCorrect()
+	result.NextReviewDate = res.Msg.GetNextReviewDate()
+	result.Override = nil
+	return result, nil
+}
+
+// Exclude implements Backend.
+func (b *RemoteBackend) Exclude(ctx context.Context, result Result) (Result, error) {
+	ref, ok := result.Ref.(remoteRef)
+	if !ok {
+		return result, fmt.Errorf("result was not recorded")
+	}
+	if _, err := b.client.SkipWord(ctx, connect.NewRequest(&apiv1.SkipWordRequest{
+		NoteId:    ref.noteID,
+		QuizTypes: []apiv1.QuizType{ref.quizType},
+	})); err != nil {
+		return result, fmt.Errorf("SkipWord() > %w", err)
+	}
+	result.Excluded = true
+	return result, nil
+}
+
+// Resume implements Backend.
+func (b *RemoteBackend) Resume(ctx context.Context, result Result) (Result, error) {
+	ref, ok := result.Ref.(remoteRef)
+	if !ok {
+		return result, fmt.Errorf("result was not recorded")
+	}
+	if _, err := b.client.ResumeWord(ctx, connect.NewRequest(&apiv1.ResumeWordRequest{
+		NoteId:    ref.noteID,
+		QuizTypes: []apiv1.QuizType{ref.quizType},
+	})); err != nil {
+		return result, fmt.Errorf("ResumeWord() > %w", err)
+	}
+	result.Excluded = false
+	return result, nil
+}
@@ -0,0 +1,228 @@
+package quiztui
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+	"github.com/at-ishikawa/langner/gen-protos/api/v1/apiv1connect"
+)
+
+// fakeQuizServer records the requests a RemoteBackend sends.
+type fakeQuizServer struct {
+	apiv1connect.UnimplementedQuizServiceHandler
+
+	startQuiz      *apiv1.StartQuizRequest
+	submitAnswer   *apiv1.SubmitAnswerRequest
+	submitFreeform *apiv1.SubmitFreeformAnswerRequest
+	override       *apiv1.OverrideAnswerRequest
+	undo           *apiv1.UndoOverrideAnswerRequest
+	skip           *apiv1.SkipWordRequest
+}
+
+func (s *fakeQuizServer) GetQuizOptions(_ context.Context, req *connect.Request[apiv1.GetQuizOptionsRequest]) (*connect.Response[apiv1.GetQuizOptionsResponse], error) {
+	return connect.NewResponse(&apiv1.GetQuizOptionsResponse{Notebooks: []*apiv1.NotebookSummary{
+		{NotebookId: "friends", Name: "Friends", ReviewCount: 2, ReverseReviewCount: 0, Sections: []*apiv1.NotebookSectionSummary{
+			{Title: "Episode 1", ReviewCount: 2},
+			{Title: "Episode 2"},
+		}},
+		{NotebookId: "journal", Name: "Journal", GrammarReviewCount: 1},
+	}}), nil
+}
+
+func (s *fakeQuizServer) StartQuiz(_ context.Context, req *connect.Request[apiv1.StartQuizRequest]) (*connect.Response[apiv1.StartQuizResponse], error) {
+	s.startQuiz = req.Msg
+	return connect.NewResponse(&apiv1.StartQuizResponse{Flashcards: []*apiv1.Flashcard{
+		{NoteId: 7, Entry: "break a leg", Examples: []*apiv1.Example{{Text: "Break a leg tonight!", Speaker: "Joey"}}},
+	}}), nil
+}
+
+func (s *fakeQuizServer) SubmitAnswer(_ context.Context, req *connect.Request[apiv1.SubmitAnswerRequest]) (*connect.Response[apiv1.SubmitAnswerResponse], error) {
+	s.submitAnswer = req.Msg
+	return connect.NewResponse(&apiv1.SubmitAnswerResponse{
+		Correct: false, Meaning: "good luck", Reason: "not quite",
+		NextReviewDate: "2026-10-19", LearnedAt: "2026-10-18T10:00:00Z", SenseId: "sense-7",
+	}), nil
+}
+
+func (s *fakeQuizServer) StartFreeformQuiz(_ context.Context, _ *connect.Request[apiv1.StartFreeformQuizRequest]) (*connect.Response[apiv1.StartFreeformQuizResponse], error) {
+	return connect.NewResponse(&apiv1.StartFreeformQuizResponse{WordCount: 42}), nil
+}
+
+func (s *fakeQuizServer) SubmitFreeformAnswer(_ context.Context, req *connect.Request[apiv1.SubmitFreeformAnswerRequest]) (*connect.Response[apiv1.SubmitFreeformAnswerResponse], error) {
+	s.submitFreeform = req.Msg
+	if req.Msg.GetWord() != "break a leg" {
+		return connect.NewResponse(&apiv1.SubmitFreeformAnswerResponse{Word: req.Msg.GetWord(), Reason: "not in your notebooks"}), nil
+	}
+	return connect.NewResponse(&apiv1.SubmitFreeformAnswerResponse{
+		Correct: true, Word: "break a leg", Meaning: "good luck", NoteId: 11, SenseId: "sense-7",
+		LearnedAt: "2026-10-18T10:00:00Z", NextReviewDate: "2026-10-21",
+	}), nil
+}
+
+func (s *fakeQuizServer) OverrideAnswer(_ context.Context, req *connect.Request[apiv1.OverrideAnswerRequest]) (*connect.Response[apiv1.OverrideAnswerResponse], error) {
+	s.override = req.Msg
+	return connect.NewResponse(&apiv1.OverrideAnswerResponse{
+		NextReviewDate: "2026-10-25", OriginalQuality: 1, OriginalStatus: "misunderstood", OriginalIntervalDays: 1,
+	}), nil
+}
+
+func (s *fakeQuizServer) UndoOverrideAnswer(_ context.Context, req *connect.Request[apiv1.UndoOverrideAnswerRequest]) (*connect.Response[apiv1.UndoOverrideAnswerResponse], error) {
+	s.undo = req.Msg
+	return connect.NewResponse(&apiv1.UndoOverrideAnswerResponse{Correct: false, NextReviewDate: "2026-10-19"}), nil
+}
+
+func (s *fakeQuizServer) SkipWord(_ context.Context, req *connect.Request[apiv1.SkipWordRequest]) (*connect.Response[apiv1.SkipWordResponse], error) {
+	s.skip = req.Msg
+	return connect.NewResponse(&apiv1.SkipWordResponse{}), nil
+}
+
+func newTestRemoteBackend(t *testing.T) (*RemoteBackend, *fakeQuizServer) {
+	t.Helper()
+	fake := &fakeQuizServer{}
+	path, handler := apiv1connect.NewQuizServiceHandler(fake)
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return NewRemoteBackend(apiv1connect.NewQuizServiceClient(server.Client(), server.URL)), fake
+}
+
+func TestRemoteBackend_Notebooks(t *testing.T) {
+	tests := []struct {
+		mode Mode
+		want []Notebook
+	}{
+		{
+			mode: ModeRecognition,
+			want: []Notebook{{ID: "friends", Name: "Friends", Due: 2, Sections: []Section{{Title: "Episode 1", Due: 2}}}},
+		},
+		{
+			mode: ModeReverse,
+			want: nil,
+		},
+		{
+			mode: ModeGrammar,
+			want: []Notebook{{ID: "journal", Name: "Journal", Due: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			backend, _ := newTestRemoteBackend(t)
+			got, err := backend.Notebooks(context.Background(), tt.mode)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRemoteBackend_etymologyNotServed(t *testing.T) {
+	backend, _ := newTestRemoteBackend(t)
+	_, err := backend.Notebooks(context.Background(), ModeEtymology)
+	assert.ErrorIs(t, err, ErrModeNotServed)
+	_, err = backend.Start(context.Background(), ModeEtymology, nil)
+	assert.ErrorIs(t, err, ErrModeNotServed)
+}
+
+func TestRemoteBackend_recognition(t *testing.T) {
+	backend, fake := newTestRemoteBackend(t)
+	backend = backend.WithIncludeUnstudied(true)
+	ctx := context.Background()
+
+	questions, err := backend.Start(ctx, ModeRecognition, []Selection{{NotebookID: "friends", Sections: []string{"Episode 1"}}})
+	require.NoError(t, err)
+	assert.True(t, fake.startQuiz.GetIncludeUnstudied())
+	require.Len(t, fake.startQuiz.GetNotebookSections(), 1)
+	assert.Equal(t, []string{"Episode 1"}, fake.startQuiz.GetNotebookSections()[0].GetSectionTitles())
+	assert.Equal(t, []Question{{ID: 7, Prompt: "break a leg", Context: []string{"Joey: Break a leg tonight!"}, Fields: []string{"Meaning"}}}, questions)
+
+	result, err := backend.Submit(ctx, questions[0], []string{" "}, 1500*time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, fake.submitAnswer.GetIsSkipped())
+	assert.Equal(t, int64(1500), fake.submitAnswer.GetResponseTimeMs())
+	assert.False(t, result.Correct)
+	assert.Equal(t, "good luck", result.Expected)
+	assert.True(t, result.Recorded)
+
+	result, err = backend.Override(ctx, result)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), fake.override.GetNoteId())
+	assert.Equal(t, "sense-7", fake.override.GetSenseId())
+	assert.Equal(t, "2026-10-18T10:00:00Z", fake.override.GetLearnedAt())
+	assert.Equal(t, apiv1.QuizType_QUIZ_TYPE_STANDARD, fake.override.GetQuizType())
+	assert.True(t, fake.override.GetMarkCorrect())
+	assert.True(t, result.Correct)
+	assert.Equal(t, "2026-10-25", result.NextReviewDate)
+	assert.Equal(t, &Override{OriginalQuality: 1, OriginalStatus: "misunderstood", OriginalIntervalDays: 1}, result.Override)
+
+	result, err = backend.UndoOverride(ctx, result)
+	require.NoError(t, err)
+	assert.Equal(t, "misunderstood", fake.undo.GetOriginalStatus())
+	assert.False(t, result.Correct)
+	assert.Nil(t, result.Override)
+
+	result, err = backend.Exclude(ctx, result)
+	require.NoError(t, err)
+	assert.Equal(t, []apiv1.QuizType{apiv1.QuizType_QUIZ_TYPE_STANDARD}, fake.skip.GetQuizTypes())
+	assert.True(t, result.Excluded)
+}
+
+func TestRemoteBackend_freeform(t *testing.T) {
+	backend, fake := newTestRemoteBackend(t)
+	ctx := context.Background()
+
+	questions, err := backend.Start(ctx, ModeFreeform, nil)
+	require.NoError(t, err)
+	require.Len(t, questions, 1)
+	assert.True(t, questions[0].Repeat)
+	assert.Equal(t, "42 words in your notebooks", questions[0].Heading)
+
+	tests := []struct {
+		name    string
+		answers []string
+		want    Result
+	}{
+		{
+			name:    "matched word is recorded",
+			answers: []string{"break a leg", "good luck"},
+			want: Result{
+				Correct: true, Expected: "break a leg: good luck", NextReviewDate: "2026-10-21",
+				LearnedAt: "2026-10-18T10:00:00Z", Recorded: true,
+				Ref: remoteRef{noteID: 11, senseID: "sense-7", quizType: apiv1.QuizType_QUIZ_TYPE_FREEFORM},
+			},
+		},
+		{
+			name:    "unknown word is not recorded",
+			answers: []string{"kick the bucket", "die"},
+			want:    Result{Expected: "kick the bucket: ", Reason: "not in your notebooks"},
+		},
+		{
+			name:    "missing meaning isn't sent",
+			answers: []string{"on a roll", ""},
+			want:    Result{Reason: "no meaning was given"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake.submitFreeform = nil
+			got, err := backend.Submit(ctx, questions[0], tt.answers, time.Second)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	assert.Nil(t, fake.submitFreeform)
+}
+
+func TestRemoteBackend_serverError(t *testing.T) {
+	backend, _ := newTestRemoteBackend(t)
+	_, err := backend.Start(context.Background(), ModeRelearn, nil)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
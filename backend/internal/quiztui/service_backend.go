@@ -0,0 +1,552 @@
+package quiztui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+// defaultRelearnWindow matches the web UI's relearn look-back.
+const defaultRelearnWindow = 24 * time.Hour
+
+// ServiceBackend runs sessions against a local quiz.Service, the same way
+// the RPC handlers do for the web UI.
+type ServiceBackend struct {
+	svc              *quiz.Service
+	includeUnstudied bool
+	relearnWindow    time.Duration
+	now              func() time.Time
+
+	mu     sync.Mutex
+	items  map[int64]any
+	nextID int64
+	// freeformCards is every word freeform answers are matched against.
+	freeformCards []quiz.FreeformCard
+
+	// writeMu serializes learning-history writes; answers are graded
+	// concurrently but the YAML store is read-modify-write.
+	writeMu sync.Mutex
+}
+
+// recordRef identifies the learning-history slot a result was written to.
+type recordRef struct {
+	info     quiz.CardInfo
+	quizType notebook.QuizType
+}
+
+// grammarItem is one blank of a journal entry.
+type grammarItem struct {
+	notebookID string
+	content    string
+	blank      quiz.GrammarBlank
+}
+
+// etymologyItem is one word asked under its origin.
+type etymologyItem struct {
+	card   quiz.Card
+	origin string
+}
+
+type freeformItem struct{}
+
+// NewServiceBackend returns a Backend over svc.
+func NewServiceBackend(svc *quiz.Service) *ServiceBackend {
+	return &ServiceBackend{
+		svc:           svc,
+		relearnWindow: defaultRelearnWindow,
+		now:           time.Now,
+		items:         make(map[int64]any),
+		nextID:        1,
+	}
+}
+
+// WithIncludeUnstudied returns a copy that also offers words never answered.
+func (b *ServiceBackend) WithIncludeUnstudied(includeUnstudied bool) *ServiceBackend {
+	return &ServiceBackend{
+		svc:              b.svc,
+		includeUnstudied: includeUnstudied,
+		relearnWindow:    b.relearnWindow,
+		now:              b.now,
+		items:            make(map[int64]any),
+		nextID:           1,
+	}
+}
+
+// Notebooks implements Backend.
+func (b *ServiceBackend) Notebooks(_ context.Context, mode Mode) ([]Notebook, error) {
+	var summaries []quiz.NotebookSummary
+	var err error
+	if mode == ModeGrammar {
+		summaries, err = b.svc.LoadGrammarStorySummaries()
+	} else {
+		summaries, err = b.svc.LoadNotebookSummaries(b.includeUnstudied)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load notebook summaries: %w", err)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		di, dj := summaries[i].LatestDate, summaries[j].LatestDate
+		if !di.Equal(dj) {
+			return di.After(dj)
+		}
+		return summaries[i].NotebookID < summaries[j].NotebookID
+	})
+
+	var notebooks []Notebook
+	for _, s := range summaries {
+		due := summaryDue(mode, s.ReviewCount, s.ReverseReviewCount, s.EtymologyReviewCount, s.GrammarReviewCount)
+		if due == 0 {
+			continue
+		}
+		nb := Notebook{ID: s.NotebookID, Name: s.Name, Due: due}
+		for _, sec := range s.Sections {
+			if d := summaryDue(mode, sec.ReviewCount, sec.ReverseReviewCount, sec.EtymologyReviewCount, sec.GrammarReviewCount); d > 0 {
+				nb.Sections = append(nb.Sections, Section{Title: sec.Title, Due: d})
+			}
+		}
+		notebooks = append(notebooks, nb)
+	}
+	return notebooks, nil
+}
+
+func summaryDue(mode Mode, recognition, reverse, etymology, grammar int) int {
+	switch mode {
+	case ModeReverse:
+		return reverse
+	case ModeEtymology:
+		return etymology
+	case ModeGrammar:
+		return grammar
+	default:
+		return recognition
+	}
+}
+
+// Start implements Backend.
+func (b *ServiceBackend) Start(_ context.Context, mode Mode, selections []Selection) ([]Question, error) {
+	notebookIDs := make([]string, 0, len(selections))
+	sectionTitles := make(map[string][]string)
+	for _, sel := range selections {
+		notebookIDs = append(notebookIDs, sel.NotebookID)
+		if len(sel.Sections) > 0 {
+			sectionTitles[sel.NotebookID] = sel.Sections
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.items = make(map[int64]any)
+
+	switch mode {
+	case ModeRecognition:
+		cards, err := b.svc.LoadCards(notebookIDs, b.includeUnstudied, sectionTitles)
+		if err != nil {
+			return nil, fmt.Errorf("load cards: %w", err)
+		}
+		questions := make([]Question, 0, len(cards))
+		for _, card := range cards {
+			questions = append(questions, b.add(card, recognitionQuestion(card)))
+		}
+		return questions, nil
+	case ModeReverse:
+		cards, err := b.svc.LoadReverseCards(notebookIDs, false, b.includeUnstudied, sectionTitles)
+		if err != nil {
+			return nil, fmt.Errorf("load reverse cards: %w", err)
+		}
+		questions := make([]Question, 0, len(cards))
+		for _, card := range cards {
+			questions = append(questions, b.add(card, reverseQuestion(card)))
+		}
+		return questions, nil
+	case ModeFreeform:
+		cards, err := b.svc.LoadAllWords()
+		if err != nil {
+			return nil, fmt.Errorf("load all words: %w", err)
+		}
+		b.freeformCards = cards
+		return []Question{b.add(freeformItem{}, Question{
+			Heading: fmt.Sprintf("%d words in your notebooks", len(cards)),
+			Prompt:  "Recall a word and its meaning",
+			Fields:  []string{"Word", "Meaning"},
+			Repeat:  true,
+		})}, nil
+	case ModeEtymology:
+		items, err := b.loadEtymologyItems(notebookIDs, sectionTitles)
+		if err != nil {
+			return nil, err
+		}
+		questions := make([]Question, 0, len(items))
+		for _, item := range items {
+			questions = append(questions, b.add(item, etymologyQuestion(item)))
+		}
+		return questions, nil
+	case ModeGrammar:
+		var questions []Question
+		for _, id := range notebookIDs {
+			posts, err := b.svc.LoadGrammarPosts(id, sectionTitles[id])
+			if err != nil {
+				return nil, fmt.Errorf("load grammar posts for %q: %w", id, err)
+			}
+			for _, post := range posts {
+				for _, blank := range post.Blanks {
+					item := grammarItem{notebookID: post.NotebookID, content: post.Content, blank: blank}
+					questions = append(questions, b.add(item, Question{
+						Heading: post.NotebookName + " › " + post.Title,
+						Prompt:  blank.Incorrect,
+						Context: []string{post.Content},
+						Fields:  []string{"Correction"},
+					}))
+				}
+			}
+		}
+		return questions, nil
+	case ModeRelearn:
+		cards, err := b.svc.LoadRelearnPool(b.now().Add(-b.relearnWindow))
+		if err != nil {
+			return nil, fmt.Errorf("load relearn pool: %w", err)
+		}
+		questions := make([]Question, 0, len(cards))
+		for _, card := range cards {
+			// The terminal quiz plays no audio, so a missed dictation
+			// line has nothing to show here.
+			if card.IsDictation() {
+				continue
+			}
+			questions = append(questions, b.add(card, relearnQuestion(card)))
+		}
+		return questions, nil
+	default:
+		return nil, fmt.Errorf("unknown quiz mode %q", mode)
+	}
+}
+
+// add stores item under a fresh question id. b.mu must be held.
+func (b *ServiceBackend) add(item any, q Question) Question {
+	q.ID = b.nextID
+	b.nextID++
+	b.items[q.ID] = item
+	return q
+}
+
+// loadEtymologyItems collects the words of the selected notebooks that
+// carry an origin and are due in the etymology-origin mode, grouped so the
+// words of one origin are asked one after another.
+func (b *ServiceBackend) loadEtymologyItems(notebookIDs []string, sectionTitles map[string][]string) ([]etymologyItem, error) {
+	words, err := b.svc.LoadAllWords()
+	if err != nil {
+		return nil, fmt.Errorf("load all words: %w", err)
+	}
+	selected := make(map[string]bool, len(notebookIDs))
+	for _, id := range notebookIDs {
+		selected[id] = true
+	}
+	today := b.now().Format("2006-01-02")
+
+	var items []etymologyItem
+	for _, w := range words {
+		if !selected[w.NotebookName] || len(w.WordDetail.OriginParts) == 0 {
+			continue
+		}
+		if titles := sectionTitles[w.NotebookName]; len(titles) > 0 && !containsString(titles, w.StoryTitle) {
+			continue
+		}
+		_, next := b.svc.GetLatestLearnedInfo(w.NotebookName, w.ID, w.Expression, notebook.QuizTypeEtymologyOrigin)
+		if next == "" && !b.includeUnstudied || next != "" && next > today {
+			continue
+		}
+		items = append(items, etymologyItem{
+			card: quiz.Card{
+				ID: w.ID, NotebookName: w.NotebookName, StoryTitle: w.StoryTitle, SceneTitle: w.SceneTitle,
+				Entry: w.Expression, OriginalEntry: w.OriginalExpression, Meaning: w.Meaning,
+				Examples: w.Examples, Contexts: w.Contexts, WordDetail: w.WordDetail, Images: w.Images,
+			},
+			origin: w.WordDetail.OriginParts[0].Origin,
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].origin < items[j].origin
+	})
+	return items, nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Submit implements Backend.
+func (b *ServiceBackend) Submit(ctx context.Context, question Question, answers []string, responseTime time.Duration) (Result, error) {
+	b.mu.Lock()
+	item, ok := b.items[question.ID]
+	b.mu.Unlock()
+	if !ok {
+		return Result{}, fmt.Errorf("question %d not found", question.ID)
+	}
+	answer := ""
+	if len(answers) > 0 {
+		answer = strings.TrimSpace(answers[0])
+	}
+	ms := responseTime.Milliseconds()
+
+	switch it := item.(type) {
+	case quiz.Card:
+		grade, err := b.grade(answer, func() (quiz.GradeResult, error) {
+			return b.svc.GradeNotebookAnswer(ctx, it, answer, ms)
+		})
+		if err != nil {
+			return Result{}, err
+		}
+		if err := b.write(func() error { return b.svc.SaveResult(ctx, it, grade, ms) }); err != nil {
+			return Result{}, fmt.Errorf("save result: %w", err)
+		}
+		return b.recorded(grade, it.Meaning, cardRef(it, notebook.QuizTypeNotebook), it.NotebookName, it.Entry), nil
+	case etymologyItem:
+		grade, err := b.grade(answer, func() (quiz.GradeResult, error) {
+			return b.svc.GradeNotebookAnswer(ctx, it.card, answer, ms)
+		})
+		if err != nil {
+			return Result{}, err
+		}
+		if err := b.write(func() error {
+			return b.svc.SaveEtymologyOriginResult(ctx, it.card, it.origin, "", grade, ms)
+		}); err != nil {
+			return Result{}, fmt.Errorf("save etymology result: %w", err)
+		}
+		return b.recorded(grade, it.card.Meaning, cardRef(it.card, notebook.QuizTypeEtymologyOrigin), it.card.NotebookName, it.card.Entry), nil
+	case quiz.ReverseCard:
+		grade, err := b.grade(answer, func() (quiz.GradeResult, error) {
+			return b.svc.GradeReverseAnswer(ctx, it, answer, ms)
+		})
+		if err != nil {
+			return Result{}, err
+		}
+		// A synonym isn't held against the learner and isn't recorded,
+		// same as the web UI.
+		if grade.Classification == string(inference.ClassificationSynonym) {
+			return Result{Correct: false, Expected: it.Expression, Reason: grade.Reason}, nil
+		}
+		if err := b.write(func() error { return b.svc.SaveReverseResult(ctx, it, grade, ms) }); err != nil {
+			return Result{}, fmt.Errorf("save reverse result: %w", err)
+		}
+		info := quiz.CardInfoFromReverseCard(it)
+		info.ID = it.ID
+		return b.recorded(grade, it.Expression, recordRef{info: info, quizType: notebook.QuizTypeReverse}, it.NotebookName, it.Expression), nil
+	case grammarItem:
+		grade, err := b.grade(answer, func() (quiz.GradeResult, error) {
+			return b.svc.GradeGrammarBlank(ctx, it.content, it.blank, answer, ms)
+		})
+		if err != nil {
+			return Result{}, err
+		}
+		if err := b.write(func() error {
+			return b.svc.SaveGrammarBlank(ctx, it.notebookID, it.blank.SenseID, grade, ms)
+		}); err != nil {
+			return Result{}, fmt.Errorf("save grammar result: %w", err)
+		}
+		info := quiz.CardInfo{
+			NotebookName: it.notebookID,
+			StoryTitle:   notebook.JournalStoryTitle,
+			Expression:   it.blank.SenseID,
+			ID:           it.blank.SenseID,
+		}
+		result := b.recorded(grade, it.blank.Correct, recordRef{info: info, quizType: notebook.QuizTypeGrammar}, it.notebookID, it.blank.SenseID)
+		if it.blank.Reason != "" {
+			result.Reason = strings.TrimSpace(result.Reason + " " + it.blank.Reason)
+		}
+		return result, nil
+	case quiz.RelearnCard:
+		grade, err := b.grade(answer, func() (quiz.GradeResult, error) {
+			return b.gradeRelearn(ctx, it, answer, ms)
+		})
+		if err != nil {
+			return Result{}, err
+		}
+		return Result{Correct: grade.Correct, Expected: relearnExpected(it), Reason: grade.Reason}, nil
+	case freeformItem:
+		meaning := ""
+		if len(answers) > 1 {
+			meaning = strings.TrimSpace(answers[1])
+		}
+		b.mu.Lock()
+		cards := b.freeformCards
+		b.mu.Unlock()
+		grade, err := b.svc.GradeFreeformAnswer(ctx, answer, meaning, ms, cards)
+		if err != nil {
+			return Result{}, fmt.Errorf("grade answer: %w", err)
+		}
+		result := Result{Correct: grade.Correct, Expected: grade.Word + ": " + grade.Meaning, Reason: grade.Reason}
+		if grade.MatchedCard == nil {
+			return result, nil
+		}
+		card := *grade.MatchedCard
+		if err := b.write(func() error { return b.svc.SaveFreeformResult(ctx, card, grade, ms) }); err != nil {
+			return Result{}, fmt.Errorf("save freeform result: %w", err)
+		}
+		info := quiz.CardInfoFromFreeformCard(card)
+		info.ID = card.ID
+		result.LearnedAt, result.NextReviewDate = b.svc.GetLatestLearnedInfo(card.NotebookName, card.ID, card.Expression, notebook.QuizTypeFreeform)
+		result.Recorded = true
+		result.Ref = recordRef{info: info, quizType: notebook.QuizTypeFreeform}
+		return result, nil
+	default:
+		return Result{}, fmt.Errorf("unsupported question %d", question.ID)
+	}
+}
+
+// grade calls grader unless the answer is empty, which is recorded as
+// "don't know" without a grading round-trip.
+func (b *ServiceBackend) grade(answer string, grader func() (quiz.GradeResult, error)) (quiz.GradeResult, error) {
+	if answer == "" {
+		return quiz.GradeResult{
+			Correct:        false,
+			Reason:         "skipped by user",
+			Quality:        int(notebook.QualityWrong),
+			Classification: string(inference.ClassificationWrong),
+			Grader:         notebook.GraderDeterministic,
+		}, nil
+	}
+	grade, err := grader()
+	if err != nil {
+		return quiz.GradeResult{}, fmt.Errorf("grade answer: %w", err)
+	}
+	return grade, nil
+}
+
+func (b *ServiceBackend) write(save func() error) error {
+	b.writeMu.Lock()
+	defer b.writeMu.Unlock()
+	return save()
+}
+
+func (b *ServiceBackend) recorded(grade quiz.GradeResult, expected string, ref recordRef, notebookName, expression string) Result {
+	learnedAt, next := b.svc.GetLatestLearnedInfo(notebookName, ref.info.ID, expression, ref.quizType)
+	return Result{
+		Correct:        grade.Correct,
+		Expected:       expected,
+		Reason:         grade.Reason,
+		NextReviewDate: next,
+		LearnedAt:      learnedAt,
+		Recorded:       true,
+		Ref:            ref,
+	}
+}
+
+func cardRef(card quiz.Card, quizType notebook.QuizType) recordRef {
+	info := quiz.CardInfoFromCard(card)
+	info.ID = card.ID
+	return recordRef{info: info, quizType: quizType}
+}
+
+// gradeRelearn grades a relearn card in the direction it was missed in,
+// like the relearn RPC handler.
+func (b *ServiceBackend) gradeRelearn(ctx context.Context, card quiz.RelearnCard, answer string, ms int64) (quiz.GradeResult, error) {
+	switch {
+	case card.IsGrammar():
+		return b.svc.GradeGrammarBlank(ctx, card.Content, card.GrammarCard(), answer, ms)
+	case relearnAsksWord(card):
+		return b.svc.GradeReverseAnswer(ctx, card.ReverseCard(), answer, ms)
+	default:
+		return b.svc.GradeNotebookAnswer(ctx, card.VocabCard(), answer, ms)
+	}
+}
+
+// Override implements Backend.
+func (b *ServiceBackend) Override(_ context.Context, result Result) (Result, error) {
+	ref, ok := result.Ref.(recordRef)
+	if !ok {
+		return result, fmt.Errorf("result was not recorded")
+	}
+	info := ref.info
+	info.LearnedAt = result.LearnedAt
+	markCorrect := !result.Correct
+	info.MarkCorrect = &markCorrect
+
+	var res quiz.OverrideResult
+	err := b.write(func() error {
+		var err error
+		res, err = b.svc.OverrideAnswer(info, ref.quizType)
+		return err
+	})
+	if err != nil {
+		return result, err
+	}
+	result.Correct = markCorrect
+	result.NextReviewDate = res.NextReviewDate
+	result.Override = &Override{
+		OriginalQuality:      res.OriginalQuality,
+		OriginalStatus:       res.OriginalStatus,
+		OriginalIntervalDays: res.OriginalIntervalDays,
+		OriginalGrader:       res.OriginalGrader,
+	}
+	return result, nil
+}
+
+// UndoOverride implements Backend.
+func (b *ServiceBackend) UndoOverride(_ context.Context, result Result) (Result, error) {
+	ref, ok := result.Ref.(recordRef)
+	if !ok || result.Override == nil {
+		return result, fmt.Errorf("result was not overridden")
+	}
+	info := ref.info
+	info.LearnedAt = result.LearnedAt
+	info.OriginalQuality = result.Override.OriginalQuality
+	info.OriginalStatus = result.Override.OriginalStatus
+	info.OriginalIntervalDays = result.Override.OriginalIntervalDays
+	info.OriginalGrader = result.Override.OriginalGrader
+
+	var correct bool
+	var next string
+	err := b.write(func() error {
+		var err error
+		correct, next, err = b.svc.UndoOverrideAnswer(info, ref.quizType)
+		return err
+	})
+	if err != nil {
+		return result, err
+	}
+	result.Correct = correct
+	result.NextReviewDate = next
+	result.Override = nil
+	return result, nil
+}
+
+// Exclude implements Backend.
+func (b *ServiceBackend) Exclude(_ context.Context, result Result) (Result, error) {
+	ref, ok := result.Ref.(recordRef)
+	if !ok {
+		return result, fmt.Errorf("result was not recorded")
+	}
+	if err := b.write(func() error {
+		return b.svc.SkipWord(ref.info, "", []notebook.QuizType{ref.quizType})
+	}); err != nil {
+		return result, err
+	}
+	result.Excluded = true
+	return result, nil
+}
+
+// Resume implements Backend.
+func (b *ServiceBackend) Resume(_ context.Context, result Result) (Result, error) {
+	ref, ok := result.Ref.(recordRef)
+	if !ok {
+		return result, fmt.Errorf("result was not recorded")
+	}
+	if err := b.write(func() error {
+		return b.svc.ResumeWord(ref.info, []notebook.QuizType{ref.quizType})
+	}); err != nil {
+		return result, err
+	}
+	result.Excluded = false
+	return result, nil
+}
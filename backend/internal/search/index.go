@@ -0,0 +1,195 @@
+// Package search indexes the text of every notebook — story lines, notes
+// and their examples — so a word can be looked up across all of them,
+// matching its inflected forms as well as the form typed. PassageIndex
+// adds ranked full-text search over ebook paragraphs and story lines.
+package search
+
+import (
+	"slices"
+)
+
+// DocumentKind is what part of a notebook a document was taken from.
+type DocumentKind string
+
+const (
+	// DocumentKindConversation is a line a speaker says in a story scene.
+	DocumentKindConversation DocumentKind = "conversation"
+	// DocumentKindStatement is a narration line of a story scene.
+	DocumentKindStatement DocumentKind = "statement"
+	// DocumentKindNote is a vocabulary note of a scene, card or definitions
+	// book, matched on its expression and definition.
+	DocumentKindNote DocumentKind = "note"
+	// DocumentKindExample is an example sentence of a note.
+	DocumentKindExample DocumentKind = "example"
+)
+
+// Document is one searchable piece of a notebook with where it came from.
+type Document struct {
+	Kind          DocumentKind `json:"kind"`
+	NotebookID    string       `json:"notebook_id"`
+	NotebookTitle string       `json:"notebook_title,omitempty"`
+	// StoryTitle is the story event, flashcard deck title or definitions
+	// session the document belongs to.
+	StoryTitle string `json:"story_title,omitempty"`
+	SceneTitle string `json:"scene_title,omitempty"`
+	Speaker    string `json:"speaker,omitempty"`
+	// Text is the line or example sentence, with notebook markers removed.
+	// It is empty for notes.
+	Text string `json:"text,omitempty"`
+	// Expression, Definition and Meaning are the note the document is, or
+	// the note an example belongs to.
+	Expression string `json:"expression,omitempty"`
+	Definition string `json:"definition,omitempty"`
+	Meaning    string `json:"meaning,omitempty"`
+}
+
+// searchableFields returns the texts of the document matched by a search.
+func (d Document) searchableFields() []string {
+	if d.Kind == DocumentKindNote {
+		return []string{d.Expression, d.Definition}
+	}
+	return []string{d.Text}
+}
+
+// Occurrence is a document that mentions the searched word.
+type Occurrence struct {
+	Document
+	// Highlight is the first matched text as written in the document,
+	// e.g. "ran into" for a search of "run into".
+	Highlight string `json:"highlight"`
+}
+
+// SearchOptions narrows a search.
+type SearchOptions struct {
+	// NotebookIDs limits the search to these notebooks; empty searches all.
+	NotebookIDs []string
+	// Limit caps the number of occurrences returned; 0 returns all.
+	Limit int
+}
+
+// posting is where a lemma appears: a token of a field of a document.
+type posting struct {
+	doc, field, pos int
+}
+
+// Index is an in-memory inverted index from lemmas to the documents using
+// them. It is safe for concurrent searches once built.
+type Index struct {
+	documents []Document
+	// fields holds the tokens of each searchable field of each document.
+	fields   [][][]token
+	postings map[string][]posting
+}
+
+// NewIndex indexes the documents.
+func NewIndex(documents []Document) *Index {
+	index := &Index{
+		documents: documents,
+		fields:    make([][][]token, len(documents)),
+		postings:  make(map[string][]posting),
+	}
+	for d, document := range documents {
+		texts := document.searchableFields()
+		index.fields[d] = make([][]token, len(texts))
+		for f, text := range texts {
+			tokens := tokenize(text)
+			index.fields[d][f] = tokens
+			for p, tok := range tokens {
+				for _, lemma := range tok.Lemmas {
+					index.postings[lemma] = append(index.postings[lemma], posting{doc: d, field: f, pos: p})
+				}
+			}
+		}
+	}
+	return index
+}
+
+// Documents returns the indexed documents.
+func (index *Index) Documents() []Document {
+	return index.documents
+}
+
+// Search returns the documents that mention the query, in notebook order,
+// with the total number of matching documents before the limit. A query of
+// several words matches them as a phrase, each word in any of its forms.
+func (index *Index) Search(query string, opts SearchOptions) ([]Occurrence, int) {
+	words := tokenize(query)
+	if len(words) == 0 {
+		return nil, 0
+	}
+
+	// Every posting of any form of the first word is a candidate start
+	// of the phrase; the same token can be reached through several lemmas.
+	type start struct{ field, pos int }
+	starts := make(map[int][]start)
+	seen := make(map[posting]bool)
+	for _, lemma := range words[0].Lemmas {
+		for _, p := range index.postings[lemma] {
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+			starts[p.doc] = append(starts[p.doc], start{field: p.field, pos: p.pos})
+		}
+	}
+
+	docs := make([]int, 0, len(starts))
+	for d := range starts {
+		if len(opts.NotebookIDs) > 0 && !slices.Contains(opts.NotebookIDs, index.documents[d].NotebookID) {
+			continue
+		}
+		docs = append(docs, d)
+	}
+	slices.Sort(docs)
+
+	var occurrences []Occurrence
+	total := 0
+	for _, d := range docs {
+		candidates := starts[d]
+		slices.SortFunc(candidates, func(a, b start) int {
+			if a.field != b.field {
+				return a.field - b.field
+			}
+			return a.pos - b.pos
+		})
+		for _, c := range candidates {
+			tokens := index.fields[d][c.field]
+			if !matchesPhrase(tokens[c.pos:], words) {
+				continue
+			}
+			total++
+			if opts.Limit <= 0 || len(occurrences) < opts.Limit {
+				text := index.documents[d].searchableFields()[c.field]
+				occurrences = append(occurrences, Occurrence{
+					Document:  index.documents[d],
+					Highlight: text[tokens[c.pos].Start:tokens[c.pos+len(words)-1].End],
+				})
+			}
+			break
+		}
+	}
+	return occurrences, total
+}
+
+// matchesPhrase reports whether tokens start with a form of each word of
+// the phrase in order.
+func matchesPhrase(tokens, words []token) bool {
+	if len(tokens) < len(words) {
+		return false
+	}
+	for i, word := range words {
+		if !shareLemma(tokens[i].Lemmas, word.Lemmas) {
+			return false
+		}
+	}
+	return true
+}
+
+func shareLemma(a, b []string) bool {
+	for _, lemma := range a {
+		if slices.Contains(b, lemma) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex_Search(t *testing.T) {
+	documents := []Document{
+		{Kind: DocumentKindConversation, NotebookID: "drama", Speaker: "Ann", Text: "I ran into Bob yesterday."},
+		{Kind: DocumentKindStatement, NotebookID: "drama", Text: "Nobody runs into anyone here."},
+		{Kind: DocumentKindNote, NotebookID: "vocab", Expression: "run into", Meaning: "to meet by chance"},
+		{Kind: DocumentKindExample, NotebookID: "vocab", Expression: "run into", Text: "We run out of time, then into trouble."},
+		{Kind: DocumentKindConversation, NotebookID: "drama", Speaker: "Bob", Text: "Cities never sleep; the city woke."},
+	}
+	index := NewIndex(documents)
+
+	tests := []struct {
+		name          string
+		query         string
+		opts          SearchOptions
+		wantHighlight []string
+		wantTotal     int
+	}{
+		{
+			name:          "phrase in any inflected form",
+			query:         "run into",
+			wantHighlight: []string{"ran into", "runs into", "run into"},
+			wantTotal:     3,
+		},
+		{
+			name:          "plural matches the singular once per document",
+			query:         "city",
+			wantHighlight: []string{"Cities"},
+			wantTotal:     1,
+		},
+		{
+			name:          "notebook filter",
+			query:         "Run Into",
+			opts:          SearchOptions{NotebookIDs: []string{"vocab"}},
+			wantHighlight: []string{"run into"},
+			wantTotal:     1,
+		},
+		{
+			name:          "limit keeps the total",
+			query:         "ran into",
+			opts:          SearchOptions{Limit: 1},
+			wantHighlight: []string{"ran into"},
+			wantTotal:     3,
+		},
+		{
+			name:  "no match",
+			query: "into run",
+		},
+		{
+			name:  "no words",
+			query: " ?! ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occurrences, total := index.Search(tt.query, tt.opts)
+			var highlights []string
+			for _, o := range occurrences {
+				highlights = append(highlights, o.Highlight)
+			}
+			assert.Equal(t, tt.wantHighlight, highlights)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+
+	occurrences, _ := index.Search("run into", SearchOptions{})
+	assert.Equal(t, documents[0], occurrences[0].Document)
+}
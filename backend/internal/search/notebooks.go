@@ -0,0 +1,124 @@
+package search
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// DocumentsFromReader collects the documents of every notebook the reader
+// loaded: each line and statement of a story or book scene, each note of a
+// scene, flashcard deck or definitions book, and each note's examples.
+// Notebooks come in ID order so search results are stable.
+func DocumentsFromReader(reader *notebook.Reader) ([]Document, error) {
+	var documents []Document
+
+	storyIndexes := reader.GetStoryIndexes()
+	for _, id := range sortedKeys(storyIndexes) {
+		stories, err := reader.ReadStoryNotebooks(id)
+		if err != nil {
+			return nil, fmt.Errorf("ReadStoryNotebooks(%s) > %w", id, err)
+		}
+		for _, story := range stories {
+			for _, scene := range story.Scenes {
+				base := Document{
+					NotebookID:    id,
+					NotebookTitle: storyIndexes[id].Name,
+					StoryTitle:    story.Event,
+					SceneTitle:    scene.Title,
+				}
+				for _, conversation := range scene.Conversations {
+					doc := base
+					doc.Kind = DocumentKindConversation
+					doc.Speaker = conversation.Speaker
+					doc.Text = plainText(conversation.Quote)
+					documents = append(documents, doc)
+				}
+				for _, statement := range scene.Statements {
+					doc := base
+					doc.Kind = DocumentKindStatement
+					doc.Text = plainText(statement)
+					documents = append(documents, doc)
+				}
+				documents = appendNotes(documents, base, scene.Definitions)
+			}
+		}
+	}
+
+	flashcardIndexes := reader.GetFlashcardIndexes()
+	for _, id := range sortedKeys(flashcardIndexes) {
+		decks, err := reader.ReadFlashcardNotebooks(id)
+		if err != nil {
+			return nil, fmt.Errorf("ReadFlashcardNotebooks(%s) > %w", id, err)
+		}
+		for _, deck := range decks {
+			documents = appendNotes(documents, Document{
+				NotebookID:    id,
+				NotebookTitle: flashcardIndexes[id].Name,
+				StoryTitle:    deck.Title,
+			}, deck.Cards)
+		}
+	}
+
+	// Definitions of a book are merged into its story scenes above; only
+	// definitions books without a story or flashcard notebook of their own
+	// are read here.
+	bookIDs := reader.GetDefinitionsBookIDs()
+	slices.Sort(bookIDs)
+	for _, id := range bookIDs {
+		if _, ok := storyIndexes[id]; ok {
+			continue
+		}
+		if _, ok := flashcardIndexes[id]; ok {
+			continue
+		}
+		sessions, ok := reader.GetDefinitionsNotesByTitle(id)
+		if !ok {
+			continue
+		}
+		for _, session := range sortedKeys(sessions) {
+			for _, scene := range sortedKeys(sessions[session]) {
+				documents = appendNotes(documents, Document{
+					NotebookID: id,
+					StoryTitle: session,
+					SceneTitle: scene,
+				}, sessions[session][scene])
+			}
+		}
+	}
+	return documents, nil
+}
+
+// appendNotes appends a note document and its example documents for each
+// note, placed where base says.
+func appendNotes(documents []Document, base Document, notes []notebook.Note) []Document {
+	for _, note := range notes {
+		doc := base
+		doc.Kind = DocumentKindNote
+		doc.Expression = note.Expression
+		doc.Definition = note.Definition
+		doc.Meaning = note.Meaning
+		documents = append(documents, doc)
+		for _, example := range note.Examples {
+			exampleDoc := doc
+			exampleDoc.Kind = DocumentKindExample
+			exampleDoc.Text = plainText(example.Text)
+			documents = append(documents, exampleDoc)
+		}
+	}
+	return documents
+}
+
+func plainText(text string) string {
+	return notebook.ConvertMarkersInText(text, nil, notebook.ConversionStylePlain, "")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
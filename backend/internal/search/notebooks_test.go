@@ -0,0 +1,77 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// writeSearchFixture writes a story notebook and a flashcard notebook that
+// both use "put up with", and returns the directories they are in.
+func writeSearchFixture(t *testing.T) notebook.ReaderDirectories {
+	t.Helper()
+	dir := t.TempDir()
+	storyDir := filepath.Join(dir, "stories", "drama")
+	require.NoError(t, os.MkdirAll(storyDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(storyDir, "index.yml"), []byte(
+		"id: drama\nname: Office Drama\nnotebooks:\n  - ./episode1.yml\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(storyDir, "episode1.yml"), []byte(`- event: "Episode 1"
+  date: 2025-01-15T00:00:00Z
+  scenes:
+    - scene: "Kitchen"
+      conversations:
+        - speaker: "Bob"
+          quote: "I can't {{ put up with }} this noise anymore."
+      statements:
+        - "Ann puts up with him."
+      definitions:
+        - expression: "put up with"
+          meaning: "to tolerate"
+`), 0o644))
+
+	flashcardDir := filepath.Join(dir, "flashcards", "vocab")
+	require.NoError(t, os.MkdirAll(flashcardDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(flashcardDir, "index.yml"), []byte(
+		"id: vocab\nname: Vocabulary\nnotebooks:\n  - ./cards.yml\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(flashcardDir, "cards.yml"), []byte(`- title: "Phrasal verbs"
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: "tolerate"
+      meaning: "to allow without complaint"
+      examples:
+        - "She {{ put up with }} the delay."
+`), 0o644))
+
+	return notebook.ReaderDirectories{
+		Stories:    []string{filepath.Join(dir, "stories")},
+		Flashcards: []string{filepath.Join(dir, "flashcards")},
+	}
+}
+
+func TestDocumentsFromReader(t *testing.T) {
+	reader, err := notebook.NewReaderFromDirectories(writeSearchFixture(t), nil)
+	require.NoError(t, err)
+
+	got, err := DocumentsFromReader(reader)
+	require.NoError(t, err)
+
+	scene := Document{NotebookID: "drama", NotebookTitle: "Office Drama", StoryTitle: "Episode 1", SceneTitle: "Kitchen"}
+	conversation := scene
+	conversation.Kind, conversation.Speaker, conversation.Text = DocumentKindConversation, "Bob", "I can't put up with this noise anymore."
+	statement := scene
+	statement.Kind, statement.Text = DocumentKindStatement, "Ann puts up with him."
+	sceneNote := scene
+	sceneNote.Kind, sceneNote.Expression, sceneNote.Meaning = DocumentKindNote, "put up with", "to tolerate"
+	card := Document{
+		Kind: DocumentKindNote, NotebookID: "vocab", NotebookTitle: "Vocabulary", StoryTitle: "Phrasal verbs",
+		Expression: "tolerate", Meaning: "to allow without complaint",
+	}
+	example := card
+	example.Kind, example.Text = DocumentKindExample, "She put up with the delay."
+	assert.Equal(t, []Document{conversation, statement, sceneNote, card, example}, got)
+}
@@ -0,0 +1,465 @@
+package search
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+)
+
+// PassageKind is where a passage was read from.
+type PassageKind string
+
+const (
+	// PassageKindBook is a paragraph of a cloned ebook.
+	PassageKindBook PassageKind = "book"
+	// PassageKindStory is a line or statement of a story scene.
+	PassageKindStory PassageKind = "story"
+)
+
+// Passage is a paragraph of an ebook or a line of a story scene, the unit
+// full-text searches rank and return.
+type Passage struct {
+	Kind PassageKind
+	// SourceID is the ebook or story notebook ID. An ebook's ID is also the
+	// ID of the book notebook generated from it.
+	SourceID    string
+	SourceTitle string
+	// Section is the chapter title of a book or the event of a story.
+	Section string
+	Scene   string
+	Speaker string
+	// Position is the 1-based number of the paragraph in its chapter, or
+	// of the line in its scene.
+	Position int
+	Text     string
+}
+
+// PassageHit is a passage matching a full-text search.
+type PassageHit struct {
+	Passage
+	Score float64
+	// Snippet is the part of the text around the best match, with every
+	// matched word wrapped in {{ }} markers like notebook text, and "…"
+	// where the text was cut.
+	Snippet string
+}
+
+// PassageSearchOptions narrows and pages a full-text search.
+type PassageSearchOptions struct {
+	// SourceIDs limits the search to these ebooks and notebooks; empty
+	// searches all.
+	SourceIDs []string
+	// Offset skips this many hits; Limit caps the hits returned, 0
+	// returning all.
+	Offset, Limit int
+}
+
+// passageSegment indexes the passages of one source. Segments are never
+// modified once built, so a changed source is re-indexed on its own and
+// swapped in without touching the others.
+type passageSegment struct {
+	sourceID string
+	passages []Passage
+	tokens   [][]token
+	postings map[string][]posting
+	length   int
+}
+
+func newPassageSegment(sourceID string, passages []Passage) *passageSegment {
+	segment := &passageSegment{
+		sourceID: sourceID,
+		passages: passages,
+		tokens:   make([][]token, len(passages)),
+		postings: make(map[string][]posting),
+	}
+	for d, passage := range passages {
+		tokens := tokenize(passage.Text)
+		segment.tokens[d] = tokens
+		segment.length += len(tokens)
+		for p, tok := range tokens {
+			for _, lemma := range tok.Lemmas {
+				segment.postings[lemma] = append(segment.postings[lemma], posting{doc: d, pos: p})
+			}
+		}
+	}
+	return segment
+}
+
+// PassageIndex ranks passages against full-text queries. It is an
+// immutable snapshot: PassageStore builds a new one sharing the segments
+// of every source that didn't change.
+type PassageIndex struct {
+	// sources are the keys of the segments in search order.
+	sources  []string
+	segments map[string]*passageSegment
+}
+
+// NewPassageIndex indexes the passages, keeping the sources in the order
+// they first appear.
+func NewPassageIndex(passages []Passage) *PassageIndex {
+	var sources []string
+	bySource := make(map[string][]Passage)
+	for _, passage := range passages {
+		key := passage.sourceKey()
+		if _, ok := bySource[key]; !ok {
+			sources = append(sources, key)
+		}
+		bySource[key] = append(bySource[key], passage)
+	}
+	index := &PassageIndex{sources: sources, segments: make(map[string]*passageSegment, len(sources))}
+	for _, key := range sources {
+		index.segments[key] = newPassageSegment(bySource[key][0].SourceID, bySource[key])
+	}
+	return index
+}
+
+// sourceKey tells an ebook and a story notebook with the same ID apart.
+func (p Passage) sourceKey() string {
+	return passageSourceKey(p.Kind, p.SourceID)
+}
+
+func passageSourceKey(kind PassageKind, id string) string {
+	return string(kind) + "/" + id
+}
+
+// Len returns the number of indexed passages.
+func (index *PassageIndex) Len() int {
+	n := 0
+	for _, segment := range index.segments {
+		n += len(segment.passages)
+	}
+	return n
+}
+
+// BM25 parameters, and the factor a passage's score is multiplied by when
+// it contains all the words of the query as one phrase.
+const (
+	bm25K1      = 1.2
+	bm25B       = 0.75
+	phraseBoost = 2.0
+)
+
+// Search ranks the passages containing every word of the query, in any
+// inflected form, by BM25. Words in double quotes must appear together as
+// a phrase; unquoted words may appear anywhere in the passage, but
+// passages where they read as the typed phrase rank higher. In a phrase,
+// "one's" stands for any possessive, so "at one's wits' end" finds "at my
+// wits' end". It returns the requested page of hits and the total count.
+func (index *PassageIndex) Search(query string, opts PassageSearchOptions) ([]PassageHit, int) {
+	q := parsePassageQuery(query)
+	if len(q.phrases) == 0 {
+		return nil, 0
+	}
+
+	total, length := 0, 0
+	for _, segment := range index.segments {
+		total += len(segment.passages)
+		length += segment.length
+	}
+	if total == 0 {
+		return nil, 0
+	}
+	avgLength := float64(length) / float64(total)
+
+	// Document frequencies span every segment, so scores are comparable
+	// across sources.
+	scored := q.scoredTerms()
+	termHits := make(map[string][]map[int]int, len(index.segments))
+	df := make([]int, len(scored))
+	for source, segment := range index.segments {
+		hits := make([]map[int]int, len(scored))
+		for i, term := range scored {
+			hits[i] = segment.termFrequencies(term)
+			df[i] += len(hits[i])
+		}
+		termHits[source] = hits
+	}
+	idf := make([]float64, len(scored))
+	for i := range scored {
+		idf[i] = math.Log(1 + (float64(total)-float64(df[i])+0.5)/(float64(df[i])+0.5))
+	}
+
+	type rankedHit struct {
+		score       float64
+		source, doc int
+		// wholeStart is where the whole query matches as a phrase, or -1.
+		wholeStart int
+	}
+	var ranked []rankedHit
+	for s, source := range index.sources {
+		segment := index.segments[source]
+		if len(opts.SourceIDs) > 0 && !slices.Contains(opts.SourceIDs, segment.sourceID) {
+			continue
+		}
+		for _, doc := range segment.matchingDocs(q, termHits[source]) {
+			tokens := segment.tokens[doc]
+			score := 0.0
+			for i := range scored {
+				tf := float64(termHits[source][i][doc])
+				norm := 1 - bm25B + bm25B*float64(len(tokens))/avgLength
+				score += idf[i] * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+			}
+			wholeStart := -1
+			if len(q.whole) > 1 {
+				if starts := phraseStarts(segment.passages[doc].Text, tokens, q.whole); len(starts) > 0 {
+					score *= phraseBoost
+					wholeStart = starts[0]
+				}
+			}
+			ranked = append(ranked, rankedHit{score: score, source: s, doc: doc, wholeStart: wholeStart})
+		}
+	}
+	slices.SortFunc(ranked, func(a, b rankedHit) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		if a.source != b.source {
+			return a.source - b.source
+		}
+		return a.doc - b.doc
+	})
+
+	start := min(max(opts.Offset, 0), len(ranked))
+	end := len(ranked)
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, end)
+	}
+	// Snippets are cut for the returned page only.
+	hits := make([]PassageHit, 0, end-start)
+	for _, r := range ranked[start:end] {
+		segment := index.segments[index.sources[r.source]]
+		passage, tokens := segment.passages[r.doc], segment.tokens[r.doc]
+		spans := q.matchSpans(passage.Text, tokens)
+		if r.wholeStart >= 0 {
+			for _, start := range phraseStarts(passage.Text, tokens, q.whole) {
+				spans = append(spans, tokenSpan{start, start + len(q.whole)})
+			}
+		}
+		hits = append(hits, PassageHit{
+			Passage: passage,
+			Score:   r.score,
+			Snippet: snippet(passage.Text, tokens, spans, r.wholeStart),
+		})
+	}
+	return hits, len(ranked)
+}
+
+// termFrequencies counts, for each passage using the term, how many of its
+// words are a form of the term.
+func (segment *passageSegment) termFrequencies(term queryTerm) map[int]int {
+	counts := make(map[int]int)
+	seen := make(map[posting]bool)
+	for _, lemma := range term.lemmas {
+		for _, p := range segment.postings[lemma] {
+			if !seen[p] {
+				seen[p] = true
+				counts[p.doc]++
+			}
+		}
+	}
+	return counts
+}
+
+// matchingDocs returns the passages, in order, containing every phrase of
+// the query. termHits are the frequencies of q.scoredTerms() in the
+// segment, which narrow the candidates before phrases are checked.
+func (segment *passageSegment) matchingDocs(q passageQuery, termHits []map[int]int) []int {
+	var candidates []int
+	for doc := range termHits[0] {
+		candidates = append(candidates, doc)
+	}
+	slices.Sort(candidates)
+
+	var docs []int
+candidate:
+	for _, doc := range candidates {
+		for i := 1; i < len(termHits); i++ {
+			if termHits[i][doc] == 0 {
+				continue candidate
+			}
+		}
+		for _, phrase := range q.phrases {
+			if len(phraseStarts(segment.passages[doc].Text, segment.tokens[doc], phrase)) == 0 {
+				continue candidate
+			}
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+// queryTerm is one word of a query.
+type queryTerm struct {
+	lemmas []string
+	// possessive is set for "one's", which matches any possessive.
+	possessive bool
+}
+
+func (term queryTerm) matches(text string, tok token) bool {
+	if term.possessive {
+		return isPossessive(text[tok.Start:tok.End])
+	}
+	return shareLemma(tok.Lemmas, term.lemmas)
+}
+
+// possessiveDeterminers are the words "one's" stands for besides nouns
+// ending in 's.
+var possessiveDeterminers = map[string]bool{
+	"my": true, "your": true, "his": true, "her": true, "its": true,
+	"our": true, "their": true, "one's": true,
+}
+
+func isPossessive(word string) bool {
+	word = strings.ToLower(strings.ReplaceAll(word, "’", "'"))
+	return possessiveDeterminers[word] || strings.HasSuffix(word, "'s")
+}
+
+// passageQuery is a parsed full-text query. Every phrase must match; an
+// unquoted word is a phrase of one word. whole is every word in the order
+// typed.
+type passageQuery struct {
+	phrases [][]queryTerm
+	whole   []queryTerm
+}
+
+func parsePassageQuery(query string) passageQuery {
+	var q passageQuery
+	for i, part := range strings.Split(query, `"`) {
+		var terms []queryTerm
+		for _, tok := range tokenize(part) {
+			raw := strings.ToLower(strings.ReplaceAll(part[tok.Start:tok.End], "’", "'"))
+			terms = append(terms, queryTerm{lemmas: tok.Lemmas, possessive: raw == "one's"})
+		}
+		q.whole = append(q.whole, terms...)
+		quoted := i%2 == 1
+		if quoted {
+			if hasWord(terms) {
+				q.phrases = append(q.phrases, terms)
+			}
+			continue
+		}
+		for _, term := range terms {
+			if !term.possessive {
+				q.phrases = append(q.phrases, []queryTerm{term})
+			}
+		}
+	}
+	return q
+}
+
+// scoredTerms returns the words BM25 scores, each once; "one's" isn't one.
+func (q passageQuery) scoredTerms() []queryTerm {
+	var terms []queryTerm
+	for _, term := range q.whole {
+		if term.possessive {
+			continue
+		}
+		duplicate := slices.ContainsFunc(terms, func(t queryTerm) bool {
+			return slices.Equal(t.lemmas, term.lemmas)
+		})
+		if !duplicate {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+func hasWord(terms []queryTerm) bool {
+	return slices.ContainsFunc(terms, func(term queryTerm) bool { return !term.possessive })
+}
+
+// phraseStarts returns every token position the phrase starts at.
+func phraseStarts(text string, tokens []token, phrase []queryTerm) []int {
+	var starts []int
+	for start := 0; start+len(phrase) <= len(tokens); start++ {
+		matched := true
+		for i, term := range phrase {
+			if !term.matches(text, tokens[start+i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			starts = append(starts, start)
+		}
+	}
+	return starts
+}
+
+// tokenSpan is a range of token positions, end exclusive.
+type tokenSpan struct {
+	start, end int
+}
+
+// matchSpans returns where each phrase of the query matches the passage.
+func (q passageQuery) matchSpans(text string, tokens []token) []tokenSpan {
+	var spans []tokenSpan
+	for _, phrase := range q.phrases {
+		for _, start := range phraseStarts(text, tokens, phrase) {
+			spans = append(spans, tokenSpan{start, start + len(phrase)})
+		}
+	}
+	return spans
+}
+
+// snippetRadius is how many words a snippet keeps on each side of the
+// match it is centred on.
+const snippetRadius = 12
+
+// snippet cuts the text around the match at focus, or the first match when
+// focus is negative, and wraps the matched words in {{ }} markers.
+func snippet(text string, tokens []token, spans []tokenSpan, focus int) string {
+	if len(spans) == 0 {
+		return text
+	}
+	slices.SortFunc(spans, func(a, b tokenSpan) int {
+		if a.start != b.start {
+			return a.start - b.start
+		}
+		return b.end - a.end
+	})
+	// Overlapping and adjacent spans are marked as one.
+	merged := []tokenSpan{spans[0]}
+	for _, span := range spans[1:] {
+		last := &merged[len(merged)-1]
+		if span.start <= last.end {
+			last.end = max(last.end, span.end)
+			continue
+		}
+		merged = append(merged, span)
+	}
+	if focus < 0 {
+		focus = merged[0].start
+	}
+
+	first := max(focus-snippetRadius, 0)
+	last := min(focus+snippetRadius, len(tokens)-1)
+	from, to := 0, len(text)
+	var b strings.Builder
+	if first > 0 {
+		from = tokens[first].Start
+		b.WriteString("…")
+	}
+	if last < len(tokens)-1 {
+		to = tokens[last].End
+	}
+	pos := from
+	for _, span := range merged {
+		if span.end <= first || span.start > last {
+			continue
+		}
+		start := tokens[max(span.start, first)].Start
+		end := tokens[min(span.end, last+1)-1].End
+		b.WriteString(text[pos:start])
+		b.WriteString("{{ ")
+		b.WriteString(text[start:end])
+		b.WriteString(" }}")
+		pos = end
+	}
+	b.WriteString(text[pos:to])
+	if to < len(text) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
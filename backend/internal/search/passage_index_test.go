@@ -0,0 +1,106 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassageIndex_Search(t *testing.T) {
+	passages := []Passage{
+		{Kind: PassageKindBook, SourceID: "novel", Section: "I", Position: 1, Text: "By nightfall she was at her wits' end, and the end of the road was nowhere in sight."},
+		{Kind: PassageKindBook, SourceID: "novel", Section: "I", Position: 2, Text: "At the end of the day his wits returned."},
+		{Kind: PassageKindStory, SourceID: "drama", Section: "Episode 1", Scene: "Kitchen", Speaker: "Ann", Position: 1, Text: "I'm at my wits' end."},
+		{Kind: PassageKindStory, SourceID: "drama", Section: "Episode 1", Scene: "Kitchen", Speaker: "Bob", Position: 2, Text: "Nothing to see here."},
+	}
+	index := NewPassageIndex(passages)
+	require.Equal(t, 4, index.Len())
+
+	tests := []struct {
+		name         string
+		query        string
+		opts         PassageSearchOptions
+		wantSnippets []string
+		wantTotal    int
+	}{
+		{
+			name:  "the typed phrase ranks above scattered words",
+			query: "at one's wits' end",
+			wantSnippets: []string{
+				"I'm {{ at my wits' end }}.",
+				"By nightfall she was {{ at her wits' end }}, and the {{ end }} of the road was nowhere in…",
+				"{{ At }} the {{ end }} of the day his {{ wits }} returned.",
+			},
+			wantTotal: 3,
+		},
+		{
+			name:  "a quoted phrase must match",
+			query: `"at one's wits' end"`,
+			wantSnippets: []string{
+				"I'm {{ at my wits' end }}.",
+				"By nightfall she was {{ at her wits' end }}, and the end of the road was nowhere in…",
+			},
+			wantTotal: 2,
+		},
+		{
+			name:         "inflected forms match",
+			query:        `"his wit returns"`,
+			wantSnippets: []string{"At the end of the day {{ his wits returned }}."},
+			wantTotal:    1,
+		},
+		{
+			name:         "source filter",
+			query:        "wits",
+			opts:         PassageSearchOptions{SourceIDs: []string{"drama"}},
+			wantSnippets: []string{"I'm at my {{ wits }}' end."},
+			wantTotal:    1,
+		},
+		{
+			name:  "pages keep the total",
+			query: "at one's wits' end",
+			opts:  PassageSearchOptions{Offset: 1, Limit: 1},
+			wantSnippets: []string{
+				"By nightfall she was {{ at her wits' end }}, and the {{ end }} of the road was nowhere in…",
+			},
+			wantTotal: 3,
+		},
+		{
+			name:      "a page past the end is empty",
+			query:     "wits",
+			opts:      PassageSearchOptions{Offset: 10, Limit: 5},
+			wantTotal: 3,
+		},
+		{
+			name:  "every word is required",
+			query: "wits nightfall sight",
+			wantSnippets: []string{
+				"By {{ nightfall }} she was at her {{ wits }}' end, and the end of the road…",
+			},
+			wantTotal: 1,
+		},
+		{
+			name:  "only a possessive",
+			query: "one's",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, total := index.Search(tt.query, tt.opts)
+			var snippets []string
+			for _, hit := range hits {
+				snippets = append(snippets, hit.Snippet)
+			}
+			assert.Equal(t, tt.wantSnippets, snippets)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	text := "one two three four five six seven eight nine ten eleven twelve thirteen fourteen fifteen sixteen seventeen eighteen nineteen twenty"
+	tokens := tokenize(text)
+	got := snippet(text, tokens, []tokenSpan{{15, 16}, {0, 1}, {19, 20}}, 15)
+	assert.Equal(t, "…four five six seven eight nine ten eleven twelve thirteen fourteen fifteen {{ sixteen }} seventeen eighteen nineteen {{ twenty }}", got)
+	assert.Equal(t, "plain text", snippet("plain text", tokenize("plain text"), nil, -1))
+}
@@ -0,0 +1,229 @@
+package search
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/at-ishikawa/langner/internal/ebook"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// PassageStore hands out a PassageIndex of the cloned ebooks and the story
+// notebooks, re-reading only the ebooks and notebooks whose files changed
+// since the last call; the rest keep their indexed segments.
+type PassageStore struct {
+	directories []string
+	load        func() (*notebook.Reader, error)
+	ebooks      func() ([]ebook.Repository, error)
+
+	mu sync.Mutex
+	// storiesFingerprint is the Fingerprint of directories when the story
+	// segments were last listed.
+	storiesFingerprint string
+	fingerprints       map[string]string
+	index              *PassageIndex
+}
+
+// NewPassageStore returns a store over the story notebooks in directories,
+// read with load, and the ebook repositories ebooks lists. While no file
+// under directories changed, the notebooks aren't loaded at all. Without
+// directories nothing tells when the notebooks changed (e.g. they are read
+// from a database), so every call loads them. ebooks may be nil to search
+// stories only.
+func NewPassageStore(directories []string, load func() (*notebook.Reader, error), ebooks func() ([]ebook.Repository, error)) *PassageStore {
+	return &PassageStore{directories: directories, load: load, ebooks: ebooks, index: NewPassageIndex(nil)}
+}
+
+// passageSource is an ebook or story notebook. The files under dir with
+// the extensions tell when it changed; an empty dir (a notebook read from
+// the database) is re-read on every call. A source without read keeps its
+// segment as is.
+type passageSource struct {
+	id, key    string
+	dir        string
+	extensions []string
+	read       func() ([]Passage, error)
+}
+
+// Index returns the index of the ebooks and stories as they are now.
+func (s *PassageStore) Index() (*PassageIndex, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	storiesFingerprint := ""
+	if len(s.directories) > 0 {
+		var err error
+		if storiesFingerprint, err = Fingerprint(s.directories); err != nil {
+			return nil, err
+		}
+	}
+	var sources []passageSource
+	if storiesFingerprint != "" && storiesFingerprint == s.storiesFingerprint {
+		sources = s.unchangedStorySources()
+	} else {
+		stories, err := s.storySources()
+		if err != nil {
+			return nil, err
+		}
+		sources = stories
+	}
+	ebooks, err := s.ebookSources()
+	if err != nil {
+		return nil, err
+	}
+	sources = append(sources, ebooks...)
+
+	next := &PassageIndex{segments: make(map[string]*passageSegment, len(sources))}
+	fingerprints := make(map[string]string, len(sources))
+	for _, source := range sources {
+		if source.read == nil {
+			next.sources = append(next.sources, source.key)
+			next.segments[source.key] = s.index.segments[source.key]
+			fingerprints[source.key] = s.fingerprints[source.key]
+			continue
+		}
+		fingerprint := ""
+		if source.dir != "" {
+			if fingerprint, err = fingerprintFiles([]string{source.dir}, source.extensions...); err != nil {
+				return nil, err
+			}
+		}
+		segment, ok := s.index.segments[source.key]
+		if !ok || fingerprint == "" || fingerprint != s.fingerprints[source.key] {
+			passages, err := source.read()
+			if err != nil {
+				return nil, err
+			}
+			segment = newPassageSegment(source.id, passages)
+		}
+		next.sources = append(next.sources, source.key)
+		next.segments[source.key] = segment
+		fingerprints[source.key] = fingerprint
+	}
+	s.index, s.fingerprints, s.storiesFingerprint = next, fingerprints, storiesFingerprint
+	return next, nil
+}
+
+// unchangedStorySources lists the story segments of the last index, to be
+// kept as they are.
+func (s *PassageStore) unchangedStorySources() []passageSource {
+	var sources []passageSource
+	for _, key := range s.index.sources {
+		if strings.HasPrefix(key, passageSourceKey(PassageKindStory, "")) {
+			sources = append(sources, passageSource{key: key})
+		}
+	}
+	return sources
+}
+
+// storySources lists the story notebooks in ID order. Book notebooks are
+// left out: their text is the ebook they were generated from, searched by
+// paragraph instead of by sentence.
+func (s *PassageStore) storySources() ([]passageSource, error) {
+	reader, err := s.load()
+	if err != nil {
+		return nil, fmt.Errorf("load notebooks: %w", err)
+	}
+	var sources []passageSource
+	indexes := reader.GetStoryIndexes()
+	for _, id := range sortedKeys(indexes) {
+		if indexes[id].IsBook {
+			continue
+		}
+		index := indexes[id]
+		sources = append(sources, passageSource{
+			id:         id,
+			key:        passageSourceKey(PassageKindStory, id),
+			dir:        index.Path,
+			extensions: []string{".yml", ".yaml"},
+			read:       func() ([]Passage, error) { return storyPassages(reader, index) },
+		})
+	}
+	return sources, nil
+}
+
+// ebookSources lists the ebooks in ID order.
+func (s *PassageStore) ebookSources() ([]passageSource, error) {
+	if s.ebooks == nil {
+		return nil, nil
+	}
+	repos, err := s.ebooks()
+	if err != nil {
+		return nil, fmt.Errorf("list ebooks: %w", err)
+	}
+	slices.SortFunc(repos, func(a, b ebook.Repository) int { return strings.Compare(a.ID, b.ID) })
+	var sources []passageSource
+	for _, repo := range repos {
+		sources = append(sources, passageSource{
+			id:         repo.ID,
+			key:        passageSourceKey(PassageKindBook, repo.ID),
+			dir:        filepath.Join(repo.RepoPath, "src", "epub"),
+			extensions: []string{".xhtml", ".opf"},
+			read:       func() ([]Passage, error) { return ebookPassages(repo) },
+		})
+	}
+	return sources, nil
+}
+
+// storyPassages returns each conversation line and statement of the story
+// notebook, markers removed.
+func storyPassages(reader *notebook.Reader, index notebook.Index) ([]Passage, error) {
+	stories, err := reader.ReadStoryNotebooks(index.ID)
+	if err != nil {
+		return nil, fmt.Errorf("ReadStoryNotebooks(%s) > %w", index.ID, err)
+	}
+	var passages []Passage
+	for _, story := range stories {
+		for _, scene := range story.Scenes {
+			base := Passage{
+				Kind:        PassageKindStory,
+				SourceID:    index.ID,
+				SourceTitle: index.Name,
+				Section:     story.Event,
+				Scene:       scene.Title,
+			}
+			position := 0
+			for _, conversation := range scene.Conversations {
+				position++
+				passage := base
+				passage.Speaker = conversation.Speaker
+				passage.Position = position
+				passage.Text = plainText(conversation.Quote)
+				passages = append(passages, passage)
+			}
+			for _, statement := range scene.Statements {
+				position++
+				passage := base
+				passage.Position = position
+				passage.Text = plainText(statement)
+				passages = append(passages, passage)
+			}
+		}
+	}
+	return passages, nil
+}
+
+// ebookPassages returns each paragraph of the ebook's chapters.
+func ebookPassages(repo ebook.Repository) ([]Passage, error) {
+	chapters, err := ebook.ParseChapters(repo.RepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("ebook.ParseChapters(%s) > %w", repo.RepoPath, err)
+	}
+	var passages []Passage
+	for _, chapter := range chapters {
+		for i, paragraph := range chapter.Paragraphs {
+			passages = append(passages, Passage{
+				Kind:        PassageKindBook,
+				SourceID:    repo.ID,
+				SourceTitle: repo.Title,
+				Section:     chapter.Title,
+				Position:    i + 1,
+				Text:        paragraph.Text,
+			})
+		}
+	}
+	return passages, nil
+}
@@ -0,0 +1,120 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/ebook"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// writeEbookFixture writes a cloned ebook repository with one chapter.
+func writeEbookFixture(t *testing.T) ebook.Repository {
+	t.Helper()
+	repoPath := t.TempDir()
+	epubDir := filepath.Join(repoPath, "src", "epub")
+	require.NoError(t, os.MkdirAll(filepath.Join(epubDir, "text"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(epubDir, "content.opf"), []byte(`<?xml version="1.0" encoding="utf-8"?>
+<package xmlns="http://www.idpf.org/2007/opf">
+    <spine><itemref idref="chapter-1.xhtml"/></spine>
+</package>`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(epubDir, "text", "chapter-1.xhtml"), []byte(`<html><head><title>Chapter 1</title></head><body>
+<p>She could not put up with the noise.</p>
+<p>The house was quiet again.</p>
+</body></html>`), 0o644))
+	return ebook.Repository{ID: "novel", RepoPath: repoPath, Title: "A Novel"}
+}
+
+func TestPassageStore_Index(t *testing.T) {
+	dirs := writeSearchFixture(t)
+	repo := writeEbookFixture(t)
+	store := NewPassageStore(
+		dirs.All(),
+		func() (*notebook.Reader, error) { return notebook.NewReaderFromDirectories(dirs, nil) },
+		func() ([]ebook.Repository, error) { return []ebook.Repository{repo}, nil },
+	)
+
+	index, err := store.Index()
+	require.NoError(t, err)
+	hits, total := index.Search("put up with", PassageSearchOptions{})
+	require.Equal(t, 3, total)
+	assert.Equal(t, Passage{
+		Kind: PassageKindStory, SourceID: "drama", SourceTitle: "Office Drama", Section: "Episode 1",
+		Scene: "Kitchen", Speaker: "Bob", Position: 1, Text: "I can't put up with this noise anymore.",
+	}, hits[1].Passage)
+	assert.Equal(t, Passage{
+		Kind: PassageKindBook, SourceID: "novel", SourceTitle: "A Novel", Section: "Chapter 1",
+		Position: 1, Text: "She could not put up with the noise.",
+	}, hits[2].Passage)
+
+	// An edited notebook is indexed again; the unchanged ebook keeps its
+	// segment.
+	story := filepath.Join(dirs.Stories[0], "drama", "episode1.yml")
+	require.NoError(t, os.WriteFile(story, []byte(`- event: "Episode 1"
+  date: 2025-01-15T00:00:00Z
+  scenes:
+    - scene: "Kitchen"
+      conversations:
+        - speaker: "Bob"
+          quote: "The noise stopped."
+`), 0o644))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(story, later, later))
+
+	next, err := store.Index()
+	require.NoError(t, err)
+	_, total = next.Search("put up with", PassageSearchOptions{})
+	assert.Equal(t, 1, total)
+	assert.Same(t, index.segments["book/novel"], next.segments["book/novel"])
+	assert.NotSame(t, index.segments["story/drama"], next.segments["story/drama"])
+}
+
+func TestPassageStore_Index_StoriesOnly(t *testing.T) {
+	dirs := writeSearchFixture(t)
+	store := NewPassageStore(dirs.All(), func() (*notebook.Reader, error) { return notebook.NewReaderFromDirectories(dirs, nil) }, nil)
+
+	index, err := store.Index()
+	require.NoError(t, err)
+	assert.Equal(t, 2, index.Len())
+}
+
+func TestPassageStore_Index_LoadsOnlyWhenNotebooksChange(t *testing.T) {
+	dirs := writeSearchFixture(t)
+	loads := 0
+	store := NewPassageStore(dirs.All(), func() (*notebook.Reader, error) {
+		loads++
+		return notebook.NewReaderFromDirectories(dirs, nil)
+	}, nil)
+
+	index, err := store.Index()
+	require.NoError(t, err)
+	next, err := store.Index()
+	require.NoError(t, err)
+	assert.Equal(t, 1, loads)
+	assert.Same(t, index.segments["story/drama"], next.segments["story/drama"])
+	assert.Equal(t, index.sources, next.sources)
+
+	story := filepath.Join(dirs.Stories[0], "drama", "episode1.yml")
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(story, later, later))
+	_, err = store.Index()
+	require.NoError(t, err)
+	assert.Equal(t, 2, loads)
+
+	// Without directories nothing tells the notebooks are unchanged.
+	loads = 0
+	store = NewPassageStore(nil, func() (*notebook.Reader, error) {
+		loads++
+		return notebook.NewReaderFromDirectories(dirs, nil)
+	}, nil)
+	for range 2 {
+		_, err = store.Index()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, loads)
+}
@@ -0,0 +1,179 @@
+package search
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// Store hands out an Index of the notebooks, rebuilding it only when a
+// notebook file changed since it was built. With a path, the documents of
+// the last build are saved there too, so a new process (e.g. each `langner
+// search`) skips reading every notebook while nothing changed.
+type Store struct {
+	directories []string
+	path        string
+	load        func() (*notebook.Reader, error)
+
+	mu          sync.Mutex
+	fingerprint string
+	index       *Index
+}
+
+// NewStore returns a store over the notebooks in directories, read with
+// load when the index has to be rebuilt. An empty path keeps the index in
+// memory only. Without directories nothing tells when the notebooks
+// changed (e.g. they are read from a database), so every call rebuilds.
+func NewStore(directories []string, path string, load func() (*notebook.Reader, error)) *Store {
+	return &Store{directories: directories, path: path, load: load}
+}
+
+// Index returns the index of the notebooks as they are now.
+func (s *Store) Index() (*Index, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fingerprint := ""
+	if len(s.directories) > 0 {
+		var err error
+		fingerprint, err = Fingerprint(s.directories)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if fingerprint != "" && s.index != nil && fingerprint == s.fingerprint {
+		return s.index, nil
+	}
+
+	if fingerprint != "" && s.path != "" {
+		documents, ok, err := readIndexFile(s.path, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			s.fingerprint, s.index = fingerprint, NewIndex(documents)
+			return s.index, nil
+		}
+	}
+
+	reader, err := s.load()
+	if err != nil {
+		return nil, fmt.Errorf("load notebooks: %w", err)
+	}
+	documents, err := DocumentsFromReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	if fingerprint != "" && s.path != "" {
+		if err := writeIndexFile(s.path, fingerprint, documents); err != nil {
+			return nil, err
+		}
+	}
+	s.fingerprint, s.index = fingerprint, NewIndex(documents)
+	return s.index, nil
+}
+
+// Fingerprint identifies the state of the notebook files under the
+// directories by the path, size and modification time of every YAML file.
+// Missing directories are skipped, like the notebook reader does.
+func Fingerprint(directories []string) (string, error) {
+	return fingerprintFiles(directories, ".yml", ".yaml")
+}
+
+// fingerprintFiles is Fingerprint over the files with the extensions.
+func fingerprintFiles(directories []string, extensions ...string) (string, error) {
+	var entries []string
+	for _, dir := range directories {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if d.IsDir() || !slices.Contains(extensions, filepath.Ext(path)) {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			entries = append(entries, fmt.Sprintf("%s\t%d\t%d", path, info.Size(), info.ModTime().UnixNano()))
+			return nil
+		})
+		if err != nil {
+			return "", fmt.Errorf("filepath.WalkDir(%s) > %w", dir, err)
+		}
+	}
+	sort.Strings(entries)
+	sum := sha256.Sum256([]byte(strings.Join(entries, "\n")))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// indexFile is the saved form of an index: its documents and the
+// fingerprint of the notebooks they were read from. The postings are
+// rebuilt on load, which is cheap next to reading the notebooks.
+type indexFile struct {
+	Fingerprint string     `json:"fingerprint"`
+	Documents   []Document `json:"documents"`
+}
+
+// readIndexFile returns the saved documents if the file exists and was
+// built from notebooks with the given fingerprint.
+func readIndexFile(path, fingerprint string) ([]Document, bool, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("os.ReadFile(%s) > %w", path, err)
+	}
+	var file indexFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		// A corrupt or older file is rebuilt rather than failing the search.
+		return nil, false, nil
+	}
+	if file.Fingerprint != fingerprint {
+		return nil, false, nil
+	}
+	return file.Documents, true, nil
+}
+
+// writeIndexFile saves the documents through a temporary file so a reader
+// never sees a half-written index.
+func writeIndexFile(path, fingerprint string, documents []Document) error {
+	data, err := json.Marshal(indexFile{Fingerprint: fingerprint, Documents: documents})
+	if err != nil {
+		return fmt.Errorf("json.Marshal() > %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("os.MkdirAll(%s) > %w", filepath.Dir(path), err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("os.CreateTemp() > %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write %s: %w", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close %s: %w", tmp.Name(), err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("os.Rename(%s) > %w", path, err)
+	}
+	return nil
+}
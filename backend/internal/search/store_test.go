@@ -0,0 +1,70 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+func TestStore_Index(t *testing.T) {
+	dirs := writeSearchFixture(t)
+	indexPath := filepath.Join(t.TempDir(), "cache", "search-index.json")
+	loads := 0
+	load := func() (*notebook.Reader, error) {
+		loads++
+		return notebook.NewReaderFromDirectories(dirs, nil)
+	}
+
+	store := NewStore(dirs.All(), indexPath, load)
+	index, err := store.Index()
+	require.NoError(t, err)
+	_, total := index.Search("put up with", SearchOptions{})
+	assert.Equal(t, 4, total)
+	assert.FileExists(t, indexPath)
+
+	// Unchanged notebooks are served from memory, and a new store reads
+	// the saved index instead of the notebooks.
+	_, err = store.Index()
+	require.NoError(t, err)
+	index, err = NewStore(dirs.All(), indexPath, load).Index()
+	require.NoError(t, err)
+	_, total = index.Search("put up with", SearchOptions{})
+	assert.Equal(t, 4, total)
+	assert.Equal(t, 1, loads)
+
+	// An edited notebook is read again.
+	cards := filepath.Join(dirs.Flashcards[0], "vocab", "cards.yml")
+	require.NoError(t, os.WriteFile(cards, []byte(`- title: "Phrasal verbs"
+  date: 2025-01-15T00:00:00Z
+  cards:
+    - expression: "tolerate"
+      meaning: "to allow without complaint"
+`), 0o644))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(cards, later, later))
+	index, err = store.Index()
+	require.NoError(t, err)
+	_, total = index.Search("put up with", SearchOptions{})
+	assert.Equal(t, 3, total)
+	assert.Equal(t, 2, loads)
+}
+
+func TestStore_Index_WithoutDirectories(t *testing.T) {
+	dirs := writeSearchFixture(t)
+	loads := 0
+	store := NewStore(nil, "", func() (*notebook.Reader, error) {
+		loads++
+		return notebook.NewReaderFromDirectories(dirs, nil)
+	})
+	for range 2 {
+		_, err := store.Index()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, loads)
+}
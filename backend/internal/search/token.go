@@ -0,0 +1,46 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/at-ishikawa/langner/internal/lemma"
+)
+
+// token is one word of a text, with its byte offsets in the text.
+type token struct {
+	Start, End int
+	Lemmas     []string
+}
+
+// tokenize splits text into words: runs of letters and digits, with
+// apostrophes kept inside a word ("can't") and everything else, hyphens
+// included, separating words.
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		word := strings.TrimRight(text[start:end], "'’")
+		if word != "" {
+			tokens = append(tokens, token{Start: start, End: start + len(word), Lemmas: lemma.Lemmas(word)})
+		}
+		start = -1
+	}
+	for i, r := range text {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if start < 0 {
+				start = i
+			}
+		case (r == '\'' || r == '’') && start >= 0:
+			// Part of the word; a trailing one is trimmed by flush.
+		default:
+			flush(i)
+		}
+	}
+	flush(len(text))
+	return tokens
+}
@@ -0,0 +1,16 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	text := "I can't — well-known, isn't it?"
+	var got []string
+	for _, tok := range tokenize(text) {
+		got = append(got, text[tok.Start:tok.End])
+	}
+	assert.Equal(t, []string{"I", "can't", "well", "known", "isn't", "it"}, got)
+}
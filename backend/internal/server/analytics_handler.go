@@ -9,11 +9,14 @@ import (
 
 	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
 	"github.com/at-ishikawa/langner/internal/analytics"
+	"github.com/at-ishikawa/langner/internal/frequency"
 )
 
 // AnalyticsHandler exposes the analytics views over Connect RPC.
 type AnalyticsHandler struct {
-	repo analytics.Repository
+	repo           analytics.Repository
+	leechThreshold analytics.LeechThreshold
+	frequencyList  *frequency.List
 }
 
 // NewAnalyticsHandler returns a handler that serves analytics requests
@@ -22,6 +25,18 @@ func NewAnalyticsHandler(repo analytics.Repository) *AnalyticsHandler {
 	return &AnalyticsHandler{repo: repo}
 }
 
+// SetLeechThreshold sets the threshold GetLeeches uses when the request
+// doesn't override it.
+func (h *AnalyticsHandler) SetLeechThreshold(threshold analytics.LeechThreshold) {
+	h.leechThreshold = threshold
+}
+
+// SetFrequencyList sets the list GetFrequencyCoverage takes the most
+// frequent words from.
+func (h *AnalyticsHandler) SetFrequencyList(list *frequency.List) {
+	h.frequencyList = list
+}
+
 // GetDailySummaries returns one row per day.
 func (h *AnalyticsHandler) GetDailySummaries(
 	ctx context.Context,
@@ -89,6 +104,9 @@ func (h *AnalyticsHandler) GetWordHistory(
 			Quality:              int32(a.Quality),
 			StreakBeforeWrong:    int32(a.StreakBeforeWrong),
 			StreakBeforeCorrect:  int32(a.StreakBeforeCorrect),
+			Answer:               a.Answer,
+			GraderReason:         a.GraderReason,
+			Grader:               a.Grader,
 		}
 	}
 	return connect.NewResponse(&apiv1.GetWordHistoryResponse{
@@ -164,6 +182,123 @@ func (h *AnalyticsHandler) GetTrends(
 	}), nil
 }
 
+// GetLeeches returns the word × quiz type series that keep failing.
+func (h *AnalyticsHandler) GetLeeches(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetLeechesRequest],
+) (*connect.Response[apiv1.GetLeechesResponse], error) {
+	threshold := h.leechThreshold
+	if req.Msg.Lapses > 0 {
+		threshold.Lapses = int(req.Msg.Lapses)
+	}
+	if req.Msg.WrongStreak > 0 {
+		threshold.WrongStreak = int(req.Msg.WrongStreak)
+	}
+	leeches, err := h.repo.Leeches(ctx, analytics.LeechQuery{
+		Threshold: threshold,
+		Filters:   unpackFilters(req.Msg.Filters),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	out := make([]*apiv1.LeechEntry, len(leeches))
+	for i, l := range leeches {
+		out[i] = &apiv1.LeechEntry{
+			SenseId:            l.ID,
+			Expression:         l.Expression,
+			NotebookId:         l.NotebookID,
+			NotebookTitle:      l.NotebookTitle,
+			SceneTitle:         l.SceneTitle,
+			QuizType:           l.QuizType,
+			Lapses:             int32(l.Lapses),
+			CurrentWrongStreak: int32(l.CurrentWrongStreak),
+			Attempts:           int32(l.Attempts),
+			LastAttemptDate:    formatDate(l.LastAttemptAt),
+			Skipped:            l.Skipped,
+			InRelearnPool:      l.InRelearnPool,
+		}
+	}
+	return connect.NewResponse(&apiv1.GetLeechesResponse{Leeches: out}), nil
+}
+
+// GetConfusions returns the pairs of words the learner mixes up.
+func (h *AnalyticsHandler) GetConfusions(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetConfusionsRequest],
+) (*connect.Response[apiv1.GetConfusionsResponse], error) {
+	confusions, err := h.repo.Confusions(ctx, analytics.ConfusionQuery{
+		Filters: unpackFilters(req.Msg.Filters),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	out := make([]*apiv1.ConfusionPair, 0, len(confusions))
+	for _, c := range confusions {
+		if req.Msg.UnresolvedOnly && c.Resolved {
+			continue
+		}
+		if req.Msg.Limit > 0 && len(out) == int(req.Msg.Limit) {
+			break
+		}
+		words := make([]*apiv1.ConfusionWord, len(c.Words))
+		for i, w := range c.Words {
+			words[i] = &apiv1.ConfusionWord{
+				SenseId:    w.ID,
+				Expression: w.Expression,
+				Meaning:    w.Meaning,
+				Example:    w.Example,
+			}
+		}
+		out = append(out, &apiv1.ConfusionPair{
+			NotebookId:       c.NotebookID,
+			NotebookTitle:    c.NotebookTitle,
+			Words:            words,
+			Count:            int32(c.Count),
+			LastConfusedDate: formatDate(c.LastConfusedAt),
+			QuizTypes:        c.QuizTypes,
+			Answers:          c.Answers,
+			Resolved:         c.Resolved,
+		})
+	}
+	return connect.NewResponse(&apiv1.GetConfusionsResponse{Pairs: out}), nil
+}
+
+// defaultCoverageTopN is the number of words GetFrequencyCoverage covers
+// when the request leaves top_n 0.
+const defaultCoverageTopN = 1000
+
+// GetFrequencyCoverage returns how many of the most frequent words of the
+// frequency list the learner has studied and knows.
+func (h *AnalyticsHandler) GetFrequencyCoverage(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetFrequencyCoverageRequest],
+) (*connect.Response[apiv1.GetFrequencyCoverageResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	if h.frequencyList == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no frequency list is configured (frequency.list_file)"))
+	}
+	topN := int(req.Msg.TopN)
+	if topN == 0 {
+		topN = defaultCoverageTopN
+	}
+	coverage, err := h.repo.Coverage(ctx, analytics.CoverageQuery{
+		Words:        h.frequencyList.Top(topN),
+		Filters:      unpackFilters(req.Msg.Filters),
+		MissingLimit: int(req.Msg.MissingLimit),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&apiv1.GetFrequencyCoverageResponse{
+		TopN:         int32(coverage.Words),
+		Studied:      int32(coverage.Studied),
+		Known:        int32(coverage.Known),
+		MissingWords: coverage.Missing,
+	}), nil
+}
+
 func granularityFromProto(g apiv1.Granularity) analytics.Granularity {
 	switch g {
 	case apiv1.Granularity_GRANULARITY_WEEK:
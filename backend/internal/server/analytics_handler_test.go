@@ -14,6 +14,7 @@ import (
 
 	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
 	"github.com/at-ishikawa/langner/internal/analytics"
+	"github.com/at-ishikawa/langner/internal/frequency"
 )
 
 // fakeRepo lets each test specify the response without standing up a real
@@ -32,6 +33,12 @@ type fakeRepo struct {
 	dayCalls  int
 	trends    analytics.TrendsResult
 	gotTrends analytics.TrendsQuery
+	leeches   []analytics.Leech
+	gotLeech  analytics.LeechQuery
+	confusion []analytics.Confusion
+	gotConfus analytics.ConfusionQuery
+	coverage  analytics.Coverage
+	gotCover  analytics.CoverageQuery
 }
 
 func (f *fakeRepo) DailySummaries(_ context.Context, rangeDays int, filters analytics.Filters) ([]analytics.DailySummary, error) {
@@ -58,6 +65,21 @@ func (f *fakeRepo) Trends(_ context.Context, q analytics.TrendsQuery) (analytics
 	return f.trends, nil
 }
 
+func (f *fakeRepo) Leeches(_ context.Context, q analytics.LeechQuery) ([]analytics.Leech, error) {
+	f.gotLeech = q
+	return f.leeches, nil
+}
+
+func (f *fakeRepo) Confusions(_ context.Context, q analytics.ConfusionQuery) ([]analytics.Confusion, error) {
+	f.gotConfus = q
+	return f.confusion, nil
+}
+
+func (f *fakeRepo) Coverage(_ context.Context, q analytics.CoverageQuery) (analytics.Coverage, error) {
+	f.gotCover = q
+	return f.coverage, nil
+}
+
 func TestAnalyticsHandler_GetDailySummaries(t *testing.T) {
 	day, _ := time.Parse("2006-01-02", "2026-06-05")
 	repo := &fakeRepo{
@@ -139,6 +161,15 @@ func (emptyDBRepo) WordHistory(context.Context, analytics.WordRef) (analytics.Wo
 func (emptyDBRepo) Trends(context.Context, analytics.TrendsQuery) (analytics.TrendsResult, error) {
 	return analytics.TrendsResult{}, nil
 }
+func (emptyDBRepo) Leeches(context.Context, analytics.LeechQuery) ([]analytics.Leech, error) {
+	return nil, nil
+}
+func (emptyDBRepo) Confusions(context.Context, analytics.ConfusionQuery) ([]analytics.Confusion, error) {
+	return nil, nil
+}
+func (emptyDBRepo) Coverage(context.Context, analytics.CoverageQuery) (analytics.Coverage, error) {
+	return analytics.Coverage{}, nil
+}
 
 // writeEtymologyLearningHistory lays out one YAML learning history file with
 // an etymology-origin log marked misunderstood on the requested date, in the
@@ -229,6 +260,92 @@ func TestAnalyticsHandler_GetTrends(t *testing.T) {
 	assert.EqualValues(t, 4, resp.Msg.Backlog.NeverCorrect)
 }
 
+func TestAnalyticsHandler_GetLeeches(t *testing.T) {
+	last, _ := time.Parse("2006-01-02", "2026-06-05")
+	repo := &fakeRepo{
+		leeches: []analytics.Leech{{
+			ID:                 "n-1",
+			Expression:         "ubiquitous",
+			NotebookID:         "flashcards",
+			NotebookTitle:      "Flashcards",
+			QuizType:           "reverse",
+			Lapses:             4,
+			CurrentWrongStreak: 1,
+			Attempts:           9,
+			LastAttemptAt:      last,
+			Skipped:            true,
+		}},
+	}
+	h := NewAnalyticsHandler(repo)
+	h.SetLeechThreshold(analytics.LeechThreshold{Lapses: 4, WrongStreak: 3})
+
+	resp, err := h.GetLeeches(context.Background(), connect.NewRequest(&apiv1.GetLeechesRequest{
+		WrongStreak: 5,
+		Filters:     &apiv1.AnalyticsFilters{QuizType: "reverse"},
+	}))
+	require.NoError(t, err)
+	// A non-zero request field overrides only that part of the configured threshold.
+	assert.Equal(t, analytics.LeechThreshold{Lapses: 4, WrongStreak: 5}, repo.gotLeech.Threshold)
+	assert.Equal(t, "reverse", repo.gotLeech.Filters.QuizType)
+	require.Len(t, resp.Msg.Leeches, 1)
+	got := resp.Msg.Leeches[0]
+	assert.Equal(t, "n-1", got.SenseId)
+	assert.Equal(t, "ubiquitous", got.Expression)
+	assert.EqualValues(t, 4, got.Lapses)
+	assert.EqualValues(t, 9, got.Attempts)
+	assert.Equal(t, "2026-06-05", got.LastAttemptDate)
+	assert.True(t, got.Skipped)
+	assert.False(t, got.InRelearnPool)
+}
+
+func TestAnalyticsHandler_GetConfusions(t *testing.T) {
+	last, _ := time.Parse("2006-01-02", "2026-06-05")
+	pair := func(first, second string, resolved bool) analytics.Confusion {
+		return analytics.Confusion{
+			NotebookID: "flashcards",
+			Words: [2]analytics.ConfusionWord{
+				{ID: first, Expression: first, Meaning: first + " meaning"},
+				{ID: second, Expression: second, Meaning: second + " meaning", Example: "An " + second + "."},
+			},
+			Count:          2,
+			LastConfusedAt: last,
+			QuizTypes:      []string{"notebook"},
+			Answers:        []string{second + " meaning"},
+			Resolved:       resolved,
+		}
+	}
+	repo := &fakeRepo{confusion: []analytics.Confusion{
+		pair("affect", "effect", false),
+		pair("complement", "compliment", false),
+		pair("lose", "loose", true),
+	}}
+	h := NewAnalyticsHandler(repo)
+
+	resp, err := h.GetConfusions(context.Background(), connect.NewRequest(&apiv1.GetConfusionsRequest{
+		Filters: &apiv1.AnalyticsFilters{NotebookId: "flashcards"},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "flashcards", repo.gotConfus.Filters.NotebookID)
+	require.Len(t, resp.Msg.Pairs, 3)
+	got := resp.Msg.Pairs[0]
+	require.Len(t, got.Words, 2)
+	assert.Equal(t, "affect", got.Words[0].Expression)
+	assert.Equal(t, "effect meaning", got.Words[1].Meaning)
+	assert.Equal(t, "An effect.", got.Words[1].Example)
+	assert.EqualValues(t, 2, got.Count)
+	assert.Equal(t, "2026-06-05", got.LastConfusedDate)
+	assert.Equal(t, []string{"effect meaning"}, got.Answers)
+
+	resp, err = h.GetConfusions(context.Background(), connect.NewRequest(&apiv1.GetConfusionsRequest{UnresolvedOnly: true}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Pairs, 2)
+	assert.False(t, resp.Msg.Pairs[1].Resolved)
+
+	resp, err = h.GetConfusions(context.Background(), connect.NewRequest(&apiv1.GetConfusionsRequest{Limit: 1}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Pairs, 1)
+}
+
 func TestAnalyticsHandler_GetWordHistory(t *testing.T) {
 	day, _ := time.Parse("2006-01-02", "2026-06-05")
 	repo := &fakeRepo{
@@ -253,3 +370,34 @@ func TestAnalyticsHandler_GetWordHistory(t *testing.T) {
 	assert.Equal(t, "wrong", resp.Msg.Attempts[0].Result)
 	assert.EqualValues(t, 2, resp.Msg.CurrentWrongStreak)
 }
+
+func TestAnalyticsHandler_GetFrequencyCoverage(t *testing.T) {
+	repo := &fakeRepo{coverage: analytics.Coverage{Words: 3, Studied: 2, Known: 1, Missing: []string{"of"}}}
+	h := NewAnalyticsHandler(repo)
+
+	_, err := h.GetFrequencyCoverage(context.Background(), connect.NewRequest(&apiv1.GetFrequencyCoverageRequest{}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "no list configured")
+
+	h.SetFrequencyList(frequency.NewList([]string{"the", "of", "and", "to"}))
+	resp, err := h.GetFrequencyCoverage(context.Background(), connect.NewRequest(&apiv1.GetFrequencyCoverageRequest{
+		TopN:         3,
+		MissingLimit: 5,
+		Filters:      &apiv1.AnalyticsFilters{NotebookId: "flashcards"},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"the", "of", "and"}, repo.gotCover.Words)
+	assert.Equal(t, 5, repo.gotCover.MissingLimit)
+	assert.Equal(t, "flashcards", repo.gotCover.Filters.NotebookID)
+	assert.EqualValues(t, 3, resp.Msg.TopN)
+	assert.EqualValues(t, 2, resp.Msg.Studied)
+	assert.EqualValues(t, 1, resp.Msg.Known)
+	assert.Equal(t, []string{"of"}, resp.Msg.MissingWords)
+
+	// top_n 0 covers the default number of words, or the whole list when shorter.
+	_, err = h.GetFrequencyCoverage(context.Background(), connect.NewRequest(&apiv1.GetFrequencyCoverageRequest{}))
+	require.NoError(t, err)
+	assert.Len(t, repo.gotCover.Words, 4)
+
+	_, err = h.GetFrequencyCoverage(context.Background(), connect.NewRequest(&apiv1.GetFrequencyCoverageRequest{TopN: -1}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
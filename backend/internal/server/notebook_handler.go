@@ -4,9 +4,11 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"log/slog"
 
@@ -14,13 +16,16 @@ import (
 
 	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
 	"github.com/at-ishikawa/langner/gen-protos/api/v1/apiv1connect"
+	"github.com/at-ishikawa/langner/internal/analytics"
 	"github.com/at-ishikawa/langner/internal/assets"
 	"github.com/at-ishikawa/langner/internal/config"
 	"github.com/at-ishikawa/langner/internal/dictionary"
 	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+	"github.com/at-ishikawa/langner/internal/frequency"
 	"github.com/at-ishikawa/langner/internal/inference"
 	"github.com/at-ishikawa/langner/internal/notebook"
 	"github.com/at-ishikawa/langner/internal/pdf"
+	"github.com/at-ishikawa/langner/internal/search"
 )
 
 // NotebookHandler implements the NotebookServiceHandler interface.
@@ -32,6 +37,17 @@ type NotebookHandler struct {
 	dictionaryReader *dictionary.Reader
 	openaiClient     inference.Client
 	noteRepository   notebook.NoteRepository
+	readerSource     notebook.ReaderSource
+	historySource    notebook.LearningHistorySource
+	pdfFonts         config.PDFConfig
+	leechThreshold   analytics.LeechThreshold
+	frequency        *frequency.Table
+
+	searchStoreOnce sync.Once
+	searchStore     *search.Store
+
+	passageStoreOnce sync.Once
+	passageStore     *search.PassageStore
 }
 
 // NewNotebookHandler creates a new NotebookHandler.
@@ -44,10 +60,64 @@ func NewNotebookHandler(notebooksConfig config.NotebooksConfig, templatesConfig
 		dictionaryReader: dictionaryReader,
 		openaiClient:     openaiClient,
 		noteRepository:   noteRepo,
+		frequency:        frequency.NewTable(nil, dictionaryMap),
 	}
 }
 
+// SetReaderSource makes the handler read notebooks from source instead of
+// re-parsing every directory per request.
+func (h *NotebookHandler) SetReaderSource(source notebook.ReaderSource) {
+	h.readerSource = source
+}
+
+// SetLearningHistorySource makes the handler read learning history from
+// source instead of the learning notes directory.
+func (h *NotebookHandler) SetLearningHistorySource(source notebook.LearningHistorySource) {
+	h.historySource = source
+}
+
+// SetPDFFonts sets the fonts ExportNotebookPDF typesets with.
+func (h *NotebookHandler) SetPDFFonts(fonts config.PDFConfig) {
+	h.pdfFonts = fonts
+}
+
+// SetSearchStore sets the index SearchNotebooks searches. Without one, an
+// in-memory index of the configured directories is built on the first
+// search.
+func (h *NotebookHandler) SetSearchStore(store *search.Store) {
+	h.searchStore = store
+}
+
+// SetPassageStore sets the index SearchPassages searches. Without one,
+// only the story notebooks of the configured directories are searched.
+func (h *NotebookHandler) SetPassageStore(store *search.PassageStore) {
+	h.passageStore = store
+}
+
+// SetFrequencyList ranks words by list in GetNotebookDetail and
+// LookupWord, falling back to the frequency in the dictionary cache for
+// the words it doesn't list.
+func (h *NotebookHandler) SetFrequencyList(list *frequency.List) {
+	h.frequency = frequency.NewTable(list, h.dictionaryMap)
+}
+
+// SetLeechThreshold sets the threshold GetNotebookDetail flags leeches
+// with. Leeches are never flagged until it's set.
+func (h *NotebookHandler) SetLeechThreshold(threshold analytics.LeechThreshold) {
+	h.leechThreshold = threshold
+}
+
+func (h *NotebookHandler) loadLearningHistories() (map[string][]notebook.LearningHistory, error) {
+	if h.historySource != nil {
+		return h.historySource.LearningHistories()
+	}
+	return notebook.NewLearningHistories(h.notebooksConfig.LearningNotesDirectory)
+}
+
 func (h *NotebookHandler) newReader() (*notebook.Reader, error) {
+	if h.readerSource != nil {
+		return h.readerSource.Reader()
+	}
 	reader, err := notebook.NewReader(
 		h.notebooksConfig.StoriesDirectories,
 		h.notebooksConfig.FlashcardsDirectories,
@@ -69,7 +139,7 @@ func (h *NotebookHandler) newReader() (*notebook.Reader, error) {
 
 
 func (h *NotebookHandler) loadLearningHistory(notebookID string) ([]notebook.LearningHistory, error) {
-	histories, err := notebook.NewLearningHistories(h.notebooksConfig.LearningNotesDirectory)
+	histories, err := h.loadLearningHistories()
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("load learning histories: %w", err))
 	}
@@ -147,11 +217,13 @@ func (h *NotebookHandler) GetNotebookDetail(
 					}
 				}
 
-				_ = def.SetDetails(h.dictionaryMap, "")
+				_ = def.SetDetails(h.dictionaryMap, nb.YouTubeURL)
 				info := h.findLearningInfoFull(learningHistory, nb.Event, scene.Title, def)
 				info.noteID = noteIDForDef(noteIDByExpr, def)
 
 				conceptHead, conceptMembers, conceptMeaning := lookupConceptForWord(def, conceptByExpression, conceptByHead)
+				timeSeconds := scene.TimeSecondsOf(def)
+				freq := h.wordFrequency(def)
 
 				definitions = append(definitions, &apiv1.NotebookWord{
 					Expression:     def.Expression,
@@ -159,6 +231,7 @@ func (h *NotebookHandler) GetNotebookDetail(
 					Meaning:        def.Meaning,
 					PartOfSpeech:   def.PartOfSpeech,
 					Pronunciation:  def.Pronunciation,
+					Audio:          def.Audio,
 					Examples:       def.Examples.Texts(),
 					Synonyms:       def.Synonyms,
 					Antonyms:       def.Antonyms,
@@ -169,9 +242,15 @@ func (h *NotebookHandler) GetNotebookDetail(
 					IsSkipped:        info.isSkipped,
 					SkippedQuizTypes: info.skippedTypes,
 					NoteId:           info.noteID,
+					IsLeech:          len(info.leechTypes) > 0,
+					LeechQuizTypes:   info.leechTypes,
+					FrequencyBand:    string(freq.Band),
+					FrequencyRank:    int32(freq.Rank),
 					ConceptHead:    conceptHead,
 					ConceptMembers: conceptMembers,
 					ConceptMeaning: conceptMeaning,
+					TimeSeconds:    int32(timeSeconds),
+					YoutubeUrl:     momentLink(nb.YouTubeURL, timeSeconds),
 				})
 				totalWordCount++
 			}
@@ -179,8 +258,10 @@ func (h *NotebookHandler) GetNotebookDetail(
 			var conversations []*apiv1.Conversation
 			for _, conv := range scene.Conversations {
 				conversations = append(conversations, &apiv1.Conversation{
-					Speaker: conv.Speaker,
-					Quote:   conv.Quote,
+					Speaker:     conv.Speaker,
+					Quote:       conv.Quote,
+					TimeSeconds: int32(conv.TimeSeconds),
+					YoutubeUrl:  momentLink(nb.YouTubeURL, conv.TimeSeconds),
 				})
 			}
 
@@ -199,8 +280,9 @@ func (h *NotebookHandler) GetNotebookDetail(
 				Season:  int32(nb.Metadata.Season),
 				Episode: int32(nb.Metadata.Episode),
 			},
-			Date:   nb.Date.Format("2006-01-02"),
-			Scenes: scenes,
+			Date:       nb.Date.Format("2006-01-02"),
+			Scenes:     scenes,
+			YoutubeUrl: nb.YouTubeURL,
 		})
 	}
 
@@ -212,6 +294,31 @@ func (h *NotebookHandler) GetNotebookDetail(
 	}), nil
 }
 
+// conversationLink links to seconds into videoURL, or returns "" when
+// either is unknown.
+func momentLink(videoURL string, seconds int) string {
+	_, link := notebook.VideoMoment(videoURL, seconds)
+	return link
+}
+
+// storyVideoURLs maps the event of every story with a video to its URL, so
+// definitions written against a story link into the same video.
+func storyVideoURLs(reader *notebook.Reader) map[string]string {
+	urls := make(map[string]string)
+	for nbID := range reader.GetStoryIndexes() {
+		stories, err := reader.ReadStoryNotebooks(nbID)
+		if err != nil {
+			continue
+		}
+		for _, story := range stories {
+			if story.YouTubeURL != "" {
+				urls[story.Event] = story.YouTubeURL
+			}
+		}
+	}
+	return urls
+}
+
 // getFlashcardNotebookDetail handles GetNotebookDetail for flashcard notebooks.
 // If no flashcard notebook matches, it falls through to definitions-only books
 // (e.g. vocabulary books under definitions/books/<id>/) before returning 404.
@@ -246,9 +353,10 @@ func (h *NotebookHandler) getFlashcardNotebookDetail(
 				}
 			}
 
-			_ = card.SetDetails(h.dictionaryMap, "")
+			_ = card.SetDetails(h.dictionaryMap, nb.YouTubeURL)
 			info := h.findLearningInfoFull(learningHistory, nb.Title, "", card)
 			info.noteID = noteIDForDef(noteIDByExpr, card)
+			freq := h.wordFrequency(card)
 
 			definitions = append(definitions, &apiv1.NotebookWord{
 				Expression:       card.Expression,
@@ -256,6 +364,7 @@ func (h *NotebookHandler) getFlashcardNotebookDetail(
 				Meaning:          card.Meaning,
 				PartOfSpeech:     card.PartOfSpeech,
 				Pronunciation:    card.Pronunciation,
+				Audio:            card.Audio,
 				Examples:         card.Examples.Texts(),
 				Synonyms:         card.Synonyms,
 				Antonyms:         card.Antonyms,
@@ -266,6 +375,12 @@ func (h *NotebookHandler) getFlashcardNotebookDetail(
 				IsSkipped:        info.isSkipped,
 				SkippedQuizTypes: info.skippedTypes,
 				NoteId:           info.noteID,
+				IsLeech:          len(info.leechTypes) > 0,
+				LeechQuizTypes:   info.leechTypes,
+				FrequencyBand:    string(freq.Band),
+				FrequencyRank:    int32(freq.Rank),
+				TimeSeconds:      int32(card.YouTubeTimeSeconds),
+				YoutubeUrl:       card.YoutubeURL,
 			})
 			totalWordCount++
 		}
@@ -277,6 +392,7 @@ func (h *NotebookHandler) getFlashcardNotebookDetail(
 					Definitions: definitions,
 				},
 			},
+			YoutubeUrl: nb.YouTubeURL,
 		})
 	}
 
@@ -306,6 +422,7 @@ func (h *NotebookHandler) getDefinitionsBookDetail(
 	}
 
 	conceptByExpression, conceptByHead := reader.GetDefinitionsBookConceptInfo(notebookID)
+	videoURLs := storyVideoURLs(reader)
 
 	var totalWordCount int32
 	var stories []*apiv1.StoryEntry
@@ -323,7 +440,7 @@ func (h *NotebookHandler) getDefinitionsBookDetail(
 			var definitions []*apiv1.NotebookWord
 			for i := range scene.Expressions {
 				note := scene.Expressions[i]
-				_ = note.SetDetails(h.dictionaryMap, "")
+				_ = note.SetDetails(h.dictionaryMap, videoURLs[event])
 				info := h.findLearningInfoFull(learningHistory, event, scene.Metadata.Title, note)
 				info.noteID = noteIDForDef(noteIDByExpr, note)
 
@@ -335,6 +452,7 @@ func (h *NotebookHandler) getDefinitionsBookDetail(
 				}
 
 				conceptHead, conceptMembers, conceptMeaning := lookupConceptForWord(note, conceptByExpression, conceptByHead)
+				freq := h.wordFrequency(note)
 
 				definitions = append(definitions, &apiv1.NotebookWord{
 					Expression:     note.Expression,
@@ -342,6 +460,7 @@ func (h *NotebookHandler) getDefinitionsBookDetail(
 					Meaning:        note.Meaning,
 					PartOfSpeech:   note.PartOfSpeech,
 					Pronunciation:  note.Pronunciation,
+					Audio:          note.Audio,
 					Examples:       note.Examples.Texts(),
 					Synonyms:       note.Synonyms,
 					Antonyms:       note.Antonyms,
@@ -352,9 +471,15 @@ func (h *NotebookHandler) getDefinitionsBookDetail(
 					IsSkipped:        info.isSkipped,
 					SkippedQuizTypes: info.skippedTypes,
 					NoteId:           info.noteID,
+					IsLeech:          len(info.leechTypes) > 0,
+					LeechQuizTypes:   info.leechTypes,
+					FrequencyBand:    string(freq.Band),
+					FrequencyRank:    int32(freq.Rank),
 					ConceptHead:    conceptHead,
 					ConceptMembers: conceptMembers,
 					ConceptMeaning: conceptMeaning,
+					TimeSeconds:    int32(note.YouTubeTimeSeconds),
+					YoutubeUrl:     note.YoutubeURL,
 				})
 				totalWordCount++
 			}
@@ -393,6 +518,8 @@ type learningInfo struct {
 	// the notebook detail page to call SkipWord/ResumeWord. Zero when the
 	// DB hasn't been populated.
 	noteID int64
+	// leechTypes lists the quiz type strings the expression is a leech in.
+	leechTypes []string
 }
 
 // loadNoteIDsForNotebook returns a map of lowercase expression -> note ID
@@ -466,6 +593,16 @@ func lookupConceptForWord(
 	return h, append([]string(nil), info.Members...), info.Meaning
 }
 
+// wordFrequency returns how common the note's word is, looked up by its
+// dictionary form when the note has one.
+func (h *NotebookHandler) wordFrequency(note notebook.Note) frequency.Frequency {
+	entry := note.Definition
+	if entry == "" {
+		entry = note.Expression
+	}
+	return h.frequency.Lookup(entry)
+}
+
 // findLearningInfoFull returns full learning info including skip status.
 func (h *NotebookHandler) findLearningInfoFull(
 	learningHistory []notebook.LearningHistory,
@@ -487,6 +624,7 @@ func (h *NotebookHandler) findLearningInfoFull(
 			nextReviewDate: nextReview,
 			isSkipped:      expr.SkippedAt.IsSkippedAny(),
 			skippedTypes:   expr.SkippedAt.SkippedTypes(),
+			leechTypes:     analytics.LeechQuizTypes(expr, h.leechThreshold),
 		}
 	}
 
@@ -571,7 +709,7 @@ func (h *NotebookHandler) ExportNotebookPDF(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("close markdown file: %w", err))
 	}
 
-	pdfPath, err := pdf.ConvertMarkdownToPDF(mdPath)
+	pdfPath, err := pdf.ConvertMarkdownToPDF(mdPath, pdf.Options{Fonts: h.pdfFonts})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("convert to PDF: %w", err))
 	}
@@ -587,6 +725,72 @@ func (h *NotebookHandler) ExportNotebookPDF(
 	}), nil
 }
 
+// audioChunkSize is the size of the chunks StreamNoteAudio sends.
+const audioChunkSize = 64 * 1024
+
+// StreamNoteAudio streams the pronunciation audio of a note from the audio
+// directory of its notebook.
+func (h *NotebookHandler) StreamNoteAudio(
+	ctx context.Context,
+	req *connect.Request[apiv1.StreamNoteAudioRequest],
+	stream *connect.ServerStream[apiv1.StreamNoteAudioResponse],
+) error {
+	if err := validateRequest(req.Msg); err != nil {
+		return err
+	}
+
+	reader, err := h.newReader()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("create notebook reader: %w", err))
+	}
+	// Definitions-book notes keep their audio next to the definitions book
+	// rather than the notebook they are merged into.
+	notebookDirs := reader.AudioDirectories(req.Msg.GetNotebookId())
+	if len(notebookDirs) == 0 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("notebook %s not found", req.Msg.GetNotebookId()))
+	}
+	var file *os.File
+	var audioPath string
+	for _, dir := range notebookDirs {
+		audioPath, err = notebook.ResolveAudioPath(dir, req.Msg.GetAudio())
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		file, err = os.Open(audioPath)
+		if err == nil {
+			break
+		}
+		if !os.IsNotExist(err) {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("open audio: %w", err))
+		}
+	}
+	if file == nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("audio %s not found", req.Msg.GetAudio()))
+	}
+	defer func() { _ = file.Close() }()
+
+	mediaType := notebook.AudioMediaType(audioPath)
+	buf := make([]byte, audioChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&apiv1.StreamNoteAudioResponse{Chunk: buf[:n], MediaType: mediaType}); err != nil {
+				return err
+			}
+			mediaType = ""
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("read audio: %w", err))
+		}
+		if ctx.Err() != nil {
+			return connect.NewError(connect.CodeCanceled, ctx.Err())
+		}
+	}
+}
+
 // LookupWord looks up a word definition using dictionary cache, RapidAPI, or OpenAI.
 func (h *NotebookHandler) LookupWord(
 	ctx context.Context,
@@ -597,9 +801,24 @@ func (h *NotebookHandler) LookupWord(
 	}
 
 	word := req.Msg.GetWord()
+	resp, entry := h.lookupWord(ctx, word, req.Msg.GetContext())
+	// The frequency tells the reader whether the word is worth saving. A
+	// word just fetched from the dictionary API isn't in the table yet.
+	freq := h.frequency.Lookup(word)
+	if freq.Band == frequency.BandUnknown && entry.Frequency > 0 {
+		freq.Band = frequency.ZipfBand(entry.Frequency)
+	}
+	resp.FrequencyBand = string(freq.Band)
+	resp.FrequencyRank = int32(freq.Rank)
+	return connect.NewResponse(resp), nil
+}
 
+// lookupWord looks the word up in the dictionary cache, then the
+// dictionary API, then the model. The dictionary entry is returned too
+// when the word was found in the dictionary.
+func (h *NotebookHandler) lookupWord(ctx context.Context, word, wordContext string) (*apiv1.LookupWordResponse, rapidapi.Response) {
 	if resp, ok := h.dictionaryMap[word]; ok {
-		return connect.NewResponse(rapidAPIToLookupResponse(word, resp, "dictionary")), nil
+		return rapidAPIToLookupResponse(word, resp, "dictionary"), resp
 	}
 
 	if h.dictionaryReader != nil {
@@ -607,14 +826,14 @@ func (h *NotebookHandler) LookupWord(
 		if err != nil {
 			slog.Warn("dictionary lookup failed", "word", word, "error", err)
 		} else if len(resp.Results) > 0 {
-			return connect.NewResponse(rapidAPIToLookupResponse(word, resp, "dictionary")), nil
+			return rapidAPIToLookupResponse(word, resp, "dictionary"), resp
 		}
 	}
 
 	if h.openaiClient != nil {
 		aiResp, err := h.openaiClient.LookupWord(ctx, inference.LookupWordRequest{
 			Word:    word,
-			Context: req.Msg.GetContext(),
+			Context: wordContext,
 		})
 		if err != nil {
 			slog.Warn("openai word lookup failed", "word", word, "error", err)
@@ -631,15 +850,15 @@ func (h *NotebookHandler) LookupWord(
 					Origin:        d.Origin,
 				})
 			}
-			return connect.NewResponse(&apiv1.LookupWordResponse{
+			return &apiv1.LookupWordResponse{
 				Word:        word,
 				Definitions: defs,
 				Source:      "openai",
-			}), nil
+			}, rapidapi.Response{}
 		}
 	}
 
-	return connect.NewResponse(&apiv1.LookupWordResponse{Word: word}), nil
+	return &apiv1.LookupWordResponse{Word: word}, rapidapi.Response{}
 }
 
 func rapidAPIToLookupResponse(word string, resp rapidapi.Response, source string) *apiv1.LookupWordResponse {
@@ -691,7 +910,7 @@ func (h *NotebookHandler) GetEtymologyNotebook(
 	// notebook-detail page does off NotebookWord). Both are keyed per notebook
 	// because a definition can come from any book. Skip exclusion is read via
 	// the SAME key SkipWord wrote (invariant L2).
-	learningHistories, _ := notebook.NewLearningHistories(h.notebooksConfig.LearningNotesDirectory)
+	learningHistories, _ := h.loadLearningHistories()
 	noteIDCache := make(map[string]map[string]int64)
 	noteIDsFor := func(nbName string) map[string]int64 {
 		if m, ok := noteIDCache[nbName]; ok {
@@ -942,6 +1161,59 @@ func (h *NotebookHandler) GetEtymologyNotebook(
 	}), nil
 }
 
+// GetEtymologyGraph returns the origins, words and concepts of an etymology
+// notebook as graph nodes, with origin_parts, concept membership and concept
+// relations as edges.
+func (h *NotebookHandler) GetEtymologyGraph(
+	ctx context.Context,
+	req *connect.Request[apiv1.GetEtymologyGraphRequest],
+) (*connect.Response[apiv1.GetEtymologyGraphResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+
+	notebookID := req.Msg.GetNotebookId()
+	reader, err := h.newReader()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("create notebook reader: %w", err))
+	}
+	graph, err := reader.ReadEtymologyGraph(ctx, notebookID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("etymology notebook %s not found", notebookID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("read etymology graph: %w", err))
+	}
+
+	nodes := make([]*apiv1.EtymologyGraphNode, 0, len(graph.Nodes))
+	for _, n := range graph.Nodes {
+		nodes = append(nodes, &apiv1.EtymologyGraphNode{
+			Id:           n.ID,
+			Kind:         string(n.Kind),
+			Label:        n.Label,
+			Meaning:      n.Meaning,
+			Language:     n.Language,
+			OriginType:   n.OriginType,
+			SessionTitle: n.SessionTitle,
+			NotebookId:   n.NotebookID,
+		})
+	}
+	edges := make([]*apiv1.EtymologyGraphEdge, 0, len(graph.Edges))
+	for _, e := range graph.Edges {
+		edges = append(edges, &apiv1.EtymologyGraphEdge{
+			Source:   e.Source,
+			Target:   e.Target,
+			Kind:     string(e.Kind),
+			Label:    e.Label,
+			Directed: e.Directed,
+		})
+	}
+	return connect.NewResponse(&apiv1.GetEtymologyGraphResponse{
+		Nodes: nodes,
+		Edges: edges,
+	}), nil
+}
+
 // loadEtymologyConcepts merges per-session concept declarations into one
 // proto entry per (notebook_id, concept_key) and attaches each concept's
 // outgoing relations. Returns the proto list plus a map keyed by the same
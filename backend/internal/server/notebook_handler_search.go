@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"connectrpc.com/connect"
+
+	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/search"
+)
+
+// SearchNotebooks returns every scene line, statement, note and example
+// that uses the query in any inflected form.
+func (h *NotebookHandler) SearchNotebooks(
+	ctx context.Context,
+	req *connect.Request[apiv1.SearchNotebooksRequest],
+) (*connect.Response[apiv1.SearchNotebooksResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+
+	index, err := h.searchIndex().Index()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("build search index: %w", err))
+	}
+	occurrences, total := index.Search(req.Msg.GetQuery(), search.SearchOptions{
+		NotebookIDs: req.Msg.GetNotebookIds(),
+		Limit:       int(req.Msg.GetLimit()),
+	})
+
+	result := make([]*apiv1.NotebookOccurrence, 0, len(occurrences))
+	for _, o := range occurrences {
+		result = append(result, &apiv1.NotebookOccurrence{
+			Kind:          string(o.Kind),
+			NotebookId:    o.NotebookID,
+			NotebookTitle: o.NotebookTitle,
+			StoryTitle:    o.StoryTitle,
+			SceneTitle:    o.SceneTitle,
+			Speaker:       o.Speaker,
+			Text:          o.Text,
+			Expression:    o.Expression,
+			Meaning:       o.Meaning,
+			Highlight:     o.Highlight,
+		})
+	}
+	return connect.NewResponse(&apiv1.SearchNotebooksResponse{
+		Occurrences: result,
+		Total:       int32(total),
+	}), nil
+}
+
+// searchIndex returns the store set by SetSearchStore, or builds one over
+// the configured directories the first time it's needed.
+func (h *NotebookHandler) searchIndex() *search.Store {
+	h.searchStoreOnce.Do(func() {
+		if h.searchStore != nil {
+			return
+		}
+		dirs := notebook.ReaderDirectories{
+			Stories:     h.notebooksConfig.StoriesDirectories,
+			Journals:    h.notebooksConfig.JournalsDirectories,
+			Flashcards:  h.notebooksConfig.FlashcardsDirectories,
+			Books:       h.notebooksConfig.BooksDirectories,
+			Definitions: h.notebooksConfig.DefinitionsDirectories,
+		}
+		h.searchStore = search.NewStore(dirs.All(), "", h.newReader)
+	})
+	return h.searchStore
+}
+
+// defaultPassagePageSize is the page size of SearchPassages when the
+// request leaves it 0.
+const defaultPassagePageSize = 20
+
+// SearchPassages ranks the ebook paragraphs and story lines containing
+// every word of the query and returns a page of them with highlighted
+// snippets. The page token is the offset of the page's first hit.
+func (h *NotebookHandler) SearchPassages(
+	ctx context.Context,
+	req *connect.Request[apiv1.SearchPassagesRequest],
+) (*connect.Response[apiv1.SearchPassagesResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	offset := 0
+	if token := req.Msg.GetPageToken(); token != "" {
+		var err error
+		offset, err = strconv.Atoi(token)
+		if err != nil || offset < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token %q", token))
+		}
+	}
+	pageSize := int(req.Msg.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultPassagePageSize
+	}
+
+	index, err := h.passageIndex().Index()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("build passage index: %w", err))
+	}
+	hits, total := index.Search(req.Msg.GetQuery(), search.PassageSearchOptions{
+		SourceIDs: req.Msg.GetSourceIds(),
+		Offset:    offset,
+		Limit:     pageSize,
+	})
+
+	result := make([]*apiv1.PassageHit, 0, len(hits))
+	for _, hit := range hits {
+		result = append(result, &apiv1.PassageHit{
+			Kind:        string(hit.Kind),
+			SourceId:    hit.SourceID,
+			SourceTitle: hit.SourceTitle,
+			Section:     hit.Section,
+			Scene:       hit.Scene,
+			Speaker:     hit.Speaker,
+			Position:    int32(hit.Position),
+			Snippet:     hit.Snippet,
+			Score:       hit.Score,
+		})
+	}
+	nextPageToken := ""
+	if next := offset + len(hits); len(hits) > 0 && next < total {
+		nextPageToken = strconv.Itoa(next)
+	}
+	return connect.NewResponse(&apiv1.SearchPassagesResponse{
+		Hits:          result,
+		Total:         int32(total),
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// passageIndex returns the store set by SetPassageStore, or builds one over
+// the configured story notebooks the first time it's needed.
+func (h *NotebookHandler) passageIndex() *search.PassageStore {
+	h.passageStoreOnce.Do(func() {
+		if h.passageStore == nil {
+			dirs := slices.Concat(h.notebooksConfig.StoriesDirectories, h.notebooksConfig.JournalsDirectories)
+			h.passageStore = search.NewPassageStore(dirs, h.newReader, nil)
+		}
+	})
+	return h.passageStore
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+)
+
+func TestNotebookHandler_SearchNotebooks(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *apiv1.SearchNotebooksRequest
+		want      []*apiv1.NotebookOccurrence
+		wantTotal int32
+		wantCode  connect.Code
+	}{
+		{
+			name: "finds the scene line and the note",
+			req:  &apiv1.SearchNotebooksRequest{Query: "Preposterous"},
+			want: []*apiv1.NotebookOccurrence{
+				{
+					Kind: "conversation", NotebookId: "test-story", NotebookTitle: "Test Story",
+					StoryTitle: "Chapter One", SceneTitle: "Opening", Speaker: "Alice",
+					Text: "That sounds preposterous to me.", Highlight: "preposterous",
+				},
+				{
+					Kind: "note", NotebookId: "test-story", NotebookTitle: "Test Story",
+					StoryTitle: "Chapter One", SceneTitle: "Opening",
+					Expression: "preposterous", Meaning: "contrary to reason or common sense", Highlight: "preposterous",
+				},
+			},
+			wantTotal: 2,
+		},
+		{
+			name:      "limit keeps the total",
+			req:       &apiv1.SearchNotebooksRequest{Query: "ludicrous", Limit: 1},
+			want:      []*apiv1.NotebookOccurrence{{Kind: "conversation", NotebookId: "test-story", NotebookTitle: "Test Story", StoryTitle: "Chapter One", SceneTitle: "Closing", Speaker: "Bob", Text: "I find that ludicrous.", Highlight: "ludicrous"}},
+			wantTotal: 2,
+		},
+		{
+			name: "other notebooks only",
+			req:  &apiv1.SearchNotebooksRequest{Query: "ludicrous", NotebookIds: []string{"other"}},
+			want: []*apiv1.NotebookOccurrence{},
+		},
+		{
+			name:     "returns INVALID_ARGUMENT when the query is empty",
+			req:      &apiv1.SearchNotebooksRequest{},
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	handler, _ := newTestNotebookHandlerWithFixtures(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := handler.SearchNotebooks(context.Background(), connect.NewRequest(tt.req))
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, resp.Msg.GetTotal())
+			require.Len(t, resp.Msg.GetOccurrences(), len(tt.want))
+			for i, want := range tt.want {
+				got := resp.Msg.GetOccurrences()[i]
+				assert.True(t, proto.Equal(want, got), "occurrence %d: got %v", i, got)
+			}
+		})
+	}
+}
+
+func TestNotebookHandler_SearchPassages(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *apiv1.SearchPassagesRequest
+		want          []*apiv1.PassageHit
+		wantTotal     int32
+		wantNextToken string
+		wantCode      connect.Code
+	}{
+		{
+			name: "finds the story line with a snippet",
+			req:  &apiv1.SearchPassagesRequest{Query: "sound preposterous"},
+			want: []*apiv1.PassageHit{{
+				Kind: "story", SourceId: "test-story", SourceTitle: "Test Story",
+				Section: "Chapter One", Scene: "Opening", Speaker: "Alice", Position: 1,
+				Snippet: "That {{ sounds preposterous }} to me.",
+			}},
+			wantTotal: 1,
+		},
+		{
+			name: "first page has a next page token",
+			req:  &apiv1.SearchPassagesRequest{Query: "that", PageSize: 1},
+			want: []*apiv1.PassageHit{{
+				Kind: "story", SourceId: "test-story", SourceTitle: "Test Story",
+				Section: "Chapter One", Scene: "Closing", Speaker: "Bob", Position: 1,
+				Snippet: "I find {{ that }} ludicrous.",
+			}},
+			wantTotal:     2,
+			wantNextToken: "1",
+		},
+		{
+			name: "last page has no next page token",
+			req:  &apiv1.SearchPassagesRequest{Query: "that", PageSize: 1, PageToken: "1"},
+			want: []*apiv1.PassageHit{{
+				Kind: "story", SourceId: "test-story", SourceTitle: "Test Story",
+				Section: "Chapter One", Scene: "Opening", Speaker: "Alice", Position: 1,
+				Snippet: "{{ That }} sounds preposterous to me.",
+			}},
+			wantTotal: 2,
+		},
+		{
+			name:     "returns INVALID_ARGUMENT when the page token isn't an offset",
+			req:      &apiv1.SearchPassagesRequest{Query: "that", PageToken: "abc"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "returns INVALID_ARGUMENT when the query is empty",
+			req:      &apiv1.SearchPassagesRequest{},
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	handler, _ := newTestNotebookHandlerWithFixtures(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := handler.SearchPassages(context.Background(), connect.NewRequest(tt.req))
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, resp.Msg.GetTotal())
+			assert.Equal(t, tt.wantNextToken, resp.Msg.GetNextPageToken())
+			require.Len(t, resp.Msg.GetHits(), len(tt.want))
+			for i, want := range tt.want {
+				got := resp.Msg.GetHits()[i]
+				assert.Positive(t, got.GetScore())
+				want.Score = got.GetScore()
+				assert.True(t, proto.Equal(want, got), "hit %d: got %v", i, got)
+			}
+		})
+	}
+}
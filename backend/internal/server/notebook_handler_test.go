@@ -1,7 +1,10 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -11,8 +14,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+	"github.com/at-ishikawa/langner/gen-protos/api/v1/apiv1connect"
+	"github.com/at-ishikawa/langner/internal/analytics"
 	"github.com/at-ishikawa/langner/internal/config"
 	"github.com/at-ishikawa/langner/internal/dictionary/rapidapi"
+	"github.com/at-ishikawa/langner/internal/frequency"
 	"github.com/at-ishikawa/langner/internal/notebook"
 )
 
@@ -50,6 +56,7 @@ notebooks:
 `), 0644))
 	require.NoError(t, os.WriteFile(filepath.Join(storyDir, "episodes.yml"), []byte(`- event: "Chapter One"
   date: 2025-01-15T00:00:00Z
+  youtube_url: "https://youtu.be/abc"
   metadata:
     series: "Test Series"
     season: 1
@@ -59,6 +66,7 @@ notebooks:
       conversations:
         - speaker: "Alice"
           quote: "That sounds {{ preposterous }} to me."
+          time_seconds: 83
       definitions:
         - expression: "preposterous"
           meaning: "contrary to reason or common sense"
@@ -151,6 +159,7 @@ func TestNotebookHandler_GetNotebookDetail_WithFixtures(t *testing.T) {
 	story := stories[0]
 	assert.Equal(t, "Chapter One", story.GetEvent())
 	assert.Equal(t, "2025-01-15", story.GetDate())
+	assert.Equal(t, "https://youtu.be/abc", story.GetYoutubeUrl())
 
 	metadata := story.GetMetadata()
 	require.NotNil(t, metadata)
@@ -168,11 +177,19 @@ func TestNotebookHandler_GetNotebookDetail_WithFixtures(t *testing.T) {
 	require.Len(t, conversations, 1)
 	assert.Equal(t, "Alice", conversations[0].GetSpeaker())
 	assert.Contains(t, conversations[0].GetQuote(), "preposterous")
+	assert.Equal(t, int32(83), conversations[0].GetTimeSeconds())
+	assert.Equal(t, "https://youtu.be/abc?t=83", conversations[0].GetYoutubeUrl())
 
 	definitions := scene.GetDefinitions()
 	require.Len(t, definitions, 1)
 	assert.Equal(t, "preposterous", definitions[0].GetExpression())
 	assert.Equal(t, "contrary to reason or common sense", definitions[0].GetMeaning())
+	assert.Equal(t, int32(83), definitions[0].GetTimeSeconds())
+	assert.Equal(t, "https://youtu.be/abc?t=83", definitions[0].GetYoutubeUrl())
+
+	closing := scenes[1]
+	assert.Zero(t, closing.GetConversations()[0].GetTimeSeconds())
+	assert.Empty(t, closing.GetConversations()[0].GetYoutubeUrl())
 }
 
 func TestNotebookHandler_GetNotebookDetail_WithLearningHistory(t *testing.T) {
@@ -226,6 +243,67 @@ func TestNotebookHandler_GetNotebookDetail_WithLearningHistory(t *testing.T) {
 	assert.Equal(t, int32(7), logs[0].GetIntervalDays())
 }
 
+func TestNotebookHandler_GetNotebookDetail_FrequencyBands(t *testing.T) {
+	handler, _ := newTestNotebookHandlerWithFixtures(t)
+	handler.SetFrequencyList(frequency.NewList([]string{"the", "ludicrous"}))
+
+	resp, err := handler.GetNotebookDetail(
+		context.Background(),
+		connect.NewRequest(&apiv1.GetNotebookDetailRequest{NotebookId: "test-story"}),
+	)
+
+	require.NoError(t, err)
+	scenes := resp.Msg.GetStories()[0].GetScenes()
+	require.Len(t, scenes, 2)
+
+	preposterous := scenes[0].GetDefinitions()[0]
+	assert.Empty(t, preposterous.GetFrequencyBand())
+	assert.Zero(t, preposterous.GetFrequencyRank())
+
+	ludicrous := scenes[1].GetDefinitions()[0]
+	assert.Equal(t, "very_common", ludicrous.GetFrequencyBand())
+	assert.Equal(t, int32(2), ludicrous.GetFrequencyRank())
+}
+
+func TestNotebookHandler_GetNotebookDetail_FlagsLeeches(t *testing.T) {
+	handler, learningDir := newTestNotebookHandlerWithFixtures(t)
+	handler.SetLeechThreshold(analytics.LeechThreshold{Lapses: 4, WrongStreak: 3})
+
+	require.NoError(t, os.WriteFile(filepath.Join(learningDir, "test-story.yml"), []byte(`- metadata:
+    id: test-story
+    title: "Chapter One"
+  scenes:
+    - metadata:
+        title: "Opening"
+      expressions:
+        - expression: "preposterous"
+          learned_logs:
+            - status: "understood"
+              learned_at: "2025-01-20"
+              quiz_type: "notebook"
+          reverse_logs:
+            - status: "misunderstood"
+              learned_at: "2025-01-22"
+              quiz_type: "reverse"
+            - status: "misunderstood"
+              learned_at: "2025-01-21"
+              quiz_type: "reverse"
+            - status: "misunderstood"
+              learned_at: "2025-01-20"
+              quiz_type: "reverse"
+`), 0644))
+
+	resp, err := handler.GetNotebookDetail(
+		context.Background(),
+		connect.NewRequest(&apiv1.GetNotebookDetailRequest{NotebookId: "test-story"}),
+	)
+	require.NoError(t, err)
+
+	word := resp.Msg.GetStories()[0].GetScenes()[0].GetDefinitions()[0]
+	assert.True(t, word.GetIsLeech())
+	assert.Equal(t, []string{"reverse"}, word.GetLeechQuizTypes())
+}
+
 // TestNotebookHandler_GetNotebookDetail_DefinitionsBook reproduces the
 // "notebook X not found" error the user hit when opening a definitions-only
 // vocabulary book (notebooks under definitions/books/<id>/, with an
@@ -296,6 +374,53 @@ notebooks:
 	assert.Equal(t, "telegraph", defs[0].GetExpression())
 }
 
+func TestNotebookHandler_GetNotebookDetail_FlashcardVideo(t *testing.T) {
+	flashcardsDir := t.TempDir()
+	vocabDir := filepath.Join(flashcardsDir, "test-vocab")
+	require.NoError(t, os.MkdirAll(vocabDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(vocabDir, "index.yml"), []byte(`id: test-vocab
+name: Test Vocabulary
+notebooks:
+  - ./cards.yml
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(vocabDir, "cards.yml"), []byte(`- title: "Lecture Words"
+  date: 2025-01-15T00:00:00Z
+  youtube_url: "https://youtu.be/xyz"
+  cards:
+    - expression: "serendipity"
+      meaning: "a fortunate discovery by accident"
+      youtube_time_seconds: 42
+    - expression: "ephemeral"
+      meaning: "lasting a very short time"
+`), 0644))
+
+	handler := NewNotebookHandler(
+		config.NotebooksConfig{
+			FlashcardsDirectories:  []string{flashcardsDir},
+			LearningNotesDirectory: t.TempDir(),
+		},
+		config.TemplatesConfig{},
+		make(map[string]rapidapi.Response),
+		nil, nil, nil,
+	)
+
+	resp, err := handler.GetNotebookDetail(
+		context.Background(),
+		connect.NewRequest(&apiv1.GetNotebookDetailRequest{NotebookId: "test-vocab"}),
+	)
+	require.NoError(t, err)
+
+	stories := resp.Msg.GetStories()
+	require.Len(t, stories, 1)
+	assert.Equal(t, "https://youtu.be/xyz", stories[0].GetYoutubeUrl())
+
+	definitions := stories[0].GetScenes()[0].GetDefinitions()
+	require.Len(t, definitions, 2)
+	assert.Equal(t, int32(42), definitions[0].GetTimeSeconds())
+	assert.Equal(t, "https://youtu.be/xyz?t=42", definitions[0].GetYoutubeUrl())
+	assert.Empty(t, definitions[1].GetYoutubeUrl())
+}
+
 func TestNotebookHandler_ExportNotebookPDF(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -438,6 +563,60 @@ func TestNotebookHandler_LookupWord_FromCache(t *testing.T) {
 	assert.Equal(t, "verb", resp.Msg.GetDefinitions()[0].GetPartOfSpeech())
 }
 
+func TestNotebookHandler_LookupWord_FrequencyBand(t *testing.T) {
+	dictionaryMap := map[string]rapidapi.Response{
+		"break": {
+			Results: []rapidapi.Result{
+				{PartOfSpeech: "verb", Definition: "to separate into pieces"},
+			},
+			Frequency: 5.3,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		list     *frequency.List
+		wantBand string
+		wantRank int32
+	}{
+		{
+			name:     "bands by the dictionary frequency without a list",
+			wantBand: "very_common",
+		},
+		{
+			name:     "ranks by the frequency list",
+			list:     frequency.NewList([]string{"the", "of", "break"}),
+			wantBand: "very_common",
+			wantRank: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewNotebookHandler(
+				config.NotebooksConfig{},
+				config.TemplatesConfig{},
+				dictionaryMap,
+				nil,
+				nil,
+				nil,
+			)
+			if tt.list != nil {
+				handler.SetFrequencyList(tt.list)
+			}
+
+			resp, err := handler.LookupWord(
+				context.Background(),
+				connect.NewRequest(&apiv1.LookupWordRequest{Word: "break"}),
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBand, resp.Msg.GetFrequencyBand())
+			assert.Equal(t, tt.wantRank, resp.Msg.GetFrequencyRank())
+		})
+	}
+}
+
 func TestNotebookHandler_LookupWord_NotFound(t *testing.T) {
 	handler := NewNotebookHandler(
 		config.NotebooksConfig{},
@@ -679,3 +858,169 @@ notebooks:
 	assert.Equal(t, "mittere", origins[2].GetOrigin())
 	assert.Equal(t, "Chapter 2: Sending", origins[2].GetSessionTitle())
 }
+
+func TestNotebookHandler_GetEtymologyGraph(t *testing.T) {
+	etymDir := t.TempDir()
+	bookDir := filepath.Join(etymDir, "roots")
+	require.NoError(t, os.MkdirAll(bookDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(bookDir, "index.yml"), []byte(`id: roots
+kind: Etymology
+name: Roots
+notebooks:
+  - ./session1.yml
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(bookDir, "session1.yml"), []byte(`metadata:
+  title: "Session 1"
+origins:
+  - origin: cardio
+    type: root
+    language: Greek
+    meaning: heart
+definitions:
+  - expression: cardiology
+    meaning: the study of the heart
+    origin_parts:
+      - origin: cardio
+concepts:
+  - key: heart
+    meaning: heart
+    members:
+      - origin: cardio
+`), 0644))
+
+	handler := NewNotebookHandler(
+		config.NotebooksConfig{
+			EtymologyDirectories:   []string{etymDir},
+			LearningNotesDirectory: t.TempDir(),
+		},
+		config.TemplatesConfig{},
+		make(map[string]rapidapi.Response),
+		nil,
+		nil,
+		nil,
+	)
+
+	resp, err := handler.GetEtymologyGraph(
+		context.Background(),
+		connect.NewRequest(&apiv1.GetEtymologyGraphRequest{NotebookId: "roots"}),
+	)
+	require.NoError(t, err)
+	nodes := resp.Msg.GetNodes()
+	require.Len(t, nodes, 3)
+	assert.Equal(t, "origin:Session 1/cardio", nodes[0].GetId())
+	assert.Equal(t, "origin", nodes[0].GetKind())
+	assert.Equal(t, "Greek", nodes[0].GetLanguage())
+	assert.Equal(t, "root", nodes[0].GetOriginType())
+	assert.Equal(t, "word", nodes[1].GetKind())
+	assert.Equal(t, "cardiology", nodes[1].GetLabel())
+	assert.Equal(t, "roots", nodes[1].GetNotebookId())
+	assert.Equal(t, "concept", nodes[2].GetKind())
+
+	edges := resp.Msg.GetEdges()
+	require.Len(t, edges, 2)
+	assert.Equal(t, "origin_part", edges[0].GetKind())
+	assert.Equal(t, nodes[1].GetId(), edges[0].GetSource())
+	assert.Equal(t, nodes[0].GetId(), edges[0].GetTarget())
+	assert.Equal(t, "member", edges[1].GetKind())
+	assert.True(t, edges[1].GetDirected())
+
+	_, err = handler.GetEtymologyGraph(
+		context.Background(),
+		connect.NewRequest(&apiv1.GetEtymologyGraphRequest{NotebookId: "missing"}),
+	)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestNotebookHandler_StreamNoteAudio(t *testing.T) {
+	h, _ := newTestNotebookHandlerWithFixtures(t)
+	audioDir := filepath.Join(h.notebooksConfig.StoriesDirectories[0], "test-story", "audio")
+	require.NoError(t, os.MkdirAll(audioDir, 0755))
+	audio := bytes.Repeat([]byte("RIFF"), audioChunkSize/2)
+	require.NoError(t, os.WriteFile(filepath.Join(audioDir, "preposterous.wav"), audio, 0644))
+
+	// A standalone definitions book annotating test-story, whose notes keep
+	// their audio next to it.
+	defsDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(defsDir, "test-story.yml"), []byte(`- metadata:
+    notebook: episodes.yml
+  scenes:
+    - metadata:
+        title: Opening
+      expressions:
+        - expression: colossal
+          meaning: extremely large
+          audio: audio/colossal.wav
+`), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(defsDir, "audio"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(defsDir, "audio", "colossal.wav"), audio, 0644))
+	h.notebooksConfig.DefinitionsDirectories = []string{defsDir}
+
+	path, handler := apiv1connect.NewNotebookServiceHandler(h)
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := apiv1connect.NewNotebookServiceClient(server.Client(), server.URL)
+
+	tests := []struct {
+		name     string
+		req      *apiv1.StreamNoteAudioRequest
+		wantCode connect.Code
+	}{
+		{
+			name: "streams the audio in chunks",
+			req:  &apiv1.StreamNoteAudioRequest{NotebookId: "test-story", Audio: "audio/preposterous.wav"},
+		},
+		{
+			name: "streams the audio of a definitions-book note",
+			req:  &apiv1.StreamNoteAudioRequest{NotebookId: "test-story", Audio: "audio/colossal.wav"},
+		},
+		{
+			name:     "returns INVALID_ARGUMENT when audio is empty",
+			req:      &apiv1.StreamNoteAudioRequest{NotebookId: "test-story"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "returns INVALID_ARGUMENT for a path outside the notebook",
+			req:      &apiv1.StreamNoteAudioRequest{NotebookId: "test-story", Audio: "../../secret.wav"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "returns INVALID_ARGUMENT for a file that isn't audio",
+			req:      &apiv1.StreamNoteAudioRequest{NotebookId: "test-story", Audio: "episodes.yml"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "returns NOT_FOUND for an unknown notebook",
+			req:      &apiv1.StreamNoteAudioRequest{NotebookId: "unknown", Audio: "audio/preposterous.wav"},
+			wantCode: connect.CodeNotFound,
+		},
+		{
+			name:     "returns NOT_FOUND for missing audio",
+			req:      &apiv1.StreamNoteAudioRequest{NotebookId: "test-story", Audio: "audio/ludicrous.wav"},
+			wantCode: connect.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.StreamNoteAudio(context.Background(), connect.NewRequest(tt.req))
+			require.NoError(t, err)
+			defer func() { _ = stream.Close() }()
+
+			var got []byte
+			var mediaTypes []string
+			for stream.Receive() {
+				got = append(got, stream.Msg().GetChunk()...)
+				mediaTypes = append(mediaTypes, stream.Msg().GetMediaType())
+			}
+			if tt.wantCode != 0 {
+				require.Error(t, stream.Err())
+				assert.Equal(t, tt.wantCode, connect.CodeOf(stream.Err()))
+				return
+			}
+			require.NoError(t, stream.Err())
+			assert.Equal(t, audio, got)
+			assert.Equal(t, []string{"audio/wav", ""}, mediaTypes)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/at-ishikawa/langner/internal/inference"
 	"github.com/at-ishikawa/langner/internal/notebook"
 	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/stt"
 )
 
 // QuizHandler implements the QuizServiceHandler interface.
@@ -27,6 +28,7 @@ type QuizHandler struct {
 
 	svc                  *quiz.Service
 	noteRepository       notebook.NoteRepository
+	transcriber          stt.Transcriber
 	mu                   sync.Mutex
 	noteStore            map[int64]quiz.Card
 	reverseStore         map[int64]quiz.ReverseCard
@@ -37,7 +39,13 @@ type QuizHandler struct {
 	// keyed by the ephemeral note_id (same id scheme as noteStore) so Submit,
 	// Override, and Skip all resolve a blank the same way vocab cards do.
 	grammarStore map[int64]grammarBlankCtx
-	nextID       int64
+	// dictationStore holds the lines of the current dictation session, keyed
+	// by the same ephemeral note_id so Override and Skip reach their words.
+	dictationStore map[int64]quiz.DictationCard
+	// wordChoiceStore holds the questions of the current word choice
+	// session, keyed the same way.
+	wordChoiceStore map[int64]quiz.WordChoiceCard
+	nextID          int64
 }
 
 // NewQuizHandler creates a new QuizHandler.
@@ -49,6 +57,8 @@ func NewQuizHandler(svc *quiz.Service) *QuizHandler {
 		freeformStore:        make(map[int64]quiz.FreeformCard),
 		relearnStore:         make(map[int64]quiz.RelearnCard),
 		grammarStore:         make(map[int64]grammarBlankCtx),
+		dictationStore:       make(map[int64]quiz.DictationCard),
+		wordChoiceStore:      make(map[int64]quiz.WordChoiceCard),
 		nextID:               1,
 	}
 }
@@ -57,6 +67,11 @@ func (h *QuizHandler) SetNoteRepository(repo notebook.NoteRepository) {
 	h.noteRepository = repo
 }
 
+// SetTranscriber enables spoken answers, transcribed with transcriber.
+func (h *QuizHandler) SetTranscriber(transcriber stt.Transcriber) {
+	h.transcriber = transcriber
+}
+
 func (h *QuizHandler) GetQuizOptions(ctx context.Context, req *connect.Request[apiv1.GetQuizOptionsRequest]) (*connect.Response[apiv1.GetQuizOptionsResponse], error) {
 	summaries, err := h.svc.LoadNotebookSummaries(req.Msg.GetIncludeUnstudied())
 	if err != nil {
@@ -89,6 +104,7 @@ func (h *QuizHandler) GetQuizOptions(ctx context.Context, req *connect.Request[a
 			EtymologyReverseReviewCount: int32(s.EtymologyReverseReviewCount),
 			GrammarReviewCount:          int32(s.GrammarReviewCount),
 			VocabularyCount:             int32(s.VocabularyCount),
+			FrequentReviewCount:         int32(s.FrequentReviewCount),
 			HasContent:                  s.HasContent,
 			Sections:                    sections,
 		})
@@ -274,25 +290,42 @@ func (h *QuizHandler) SubmitReverseAnswer(ctx context.Context, req *connect.Requ
 	if err := validateRequest(req.Msg); err != nil {
 		return nil, err
 	}
-	noteID := req.Msg.GetNoteId()
+	card, err := h.reverseCard(req.Msg.GetNoteId())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := h.submitReverseAnswer(ctx, card, req.Msg.GetAnswer(), req.Msg.GetResponseTimeMs(), req.Msg.GetIsSkipped())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *QuizHandler) reverseCard(noteID int64) (quiz.ReverseCard, error) {
 	h.mu.Lock()
 	card, ok := h.reverseStore[noteID]
 	h.mu.Unlock()
 	if !ok {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("note %d not found", noteID))
+		return quiz.ReverseCard{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("note %d not found", noteID))
 	}
+	return card, nil
+}
+
+// submitReverseAnswer grades one reverse answer and records it, unless it
+// is a synonym the frontend asks the user to retry.
+func (h *QuizHandler) submitReverseAnswer(ctx context.Context, card quiz.ReverseCard, answer string, responseTimeMs int64, skipped bool) (*apiv1.SubmitReverseAnswerResponse, error) {
 	var grade quiz.GradeResult
 	var err error
-	if req.Msg.GetIsSkipped() {
+	if skipped {
 		grade = skippedGradeResult()
 	} else {
-		grade, err = h.svc.GradeReverseAnswer(ctx, card, req.Msg.GetAnswer(), req.Msg.GetResponseTimeMs())
+		grade, err = h.svc.GradeReverseAnswer(ctx, card, answer, responseTimeMs)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("grade answer: %w", err))
 		}
 	}
 	if grade.Classification != string(inference.ClassificationSynonym) {
-		if err := h.svc.SaveReverseResult(ctx, card, grade, req.Msg.GetResponseTimeMs()); err != nil {
+		if err := h.svc.SaveReverseResult(ctx, card, grade, responseTimeMs); err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update learning history: %w", err))
 		}
 	}
@@ -301,11 +334,39 @@ func (h *QuizHandler) SubmitReverseAnswer(ctx context.Context, req *connect.Requ
 		contexts = append(contexts, c.Context)
 	}
 	learnedAt, nextReviewDate := h.svc.GetLatestLearnedInfo(card.NotebookName, card.ID, card.Expression, notebook.QuizTypeReverse)
-	return connect.NewResponse(&apiv1.SubmitReverseAnswerResponse{
+	return &apiv1.SubmitReverseAnswerResponse{
 		Correct: grade.Correct, Expression: card.Expression, Meaning: card.Meaning, Reason: grade.Reason,
 		Contexts: contexts, WordDetail: toProtoWordDetail(card.WordDetail), Classification: grade.Classification,
 		NextReviewDate: nextReviewDate, LearnedAt: learnedAt, Images: card.Images, SenseId: card.ID,
-	}), nil
+	}, nil
+}
+
+// SubmitReverseAnswerAudio transcribes a spoken reverse answer and grades
+// the transcript like a typed one.
+func (h *QuizHandler) SubmitReverseAnswerAudio(ctx context.Context, req *connect.Request[apiv1.SubmitReverseAnswerAudioRequest]) (*connect.Response[apiv1.SubmitReverseAnswerAudioResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	if h.transcriber == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("spoken answers need a speech-to-text service; set stt in the config"))
+	}
+	filename, ok := stt.AudioFilename(req.Msg.GetMediaType())
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported audio type %q", req.Msg.GetMediaType()))
+	}
+	card, err := h.reverseCard(req.Msg.GetNoteId())
+	if err != nil {
+		return nil, err
+	}
+	transcript, err := h.transcriber.Transcribe(ctx, req.Msg.GetAudio(), filename)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("transcribe answer: %w", err))
+	}
+	resp, err := h.submitReverseAnswer(ctx, card, transcript, req.Msg.GetResponseTimeMs(), false)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&apiv1.SubmitReverseAnswerAudioResponse{Result: resp, Transcript: transcript}), nil
 }
 
 func (h *QuizHandler) StartFreeformQuiz(ctx context.Context, req *connect.Request[apiv1.StartFreeformQuizRequest]) (*connect.Response[apiv1.StartFreeformQuizResponse], error) {
@@ -413,6 +474,16 @@ func (h *QuizHandler) resolveCardInfo(ctx context.Context, noteID int64) (*quiz.
 		}
 		return &info, nil
 	}
+	if dc, ok := h.dictationStore[noteID]; ok {
+		h.mu.Unlock()
+		info := quiz.CardInfoFromDictationCard(dc)
+		return &info, nil
+	}
+	if wc, ok := h.wordChoiceStore[noteID]; ok {
+		h.mu.Unlock()
+		info := quiz.CardInfoFromWordChoiceCard(wc)
+		return &info, nil
+	}
 	if rc, ok := h.relearnStore[noteID]; ok {
 		h.mu.Unlock()
 		// A grammar blank drilled in Relearn can be deliberately Excluded via
@@ -430,6 +501,10 @@ func (h *QuizHandler) resolveCardInfo(ctx context.Context, noteID int64) (*quiz.
 			}
 			return &info, nil
 		}
+		if rc.IsDictation() {
+			info := quiz.CardInfoFromDictationCard(rc.DictationCard())
+			return &info, nil
+		}
 		info := quiz.CardInfo{NotebookName: rc.NotebookName, Expression: rc.Entry}
 		return &info, nil
 	}
@@ -469,6 +544,10 @@ func protoQuizTypeToNotebook(qt apiv1.QuizType) notebook.QuizType {
 		return notebook.QuizTypeEtymologyOrigin
 	case apiv1.QuizType_QUIZ_TYPE_GRAMMAR:
 		return notebook.QuizTypeGrammar
+	case apiv1.QuizType_QUIZ_TYPE_DICTATION:
+		return notebook.QuizTypeDictation
+	case apiv1.QuizType_QUIZ_TYPE_WORD_CHOICE:
+		return notebook.QuizTypeWordChoice
 	default:
 		return notebook.QuizTypeNotebook
 	}
@@ -504,6 +583,75 @@ func (h *QuizHandler) ResumeWord(ctx context.Context, req *connect.Request[apiv1
 	return connect.NewResponse(&apiv1.ResumeWordResponse{}), nil
 }
 
+// GenerateMnemonic writes a memory hook into the memo of the card's note.
+// Standard-quiz cards carry the note's sense id, so the exact entry is
+// targeted; other cards resolve by expression within the card's notebook.
+func (h *QuizHandler) GenerateMnemonic(ctx context.Context, req *connect.Request[apiv1.GenerateMnemonicRequest]) (*connect.Response[apiv1.GenerateMnemonicResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	info, err := h.resolveCardInfo(ctx, req.Msg.GetNoteId())
+	if err != nil {
+		return nil, err
+	}
+	target := quiz.MnemonicTarget{
+		NotebookID: info.NotebookName,
+		ID:         info.ID,
+		Expression: info.Expression,
+	}
+	if info.OriginalExpression != "" {
+		target.Expression = info.OriginalExpression
+	}
+	h.mu.Lock()
+	if card, ok := h.noteStore[req.Msg.GetNoteId()]; ok && card.ID != "" {
+		target.ID = card.ID
+	}
+	h.mu.Unlock()
+
+	results, err := h.svc.GenerateMnemonics(ctx, target, false)
+	if err != nil {
+		var notFoundErr *quiz.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("generate mnemonic: %w", err))
+	}
+	if len(results) == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%q already has a memo or has no meaning", target.Expression))
+	}
+	return connect.NewResponse(&apiv1.GenerateMnemonicResponse{Memo: results[0].Mnemonic}), nil
+}
+
+// SubmitDiscriminationAnswer moves the words of a confusion pair missed in
+// the discrimination drill into the Relearn Quiz pool for the quiz types
+// they were confused in.
+func (h *QuizHandler) SubmitDiscriminationAnswer(ctx context.Context, req *connect.Request[apiv1.SubmitDiscriminationAnswerRequest]) (*connect.Response[apiv1.SubmitDiscriminationAnswerResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	quizTypes := make([]notebook.QuizType, 0, len(req.Msg.GetQuizTypes()))
+	for _, qt := range req.Msg.GetQuizTypes() {
+		switch quizType := notebook.QuizType(qt); quizType {
+		case notebook.QuizTypeNotebook, notebook.QuizTypeReverse, notebook.QuizTypeFreeform:
+			quizTypes = append(quizTypes, quizType)
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("quiz type %q has no confusions", qt))
+		}
+	}
+	if req.Msg.GetCorrect() {
+		return connect.NewResponse(&apiv1.SubmitDiscriminationAnswerResponse{}), nil
+	}
+	words := make([]quiz.CardInfo, 0, len(req.Msg.GetWords()))
+	for _, word := range req.Msg.GetWords() {
+		words = append(words, quiz.CardInfo{NotebookName: req.Msg.GetNotebookId(), Expression: word.GetExpression(), ID: word.GetSenseId()})
+	}
+	moved, err := h.svc.MoveConfusionToRelearnPool(words, quizTypes)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("move confusion to relearn pool: %w", err))
+	}
+	return connect.NewResponse(&apiv1.SubmitDiscriminationAnswerResponse{MovedToRelearnPool: moved}), nil
+}
+
 // protoQuizTypesToNotebook converts a repeated proto QuizType list to the
 // internal notebook.QuizType slice. Used by SkipWord/ResumeWord which
 // accept multiple types per request.
@@ -589,6 +737,7 @@ func (h *QuizHandler) OverrideAnswer(ctx context.Context, req *connect.Request[a
 		OriginalQuality:      int32(res.OriginalQuality),
 		OriginalStatus:       res.OriginalStatus,
 		OriginalIntervalDays: int32(res.OriginalIntervalDays),
+		OriginalGrader:       res.OriginalGrader,
 	}), nil
 }
 
@@ -606,6 +755,7 @@ func (h *QuizHandler) UndoOverrideAnswer(ctx context.Context, req *connect.Reque
 	info.OriginalQuality = int(req.Msg.GetOriginalQuality())
 	info.OriginalStatus = req.Msg.GetOriginalStatus()
 	info.OriginalIntervalDays = int(req.Msg.GetOriginalIntervalDays())
+	info.OriginalGrader = req.Msg.GetOriginalGrader()
 	quizType := protoQuizTypeToNotebook(req.Msg.GetQuizType())
 	correct, nextReviewDate, err := h.svc.UndoOverrideAnswer(*info, quizType)
 	if err != nil {
@@ -24,6 +24,7 @@ func skippedGradeResult() quiz.GradeResult {
 		Reason:         "skipped by user",
 		Quality:        1,
 		Classification: string(inference.ClassificationWrong),
+		Grader:         notebook.GraderDeterministic,
 	}
 }
 
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+// StartDictationQuiz loads the due lines of the selected story notebooks and
+// assigns each an ephemeral note_id. The line itself stays on the server
+// until the answer is submitted.
+func (h *QuizHandler) StartDictationQuiz(ctx context.Context, req *connect.Request[apiv1.StartDictationQuizRequest]) (*connect.Response[apiv1.StartDictationQuizResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	notebookIDs, sectionTitles, err := resolveNotebookSections(req.Msg.GetNotebookIds(), req.Msg.GetNotebookSections())
+	if err != nil {
+		return nil, err
+	}
+	cards, err := h.svc.LoadDictationCards(ctx, notebookIDs, req.Msg.GetIncludeUnstudied(), sectionTitles)
+	if err != nil {
+		var notFoundErr *quiz.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("load dictation cards: %w", err))
+	}
+
+	protoCards := make([]*apiv1.DictationCard, 0, len(cards))
+	h.mu.Lock()
+	h.dictationStore = make(map[int64]quiz.DictationCard, len(cards))
+	for _, card := range cards {
+		noteID := h.nextID
+		h.nextID++
+		h.dictationStore[noteID] = card
+		protoCards = append(protoCards, &apiv1.DictationCard{
+			NoteId:     noteID,
+			NotebookId: card.NotebookName,
+			StoryTitle: card.StoryTitle,
+			SceneTitle: card.SceneTitle,
+			Speaker:    card.Speaker,
+			Audio:      card.Audio,
+			WordCount:  int32(card.WordCount()),
+		})
+	}
+	h.mu.Unlock()
+
+	return connect.NewResponse(&apiv1.StartDictationQuizResponse{Cards: protoCards}), nil
+}
+
+// SubmitDictationAnswer grades what the user heard against the line and
+// records the result in the word's dictation learning history.
+func (h *QuizHandler) SubmitDictationAnswer(ctx context.Context, req *connect.Request[apiv1.SubmitDictationAnswerRequest]) (*connect.Response[apiv1.SubmitDictationAnswerResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	noteID := req.Msg.GetNoteId()
+	h.mu.Lock()
+	card, ok := h.dictationStore[noteID]
+	h.mu.Unlock()
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("note %d not found", noteID))
+	}
+
+	grade := skippedGradeResult()
+	if !req.Msg.GetIsSkipped() {
+		grade = quiz.GradeDictation(card, req.Msg.GetAnswer())
+	}
+	if err := h.svc.SaveDictationResult(ctx, card, grade, req.Msg.GetResponseTimeMs()); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update learning history: %w", err))
+	}
+	learnedAt, nextReviewDate := h.svc.GetLatestLearnedInfo(card.NotebookName, card.ID, card.Entry, notebook.QuizTypeDictation)
+	return connect.NewResponse(&apiv1.SubmitDictationAnswerResponse{
+		Correct:        grade.Correct,
+		Line:           card.Line,
+		Expression:     card.Expression,
+		Meaning:        card.Meaning,
+		Reason:         grade.Reason,
+		NextReviewDate: nextReviewDate,
+		LearnedAt:      learnedAt,
+	}), nil
+}
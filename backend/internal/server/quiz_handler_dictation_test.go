@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+func newDictationHandler(t *testing.T) (*QuizHandler, string) {
+	t.Helper()
+	base := t.TempDir()
+
+	storyDir := filepath.Join(base, "stories", "drama")
+	require.NoError(t, os.MkdirAll(filepath.Join(storyDir, "audio"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(storyDir, "index.yml"), []byte(
+		"id: drama\nname: \"Office Drama\"\nnotebooks:\n  - ./episode1.yml\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(storyDir, "audio", "kitchen.wav"), []byte("RIFF"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(storyDir, "episode1.yml"), []byte(`- event: "Episode 1"
+  scenes:
+    - scene: "Kitchen"
+      conversations:
+        - speaker: "Bob"
+          quote: "I can't put up with this noise anymore."
+          audio: audio/kitchen.wav
+      definitions:
+        - expression: "put up with"
+          meaning: "to tolerate"
+`), 0o644))
+
+	learningDir := t.TempDir()
+	quizCfg := config.QuizConfig{Algorithm: "modified_sm2", FixedIntervals: []int{1, 7, 30, 90, 365, 1095, 1825}}
+	calc := notebook.NewIntervalCalculator(quizCfg.Algorithm, quizCfg.FixedIntervals)
+	svc := quiz.NewService(
+		config.NotebooksConfig{
+			StoriesDirectories:     []string{filepath.Join(base, "stories")},
+			LearningNotesDirectory: learningDir,
+		},
+		nil,
+		nil,
+		learning.NewYAMLLearningRepository(learningDir, calc),
+		quizCfg,
+	)
+	return NewQuizHandler(svc), learningDir
+}
+
+func TestQuizHandler_DictationQuiz(t *testing.T) {
+	ctx := context.Background()
+	handler, learningDir := newDictationHandler(t)
+
+	// Start: the line is played by its audio; its text stays on the server.
+	start, err := handler.StartDictationQuiz(ctx, connect.NewRequest(&apiv1.StartDictationQuizRequest{
+		NotebookIds:      []string{"drama"},
+		IncludeUnstudied: true,
+	}))
+	require.NoError(t, err)
+	require.Len(t, start.Msg.GetCards(), 1)
+	card := start.Msg.GetCards()[0]
+	assert.Greater(t, card.GetNoteId(), int64(0))
+	assert.Equal(t, "drama", card.GetNotebookId())
+	assert.Equal(t, "Kitchen", card.GetSceneTitle())
+	assert.Equal(t, "Bob", card.GetSpeaker())
+	assert.Equal(t, "audio/kitchen.wav", card.GetAudio())
+	assert.Equal(t, int32(8), card.GetWordCount())
+
+	// Submit: graded against the line, which is revealed, and recorded.
+	sub, err := handler.SubmitDictationAnswer(ctx, connect.NewRequest(&apiv1.SubmitDictationAnswerRequest{
+		NoteId:         card.GetNoteId(),
+		Answer:         "I can't put up with this noise",
+		ResponseTimeMs: 2000,
+	}))
+	require.NoError(t, err)
+	assert.True(t, sub.Msg.GetCorrect())
+	assert.Equal(t, "I can't put up with this noise anymore.", sub.Msg.GetLine())
+	assert.Equal(t, "put up with", sub.Msg.GetExpression())
+	assert.Equal(t, "to tolerate", sub.Msg.GetMeaning())
+	assert.Equal(t, "Missed: anymore.", sub.Msg.GetReason())
+	assert.NotEmpty(t, sub.Msg.GetLearnedAt())
+	assert.NotEmpty(t, sub.Msg.GetNextReviewDate())
+
+	histories, err := notebook.NewLearningHistories(learningDir)
+	require.NoError(t, err)
+	require.Len(t, histories["drama"], 1)
+	require.Len(t, histories["drama"][0].Scenes, 1)
+	expr := histories["drama"][0].Scenes[0].Expressions[0]
+	assert.Equal(t, "put up with", expr.Expression)
+	require.Len(t, expr.DictationLogs, 1)
+	assert.Empty(t, expr.LearnedLogs)
+
+	// Skip: resolves the dictation card to its word like any other card.
+	_, err = handler.SkipWord(ctx, connect.NewRequest(&apiv1.SkipWordRequest{
+		NoteId:    card.GetNoteId(),
+		QuizTypes: []apiv1.QuizType{apiv1.QuizType_QUIZ_TYPE_DICTATION},
+	}))
+	require.NoError(t, err)
+	histories, err = notebook.NewLearningHistories(learningDir)
+	require.NoError(t, err)
+	assert.True(t, histories["drama"][0].Scenes[0].Expressions[0].SkippedAt.IsSkipped(notebook.QuizTypeDictation))
+}
+
+func TestQuizHandler_SubmitDictationAnswer_UnknownNote(t *testing.T) {
+	handler, _ := newDictationHandler(t)
+
+	_, err := handler.SubmitDictationAnswer(context.Background(), connect.NewRequest(&apiv1.SubmitDictationAnswerRequest{
+		NoteId: 42,
+		Answer: "hello",
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
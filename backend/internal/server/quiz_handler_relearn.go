@@ -123,6 +123,8 @@ func (h *QuizHandler) StartRelearnQuiz(ctx context.Context, req *connect.Request
 			EnglishForms:    card.EnglishForms,
 			OriginDirection: notebookQuizTypeToProto(card.Direction),
 			RelatedWords:    relatedWords,
+			Audio:           card.Audio,
+			NotebookId:      card.NotebookName,
 		})
 	}
 
@@ -220,6 +222,8 @@ func (h *QuizHandler) gradeRelearn(ctx context.Context, card quiz.RelearnCard, a
 		return h.svc.GradeReverseAnswer(ctx, card.ReverseCard(), answer, responseTimeMs)
 	case notebook.QuizTypeGrammar:
 		return h.svc.GradeGrammarBlank(ctx, card.Content, card.GrammarCard(), answer, responseTimeMs)
+	case notebook.QuizTypeDictation:
+		return quiz.GradeDictation(card.DictationCard(), answer), nil
 	case notebook.QuizTypeEtymologyOrigin:
 		// An origin family word is graded in the direction it was missed: a
 		// reverse word produces the word (reverseCard), a recognition word types
@@ -259,6 +263,9 @@ func (h *QuizHandler) buildRelearnResponse(ctx context.Context, card quiz.Relear
 		resp.Category = gc.Category
 		resp.GrammarNote = gc.Reason
 	}
+	if card.IsDictation() {
+		resp.CorrectAnswer = card.DictationCard().Line
+	}
 	return resp
 }
 
@@ -294,6 +301,8 @@ func notebookQuizTypeToProto(qt notebook.QuizType) apiv1.QuizType {
 		return apiv1.QuizType_QUIZ_TYPE_ETYMOLOGY_ORIGIN
 	case notebook.QuizTypeGrammar:
 		return apiv1.QuizType_QUIZ_TYPE_GRAMMAR
+	case notebook.QuizTypeDictation:
+		return apiv1.QuizType_QUIZ_TYPE_DICTATION
 	default:
 		return apiv1.QuizType_QUIZ_TYPE_STANDARD
 	}
@@ -22,6 +22,8 @@ import (
 	mock_notebook "github.com/at-ishikawa/langner/internal/mocks/notebook"
 	"github.com/at-ishikawa/langner/internal/notebook"
 	"github.com/at-ishikawa/langner/internal/quiz"
+	"github.com/at-ishikawa/langner/internal/stt"
+	sttmock "github.com/at-ishikawa/langner/internal/stt/mock"
 )
 
 func newTestHandler(t *testing.T, openaiClient inference.Client) *QuizHandler {
@@ -1450,6 +1452,120 @@ func TestQuizHandler_SkipWord_FromSession(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestQuizHandler_GenerateMnemonic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClient := mock_inference.NewMockClient(ctrl)
+	handler, _ := newTestHandlerWithFixtures(t, mockClient)
+
+	startResp, err := handler.StartQuiz(
+		context.Background(),
+		connect.NewRequest(&apiv1.StartQuizRequest{
+			NotebookIds:      []string{"test-story"},
+			IncludeUnstudied: true,
+		}),
+	)
+	require.NoError(t, err)
+	require.Len(t, startResp.Msg.GetFlashcards(), 1)
+	noteID := startResp.Msg.GetFlashcards()[0].GetNoteId()
+
+	mockClient.EXPECT().GenerateMnemonic(gomock.Any(), inference.GenerateMnemonicRequest{
+		Expression: "preposterous",
+		Meaning:    "contrary to reason or common sense",
+		Contexts:   []string{"That sounds preposterous to me."},
+	}).Return(inference.GenerateMnemonicResponse{Mnemonic: "Pre + post: before is after, absurd."}, nil)
+
+	resp, err := handler.GenerateMnemonic(
+		context.Background(),
+		connect.NewRequest(&apiv1.GenerateMnemonicRequest{NoteId: noteID}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Pre + post: before is after, absurd.", resp.Msg.GetMemo())
+
+	// The note now has a memo, so a second request has nothing to write.
+	_, err = handler.GenerateMnemonic(
+		context.Background(),
+		connect.NewRequest(&apiv1.GenerateMnemonicRequest{NoteId: noteID}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = handler.GenerateMnemonic(
+		context.Background(),
+		connect.NewRequest(&apiv1.GenerateMnemonicRequest{NoteId: 999}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestQuizHandler_SubmitDiscriminationAnswer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClient := mock_inference.NewMockClient(ctrl)
+	handler, learningDir := newTestHandlerWithFixtures(t, mockClient)
+
+	startResp, err := handler.StartQuiz(
+		context.Background(),
+		connect.NewRequest(&apiv1.StartQuizRequest{
+			NotebookIds:      []string{"test-vocab"},
+			IncludeUnstudied: true,
+		}),
+	)
+	require.NoError(t, err)
+	noteID := startResp.Msg.GetFlashcards()[0].GetNoteId()
+	mockClient.EXPECT().AnswerMeanings(gomock.Any(), gomock.Any()).Return(
+		inference.AnswerMeaningsResponse{
+			Answers: []inference.AnswerMeaning{
+				{
+					Expression: "serendipity",
+					Meaning:    "a fortunate discovery by accident",
+					AnswersForContext: []inference.AnswersForContext{
+						{Correct: false, Reason: "That is another word.", Quality: 1},
+					},
+				},
+			},
+		}, nil,
+	)
+	_, err = handler.SubmitAnswer(
+		context.Background(),
+		connect.NewRequest(&apiv1.SubmitAnswerRequest{NoteId: noteID, Answer: "luck", ResponseTimeMs: 1000}),
+	)
+	require.NoError(t, err)
+
+	request := func(correct bool, quizTypes ...string) *connect.Request[apiv1.SubmitDiscriminationAnswerRequest] {
+		return connect.NewRequest(&apiv1.SubmitDiscriminationAnswerRequest{
+			NotebookId: "test-vocab",
+			Words: []*apiv1.DiscriminationWord{
+				{Expression: "fortune"},
+				{Expression: "serendipity"},
+			},
+			QuizTypes: quizTypes,
+			Correct:   correct,
+		})
+	}
+	readHistory := func() notebook.LearningHistoryExpression {
+		raw, err := os.ReadFile(filepath.Join(learningDir, "test-vocab.yml"))
+		require.NoError(t, err)
+		var got []notebook.LearningHistory
+		require.NoError(t, yaml.Unmarshal(raw, &got))
+		expression := notebook.FindExpressionInHistories(got, "", "serendipity")
+		require.NotNil(t, expression)
+		return *expression
+	}
+
+	resp, err := handler.SubmitDiscriminationAnswer(context.Background(), request(true, "notebook"))
+	require.NoError(t, err)
+	assert.False(t, resp.Msg.GetMovedToRelearnPool())
+	assert.False(t, readHistory().InRelearnPool(notebook.QuizTypeNotebook))
+
+	_, err = handler.SubmitDiscriminationAnswer(context.Background(), request(false, "dictation"))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// The never-studied word has no history to pool; the other one does.
+	resp, err = handler.SubmitDiscriminationAnswer(context.Background(), request(false, "notebook"))
+	require.NoError(t, err)
+	assert.True(t, resp.Msg.GetMovedToRelearnPool())
+	assert.True(t, readHistory().InRelearnPool(notebook.QuizTypeNotebook))
+}
+
 func TestQuizHandler_SkipWord_ValidationError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockClient := mock_inference.NewMockClient(ctrl)
@@ -1942,3 +2058,85 @@ func TestQuizHandler_SkipWord_WithCustomDate(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, resp)
 }
+
+func TestQuizHandler_SubmitReverseAnswerAudio(t *testing.T) {
+	tests := []struct {
+		name        string
+		transcriber stt.Transcriber
+		mediaType   string
+		noteID      int64
+		wantCode    connect.Code
+	}{
+		{
+			name:        "the transcript is graded like a typed answer",
+			transcriber: sttmock.NewTranscriber(),
+			mediaType:   "audio/webm;codecs=opus",
+			noteID:      1,
+		},
+		{
+			name:      "no speech-to-text service",
+			mediaType: "audio/webm",
+			noteID:    1,
+			wantCode:  connect.CodeFailedPrecondition,
+		},
+		{
+			name:        "unsupported audio type",
+			transcriber: sttmock.NewTranscriber(),
+			mediaType:   "video/mp4",
+			noteID:      1,
+			wantCode:    connect.CodeInvalidArgument,
+		},
+		{
+			name:        "unknown note",
+			transcriber: sttmock.NewTranscriber(),
+			mediaType:   "audio/wav",
+			noteID:      2,
+			wantCode:    connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_inference.NewMockClient(ctrl)
+			handler := newTestHandler(t, mockClient)
+			if tt.transcriber != nil {
+				handler.SetTranscriber(tt.transcriber)
+			}
+			handler.reverseStore[1] = quiz.ReverseCard{
+				NotebookName: "notebook",
+				Expression:   "lose one's temper",
+				Meaning:      "to become angry",
+			}
+			if tt.wantCode == 0 {
+				mockClient.EXPECT().ValidateWordForm(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req inference.ValidateWordFormRequest) (inference.ValidateWordFormResponse, error) {
+						assert.Equal(t, "lose one's temper", req.UserAnswer)
+						return inference.ValidateWordFormResponse{
+							Classification: inference.ClassificationSameWord,
+							Reason:         "exact match",
+							Quality:        4,
+						}, nil
+					},
+				)
+			}
+
+			resp, err := handler.SubmitReverseAnswerAudio(context.Background(), connect.NewRequest(&apiv1.SubmitReverseAnswerAudioRequest{
+				NoteId:         tt.noteID,
+				Audio:          []byte(" lose one's temper\n"),
+				MediaType:      tt.mediaType,
+				ResponseTimeMs: 2000,
+			}))
+			if tt.wantCode != 0 {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "lose one's temper", resp.Msg.GetTranscript())
+			assert.True(t, resp.Msg.GetResult().GetCorrect())
+			assert.Equal(t, "lose one's temper", resp.Msg.GetResult().GetExpression())
+			assert.NotEmpty(t, resp.Msg.GetResult().GetLearnedAt(), "the spoken answer is recorded")
+		})
+	}
+}
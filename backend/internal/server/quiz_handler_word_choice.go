@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+// StartWordChoiceQuiz loads the due concept members of the selected
+// definitions books and assigns each an ephemeral note_id. Which member a
+// sentence was written for stays on the server until the answer is
+// submitted.
+func (h *QuizHandler) StartWordChoiceQuiz(ctx context.Context, req *connect.Request[apiv1.StartWordChoiceQuizRequest]) (*connect.Response[apiv1.StartWordChoiceQuizResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	notebookIDs, sectionTitles, err := resolveNotebookSections(req.Msg.GetNotebookIds(), req.Msg.GetNotebookSections())
+	if err != nil {
+		return nil, err
+	}
+	cards, err := h.svc.LoadWordChoiceCards(notebookIDs, req.Msg.GetIncludeUnstudied(), sectionTitles)
+	if err != nil {
+		var notFoundErr *quiz.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("load word choice cards: %w", err))
+	}
+
+	protoCards := make([]*apiv1.WordChoiceCard, 0, len(cards))
+	h.mu.Lock()
+	h.wordChoiceStore = make(map[int64]quiz.WordChoiceCard, len(cards))
+	for _, card := range cards {
+		noteID := h.nextID
+		h.nextID++
+		h.wordChoiceStore[noteID] = card
+		protoCard := &apiv1.WordChoiceCard{
+			NoteId:         noteID,
+			NotebookId:     card.NotebookName,
+			ConceptHead:    card.ConceptHead,
+			ConceptMeaning: card.ConceptMeaning,
+			ConceptKind:    string(card.ConceptKind),
+			Sentence:       card.Sentence,
+		}
+		for _, option := range card.Options {
+			protoCard.Options = append(protoCard.Options, option.Expression)
+		}
+		if card.IsDifference() {
+			protoCard.Expression = card.Expression
+			protoCard.CompareExpression = card.Compare.Expression
+		}
+		protoCards = append(protoCards, protoCard)
+	}
+	h.mu.Unlock()
+
+	return connect.NewResponse(&apiv1.StartWordChoiceQuizResponse{Cards: protoCards}), nil
+}
+
+// SubmitWordChoiceAnswer grades the chosen member or explained difference
+// and records the result in the member's word choice learning history.
+func (h *QuizHandler) SubmitWordChoiceAnswer(ctx context.Context, req *connect.Request[apiv1.SubmitWordChoiceAnswerRequest]) (*connect.Response[apiv1.SubmitWordChoiceAnswerResponse], error) {
+	if err := validateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	noteID := req.Msg.GetNoteId()
+	h.mu.Lock()
+	card, ok := h.wordChoiceStore[noteID]
+	h.mu.Unlock()
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("note %d not found", noteID))
+	}
+
+	grade := skippedGradeResult()
+	if !req.Msg.GetIsSkipped() {
+		grade = h.svc.GradeWordChoice(ctx, card, req.Msg.GetAnswer(), req.Msg.GetResponseTimeMs())
+	}
+	if err := h.svc.SaveWordChoiceResult(ctx, card, grade, req.Msg.GetResponseTimeMs()); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("update learning history: %w", err))
+	}
+	learnedAt, nextReviewDate := h.svc.GetLatestLearnedInfo(card.NotebookName, card.ID, card.Entry, notebook.QuizTypeWordChoice)
+	members := make([]*apiv1.WordChoiceMember, 0, len(card.Options))
+	for _, option := range card.Options {
+		members = append(members, &apiv1.WordChoiceMember{Expression: option.Expression, Meaning: option.Meaning})
+	}
+	return connect.NewResponse(&apiv1.SubmitWordChoiceAnswerResponse{
+		Correct:        grade.Correct,
+		Expression:     card.Expression,
+		Meaning:        card.Meaning,
+		Example:        card.Example,
+		Members:        members,
+		Reason:         grade.Reason,
+		NextReviewDate: nextReviewDate,
+		LearnedAt:      learnedAt,
+	}), nil
+}
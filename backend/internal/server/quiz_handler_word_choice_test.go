@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/at-ishikawa/langner/gen-protos/api/v1"
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/learning"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+func newWordChoiceHandler(t *testing.T) (*QuizHandler, string) {
+	t.Helper()
+	defsDir := t.TempDir()
+
+	bookDir := filepath.Join(defsDir, "nuance-book")
+	require.NoError(t, os.MkdirAll(bookDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(bookDir, "index.yml"), []byte(
+		"id: nuance-book\nnotebooks:\n  - ./session1.yml\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(bookDir, "session1.yml"), []byte(`- metadata:
+    title: "Session 1"
+  scenes:
+    - metadata:
+        index: 0
+        title: "adjectives"
+      expressions:
+        - expression: "hearty"
+          meaning: "large and satisfying"
+          examples:
+            - "We had a hearty breakfast before the hike."
+        - expression: "cordial"
+          meaning: "warm and friendly"
+  concepts:
+    - head: "hearty"
+      kind: synonym
+      meaning: "warm"
+      expressions:
+        - "hearty"
+        - "cordial"
+`), 0o644))
+
+	learningDir := t.TempDir()
+	quizCfg := config.QuizConfig{Algorithm: "modified_sm2", FixedIntervals: []int{1, 7, 30, 90, 365, 1095, 1825}, DisableShuffle: true}
+	calc := notebook.NewIntervalCalculator(quizCfg.Algorithm, quizCfg.FixedIntervals)
+	svc := quiz.NewService(
+		config.NotebooksConfig{
+			DefinitionsDirectories: []string{defsDir},
+			LearningNotesDirectory: learningDir,
+		},
+		nil,
+		nil,
+		learning.NewYAMLLearningRepository(learningDir, calc),
+		quizCfg,
+	)
+	return NewQuizHandler(svc), learningDir
+}
+
+func TestQuizHandler_WordChoiceQuiz(t *testing.T) {
+	ctx := context.Background()
+	handler, learningDir := newWordChoiceHandler(t)
+
+	// Start: a fit question for the member with an example and a difference
+	// question for the one without.
+	start, err := handler.StartWordChoiceQuiz(ctx, connect.NewRequest(&apiv1.StartWordChoiceQuizRequest{
+		NotebookIds:      []string{"nuance-book"},
+		IncludeUnstudied: true,
+	}))
+	require.NoError(t, err)
+	require.Len(t, start.Msg.GetCards(), 2)
+	fit := start.Msg.GetCards()[0]
+	assert.Greater(t, fit.GetNoteId(), int64(0))
+	assert.Equal(t, "nuance-book", fit.GetNotebookId())
+	assert.Equal(t, "hearty", fit.GetConceptHead())
+	assert.Equal(t, "synonym", fit.GetConceptKind())
+	assert.Equal(t, "We had a ______ breakfast before the hike.", fit.GetSentence())
+	assert.Equal(t, []string{"hearty", "cordial"}, fit.GetOptions())
+	assert.Empty(t, fit.GetExpression(), "the answer of a fit question stays on the server")
+
+	difference := start.Msg.GetCards()[1]
+	assert.Empty(t, difference.GetSentence())
+	assert.Equal(t, "cordial", difference.GetExpression())
+	assert.Equal(t, "hearty", difference.GetCompareExpression())
+
+	// Submit: graded without a model, revealed and recorded.
+	sub, err := handler.SubmitWordChoiceAnswer(ctx, connect.NewRequest(&apiv1.SubmitWordChoiceAnswerRequest{
+		NoteId:         fit.GetNoteId(),
+		Answer:         "hearty",
+		ResponseTimeMs: 2000,
+	}))
+	require.NoError(t, err)
+	assert.True(t, sub.Msg.GetCorrect())
+	assert.Equal(t, "hearty", sub.Msg.GetExpression())
+	assert.Equal(t, "large and satisfying", sub.Msg.GetMeaning())
+	assert.Equal(t, "We had a hearty breakfast before the hike.", sub.Msg.GetExample())
+	require.Len(t, sub.Msg.GetMembers(), 2)
+	assert.Equal(t, "cordial", sub.Msg.GetMembers()[1].GetExpression())
+	assert.Equal(t, "warm and friendly", sub.Msg.GetMembers()[1].GetMeaning())
+	assert.NotEmpty(t, sub.Msg.GetLearnedAt())
+	assert.NotEmpty(t, sub.Msg.GetNextReviewDate())
+
+	histories, err := notebook.NewLearningHistories(learningDir)
+	require.NoError(t, err)
+	require.Len(t, histories["nuance-book"], 1)
+	require.Len(t, histories["nuance-book"][0].Scenes, 1)
+	expr := histories["nuance-book"][0].Scenes[0].Expressions[0]
+	assert.Equal(t, "hearty", expr.Expression)
+	require.Len(t, expr.WordChoiceLogs, 1)
+	assert.Empty(t, expr.LearnedLogs)
+
+	// Skip: resolves the word choice card to its member like any other card.
+	_, err = handler.SkipWord(ctx, connect.NewRequest(&apiv1.SkipWordRequest{
+		NoteId:    difference.GetNoteId(),
+		QuizTypes: []apiv1.QuizType{apiv1.QuizType_QUIZ_TYPE_WORD_CHOICE},
+	}))
+	require.NoError(t, err)
+	histories, err = notebook.NewLearningHistories(learningDir)
+	require.NoError(t, err)
+	var skipped bool
+	for _, e := range histories["nuance-book"][0].Scenes[0].Expressions {
+		if e.Expression == "cordial" {
+			skipped = e.SkippedAt.IsSkipped(notebook.QuizTypeWordChoice)
+		}
+	}
+	assert.True(t, skipped)
+}
+
+func TestQuizHandler_SubmitWordChoiceAnswer_UnknownNote(t *testing.T) {
+	handler, _ := newWordChoiceHandler(t)
+
+	_, err := handler.SubmitWordChoiceAnswer(context.Background(), connect.NewRequest(&apiv1.SubmitWordChoiceAnswerRequest{
+		NoteId: 42,
+		Answer: "hearty",
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
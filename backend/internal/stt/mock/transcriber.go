@@ -0,0 +1,24 @@
+// Package mock provides a deterministic stt.Transcriber used by e2e tests.
+// It avoids running a speech-to-text model and instead reads the audio back
+// as the UTF-8 text of what was said, so a test can "speak" an answer by
+// sending its text.
+package mock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type Transcriber struct{}
+
+func NewTranscriber() *Transcriber {
+	return &Transcriber{}
+}
+
+func (t *Transcriber) Transcribe(_ context.Context, audio []byte, _ string) (string, error) {
+	if len(audio) == 0 {
+		return "", fmt.Errorf("no audio to transcribe")
+	}
+	return strings.TrimSpace(string(audio)), nil
+}
@@ -0,0 +1,249 @@
+// Package stt transcribes spoken answers with a local speech-to-text
+// service such as a whisper.cpp server.
+package stt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/stt/mock"
+)
+
+// Transcriber turns speech into text.
+type Transcriber interface {
+	// Transcribe returns what is said in audio. filename only tells the
+	// service the audio's format by its extension, e.g. "answer.webm".
+	Transcribe(ctx context.Context, audio []byte, filename string) (string, error)
+}
+
+const (
+	ModeWhisper = "whisper"
+	ModeMock    = "mock"
+)
+
+// defaultLanguage is the language answers are transcribed in when none is
+// configured.
+const defaultLanguage = "en"
+
+// whisperTimeout bounds one transcription. A quiz answer is a few seconds
+// of audio, which a local model transcribes well within this.
+const whisperTimeout = 60 * time.Second
+
+// audioExtensions are the recorded-answer formats a transcriber is sent,
+// by media type.
+var audioExtensions = map[string]string{
+	"audio/wav":   ".wav",
+	"audio/x-wav": ".wav",
+	"audio/wave":  ".wav",
+	"audio/webm":  ".webm",
+	"audio/ogg":   ".ogg",
+	"audio/mpeg":  ".mp3",
+	"audio/mp4":   ".m4a",
+	"audio/flac":  ".flac",
+}
+
+// AudioFilename returns the file name audio of mediaType is transcribed
+// under, ignoring parameters such as "codecs=opus", or false when the
+// media type isn't a supported audio format.
+func AudioFilename(mediaType string) (string, bool) {
+	base, _, _ := strings.Cut(mediaType, ";")
+	ext, ok := audioExtensions[strings.ToLower(strings.TrimSpace(base))]
+	if !ok {
+		return "", false
+	}
+	return "answer" + ext, true
+}
+
+// NewTranscriber returns the transcriber configured by cfg, or nil when
+// spoken answers are disabled.
+func NewTranscriber(cfg config.STTConfig) (Transcriber, error) {
+	switch cfg.Mode {
+	case "":
+		return nil, nil
+	case ModeMock:
+		return mock.NewTranscriber(), nil
+	case ModeWhisper:
+		client, err := NewWhisperClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	default:
+		return nil, fmt.Errorf("unknown stt mode %q", cfg.Mode)
+	}
+}
+
+// WhisperClient transcribes audio with a whisper.cpp compatible server,
+// posting it to the server's /inference endpoint.
+type WhisperClient struct {
+	url        string
+	language   string
+	httpClient *http.Client
+}
+
+// NewWhisperClient returns a client of the whisper.cpp server configured by
+// cfg.
+func NewWhisperClient(cfg config.STTConfig) (*WhisperClient, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("stt.url is required for the %s mode", ModeWhisper)
+	}
+	language := cfg.Language
+	if language == "" {
+		language = defaultLanguage
+	}
+	return &WhisperClient{
+		url:        strings.TrimSuffix(cfg.URL, "/") + "/inference",
+		language:   language,
+		httpClient: &http.Client{Timeout: whisperTimeout},
+	}, nil
+}
+
+// SetTimeout replaces how long one request may take, e.g. to transcribe
+// the whole soundtrack of a video rather than a quiz answer.
+func (c *WhisperClient) SetTimeout(timeout time.Duration) {
+	c.httpClient.Timeout = timeout
+}
+
+// whisperResponse is the JSON response of the /inference endpoint.
+type whisperResponse struct {
+	Text     string           `json:"text"`
+	Segments []whisperSegment `json:"segments"`
+	Error    string           `json:"error"`
+}
+
+// whisperSegment is a segment of a verbose_json response. Speaker and
+// SpeakerTurnNext are only reported by servers that diarize.
+type whisperSegment struct {
+	Start           float64 `json:"start"`
+	End             float64 `json:"end"`
+	Text            string  `json:"text"`
+	Speaker         string  `json:"speaker"`
+	SpeakerTurnNext bool    `json:"speaker_turn_next"`
+}
+
+// Segment is a stretch of speech with its times in seconds from the start
+// of the audio.
+type Segment struct {
+	Start float64
+	End   float64
+	Text  string
+	// Speaker labels who is speaking, when the service diarizes.
+	Speaker string
+	// SpeakerTurnNext reports that the speaker changes after this segment,
+	// when the service detects turns without labelling speakers.
+	SpeakerTurnNext bool
+}
+
+// Transcribe implements Transcriber.
+func (c *WhisperClient) Transcribe(ctx context.Context, audio []byte, filename string) (string, error) {
+	result, err := c.inference(ctx, audio, filename, "json")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(result.Text), nil
+}
+
+// TranscribeSegments returns the segments of speech in audio with their
+// timestamps, skipping segments with no text.
+func (c *WhisperClient) TranscribeSegments(ctx context.Context, audio []byte, filename string) ([]Segment, error) {
+	result, err := c.inference(ctx, audio, filename, "verbose_json")
+	if err != nil {
+		return nil, err
+	}
+	return result.segments(), nil
+}
+
+// ParseSegments returns the segments of a verbose_json response saved from
+// a whisper.cpp compatible server, as TranscribeSegments returns them.
+func ParseSegments(data []byte) ([]Segment, error) {
+	var result whisperResponse
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("unmarshal transcript: %w", err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("transcript: %s", result.Error)
+	}
+	return result.segments(), nil
+}
+
+func (r whisperResponse) segments() []Segment {
+	segments := make([]Segment, 0, len(r.Segments))
+	for _, segment := range r.Segments {
+		text := strings.TrimSpace(segment.Text)
+		if text == "" {
+			continue
+		}
+		segments = append(segments, Segment{
+			Start:           segment.Start,
+			End:             segment.End,
+			Text:            text,
+			Speaker:         segment.Speaker,
+			SpeakerTurnNext: segment.SpeakerTurnNext,
+		})
+	}
+	return segments
+}
+
+// inference posts audio to the server and returns its response in format.
+func (c *WhisperClient) inference(ctx context.Context, audio []byte, filename, format string) (*whisperResponse, error) {
+	if len(audio) == 0 {
+		return nil, fmt.Errorf("no audio to transcribe")
+	}
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	file, err := form.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, fmt.Errorf("create form file: %w", err)
+	}
+	if _, err := file.Write(audio); err != nil {
+		return nil, fmt.Errorf("write form file: %w", err)
+	}
+	for key, value := range map[string]string{
+		"response_format": format,
+		"language":        c.language,
+		"temperature":     "0",
+	} {
+		if err := form.WriteField(key, value); err != nil {
+			return nil, fmt.Errorf("write form field %s: %w", key, err)
+		}
+	}
+	if err := form.Close(); err != nil {
+		return nil, fmt.Errorf("close form: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, &body)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("post %s: %w", c.url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("post %s: %s: %s", c.url, resp.Status, strings.TrimSpace(string(data)))
+	}
+	var result whisperResponse
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("transcribe: %s", result.Error)
+	}
+	return &result, nil
+}
@@ -0,0 +1,172 @@
+package stt
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/stt/mock"
+)
+
+func TestNewTranscriber(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.STTConfig
+		want    Transcriber
+		wantErr string
+	}{
+		{
+			name: "disabled",
+			cfg:  config.STTConfig{},
+			want: nil,
+		},
+		{
+			name: "mock",
+			cfg:  config.STTConfig{Mode: ModeMock},
+			want: mock.NewTranscriber(),
+		},
+		{
+			name: "whisper",
+			cfg:  config.STTConfig{Mode: ModeWhisper, URL: "http://127.0.0.1:8178/"},
+			want: &WhisperClient{url: "http://127.0.0.1:8178/inference", language: "en", httpClient: &http.Client{Timeout: whisperTimeout}},
+		},
+		{
+			name:    "whisper without a url",
+			cfg:     config.STTConfig{Mode: ModeWhisper},
+			wantErr: "stt.url is required",
+		},
+		{
+			name:    "unknown mode",
+			cfg:     config.STTConfig{Mode: "vosk"},
+			wantErr: `unknown stt mode "vosk"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTranscriber(tt.cfg)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAudioFilename(t *testing.T) {
+	tests := []struct {
+		mediaType string
+		want      string
+		wantOK    bool
+	}{
+		{mediaType: "audio/webm;codecs=opus", want: "answer.webm", wantOK: true},
+		{mediaType: "audio/WAV", want: "answer.wav", wantOK: true},
+		{mediaType: "audio/mp4", want: "answer.m4a", wantOK: true},
+		{mediaType: "video/mp4", wantOK: false},
+		{mediaType: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mediaType, func(t *testing.T) {
+			got, ok := AudioFilename(tt.mediaType)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWhisperClient_Transcribe(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "the transcript is trimmed",
+			status: http.StatusOK,
+			body:   `{"text": " lose one's temper\n"}`,
+			want:   "lose one's temper",
+		},
+		{
+			name:    "an error response",
+			status:  http.StatusOK,
+			body:    `{"error": "failed to read WAV file"}`,
+			wantErr: "failed to read WAV file",
+		},
+		{
+			name:    "a failing server",
+			status:  http.StatusInternalServerError,
+			body:    "model not loaded",
+			wantErr: "500 Internal Server Error: model not loaded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/inference", r.URL.Path)
+				require.NoError(t, r.ParseMultipartForm(1<<20))
+				assert.Equal(t, "json", r.FormValue("response_format"))
+				assert.Equal(t, "fr", r.FormValue("language"))
+				file, header, err := r.FormFile("file")
+				require.NoError(t, err)
+				assert.Equal(t, "answer.wav", header.Filename)
+				audio, err := io.ReadAll(file)
+				require.NoError(t, err)
+				assert.Equal(t, "RIFF", string(audio))
+
+				w.WriteHeader(tt.status)
+				_, _ = io.WriteString(w, tt.body)
+			}))
+			defer server.Close()
+
+			client, err := NewWhisperClient(config.STTConfig{Mode: ModeWhisper, URL: server.URL, Language: "fr"})
+			require.NoError(t, err)
+			got, err := client.Transcribe(context.Background(), []byte("RIFF"), "answer.wav")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWhisperClient_Transcribe_NoAudio(t *testing.T) {
+	client, err := NewWhisperClient(config.STTConfig{Mode: ModeWhisper, URL: "http://127.0.0.1:1"})
+	require.NoError(t, err)
+
+	_, err = client.Transcribe(context.Background(), nil, "answer.wav")
+	assert.ErrorContains(t, err, "no audio to transcribe")
+}
+
+func TestWhisperClient_TranscribeSegments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "verbose_json", r.FormValue("response_format"))
+		_, _ = io.WriteString(w, `{"text": "Hi. How are you?", "segments": [
+			{"start": 0.0, "end": 1.2, "text": " Hi.", "speaker_turn_next": true},
+			{"start": 1.2, "end": 1.5, "text": " "},
+			{"start": 1.5, "end": 3.0, "text": " How are you?", "speaker": "SPEAKER_01"}
+		]}`)
+	}))
+	defer server.Close()
+
+	client, err := NewWhisperClient(config.STTConfig{Mode: ModeWhisper, URL: server.URL})
+	require.NoError(t, err)
+	got, err := client.TranscribeSegments(context.Background(), []byte("RIFF"), "video.wav")
+	require.NoError(t, err)
+	assert.Equal(t, []Segment{
+		{Start: 0, End: 1.2, Text: "Hi.", SpeakerTurnNext: true},
+		{Start: 1.5, End: 3.0, Text: "How are you?", Speaker: "SPEAKER_01"},
+	}, got)
+}
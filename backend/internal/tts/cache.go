@@ -0,0 +1,54 @@
+package tts
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// AudioDirectory is the directory, relative to a notebook's index.yml,
+// synthesized audio is cached in.
+const AudioDirectory = "audio"
+
+// Cache keeps synthesized audio next to notebooks so each expression is
+// synthesized only once.
+type Cache struct {
+	synthesizer Synthesizer
+}
+
+// NewCache returns a cache synthesizing missing audio with synthesizer.
+func NewCache(synthesizer Synthesizer) *Cache {
+	return &Cache{synthesizer: synthesizer}
+}
+
+// Path returns the path, relative to the notebook directory, of the audio
+// cached under key.
+func (c *Cache) Path(key string) string {
+	return filepath.ToSlash(filepath.Join(AudioDirectory, key+c.synthesizer.Extension()))
+}
+
+// Audio returns the path, relative to notebookDir, of the audio of text
+// cached under key, synthesizing it when the file doesn't exist yet.
+// synthesized reports whether the synthesizer ran.
+func (c *Cache) Audio(ctx context.Context, notebookDir, key, text string) (path string, synthesized bool, err error) {
+	path = c.Path(key)
+	fullPath := filepath.Join(notebookDir, filepath.FromSlash(path))
+	if _, err := os.Stat(fullPath); err == nil {
+		return path, false, nil
+	} else if !os.IsNotExist(err) {
+		return "", false, fmt.Errorf("stat %s: %w", fullPath, err)
+	}
+
+	audio, err := c.synthesizer.Synthesize(ctx, text)
+	if err != nil {
+		return "", false, fmt.Errorf("synthesize %q: %w", text, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return "", false, fmt.Errorf("create audio directory: %w", err)
+	}
+	if err := os.WriteFile(fullPath, audio, 0644); err != nil {
+		return "", false, fmt.Errorf("write %s: %w", fullPath, err)
+	}
+	return path, true, nil
+}
@@ -0,0 +1,60 @@
+package tts
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSynthesizer struct {
+	texts []string
+	err   error
+}
+
+func (s *fakeSynthesizer) Synthesize(_ context.Context, text string) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.texts = append(s.texts, text)
+	return []byte("RIFF " + text), nil
+}
+
+func (s *fakeSynthesizer) Extension() string {
+	return ".wav"
+}
+
+func TestCache_Audio(t *testing.T) {
+	notebookDir := t.TempDir()
+	synthesizer := &fakeSynthesizer{}
+	cache := NewCache(synthesizer)
+
+	path, synthesized, err := cache.Audio(context.Background(), notebookDir, "ephemeral", "ephemeral")
+	require.NoError(t, err)
+	assert.Equal(t, "audio/ephemeral.wav", path)
+	assert.True(t, synthesized)
+	audio, err := os.ReadFile(filepath.Join(notebookDir, "audio", "ephemeral.wav"))
+	require.NoError(t, err)
+	assert.Equal(t, "RIFF ephemeral", string(audio))
+
+	path, synthesized, err = cache.Audio(context.Background(), notebookDir, "ephemeral", "ephemeral")
+	require.NoError(t, err)
+	assert.Equal(t, "audio/ephemeral.wav", path)
+	assert.False(t, synthesized, "cached audio is reused")
+	assert.Equal(t, []string{"ephemeral"}, synthesizer.texts)
+}
+
+func TestCache_Audio_Error(t *testing.T) {
+	notebookDir := t.TempDir()
+	cache := NewCache(&fakeSynthesizer{err: fmt.Errorf("espeak-ng not found")})
+
+	_, _, err := cache.Audio(context.Background(), notebookDir, "ephemeral", "ephemeral")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "espeak-ng not found")
+	_, err = os.Stat(filepath.Join(notebookDir, "audio"))
+	assert.True(t, os.IsNotExist(err), "nothing is written on failure")
+}
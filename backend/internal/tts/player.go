@@ -0,0 +1,53 @@
+package tts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/at-ishikawa/langner/internal/config"
+)
+
+// defaultPlayers are tried in order when no player is configured. ffplay
+// plays every format a note's audio can be in; the others cover the stock
+// players of macOS and Linux.
+var defaultPlayers = [][]string{
+	{"afplay"},
+	{"ffplay", "-nodisp", "-autoexit", "-loglevel", "quiet"},
+	{"aplay", "-q"},
+	{"paplay"},
+}
+
+// Player plays audio files with a local program.
+type Player struct {
+	command string
+	args    []string
+}
+
+// NewPlayer returns the player configured by cfg, or the first default
+// player found on PATH.
+func NewPlayer(cfg config.TTSConfig) (*Player, error) {
+	if len(cfg.Player) > 0 {
+		return &Player{command: cfg.Player[0], args: cfg.Player[1:]}, nil
+	}
+	for _, candidate := range defaultPlayers {
+		if _, err := exec.LookPath(candidate[0]); err == nil {
+			return &Player{command: candidate[0], args: candidate[1:]}, nil
+		}
+	}
+	return nil, fmt.Errorf("no audio player found; set tts.player")
+}
+
+// Play plays the audio file at path and returns once it has finished.
+func (p *Player) Play(ctx context.Context, path string) error {
+	args := append(append([]string{}, p.args...), path)
+	cmd := exec.CommandContext(ctx, p.command, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("run %s: %w: %s", p.command, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package tts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
+)
+
+func TestPlayer_Play(t *testing.T) {
+	dir := t.TempDir()
+	played := filepath.Join(dir, "played")
+	script := filepath.Join(dir, "fake-player")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\nprintf '%s ' \"$@\" > \""+played+"\"\n"), 0755))
+	failing := filepath.Join(dir, "failing-player")
+	require.NoError(t, os.WriteFile(failing, []byte("#!/bin/sh\necho 'no audio device' >&2\nexit 1\n"), 0755))
+
+	tests := []struct {
+		name    string
+		player  []string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "the file is appended to the configured arguments",
+			player: []string{script, "-q"},
+			want:   "-q audio/line.wav ",
+		},
+		{
+			name:    "a failing player reports its output",
+			player:  []string{failing},
+			wantErr: "no audio device",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player, err := NewPlayer(config.TTSConfig{Player: tt.player})
+			require.NoError(t, err)
+
+			err = player.Play(context.Background(), "audio/line.wav")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			got, err := os.ReadFile(played)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestNewPlayer_NoPlayerFound(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := NewPlayer(config.TTSConfig{})
+	assert.ErrorContains(t, err, "set tts.player")
+}
@@ -0,0 +1,154 @@
+// Package tts synthesizes pronunciation audio with a local text-to-speech
+// program such as espeak-ng or piper.
+package tts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/at-ishikawa/langner/internal/config"
+)
+
+// Synthesizer turns text into audio.
+type Synthesizer interface {
+	// Synthesize returns the audio of text being spoken.
+	Synthesize(ctx context.Context, text string) ([]byte, error)
+	// Extension is the file extension of the audio Synthesize returns,
+	// including the leading dot.
+	Extension() string
+}
+
+// Placeholders replaced in the arguments of a CommandSynthesizer.
+const (
+	PlaceholderText   = "{text}"
+	PlaceholderVoice  = "{voice}"
+	PlaceholderOutput = "{output}"
+)
+
+const (
+	BackendEspeakNG = "espeak-ng"
+	BackendPiper    = "piper"
+	BackendCommand  = "command"
+)
+
+// presets are the command lines of the supported backends. espeak-ng takes
+// the text as an argument; piper reads it from stdin.
+var presets = map[string]struct {
+	command string
+	args    []string
+}{
+	BackendEspeakNG: {command: "espeak-ng", args: []string{"-v", PlaceholderVoice, "-w", PlaceholderOutput, "--", PlaceholderText}},
+	BackendPiper:    {command: "piper", args: []string{"--model", PlaceholderVoice, "--output_file", PlaceholderOutput}},
+}
+
+// defaultVoices are used when no voice is configured. piper has no default
+// model, so it requires one.
+var defaultVoices = map[string]string{
+	BackendEspeakNG: "en-us",
+}
+
+// CommandSynthesizer runs a local program that writes a WAV file.
+type CommandSynthesizer struct {
+	command string
+	args    []string
+	voice   string
+}
+
+// NewSynthesizer returns the synthesizer configured by cfg.
+func NewSynthesizer(cfg config.TTSConfig) (*CommandSynthesizer, error) {
+	backend := cfg.Backend
+	if backend == "" {
+		backend = BackendEspeakNG
+	}
+
+	synthesizer := &CommandSynthesizer{
+		command: cfg.Command,
+		args:    cfg.Args,
+		voice:   cfg.Voice,
+	}
+	if preset, ok := presets[backend]; ok {
+		if synthesizer.command == "" {
+			synthesizer.command = preset.command
+		}
+		if synthesizer.args == nil {
+			synthesizer.args = preset.args
+		}
+	} else if backend != BackendCommand {
+		return nil, fmt.Errorf("unknown tts backend %q", backend)
+	}
+	if synthesizer.voice == "" {
+		synthesizer.voice = defaultVoices[backend]
+	}
+
+	if synthesizer.command == "" {
+		return nil, fmt.Errorf("tts.command is required for the %s backend", backend)
+	}
+	if !containsPlaceholder(synthesizer.args, PlaceholderOutput) {
+		return nil, fmt.Errorf("tts.args must contain %s", PlaceholderOutput)
+	}
+	if synthesizer.voice == "" && containsPlaceholder(synthesizer.args, PlaceholderVoice) {
+		return nil, fmt.Errorf("tts.voice is required for the %s backend", backend)
+	}
+	return synthesizer, nil
+}
+
+// Extension implements Synthesizer.
+func (s *CommandSynthesizer) Extension() string {
+	return ".wav"
+}
+
+// Synthesize implements Synthesizer. It runs the command with the
+// placeholders of its arguments replaced, and reads back the file the
+// command wrote.
+func (s *CommandSynthesizer) Synthesize(ctx context.Context, text string) ([]byte, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("no text to synthesize")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "langner-tts-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temp directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+	output := filepath.Join(tmpDir, "audio"+s.Extension())
+
+	replacer := strings.NewReplacer(PlaceholderText, text, PlaceholderVoice, s.voice, PlaceholderOutput, output)
+	args := make([]string, len(s.args))
+	for i, arg := range s.args {
+		args[i] = replacer.Replace(arg)
+	}
+
+	cmd := exec.CommandContext(ctx, s.command, args...)
+	if !containsPlaceholder(s.args, PlaceholderText) {
+		cmd.Stdin = strings.NewReader(text + "\n")
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("run %s: %w: %s", s.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	audio, err := os.ReadFile(output)
+	if err != nil {
+		return nil, fmt.Errorf("read the audio %s wrote: %w", s.command, err)
+	}
+	if len(audio) == 0 {
+		return nil, fmt.Errorf("%s wrote no audio for %q", s.command, text)
+	}
+	return audio, nil
+}
+
+func containsPlaceholder(args []string, placeholder string) bool {
+	for _, arg := range args {
+		if strings.Contains(arg, placeholder) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package tts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
+)
+
+// writeFakeTTS writes a shell script standing in for a TTS program: it
+// writes "<voice>:<text>" to the file after -o or -w, taking the text from
+// the argument after -t or --, or else from stdin. Like espeak-ng it rejects
+// any other argument starting with a dash.
+func writeFakeTTS(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fake-tts")
+	script := `#!/bin/sh
+voice=""; out=""; text=""
+while [ $# -gt 0 ]; do
+  case "$1" in
+    -v) voice="$2"; shift 2 ;;
+    -o|-w) out="$2"; shift 2 ;;
+    -t|--) text="$2"; shift 2 ;;
+    *) echo "unknown argument $1" >&2; exit 2 ;;
+  esac
+done
+if [ -z "$text" ]; then read -r text; fi
+printf '%s:%s' "$voice" "$text" > "$out"
+`
+	require.NoError(t, os.WriteFile(path, []byte(script), 0755))
+	return path
+}
+
+func TestNewSynthesizer(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.TTSConfig
+		want    *CommandSynthesizer
+		wantErr string
+	}{
+		{
+			name: "espeak-ng by default",
+			cfg:  config.TTSConfig{},
+			want: &CommandSynthesizer{command: "espeak-ng", args: presets[BackendEspeakNG].args, voice: "en-us"},
+		},
+		{
+			name: "piper with a model",
+			cfg:  config.TTSConfig{Backend: BackendPiper, Voice: "en_US-lessac-medium.onnx"},
+			want: &CommandSynthesizer{command: "piper", args: presets[BackendPiper].args, voice: "en_US-lessac-medium.onnx"},
+		},
+		{
+			name:    "piper without a model",
+			cfg:     config.TTSConfig{Backend: BackendPiper},
+			wantErr: "tts.voice is required for the piper backend",
+		},
+		{
+			name: "preset with another binary",
+			cfg:  config.TTSConfig{Backend: BackendEspeakNG, Command: "/opt/espeak/bin/espeak-ng"},
+			want: &CommandSynthesizer{command: "/opt/espeak/bin/espeak-ng", args: presets[BackendEspeakNG].args, voice: "en-us"},
+		},
+		{
+			name: "command",
+			cfg:  config.TTSConfig{Backend: BackendCommand, Command: "say", Args: []string{"-o", "{output}", "{text}"}},
+			want: &CommandSynthesizer{command: "say", args: []string{"-o", "{output}", "{text}"}},
+		},
+		{
+			name:    "command without a program",
+			cfg:     config.TTSConfig{Backend: BackendCommand, Args: []string{"{output}"}},
+			wantErr: "tts.command is required for the command backend",
+		},
+		{
+			name:    "command without an output",
+			cfg:     config.TTSConfig{Backend: BackendCommand, Command: "say", Args: []string{"{text}"}},
+			wantErr: "tts.args must contain {output}",
+		},
+		{
+			name:    "unknown backend",
+			cfg:     config.TTSConfig{Backend: "festival"},
+			wantErr: `unknown tts backend "festival"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSynthesizer(tt.cfg)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSynthesizer_Synthesize(t *testing.T) {
+	fakeTTS := writeFakeTTS(t)
+
+	tests := []struct {
+		name    string
+		args    []string
+		text    string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "text as an argument",
+			args: []string{"-v", "{voice}", "-o", "{output}", "-t", "{text}"},
+			text: "ephemeral",
+			want: "en-gb:ephemeral",
+		},
+		{
+			name: "text on stdin",
+			args: []string{"-v", "{voice}", "-o", "{output}"},
+			text: " break the ice ",
+			want: "en-gb:break the ice",
+		},
+		{
+			name: "espeak-ng arguments with a leading-dash expression",
+			args: presets[BackendEspeakNG].args,
+			text: "-ish",
+			want: "en-gb:-ish",
+		},
+		{
+			name:    "empty text",
+			args:    []string{"-o", "{output}"},
+			text:    " ",
+			wantErr: "no text to synthesize",
+		},
+		{
+			name:    "command fails",
+			args:    []string{"-x", "-o", "{output}"},
+			text:    "ephemeral",
+			wantErr: "unknown argument -x",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			synthesizer, err := NewSynthesizer(config.TTSConfig{Backend: BackendCommand, Command: fakeTTS, Args: tt.args, Voice: "en-gb"})
+			require.NoError(t, err)
+
+			got, err := synthesizer.Synthesize(context.Background(), tt.text)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package tui
+
+import "unicode/utf8"
+
+// KeyType identifies a non-printable key. Printable input is KeyRunes.
+type KeyType int
+
+const (
+	KeyRunes KeyType = iota
+	KeyEnter
+	KeyTab
+	KeyShiftTab
+	KeyBackspace
+	KeyEscape
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyHome
+	KeyEnd
+	KeyCtrlC
+	KeyCtrlU
+	KeyCtrlW
+)
+
+var keyNames = map[KeyType]string{
+	KeyEnter:     "enter",
+	KeyTab:       "tab",
+	KeyShiftTab:  "shift+tab",
+	KeyBackspace: "backspace",
+	KeyEscape:    "esc",
+	KeyUp:        "up",
+	KeyDown:      "down",
+	KeyLeft:      "left",
+	KeyRight:     "right",
+	KeyHome:      "home",
+	KeyEnd:       "end",
+	KeyCtrlC:     "ctrl+c",
+	KeyCtrlU:     "ctrl+u",
+	KeyCtrlW:     "ctrl+w",
+}
+
+// KeyMsg is one key press.
+type KeyMsg struct {
+	Type  KeyType
+	Runes []rune
+}
+
+// String returns the typed text for printable keys and a name such as
+// "enter" or "ctrl+c" otherwise, so models can switch on it.
+func (k KeyMsg) String() string {
+	if k.Type == KeyRunes {
+		return string(k.Runes)
+	}
+	return keyNames[k.Type]
+}
+
+// Key builds a KeyMsg from the name String returns, for tests and
+// scripted input.
+func Key(name string) KeyMsg {
+	for t, n := range keyNames {
+		if n == name {
+			return KeyMsg{Type: t}
+		}
+	}
+	return KeyMsg{Type: KeyRunes, Runes: []rune(name)}
+}
+
+var escapeSequences = map[string]KeyType{
+	"[A": KeyUp, "OA": KeyUp,
+	"[B": KeyDown, "OB": KeyDown,
+	"[C": KeyRight, "OC": KeyRight,
+	"[D": KeyLeft, "OD": KeyLeft,
+	"[H": KeyHome, "OH": KeyHome, "[1~": KeyHome,
+	"[F": KeyEnd, "OF": KeyEnd, "[4~": KeyEnd,
+	"[Z": KeyShiftTab,
+}
+
+// ParseKeys splits raw terminal input into key presses. Consecutive
+// printable characters, as delivered by a paste, are kept together in one
+// KeyMsg so a text input inserts them at once.
+func ParseKeys(input []byte) []KeyMsg {
+	var keys []KeyMsg
+	var runes []rune
+	flush := func() {
+		if len(runes) > 0 {
+			keys = append(keys, KeyMsg{Type: KeyRunes, Runes: runes})
+			runes = nil
+		}
+	}
+	for len(input) > 0 {
+		b := input[0]
+		switch {
+		case b == 0x1b:
+			flush()
+			key, n, ok := parseEscape(input)
+			if ok {
+				keys = append(keys, key)
+			}
+			input = input[n:]
+			continue
+		case b == '\r' || b == '\n':
+			flush()
+			keys = append(keys, KeyMsg{Type: KeyEnter})
+		case b == '\t':
+			flush()
+			keys = append(keys, KeyMsg{Type: KeyTab})
+		case b == 0x7f || b == 0x08:
+			flush()
+			keys = append(keys, KeyMsg{Type: KeyBackspace})
+		case b == 0x03:
+			flush()
+			keys = append(keys, KeyMsg{Type: KeyCtrlC})
+		case b == 0x15:
+			flush()
+			keys = append(keys, KeyMsg{Type: KeyCtrlU})
+		case b == 0x17:
+			flush()
+			keys = append(keys, KeyMsg{Type: KeyCtrlW})
+		case b < 0x20:
+			// Other control characters are ignored.
+		default:
+			r, size := utf8.DecodeRune(input)
+			runes = append(runes, r)
+			input = input[size:]
+			continue
+		}
+		input = input[1:]
+	}
+	flush()
+	return keys
+}
+
+// parseEscape decodes the escape sequence at the start of input and
+// returns the key and the number of bytes consumed. A lone ESC is the
+// escape key; an unknown CSI sequence (e.g. delete, F-keys) is consumed
+// and reported as no key.
+func parseEscape(input []byte) (KeyMsg, int, bool) {
+	for seq, t := range escapeSequences {
+		if len(input) > len(seq) && string(input[1:1+len(seq)]) == seq {
+			return KeyMsg{Type: t}, 1 + len(seq), true
+		}
+	}
+	if len(input) > 1 && input[1] == '[' {
+		for i := 2; i < len(input); i++ {
+			if input[i] >= 0x40 && input[i] <= 0x7e {
+				return KeyMsg{}, i + 1, false
+			}
+		}
+		return KeyMsg{}, len(input), false
+	}
+	return KeyMsg{Type: KeyEscape}, 1, true
+}
@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "typed text stays together", input: "héllo", want: []string{"héllo"}},
+		{name: "enter splits text", input: "ab\rc", want: []string{"ab", "enter", "c"}},
+		{name: "arrows", input: "\x1b[A\x1b[B\x1bOC\x1b[D", want: []string{"up", "down", "right", "left"}},
+		{name: "shift tab and tab", input: "\x1b[Z\t", want: []string{"shift+tab", "tab"}},
+		{name: "lone escape", input: "\x1b", want: []string{"esc"}},
+		{name: "editing keys", input: "\x7f\x15\x17\x03", want: []string{"backspace", "ctrl+u", "ctrl+w", "ctrl+c"}},
+		{name: "unknown CSI is dropped", input: "a\x1b[3~b", want: []string{"a", "b"}},
+		{name: "home and end", input: "\x1b[H\x1b[4~", want: []string{"home", "end"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, key := range ParseKeys([]byte(tt.input)) {
+				got = append(got, key.String())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, KeyMsg{Type: KeyEnter}, Key("enter"))
+	assert.Equal(t, KeyMsg{Type: KeyRunes, Runes: []rune("x")}, Key("x"))
+}
+
+func TestTextInput_Update(t *testing.T) {
+	input := TextInput{}
+	for _, key := range []KeyMsg{Key("helo"), Key("left"), Key("l"), Key("end"), Key(" world")} {
+		input = input.Update(key)
+	}
+	assert.Equal(t, "hello world", input.Value())
+
+	input = input.Update(Key("ctrl+w"))
+	assert.Equal(t, "hello ", input.Value())
+	input = input.Update(Key("backspace"))
+	assert.Equal(t, "hello", input.Value())
+	input = input.Update(Key("home")).Update(Key("right")).Update(Key("ctrl+u"))
+	assert.Equal(t, "ello", input.Value())
+	assert.Equal(t, "", input.Reset().Value())
+}
@@ -0,0 +1,21 @@
+package tui
+
+// ANSI SGR helpers for View output.
+
+// Bold renders s in bold.
+func Bold(s string) string { return "\x1b[1m" + s + "\x1b[0m" }
+
+// Faint renders s dimmed, for hints and secondary text.
+func Faint(s string) string { return "\x1b[2m" + s + "\x1b[0m" }
+
+// Reverse renders s in reverse video, for the cursor and the selected row.
+func Reverse(s string) string { return "\x1b[7m" + s + "\x1b[0m" }
+
+// Green renders s in green, for correct answers.
+func Green(s string) string { return "\x1b[32m" + s + "\x1b[0m" }
+
+// Red renders s in red, for wrong answers and errors.
+func Red(s string) string { return "\x1b[31m" + s + "\x1b[0m" }
+
+// Yellow renders s in yellow, for overridden or skipped results.
+func Yellow(s string) string { return "\x1b[33m" + s + "\x1b[0m" }
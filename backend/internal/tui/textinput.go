@@ -0,0 +1,72 @@
+package tui
+
+// TextInput is a single-line editable field. It is a value type: Update
+// returns the edited copy.
+type TextInput struct {
+	value  []rune
+	cursor int
+}
+
+// Value returns the current text.
+func (t TextInput) Value() string {
+	return string(t.value)
+}
+
+// Reset returns an empty input.
+func (t TextInput) Reset() TextInput {
+	return TextInput{}
+}
+
+// Update applies an editing key. Keys that don't edit text (enter, arrows
+// up/down, tab, esc) are left to the caller and return the input as is.
+func (t TextInput) Update(key KeyMsg) TextInput {
+	switch key.Type {
+	case KeyRunes:
+		value := make([]rune, 0, len(t.value)+len(key.Runes))
+		value = append(value, t.value[:t.cursor]...)
+		value = append(value, key.Runes...)
+		value = append(value, t.value[t.cursor:]...)
+		t.value = value
+		t.cursor += len(key.Runes)
+	case KeyBackspace:
+		if t.cursor > 0 {
+			t.value = append(append([]rune{}, t.value[:t.cursor-1]...), t.value[t.cursor:]...)
+			t.cursor--
+		}
+	case KeyLeft:
+		if t.cursor > 0 {
+			t.cursor--
+		}
+	case KeyRight:
+		if t.cursor < len(t.value) {
+			t.cursor++
+		}
+	case KeyHome:
+		t.cursor = 0
+	case KeyEnd:
+		t.cursor = len(t.value)
+	case KeyCtrlU:
+		t.value = append([]rune{}, t.value[t.cursor:]...)
+		t.cursor = 0
+	case KeyCtrlW:
+		start := t.cursor
+		for start > 0 && t.value[start-1] == ' ' {
+			start--
+		}
+		for start > 0 && t.value[start-1] != ' ' {
+			start--
+		}
+		t.value = append(append([]rune{}, t.value[:start]...), t.value[t.cursor:]...)
+		t.cursor = start
+	}
+	return t
+}
+
+// View renders the text with the cursor drawn as a reverse-video cell.
+func (t TextInput) View() string {
+	before := string(t.value[:t.cursor])
+	if t.cursor == len(t.value) {
+		return before + Reverse(" ")
+	}
+	return before + Reverse(string(t.value[t.cursor])) + string(t.value[t.cursor+1:])
+}
@@ -0,0 +1,189 @@
+// Package tui is a small full-screen terminal UI runtime in the style of
+// the Elm architecture: a Model turns messages (key presses, results of
+// background commands) into a new Model, and View renders it as a string
+// that the Program repaints on the alternate screen.
+package tui
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// Msg is anything delivered to Model.Update: a KeyMsg, a WindowSizeMsg, or
+// the value returned by a Cmd.
+type Msg any
+
+// Cmd runs outside the update loop, typically blocking I/O, and returns the
+// Msg to deliver when it finishes. A nil Cmd does nothing.
+type Cmd func() Msg
+
+// Model is the state of a program.
+type Model interface {
+	// Init returns the command to run when the program starts.
+	Init() Cmd
+	// Update applies msg and returns the next state and a command to run.
+	Update(msg Msg) (Model, Cmd)
+	// View renders the current state. Lines are separated by "\n".
+	View() string
+}
+
+// WindowSizeMsg reports the terminal size at start-up.
+type WindowSizeMsg struct {
+	Width  int
+	Height int
+}
+
+type quitMsg struct{}
+
+type batchMsg []Cmd
+
+// Quit is a Cmd that stops the program after the current update.
+func Quit() Msg {
+	return quitMsg{}
+}
+
+// Batch runs several commands concurrently.
+func Batch(cmds ...Cmd) Cmd {
+	var valid []Cmd
+	for _, cmd := range cmds {
+		if cmd != nil {
+			valid = append(valid, cmd)
+		}
+	}
+	switch len(valid) {
+	case 0:
+		return nil
+	case 1:
+		return valid[0]
+	default:
+		return func() Msg { return batchMsg(valid) }
+	}
+}
+
+// Program drives a Model against a terminal.
+type Program struct {
+	model  Model
+	input  io.Reader
+	output io.Writer
+}
+
+// NewProgram returns a Program that reads keys from stdin and draws to stdout.
+func NewProgram(model Model) *Program {
+	return &Program{model: model, input: os.Stdin, output: os.Stdout}
+}
+
+// WithIO returns a copy of the program using the given input and output.
+// The terminal is only switched to raw mode when input is a terminal.
+func (p *Program) WithIO(input io.Reader, output io.Writer) *Program {
+	copied := *p
+	copied.input = input
+	copied.output = output
+	return &copied
+}
+
+// Run starts the program and blocks until a Model returns Quit or ctx is
+// cancelled, returning the final model.
+func (p *Program) Run(ctx context.Context) (Model, error) {
+	if f, ok := p.input.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return p.model, fmt.Errorf("term.MakeRaw() > %w", err)
+		}
+		defer func() {
+			_ = term.Restore(int(f.Fd()), state)
+		}()
+	}
+	out := bufio.NewWriter(p.output)
+	// Alternate screen, hidden cursor; restored on exit.
+	_, _ = out.WriteString("\x1b[?1049h\x1b[?25l")
+	defer func() {
+		_, _ = out.WriteString("\x1b[?25h\x1b[?1049l")
+		_ = out.Flush()
+	}()
+
+	// Commands still running when the program quits (a slow grading call,
+	// say) are abandoned: their results are dropped once ctx is cancelled.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	msgs := make(chan Msg)
+
+	send := func(msg Msg) {
+		select {
+		case msgs <- msg:
+		case <-ctx.Done():
+		}
+	}
+	var run func(cmd Cmd)
+	run = func(cmd Cmd) {
+		if cmd == nil {
+			return
+		}
+		go func() {
+			msg := cmd()
+			if batch, ok := msg.(batchMsg); ok {
+				for _, c := range batch {
+					run(c)
+				}
+				return
+			}
+			if msg != nil {
+				send(msg)
+			}
+		}()
+	}
+
+	go readKeys(ctx, p.input, send)
+
+	model := p.model
+	run(model.Init())
+	if f, ok := p.output.(*os.File); ok {
+		if width, height, err := term.GetSize(int(f.Fd())); err == nil {
+			run(func() Msg { return WindowSizeMsg{Width: width, Height: height} })
+		}
+	}
+	render(out, model.View())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return model, ctx.Err()
+		case msg := <-msgs:
+			if _, ok := msg.(quitMsg); ok {
+				return model, nil
+			}
+			var cmd Cmd
+			model, cmd = model.Update(msg)
+			run(cmd)
+			render(out, model.View())
+		}
+	}
+}
+
+// render repaints the whole screen. Raw mode disables the newline to
+// carriage-return translation, so each line break is written as "\r\n".
+func render(out *bufio.Writer, view string) {
+	_, _ = out.WriteString("\x1b[H\x1b[2J")
+	_, _ = out.WriteString(strings.ReplaceAll(view, "\n", "\r\n"))
+	_ = out.Flush()
+}
+
+func readKeys(ctx context.Context, input io.Reader, send func(Msg)) {
+	buf := make([]byte, 256)
+	for {
+		n, err := input.Read(buf)
+		if n > 0 {
+			for _, key := range ParseKeys(buf[:n]) {
+				send(key)
+			}
+		}
+		if err != nil || ctx.Err() != nil {
+			return
+		}
+	}
+}
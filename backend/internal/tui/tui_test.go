@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type echoModel struct {
+	typed []string
+}
+
+type echoedMsg string
+
+func (m echoModel) Init() Cmd { return nil }
+
+func (m echoModel) Update(msg Msg) (Model, Cmd) {
+	switch msg := msg.(type) {
+	case KeyMsg:
+		text := msg.String()
+		return m, func() Msg { return echoedMsg(text) }
+	case echoedMsg:
+		m.typed = append(m.typed, string(msg))
+		if len(m.typed) == 2 {
+			return m, Quit
+		}
+	}
+	return m, nil
+}
+
+func (m echoModel) View() string {
+	return "typed:\n" + strings.Join(m.typed, ",")
+}
+
+func TestProgram_Run(t *testing.T) {
+	var out bytes.Buffer
+	input := &chunkReader{chunks: []string{"ab", "\r"}}
+	final, err := NewProgram(echoModel{}).WithIO(input, &out).Run(context.Background())
+	require.NoError(t, err)
+
+	// Commands run concurrently, so their results may arrive in any order.
+	typed := final.(echoModel).typed
+	assert.ElementsMatch(t, []string{"ab", "enter"}, typed)
+	assert.True(t, strings.HasPrefix(out.String(), "\x1b[?1049h"))
+	assert.True(t, strings.HasSuffix(out.String(), "\x1b[?25h\x1b[?1049l"))
+	assert.Contains(t, out.String(), "typed:\r\n"+strings.Join(typed, ","))
+}
+
+// chunkReader returns one chunk per Read and then blocks like an idle
+// terminal.
+type chunkReader struct {
+	chunks []string
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(r.chunks) == 0 {
+		select {}
+	}
+	chunk := r.chunks[0]
+	r.chunks = r.chunks[1:]
+	return copy(p, chunk), nil
+}
@@ -0,0 +1,336 @@
+// Package versioning records writes to the learning data directory as git
+// commits, so overrides, imports and destructive migrations can be
+// inspected and undone with `langner history`.
+package versioning
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/at-ishikawa/langner/internal/config"
+)
+
+// ModeGit is the versioning.mode value that turns on git-backed versioning.
+const ModeGit = "git"
+
+// Recorder commits data-directory writes.
+type Recorder interface {
+	// Record commits the given paths with message. With no paths every
+	// change in the data directory is committed, which is what
+	// migrations touching many files want. A write that left the files
+	// unchanged produces no commit.
+	Record(message string, paths ...string) error
+}
+
+type nopRecorder struct{}
+
+func (nopRecorder) Record(string, ...string) error { return nil }
+
+// NopRecorder returns a Recorder that does nothing; the default when
+// versioning is not configured.
+func NopRecorder() Recorder {
+	return nopRecorder{}
+}
+
+// NewRecorder returns the Recorder selected by cfg: a GitRecorder on
+// cfg.Directory when cfg.Mode is "git", otherwise a NopRecorder.
+func NewRecorder(cfg config.VersioningConfig) (Recorder, error) {
+	switch cfg.Mode {
+	case "":
+		return NopRecorder(), nil
+	case ModeGit:
+		return OpenGitRecorder(cfg.Directory)
+	default:
+		return nil, fmt.Errorf("unknown versioning mode %q", cfg.Mode)
+	}
+}
+
+// Change is one recorded commit as shown by `langner history log`.
+type Change struct {
+	Hash    string
+	Message string
+	When    time.Time
+	Files   []string
+}
+
+// Subject returns the first line of the commit message.
+func (c Change) Subject() string {
+	subject, _, _ := strings.Cut(c.Message, "\n")
+	return subject
+}
+
+// GitRecorder commits into a git repository rooted at the data directory.
+// It uses go-git, so no git binary is needed. Safe for concurrent use; the
+// server records every quiz answer from its request goroutines.
+type GitRecorder struct {
+	root string
+	repo *git.Repository
+
+	mu sync.Mutex
+}
+
+// OpenGitRecorder opens the git repository at dir, initialising one when
+// dir is not a repository yet.
+func OpenGitRecorder(dir string) (*GitRecorder, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("versioning directory is not configured")
+	}
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("filepath.Abs(%s) > %w", dir, err)
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("os.MkdirAll(%s) > %w", root, err)
+	}
+
+	repo, err := git.PlainOpen(root)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repo, err = git.PlainInit(root, false)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open git repository %s: %w", root, err)
+	}
+	return &GitRecorder{root: root, repo: repo}, nil
+}
+
+// Record stages paths (or everything, when none are given) and commits.
+// Paths outside the repository are skipped with a warning rather than
+// failing the write that has already happened.
+func (g *GitRecorder) Record(message string, paths ...string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	wt, err := g.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("repo.Worktree() > %w", err)
+	}
+
+	if len(paths) == 0 {
+		if err := wt.AddWithOptions(&git.AddOptions{All: true}); err != nil {
+			return fmt.Errorf("stage all changes: %w", err)
+		}
+	}
+	for _, path := range paths {
+		rel, ok := g.relativePath(path)
+		if !ok {
+			slog.Warn("versioning: path is outside the data directory, not recorded", "path", path, "root", g.root)
+			continue
+		}
+		if _, err := wt.Add(rel); err != nil {
+			return fmt.Errorf("stage %s: %w", rel, err)
+		}
+	}
+
+	_, err = wt.Commit(message, &git.CommitOptions{Author: g.signature()})
+	if errors.Is(err, git.ErrEmptyCommit) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("commit %q: %w", message, err)
+	}
+	return nil
+}
+
+// Log returns the latest limit commits, newest first. limit <= 0 returns
+// the whole history.
+func (g *GitRecorder) Log(limit int) ([]Change, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	head, err := g.repo.Head()
+	if err != nil {
+		// A freshly initialised repository has no HEAD yet.
+		return nil, nil
+	}
+	iter, err := g.repo.Log(&git.LogOptions{From: head.Hash()})
+	if err != nil {
+		return nil, fmt.Errorf("repo.Log() > %w", err)
+	}
+	defer iter.Close()
+
+	var changes []Change
+	for limit <= 0 || len(changes) < limit {
+		commit, err := iter.Next()
+		if err != nil {
+			break
+		}
+		change, err := toChange(commit)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// Undo reverts the last count commits by restoring every file they touched
+// to its content before them and committing the result. History is never
+// rewritten, so an undo can itself be undone. It refuses to run when one of
+// those files has uncommitted edits, which the restore would overwrite.
+func (g *GitRecorder) Undo(count int) (Change, error) {
+	if count <= 0 {
+		return Change{}, fmt.Errorf("the number of changes to undo must be positive, got %d", count)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	head, err := g.repo.Head()
+	if err != nil {
+		return Change{}, fmt.Errorf("there is no recorded change to undo")
+	}
+	headCommit, err := g.repo.CommitObject(head.Hash())
+	if err != nil {
+		return Change{}, fmt.Errorf("repo.CommitObject(%s) > %w", head.Hash(), err)
+	}
+
+	target := headCommit
+	subjects := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		subject, _, _ := strings.Cut(target.Message, "\n")
+		subjects = append(subjects, subject)
+		if target.NumParents() == 0 {
+			if i != count-1 {
+				return Change{}, fmt.Errorf("only %d change(s) are recorded", i+1)
+			}
+			target = nil
+			break
+		}
+		if target, err = target.Parent(0); err != nil {
+			return Change{}, fmt.Errorf("commit.Parent() > %w", err)
+		}
+	}
+
+	headTree, err := headCommit.Tree()
+	if err != nil {
+		return Change{}, fmt.Errorf("commit.Tree() > %w", err)
+	}
+	targetTree := &object.Tree{}
+	if target != nil {
+		if targetTree, err = target.Tree(); err != nil {
+			return Change{}, fmt.Errorf("commit.Tree() > %w", err)
+		}
+	}
+	diffs, err := object.DiffTree(headTree, targetTree)
+	if err != nil {
+		return Change{}, fmt.Errorf("object.DiffTree() > %w", err)
+	}
+
+	wt, err := g.repo.Worktree()
+	if err != nil {
+		return Change{}, fmt.Errorf("repo.Worktree() > %w", err)
+	}
+	status, err := wt.Status()
+	if err != nil {
+		return Change{}, fmt.Errorf("worktree.Status() > %w", err)
+	}
+	for _, diff := range diffs {
+		name := changeName(diff)
+		if fileStatus, ok := status[name]; ok && (fileStatus.Worktree != git.Unmodified || fileStatus.Staging != git.Unmodified) {
+			return Change{}, fmt.Errorf("%s has uncommitted changes; commit or discard them before undoing", name)
+		}
+	}
+
+	for _, diff := range diffs {
+		name := changeName(diff)
+		path := filepath.Join(g.root, filepath.FromSlash(name))
+		if diff.To.Name == "" {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return Change{}, fmt.Errorf("os.Remove(%s) > %w", path, err)
+			}
+		} else {
+			file, err := targetTree.TreeEntryFile(&diff.To.TreeEntry)
+			if err != nil {
+				return Change{}, fmt.Errorf("read %s from %s: %w", name, target.Hash, err)
+			}
+			contents, err := file.Contents()
+			if err != nil {
+				return Change{}, fmt.Errorf("read %s from %s: %w", name, target.Hash, err)
+			}
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return Change{}, fmt.Errorf("os.MkdirAll(%s) > %w", filepath.Dir(path), err)
+			}
+			if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+				return Change{}, fmt.Errorf("os.WriteFile(%s) > %w", path, err)
+			}
+		}
+		if _, err := wt.Add(name); err != nil {
+			return Change{}, fmt.Errorf("stage %s: %w", name, err)
+		}
+	}
+
+	message := fmt.Sprintf("Undo %d change(s)\n\n- %s\n", count, strings.Join(subjects, "\n- "))
+	hash, err := wt.Commit(message, &git.CommitOptions{Author: g.signature(), AllowEmptyCommits: true})
+	if err != nil {
+		return Change{}, fmt.Errorf("commit undo: %w", err)
+	}
+	commit, err := g.repo.CommitObject(hash)
+	if err != nil {
+		return Change{}, fmt.Errorf("repo.CommitObject(%s) > %w", hash, err)
+	}
+	return toChange(commit)
+}
+
+func (g *GitRecorder) relativePath(path string) (string, bool) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(g.root, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// signature uses the user's git identity when one is configured, so commits
+// read like their own; otherwise it falls back to a langner identity.
+func (g *GitRecorder) signature() *object.Signature {
+	signature := &object.Signature{Name: "langner", Email: "langner@localhost", When: time.Now()}
+	for _, scope := range []gitconfig.Scope{gitconfig.LocalScope, gitconfig.GlobalScope} {
+		cfg, err := g.repo.ConfigScoped(scope)
+		if err != nil || cfg.User.Name == "" {
+			continue
+		}
+		signature.Name = cfg.User.Name
+		if cfg.User.Email != "" {
+			signature.Email = cfg.User.Email
+		}
+		break
+	}
+	return signature
+}
+
+func changeName(change *object.Change) string {
+	if change.From.Name != "" {
+		return change.From.Name
+	}
+	return change.To.Name
+}
+
+func toChange(commit *object.Commit) (Change, error) {
+	stats, err := commit.Stats()
+	if err != nil {
+		return Change{}, fmt.Errorf("commit.Stats() > %w", err)
+	}
+	files := make([]string, 0, len(stats))
+	for _, stat := range stats {
+		files = append(files, stat.Name)
+	}
+	return Change{
+		Hash:    commit.Hash.String(),
+		Message: strings.TrimSpace(commit.Message),
+		When:    commit.Author.When,
+		Files:   files,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package versioning
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestNewRecorder(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.VersioningConfig
+		wantGit bool
+		wantErr bool
+	}{
+		{name: "disabled", cfg: config.VersioningConfig{}},
+		{name: "git", cfg: config.VersioningConfig{Mode: ModeGit, Directory: t.TempDir()}, wantGit: true},
+		{name: "git without directory", cfg: config.VersioningConfig{Mode: ModeGit}, wantErr: true},
+		{name: "unknown mode", cfg: config.VersioningConfig{Mode: "svn"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRecorder(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, isGit := got.(*GitRecorder)
+			assert.Equal(t, tt.wantGit, isGit)
+		})
+	}
+}
+
+func TestGitRecorder_RecordAndLog(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := OpenGitRecorder(dir)
+	require.NoError(t, err)
+
+	changes, err := recorder.Log(0)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	notes := filepath.Join(dir, "learning_notes", "a.yml")
+	other := filepath.Join(dir, "learning_notes", "b.yml")
+	writeFile(t, notes, "v1\n")
+	writeFile(t, other, "untracked\n")
+	require.NoError(t, recorder.Record("Record answer", notes))
+
+	// Rewriting the same content is not a change.
+	writeFile(t, notes, "v1\n")
+	require.NoError(t, recorder.Record("Record same answer", notes))
+
+	// Paths outside the repository are skipped, not an error.
+	require.NoError(t, recorder.Record("Outside", filepath.Join(t.TempDir(), "x.yml")))
+
+	// No paths commits every pending change.
+	writeFile(t, notes, "v2\n")
+	require.NoError(t, recorder.Record("Migrate learning history\n\ndetails"))
+
+	changes, err = recorder.Log(0)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "Migrate learning history", changes[0].Subject())
+	assert.ElementsMatch(t, []string{"learning_notes/a.yml", "learning_notes/b.yml"}, changes[0].Files)
+	assert.Equal(t, "Record answer", changes[1].Subject())
+	assert.Equal(t, []string{"learning_notes/a.yml"}, changes[1].Files)
+
+	changes, err = recorder.Log(1)
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+
+	// Reopening finds the existing repository.
+	reopened, err := OpenGitRecorder(dir)
+	require.NoError(t, err)
+	changes, err = reopened.Log(0)
+	require.NoError(t, err)
+	assert.Len(t, changes, 2)
+}
+
+func TestGitRecorder_Undo(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := OpenGitRecorder(dir)
+	require.NoError(t, err)
+
+	notes := filepath.Join(dir, "a.yml")
+	added := filepath.Join(dir, "b.yml")
+	writeFile(t, notes, "v1\n")
+	require.NoError(t, recorder.Record("first"))
+	writeFile(t, notes, "v2\n")
+	require.NoError(t, recorder.Record("second"))
+	writeFile(t, notes, "v3\n")
+	writeFile(t, added, "new\n")
+	require.NoError(t, recorder.Record("third"))
+
+	change, err := recorder.Undo(2)
+	require.NoError(t, err)
+	assert.Equal(t, "Undo 2 change(s)", change.Subject())
+	assert.Contains(t, change.Message, "- third\n- second")
+	assert.Equal(t, "v1\n", readFile(t, notes))
+	assert.NoFileExists(t, added)
+
+	// The undo is itself a change that can be undone.
+	_, err = recorder.Undo(1)
+	require.NoError(t, err)
+	assert.Equal(t, "v3\n", readFile(t, notes))
+	assert.Equal(t, "new\n", readFile(t, added))
+
+	t.Run("refuses to overwrite uncommitted edits", func(t *testing.T) {
+		writeFile(t, notes, "edited\n")
+		_, err := recorder.Undo(1)
+		assert.Error(t, err)
+		assert.Equal(t, "edited\n", readFile(t, notes))
+	})
+
+	t.Run("cannot undo more than recorded", func(t *testing.T) {
+		_, err := recorder.Undo(10)
+		assert.Error(t, err)
+	})
+
+	t.Run("count must be positive", func(t *testing.T) {
+		_, err := recorder.Undo(0)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,100 @@
+package video
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/stt"
+)
+
+// DefaultSceneGap is the pause between lines, in seconds, that starts a new
+// scene when none is configured.
+const DefaultSceneGap = 10.0
+
+// Align groups transcript segments into scenes and conversations. A pause
+// longer than sceneGap seconds starts a new scene. A segment's speaker is
+// kept when it's a name, such as a caption's voice. Labels a diarizing
+// server makes up, such as "SPEAKER_00", are renamed "Speaker 1",
+// "Speaker 2", … in the order they first talk. When a server only detects
+// turns, speakers alternate between two at each turn, which holds for a
+// dialogue. Without either, lines have no speaker. Consecutive segments of the same speaker
+// are joined into one line, timed by when its first segment starts.
+func Align(segments []stt.Segment, sceneGap float64) []notebook.StoryScene {
+	if sceneGap <= 0 {
+		sceneGap = DefaultSceneGap
+	}
+
+	speakers := newSpeakerNames(segments)
+	var scenes []notebook.StoryScene
+	var sceneStart, lastEnd float64
+	var lastSpeaker string
+	for i, segment := range segments {
+		speaker := speakers[i]
+		if len(scenes) == 0 || segment.Start-lastEnd > sceneGap {
+			if len(scenes) > 0 {
+				scenes[len(scenes)-1].Title = sceneTitle(len(scenes), sceneStart, lastEnd)
+			}
+			scenes = append(scenes, notebook.StoryScene{})
+			sceneStart = segment.Start
+			lastSpeaker = ""
+		}
+		scene := &scenes[len(scenes)-1]
+		if speaker != "" && speaker == lastSpeaker {
+			last := &scene.Conversations[len(scene.Conversations)-1]
+			last.Quote += " " + segment.Text
+		} else {
+			scene.Conversations = append(scene.Conversations, notebook.Conversation{
+				Speaker:     speaker,
+				Quote:       segment.Text,
+				TimeSeconds: int(segment.Start),
+			})
+		}
+		lastSpeaker = speaker
+		lastEnd = segment.End
+	}
+	if len(scenes) > 0 {
+		scenes[len(scenes)-1].Title = sceneTitle(len(scenes), sceneStart, lastEnd)
+	}
+	return scenes
+}
+
+// speakerLabelPattern matches the speaker labels of diarizing servers.
+var speakerLabelPattern = regexp.MustCompile(`(?i)^speaker[ _-]?\d+$`)
+
+// newSpeakerNames returns the speaker of each segment.
+func newSpeakerNames(segments []stt.Segment) []string {
+	hasTurns := false
+	for _, segment := range segments {
+		if segment.SpeakerTurnNext {
+			hasTurns = true
+			break
+		}
+	}
+
+	names := make([]string, len(segments))
+	numbers := make(map[string]int)
+	turn := 0
+	for i, segment := range segments {
+		switch {
+		case segment.Speaker != "" && !speakerLabelPattern.MatchString(segment.Speaker):
+			names[i] = segment.Speaker
+		case segment.Speaker != "":
+			if _, ok := numbers[segment.Speaker]; !ok {
+				numbers[segment.Speaker] = len(numbers) + 1
+			}
+			names[i] = fmt.Sprintf("Speaker %d", numbers[segment.Speaker])
+		case hasTurns:
+			names[i] = fmt.Sprintf("Speaker %d", turn%2+1)
+		}
+		if segment.SpeakerTurnNext {
+			turn++
+		}
+	}
+	return names
+}
+
+// sceneTitle names the nth scene by when it is in the video.
+func sceneTitle(n int, start, end float64) string {
+	return fmt.Sprintf("Scene %d (%s–%s)", n, notebook.FormatTimestamp(int(start)), notebook.FormatTimestamp(int(end)))
+}
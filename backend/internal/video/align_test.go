@@ -0,0 +1,106 @@
+package video
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/stt"
+)
+
+func TestAlign(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []stt.Segment
+		sceneGap float64
+		want     []notebook.StoryScene
+	}{
+		{
+			name: "labelled speakers are numbered and their lines joined",
+			segments: []stt.Segment{
+				{Start: 1, End: 2, Text: "Hi.", Speaker: "SPEAKER_01"},
+				{Start: 2, End: 4, Text: "Long time no see.", Speaker: "SPEAKER_01"},
+				{Start: 4.5, End: 6, Text: "It's been ages.", Speaker: "SPEAKER_00"},
+				{Start: 7, End: 8, Text: "Coffee?", Speaker: "SPEAKER_01"},
+			},
+			want: []notebook.StoryScene{
+				{
+					Title: "Scene 1 (0:01–0:08)",
+					Conversations: []notebook.Conversation{
+						{Speaker: "Speaker 1", Quote: "Hi. Long time no see.", TimeSeconds: 1},
+						{Speaker: "Speaker 2", Quote: "It's been ages.", TimeSeconds: 4},
+						{Speaker: "Speaker 1", Quote: "Coffee?", TimeSeconds: 7},
+					},
+				},
+			},
+		},
+		{
+			name: "named speakers are kept",
+			segments: []stt.Segment{
+				{Start: 65, End: 66, Text: "Rock & roll!", Speaker: "Joey"},
+				{Start: 66, End: 67, Text: "Sure.", Speaker: "SPEAKER_03"},
+			},
+			want: []notebook.StoryScene{
+				{
+					Title: "Scene 1 (1:05–1:07)",
+					Conversations: []notebook.Conversation{
+						{Speaker: "Joey", Quote: "Rock & roll!", TimeSeconds: 65},
+						{Speaker: "Speaker 1", Quote: "Sure.", TimeSeconds: 66},
+					},
+				},
+			},
+		},
+		{
+			name: "speaker turns alternate between two speakers",
+			segments: []stt.Segment{
+				{Start: 0, End: 2, Text: "Are you coming?", SpeakerTurnNext: true},
+				{Start: 2, End: 3, Text: "In a minute."},
+				{Start: 3, End: 4, Text: "Just a sec.", SpeakerTurnNext: true},
+				{Start: 5, End: 6, Text: "Hurry up."},
+			},
+			want: []notebook.StoryScene{
+				{
+					Title: "Scene 1 (0:00–0:06)",
+					Conversations: []notebook.Conversation{
+						{Speaker: "Speaker 1", Quote: "Are you coming?"},
+						{Speaker: "Speaker 2", Quote: "In a minute. Just a sec.", TimeSeconds: 2},
+						{Speaker: "Speaker 1", Quote: "Hurry up.", TimeSeconds: 5},
+					},
+				},
+			},
+		},
+		{
+			name: "a long pause starts a new scene",
+			segments: []stt.Segment{
+				{Start: 0, End: 2, Text: "See you tomorrow."},
+				{Start: 2, End: 3, Text: "Bye."},
+				{Start: 3700, End: 3702, Text: "Good morning."},
+			},
+			sceneGap: 5,
+			want: []notebook.StoryScene{
+				{
+					Title: "Scene 1 (0:00–0:03)",
+					Conversations: []notebook.Conversation{
+						{Quote: "See you tomorrow."},
+						{Quote: "Bye.", TimeSeconds: 2},
+					},
+				},
+				{
+					Title: "Scene 2 (1:01:40–1:01:42)",
+					Conversations: []notebook.Conversation{
+						{Quote: "Good morning.", TimeSeconds: 3700},
+					},
+				},
+			},
+		},
+		{
+			name: "no segments",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Align(tt.segments, tt.sceneGap))
+		})
+	}
+}
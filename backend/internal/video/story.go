@@ -0,0 +1,86 @@
+package video
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+// storyIndex is a new index.yml of a story directory.
+type storyIndex struct {
+	Kind          string   `yaml:"kind,omitempty"`
+	ID            string   `yaml:"id"`
+	Name          string   `yaml:"name"`
+	NotebookPaths []string `yaml:"notebooks"`
+}
+
+// WriteStory writes story as fileName in dir and lists it in the index.yml
+// there. A missing index.yml is created with id and name; an existing one
+// keeps its own and only gains the new notebook, with its comments and
+// formatting kept. It refuses to overwrite an existing fileName and
+// returns the path of the written notebook.
+func WriteStory(dir, fileName, id, name string, story notebook.StoryNotebook) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("create directory %s: %w", dir, err)
+	}
+
+	indexPath := filepath.Join(dir, "index.yml")
+	index, err := os.ReadFile(indexPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("read %s: %w", indexPath, err)
+	}
+	entry := "./" + fileName
+	var updated []byte
+	if index != nil {
+		var added bool
+		updated, added, err = notebook.AddNotebookToIndexYAML(index, entry)
+		if err != nil {
+			return "", fmt.Errorf("notebook.AddNotebookToIndexYAML(%s) > %w", indexPath, err)
+		}
+		if !added {
+			updated = nil
+		}
+	}
+
+	storyPath := filepath.Join(dir, fileName)
+	if err := writeNewYAML(storyPath, []notebook.StoryNotebook{story}); err != nil {
+		return "", err
+	}
+	switch {
+	case index == nil:
+		if err := writeNewYAML(indexPath, storyIndex{ID: id, Name: name, NotebookPaths: []string{entry}}); err != nil {
+			return "", err
+		}
+	case updated != nil:
+		if err := os.WriteFile(indexPath, updated, 0o644); err != nil {
+			return "", fmt.Errorf("write %s: %w", indexPath, err)
+		}
+	}
+	return storyPath, nil
+}
+
+// writeNewYAML encodes data into a new file at path, failing if the file
+// already exists.
+func writeNewYAML(path string, data any) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("%s already exists", path)
+	}
+	if err != nil {
+		return fmt.Errorf("create file %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	encoder := yaml.NewEncoder(file)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(data); err != nil {
+		return fmt.Errorf("encode yaml to %s: %w", path, err)
+	}
+	return encoder.Close()
+}
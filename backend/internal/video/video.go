@@ -0,0 +1,124 @@
+// Package video turns the speech of a video into a story notebook: the
+// soundtrack is extracted with ffmpeg, transcribed by a whisper.cpp
+// compatible server, and the transcript is aligned into scenes and
+// speakers.
+package video
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/stt"
+)
+
+// Placeholders replaced in the arguments of an Extractor.
+const (
+	PlaceholderInput  = "{input}"
+	PlaceholderOutput = "{output}"
+)
+
+// defaultCommand and defaultArgs extract the soundtrack as the 16 kHz mono
+// WAV whisper.cpp transcribes.
+const defaultCommand = "ffmpeg"
+
+var defaultArgs = []string{"-y", "-loglevel", "error", "-i", PlaceholderInput, "-vn", "-ac", "1", "-ar", "16000", "-c:a", "pcm_s16le", PlaceholderOutput}
+
+// transcribeTimeout bounds the transcription of a whole soundtrack, which
+// takes a local model a while for an episode-long video.
+const transcribeTimeout = 30 * time.Minute
+
+// Extractor runs a local program that writes the soundtrack of a video as
+// a WAV file.
+type Extractor struct {
+	command string
+	args    []string
+}
+
+// NewExtractor returns the extractor configured by cfg.
+func NewExtractor(cfg config.VideoConfig) (*Extractor, error) {
+	extractor := &Extractor{
+		command: cfg.Command,
+		args:    cfg.Args,
+	}
+	if extractor.command == "" {
+		extractor.command = defaultCommand
+	}
+	if extractor.args == nil {
+		extractor.args = defaultArgs
+	}
+	for _, placeholder := range []string{PlaceholderInput, PlaceholderOutput} {
+		if !containsPlaceholder(extractor.args, placeholder) {
+			return nil, fmt.Errorf("video.args must contain %s", placeholder)
+		}
+	}
+	return extractor, nil
+}
+
+// ExtractAudio returns the soundtrack of the video at path as WAV. It runs
+// the command with the placeholders of its arguments replaced, and reads
+// back the file the command wrote.
+func (e *Extractor) ExtractAudio(ctx context.Context, path string) ([]byte, error) {
+	tmpDir, err := os.MkdirTemp("", "langner-video-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temp directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+	output := filepath.Join(tmpDir, "audio.wav")
+
+	replacer := strings.NewReplacer(PlaceholderInput, path, PlaceholderOutput, output)
+	args := make([]string, len(e.args))
+	for i, arg := range e.args {
+		args[i] = replacer.Replace(arg)
+	}
+
+	cmd := exec.CommandContext(ctx, e.command, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("run %s: %w: %s", e.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	audio, err := os.ReadFile(output)
+	if err != nil {
+		return nil, fmt.Errorf("read the audio %s wrote: %w", e.command, err)
+	}
+	if len(audio) == 0 {
+		return nil, fmt.Errorf("%s wrote no audio for %s", e.command, path)
+	}
+	return audio, nil
+}
+
+// NewTranscriber returns the client of the whisper.cpp server the
+// soundtrack is transcribed with: video.whisper_url, or stt.url when it is
+// empty, in stt.language.
+func NewTranscriber(cfg config.VideoConfig, sttCfg config.STTConfig) (*stt.WhisperClient, error) {
+	url := cfg.WhisperURL
+	if url == "" {
+		url = sttCfg.URL
+	}
+	if url == "" {
+		return nil, fmt.Errorf("video.whisper_url or stt.url is required to transcribe a video")
+	}
+	client, err := stt.NewWhisperClient(config.STTConfig{Mode: stt.ModeWhisper, URL: url, Language: sttCfg.Language})
+	if err != nil {
+		return nil, err
+	}
+	client.SetTimeout(transcribeTimeout)
+	return client, nil
+}
+
+func containsPlaceholder(args []string, placeholder string) bool {
+	for _, arg := range args {
+		if strings.Contains(arg, placeholder) {
+			return true
+		}
+	}
+	return false
+}
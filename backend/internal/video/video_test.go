@@ -0,0 +1,126 @@
+package video
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/config"
+	"github.com/at-ishikawa/langner/internal/notebook"
+)
+
+func TestNewExtractor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.VideoConfig
+		want    *Extractor
+		wantErr string
+	}{
+		{
+			name: "ffmpeg by default",
+			want: &Extractor{command: "ffmpeg", args: defaultArgs},
+		},
+		{
+			name: "a custom program",
+			cfg:  config.VideoConfig{Command: "avconv", Args: []string{"-i", "{input}", "{output}"}},
+			want: &Extractor{command: "avconv", args: []string{"-i", "{input}", "{output}"}},
+		},
+		{
+			name:    "arguments without the output",
+			cfg:     config.VideoConfig{Args: []string{"-i", "{input}"}},
+			wantErr: "video.args must contain {output}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewExtractor(tt.cfg)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExtractor_ExtractAudio(t *testing.T) {
+	dir := t.TempDir()
+	fakeFFmpeg := filepath.Join(dir, "fake-ffmpeg")
+	require.NoError(t, os.WriteFile(fakeFFmpeg, []byte("#!/bin/sh\nprintf 'RIFF %s' \"$1\" > \"$2\"\n"), 0o755))
+
+	extractor, err := NewExtractor(config.VideoConfig{Command: fakeFFmpeg, Args: []string{"{input}", "{output}"}})
+	require.NoError(t, err)
+	audio, err := extractor.ExtractAudio(context.Background(), "episode.mp4")
+	require.NoError(t, err)
+	assert.Equal(t, "RIFF episode.mp4", string(audio))
+
+	failing, err := NewExtractor(config.VideoConfig{Command: "false", Args: []string{"{input}", "{output}"}})
+	require.NoError(t, err)
+	_, err = failing.ExtractAudio(context.Background(), "episode.mp4")
+	assert.ErrorContains(t, err, "run false")
+}
+
+func TestNewTranscriber(t *testing.T) {
+	_, err := NewTranscriber(config.VideoConfig{WhisperURL: "http://127.0.0.1:8178"}, config.STTConfig{})
+	assert.NoError(t, err)
+	_, err = NewTranscriber(config.VideoConfig{}, config.STTConfig{URL: "http://127.0.0.1:8178"})
+	assert.NoError(t, err, "stt.url is used when video.whisper_url is empty")
+	_, err = NewTranscriber(config.VideoConfig{}, config.STTConfig{})
+	assert.ErrorContains(t, err, "video.whisper_url or stt.url is required")
+}
+
+func TestWriteStory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "friends")
+	story := notebook.StoryNotebook{
+		Event: "Episode 1",
+		Date:  time.Date(2025, 11, 8, 0, 0, 0, 0, time.UTC),
+		Scenes: []notebook.StoryScene{
+			{
+				Title:         "Scene 1 (0:01–0:03)",
+				Conversations: []notebook.Conversation{{Speaker: "Speaker 1", Quote: "Hi."}},
+			},
+		},
+	}
+
+	path, err := WriteStory(dir, "episode-1.yml", "friends", "Friends", story)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "episode-1.yml"), path)
+	index, err := os.ReadFile(filepath.Join(dir, "index.yml"))
+	require.NoError(t, err)
+	assert.Equal(t, `id: friends
+name: Friends
+notebooks:
+  - ./episode-1.yml
+`, string(index))
+
+	// A hand-edited index keeps its comments.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.yml"), append([]byte("# Season 1\n"), index...), 0o644))
+	_, err = WriteStory(dir, "episode-2.yml", "ignored", "Ignored", story)
+	require.NoError(t, err)
+	_, err = WriteStory(dir, "episode-2.yml", "ignored", "Ignored", story)
+	assert.ErrorContains(t, err, "already exists", "an existing notebook isn't overwritten")
+
+	index, err = os.ReadFile(filepath.Join(dir, "index.yml"))
+	require.NoError(t, err)
+	assert.Equal(t, `# Season 1
+id: friends
+name: Friends
+notebooks:
+  - ./episode-1.yml
+  - ./episode-2.yml
+`, string(index))
+
+	reader, err := notebook.NewReader([]string{filepath.Dir(dir)}, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	notebooks, err := reader.ReadStoryNotebooks("friends")
+	require.NoError(t, err)
+	require.Len(t, notebooks, 2)
+	assert.Equal(t, story.Event, notebooks[0].Event)
+	assert.Equal(t, story.Scenes[0].Conversations, notebooks[0].Scenes[0].Conversations)
+}
@@ -0,0 +1,141 @@
+package video
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/at-ishikawa/langner/internal/stt"
+)
+
+var (
+	// vttTagPattern matches the markup of a cue's text: voice and class
+	// spans, and the per-word timestamps of YouTube's automatic captions.
+	vttTagPattern = regexp.MustCompile(`<[^>]*>`)
+	// vttVoicePattern matches the voice span naming who says a cue.
+	vttVoicePattern = regexp.MustCompile(`^<v(?:\.[^ >]*)? ([^>]+)>`)
+)
+
+// ParseVTT returns the lines of a WebVTT caption file, such as one
+// downloaded from YouTube with `yt-dlp --write-subs`, as transcript
+// segments. A voice span names the speaker of a cue, and a line starting
+// with ">>" or "- " marks a change of speaker. The rolling captions YouTube
+// generates repeat the previous line at the top of each cue; repeated lines
+// are dropped.
+func ParseVTT(r io.Reader) ([]stt.Segment, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var segments []stt.Segment
+	var previousLines map[string]bool
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.Contains(line, "-->") {
+			continue
+		}
+		start, end, err := parseVTTTiming(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		cueLines := make(map[string]bool)
+		var texts []string
+		speaker := ""
+		flush := func(turnNext bool) {
+			if len(texts) > 0 {
+				segments = append(segments, stt.Segment{Start: start, End: end, Text: strings.Join(texts, " "), Speaker: speaker, SpeakerTurnNext: turnNext})
+				texts = nil
+			} else if turnNext && len(segments) > 0 {
+				segments[len(segments)-1].SpeakerTurnNext = true
+			}
+		}
+		for scanner.Scan() {
+			lineNumber++
+			raw := strings.TrimSpace(scanner.Text())
+			if raw == "" {
+				break
+			}
+			lineSpeaker := speaker
+			if match := vttVoicePattern.FindStringSubmatch(raw); match != nil {
+				lineSpeaker = strings.TrimSpace(match[1])
+			}
+			text := strings.Join(strings.Fields(html.UnescapeString(vttTagPattern.ReplaceAllString(raw, ""))), " ")
+			if text == "" || cueLines[text] {
+				continue
+			}
+			cueLines[text] = true
+			if previousLines[text] {
+				continue
+			}
+
+			turn := false
+			for _, marker := range []string{">>", "- "} {
+				if strings.HasPrefix(text, marker) {
+					text = strings.TrimSpace(strings.TrimPrefix(text, marker))
+					turn = true
+					break
+				}
+			}
+			if turn {
+				flush(true)
+			} else if lineSpeaker != speaker {
+				flush(false)
+			}
+			speaker = lineSpeaker
+			if text != "" {
+				texts = append(texts, text)
+			}
+		}
+		flush(false)
+		previousLines = cueLines
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read captions: %w", err)
+	}
+	return segments, nil
+}
+
+// parseVTTTiming parses a cue timing line such as
+// "00:01:02.500 --> 00:01:04.000 align:start position:0%".
+func parseVTTTiming(line string) (start, end float64, err error) {
+	from, rest, _ := strings.Cut(line, "-->")
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("no end time in %q", line)
+	}
+	if start, err = parseVTTTimestamp(strings.TrimSpace(from)); err != nil {
+		return 0, 0, err
+	}
+	if end, err = parseVTTTimestamp(fields[0]); err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// parseVTTTimestamp parses "hh:mm:ss.ttt" or "mm:ss.ttt" into seconds.
+func parseVTTTimestamp(timestamp string) (float64, error) {
+	parts := strings.Split(timestamp, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid timestamp %q", timestamp)
+	}
+	seconds, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp %q: %w", timestamp, err)
+	}
+	multiplier := 60.0
+	for i := len(parts) - 2; i >= 0; i-- {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid timestamp %q: %w", timestamp, err)
+		}
+		seconds += float64(n) * multiplier
+		multiplier *= 60
+	}
+	return seconds, nil
+}
@@ -0,0 +1,86 @@
+package video
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/stt"
+)
+
+func TestParseVTT(t *testing.T) {
+	tests := []struct {
+		name    string
+		vtt     string
+		want    []stt.Segment
+		wantErr string
+	}{
+		{
+			name: "rolling automatic captions drop repeated lines",
+			vtt: `WEBVTT
+Kind: captions
+Language: en
+
+00:00:01.000 --> 00:00:03.500 align:start position:0%
+hey<00:00:01.500><c> Alex</c><00:00:02.000><c> look</c><00:00:02.500><c> at</c><00:00:03.000><c> you</c>
+
+00:00:03.500 --> 00:00:03.510 align:start position:0%
+hey Alex look at you
+
+
+00:00:03.510 --> 00:00:06.000 align:start position:0%
+hey Alex look at you
+all<00:00:04.000><c> dressed</c><00:00:04.500><c> for</c><00:00:05.000><c> work</c>
+`,
+			want: []stt.Segment{
+				{Start: 1, End: 3.5, Text: "hey Alex look at you"},
+				{Start: 3.51, End: 6, Text: "all dressed for work"},
+			},
+		},
+		{
+			name: "speaker changes and voices",
+			vtt: `WEBVTT
+
+1
+01:02.000 --> 01:04.000
+>> Where were you
+last night?
+>> Out.
+
+2
+01:05.000 --> 01:06.000
+<v Joey>Rock &amp; roll!</v>
+
+NOTE written by hand
+
+3
+1:01:05.000 --> 1:01:06.000
+- Bye.
+`,
+			want: []stt.Segment{
+				{Start: 62, End: 64, Text: "Where were you last night?", SpeakerTurnNext: true},
+				{Start: 62, End: 64, Text: "Out."},
+				{Start: 65, End: 66, Text: "Rock & roll!", Speaker: "Joey", SpeakerTurnNext: true},
+				{Start: 3665, End: 3666, Text: "Bye."},
+			},
+		},
+		{
+			name:    "a broken timing",
+			vtt:     "WEBVTT\n\n00:00:xx.000 --> 00:00:02.000\nHi.\n",
+			wantErr: `line 3: invalid timestamp "00:00:xx.000"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVTT(strings.NewReader(tt.vtt))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
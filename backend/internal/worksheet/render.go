@@ -0,0 +1,182 @@
+package worksheet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maskedBlank is the blank maskWord leaves in a sentence; underscores are
+// escaped so markdown doesn't read them as emphasis.
+const maskedBlank = "______"
+
+var sectionHeadings = map[Section]string{
+	SectionCloze:   "Fill in the blanks",
+	SectionMeaning: "Write the meaning",
+	SectionReverse: "Write the word",
+	SectionOrigin:  "Match each word to its origin",
+}
+
+var sectionInstructions = map[Section]string{
+	SectionCloze:   "Complete each sentence with a word from the word bank.",
+	SectionMeaning: "Write what each word means.",
+	SectionReverse: "Write the word or phrase that has each meaning.",
+	SectionOrigin:  "Write the letter of the origin each word comes from.",
+}
+
+// RenderQuestions returns the markdown of the questions, without answers.
+func RenderQuestions(sheet Sheet) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Worksheet %s\n\n", sheet.ID)
+	fmt.Fprintf(&sb, "%d question%s due by %s.\n", len(sheet.Items), plural(len(sheet.Items)), sheet.DueBy.Format("2006-01-02"))
+
+	origins := originLabels(sheet.Items)
+	for _, section := range sections {
+		items := itemsIn(sheet.Items, section)
+		if len(items) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n## %s\n\n%s\n\n", sectionHeadings[section], sectionInstructions[section])
+		switch section {
+		case SectionCloze:
+			bank := make([]string, 0, len(items))
+			for _, item := range items {
+				bank = append(bank, item.Expression)
+			}
+			sort.Slice(bank, func(i, j int) bool { return strings.ToLower(bank[i]) < strings.ToLower(bank[j]) })
+			fmt.Fprintf(&sb, "**Word bank:** %s\n\n", strings.Join(uniqueStrings(bank), " · "))
+			for _, item := range items {
+				fmt.Fprintf(&sb, "%d. %s\n", item.Number, escapeBlanks(item.MaskedContext))
+			}
+		case SectionMeaning:
+			for _, item := range items {
+				fmt.Fprintf(&sb, "%d. **%s**: %s\n", item.Number, item.Expression, escapeBlanks(strings.Repeat(maskedBlank, 4)))
+			}
+		case SectionReverse:
+			for _, item := range items {
+				fmt.Fprintf(&sb, "%d. %s: %s\n", item.Number, item.Meaning, escapeBlanks(maskedBlank))
+				if item.MaskedContext != "" {
+					fmt.Fprintf(&sb, "   - %s\n", escapeBlanks(item.MaskedContext))
+				}
+			}
+		case SectionOrigin:
+			for _, item := range items {
+				fmt.Fprintf(&sb, "%d. **%s** ( )\n", item.Number, item.Expression)
+			}
+			sb.WriteString("\n")
+			for _, origin := range origins.order {
+				fmt.Fprintf(&sb, "- %s. %s\n", origins.labels[origin], origin)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// RenderAnswerKey returns the markdown of the answers, numbered like the
+// questions.
+func RenderAnswerKey(sheet Sheet) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Answer key: worksheet %s\n\n", sheet.ID)
+	fmt.Fprintf(&sb, "Record the results with `langner worksheet grade %s`.\n", sheet.ID)
+
+	origins := originLabels(sheet.Items)
+	for _, section := range sections {
+		items := itemsIn(sheet.Items, section)
+		if len(items) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n## %s\n\n", sectionHeadings[section])
+		for _, item := range items {
+			switch section {
+			case SectionOrigin:
+				fmt.Fprintf(&sb, "%d. **%s**: %s. %s", item.Number, item.Expression, origins.labels[originKey(item)], originKey(item))
+				if item.OriginMeaning != "" {
+					fmt.Fprintf(&sb, " (%s)", item.OriginMeaning)
+				}
+				sb.WriteString("\n")
+			default:
+				fmt.Fprintf(&sb, "%d. **%s**", item.Number, item.Expression)
+				if item.Meaning != "" {
+					fmt.Fprintf(&sb, ": %s", item.Meaning)
+				}
+				sb.WriteString("\n")
+				if section == SectionCloze && item.Context != "" {
+					fmt.Fprintf(&sb, "   - %s\n", item.Context)
+				}
+			}
+		}
+	}
+	return sb.String()
+}
+
+type originLabelSet struct {
+	order  []string
+	labels map[string]string
+}
+
+// originLabels letters the distinct origins of the matching section in
+// alphabetical order, so the list doesn't follow the order of the words.
+func originLabels(items []Item) originLabelSet {
+	set := originLabelSet{labels: make(map[string]string)}
+	for _, item := range itemsIn(items, SectionOrigin) {
+		key := originKey(item)
+		if _, ok := set.labels[key]; ok {
+			continue
+		}
+		set.labels[key] = ""
+		set.order = append(set.order, key)
+	}
+	sort.Slice(set.order, func(i, j int) bool { return strings.ToLower(set.order[i]) < strings.ToLower(set.order[j]) })
+	for i, key := range set.order {
+		set.labels[key] = columnLabel(i)
+	}
+	return set
+}
+
+func originKey(item Item) string {
+	if item.OriginLanguage == "" {
+		return item.Origin
+	}
+	return fmt.Sprintf("%s (%s)", item.Origin, item.OriginLanguage)
+}
+
+// columnLabel returns A, B, ..., Z, AA, AB, ... for i = 0, 1, ...
+func columnLabel(i int) string {
+	label := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		label = string(rune('A'+(i-1)%26)) + label
+	}
+	return label
+}
+
+func itemsIn(items []Item, section Section) []Item {
+	var result []Item
+	for _, item := range items {
+		if item.Section == section {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func escapeBlanks(s string) string {
+	return strings.ReplaceAll(s, "_", `\_`)
+}
+
+func uniqueStrings(values []string) []string {
+	result := values[:0:0]
+	for i, value := range values {
+		if i > 0 && strings.EqualFold(values[i-1], value) {
+			continue
+		}
+		result = append(result, value)
+	}
+	return result
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
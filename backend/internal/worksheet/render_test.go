@@ -0,0 +1,94 @@
+package worksheet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderQuestions(t *testing.T) {
+	sheet := NewSheet("worksheet-2026-03-01", testCreatedAt, testDueBy, testQuizItems())
+
+	want := `# Worksheet worksheet-2026-03-01
+
+5 questions due by 2026-03-08.
+
+## Fill in the blanks
+
+Complete each sentence with a word from the word bank.
+
+**Word bank:** ephemeral
+
+1. Fame is \_\_\_\_\_\_.
+
+## Write the meaning
+
+Write what each word means.
+
+2. **resilient**: \_\_\_\_\_\_\_\_\_\_\_\_\_\_\_\_\_\_\_\_\_\_\_\_
+
+## Write the word
+
+Write the word or phrase that has each meaning.
+
+3. truthful and straightforward: \_\_\_\_\_\_
+   - Let me be \_\_\_\_\_\_ with you.
+
+## Match each word to its origin
+
+Write the letter of the origin each word comes from.
+
+4. **benediction** ( )
+5. **chronic** ( )
+
+- A. bene (Latin)
+- B. chronos (Greek)
+`
+	assert.Equal(t, want, RenderQuestions(sheet))
+}
+
+func TestRenderAnswerKey(t *testing.T) {
+	sheet := NewSheet("worksheet-2026-03-01", testCreatedAt, testDueBy, testQuizItems())
+
+	want := "# Answer key: worksheet worksheet-2026-03-01\n\n" +
+		"Record the results with `langner worksheet grade worksheet-2026-03-01`.\n" +
+		`
+## Fill in the blanks
+
+1. **ephemeral**: lasting a very short time
+   - Fame is ephemeral.
+
+## Write the meaning
+
+2. **resilient**: able to recover quickly
+
+## Write the word
+
+3. **candid**: truthful and straightforward
+
+## Match each word to its origin
+
+4. **benediction**: A. bene (Latin) (well)
+5. **chronic**: B. chronos (Greek) (time)
+`
+	assert.Equal(t, want, RenderAnswerKey(sheet))
+}
+
+func TestColumnLabel(t *testing.T) {
+	tests := []struct {
+		i    int
+		want string
+	}{
+		{0, "A"},
+		{25, "Z"},
+		{26, "AA"},
+		{27, "AB"},
+		{701, "ZZ"},
+		{702, "AAA"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, columnLabel(tt.i))
+		})
+	}
+}
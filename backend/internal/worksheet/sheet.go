@@ -0,0 +1,197 @@
+// Package worksheet prints spaced-repetition reviews on paper. A worksheet
+// holds the words due within the next few days as cloze sentences, reverse
+// prompts and etymology origin matching, with a separate answer key, and is
+// kept as YAML so the results written on paper can be recorded back into the
+// learning history once the sheet has been graded.
+package worksheet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/at-ishikawa/langner/internal/inference"
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+// Result is the outcome of one question graded on paper.
+type Result string
+
+const (
+	ResultCorrect Result = "correct"
+	ResultWrong   Result = "wrong"
+	// ResultSkipped marks a question left unanswered; nothing is recorded
+	// for it.
+	ResultSkipped Result = "skipped"
+)
+
+// Section is a group of questions printed under one heading.
+type Section string
+
+const (
+	SectionCloze   Section = "cloze"
+	SectionMeaning Section = "meaning"
+	SectionReverse Section = "reverse"
+	SectionOrigin  Section = "origin"
+)
+
+// sections is the order sections are printed and numbered in.
+var sections = []Section{SectionCloze, SectionMeaning, SectionReverse, SectionOrigin}
+
+// Sheet is a generated worksheet, stored as <ID>.yml next to its markdown.
+type Sheet struct {
+	ID        string     `yaml:"id"`
+	CreatedAt time.Time  `yaml:"created_at"`
+	DueBy     time.Time  `yaml:"due_by"`
+	GradedAt  *time.Time `yaml:"graded_at,omitempty"`
+	Items     []Item     `yaml:"items"`
+}
+
+// Item is one numbered question of a Sheet. It carries what's needed to
+// print the question and answer again and to find the learning history the
+// result belongs to.
+type Item struct {
+	Number             int               `yaml:"number"`
+	Section            Section           `yaml:"section"`
+	QuizType           notebook.QuizType `yaml:"quiz_type"`
+	NotebookName       string            `yaml:"notebook"`
+	StoryTitle         string            `yaml:"story,omitempty"`
+	SceneTitle         string            `yaml:"scene,omitempty"`
+	ID                 string            `yaml:"id,omitempty"`
+	Expression         string            `yaml:"expression"`
+	OriginalExpression string            `yaml:"original_expression,omitempty"`
+	ConceptHead        string            `yaml:"concept_head,omitempty"`
+	Meaning            string            `yaml:"meaning,omitempty"`
+	Context            string            `yaml:"context,omitempty"`
+	MaskedContext      string            `yaml:"masked_context,omitempty"`
+	Origin             string            `yaml:"origin,omitempty"`
+	OriginLanguage     string            `yaml:"origin_language,omitempty"`
+	OriginMeaning      string            `yaml:"origin_meaning,omitempty"`
+	Result             Result            `yaml:"result,omitempty"`
+	// Recorded is set once Result is in the learning history, so grading
+	// that stopped part-way resumes without recording an item twice.
+	Recorded bool `yaml:"recorded,omitempty"`
+}
+
+// NewSheet numbers items section by section, keeping their order within a
+// section.
+func NewSheet(id string, createdAt, dueBy time.Time, items []quiz.WorksheetItem) Sheet {
+	sheet := Sheet{ID: id, CreatedAt: createdAt, DueBy: dueBy}
+	for _, section := range sections {
+		for _, item := range items {
+			if sectionOf(item) != section {
+				continue
+			}
+			sheet.Items = append(sheet.Items, newItem(len(sheet.Items)+1, section, item))
+		}
+	}
+	return sheet
+}
+
+func sectionOf(item quiz.WorksheetItem) Section {
+	switch item.QuizType {
+	case notebook.QuizTypeReverse:
+		return SectionReverse
+	case notebook.QuizTypeEtymologyOrigin:
+		return SectionOrigin
+	}
+	if item.Context.MaskedContext == "" {
+		return SectionMeaning
+	}
+	return SectionCloze
+}
+
+func newItem(number int, section Section, item quiz.WorksheetItem) Item {
+	card := item.Card
+	return Item{
+		Number:             number,
+		Section:            section,
+		QuizType:           item.QuizType,
+		NotebookName:       card.NotebookName,
+		StoryTitle:         card.StoryTitle,
+		SceneTitle:         card.SceneTitle,
+		ID:                 card.ID,
+		Expression:         card.Expression,
+		OriginalExpression: card.OriginalExpression,
+		ConceptHead:        card.ConceptHead,
+		Meaning:            card.Meaning,
+		Context:            item.Context.Context,
+		MaskedContext:      item.Context.MaskedContext,
+		Origin:             item.Origin.Origin,
+		OriginLanguage:     item.Origin.Language,
+		OriginMeaning:      item.Origin.Meaning,
+	}
+}
+
+// QuizItem converts the item back to the quiz item it was printed from, so
+// its result can be saved with quiz.Service.SaveWorksheetResult.
+func (item Item) QuizItem() quiz.WorksheetItem {
+	card := quiz.FreeformCard{
+		ID:                 item.ID,
+		NotebookName:       item.NotebookName,
+		StoryTitle:         item.StoryTitle,
+		SceneTitle:         item.SceneTitle,
+		Expression:         item.Expression,
+		OriginalExpression: item.OriginalExpression,
+		Meaning:            item.Meaning,
+		ConceptHead:        item.ConceptHead,
+	}
+	if item.Context != "" {
+		card.Contexts = []inference.Context{{Context: item.Context}}
+	}
+	return quiz.WorksheetItem{
+		QuizType: item.QuizType,
+		Card:     card,
+		Context:  quiz.ReverseContext{Context: item.Context, MaskedContext: item.MaskedContext},
+		Origin: quiz.WordOriginPart{
+			Origin:   item.Origin,
+			Language: item.OriginLanguage,
+			Meaning:  item.OriginMeaning,
+		},
+	}
+}
+
+// Grade sets the result of every item: the numbers in wrong and skipped get
+// those results and all the others are correct. It fails on a number that
+// isn't on the sheet or is listed twice.
+func (sheet *Sheet) Grade(wrong, skipped []int, gradedAt time.Time) error {
+	results := make(map[int]Result, len(wrong)+len(skipped))
+	for _, list := range []struct {
+		numbers []int
+		result  Result
+	}{{wrong, ResultWrong}, {skipped, ResultSkipped}} {
+		for _, number := range list.numbers {
+			if number < 1 || number > len(sheet.Items) {
+				return fmt.Errorf("question %d is not on worksheet %s (1-%d)", number, sheet.ID, len(sheet.Items))
+			}
+			if _, ok := results[number]; ok {
+				return fmt.Errorf("question %d is listed more than once", number)
+			}
+			results[number] = list.result
+		}
+	}
+
+	for i := range sheet.Items {
+		result, ok := results[sheet.Items[i].Number]
+		if !ok {
+			result = ResultCorrect
+		}
+		sheet.Items[i].Result = result
+	}
+	sheet.GradedAt = &gradedAt
+	return nil
+}
+
+// Unrecorded returns the graded items whose result still has to be
+// recorded in the learning history. Skipped items have nothing to record.
+func (sheet *Sheet) Unrecorded() []*Item {
+	var items []*Item
+	for i := range sheet.Items {
+		item := &sheet.Items[i]
+		if item.Result == "" || item.Result == ResultSkipped || item.Recorded {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,153 @@
+package worksheet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/at-ishikawa/langner/internal/notebook"
+	"github.com/at-ishikawa/langner/internal/quiz"
+)
+
+var (
+	testCreatedAt = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	testDueBy     = time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)
+)
+
+func testQuizItems() []quiz.WorksheetItem {
+	return []quiz.WorksheetItem{
+		{
+			QuizType: notebook.QuizTypeEtymologyOrigin,
+			Card:     quiz.FreeformCard{NotebookName: "vocab", StoryTitle: "flashcards", Expression: "benediction", Meaning: "a blessing"},
+			Origin:   quiz.WordOriginPart{Origin: "bene", Language: "Latin", Meaning: "well"},
+		},
+		{
+			QuizType: notebook.QuizTypeReverse,
+			Card:     quiz.FreeformCard{NotebookName: "vocab", StoryTitle: "flashcards", Expression: "candid", Meaning: "truthful and straightforward"},
+			Context:  quiz.ReverseContext{Context: "Let me be candid with you.", MaskedContext: "Let me be ______ with you."},
+		},
+		{
+			QuizType: notebook.QuizTypeNotebook,
+			Card:     quiz.FreeformCard{NotebookName: "vocab", StoryTitle: "flashcards", Expression: "resilient", Meaning: "able to recover quickly"},
+		},
+		{
+			QuizType: notebook.QuizTypeNotebook,
+			Card: quiz.FreeformCard{
+				ID: "ephemeral-1", NotebookName: "vocab", StoryTitle: "flashcards",
+				Expression: "ephemeral", Meaning: "lasting a very short time",
+			},
+			Context: quiz.ReverseContext{Context: "Fame is ephemeral.", MaskedContext: "Fame is ______."},
+		},
+		{
+			QuizType: notebook.QuizTypeEtymologyOrigin,
+			Card:     quiz.FreeformCard{NotebookName: "vocab", StoryTitle: "flashcards", Expression: "chronic", Meaning: "persisting for a long time"},
+			Origin:   quiz.WordOriginPart{Origin: "chronos", Language: "Greek", Meaning: "time"},
+		},
+	}
+}
+
+func TestNewSheet(t *testing.T) {
+	sheet := NewSheet("worksheet-2026-03-01", testCreatedAt, testDueBy, testQuizItems())
+
+	type numbered struct {
+		number     int
+		section    Section
+		expression string
+	}
+	var got []numbered
+	for _, item := range sheet.Items {
+		got = append(got, numbered{item.Number, item.Section, item.Expression})
+	}
+	assert.Equal(t, []numbered{
+		{1, SectionCloze, "ephemeral"},
+		{2, SectionMeaning, "resilient"},
+		{3, SectionReverse, "candid"},
+		{4, SectionOrigin, "benediction"},
+		{5, SectionOrigin, "chronic"},
+	}, got)
+	assert.Equal(t, "Fame is ______.", sheet.Items[0].MaskedContext)
+	assert.Equal(t, "bene", sheet.Items[3].Origin)
+}
+
+func TestItem_QuizItem(t *testing.T) {
+	for _, want := range testQuizItems() {
+		sheet := NewSheet("id", testCreatedAt, testDueBy, []quiz.WorksheetItem{want})
+		got := sheet.Items[0].QuizItem()
+		assert.Equal(t, want.QuizType, got.QuizType)
+		assert.Equal(t, want.Card.ID, got.Card.ID)
+		assert.Equal(t, want.Card.NotebookName, got.Card.NotebookName)
+		assert.Equal(t, want.Card.StoryTitle, got.Card.StoryTitle)
+		assert.Equal(t, want.Card.Expression, got.Card.Expression)
+		assert.Equal(t, want.Context, got.Context)
+		assert.Equal(t, want.Origin.Origin, got.Origin.Origin)
+	}
+}
+
+func TestSheet_Grade(t *testing.T) {
+	tests := []struct {
+		name    string
+		wrong   []int
+		skipped []int
+		want    []Result
+		wantErr string
+	}{
+		{
+			name: "all correct",
+			want: []Result{ResultCorrect, ResultCorrect, ResultCorrect, ResultCorrect, ResultCorrect},
+		},
+		{
+			name:    "wrong and skipped",
+			wrong:   []int{2, 5},
+			skipped: []int{3},
+			want:    []Result{ResultCorrect, ResultWrong, ResultSkipped, ResultCorrect, ResultWrong},
+		},
+		{
+			name:    "unknown number",
+			wrong:   []int{6},
+			wantErr: "question 6 is not on worksheet",
+		},
+		{
+			name:    "listed twice",
+			wrong:   []int{2},
+			skipped: []int{2},
+			wantErr: "question 2 is listed more than once",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sheet := NewSheet("worksheet-2026-03-01", testCreatedAt, testDueBy, testQuizItems())
+			gradedAt := testDueBy
+			err := sheet.Grade(tt.wrong, tt.skipped, gradedAt)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Nil(t, sheet.GradedAt)
+				return
+			}
+			require.NoError(t, err)
+
+			var got []Result
+			for _, item := range sheet.Items {
+				got = append(got, item.Result)
+			}
+			assert.Equal(t, tt.want, got)
+			require.NotNil(t, sheet.GradedAt)
+			assert.Equal(t, gradedAt, *sheet.GradedAt)
+		})
+	}
+}
+
+func TestSheet_Unrecorded(t *testing.T) {
+	sheet := NewSheet("worksheet-2026-03-01", testCreatedAt, testDueBy, testQuizItems())
+	assert.Empty(t, sheet.Unrecorded(), "nothing is graded yet")
+
+	require.NoError(t, sheet.Grade([]int{2}, []int{3}, testDueBy))
+	sheet.Items[0].Recorded = true
+	var numbers []int
+	for _, item := range sheet.Unrecorded() {
+		numbers = append(numbers, item.Number)
+	}
+	assert.Equal(t, []int{2, 4, 5}, numbers)
+}
@@ -0,0 +1,118 @@
+package worksheet
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/at-ishikawa/langner/internal/pdf"
+)
+
+// Store keeps worksheets in a directory: <id>.yml for grading, and the
+// printable <id>.md and <id>-answers.md (plus PDFs when requested).
+type Store struct {
+	directory  string
+	pdfOptions pdf.Options
+}
+
+// NewStore returns a Store that keeps worksheets in directory.
+func NewStore(directory string) *Store {
+	return &Store{directory: directory}
+}
+
+// SetPDFOptions sets the fonts used when Output generates PDFs.
+func (s *Store) SetPDFOptions(opts pdf.Options) {
+	s.pdfOptions = opts
+}
+
+// NextID returns an unused sheet id for day: worksheet-YYYY-MM-DD, followed
+// by -2, -3, ... when that day already has worksheets.
+func (s *Store) NextID(day time.Time) (string, error) {
+	base := "worksheet-" + day.Format("2006-01-02")
+	id := base
+	for n := 2; ; n++ {
+		_, err := os.Stat(s.sheetPath(id))
+		if errors.Is(err, os.ErrNotExist) {
+			return id, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("os.Stat(%s) > %w", s.sheetPath(id), err)
+		}
+		id = fmt.Sprintf("%s-%d", base, n)
+	}
+}
+
+// Output saves sheet and writes its questions and answer key as markdown,
+// converting both to PDF when generatePDF is set. It returns the paths of
+// the printable files.
+func (s *Store) Output(sheet Sheet, generatePDF bool) ([]string, error) {
+	if err := s.Save(sheet); err != nil {
+		return nil, err
+	}
+
+	files := []struct {
+		path string
+		body string
+	}{
+		{filepath.Join(s.directory, sheet.ID+".md"), RenderQuestions(sheet)},
+		{filepath.Join(s.directory, sheet.ID+"-answers.md"), RenderAnswerKey(sheet)},
+	}
+	var written []string
+	for _, file := range files {
+		if err := os.WriteFile(file.path, []byte(file.body), 0o644); err != nil {
+			return written, fmt.Errorf("write %s: %w", file.path, err)
+		}
+		written = append(written, file.path)
+		if !generatePDF {
+			continue
+		}
+		pdfPath, err := pdf.ConvertMarkdownToPDF(file.path, s.pdfOptions)
+		if err != nil {
+			return written, fmt.Errorf("ConvertMarkdownToPDF(%s): %w", file.path, err)
+		}
+		written = append(written, pdfPath)
+	}
+	return written, nil
+}
+
+// Save writes sheet to <id>.yml.
+func (s *Store) Save(sheet Sheet) error {
+	if s.directory == "" {
+		return fmt.Errorf("output directory is empty")
+	}
+	if err := os.MkdirAll(s.directory, 0o755); err != nil {
+		return fmt.Errorf("mkdir %s: %w", s.directory, err)
+	}
+	data, err := yaml.Marshal(sheet)
+	if err != nil {
+		return fmt.Errorf("yaml.Marshal(%s) > %w", sheet.ID, err)
+	}
+	if err := os.WriteFile(s.sheetPath(sheet.ID), data, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", s.sheetPath(sheet.ID), err)
+	}
+	return nil
+}
+
+// Load reads the worksheet with id.
+func (s *Store) Load(id string) (Sheet, error) {
+	data, err := os.ReadFile(s.sheetPath(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return Sheet{}, fmt.Errorf("worksheet %s not found in %s", id, s.directory)
+	}
+	if err != nil {
+		return Sheet{}, fmt.Errorf("os.ReadFile(%s) > %w", s.sheetPath(id), err)
+	}
+	var sheet Sheet
+	if err := yaml.Unmarshal(data, &sheet); err != nil {
+		return Sheet{}, fmt.Errorf("yaml.Unmarshal(%s) > %w", s.sheetPath(id), err)
+	}
+	return sheet, nil
+}
+
+func (s *Store) sheetPath(id string) string {
+	return filepath.Join(s.directory, filepath.Base(id)+".yml")
+}
@@ -0,0 +1,77 @@
+package worksheet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_NextID(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir)
+
+	id, err := store.NextID(testCreatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, "worksheet-2026-03-01", id)
+
+	require.NoError(t, store.Save(Sheet{ID: id}))
+	id, err = store.NextID(testCreatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, "worksheet-2026-03-01-2", id)
+
+	require.NoError(t, store.Save(Sheet{ID: id}))
+	id, err = store.NextID(testCreatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, "worksheet-2026-03-01-3", id)
+}
+
+func TestStore_OutputAndLoad(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "worksheet")
+	store := NewStore(dir)
+	sheet := NewSheet("worksheet-2026-03-01", testCreatedAt, testDueBy, testQuizItems())
+
+	written, err := store.Output(sheet, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "worksheet-2026-03-01.md"),
+		filepath.Join(dir, "worksheet-2026-03-01-answers.md"),
+	}, written)
+	questions, err := os.ReadFile(written[0])
+	require.NoError(t, err)
+	assert.Equal(t, RenderQuestions(sheet), string(questions))
+
+	loaded, err := store.Load(sheet.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sheet, loaded)
+
+	require.NoError(t, sheet.Grade([]int{2}, nil, testDueBy))
+	require.NoError(t, store.Save(sheet))
+	loaded, err = store.Load(sheet.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sheet, loaded)
+}
+
+func TestStore_OutputPDF(t *testing.T) {
+	store := NewStore(t.TempDir())
+	sheet := NewSheet("worksheet-2026-03-01", testCreatedAt, testDueBy, testQuizItems())
+
+	written, err := store.Output(sheet, true)
+	require.NoError(t, err)
+	require.Len(t, written, 4)
+	for _, path := range written {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.NotZero(t, info.Size(), path)
+	}
+	assert.Equal(t, ".pdf", filepath.Ext(written[1]))
+	assert.Equal(t, ".pdf", filepath.Ext(written[3]))
+}
+
+func TestStore_Load_NotFound(t *testing.T) {
+	_, err := NewStore(t.TempDir()).Load("worksheet-2026-03-01")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "worksheet worksheet-2026-03-01 not found")
+}
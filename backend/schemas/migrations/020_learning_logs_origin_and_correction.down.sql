@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_learning_logs_correction_id;
+DROP INDEX IF EXISTS idx_learning_logs_origin;
+
+ALTER TABLE learning_logs
+    DROP COLUMN IF EXISTS correction_id,
+    DROP COLUMN IF EXISTS origin_sense,
+    DROP COLUMN IF EXISTS origin;
@@ -0,0 +1,15 @@
+-- Key etymology-origin and grammar learning logs by what they test, not only
+-- by the note they hang off.
+--
+-- origin / origin_sense identify the etymology origin (and, for same-session
+-- multi-sense origins, which sense) an etymology_origin log was answered
+-- under. correction_id is the grammar correction a grammar log drilled. All
+-- three default to '' for vocabulary logs and for rows imported before this
+-- migration; the next `langner migrate import-db` backfills them from YAML.
+ALTER TABLE learning_logs
+    ADD COLUMN origin VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN origin_sense VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN correction_id VARCHAR(128) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_learning_logs_origin ON learning_logs (origin, origin_sense) WHERE origin <> '';
+CREATE INDEX idx_learning_logs_correction_id ON learning_logs (correction_id) WHERE correction_id <> '';
@@ -0,0 +1,4 @@
+ALTER TABLE learning_logs
+    DROP COLUMN IF EXISTS grader,
+    DROP COLUMN IF EXISTS grader_reason,
+    DROP COLUMN IF EXISTS answer;
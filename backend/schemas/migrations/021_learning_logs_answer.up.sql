@@ -0,0 +1,11 @@
+-- Keep what the learner answered and why it was graded the way it was.
+--
+-- answer is the learner's typed (or transcribed) answer, grader_reason the
+-- explanation the grader gave, and grader who graded it: 'model',
+-- 'deterministic' (blank answers, word-by-word comparisons) or 'override'
+-- (the learner marked the answer correct or wrong afterwards). All three
+-- default to '' for rows recorded before this migration.
+ALTER TABLE learning_logs
+    ADD COLUMN answer TEXT NOT NULL DEFAULT '',
+    ADD COLUMN grader_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN grader VARCHAR(32) NOT NULL DEFAULT '';
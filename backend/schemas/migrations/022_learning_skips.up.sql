@@ -0,0 +1,17 @@
+-- learning_skips records that a note was excluded from one quiz type in one
+-- notebook, the per-quiz-type skip the YAML learning notes keep in
+-- skipped_at. notes.skipped_at (004) predates per-quiz-type skipping and
+-- can't express it, so it stays unused. One row per (note, notebook, quiz
+-- type); resuming deletes the row.
+CREATE TABLE learning_skips (
+    note_id BIGINT NOT NULL,
+    notebook_id VARCHAR(255) NOT NULL,
+    quiz_type VARCHAR(50) NOT NULL,
+    skipped_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (note_id, notebook_id, quiz_type),
+    FOREIGN KEY (note_id) REFERENCES notes(id)
+);
+CREATE TRIGGER learning_skips_set_updated_at BEFORE UPDATE ON learning_skips
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
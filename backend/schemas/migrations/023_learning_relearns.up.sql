@@ -0,0 +1,16 @@
+-- learning_relearns records that a leech was moved into the relearn pool of
+-- one quiz type in one notebook, the database form of the YAML learning
+-- notes' relearn_at. One row per (note, notebook, quiz type); the next
+-- correct answer in that quiz type, or resuming it, deletes the row.
+CREATE TABLE learning_relearns (
+    note_id BIGINT NOT NULL,
+    notebook_id VARCHAR(255) NOT NULL,
+    quiz_type VARCHAR(50) NOT NULL,
+    relearn_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (note_id, notebook_id, quiz_type),
+    FOREIGN KEY (note_id) REFERENCES notes(id)
+);
+CREATE TRIGGER learning_relearns_set_updated_at BEFORE UPDATE ON learning_relearns
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
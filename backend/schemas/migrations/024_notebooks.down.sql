@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS notebook_scenes;
+DROP TABLE IF EXISTS notebooks;
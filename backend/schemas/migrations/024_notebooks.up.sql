@@ -0,0 +1,35 @@
+-- notebooks keeps what a notebook carries besides its notes, so postgres
+-- storage mode can serve notebooks without the YAML files: the display name
+-- of the notebook's index.yml, and the prose of each story scene
+-- (conversations and statements) that dictation, passage search and quiz
+-- context examples read. import-db replaces both tables on every run.
+CREATE TABLE notebooks (
+    notebook_type VARCHAR(50) NOT NULL,
+    notebook_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (notebook_type, notebook_id)
+);
+CREATE TRIGGER notebooks_set_updated_at BEFORE UPDATE ON notebooks
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- notebook_scenes holds one row per scene, named like notebook_notes by
+-- ("group", subgroup) = (event, scene title). sort_order keeps the scenes
+-- in the order of the source notebook.
+CREATE TABLE notebook_scenes (
+    id BIGSERIAL PRIMARY KEY,
+    notebook_type VARCHAR(50) NOT NULL,
+    notebook_id VARCHAR(255) NOT NULL,
+    "group" VARCHAR(255) NOT NULL,
+    subgroup TEXT NOT NULL,
+    sort_order INT NOT NULL,
+    conversations JSONB NOT NULL DEFAULT '[]',
+    statements JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (notebook_type, notebook_id) REFERENCES notebooks(notebook_type, notebook_id)
+);
+CREATE INDEX idx_notebook_scenes_type_id ON notebook_scenes (notebook_type, notebook_id, sort_order);
+CREATE TRIGGER notebook_scenes_set_updated_at BEFORE UPDATE ON notebook_scenes
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
@@ -1,10 +1,13 @@
 import { render, screen, waitFor, fireEvent, within } from "@testing-library/react";
 import { ChakraProvider, defaultSystem } from "@chakra-ui/react";
 import { describe, it, expect, vi, beforeEach } from "vitest";
+import { Code, ConnectError } from "@connectrpc/connect";
 import TrendsPage from "./page";
 
 const getTrends = vi.fn();
 const getQuizOptions = vi.fn();
+const getConfusions = vi.fn();
+const getFrequencyCoverage = vi.fn();
 
 const pushMock = vi.fn();
 vi.mock("next/navigation", () => ({
@@ -12,7 +15,11 @@ vi.mock("next/navigation", () => ({
 }));
 
 vi.mock("@/lib/client", () => ({
-  analyticsClient: { getTrends: (...args: unknown[]) => getTrends(...args) },
+  analyticsClient: {
+    getTrends: (...args: unknown[]) => getTrends(...args),
+    getConfusions: (...args: unknown[]) => getConfusions(...args),
+    getFrequencyCoverage: (...args: unknown[]) => getFrequencyCoverage(...args),
+  },
   quizClient: { getQuizOptions: (...args: unknown[]) => getQuizOptions(...args) },
   Granularity: { UNSPECIFIED: 0, DAY: 1, WEEK: 2, MONTH: 3 },
   TrendGroupBy: { UNSPECIFIED: 0, QUIZ_TYPE: 1, NOTEBOOK: 2, STATUS: 3, LEVEL: 4 },
@@ -46,6 +53,10 @@ describe("TrendsPage", () => {
   beforeEach(() => {
     getTrends.mockReset().mockResolvedValue(trendsResponse());
     getQuizOptions.mockReset().mockResolvedValue({ notebooks: [{ notebookId: "flashcards", name: "Flashcards" }] });
+    getConfusions.mockReset().mockResolvedValue({ pairs: [] });
+    getFrequencyCoverage
+      .mockReset()
+      .mockRejectedValue(new ConnectError("no frequency list is configured", Code.FailedPrecondition));
   });
 
   it("renders KPI summary, backlog, and the chart", async () => {
@@ -75,4 +86,49 @@ describe("TrendsPage", () => {
       expect(last.groupBy).toBe(4); // TrendGroupBy.LEVEL
     });
   });
+
+  it("lists confusion pairs and links the unresolved ones to the drill", async () => {
+    getConfusions.mockResolvedValue({
+      pairs: [
+        {
+          notebookId: "flashcards",
+          notebookTitle: "Flashcards",
+          words: [
+            { senseId: "s1", expression: "affect", meaning: "to influence", example: "" },
+            { senseId: "s2", expression: "effect", meaning: "a result", example: "" },
+          ],
+          count: 2,
+          lastConfusedDate: "2026-06-02",
+          quizTypes: ["notebook"],
+          answers: ["a result"],
+          resolved: false,
+        },
+      ],
+    });
+    renderPage();
+
+    const panel = await screen.findByTestId("confusions");
+    expect(within(panel).getByText("affect ↔ effect")).toBeInTheDocument();
+    expect(within(panel).getByText("2×")).toBeInTheDocument();
+    expect(within(panel).getByText("Drill 1 pair")).toBeInTheDocument();
+  });
+
+  it("shows the coverage of the frequency list", async () => {
+    getFrequencyCoverage.mockResolvedValue({ topN: 1000, studied: 250, known: 200, missingWords: ["the", "of"] });
+    renderPage();
+
+    const panel = await screen.findByTestId("frequency-coverage");
+    expect(within(panel).getByText("250 / 1000 (25%)")).toBeInTheDocument();
+    expect(within(panel).getByText("200 / 1000 (20%)")).toBeInTheDocument();
+    expect(within(panel).getByText("the")).toBeInTheDocument();
+    expect(getFrequencyCoverage).toHaveBeenCalledWith(expect.objectContaining({ missingLimit: 30 }));
+  });
+
+  it("hides the frequency coverage when no list is configured", async () => {
+    renderPage();
+
+    await waitFor(() => expect(getFrequencyCoverage).toHaveBeenCalled());
+    expect(screen.queryByTestId("frequency-coverage")).not.toBeInTheDocument();
+    expect(screen.queryByTestId("frequency-coverage-error")).not.toBeInTheDocument();
+  });
 });
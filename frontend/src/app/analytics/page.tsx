@@ -17,6 +17,8 @@ import {
 } from "@chakra-ui/react";
 import { QUIZ_TYPE_OPTIONS } from "@/components/AnalyticsFilterBar";
 import { TrendChart, type TrendMetric } from "@/components/TrendChart";
+import { ConfusionsPanel } from "@/components/ConfusionsPanel";
+import { FrequencyCoveragePanel } from "@/components/FrequencyCoveragePanel";
 import {
   analyticsClient,
   quizClient,
@@ -354,6 +356,9 @@ export default function AnalyticsOverviewPage() {
           />
         </Box>
       )}
+
+      <ConfusionsPanel notebookId={notebookId} quizType={quizType} />
+      <FrequencyCoveragePanel notebookId={notebookId} quizType={quizType} />
     </Box>
   );
 }
@@ -24,6 +24,7 @@ import {
   type EtymologyOriginPart,
   type EtymologyDefinition,
 } from "@/lib/client";
+import { FrequencyBadge } from "@/components/FrequencyBadge";
 import { LearningStatusBadge } from "@/components/LearningStatusBadge";
 import { PdfPreviewModal } from "@/components/PdfPreviewModal";
 import { formatReviewDate } from "@/lib/formatReviewDate";
@@ -61,6 +62,25 @@ const SKIPPED_TYPE_LABELS: Record<string, string> = {
   etymology_freeform: "Etym. FF",
 };
 
+// LeechBadge flags a word that keeps failing in at least one quiz type.
+function LeechBadge({ types }: { types: string[] }) {
+  const labels = (types ?? []).map((t) => SKIPPED_TYPE_LABELS[t] ?? t);
+  return (
+    <Box
+      bg="orange.100"
+      _dark={{ bg: "orange.900" }}
+      px={2}
+      py={0.5}
+      borderRadius="sm"
+      title={labels.length > 0 ? `Keeps failing: ${labels.join(", ")}` : undefined}
+    >
+      <Text fontSize="xs" color="orange.700" _dark={{ color: "orange.200" }}>
+        Leech
+      </Text>
+    </Box>
+  );
+}
+
 function SkippedTypeBadges({ types }: { types: string[] }) {
   // Fallback to a generic "Skipped" badge if the backend didn't supply
   // per-type info (older clients) or if every entry is unrecognised.
@@ -764,6 +784,10 @@ function WordCard({
           <Text fontWeight="semibold" flex="1">
             {isConcept ? word.conceptHead : word.expression}
           </Text>
+          {word.frequencyBand && (
+            <FrequencyBadge band={word.frequencyBand} rank={word.frequencyRank} />
+          )}
+          {word.isLeech && <LeechBadge types={word.leechQuizTypes} />}
           {isSkippedAnywhere ? (
             <SkippedTypeBadges types={Array.from(skippedTypes)} />
           ) : (
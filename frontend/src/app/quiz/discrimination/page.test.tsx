@@ -0,0 +1,95 @@
+import { describe, it, expect, vi, beforeEach } from "vitest";
+import { render, screen, fireEvent } from "@testing-library/react";
+import { ChakraProvider, defaultSystem } from "@chakra-ui/react";
+import DiscriminationDrillPage from "./page";
+
+const getConfusions = vi.fn();
+const submitDiscriminationAnswer = vi.fn();
+vi.mock("@/lib/client", () => ({
+  analyticsClient: { getConfusions: (...args: unknown[]) => getConfusions(...args) },
+  quizClient: {
+    submitDiscriminationAnswer: (...args: unknown[]) => submitDiscriminationAnswer(...args),
+  },
+}));
+vi.mock("next/link", () => ({
+  default: ({ children, ...props }: { children: React.ReactNode; href: string }) => (
+    <a {...props}>{children}</a>
+  ),
+}));
+
+function renderPage() {
+  return render(
+    <ChakraProvider value={defaultSystem}>
+      <DiscriminationDrillPage />
+    </ChakraProvider>,
+  );
+}
+
+const pair = {
+  notebookId: "flashcards",
+  notebookTitle: "Flashcards",
+  words: [
+    { senseId: "s1", expression: "affect", meaning: "to influence", example: "It affects us." },
+    { senseId: "s2", expression: "effect", meaning: "a result", example: "" },
+  ],
+  count: 2,
+  lastConfusedDate: "2026-06-02",
+  quizTypes: ["notebook"],
+  answers: ["a result"],
+  resolved: false,
+};
+
+describe("DiscriminationDrillPage", () => {
+  beforeEach(() => {
+    getConfusions.mockReset();
+    submitDiscriminationAnswer.mockReset();
+    submitDiscriminationAnswer.mockImplementation(({ correct }: { correct: boolean }) =>
+      Promise.resolve({ movedToRelearnPool: !correct }),
+    );
+  });
+
+  it("asks for only the unresolved pairs", async () => {
+    getConfusions.mockResolvedValue({ pairs: [] });
+    renderPage();
+    expect(await screen.findByText("No confused words to drill.")).toBeInTheDocument();
+    expect(getConfusions.mock.calls[0][0]).toMatchObject({ unresolvedOnly: true });
+  });
+
+  it("shows both words side by side and reveals both meanings after a choice", async () => {
+    getConfusions.mockResolvedValue({ pairs: [pair] });
+    renderPage();
+
+    const meaning = await screen.findByTestId("drill-meaning");
+    const target = meaning.textContent === "to influence" ? "affect" : "effect";
+    const other = target === "affect" ? "effect" : "affect";
+    expect(screen.getByRole("button", { name: "Choose affect" })).toBeInTheDocument();
+    expect(screen.getByRole("button", { name: "Choose effect" })).toBeInTheDocument();
+
+    fireEvent.click(screen.getByRole("button", { name: `Choose ${other}` }));
+    expect(screen.getByText(`It was “${target}”`)).toBeInTheDocument();
+    expect(submitDiscriminationAnswer).toHaveBeenCalledWith({
+      notebookId: "flashcards",
+      words: [
+        { senseId: "s1", expression: "affect" },
+        { senseId: "s2", expression: "effect" },
+      ],
+      quizTypes: ["notebook"],
+      correct: false,
+    });
+    expect(await screen.findByText("The pair is back in the Relearn Quiz.")).toBeInTheDocument();
+    expect(screen.getByText("to influence")).toBeInTheDocument();
+    expect(screen.getByText("a result")).toBeInTheDocument();
+    expect(screen.getByText("It affects us.")).toBeInTheDocument();
+
+    fireEvent.click(screen.getByRole("button", { name: "Next" }));
+    const second = screen.getByTestId("drill-meaning").textContent;
+    const secondTarget = second === "to influence" ? "affect" : "effect";
+    fireEvent.click(screen.getByRole("button", { name: `Choose ${secondTarget}` }));
+    expect(screen.getByText("Correct")).toBeInTheDocument();
+    expect(submitDiscriminationAnswer.mock.calls[1][0]).toMatchObject({ correct: true });
+
+    fireEvent.click(screen.getByRole("button", { name: "Next" }));
+    expect(screen.getByTestId("drill-complete")).toHaveTextContent("1 / 2 correct");
+    expect(screen.getByTestId("drill-relearn")).toHaveTextContent("1 missed pair is back in the Relearn Quiz.");
+  });
+});
@@ -0,0 +1,214 @@
+"use client";
+
+import { useEffect, useMemo, useState } from "react";
+import Link from "next/link";
+import { Box, Button, Heading, SimpleGrid, Spinner, Text, VStack } from "@chakra-ui/react";
+import { analyticsClient, quizClient, type ConfusionPair, type ConfusionWord } from "@/lib/client";
+
+// A question shows one word's meaning and asks which of the pair it belongs
+// to. Every unresolved pair is asked once per word.
+type Question = {
+  pair: ConfusionPair;
+  target: number;
+};
+
+function shuffle<T>(items: T[]): T[] {
+  const out = [...items];
+  for (let i = out.length - 1; i > 0; i--) {
+    const j = Math.floor(Math.random() * (i + 1));
+    [out[i], out[j]] = [out[j], out[i]];
+  }
+  return out;
+}
+
+function WordCard({
+  word,
+  revealed,
+  correct,
+  chosen,
+  onChoose,
+}: {
+  word: ConfusionWord;
+  revealed: boolean;
+  correct: boolean;
+  chosen: boolean;
+  onChoose: () => void;
+}) {
+  let borderColor = "border";
+  if (revealed && correct) borderColor = "green.500";
+  else if (revealed && chosen) borderColor = "red.500";
+  return (
+    <Box
+      as="button"
+      textAlign="left"
+      borderWidth="2px"
+      borderColor={borderColor}
+      borderRadius="lg"
+      p={4}
+      onClick={onChoose}
+      disabled={revealed}
+      aria-label={`Choose ${word.expression}`}
+    >
+      <Text fontSize="xl" fontWeight="bold">{word.expression}</Text>
+      {revealed && (
+        <VStack align="stretch" gap={1} mt={2}>
+          <Text fontSize="sm">{word.meaning}</Text>
+          {word.example && (
+            <Text fontSize="xs" color="fg.muted" fontStyle="italic">{word.example}</Text>
+          )}
+        </VStack>
+      )}
+    </Box>
+  );
+}
+
+// DiscriminationDrillPage drills the confusion pairs found in the learner's
+// wrong answers: both words side by side, one meaning at a time. Every answer
+// is submitted; a miss puts the pair's words back in the Relearn Quiz pool,
+// and the pair resolves once both words are answered correctly there or in
+// the regular quizzes.
+export default function DiscriminationDrillPage() {
+  const [pairs, setPairs] = useState<ConfusionPair[] | null>(null);
+  const [error, setError] = useState<string | null>(null);
+  const [index, setIndex] = useState(0);
+  const [chosen, setChosen] = useState<number | null>(null);
+  const [correctCount, setCorrectCount] = useState(0);
+  const [relearnCount, setRelearnCount] = useState(0);
+  const [moved, setMoved] = useState(false);
+  const [submitError, setSubmitError] = useState<string | null>(null);
+
+  useEffect(() => {
+    const notebookId = new URLSearchParams(window.location.search).get("notebook") ?? "";
+    analyticsClient
+      .getConfusions({ filters: { notebookId }, unresolvedOnly: true })
+      .then((res) => setPairs(res.pairs.filter((p) => p.words.length === 2)))
+      .catch((e) => setError(e instanceof Error ? e.message : String(e)));
+  }, []);
+
+  const questions = useMemo<Question[]>(
+    () => shuffle((pairs ?? []).flatMap((pair) => [0, 1].map((target) => ({ pair, target })))),
+    [pairs],
+  );
+
+  const header = (
+    <>
+      <Link href="/quiz">
+        <Text color="blue.600" _dark={{ color: "blue.300" }} fontSize="xs">&lt; Quiz</Text>
+      </Link>
+      <Heading size="md" mt={1} mb={4}>Discrimination drill</Heading>
+    </>
+  );
+
+  if (error) {
+    return (
+      <Box maxW="2xl" mx="auto" p={4}>
+        {header}
+        <Text color="red.500">Failed to load confusions: {error}</Text>
+      </Box>
+    );
+  }
+  if (pairs === null) {
+    return (
+      <Box maxW="2xl" mx="auto" p={4}>
+        {header}
+        <Spinner />
+      </Box>
+    );
+  }
+  if (questions.length === 0) {
+    return (
+      <Box maxW="2xl" mx="auto" p={4}>
+        {header}
+        <Text color="fg.muted">No confused words to drill.</Text>
+      </Box>
+    );
+  }
+  if (index >= questions.length) {
+    return (
+      <Box maxW="2xl" mx="auto" p={4}>
+        {header}
+        <Text fontSize="lg" fontWeight="semibold" mb={2} data-testid="drill-complete">
+          {correctCount} / {questions.length} correct
+        </Text>
+        {relearnCount > 0 && (
+          <Text mb={2} data-testid="drill-relearn">
+            {relearnCount} missed {relearnCount === 1 ? "pair is" : "pairs are"} back in the Relearn Quiz.
+          </Text>
+        )}
+        <Text color="fg.muted" mb={4}>
+          Pairs clear from the drill once both words are answered correctly in the Relearn Quiz or the regular quizzes.
+        </Text>
+        <Link href="/analytics">
+          <Button variant="outline">Back to analytics</Button>
+        </Link>
+      </Box>
+    );
+  }
+
+  const { pair, target } = questions[index];
+  const revealed = chosen !== null;
+  const choose = (i: number) => {
+    if (revealed) return;
+    const correct = i === target;
+    setChosen(i);
+    if (correct) setCorrectCount((n) => n + 1);
+    quizClient
+      .submitDiscriminationAnswer({
+        notebookId: pair.notebookId,
+        words: pair.words.map((w) => ({ senseId: w.senseId, expression: w.expression })),
+        quizTypes: pair.quizTypes,
+        correct,
+      })
+      .then((res) => {
+        if (!res.movedToRelearnPool) return;
+        setMoved(true);
+        setRelearnCount((n) => n + 1);
+      })
+      .catch((e) => setSubmitError(e instanceof Error ? e.message : String(e)));
+  };
+  const next = () => {
+    setChosen(null);
+    setMoved(false);
+    setSubmitError(null);
+    setIndex((i) => i + 1);
+  };
+
+  return (
+    <Box maxW="2xl" mx="auto" p={4}>
+      {header}
+      <Text fontSize="xs" color="fg.muted" mb={1}>
+        {index + 1} / {questions.length} · {pair.notebookTitle || pair.notebookId}
+      </Text>
+      <Text fontSize="sm" mb={1}>Which word means</Text>
+      <Text fontSize="lg" fontWeight="semibold" mb={4} data-testid="drill-meaning">
+        {pair.words[target].meaning}
+      </Text>
+      <SimpleGrid columns={2} gap={3} mb={4}>
+        {pair.words.map((word, i) => (
+          <WordCard
+            key={word.senseId || word.expression}
+            word={word}
+            revealed={revealed}
+            correct={i === target}
+            chosen={chosen === i}
+            onChoose={() => choose(i)}
+          />
+        ))}
+      </SimpleGrid>
+      {revealed && (
+        <VStack align="stretch" gap={3}>
+          <Text fontWeight="semibold" color={chosen === target ? "green.600" : "red.600"}>
+            {chosen === target ? "Correct" : `It was “${pair.words[target].expression}”`}
+          </Text>
+          {moved && (
+            <Text fontSize="sm" color="fg.muted">The pair is back in the Relearn Quiz.</Text>
+          )}
+          {submitError && (
+            <Text fontSize="sm" color="red.500">Failed to save the answer: {submitError}</Text>
+          )}
+          <Button colorPalette="blue" onClick={next}>Next</Button>
+        </VStack>
+      )}
+    </Box>
+  );
+}
@@ -110,6 +110,7 @@ function summary(overrides: Record<string, unknown>) {
     etymologyReverseReviewCount: 0,
     grammarReviewCount: 0,
     vocabularyCount: 0,
+    frequentReviewCount: 0,
     hasContent: false,
     sections: [],
     ...overrides,
@@ -167,3 +168,43 @@ describe("QuizHubPage vocab-tab filtering by vocabularyCount", () => {
     expect(screen.queryByText("Empty Journal")).not.toBeInTheDocument();
   });
 });
+
+describe("QuizHubPage frequent review count", () => {
+  const notebooks = [
+    summary({
+      notebookId: "vocab",
+      name: "Vocab",
+      kind: "Flashcard",
+      reviewCount: 5,
+      reverseReviewCount: 4,
+      vocabularyCount: 5,
+      frequentReviewCount: 2,
+    }),
+  ];
+
+  beforeEach(() => {
+    vi.mocked(quizClient.getQuizOptions).mockResolvedValue({ notebooks } as never);
+  });
+
+  afterEach(() => {
+    vi.mocked(quizClient.getQuizOptions).mockResolvedValue({ notebooks: [] } as never);
+  });
+
+  it("shows how many due words are frequent in the standard quiz", async () => {
+    renderPage();
+    await waitFor(() => expect(screen.getByText("Standard")).toBeInTheDocument());
+    fireEvent.click(screen.getByText("Standard"));
+
+    await waitFor(() => expect(screen.getByText("Vocab")).toBeInTheDocument());
+    expect(screen.getByText("(2 frequent)")).toBeInTheDocument();
+  });
+
+  it("hides the frequent count in the reverse quiz", async () => {
+    renderPage();
+    await waitFor(() => expect(screen.getByText("Reverse")).toBeInTheDocument());
+    fireEvent.click(screen.getByText("Reverse"));
+
+    await waitFor(() => expect(screen.getByText("Vocab")).toBeInTheDocument());
+    expect(screen.queryByText("(2 frequent)")).not.toBeInTheDocument();
+  });
+});
@@ -541,6 +541,19 @@ export default function QuizHubPage() {
                               </Text>
                               <Text color="gray.500" fontSize="sm">
                                 {pickModeCount(notebook)}
+                                {selectedVocabMode === "standard" &&
+                                  notebook.frequentReviewCount > 0 && (
+                                    <Text
+                                      as="span"
+                                      color="blue.600"
+                                      _dark={{ color: "blue.300" }}
+                                      fontSize="xs"
+                                      ml={1}
+                                      title="Very common or common words due for review"
+                                    >
+                                      ({notebook.frequentReviewCount} frequent)
+                                    </Text>
+                                  )}
                               </Text>
                             </Box>
                           </Checkbox.Label>
@@ -0,0 +1,91 @@
+"use client";
+
+import { useEffect, useState } from "react";
+import Link from "next/link";
+import { Badge, Box, Button, Flex, HStack, Text, VStack } from "@chakra-ui/react";
+import { analyticsClient, type ConfusionPair } from "@/lib/client";
+
+// ConfusionsPanel lists the word pairs the learner mixes up under the current
+// analytics filters, unresolved first, and links the unresolved ones to the
+// discrimination drill.
+export function ConfusionsPanel({ notebookId, quizType }: { notebookId: string; quizType: string }) {
+  const [pairs, setPairs] = useState<ConfusionPair[] | null>(null);
+  const [error, setError] = useState<string | null>(null);
+
+  useEffect(() => {
+    let cancelled = false;
+    setPairs(null);
+    analyticsClient
+      .getConfusions({ filters: { notebookId, quizType } })
+      .then((res) => {
+        if (!cancelled) {
+          setPairs(res.pairs);
+          setError(null);
+        }
+      })
+      .catch((e) => {
+        if (!cancelled) setError(e instanceof Error ? e.message : String(e));
+      });
+    return () => {
+      cancelled = true;
+    };
+  }, [notebookId, quizType]);
+
+  if (error) {
+    return <Text color="red.500" data-testid="confusions-error">Failed to load confusions: {error}</Text>;
+  }
+  if (pairs === null || pairs.length === 0) {
+    return null;
+  }
+
+  const unresolved = pairs.filter((p) => !p.resolved).length;
+  const sp = new URLSearchParams();
+  if (notebookId) sp.set("notebook", notebookId);
+  const drillHref = `/quiz/discrimination${sp.toString() ? `?${sp.toString()}` : ""}`;
+
+  return (
+    <Box borderWidth="1px" borderColor="border" borderRadius="lg" p={4} mt={5} data-testid="confusions">
+      <Flex justify="space-between" align="center" mb={3} gap={2}>
+        <Box>
+          <Text fontSize="sm" fontWeight="semibold">Confused words</Text>
+          <Text fontSize="xs" color="fg.muted">
+            Wrong answers that matched another word of the same notebook
+          </Text>
+        </Box>
+        {unresolved > 0 && (
+          <Link href={drillHref}>
+            <Button size="xs" colorPalette="blue">Drill {unresolved} {unresolved === 1 ? "pair" : "pairs"}</Button>
+          </Link>
+        )}
+      </Flex>
+      <VStack align="stretch" gap={2}>
+        {pairs.map((p) => (
+          <HStack
+            key={`${p.notebookId}:${p.words.map((w) => w.senseId || w.expression).join(":")}`}
+            justify="space-between"
+            borderTopWidth="1px"
+            borderColor="border"
+            pt={2}
+            gap={3}
+          >
+            <Box minW={0}>
+              <Text fontWeight="medium">
+                {p.words.map((w) => w.expression).join(" ↔ ")}
+              </Text>
+              <Text fontSize="xs" color="fg.muted" truncate>
+                {p.notebookTitle || p.notebookId}
+                {p.answers.length > 0 && ` · answered “${p.answers.join("”, “")}”`}
+              </Text>
+            </Box>
+            <HStack gap={2} flexShrink={0}>
+              <Text fontSize="xs" color="fg.muted">{p.lastConfusedDate}</Text>
+              <Badge colorPalette={p.resolved ? "green" : "orange"}>
+                {p.resolved ? "resolved" : `${p.count}×`}
+              </Badge>
+            </HStack>
+          </HStack>
+        ))}
+      </VStack>
+    </Box>
+  );
+}
@@ -0,0 +1,34 @@
+import { Box, Text } from "@chakra-ui/react";
+
+const FREQUENCY_BAND_LABELS: Record<string, string> = {
+  very_common: "Very common",
+  common: "Common",
+  uncommon: "Uncommon",
+  rare: "Rare",
+};
+
+// isFrequentBand reports whether a word sits in the part of the frequency list
+// that is worth learning first.
+export function isFrequentBand(band: string): boolean {
+  return band === "very_common" || band === "common";
+}
+
+// FrequencyBadge tells how common a word is, by the frequency list when it
+// ranks the word.
+export function FrequencyBadge({ band, rank }: { band: string; rank: number }) {
+  const label = FREQUENCY_BAND_LABELS[band] ?? band;
+  return (
+    <Box
+      bg="blue.50"
+      _dark={{ bg: "blue.900" }}
+      px={2}
+      py={0.5}
+      borderRadius="sm"
+      title={rank > 0 ? `#${rank} in the frequency list` : undefined}
+    >
+      <Text fontSize="xs" color="blue.700" _dark={{ color: "blue.200" }}>
+        {label}
+      </Text>
+    </Box>
+  );
+}
@@ -0,0 +1,83 @@
+"use client";
+
+import { useEffect, useState } from "react";
+import { Code, ConnectError } from "@connectrpc/connect";
+import { Box, Flex, Text } from "@chakra-ui/react";
+import { analyticsClient, type GetFrequencyCoverageResponse } from "@/lib/client";
+
+// MISSING_WORDS_LIMIT caps how many never-studied frequent words are listed.
+const MISSING_WORDS_LIMIT = 30;
+
+// FrequencyCoveragePanel shows how many of the most frequent words of the
+// configured frequency list the learner has studied and knows under the
+// current analytics filters. It stays hidden when no list is configured.
+export function FrequencyCoveragePanel({ notebookId, quizType }: { notebookId: string; quizType: string }) {
+  const [coverage, setCoverage] = useState<GetFrequencyCoverageResponse | null>(null);
+  const [error, setError] = useState<string | null>(null);
+
+  useEffect(() => {
+    let cancelled = false;
+    setCoverage(null);
+    analyticsClient
+      .getFrequencyCoverage({ filters: { notebookId, quizType }, missingLimit: MISSING_WORDS_LIMIT })
+      .then((res) => {
+        if (!cancelled) {
+          setCoverage(res);
+          setError(null);
+        }
+      })
+      .catch((e) => {
+        if (cancelled) return;
+        // No frequency list is configured: nothing to cover.
+        if (ConnectError.from(e).code === Code.FailedPrecondition) return;
+        setError(e instanceof Error ? e.message : String(e));
+      });
+    return () => {
+      cancelled = true;
+    };
+  }, [notebookId, quizType]);
+
+  if (error) {
+    return <Text color="red.500" data-testid="frequency-coverage-error">Failed to load frequency coverage: {error}</Text>;
+  }
+  if (coverage === null || coverage.topN === 0) {
+    return null;
+  }
+
+  const percent = (n: number) => Math.round((n / coverage.topN) * 100);
+
+  return (
+    <Box borderWidth="1px" borderColor="border" borderRadius="lg" p={4} mt={5} data-testid="frequency-coverage">
+      <Box mb={3}>
+        <Text fontSize="sm" fontWeight="semibold">Frequent words</Text>
+        <Text fontSize="xs" color="fg.muted">
+          Coverage of the top {coverage.topN} words of the frequency list
+        </Text>
+      </Box>
+      <Flex gap={6} mb={coverage.missingWords.length > 0 ? 3 : 0}>
+        <Box>
+          <Text fontSize="xs" color="fg.muted">Studied</Text>
+          <Text fontWeight="medium">
+            {coverage.studied} / {coverage.topN} ({percent(coverage.studied)}%)
+          </Text>
+        </Box>
+        <Box>
+          <Text fontSize="xs" color="fg.muted">Known</Text>
+          <Text fontWeight="medium">
+            {coverage.known} / {coverage.topN} ({percent(coverage.known)}%)
+          </Text>
+        </Box>
+      </Flex>
+      {coverage.missingWords.length > 0 && (
+        <Box borderTopWidth="1px" borderColor="border" pt={2}>
+          <Text fontSize="xs" color="fg.muted" mb={1}>Not studied yet, most frequent first</Text>
+          <Flex gap={2} flexWrap="wrap">
+            {coverage.missingWords.map((w) => (
+              <Text key={w} fontSize="sm">{w}</Text>
+            ))}
+          </Flex>
+        </Box>
+      )}
+    </Box>
+  );
+}
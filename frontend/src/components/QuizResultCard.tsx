@@ -1,7 +1,9 @@
 "use client";
 
+import { useState } from "react";
 import { Box, Button, Text } from "@chakra-ui/react";
 import type { WordDetail } from "@/store/quizStore";
+import { quizClient } from "@/lib/client";
 import { WordDetailView } from "./WordDetailView";
 import { OriginBreakdown, type OriginPartDisplay } from "./OriginBreakdown";
 
@@ -108,6 +110,25 @@ export function QuizResultCard({
   onSkip,
   onResume,
 }: QuizResultCardProps) {
+  // generatedMemo holds a memory hook written by GenerateMnemonic, shown in
+  // place of the note's (empty) memo until the next session reloads it.
+  const [generatedMemo, setGeneratedMemo] = useState("");
+  const [generatingMemo, setGeneratingMemo] = useState(false);
+  const memo = item.wordDetail?.memo?.trim() || generatedMemo;
+  const wordDetail = generatedMemo
+    ? { ...item.wordDetail, memo: generatedMemo }
+    : item.wordDetail;
+
+  const handleGenerateMnemonic = async () => {
+    if (!item.noteId) return;
+    setGeneratingMemo(true);
+    try {
+      const res = await quizClient.generateMnemonic({ noteId: item.noteId });
+      setGeneratedMemo(res.memo);
+    } catch { /* silently fail */ }
+    setGeneratingMemo(false);
+  };
+
   const statusKind: "correct" | "incorrect" | "skipped" = item.isSkipped
     ? "skipped"
     : item.correct
@@ -234,9 +255,9 @@ export function QuizResultCard({
       {/* Extra word details (origin prose, synonyms, antonyms, memo). Origin
           parts are stripped so WordDetailView doesn't render the etymology
           section a second time. */}
-      {item.wordDetail && (
+      {wordDetail && (
         <Box mb={3}>
-          <WordDetailView wordDetail={{ ...item.wordDetail, originParts: undefined }} />
+          <WordDetailView wordDetail={{ ...wordDetail, originParts: undefined }} />
         </Box>
       )}
 
@@ -264,6 +285,18 @@ export function QuizResultCard({
           </Button>
         )}
 
+        {!memo && !item.isSkipped && item.noteId && (
+          <Button
+            size="sm"
+            variant="outline"
+            colorPalette="purple"
+            disabled={generatingMemo}
+            onClick={handleGenerateMnemonic}
+          >
+            {generatingMemo ? "Generating..." : "Generate mnemonic"}
+          </Button>
+        )}
+
         {item.isSkipped
           ? item.noteId && (
               <Button
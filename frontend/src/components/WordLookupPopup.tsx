@@ -21,6 +21,7 @@ import {
   type NotebookWord,
   type WordDefinition,
 } from "@/lib/client";
+import { FrequencyBadge, isFrequentBand } from "@/components/FrequencyBadge";
 
 // LookupState is exported so callers can read e.g. `lookup !== null` to decide
 // whether to render the popup, or to attach keyboard handlers.
@@ -31,6 +32,10 @@ export interface LookupState {
   sceneIndex: number;
   definitions: WordDefinition[];
   source: string;
+  // frequencyBand and frequencyRank place the word in the frequency list, so
+  // the popup can flag common words as worth saving.
+  frequencyBand: string;
+  frequencyRank: number;
   loading: boolean;
   error: string | null;
   saved: boolean;
@@ -87,6 +92,8 @@ export function useWordLookup(
         sceneIndex,
         definitions: [],
         source: "",
+        frequencyBand: "",
+        frequencyRank: 0,
         loading: true,
         error: null,
         saved: false,
@@ -106,6 +113,8 @@ export function useWordLookup(
               ...prev,
               definitions: res.definitions,
               source: res.source,
+              frequencyBand: res.frequencyBand,
+              frequencyRank: res.frequencyRank,
               loading: false,
             };
           });
@@ -281,6 +290,7 @@ function SavedDefinitionView({
       >
         <Box display="flex" alignItems="center" gap={2}>
           <Heading size="sm">{lookup.word}</Heading>
+          <LookupFrequencyBadge lookup={lookup} />
           <Text
             fontSize="xs"
             px={2}
@@ -398,25 +408,39 @@ function NewDefinitionView({
         alignItems="center"
         mb={3}
       >
-        <Heading size="sm">
-          {lookup.word}
-          {lookup.source && (
-            <Text
-              as="span"
-              fontWeight="normal"
-              fontSize="xs"
-              color="fg.muted"
-              ml={2}
-            >
-              ({lookup.source})
-            </Text>
-          )}
-        </Heading>
+        <Box display="flex" alignItems="center" gap={2}>
+          <Heading size="sm">
+            {lookup.word}
+            {lookup.source && (
+              <Text
+                as="span"
+                fontWeight="normal"
+                fontSize="xs"
+                color="fg.muted"
+                ml={2}
+              >
+                ({lookup.source})
+              </Text>
+            )}
+          </Heading>
+          <LookupFrequencyBadge lookup={lookup} />
+        </Box>
         <Button size="xs" variant="ghost" onClick={onClose}>
           Close
         </Button>
       </Box>
 
+      {!lookup.saved && isFrequentBand(lookup.frequencyBand) && (
+        <Text
+          fontSize="xs"
+          color="blue.600"
+          _dark={{ color: "blue.300" }}
+          mb={2}
+        >
+          A frequent word, worth saving.
+        </Text>
+      )}
+
       {lookup.loading && (
         <Box textAlign="center" py={4}>
           <Spinner size="sm" />
@@ -467,6 +491,15 @@ function NewDefinitionView({
   );
 }
 
+// LookupFrequencyBadge shows the frequency band of the looked-up word, which
+// stays empty when no frequency list is configured or the list misses it.
+function LookupFrequencyBadge({ lookup }: { lookup: LookupState }) {
+  if (!lookup.frequencyBand) return null;
+  return (
+    <FrequencyBadge band={lookup.frequencyBand} rank={lookup.frequencyRank} />
+  );
+}
+
 function DefinitionCard({
   def,
   onSave,
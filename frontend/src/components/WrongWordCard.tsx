@@ -30,6 +30,17 @@ function attemptStreakLabel(a: AttemptEntry): string {
   return "(first attempt)";
 }
 
+// attemptAnswerLabel describes what the learner answered and why the grader
+// judged it that way. Empty for attempts recorded before answers were kept.
+function attemptAnswerLabel(a: AttemptEntry): string {
+  if (!a.answer && !a.graderReason) return "";
+  const parts: string[] = [];
+  if (a.answer) parts.push(`you answered "${a.answer}"`);
+  if (a.graderReason) parts.push(a.graderReason);
+  const label = parts.join(" — ");
+  return a.grader ? `${label} (${a.grader})` : label;
+}
+
 // summariseSceneTitle keeps the breadcrumb short. Story-style notebooks
 // (Speak English Like an American, Friends) declare the scene title as
 // the lesson's multi-paragraph plot summary; rendering it raw produced a
@@ -237,17 +248,24 @@ export function WrongWordCard({ word }: { word: WrongWord }) {
           {!loading && !error && attempts && (
             <VStack align="stretch" gap={2}>
               {attempts.map((a, i) => (
-                <HStack key={i} fontSize="sm" justifyContent="space-between" data-testid="attempt-row">
-                  <Text>{formatAttemptDate(a.date)}</Text>
-                  <QuizTypeChip quizType={a.quizType} />
-                  <Text color={a.result === "wrong" ? "red.500" : "green.600"}>
-                    {a.result === "wrong" ? "✗ wrong" : "✓ correct"}
-                  </Text>
-                  <Text>Q{a.quality}</Text>
-                  <Text color="fg.muted" fontSize="xs">
-                    {attemptStreakLabel(a)}
-                  </Text>
-                </HStack>
+                <VStack key={i} align="stretch" gap={0}>
+                  <HStack fontSize="sm" justifyContent="space-between" data-testid="attempt-row">
+                    <Text>{formatAttemptDate(a.date)}</Text>
+                    <QuizTypeChip quizType={a.quizType} />
+                    <Text color={a.result === "wrong" ? "red.500" : "green.600"}>
+                      {a.result === "wrong" ? "✗ wrong" : "✓ correct"}
+                    </Text>
+                    <Text>Q{a.quality}</Text>
+                    <Text color="fg.muted" fontSize="xs">
+                      {attemptStreakLabel(a)}
+                    </Text>
+                  </HStack>
+                  {attemptAnswerLabel(a) && (
+                    <Text color="fg.muted" fontSize="xs" data-testid="attempt-answer">
+                      {attemptAnswerLabel(a)}
+                    </Text>
+                  )}
+                </VStack>
               ))}
               {word.notebookId && (
                 <Button
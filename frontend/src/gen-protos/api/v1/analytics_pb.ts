@@ -11,7 +11,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/analytics.proto.
  */
 export const file_api_v1_analytics: GenFile = /*@__PURE__*/
-  fileDesc("ChZhcGkvdjEvYW5hbHl0aWNzLnByb3RvEgZhcGkudjEitQEKEEdldFRyZW5kc1JlcXVlc3QSKAoLZ3JhbnVsYXJpdHkYASABKA4yEy5hcGkudjEuR3JhbnVsYXJpdHkSEgoKc3RhcnRfZGF0ZRgCIAEoCRIQCghlbmRfZGF0ZRgDIAEoCRImCghncm91cF9ieRgEIAEoDjIULmFwaS52MS5UcmVuZEdyb3VwQnkSKQoHZmlsdGVycxgFIAEoCzIYLmFwaS52MS5BbmFseXRpY3NGaWx0ZXJzIosBChFHZXRUcmVuZHNSZXNwb25zZRIkCgdidWNrZXRzGAEgAygLMhMuYXBpLnYxLlRyZW5kQnVja2V0EiYKB3N1bW1hcnkYAiABKAsyFS5hcGkudjEuVHJlbmRzU3VtbWFyeRIoCgdiYWNrbG9nGAMgASgLMhcuYXBpLnYxLkJhY2tsb2dTbmFwc2hvdCJCCgtUcmVuZEJ1Y2tldBIOCgZwZXJpb2QYASABKAkSIwoGc2VyaWVzGAIgAygLMhMuYXBpLnYxLlRyZW5kU2VyaWVzIpcBCgtUcmVuZFNlcmllcxIRCglncm91cF9rZXkYASABKAkSEwoLZ3JvdXBfbGFiZWwYAiABKAkSEAoIYXR0ZW1wdHMYAyABKAUSFAoMd29yZHNfdGVzdGVkGAQgASgFEhUKDXdvcmRzX2xlYXJuZWQYBSABKAUSEQoJbGV2ZWxfdXBzGAYgASgFEg4KBmxhcHNlcxgHIAEoBSJxCg1UcmVuZHNTdW1tYXJ5EhAKCGF0dGVtcHRzGAEgASgFEhQKDHdvcmRzX3Rlc3RlZBgCIAEoBRIVCg13b3Jkc19sZWFybmVkGAMgASgFEhEKCWxldmVsX3VwcxgEIAEoBRIOCgZsYXBzZXMYBSABKAUiTwoPQmFja2xvZ1NuYXBzaG90EhUKDW5ldmVyX2NvcnJlY3QYASABKAUSEwoLaW5fcHJvZ3Jlc3MYAiABKAUSEAoIbWFzdGVyZWQYAyABKAUiOgoQQW5hbHl0aWNzRmlsdGVycxITCgtub3RlYm9va19pZBgBIAEoCRIRCglxdWl6X3R5cGUYAiABKAkiYgoYR2V0RGFpbHlTdW1tYXJpZXNSZXF1ZXN0EhsKCnJhbmdlX2RheXMYASABKAVCB7pIBBoCKAASKQoHZmlsdGVycxgCIAEoCzIYLmFwaS52MS5BbmFseXRpY3NGaWx0ZXJzIj8KGUdldERhaWx5U3VtbWFyaWVzUmVzcG9uc2USIgoEZGF5cxgBIAMoCzIULmFwaS52MS5EYWlseVN1bW1hcnkicgoMRGFpbHlTdW1tYXJ5EgwKBGRhdGUYASABKAkSEwoLd3JvbmdfY291bnQYAiABKAUSEwoLdG90YWxfY291bnQYAyABKAUSFgoObm90ZWJvb2tfY291bnQYBCABKAUSEgoKcXVpel90eXBlcxgFIAMoCSJXChNHZXREYXlEZXRhaWxSZXF1ZXN0EhUKBGRhdGUYASABKAlCB7pIBHICEAESKQoHZmlsdGVycxgCIAEoCzIYLmFwaS52MS5BbmFseXRpY3NGaWx0ZXJzIo8BChRHZXREYXlEZXRhaWxSZXNwb25zZRIlCgdzdW1tYXJ5GAEgASgLMhQuYXBpLnYxLkRhaWx5U3VtbWFyeRImCgt3cm9uZ193b3JkcxgCIAMoCzIRLmFwaS52MS5Xcm9uZ1dvcmQSFQoNcHJldmlvdXNfZGF0ZRgDIAEoCRIRCgluZXh0X2RhdGUYBCABKAkiowMKCVdyb25nV29yZBIPCgdub3RlX2lkGAEgASgDEhIKCmV4cHJlc3Npb24YAiABKAkSEwoLbm90ZWJvb2tfaWQYAyABKAkSFgoObm90ZWJvb2tfdGl0bGUYBCABKAkSEwoLc2NlbmVfdGl0bGUYBSABKAkSEQoJcXVpel90eXBlGAYgASgJEhYKDnJlY2VudF9wYXR0ZXJuGAcgAygJEhwKFGN1cnJlbnRfd3Jvbmdfc3RyZWFrGAggASgFEh8KF3ByZXZpb3VzX2NvcnJlY3Rfc3RyZWFrGAkgASgFEhYKDmN1cnJlbnRfc3RhdHVzGAogASgJEg8KB21lYW5pbmcYCyABKAkSGAoQZXhhbXBsZV9zZW50ZW5jZRgMIAEoCRIVCg1ub3RlYm9va19raW5kGA0gASgJEg8KB3NraXBwZWQYDiABKAgSLAoOcmVsYXRlZF9ncm91cHMYDyADKAsyFC5hcGkudjEuUmVsYXRlZEdyb3VwEhAKCHNlbnNlX2lkGBAgASgJEhoKEmRpc3BsYXlfZXhwcmVzc2lvbhgRIAEoCSI8CgxSZWxhdGVkR3JvdXASDAoEa2luZBgBIAEoCRINCgVsYWJlbBgCIAEoCRIPCgdtZW1iZXJzGAMgAygJIogBChVHZXRXb3JkSGlzdG9yeVJlcXVlc3QSDwoHbm90ZV9pZBgBIAEoAxITCgtub3RlYm9va19pZBgCIAEoCRIbCgpleHByZXNzaW9uGAMgASgJQge6SARyAhABEhoKCXF1aXpfdHlwZRgEIAEoCUIHukgEcgIQARIQCghzZW5zZV9pZBgFIAEoCSLJAQoWR2V0V29yZEhpc3RvcnlSZXNwb25zZRISCgpleHByZXNzaW9uGAEgASgJEhMKC25vdGVib29rX2lkGAIgASgJEhYKDm5vdGVib29rX3RpdGxlGAMgASgJEhYKDmN1cnJlbnRfc3RhdHVzGAQgASgJEhwKFGN1cnJlbnRfd3Jvbmdfc3RyZWFrGAUgASgFEiYKCGF0dGVtcHRzGAYgAygLMhQuYXBpLnYxLkF0dGVtcHRFbnRyeRIQCghzZW5zZV9pZBgHIAEoCSKMAQoMQXR0ZW1wdEVudHJ5EgwKBGRhdGUYASABKAkSEQoJcXVpel90eXBlGAIgASgJEg4KBnJlc3VsdBgDIAEoCRIPCgdxdWFsaXR5GAQgASgFEhsKE3N0cmVha19iZWZvcmVfd3JvbmcYBSABKAUSHQoVc3RyZWFrX2JlZm9yZV9jb3JyZWN0GAYgASgFKoIBCgtHcmFudWxhcml0eRIbChdHUkFOVUxBUklUWV9VTlNQRUNJRklFRBAAEhMKD0dSQU5VTEFSSVRZX0RBWRABEhQKEEdSQU5VTEFSSVRZX1dFRUsQAhIVChFHUkFOVUxBUklUWV9NT05USBADEhQKEEdSQU5VTEFSSVRZX1lFQVIQBCqeAQoMVHJlbmRHcm91cEJ5Eh4KGlRSRU5EX0dST1VQX0JZX1VOU1BFQ0lGSUVEEAASHAoYVFJFTkRfR1JPVVBfQllfUVVJWl9UWVBFEAESGwoXVFJFTkRfR1JPVVBfQllfTk9URUJPT0sQAhIZChVUUkVORF9HUk9VUF9CWV9TVEFUVVMQAxIYChRUUkVORF9HUk9VUF9CWV9MRVZFTBAEMsoCChBBbmFseXRpY3NTZXJ2aWNlElgKEUdldERhaWx5U3VtbWFyaWVzEiAuYXBpLnYxLkdldERhaWx5U3VtbWFyaWVzUmVxdWVzdBohLmFwaS52MS5HZXREYWlseVN1bW1hcmllc1Jlc3BvbnNlEkkKDEdldERheURldGFpbBIbLmFwaS52MS5HZXREYXlEZXRhaWxSZXF1ZXN0GhwuYXBpLnYxLkdldERheURldGFpbFJlc3BvbnNlEk8KDkdldFdvcmRIaXN0b3J5Eh0uYXBpLnYxLkdldFdvcmRIaXN0b3J5UmVxdWVzdBoeLmFwaS52MS5HZXRXb3JkSGlzdG9yeVJlc3BvbnNlEkAKCUdldFRyZW5kcxIYLmFwaS52MS5HZXRUcmVuZHNSZXF1ZXN0GhkuYXBpLnYxLkdldFRyZW5kc1Jlc3BvbnNlQjhaNmdpdGh1Yi5jb20vYXQtaXNoaWthd2EvbGFuZ25lci9nZW4tcHJvdG9zL2FwaS92MTthcGl2MWIGcHJvdG8z", [file_buf_validate_validate]);
+  fileDesc("ChZhcGkvdjEvYW5hbHl0aWNzLnByb3RvEgZhcGkudjEitQEKEEdldFRyZW5kc1JlcXVlc3QSKAoLZ3JhbnVsYXJpdHkYASABKA4yEy5hcGkudjEuR3JhbnVsYXJpdHkSEgoKc3RhcnRfZGF0ZRgCIAEoCRIQCghlbmRfZGF0ZRgDIAEoCRImCghncm91cF9ieRgEIAEoDjIULmFwaS52MS5UcmVuZEdyb3VwQnkSKQoHZmlsdGVycxgFIAEoCzIYLmFwaS52MS5BbmFseXRpY3NGaWx0ZXJzIosBChFHZXRUcmVuZHNSZXNwb25zZRIkCgdidWNrZXRzGAEgAygLMhMuYXBpLnYxLlRyZW5kQnVja2V0EiYKB3N1bW1hcnkYAiABKAsyFS5hcGkudjEuVHJlbmRzU3VtbWFyeRIoCgdiYWNrbG9nGAMgASgLMhcuYXBpLnYxLkJhY2tsb2dTbmFwc2hvdCJCCgtUcmVuZEJ1Y2tldBIOCgZwZXJpb2QYASABKAkSIwoGc2VyaWVzGAIgAygLMhMuYXBpLnYxLlRyZW5kU2VyaWVzIpcBCgtUcmVuZFNlcmllcxIRCglncm91cF9rZXkYASABKAkSEwoLZ3JvdXBfbGFiZWwYAiABKAkSEAoIYXR0ZW1wdHMYAyABKAUSFAoMd29yZHNfdGVzdGVkGAQgASgFEhUKDXdvcmRzX2xlYXJuZWQYBSABKAUSEQoJbGV2ZWxfdXBzGAYgASgFEg4KBmxhcHNlcxgHIAEoBSJxCg1UcmVuZHNTdW1tYXJ5EhAKCGF0dGVtcHRzGAEgASgFEhQKDHdvcmRzX3Rlc3RlZBgCIAEoBRIVCg13b3Jkc19sZWFybmVkGAMgASgFEhEKCWxldmVsX3VwcxgEIAEoBRIOCgZsYXBzZXMYBSABKAUiTwoPQmFja2xvZ1NuYXBzaG90EhUKDW5ldmVyX2NvcnJlY3QYASABKAUSEwoLaW5fcHJvZ3Jlc3MYAiABKAUSEAoIbWFzdGVyZWQYAyABKAUiOgoQQW5hbHl0aWNzRmlsdGVycxITCgtub3RlYm9va19pZBgBIAEoCRIRCglxdWl6X3R5cGUYAiABKAkiYgoYR2V0RGFpbHlTdW1tYXJpZXNSZXF1ZXN0EhsKCnJhbmdlX2RheXMYASABKAVCB7pIBBoCKAASKQoHZmlsdGVycxgCIAEoCzIYLmFwaS52MS5BbmFseXRpY3NGaWx0ZXJzIj8KGUdldERhaWx5U3VtbWFyaWVzUmVzcG9uc2USIgoEZGF5cxgBIAMoCzIULmFwaS52MS5EYWlseVN1bW1hcnkicgoMRGFpbHlTdW1tYXJ5EgwKBGRhdGUYASABKAkSEwoLd3JvbmdfY291bnQYAiABKAUSEwoLdG90YWxfY291bnQYAyABKAUSFgoObm90ZWJvb2tfY291bnQYBCABKAUSEgoKcXVpel90eXBlcxgFIAMoCSJXChNHZXREYXlEZXRhaWxSZXF1ZXN0EhUKBGRhdGUYASABKAlCB7pIBHICEAESKQoHZmlsdGVycxgCIAEoCzIYLmFwaS52MS5BbmFseXRpY3NGaWx0ZXJzIo8BChRHZXREYXlEZXRhaWxSZXNwb25zZRIlCgdzdW1tYXJ5GAEgASgLMhQuYXBpLnYxLkRhaWx5U3VtbWFyeRImCgt3cm9uZ193b3JkcxgCIAMoCzIRLmFwaS52MS5Xcm9uZ1dvcmQSFQoNcHJldmlvdXNfZGF0ZRgDIAEoCRIRCgluZXh0X2RhdGUYBCABKAkiowMKCVdyb25nV29yZBIPCgdub3RlX2lkGAEgASgDEhIKCmV4cHJlc3Npb24YAiABKAkSEwoLbm90ZWJvb2tfaWQYAyABKAkSFgoObm90ZWJvb2tfdGl0bGUYBCABKAkSEwoLc2NlbmVfdGl0bGUYBSABKAkSEQoJcXVpel90eXBlGAYgASgJEhYKDnJlY2VudF9wYXR0ZXJuGAcgAygJEhwKFGN1cnJlbnRfd3Jvbmdfc3RyZWFrGAggASgFEh8KF3ByZXZpb3VzX2NvcnJlY3Rfc3RyZWFrGAkgASgFEhYKDmN1cnJlbnRfc3RhdHVzGAogASgJEg8KB21lYW5pbmcYCyABKAkSGAoQZXhhbXBsZV9zZW50ZW5jZRgMIAEoCRIVCg1ub3RlYm9va19raW5kGA0gASgJEg8KB3NraXBwZWQYDiABKAgSLAoOcmVsYXRlZF9ncm91cHMYDyADKAsyFC5hcGkudjEuUmVsYXRlZEdyb3VwEhAKCHNlbnNlX2lkGBAgASgJEhoKEmRpc3BsYXlfZXhwcmVzc2lvbhgRIAEoCSI8CgxSZWxhdGVkR3JvdXASDAoEa2luZBgBIAEoCRINCgVsYWJlbBgCIAEoCRIPCgdtZW1iZXJzGAMgAygJIogBChVHZXRXb3JkSGlzdG9yeVJlcXVlc3QSDwoHbm90ZV9pZBgBIAEoAxITCgtub3RlYm9va19pZBgCIAEoCRIbCgpleHByZXNzaW9uGAMgASgJQge6SARyAhABEhoKCXF1aXpfdHlwZRgEIAEoCUIHukgEcgIQARIQCghzZW5zZV9pZBgFIAEoCSLJAQoWR2V0V29yZEhpc3RvcnlSZXNwb25zZRISCgpleHByZXNzaW9uGAEgASgJEhMKC25vdGVib29rX2lkGAIgASgJEhYKDm5vdGVib29rX3RpdGxlGAMgASgJEhYKDmN1cnJlbnRfc3RhdHVzGAQgASgJEhwKFGN1cnJlbnRfd3Jvbmdfc3RyZWFrGAUgASgFEiYKCGF0dGVtcHRzGAYgAygLMhQuYXBpLnYxLkF0dGVtcHRFbnRyeRIQCghzZW5zZV9pZBgHIAEoCSLDAQoMQXR0ZW1wdEVudHJ5EgwKBGRhdGUYASABKAkSEQoJcXVpel90eXBlGAIgASgJEg4KBnJlc3VsdBgDIAEoCRIPCgdxdWFsaXR5GAQgASgFEhsKE3N0cmVha19iZWZvcmVfd3JvbmcYBSABKAUSHQoVc3RyZWFrX2JlZm9yZV9jb3JyZWN0GAYgASgFEg4KBmFuc3dlchgHIAEoCRIVCg1ncmFkZXJfcmVhc29uGAggASgJEg4KBmdyYWRlchgJIAEoCSJ2ChFHZXRMZWVjaGVzUmVxdWVzdBIpCgdmaWx0ZXJzGAEgASgLMhguYXBpLnYxLkFuYWx5dGljc0ZpbHRlcnMSFwoGbGFwc2VzGAIgASgFQge6SAQaAigAEh0KDHdyb25nX3N0cmVhaxgDIAEoBUIHukgEGgIoACI5ChJHZXRMZWVjaGVzUmVzcG9uc2USIwoHbGVlY2hlcxgBIAMoCzISLmFwaS52MS5MZWVjaEVudHJ5IowCCgpMZWVjaEVudHJ5EhAKCHNlbnNlX2lkGAEgASgJEhIKCmV4cHJlc3Npb24YAiABKAkSEwoLbm90ZWJvb2tfaWQYAyABKAkSFgoObm90ZWJvb2tfdGl0bGUYBCABKAkSEwoLc2NlbmVfdGl0bGUYBSABKAkSEQoJcXVpel90eXBlGAYgASgJEg4KBmxhcHNlcxgHIAEoBRIcChRjdXJyZW50X3dyb25nX3N0cmVhaxgIIAEoBRIQCghhdHRlbXB0cxgJIAEoBRIZChFsYXN0X2F0dGVtcHRfZGF0ZRgKIAEoCRIPCgdza2lwcGVkGAsgASgIEhcKD2luX3JlbGVhcm5fcG9vbBgMIAEoCCJyChRHZXRDb25mdXNpb25zUmVxdWVzdBIpCgdmaWx0ZXJzGAEgASgLMhguYXBpLnYxLkFuYWx5dGljc0ZpbHRlcnMSFwoPdW5yZXNvbHZlZF9vbmx5GAIgASgIEhYKBWxpbWl0GAMgASgFQge6SAQaAigAIj0KFUdldENvbmZ1c2lvbnNSZXNwb25zZRIkCgVwYWlycxgBIAMoCzIVLmFwaS52MS5Db25mdXNpb25QYWlyIsQBCg1Db25mdXNpb25QYWlyEhMKC25vdGVib29rX2lkGAEgASgJEhYKDm5vdGVib29rX3RpdGxlGAIgASgJEiQKBXdvcmRzGAMgAygLMhUuYXBpLnYxLkNvbmZ1c2lvbldvcmQSDQoFY291bnQYBCABKAUSGgoSbGFzdF9jb25mdXNlZF9kYXRlGAUgASgJEhIKCnF1aXpfdHlwZXMYBiADKAkSDwoHYW5zd2VycxgHIAMoCRIQCghyZXNvbHZlZBgIIAEoCCJXCg1Db25mdXNpb25Xb3JkEhAKCHNlbnNlX2lkGAEgASgJEhIKCmV4cHJlc3Npb24YAiABKAkSDwoHbWVhbmluZxgDIAEoCRIPCgdleGFtcGxlGAQgASgJIoABChtHZXRGcmVxdWVuY3lDb3ZlcmFnZVJlcXVlc3QSKQoHZmlsdGVycxgBIAEoCzIYLmFwaS52MS5BbmFseXRpY3NGaWx0ZXJzEhYKBXRvcF9uGAIgASgFQge6SAQaAigAEh4KDW1pc3NpbmdfbGltaXQYAyABKAVCB7pIBBoCKAAiZAocR2V0RnJlcXVlbmN5Q292ZXJhZ2VSZXNwb25zZRINCgV0b3BfbhgBIAEoBRIPCgdzdHVkaWVkGAIgASgFEg0KBWtub3duGAMgASgFEhUKDW1pc3Npbmdfd29yZHMYBCADKAkqggEKC0dyYW51bGFyaXR5EhsKF0dSQU5VTEFSSVRZX1VOU1BFQ0lGSUVEEAASEwoPR1JBTlVMQVJJVFlfREFZEAESFAoQR1JBTlVMQVJJVFlfV0VFSxACEhUKEUdSQU5VTEFSSVRZX01PTlRIEAMSFAoQR1JBTlVMQVJJVFlfWUVBUhAEKp4BCgxUcmVuZEdyb3VwQnkSHgoaVFJFTkRfR1JPVVBfQllfVU5TUEVDSUZJRUQQABIcChhUUkVORF9HUk9VUF9CWV9RVUlaX1RZUEUQARIbChdUUkVORF9HUk9VUF9CWV9OT1RFQk9PSxACEhkKFVRSRU5EX0dST1VQX0JZX1NUQVRVUxADEhgKFFRSRU5EX0dST1VQX0JZX0xFVkVMEAQywAQKEEFuYWx5dGljc1NlcnZpY2USWAoRR2V0RGFpbHlTdW1tYXJpZXMSIC5hcGkudjEuR2V0RGFpbHlTdW1tYXJpZXNSZXF1ZXN0GiEuYXBpLnYxLkdldERhaWx5U3VtbWFyaWVzUmVzcG9uc2USSQoMR2V0RGF5RGV0YWlsEhsuYXBpLnYxLkdldERheURldGFpbFJlcXVlc3QaHC5hcGkudjEuR2V0RGF5RGV0YWlsUmVzcG9uc2USTwoOR2V0V29yZEhpc3RvcnkSHS5hcGkudjEuR2V0V29yZEhpc3RvcnlSZXF1ZXN0Gh4uYXBpLnYxLkdldFdvcmRIaXN0b3J5UmVzcG9uc2USQAoJR2V0VHJlbmRzEhguYXBpLnYxLkdldFRyZW5kc1JlcXVlc3QaGS5hcGkudjEuR2V0VHJlbmRzUmVzcG9uc2USQwoKR2V0TGVlY2hlcxIZLmFwaS52MS5HZXRMZWVjaGVzUmVxdWVzdBoaLmFwaS52MS5HZXRMZWVjaGVzUmVzcG9uc2USTAoNR2V0Q29uZnVzaW9ucxIcLmFwaS52MS5HZXRDb25mdXNpb25zUmVxdWVzdBodLmFwaS52MS5HZXRDb25mdXNpb25zUmVzcG9uc2USYQoUR2V0RnJlcXVlbmN5Q292ZXJhZ2USIy5hcGkudjEuR2V0RnJlcXVlbmN5Q292ZXJhZ2VSZXF1ZXN0GiQuYXBpLnYxLkdldEZyZXF1ZW5jeUNvdmVyYWdlUmVzcG9uc2VCOFo2Z2l0aHViLmNvbS9hdC1pc2hpa2F3YS9sYW5nbmVyL2dlbi1wcm90b3MvYXBpL3YxO2FwaXYxYgZwcm90bzM", [file_buf_validate_validate]);
 
 /**
  * @generated from message api.v1.GetTrendsRequest
@@ -775,6 +775,25 @@ export type AttemptEntry = Message<"api.v1.AttemptEntry"> & {
    * @generated from field: int32 streak_before_correct = 6;
    */
   streakBeforeCorrect: number;
+
+  /**
+   * answer is what the learner answered, and grader_reason why grader
+   * ("model", "deterministic" or "override") accepted or rejected it.
+   * All three are empty for attempts recorded before answers were kept.
+   *
+   * @generated from field: string answer = 7;
+   */
+  answer: string;
+
+  /**
+   * @generated from field: string grader_reason = 8;
+   */
+  graderReason: string;
+
+  /**
+   * @generated from field: string grader = 9;
+   */
+  grader: string;
 };
 
 /**
@@ -784,6 +803,352 @@ export type AttemptEntry = Message<"api.v1.AttemptEntry"> & {
 export const AttemptEntrySchema: GenMessage<AttemptEntry> = /*@__PURE__*/
   messageDesc(file_api_v1_analytics, 16);
 
+/**
+ * @generated from message api.v1.GetLeechesRequest
+ */
+export type GetLeechesRequest = Message<"api.v1.GetLeechesRequest"> & {
+  /**
+   * @generated from field: api.v1.AnalyticsFilters filters = 1;
+   */
+  filters?: AnalyticsFilters;
+
+  /**
+   * lapses / wrong_streak override the configured leech threshold
+   * (quiz.leech) when non-zero.
+   *
+   * @generated from field: int32 lapses = 2;
+   */
+  lapses: number;
+
+  /**
+   * @generated from field: int32 wrong_streak = 3;
+   */
+  wrongStreak: number;
+};
+
+/**
+ * Describes the message api.v1.GetLeechesRequest.
+ * Use `create(GetLeechesRequestSchema)` to create a new message.
+ */
+export const GetLeechesRequestSchema: GenMessage<GetLeechesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_analytics, 17);
+
+/**
+ * @generated from message api.v1.GetLeechesResponse
+ */
+export type GetLeechesResponse = Message<"api.v1.GetLeechesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.LeechEntry leeches = 1;
+   */
+  leeches: LeechEntry[];
+};
+
+/**
+ * Describes the message api.v1.GetLeechesResponse.
+ * Use `create(GetLeechesResponseSchema)` to create a new message.
+ */
+export const GetLeechesResponseSchema: GenMessage<GetLeechesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_analytics, 18);
+
+/**
+ * LeechEntry is one word × quiz type series that counts as a leech.
+ *
+ * @generated from message api.v1.LeechEntry
+ */
+export type LeechEntry = Message<"api.v1.LeechEntry"> & {
+  /**
+   * @generated from field: string sense_id = 1;
+   */
+  senseId: string;
+
+  /**
+   * @generated from field: string expression = 2;
+   */
+  expression: string;
+
+  /**
+   * @generated from field: string notebook_id = 3;
+   */
+  notebookId: string;
+
+  /**
+   * @generated from field: string notebook_title = 4;
+   */
+  notebookTitle: string;
+
+  /**
+   * @generated from field: string scene_title = 5;
+   */
+  sceneTitle: string;
+
+  /**
+   * @generated from field: string quiz_type = 6;
+   */
+  quizType: string;
+
+  /**
+   * lapses counts the times a correct answer was followed by a wrong one.
+   *
+   * @generated from field: int32 lapses = 7;
+   */
+  lapses: number;
+
+  /**
+   * @generated from field: int32 current_wrong_streak = 8;
+   */
+  currentWrongStreak: number;
+
+  /**
+   * @generated from field: int32 attempts = 9;
+   */
+  attempts: number;
+
+  /**
+   * last_attempt_date in YYYY-MM-DD format.
+   *
+   * @generated from field: string last_attempt_date = 10;
+   */
+  lastAttemptDate: string;
+
+  /**
+   * skipped / in_relearn_pool report the leech action already applied:
+   * excluded from the quiz type, or moved into the relearn pool.
+   *
+   * @generated from field: bool skipped = 11;
+   */
+  skipped: boolean;
+
+  /**
+   * @generated from field: bool in_relearn_pool = 12;
+   */
+  inRelearnPool: boolean;
+};
+
+/**
+ * Describes the message api.v1.LeechEntry.
+ * Use `create(LeechEntrySchema)` to create a new message.
+ */
+export const LeechEntrySchema: GenMessage<LeechEntry> = /*@__PURE__*/
+  messageDesc(file_api_v1_analytics, 19);
+
+/**
+ * @generated from message api.v1.GetConfusionsRequest
+ */
+export type GetConfusionsRequest = Message<"api.v1.GetConfusionsRequest"> & {
+  /**
+   * @generated from field: api.v1.AnalyticsFilters filters = 1;
+   */
+  filters?: AnalyticsFilters;
+
+  /**
+   * unresolved_only drops the pairs whose words have all been answered
+   * correctly since they were last confused.
+   *
+   * @generated from field: bool unresolved_only = 2;
+   */
+  unresolvedOnly: boolean;
+
+  /**
+   * limit caps the number of pairs; 0 returns all of them.
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message api.v1.GetConfusionsRequest.
+ * Use `create(GetConfusionsRequestSchema)` to create a new message.
+ */
+export const GetConfusionsRequestSchema: GenMessage<GetConfusionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_analytics, 20);
+
+/**
+ * @generated from message api.v1.GetConfusionsResponse
+ */
+export type GetConfusionsResponse = Message<"api.v1.GetConfusionsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.ConfusionPair pairs = 1;
+   */
+  pairs: ConfusionPair[];
+};
+
+/**
+ * Describes the message api.v1.GetConfusionsResponse.
+ * Use `create(GetConfusionsResponseSchema)` to create a new message.
+ */
+export const GetConfusionsResponseSchema: GenMessage<GetConfusionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_analytics, 21);
+
+/**
+ * ConfusionPair is two words of one notebook the learner mixes up.
+ *
+ * @generated from message api.v1.ConfusionPair
+ */
+export type ConfusionPair = Message<"api.v1.ConfusionPair"> & {
+  /**
+   * @generated from field: string notebook_id = 1;
+   */
+  notebookId: string;
+
+  /**
+   * @generated from field: string notebook_title = 2;
+   */
+  notebookTitle: string;
+
+  /**
+   * words are the two words, ordered by expression.
+   *
+   * @generated from field: repeated api.v1.ConfusionWord words = 3;
+   */
+  words: ConfusionWord[];
+
+  /**
+   * count is the number of wrong answers that matched the other word.
+   *
+   * @generated from field: int32 count = 4;
+   */
+  count: number;
+
+  /**
+   * last_confused_date in YYYY-MM-DD format.
+   *
+   * @generated from field: string last_confused_date = 5;
+   */
+  lastConfusedDate: string;
+
+  /**
+   * @generated from field: repeated string quiz_types = 6;
+   */
+  quizTypes: string[];
+
+  /**
+   * answers are sample wrong answers that matched, newest first.
+   *
+   * @generated from field: repeated string answers = 7;
+   */
+  answers: string[];
+
+  /**
+   * resolved is true once both words have been answered correctly since
+   * they were last confused.
+   *
+   * @generated from field: bool resolved = 8;
+   */
+  resolved: boolean;
+};
+
+/**
+ * Describes the message api.v1.ConfusionPair.
+ * Use `create(ConfusionPairSchema)` to create a new message.
+ */
+export const ConfusionPairSchema: GenMessage<ConfusionPair> = /*@__PURE__*/
+  messageDesc(file_api_v1_analytics, 22);
+
+/**
+ * @generated from message api.v1.ConfusionWord
+ */
+export type ConfusionWord = Message<"api.v1.ConfusionWord"> & {
+  /**
+   * @generated from field: string sense_id = 1;
+   */
+  senseId: string;
+
+  /**
+   * @generated from field: string expression = 2;
+   */
+  expression: string;
+
+  /**
+   * @generated from field: string meaning = 3;
+   */
+  meaning: string;
+
+  /**
+   * @generated from field: string example = 4;
+   */
+  example: string;
+};
+
+/**
+ * Describes the message api.v1.ConfusionWord.
+ * Use `create(ConfusionWordSchema)` to create a new message.
+ */
+export const ConfusionWordSchema: GenMessage<ConfusionWord> = /*@__PURE__*/
+  messageDesc(file_api_v1_analytics, 23);
+
+/**
+ * @generated from message api.v1.GetFrequencyCoverageRequest
+ */
+export type GetFrequencyCoverageRequest = Message<"api.v1.GetFrequencyCoverageRequest"> & {
+  /**
+   * @generated from field: api.v1.AnalyticsFilters filters = 1;
+   */
+  filters?: AnalyticsFilters | undefined;
+
+  /**
+   * top_n is how many of the most frequent words to cover, 1000 when 0.
+   *
+   * @generated from field: int32 top_n = 2;
+   */
+  topN: number;
+
+  /**
+   * missing_limit caps missing_words; 0 lists none.
+   *
+   * @generated from field: int32 missing_limit = 3;
+   */
+  missingLimit: number;
+};
+
+/**
+ * Describes the message api.v1.GetFrequencyCoverageRequest.
+ * Use `create(GetFrequencyCoverageRequestSchema)` to create a new message.
+ */
+export const GetFrequencyCoverageRequestSchema: GenMessage<GetFrequencyCoverageRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_analytics, 24);
+
+/**
+ * @generated from message api.v1.GetFrequencyCoverageResponse
+ */
+export type GetFrequencyCoverageResponse = Message<"api.v1.GetFrequencyCoverageResponse"> & {
+  /**
+   * top_n is the number of words covered, fewer than requested when the
+   * list is shorter.
+   *
+   * @generated from field: int32 top_n = 1;
+   */
+  topN: number;
+
+  /**
+   * studied counts the words answered at least once in any quiz type, and
+   * known the studied words whose latest answer was correct. An answer
+   * counts for every inflection of its word.
+   *
+   * @generated from field: int32 studied = 2;
+   */
+  studied: number;
+
+  /**
+   * @generated from field: int32 known = 3;
+   */
+  known: number;
+
+  /**
+   * missing_words lists the words never studied, most frequent first.
+   *
+   * @generated from field: repeated string missing_words = 4;
+   */
+  missingWords: string[];
+};
+
+/**
+ * Describes the message api.v1.GetFrequencyCoverageResponse.
+ * Use `create(GetFrequencyCoverageResponseSchema)` to create a new message.
+ */
+export const GetFrequencyCoverageResponseSchema: GenMessage<GetFrequencyCoverageResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_analytics, 25);
+
 /**
  * Granularity is the width of one Trends bucket.
  *
@@ -918,6 +1283,42 @@ export const AnalyticsService: GenService<{
     input: typeof GetTrendsRequestSchema;
     output: typeof GetTrendsResponseSchema;
   },
+  /**
+   * GetLeeches returns the word × quiz type series that keep failing —
+   * lapsed too often or stuck on a run of wrong answers — worst first.
+   *
+   * @generated from rpc api.v1.AnalyticsService.GetLeeches
+   */
+  getLeeches: {
+    methodKind: "unary";
+    input: typeof GetLeechesRequestSchema;
+    output: typeof GetLeechesResponseSchema;
+  },
+  /**
+   * GetConfusions returns the pairs of words of one notebook the learner
+   * mixes up: a wrong answer for one was the other's meaning, or, in the
+   * reverse quiz, the other word. The discrimination drill is built from
+   * the unresolved pairs.
+   *
+   * @generated from rpc api.v1.AnalyticsService.GetConfusions
+   */
+  getConfusions: {
+    methodKind: "unary";
+    input: typeof GetConfusionsRequestSchema;
+    output: typeof GetConfusionsResponseSchema;
+  },
+  /**
+   * GetFrequencyCoverage reports how many of the most frequent words of
+   * the configured frequency list (frequency.list_file) the learner has
+   * studied and knows. FAILED_PRECONDITION when no list is configured.
+   *
+   * @generated from rpc api.v1.AnalyticsService.GetFrequencyCoverage
+   */
+  getFrequencyCoverage: {
+    methodKind: "unary";
+    input: typeof GetFrequencyCoverageRequestSchema;
+    output: typeof GetFrequencyCoverageResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_analytics, 0);
 
@@ -11,7 +11,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/notebook.proto.
  */
 export const file_api_v1_notebook: GenFile = /*@__PURE__*/
-  fileDesc("ChVhcGkvdjEvbm90ZWJvb2sucHJvdG8SBmFwaS52MSI4ChhHZXROb3RlYm9va0RldGFpbFJlcXVlc3QSHAoLbm90ZWJvb2tfaWQYASABKAlCB7pIBHICEAEifQoZR2V0Tm90ZWJvb2tEZXRhaWxSZXNwb25zZRITCgtub3RlYm9va19pZBgBIAEoCRIMCgRuYW1lGAIgASgJEiMKB3N0b3JpZXMYAyADKAsyEi5hcGkudjEuU3RvcnlFbnRyeRIYChB0b3RhbF93b3JkX2NvdW50GAQgASgFInYKClN0b3J5RW50cnkSDQoFZXZlbnQYASABKAkSJwoIbWV0YWRhdGEYAiABKAsyFS5hcGkudjEuU3RvcnlNZXRhZGF0YRIMCgRkYXRlGAMgASgJEiIKBnNjZW5lcxgEIAMoCzISLmFwaS52MS5TdG9yeVNjZW5lIkAKDVN0b3J5TWV0YWRhdGESDgoGc2VyaWVzGAEgASgJEg4KBnNlYXNvbhgCIAEoBRIPCgdlcGlzb2RlGAMgASgFIocBCgpTdG9yeVNjZW5lEg0KBXRpdGxlGAEgASgJEisKDWNvbnZlcnNhdGlvbnMYAiADKAsyFC5hcGkudjEuQ29udmVyc2F0aW9uEikKC2RlZmluaXRpb25zGAMgAygLMhQuYXBpLnYxLk5vdGVib29rV29yZBISCgpzdGF0ZW1lbnRzGAUgAygJIi4KDENvbnZlcnNhdGlvbhIPCgdzcGVha2VyGAEgASgJEg0KBXF1b3RlGAIgASgJIq4DCgxOb3RlYm9va1dvcmQSEgoKZXhwcmVzc2lvbhgBIAEoCRISCgpkZWZpbml0aW9uGAIgASgJEg8KB21lYW5pbmcYAyABKAkSFgoOcGFydF9vZl9zcGVlY2gYBCABKAkSFQoNcHJvbnVuY2lhdGlvbhgFIAEoCRIQCghleGFtcGxlcxgGIAMoCRIQCghzeW5vbnltcxgHIAMoCRIQCghhbnRvbnltcxgIIAMoCRIXCg9sZWFybmluZ19zdGF0dXMYCSABKAkSLgoMbGVhcm5lZF9sb2dzGAogAygLMhguYXBpLnYxLkxlYXJuaW5nTG9nRW50cnkSGAoQbmV4dF9yZXZpZXdfZGF0ZRgMIAEoCRIOCgZvcmlnaW4YDSABKAkSEgoKaXNfc2tpcHBlZBgOIAEoCBIaChJza2lwcGVkX3F1aXpfdHlwZXMYDyADKAkSDwoHbm90ZV9pZBgQIAEoAxIUCgxjb25jZXB0X2hlYWQYESABKAkSFwoPY29uY2VwdF9tZW1iZXJzGBIgAygJEhcKD2NvbmNlcHRfbWVhbmluZxgTIAEoCUoECAsQDCKLAQoQTGVhcm5pbmdMb2dFbnRyeRIOCgZzdGF0dXMYASABKAkSEgoKbGVhcm5lZF9hdBgCIAEoCRIPCgdxdWFsaXR5GAMgASgFEhgKEHJlc3BvbnNlX3RpbWVfbXMYBCABKAMSEQoJcXVpel90eXBlGAUgASgJEhUKDWludGVydmFsX2RheXMYBiABKAUiOAoYRXhwb3J0Tm90ZWJvb2tQREZSZXF1ZXN0EhwKC25vdGVib29rX2lkGAEgASgJQge6SARyAhABIkIKGUV4cG9ydE5vdGVib29rUERGUmVzcG9uc2USEwoLcGRmX2NvbnRlbnQYASABKAwSEAoIZmlsZW5hbWUYAiABKAkiUAoRTG9va3VwV29yZFJlcXVlc3QSFQoEd29yZBgBIAEoCUIHukgEcgIQARITCgtub3RlYm9va19pZBgCIAEoCRIPCgdjb250ZXh0GAMgASgJIpkBCg5Xb3JkRGVmaW5pdGlvbhIWCg5wYXJ0X29mX3NwZWVjaBgBIAEoCRISCgpkZWZpbml0aW9uGAIgASgJEhAKCGV4YW1wbGVzGAMgAygJEhAKCHN5bm9ueW1zGAQgAygJEhUKDXByb251bmNpYXRpb24YBSABKAkSEAoIYW50b255bXMYBiADKAkSDgoGb3JpZ2luGAcgASgJIl8KEkxvb2t1cFdvcmRSZXNwb25zZRIMCgR3b3JkGAEgASgJEisKC2RlZmluaXRpb25zGAIgAygLMhYuYXBpLnYxLldvcmREZWZpbml0aW9uEg4KBnNvdXJjZRgDIAEoCSLGAQoZUmVnaXN0ZXJEZWZpbml0aW9uUmVxdWVzdBIcCgtub3RlYm9va19pZBgBIAEoCUIHukgEcgIQARIVCg1ub3RlYm9va19maWxlGAIgASgJEhMKC3NjZW5lX2luZGV4GAMgASgFEhsKCmV4cHJlc3Npb24YBCABKAlCB7pIBHICEAESGAoHbWVhbmluZxgFIAEoCUIHukgEcgIQARIWCg5wYXJ0X29mX3NwZWVjaBgGIAEoCRIQCghleGFtcGxlcxgHIAMoCSIcChpSZWdpc3RlckRlZmluaXRpb25SZXNwb25zZSKAAQoXRGVsZXRlRGVmaW5pdGlvblJlcXVlc3QSHAoLbm90ZWJvb2tfaWQYASABKAlCB7pIBHICEAESFQoNbm90ZWJvb2tfZmlsZRgCIAEoCRITCgtzY2VuZV9pbmRleBgDIAEoBRIbCgpleHByZXNzaW9uGAQgASgJQge6SARyAhABIhoKGERlbGV0ZURlZmluaXRpb25SZXNwb25zZSI/ChNFdHltb2xvZ3lPcmlnaW5Gb3JtEgwKBGZvcm0YASABKAkSDAoEcm9sZRgCIAEoCRIMCgRub3RlGAMgASgJItYBChNFdHltb2xvZ3lPcmlnaW5QYXJ0Eg4KBm9yaWdpbhgBIAEoCRIMCgR0eXBlGAIgASgJEhAKCGxhbmd1YWdlGAMgASgJEg8KB21lYW5pbmcYBCABKAkSEgoKd29yZF9jb3VudBgFIAEoBRIqCgVmb3JtcxgGIAMoCzIbLmFwaS52MS5FdHltb2xvZ3lPcmlnaW5Gb3JtEhEKCWZyb21fZm9ybRgHIAEoCRIUCgxjb25jZXB0X2tleXMYCCADKAkSFQoNc2Vzc2lvbl90aXRsZRgJIAEoCSKPAgoTRXR5bW9sb2d5RGVmaW5pdGlvbhISCgpleHByZXNzaW9uGAEgASgJEg8KB21lYW5pbmcYAiABKAkSFgoOcGFydF9vZl9zcGVlY2gYAyABKAkSDAoEbm90ZRgEIAEoCRIxCgxvcmlnaW5fcGFydHMYBSADKAsyGy5hcGkudjEuRXR5bW9sb2d5T3JpZ2luUGFydBIVCg1ub3RlYm9va19uYW1lGAYgASgJEhAKCGV4YW1wbGVzGAcgAygJEhAKCGNvbnRleHRzGAggAygJEhIKCmlzX3NraXBwZWQYCSABKAgSGgoSc2tpcHBlZF9xdWl6X3R5cGVzGAogAygJEg8KB25vdGVfaWQYCyABKAMiVgoVRXR5bW9sb2d5TWVhbmluZ0dyb3VwEg8KB21lYW5pbmcYASABKAkSLAoHb3JpZ2lucxgCIAMoCzIbLmFwaS52MS5FdHltb2xvZ3lPcmlnaW5QYXJ0IjsKG0dldEV0eW1vbG9neU5vdGVib29rUmVxdWVzdBIcCgtub3RlYm9va19pZBgBIAEoCUIHukgEcgIQASJbChVTZW1hbnRpY0NvbmNlcHRNZW1iZXISKwoGb3JpZ2luGAEgASgLMhsuYXBpLnYxLkV0eW1vbG9neU9yaWdpblBhcnQSFQoNc2Vzc2lvbl90aXRsZRgCIAEoCSJmCg9Db25jZXB0UmVsYXRpb24SDAoEdHlwZRgBIAEoCRITCgtpc19kaXJlY3RlZBgCIAEoCBIYChBmcm9tX2NvbmNlcHRfa2V5GAMgASgJEhYKDnRvX2NvbmNlcHRfa2V5GAQgASgJIr8BCg9TZW1hbnRpY0NvbmNlcHQSEwoLbm90ZWJvb2tfaWQYASABKAkSEwoLY29uY2VwdF9rZXkYAiABKAkSDwoHbWVhbmluZxgDIAEoCRIMCgRub3RlGAQgASgJEi4KB21lbWJlcnMYBSADKAsyHS5hcGkudjEuU2VtYW50aWNDb25jZXB0TWVtYmVyEjMKEm91dGdvaW5nX3JlbGF0aW9ucxgGIAMoCzIXLmFwaS52MS5Db25jZXB0UmVsYXRpb24ikAIKHEdldEV0eW1vbG9neU5vdGVib29rUmVzcG9uc2USLAoHb3JpZ2lucxgBIAMoCzIbLmFwaS52MS5FdHltb2xvZ3lPcmlnaW5QYXJ0EjAKC2RlZmluaXRpb25zGAIgAygLMhsuYXBpLnYxLkV0eW1vbG9neURlZmluaXRpb24SNQoObWVhbmluZ19ncm91cHMYAyADKAsyHS5hcGkudjEuRXR5bW9sb2d5TWVhbmluZ0dyb3VwEhQKDG9yaWdpbl9jb3VudBgEIAEoBRIYChBkZWZpbml0aW9uX2NvdW50GAUgASgFEikKCGNvbmNlcHRzGAYgAygLMhcuYXBpLnYxLlNlbWFudGljQ29uY2VwdDKhBAoPTm90ZWJvb2tTZXJ2aWNlElgKEUdldE5vdGVib29rRGV0YWlsEiAuYXBpLnYxLkdldE5vdGVib29rRGV0YWlsUmVxdWVzdBohLmFwaS52MS5HZXROb3RlYm9va0RldGFpbFJlc3BvbnNlElgKEUV4cG9ydE5vdGVib29rUERGEiAuYXBpLnYxLkV4cG9ydE5vdGVib29rUERGUmVxdWVzdBohLmFwaS52MS5FeHBvcnROb3RlYm9va1BERlJlc3BvbnNlEkMKCkxvb2t1cFdvcmQSGS5hcGkudjEuTG9va3VwV29yZFJlcXVlc3QaGi5hcGkudjEuTG9va3VwV29yZFJlc3BvbnNlElsKElJlZ2lzdGVyRGVmaW5pdGlvbhIhLmFwaS52MS5SZWdpc3RlckRlZmluaXRpb25SZXF1ZXN0GiIuYXBpLnYxLlJlZ2lzdGVyRGVmaW5pdGlvblJlc3BvbnNlElUKEERlbGV0ZURlZmluaXRpb24SHy5hcGkudjEuRGVsZXRlRGVmaW5pdGlvblJlcXVlc3QaIC5hcGkudjEuRGVsZXRlRGVmaW5pdGlvblJlc3BvbnNlEmEKFEdldEV0eW1vbG9neU5vdGVib29rEiMuYXBpLnYxLkdldEV0eW1vbG9neU5vdGVib29rUmVxdWVzdBokLmFwaS52MS5HZXRFdHltb2xvZ3lOb3RlYm9va1Jlc3BvbnNlQjhaNmdpdGh1Yi5jb20vYXQtaXNoaWthd2EvbGFuZ25lci9nZW4tcHJvdG9zL2FwaS92MTthcGl2MWIGcHJvdG8z", [file_buf_validate_validate]);
+  fileDesc("ChVhcGkvdjEvbm90ZWJvb2sucHJvdG8SBmFwaS52MSI4ChhHZXROb3RlYm9va0RldGFpbFJlcXVlc3QSHAoLbm90ZWJvb2tfaWQYASABKAlCB7pIBHICEAEifQoZR2V0Tm90ZWJvb2tEZXRhaWxSZXNwb25zZRITCgtub3RlYm9va19pZBgBIAEoCRIMCgRuYW1lGAIgASgJEiMKB3N0b3JpZXMYAyADKAsyEi5hcGkudjEuU3RvcnlFbnRyeRIYChB0b3RhbF93b3JkX2NvdW50GAQgASgFIosBCgpTdG9yeUVudHJ5Eg0KBWV2ZW50GAEgASgJEicKCG1ldGFkYXRhGAIgASgLMhUuYXBpLnYxLlN0b3J5TWV0YWRhdGESDAoEZGF0ZRgDIAEoCRIiCgZzY2VuZXMYBCADKAsyEi5hcGkudjEuU3RvcnlTY2VuZRITCgt5b3V0dWJlX3VybBgFIAEoCSJACg1TdG9yeU1ldGFkYXRhEg4KBnNlcmllcxgBIAEoCRIOCgZzZWFzb24YAiABKAUSDwoHZXBpc29kZRgDIAEoBSKHAQoKU3RvcnlTY2VuZRINCgV0aXRsZRgBIAEoCRIrCg1jb252ZXJzYXRpb25zGAIgAygLMhQuYXBpLnYxLkNvbnZlcnNhdGlvbhIpCgtkZWZpbml0aW9ucxgDIAMoCzIULmFwaS52MS5Ob3RlYm9va1dvcmQSEgoKc3RhdGVtZW50cxgFIAMoCSJZCgxDb252ZXJzYXRpb24SDwoHc3BlYWtlchgBIAEoCRINCgVxdW90ZRgCIAEoCRIUCgx0aW1lX3NlY29uZHMYAyABKAUSEwoLeW91dHViZV91cmwYBCABKAkixAQKDE5vdGVib29rV29yZBISCgpleHByZXNzaW9uGAEgASgJEhIKCmRlZmluaXRpb24YAiABKAkSDwoHbWVhbmluZxgDIAEoCRIWCg5wYXJ0X29mX3NwZWVjaBgEIAEoCRIVCg1wcm9udW5jaWF0aW9uGAUgASgJEhAKCGV4YW1wbGVzGAYgAygJEhAKCHN5bm9ueW1zGAcgAygJEhAKCGFudG9ueW1zGAggAygJEhcKD2xlYXJuaW5nX3N0YXR1cxgJIAEoCRIuCgxsZWFybmVkX2xvZ3MYCiADKAsyGC5hcGkudjEuTGVhcm5pbmdMb2dFbnRyeRIYChBuZXh0X3Jldmlld19kYXRlGAwgASgJEg4KBm9yaWdpbhgNIAEoCRISCgppc19za2lwcGVkGA4gASgIEhoKEnNraXBwZWRfcXVpel90eXBlcxgPIAMoCRIPCgdub3RlX2lkGBAgASgDEhQKDGNvbmNlcHRfaGVhZBgRIAEoCRIXCg9jb25jZXB0X21lbWJlcnMYEiADKAkSFwoPY29uY2VwdF9tZWFuaW5nGBMgASgJEg0KBWF1ZGlvGBQgASgJEhQKDHRpbWVfc2Vjb25kcxgVIAEoBRITCgt5b3V0dWJlX3VybBgWIAEoCRIQCghpc19sZWVjaBgXIAEoCBIYChBsZWVjaF9xdWl6X3R5cGVzGBggAygJEhYKDmZyZXF1ZW5jeV9iYW5kGBkgASgJEhYKDmZyZXF1ZW5jeV9yYW5rGBogASgFSgQICxAMIosBChBMZWFybmluZ0xvZ0VudHJ5Eg4KBnN0YXR1cxgBIAEoCRISCgpsZWFybmVkX2F0GAIgASgJEg8KB3F1YWxpdHkYAyABKAUSGAoQcmVzcG9uc2VfdGltZV9tcxgEIAEoAxIRCglxdWl6X3R5cGUYBSABKAkSFQoNaW50ZXJ2YWxfZGF5cxgGIAEoBSI4ChhFeHBvcnROb3RlYm9va1BERlJlcXVlc3QSHAoLbm90ZWJvb2tfaWQYASABKAlCB7pIBHICEAEiQgoZRXhwb3J0Tm90ZWJvb2tQREZSZXNwb25zZRITCgtwZGZfY29udGVudBgBIAEoDBIQCghmaWxlbmFtZRgCIAEoCSJQChFMb29rdXBXb3JkUmVxdWVzdBIVCgR3b3JkGAEgASgJQge6SARyAhABEhMKC25vdGVib29rX2lkGAIgASgJEg8KB2NvbnRleHQYAyABKAkimQEKDldvcmREZWZpbml0aW9uEhYKDnBhcnRfb2Zfc3BlZWNoGAEgASgJEhIKCmRlZmluaXRpb24YAiABKAkSEAoIZXhhbXBsZXMYAyADKAkSEAoIc3lub255bXMYBCADKAkSFQoNcHJvbnVuY2lhdGlvbhgFIAEoCRIQCghhbnRvbnltcxgGIAMoCRIOCgZvcmlnaW4YByABKAkijwEKEkxvb2t1cFdvcmRSZXNwb25zZRIMCgR3b3JkGAEgASgJEisKC2RlZmluaXRpb25zGAIgAygLMhYuYXBpLnYxLldvcmREZWZpbml0aW9uEg4KBnNvdXJjZRgDIAEoCRIWCg5mcmVxdWVuY3lfYmFuZBgEIAEoCRIWCg5mcmVxdWVuY3lfcmFuaxgFIAEoBSLGAQoZUmVnaXN0ZXJEZWZpbml0aW9uUmVxdWVzdBIcCgtub3RlYm9va19pZBgBIAEoCUIHukgEcgIQARIVCg1ub3RlYm9va19maWxlGAIgASgJEhMKC3NjZW5lX2luZGV4GAMgASgFEhsKCmV4cHJlc3Npb24YBCABKAlCB7pIBHICEAESGAoHbWVhbmluZxgFIAEoCUIHukgEcgIQARIWCg5wYXJ0X29mX3NwZWVjaBgGIAEoCRIQCghleGFtcGxlcxgHIAMoCSIcChpSZWdpc3RlckRlZmluaXRpb25SZXNwb25zZSKAAQoXRGVsZXRlRGVmaW5pdGlvblJlcXVlc3QSHAoLbm90ZWJvb2tfaWQYASABKAlCB7pIBHICEAESFQoNbm90ZWJvb2tfZmlsZRgCIAEoCRITCgtzY2VuZV9pbmRleBgDIAEoBRIbCgpleHByZXNzaW9uGAQgASgJQge6SARyAhABIhoKGERlbGV0ZURlZmluaXRpb25SZXNwb25zZSI/ChNFdHltb2xvZ3lPcmlnaW5Gb3JtEgwKBGZvcm0YASABKAkSDAoEcm9sZRgCIAEoCRIMCgRub3RlGAMgASgJItYBChNFdHltb2xvZ3lPcmlnaW5QYXJ0Eg4KBm9yaWdpbhgBIAEoCRIMCgR0eXBlGAIgASgJEhAKCGxhbmd1YWdlGAMgASgJEg8KB21lYW5pbmcYBCABKAkSEgoKd29yZF9jb3VudBgFIAEoBRIqCgVmb3JtcxgGIAMoCzIbLmFwaS52MS5FdHltb2xvZ3lPcmlnaW5Gb3JtEhEKCWZyb21fZm9ybRgHIAEoCRIUCgxjb25jZXB0X2tleXMYCCADKAkSFQoNc2Vzc2lvbl90aXRsZRgJIAEoCSKPAgoTRXR5bW9sb2d5RGVmaW5pdGlvbhISCgpleHByZXNzaW9uGAEgASgJEg8KB21lYW5pbmcYAiABKAkSFgoOcGFydF9vZl9zcGVlY2gYAyABKAkSDAoEbm90ZRgEIAEoCRIxCgxvcmlnaW5fcGFydHMYBSADKAsyGy5hcGkudjEuRXR5bW9sb2d5T3JpZ2luUGFydBIVCg1ub3RlYm9va19uYW1lGAYgASgJEhAKCGV4YW1wbGVzGAcgAygJEhAKCGNvbnRleHRzGAggAygJEhIKCmlzX3NraXBwZWQYCSABKAgSGgoSc2tpcHBlZF9xdWl6X3R5cGVzGAogAygJEg8KB25vdGVfaWQYCyABKAMiVgoVRXR5bW9sb2d5TWVhbmluZ0dyb3VwEg8KB21lYW5pbmcYASABKAkSLAoHb3JpZ2lucxgCIAMoCzIbLmFwaS52MS5FdHltb2xvZ3lPcmlnaW5QYXJ0IjsKG0dldEV0eW1vbG9neU5vdGVib29rUmVxdWVzdBIcCgtub3RlYm9va19pZBgBIAEoCUIHukgEcgIQASJbChVTZW1hbnRpY0NvbmNlcHRNZW1iZXISKwoGb3JpZ2luGAEgASgLMhsuYXBpLnYxLkV0eW1vbG9neU9yaWdpblBhcnQSFQoNc2Vzc2lvbl90aXRsZRgCIAEoCSJmCg9Db25jZXB0UmVsYXRpb24SDAoEdHlwZRgBIAEoCRITCgtpc19kaXJlY3RlZBgCIAEoCBIYChBmcm9tX2NvbmNlcHRfa2V5GAMgASgJEhYKDnRvX2NvbmNlcHRfa2V5GAQgASgJIr8BCg9TZW1hbnRpY0NvbmNlcHQSEwoLbm90ZWJvb2tfaWQYASABKAkSEwoLY29uY2VwdF9rZXkYAiABKAkSDwoHbWVhbmluZxgDIAEoCRIMCgRub3RlGAQgASgJEi4KB21lbWJlcnMYBSADKAsyHS5hcGkudjEuU2VtYW50aWNDb25jZXB0TWVtYmVyEjMKEm91dGdvaW5nX3JlbGF0aW9ucxgGIAMoCzIXLmFwaS52MS5Db25jZXB0UmVsYXRpb24ikAIKHEdldEV0eW1vbG9neU5vdGVib29rUmVzcG9uc2USLAoHb3JpZ2lucxgBIAMoCzIbLmFwaS52MS5FdHltb2xvZ3lPcmlnaW5QYXJ0EjAKC2RlZmluaXRpb25zGAIgAygLMhsuYXBpLnYxLkV0eW1vbG9neURlZmluaXRpb24SNQoObWVhbmluZ19ncm91cHMYAyADKAsyHS5hcGkudjEuRXR5bW9sb2d5TWVhbmluZ0dyb3VwEhQKDG9yaWdpbl9jb3VudBgEIAEoBRIYChBkZWZpbml0aW9uX2NvdW50GAUgASgFEikKCGNvbmNlcHRzGAYgAygLMhcuYXBpLnYxLlNlbWFudGljQ29uY2VwdCJOChZTdHJlYW1Ob3RlQXVkaW9SZXF1ZXN0EhwKC25vdGVib29rX2lkGAEgASgJQge6SARyAhABEhYKBWF1ZGlvGAIgASgJQge6SARyAhABIjwKF1N0cmVhbU5vdGVBdWRpb1Jlc3BvbnNlEg0KBWNodW5rGAEgASgMEhIKCm1lZGlhX3R5cGUYAiABKAkiOAoYR2V0RXR5bW9sb2d5R3JhcGhSZXF1ZXN0EhwKC25vdGVib29rX2lkGAEgASgJQge6SARyAhABInEKGUdldEV0eW1vbG9neUdyYXBoUmVzcG9uc2USKQoFbm9kZXMYASADKAsyGi5hcGkudjEuRXR5bW9sb2d5R3JhcGhOb2RlEikKBWVkZ2VzGAIgAygLMhouYXBpLnYxLkV0eW1vbG9neUdyYXBoRWRnZSKhAQoSRXR5bW9sb2d5R3JhcGhOb2RlEgoKAmlkGAEgASgJEgwKBGtpbmQYAiABKAkSDQoFbGFiZWwYAyABKAkSDwoHbWVhbmluZxgEIAEoCRIQCghsYW5ndWFnZRgFIAEoCRITCgtvcmlnaW5fdHlwZRgGIAEoCRIVCg1zZXNzaW9uX3RpdGxlGAcgASgJEhMKC25vdGVib29rX2lkGAggASgJImMKEkV0eW1vbG9neUdyYXBoRWRnZRIOCgZzb3VyY2UYASABKAkSDgoGdGFyZ2V0GAIgASgJEgwKBGtpbmQYAyABKAkSDQoFbGFiZWwYBCABKAkSEAoIZGlyZWN0ZWQYBSABKAgiXgoWU2VhcmNoTm90ZWJvb2tzUmVxdWVzdBIWCgVxdWVyeRgBIAEoCUIHukgEcgIQARIUCgxub3RlYm9va19pZHMYAiADKAkSFgoFbGltaXQYAyABKAVCB7pIBBoCKAAiWQoXU2VhcmNoTm90ZWJvb2tzUmVzcG9uc2USLwoLb2NjdXJyZW5jZXMYASADKAsyGi5hcGkudjEuTm90ZWJvb2tPY2N1cnJlbmNlEg0KBXRvdGFsGAIgASgFItABChJOb3RlYm9va09jY3VycmVuY2USDAoEa2luZBgBIAEoCRITCgtub3RlYm9va19pZBgCIAEoCRIWCg5ub3RlYm9va190aXRsZRgDIAEoCRITCgtzdG9yeV90aXRsZRgEIAEoCRITCgtzY2VuZV90aXRsZRgFIAEoCRIPCgdzcGVha2VyGAYgASgJEgwKBHRleHQYByABKAkSEgoKZXhwcmVzc2lvbhgIIAEoCRIPCgdtZWFuaW5nGAkgASgJEhEKCWhpZ2hsaWdodBgKIAEoCSJ1ChVTZWFyY2hQYXNzYWdlc1JlcXVlc3QSFgoFcXVlcnkYASABKAlCB7pIBHICEAESEgoKc291cmNlX2lkcxgCIAMoCRIcCglwYWdlX3NpemUYAyABKAVCCbpIBhoEGGQoABISCgpwYWdlX3Rva2VuGAQgASgJImIKFlNlYXJjaFBhc3NhZ2VzUmVzcG9uc2USIAoEaGl0cxgBIAMoCzISLmFwaS52MS5QYXNzYWdlSGl0Eg0KBXRvdGFsGAIgASgFEhcKD25leHRfcGFnZV90b2tlbhgDIAEoCSKmAQoKUGFzc2FnZUhpdBIMCgRraW5kGAEgASgJEhEKCXNvdXJjZV9pZBgCIAEoCRIUCgxzb3VyY2VfdGl0bGUYAyABKAkSDwoHc2VjdGlvbhgEIAEoCRINCgVzY2VuZRgFIAEoCRIPCgdzcGVha2VyGAYgASgJEhAKCHBvc2l0aW9uGAcgASgFEg8KB3NuaXBwZXQYCCABKAkSDQoFc2NvcmUYCSABKAEy9gYKD05vdGVib29rU2VydmljZRJYChFHZXROb3RlYm9va0RldGFpbBIgLmFwaS52MS5HZXROb3RlYm9va0RldGFpbFJlcXVlc3QaIS5hcGkudjEuR2V0Tm90ZWJvb2tEZXRhaWxSZXNwb25zZRJYChFFeHBvcnROb3RlYm9va1BERhIgLmFwaS52MS5FeHBvcnROb3RlYm9va1BERlJlcXVlc3QaIS5hcGkudjEuRXhwb3J0Tm90ZWJvb2tQREZSZXNwb25zZRJDCgpMb29rdXBXb3JkEhkuYXBpLnYxLkxvb2t1cFdvcmRSZXF1ZXN0GhouYXBpLnYxLkxvb2t1cFdvcmRSZXNwb25zZRJbChJSZWdpc3RlckRlZmluaXRpb24SIS5hcGkudjEuUmVnaXN0ZXJEZWZpbml0aW9uUmVxdWVzdBoiLmFwaS52MS5SZWdpc3RlckRlZmluaXRpb25SZXNwb25zZRJVChBEZWxldGVEZWZpbml0aW9uEh8uYXBpLnYxLkRlbGV0ZURlZmluaXRpb25SZXF1ZXN0GiAuYXBpLnYxLkRlbGV0ZURlZmluaXRpb25SZXNwb25zZRJhChRHZXRFdHltb2xvZ3lOb3RlYm9vaxIjLmFwaS52MS5HZXRFdHltb2xvZ3lOb3RlYm9va1JlcXVlc3QaJC5hcGkudjEuR2V0RXR5bW9sb2d5Tm90ZWJvb2tSZXNwb25zZRJYChFHZXRFdHltb2xvZ3lHcmFwaBIgLmFwaS52MS5HZXRFdHltb2xvZ3lHcmFwaFJlcXVlc3QaIS5hcGkudjEuR2V0RXR5bW9sb2d5R3JhcGhSZXNwb25zZRJUCg9TdHJlYW1Ob3RlQXVkaW8SHi5hcGkudjEuU3RyZWFtTm90ZUF1ZGlvUmVxdWVzdBofLmFwaS52MS5TdHJlYW1Ob3RlQXVkaW9SZXNwb25zZTABElIKD1NlYXJjaE5vdGVib29rcxIeLmFwaS52MS5TZWFyY2hOb3RlYm9va3NSZXF1ZXN0Gh8uYXBpLnYxLlNlYXJjaE5vdGVib29rc1Jlc3BvbnNlEk8KDlNlYXJjaFBhc3NhZ2VzEh0uYXBpLnYxLlNlYXJjaFBhc3NhZ2VzUmVxdWVzdBoeLmFwaS52MS5TZWFyY2hQYXNzYWdlc1Jlc3BvbnNlQjhaNmdpdGh1Yi5jb20vYXQtaXNoaWthd2EvbGFuZ25lci9nZW4tcHJvdG9zL2FwaS92MTthcGl2MWIGcHJvdG8z", [file_buf_validate_validate]);
 
 /**
  * @generated from message api.v1.GetNotebookDetailRequest
@@ -85,6 +85,13 @@ export type StoryEntry = Message<"api.v1.StoryEntry"> & {
    * @generated from field: repeated api.v1.StoryScene scenes = 4;
    */
   scenes: StoryScene[];
+
+  /**
+   * youtube_url is the video the story was taken from. Empty when none.
+   *
+   * @generated from field: string youtube_url = 5;
+   */
+  youtubeUrl: string;
 };
 
 /**
@@ -166,6 +173,19 @@ export type Conversation = Message<"api.v1.Conversation"> & {
    * @generated from field: string quote = 2;
    */
   quote: string;
+
+  /**
+   * time_seconds is when the line is said in the story's video, and
+   * youtube_url links to that moment. Zero and empty when unknown.
+   *
+   * @generated from field: int32 time_seconds = 3;
+   */
+  timeSeconds: number;
+
+  /**
+   * @generated from field: string youtube_url = 4;
+   */
+  youtubeUrl: string;
 };
 
 /**
@@ -287,6 +307,56 @@ export type NotebookWord = Message<"api.v1.NotebookWord"> & {
    * @generated from field: string concept_meaning = 19;
    */
   conceptMeaning: string;
+
+  /**
+   * audio is the note's pronunciation audio, relative to its notebook.
+   * Pass it to StreamNoteAudio to play it. Empty when the note has none.
+   *
+   * @generated from field: string audio = 20;
+   */
+  audio: string;
+
+  /**
+   * time_seconds is when the word is said in the story's video, and
+   * youtube_url links to that moment, so reviewing it can jump there.
+   * Zero and empty when unknown.
+   *
+   * @generated from field: int32 time_seconds = 21;
+   */
+  timeSeconds: number;
+
+  /**
+   * @generated from field: string youtube_url = 22;
+   */
+  youtubeUrl: string;
+
+  /**
+   * is_leech is true when the word keeps failing in at least one quiz
+   * type (see GetLeeches); leech_quiz_types lists those quiz types.
+   *
+   * @generated from field: bool is_leech = 23;
+   */
+  isLeech: boolean;
+
+  /**
+   * @generated from field: repeated string leech_quiz_types = 24;
+   */
+  leechQuizTypes: string[];
+
+  /**
+   * frequency_band is how common the word is: "very_common" (about the
+   * 1,000 most frequent words), "common" (up to 5,000), "uncommon" (up to
+   * 20,000) or "rare". Empty when unknown. frequency_rank is its rank in
+   * the configured frequency list, 0 when not listed.
+   *
+   * @generated from field: string frequency_band = 25;
+   */
+  frequencyBand: string;
+
+  /**
+   * @generated from field: int32 frequency_rank = 26;
+   */
+  frequencyRank: number;
 };
 
 /**
@@ -469,6 +539,19 @@ export type LookupWordResponse = Message<"api.v1.LookupWordResponse"> & {
    * @generated from field: string source = 3;
    */
   source: string;
+
+  /**
+   * frequency_band and frequency_rank tell how common the word is, as in
+   * NotebookWord, so the reader can point out the words worth saving.
+   *
+   * @generated from field: string frequency_band = 4;
+   */
+  frequencyBand: string;
+
+  /**
+   * @generated from field: int32 frequency_rank = 5;
+   */
+  frequencyRank: number;
 };
 
 /**
@@ -961,6 +1044,488 @@ export type GetEtymologyNotebookResponse = Message<"api.v1.GetEtymologyNotebookR
 export const GetEtymologyNotebookResponseSchema: GenMessage<GetEtymologyNotebookResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_notebook, 25);
 
+/**
+ * StreamNoteAudioRequest names the audio of a note: notebook_id and the
+ * note's audio path as returned in NotebookWord.audio.
+ *
+ * @generated from message api.v1.StreamNoteAudioRequest
+ */
+export type StreamNoteAudioRequest = Message<"api.v1.StreamNoteAudioRequest"> & {
+  /**
+   * @generated from field: string notebook_id = 1;
+   */
+  notebookId: string;
+
+  /**
+   * @generated from field: string audio = 2;
+   */
+  audio: string;
+};
+
+/**
+ * Describes the message api.v1.StreamNoteAudioRequest.
+ * Use `create(StreamNoteAudioRequestSchema)` to create a new message.
+ */
+export const StreamNoteAudioRequestSchema: GenMessage<StreamNoteAudioRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 26);
+
+/**
+ * StreamNoteAudioResponse is one chunk of the audio. The first chunk
+ * carries the media type, e.g. "audio/wav".
+ *
+ * @generated from message api.v1.StreamNoteAudioResponse
+ */
+export type StreamNoteAudioResponse = Message<"api.v1.StreamNoteAudioResponse"> & {
+  /**
+   * @generated from field: bytes chunk = 1;
+   */
+  chunk: Uint8Array;
+
+  /**
+   * @generated from field: string media_type = 2;
+   */
+  mediaType: string;
+};
+
+/**
+ * Describes the message api.v1.StreamNoteAudioResponse.
+ * Use `create(StreamNoteAudioResponseSchema)` to create a new message.
+ */
+export const StreamNoteAudioResponseSchema: GenMessage<StreamNoteAudioResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 27);
+
+/**
+ * @generated from message api.v1.GetEtymologyGraphRequest
+ */
+export type GetEtymologyGraphRequest = Message<"api.v1.GetEtymologyGraphRequest"> & {
+  /**
+   * @generated from field: string notebook_id = 1;
+   */
+  notebookId: string;
+};
+
+/**
+ * Describes the message api.v1.GetEtymologyGraphRequest.
+ * Use `create(GetEtymologyGraphRequestSchema)` to create a new message.
+ */
+export const GetEtymologyGraphRequestSchema: GenMessage<GetEtymologyGraphRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 28);
+
+/**
+ * GetEtymologyGraphResponse lists origins, then words, then concepts as
+ * nodes, and the edges between them.
+ *
+ * @generated from message api.v1.GetEtymologyGraphResponse
+ */
+export type GetEtymologyGraphResponse = Message<"api.v1.GetEtymologyGraphResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.EtymologyGraphNode nodes = 1;
+   */
+  nodes: EtymologyGraphNode[];
+
+  /**
+   * @generated from field: repeated api.v1.EtymologyGraphEdge edges = 2;
+   */
+  edges: EtymologyGraphEdge[];
+};
+
+/**
+ * Describes the message api.v1.GetEtymologyGraphResponse.
+ * Use `create(GetEtymologyGraphResponseSchema)` to create a new message.
+ */
+export const GetEtymologyGraphResponseSchema: GenMessage<GetEtymologyGraphResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 29);
+
+/**
+ * EtymologyGraphNode is an origin sense, a word whose origin_parts bind to
+ * one, or a concept.
+ *
+ * @generated from message api.v1.EtymologyGraphNode
+ */
+export type EtymologyGraphNode = Message<"api.v1.EtymologyGraphNode"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * kind is "origin", "word" or "concept".
+   *
+   * @generated from field: string kind = 2;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string label = 3;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string meaning = 4;
+   */
+  meaning: string;
+
+  /**
+   * language and origin_type are set on origins only.
+   *
+   * @generated from field: string language = 5;
+   */
+  language: string;
+
+  /**
+   * @generated from field: string origin_type = 6;
+   */
+  originType: string;
+
+  /**
+   * session_title is the session an origin or word was declared in.
+   *
+   * @generated from field: string session_title = 7;
+   */
+  sessionTitle: string;
+
+  /**
+   * notebook_id is the notebook a word is defined in.
+   *
+   * @generated from field: string notebook_id = 8;
+   */
+  notebookId: string;
+};
+
+/**
+ * Describes the message api.v1.EtymologyGraphNode.
+ * Use `create(EtymologyGraphNodeSchema)` to create a new message.
+ */
+export const EtymologyGraphNodeSchema: GenMessage<EtymologyGraphNode> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 30);
+
+/**
+ * EtymologyGraphEdge connects two nodes by id.
+ *
+ * @generated from message api.v1.EtymologyGraphEdge
+ */
+export type EtymologyGraphEdge = Message<"api.v1.EtymologyGraphEdge"> & {
+  /**
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string target = 2;
+   */
+  target: string;
+
+  /**
+   * kind is "origin_part" (word to origin), "member" (concept to origin) or
+   * "relation" (concept to concept).
+   *
+   * @generated from field: string kind = 3;
+   */
+  kind: string;
+
+  /**
+   * label is the relation type of a relation edge and the from_form of an
+   * origin part edge.
+   *
+   * @generated from field: string label = 4;
+   */
+  label: string;
+
+  /**
+   * directed is false for relations declared with between.
+   *
+   * @generated from field: bool directed = 5;
+   */
+  directed: boolean;
+};
+
+/**
+ * Describes the message api.v1.EtymologyGraphEdge.
+ * Use `create(EtymologyGraphEdgeSchema)` to create a new message.
+ */
+export const EtymologyGraphEdgeSchema: GenMessage<EtymologyGraphEdge> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 31);
+
+/**
+ * @generated from message api.v1.SearchNotebooksRequest
+ */
+export type SearchNotebooksRequest = Message<"api.v1.SearchNotebooksRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * notebook_ids limits the search to these notebooks; empty searches all.
+   *
+   * @generated from field: repeated string notebook_ids = 2;
+   */
+  notebookIds: string[];
+
+  /**
+   * limit caps the occurrences returned; 0 returns all.
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message api.v1.SearchNotebooksRequest.
+ * Use `create(SearchNotebooksRequestSchema)` to create a new message.
+ */
+export const SearchNotebooksRequestSchema: GenMessage<SearchNotebooksRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 32);
+
+/**
+ * SearchNotebooksResponse lists the occurrences in notebook order. total
+ * counts every match, including those past the limit.
+ *
+ * @generated from message api.v1.SearchNotebooksResponse
+ */
+export type SearchNotebooksResponse = Message<"api.v1.SearchNotebooksResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.NotebookOccurrence occurrences = 1;
+   */
+  occurrences: NotebookOccurrence[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+};
+
+/**
+ * Describes the message api.v1.SearchNotebooksResponse.
+ * Use `create(SearchNotebooksResponseSchema)` to create a new message.
+ */
+export const SearchNotebooksResponseSchema: GenMessage<SearchNotebooksResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 33);
+
+/**
+ * NotebookOccurrence is a part of a notebook that mentions the searched
+ * word.
+ *
+ * @generated from message api.v1.NotebookOccurrence
+ */
+export type NotebookOccurrence = Message<"api.v1.NotebookOccurrence"> & {
+  /**
+   * kind is "conversation", "statement", "note" or "example".
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string notebook_id = 2;
+   */
+  notebookId: string;
+
+  /**
+   * @generated from field: string notebook_title = 3;
+   */
+  notebookTitle: string;
+
+  /**
+   * story_title is the story event, flashcard deck title or definitions
+   * session.
+   *
+   * @generated from field: string story_title = 4;
+   */
+  storyTitle: string;
+
+  /**
+   * @generated from field: string scene_title = 5;
+   */
+  sceneTitle: string;
+
+  /**
+   * @generated from field: string speaker = 6;
+   */
+  speaker: string;
+
+  /**
+   * text is the line or example sentence; empty for notes.
+   *
+   * @generated from field: string text = 7;
+   */
+  text: string;
+
+  /**
+   * expression and meaning are the note, or the note an example belongs to.
+   *
+   * @generated from field: string expression = 8;
+   */
+  expression: string;
+
+  /**
+   * @generated from field: string meaning = 9;
+   */
+  meaning: string;
+
+  /**
+   * highlight is the matched text as written, e.g. "ran into" for "run
+   * into".
+   *
+   * @generated from field: string highlight = 10;
+   */
+  highlight: string;
+};
+
+/**
+ * Describes the message api.v1.NotebookOccurrence.
+ * Use `create(NotebookOccurrenceSchema)` to create a new message.
+ */
+export const NotebookOccurrenceSchema: GenMessage<NotebookOccurrence> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 34);
+
+/**
+ * @generated from message api.v1.SearchPassagesRequest
+ */
+export type SearchPassagesRequest = Message<"api.v1.SearchPassagesRequest"> & {
+  /**
+   * query is the words to find, each in any inflected form. Words in
+   * double quotes must appear together as a phrase, where "one's" stands
+   * for any possessive.
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * source_ids limits the search to these ebooks and story notebooks;
+   * empty searches all.
+   *
+   * @generated from field: repeated string source_ids = 2;
+   */
+  sourceIds: string[];
+
+  /**
+   * page_size is the number of hits per page, 20 when 0.
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * page_token is the next_page_token of the previous page; empty for the
+   * first page.
+   *
+   * @generated from field: string page_token = 4;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message api.v1.SearchPassagesRequest.
+ * Use `create(SearchPassagesRequestSchema)` to create a new message.
+ */
+export const SearchPassagesRequestSchema: GenMessage<SearchPassagesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 35);
+
+/**
+ * @generated from message api.v1.SearchPassagesResponse
+ */
+export type SearchPassagesResponse = Message<"api.v1.SearchPassagesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.PassageHit hits = 1;
+   */
+  hits: PassageHit[];
+
+  /**
+   * total counts the hits on every page.
+   *
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+
+  /**
+   * next_page_token fetches the next page; empty on the last one.
+   *
+   * @generated from field: string next_page_token = 3;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message api.v1.SearchPassagesResponse.
+ * Use `create(SearchPassagesResponseSchema)` to create a new message.
+ */
+export const SearchPassagesResponseSchema: GenMessage<SearchPassagesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 36);
+
+/**
+ * PassageHit is an ebook paragraph or story line matching a search.
+ *
+ * @generated from message api.v1.PassageHit
+ */
+export type PassageHit = Message<"api.v1.PassageHit"> & {
+  /**
+   * kind is "book" or "story".
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * source_id is the ebook or story notebook ID. An ebook's ID is also the
+   * ID of the book notebook generated from it.
+   *
+   * @generated from field: string source_id = 2;
+   */
+  sourceId: string;
+
+  /**
+   * @generated from field: string source_title = 3;
+   */
+  sourceTitle: string;
+
+  /**
+   * section is the chapter title of a book or the event of a story.
+   *
+   * @generated from field: string section = 4;
+   */
+  section: string;
+
+  /**
+   * @generated from field: string scene = 5;
+   */
+  scene: string;
+
+  /**
+   * @generated from field: string speaker = 6;
+   */
+  speaker: string;
+
+  /**
+   * position is the 1-based number of the paragraph in its chapter, or of
+   * the line in its scene.
+   *
+   * @generated from field: int32 position = 7;
+   */
+  position: number;
+
+  /**
+   * snippet is the text around the best match with every matched word in
+   * {{ }} markers, and "…" where the text was cut.
+   *
+   * @generated from field: string snippet = 8;
+   */
+  snippet: string;
+
+  /**
+   * @generated from field: double score = 9;
+   */
+  score: number;
+};
+
+/**
+ * Describes the message api.v1.PassageHit.
+ * Use `create(PassageHitSchema)` to create a new message.
+ */
+export const PassageHitSchema: GenMessage<PassageHit> = /*@__PURE__*/
+  messageDesc(file_api_v1_notebook, 37);
+
 /**
  * @generated from service api.v1.NotebookService
  */
@@ -1013,6 +1578,49 @@ export const NotebookService: GenService<{
     input: typeof GetEtymologyNotebookRequestSchema;
     output: typeof GetEtymologyNotebookResponseSchema;
   },
+  /**
+   * GetEtymologyGraph returns the word family graph of an etymology
+   * notebook, the same graph `langner notebooks etymology --format` writes.
+   *
+   * @generated from rpc api.v1.NotebookService.GetEtymologyGraph
+   */
+  getEtymologyGraph: {
+    methodKind: "unary";
+    input: typeof GetEtymologyGraphRequestSchema;
+    output: typeof GetEtymologyGraphResponseSchema;
+  },
+  /**
+   * StreamNoteAudio streams the pronunciation audio a note refers to.
+   *
+   * @generated from rpc api.v1.NotebookService.StreamNoteAudio
+   */
+  streamNoteAudio: {
+    methodKind: "server_streaming";
+    input: typeof StreamNoteAudioRequestSchema;
+    output: typeof StreamNoteAudioResponseSchema;
+  },
+  /**
+   * SearchNotebooks lists every scene line, statement, note and example
+   * across all notebooks that uses a word or phrase in any inflected form.
+   *
+   * @generated from rpc api.v1.NotebookService.SearchNotebooks
+   */
+  searchNotebooks: {
+    methodKind: "unary";
+    input: typeof SearchNotebooksRequestSchema;
+    output: typeof SearchNotebooksResponseSchema;
+  },
+  /**
+   * SearchPassages ranks the ebook paragraphs and story lines containing
+   * every word of a query, best match first, a page at a time.
+   *
+   * @generated from rpc api.v1.NotebookService.SearchPassages
+   */
+  searchPassages: {
+    methodKind: "unary";
+    input: typeof SearchPassagesRequestSchema;
+    output: typeof SearchPassagesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_notebook, 0);
 
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/quiz.proto.
  */
 export const file_api_v1_quiz: GenFile = /*@__PURE__*/
-  fileDesc("ChFhcGkvdjEvcXVpei5wcm90bxIGYXBpLnYxIjIKFUdldFF1aXpPcHRpb25zUmVxdWVzdBIZChFpbmNsdWRlX3Vuc3R1ZGllZBgBIAEoCCJEChZHZXRRdWl6T3B0aW9uc1Jlc3BvbnNlEioKCW5vdGVib29rcxgBIAMoCzIXLmFwaS52MS5Ob3RlYm9va1N1bW1hcnkivQIKD05vdGVib29rU3VtbWFyeRITCgtub3RlYm9va19pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhQKDHJldmlld19jb3VudBgDIAEoBRIMCgRraW5kGAQgASgJEhwKFHJldmVyc2VfcmV2aWV3X2NvdW50GAUgASgFEh4KFmV0eW1vbG9neV9yZXZpZXdfY291bnQYBiABKAUSEwoLaGFzX2NvbnRlbnQYByABKAgSMAoIc2VjdGlvbnMYCCADKAsyHi5hcGkudjEuTm90ZWJvb2tTZWN0aW9uU3VtbWFyeRImCh5ldHltb2xvZ3lfcmV2ZXJzZV9yZXZpZXdfY291bnQYCSABKAUSHAoUZ3JhbW1hcl9yZXZpZXdfY291bnQYCiABKAUSGAoQdm9jYWJ1bGFyeV9jb3VudBgLIAEoBSLBAQoWTm90ZWJvb2tTZWN0aW9uU3VtbWFyeRINCgV0aXRsZRgBIAEoCRIUCgxyZXZpZXdfY291bnQYAiABKAUSHAoUcmV2ZXJzZV9yZXZpZXdfY291bnQYAyABKAUSHgoWZXR5bW9sb2d5X3Jldmlld19jb3VudBgEIAEoBRImCh5ldHltb2xvZ3lfcmV2ZXJzZV9yZXZpZXdfY291bnQYBSABKAUSHAoUZ3JhbW1hcl9yZXZpZXdfY291bnQYBiABKAUiRwoPTm90ZWJvb2tTZWN0aW9uEhwKC25vdGVib29rX2lkGAEgASgJQge6SARyAhABEhYKDnNlY3Rpb25fdGl0bGVzGAIgAygJIncKEFN0YXJ0UXVpelJlcXVlc3QSFAoMbm90ZWJvb2tfaWRzGAEgAygJEhkKEWluY2x1ZGVfdW5zdHVkaWVkGAIgASgIEjIKEW5vdGVib29rX3NlY3Rpb25zGAMgAygLMhcuYXBpLnYxLk5vdGVib29rU2VjdGlvbiI6ChFTdGFydFF1aXpSZXNwb25zZRIlCgpmbGFzaGNhcmRzGAEgAygLMhEuYXBpLnYxLkZsYXNoY2FyZCKuAQoJRmxhc2hjYXJkEg8KB25vdGVfaWQYASABKAMSDQoFZW50cnkYAiABKAkSIQoIZXhhbXBsZXMYAyADKAsyDy5hcGkudjEuRXhhbXBsZRIWCg5vcmlnaW5hbF9lbnRyeRgEIAEoCRIUCgxjb25jZXB0X2hlYWQYBSABKAkSFwoPY29uY2VwdF9tZW1iZXJzGAYgAygJEhcKD2NvbmNlcHRfbWVhbmluZxgHIAEoCSI7CgdFeGFtcGxlEgwKBHRleHQYASABKAkSDwoHc3BlYWtlchgCIAEoCRIRCgloaWdobGlnaHQYAyABKAkiqwEKCldvcmREZXRhaWwSDgoGb3JpZ2luGAEgASgJEhUKDXByb251bmNpYXRpb24YAiABKAkSFgoOcGFydF9vZl9zcGVlY2gYAyABKAkSEAoIc3lub255bXMYBCADKAkSEAoIYW50b255bXMYBSADKAkSDAoEbWVtbxgGIAEoCRIsCgxvcmlnaW5fcGFydHMYByADKAsyFi5hcGkudjEuV29yZE9yaWdpblBhcnQiUQoOV29yZE9yaWdpblBhcnQSDgoGb3JpZ2luGAEgASgJEgwKBHR5cGUYAiABKAkSEAoIbGFuZ3VhZ2UYAyABKAkSDwoHbWVhbmluZxgEIAEoCSJtChNTdWJtaXRBbnN3ZXJSZXF1ZXN0EhgKB25vdGVfaWQYASABKANCB7pIBCICIAASDgoGYW5zd2VyGAIgASgJEhgKEHJlc3BvbnNlX3RpbWVfbXMYAyABKAMSEgoKaXNfc2tpcHBlZBgEIAEoCCLBAQoUU3VibWl0QW5zd2VyUmVzcG9uc2USDwoHY29ycmVjdBgBIAEoCBIPCgdtZWFuaW5nGAIgASgJEg4KBnJlYXNvbhgDIAEoCRInCgt3b3JkX2RldGFpbBgEIAEoCzISLmFwaS52MS5Xb3JkRGV0YWlsEhgKEG5leHRfcmV2aWV3X2RhdGUYBSABKAkSEgoKbGVhcm5lZF9hdBgGIAEoCRIOCgZpbWFnZXMYByADKAkSEAoIc2Vuc2VfaWQYCCABKAkiUwoZQmF0Y2hTdWJtaXRBbnN3ZXJzUmVxdWVzdBI2CgdhbnN3ZXJzGAEgAygLMhsuYXBpLnYxLlN1Ym1pdEFuc3dlclJlcXVlc3RCCLpIBZIBAggBIk0KGkJhdGNoU3VibWl0QW5zd2Vyc1Jlc3BvbnNlEi8KCXJlc3BvbnNlcxgBIAMoCzIcLmFwaS52MS5TdWJtaXRBbnN3ZXJSZXNwb25zZSKcAQoXU3RhcnRSZXZlcnNlUXVpelJlcXVlc3QSFAoMbm90ZWJvb2tfaWRzGAEgAygJEhwKFGxpc3RfbWlzc2luZ19jb250ZXh0GAIgASgIEjIKEW5vdGVib29rX3NlY3Rpb25zGAMgAygLMhcuYXBpLnYxLk5vdGVib29rU2VjdGlvbhIZChFpbmNsdWRlX3Vuc3R1ZGllZBgEIAEoCCJIChhTdGFydFJldmVyc2VRdWl6UmVzcG9uc2USLAoKZmxhc2hjYXJkcxgBIAMoCzIYLmFwaS52MS5SZXZlcnNlRmxhc2hjYXJkIugBChBSZXZlcnNlRmxhc2hjYXJkEg8KB25vdGVfaWQYASABKAMSDwoHbWVhbmluZxgCIAEoCRIpCghjb250ZXh0cxgDIAMoCzIXLmFwaS52MS5Db250ZXh0U2VudGVuY2USFQoNbm90ZWJvb2tfbmFtZRgEIAEoCRITCgtzdG9yeV90aXRsZRgFIAEoCRITCgtzY2VuZV90aXRsZRgGIAEoCRIUCgxjb25jZXB0X2hlYWQYByABKAkSFwoPY29uY2VwdF9tZW1iZXJzGAggAygJEhcKD2NvbmNlcHRfbWVhbmluZxgJIAEoCSI6Cg9Db250ZXh0U2VudGVuY2USDwoHY29udGV4dBgBIAEoCRIWCg5tYXNrZWRfY29udGV4dBgCIAEoCSKXAQoaU3VibWl0UmV2ZXJzZUFuc3dlclJlcXVlc3QSGAoHbm90ZV9pZBgBIAEoA0IHukgEIgIgABIOCgZhbnN3ZXIYAiABKAkSGAoQcmVzcG9uc2VfdGltZV9tcxgDIAEoAxIhChlhY2NlcHRfc3lub255bV9hc19jb3JyZWN0GAQgASgIEhIKCmlzX3NraXBwZWQYBSABKAgihgIKG1N1Ym1pdFJldmVyc2VBbnN3ZXJSZXNwb25zZRIPCgdjb3JyZWN0GAEgASgIEhIKCmV4cHJlc3Npb24YAiABKAkSDwoHbWVhbmluZxgDIAEoCRIOCgZyZWFzb24YBCABKAkSEAoIY29udGV4dHMYBSADKAkSJwoLd29yZF9kZXRhaWwYBiABKAsyEi5hcGkudjEuV29yZERldGFpbBIWCg5jbGFzc2lmaWNhdGlvbhgHIAEoCRIYChBuZXh0X3Jldmlld19kYXRlGAggASgJEhIKCmxlYXJuZWRfYXQYCSABKAkSDgoGaW1hZ2VzGAogAygJEhAKCHNlbnNlX2lkGAsgASgJImEKIEJhdGNoU3VibWl0UmV2ZXJzZUFuc3dlcnNSZXF1ZXN0Ej0KB2Fuc3dlcnMYASADKAsyIi5hcGkudjEuU3VibWl0UmV2ZXJzZUFuc3dlclJlcXVlc3RCCLpIBZIBAggBIlsKIUJhdGNoU3VibWl0UmV2ZXJzZUFuc3dlcnNSZXNwb25zZRI2CglyZXNwb25zZXMYASADKAsyIy5hcGkudjEuU3VibWl0UmV2ZXJzZUFuc3dlclJlc3BvbnNlIhoKGFN0YXJ0RnJlZWZvcm1RdWl6UmVxdWVzdCLrAQoZU3RhcnRGcmVlZm9ybVF1aXpSZXNwb25zZRISCgp3b3JkX2NvdW50GAEgASgFEhMKC2V4cHJlc3Npb25zGAIgAygJEmQKG2V4cHJlc3Npb25fbmV4dF9yZXZpZXdfZGF0ZRgDIAMoCzI/LmFwaS52MS5TdGFydEZyZWVmb3JtUXVpelJlc3BvbnNlLkV4cHJlc3Npb25OZXh0UmV2aWV3RGF0ZUVudHJ5Gj8KHUV4cHJlc3Npb25OZXh0UmV2aWV3RGF0ZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEicAobU3VibWl0RnJlZWZvcm1BbnN3ZXJSZXF1ZXN0EhkKBHdvcmQYASABKAlCC7pICHIGEAEyAlxTEhwKB21lYW5pbmcYAiABKAlCC7pICHIGEAEyAlxTEhgKEHJlc3BvbnNlX3RpbWVfbXMYAyABKAMikAIKHFN1Ym1pdEZyZWVmb3JtQW5zd2VyUmVzcG9uc2USDwoHY29ycmVjdBgBIAEoCBIMCgR3b3JkGAIgASgJEg8KB21lYW5pbmcYAyABKAkSDgoGcmVhc29uGAQgASgJEg8KB2NvbnRleHQYBSABKAkSFQoNbm90ZWJvb2tfbmFtZRgGIAEoCRInCgt3b3JkX2RldGFpbBgHIAEoCzISLmFwaS52MS5Xb3JkRGV0YWlsEhgKEG5leHRfcmV2aWV3X2RhdGUYCCABKAkSEgoKbGVhcm5lZF9hdBgJIAEoCRIPCgdub3RlX2lkGAogASgDEg4KBmltYWdlcxgLIAMoCRIQCghzZW5zZV9pZBgMIAEoCSLFAgoVT3ZlcnJpZGVBbnN3ZXJSZXF1ZXN0EhgKB25vdGVfaWQYASABKANCB7pIBCICIAASIwoJcXVpel90eXBlGAIgASgOMhAuYXBpLnYxLlF1aXpUeXBlEhsKCmxlYXJuZWRfYXQYAyABKAlCB7pIBHICEAoSGQoMbWFya19jb3JyZWN0GAQgASgISACIAQESHQoQbmV4dF9yZXZpZXdfZGF0ZRgFIAEoCUgBiAEBEhAKCHNlbnNlX2lkGAYgASgJEhwKD3dvcmRfZXhwcmVzc2lvbhgHIAEoCUgCiAEBEhoKDXdvcmRfZXhjbHVkZWQYCCABKAhIA4gBAUIPCg1fbWFya19jb3JyZWN0QhMKEV9uZXh0X3Jldmlld19kYXRlQhIKEF93b3JkX2V4cHJlc3Npb25CEAoOX3dvcmRfZXhjbHVkZWQiiwEKFk92ZXJyaWRlQW5zd2VyUmVzcG9uc2USGAoQbmV4dF9yZXZpZXdfZGF0ZRgBIAEoCRIYChBvcmlnaW5hbF9xdWFsaXR5GAIgASgFEhcKD29yaWdpbmFsX3N0YXR1cxgDIAEoCRIeChZvcmlnaW5hbF9pbnRlcnZhbF9kYXlzGAQgASgFSgQIBRAGIuIBChlVbmRvT3ZlcnJpZGVBbnN3ZXJSZXF1ZXN0EhgKB25vdGVfaWQYASABKANCB7pIBCICIAASIwoJcXVpel90eXBlGAIgASgOMhAuYXBpLnYxLlF1aXpUeXBlEhsKCmxlYXJuZWRfYXQYAyABKAlCB7pIBHICEAoSGAoQb3JpZ2luYWxfcXVhbGl0eRgEIAEoBRIXCg9vcmlnaW5hbF9zdGF0dXMYBSABKAkSHgoWb3JpZ2luYWxfaW50ZXJ2YWxfZGF5cxgGIAEoBRIQCghzZW5zZV9pZBgIIAEoCUoECAcQCCJHChpVbmRvT3ZlcnJpZGVBbnN3ZXJSZXNwb25zZRIPCgdjb3JyZWN0GAEgASgIEhgKEG5leHRfcmV2aWV3X2RhdGUYAiABKAkigAEKD1NraXBXb3JkUmVxdWVzdBIYCgdub3RlX2lkGAEgASgDQge6SAQiAiAAEi4KCnF1aXpfdHlwZXMYBCADKA4yEC5hcGkudjEuUXVpelR5cGVCCLpIBZIBAggBEhIKCnNraXBfdW50aWwYAyABKAlKBAgCEANSCXF1aXpfdHlwZSISChBTa2lwV29yZFJlc3BvbnNlIm4KEVJlc3VtZVdvcmRSZXF1ZXN0EhgKB25vdGVfaWQYASABKANCB7pIBCICIAASLgoKcXVpel90eXBlcxgDIAMoDjIQLmFwaS52MS5RdWl6VHlwZUIIukgFkgECCAFKBAgCEANSCXF1aXpfdHlwZSIUChJSZXN1bWVXb3JkUmVzcG9uc2Ui4gEKC0dyYXBoUHJvbXB0EigKBXNoYXBlGAEgASgOMhkuYXBpLnYxLkdyYXBoUHJvbXB0LlNoYXBlEiAKBW5vZGVzGAIgAygLMhEuYXBpLnYxLkdyYXBoTm9kZRIgCgVlZGdlcxgDIAMoCzIRLmFwaS52MS5HcmFwaEVkZ2USFQoNYmxhbmtfbm9kZV9pZBgEIAEoCSJOCgVTaGFwZRIVChFTSEFQRV9VTlNQRUNJRklFRBAAEgsKB0NMVVNURVIQARIQCgxBTlRPTllNX1BBSVIQAhIPCgtGT1JNX0JSQU5DSBADItABCglHcmFwaE5vZGUSCgoCaWQYASABKAkSJAoEa2luZBgCIAEoDjIWLmFwaS52MS5HcmFwaE5vZGUuS2luZBINCgVsYWJlbBgDIAEoCRIQCghsYW5ndWFnZRgEIAEoCRIMCgRoaW50GAUgASgJEg8KB21lYW5pbmcYBiABKAkiUQoES2luZBIUChBLSU5EX1VOU1BFQ0lGSUVEEAASCwoHQ09OQ0VQVBABEgoKBk9SSUdJThACEggKBEZPUk0QAxIQCgxFTkdMSVNIX1dPUkQQBCIzCglHcmFwaEVkZ2USDAoEZnJvbRgBIAEoCRIKCgJ0bxgCIAEoCRIMCgR0eXBlGAMgASgJIjsKF1N0YXJ0UmVsZWFyblF1aXpSZXF1ZXN0EiAKDHdpbmRvd19ob3VycxgBIAEoBUIKukgHGgUYqAEoACI+ChhTdGFydFJlbGVhcm5RdWl6UmVzcG9uc2USIgoFY2FyZHMYASADKAsyEy5hcGkudjEuUmVsZWFybkNhcmQinwMKC1JlbGVhcm5DYXJkEg8KB25vdGVfaWQYASABKAMSDQoFZW50cnkYAiABKAkSKgoQc291cmNlX3F1aXpfdHlwZRgDIAEoDjIQLmFwaS52MS5RdWl6VHlwZRIPCgdtZWFuaW5nGAQgASgJEiEKCGV4YW1wbGVzGAUgAygLMg8uYXBpLnYxLkV4YW1wbGUSKQoIY29udGV4dHMYBiADKAsyFy5hcGkudjEuQ29udGV4dFNlbnRlbmNlEgwKBHR5cGUYByABKAkSEAoIbGFuZ3VhZ2UYCCABKAkSDwoHY29udGVudBgJIAEoCRIRCglpbmNvcnJlY3QYCiABKAkSEwoLb3JpZ2luX3RleHQYCyABKAkSFgoOb3JpZ2luX21lYW5pbmcYDCABKAkSFQoNZW5nbGlzaF9mb3JtcxgNIAMoCRIqChBvcmlnaW5fZGlyZWN0aW9uGA4gASgOMhAuYXBpLnYxLlF1aXpUeXBlEjEKDXJlbGF0ZWRfd29yZHMYDyADKAsyGi5hcGkudjEuT3JpZ2luRmFtaWx5TWVtYmVyIjMKEk9yaWdpbkZhbWlseU1lbWJlchIMCgR3b3JkGAEgASgJEg8KB21lYW5pbmcYAiABKAkidAoaU3VibWl0UmVsZWFybkFuc3dlclJlcXVlc3QSGAoHbm90ZV9pZBgBIAEoA0IHukgEIgIgABIOCgZhbnN3ZXIYAiABKAkSGAoQcmVzcG9uc2VfdGltZV9tcxgDIAEoAxISCgppc19za2lwcGVkGAQgASgIIrgCChtTdWJtaXRSZWxlYXJuQW5zd2VyUmVzcG9uc2USDwoHY29ycmVjdBgBIAEoCBIPCgdtZWFuaW5nGAIgASgJEg4KBnJlYXNvbhgDIAEoCRInCgt3b3JkX2RldGFpbBgEIAEoCzISLmFwaS52MS5Xb3JkRGV0YWlsEg4KBmltYWdlcxgFIAMoCRIzCg5jb250ZXh0X3NjZW5lcxgGIAMoCzIbLmFwaS52MS5SZWxlYXJuQ29udGV4dFNjZW5lEhYKDmNvcnJlY3RfYW5zd2VyGAkgASgJEhAKCGNhdGVnb3J5GAogASgJEhQKDGdyYW1tYXJfbm90ZRgLIAEoCRIPCgdsaXRlcmFsGAwgASgJSgQIBxAISgQICBAJUg1ncmFwaF9jb250ZXh0Ug1leGFtcGxlX3dvcmRzIo0BChNSZWxlYXJuQ29udGV4dFNjZW5lEhUKDW5vdGVib29rX25hbWUYASABKAkSEwoLc2NlbmVfdGl0bGUYAiABKAkSEgoKc3RhdGVtZW50cxgDIAMoCRI2Cg1jb252ZXJzYXRpb25zGAQgAygLMh8uYXBpLnYxLlJlbGVhcm5Db252ZXJzYXRpb25MaW5lIjkKF1JlbGVhcm5Db252ZXJzYXRpb25MaW5lEg8KB3NwZWFrZXIYASABKAkSDQoFcXVvdGUYAiABKAkiYQogQmF0Y2hTdWJtaXRSZWxlYXJuQW5zd2Vyc1JlcXVlc3QSPQoHYW5zd2VycxgBIAMoCzIiLmFwaS52MS5TdWJtaXRSZWxlYXJuQW5zd2VyUmVxdWVzdEIIukgFkgECCAEiWwohQmF0Y2hTdWJtaXRSZWxlYXJuQW5zd2Vyc1Jlc3BvbnNlEjYKCXJlc3BvbnNlcxgBIAMoCzIjLmFwaS52MS5TdWJtaXRSZWxlYXJuQW5zd2VyUmVzcG9uc2UifgoXU3RhcnRHcmFtbWFyUXVpelJlcXVlc3QSFAoMbm90ZWJvb2tfaWRzGAEgAygJEhkKEWluY2x1ZGVfdW5zdHVkaWVkGAIgASgIEjIKEW5vdGVib29rX3NlY3Rpb25zGAMgAygLMhcuYXBpLnYxLk5vdGVib29rU2VjdGlvbiJCChhTdGFydEdyYW1tYXJRdWl6UmVzcG9uc2USJgoFcG9zdHMYASADKAsyFy5hcGkudjEuR3JhbW1hclBvc3RDYXJkIoABCg9HcmFtbWFyUG9zdENhcmQSEwoLbm90ZWJvb2tfaWQYASABKAkSEAoIZW50cnlfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSEQoJcG9zdF90ZXh0GAQgASgJEiQKBmJsYW5rcxgFIAMoCzIULmFwaS52MS5HcmFtbWFyQmxhbmsidAoMR3JhbW1hckJsYW5rEg8KB25vdGVfaWQYASABKAMSEAoIc2Vuc2VfaWQYAiABKAkSEQoJaW5jb3JyZWN0GAMgASgJEgwKBGxpbmUYBCABKAUSEAoIY2F0ZWdvcnkYBSABKAkSDgoGc3RhdHVzGAYgASgJIlEKGFN1Ym1pdEdyYW1tYXJQb3N0UmVxdWVzdBI1CgdhbnN3ZXJzGAEgAygLMhouYXBpLnYxLkdyYW1tYXJCbGFua0Fuc3dlckIIukgFkgECCAEibAoSR3JhbW1hckJsYW5rQW5zd2VyEhgKB25vdGVfaWQYASABKANCB7pIBCICIAASDgoGYW5zd2VyGAIgASgJEhgKEHJlc3BvbnNlX3RpbWVfbXMYAyABKAMSEgoKaXNfc2tpcHBlZBgEIAEoCCJIChlTdWJtaXRHcmFtbWFyUG9zdFJlc3BvbnNlEisKB3Jlc3VsdHMYASADKAsyGi5hcGkudjEuR3JhbW1hckJsYW5rUmVzdWx0ItcBChJHcmFtbWFyQmxhbmtSZXN1bHQSDwoHbm90ZV9pZBgBIAEoAxIQCghzZW5zZV9pZBgCIAEoCRIPCgdjb3JyZWN0GAMgASgIEhYKDmNvcnJlY3RfYW5zd2VyGAQgASgJEhEKCWluY29ycmVjdBgFIAEoCRIOCgZyZWFzb24YBiABKAkSEAoIY2F0ZWdvcnkYByABKAkSGAoQbmV4dF9yZXZpZXdfZGF0ZRgIIAEoCRISCgpsZWFybmVkX2F0GAkgASgJEhIKCmFzc2Vzc21lbnQYCiABKAkiUgoaTGlzdEdyYW1tYXJNaXN0YWtlc1JlcXVlc3QSHAoLbm90ZWJvb2tfaWQYASABKAlCB7pIBHICEAESFgoOc2VjdGlvbl90aXRsZXMYAiADKAkiRwobTGlzdEdyYW1tYXJNaXN0YWtlc1Jlc3BvbnNlEigKCG1pc3Rha2VzGAEgAygLMhYuYXBpLnYxLkdyYW1tYXJNaXN0YWtlIq4BCg5HcmFtbWFyTWlzdGFrZRIQCghzZW5zZV9pZBgBIAEoCRIQCghlbnRyeV9pZBgCIAEoCRINCgV0aXRsZRgDIAEoCRIRCglpbmNvcnJlY3QYBCABKAkSDwoHY29ycmVjdBgFIAEoCRIQCghjYXRlZ29yeRgGIAEoCRIOCgZyZWFzb24YByABKAkSDgoGc3RhdHVzGAggASgJEhMKC2lzX2V4Y2x1ZGVkGAkgASgIIlcKHEV4Y2x1ZGVHcmFtbWFyTWlzdGFrZVJlcXVlc3QSHAoLbm90ZWJvb2tfaWQYASABKAlCB7pIBHICEAESGQoIc2Vuc2VfaWQYAiABKAlCB7pIBHICEAEiHwodRXhjbHVkZUdyYW1tYXJNaXN0YWtlUmVzcG9uc2UiVgobUmVzdW1lR3JhbW1hck1pc3Rha2VSZXF1ZXN0EhwKC25vdGVib29rX2lkGAEgASgJQge6SARyAhABEhkKCHNlbnNlX2lkGAIgASgJQge6SARyAhABIh4KHFJlc3VtZUdyYW1tYXJNaXN0YWtlUmVzcG9uc2UiWAobRXhjbHVkZUV0eW1vbG9neVdvcmRSZXF1ZXN0EhwKC25vdGVib29rX2lkGAEgASgJQge6SARyAhABEhsKCmV4cHJlc3Npb24YAiABKAlCB7pIBHICEAEiHgocRXhjbHVkZUV0eW1vbG9neVdvcmRSZXNwb25zZSJXChpSZXN1bWVFdHltb2xvZ3lXb3JkUmVxdWVzdBIcCgtub3RlYm9va19pZBgBIAEoCUIHukgEcgIQARIbCgpleHByZXNzaW9uGAIgASgJQge6SARyAhABIh0KG1Jlc3VtZUV0eW1vbG9neVdvcmRSZXNwb25zZSrGAQoIUXVpelR5cGUSGQoVUVVJWl9UWVBFX1VOU1BFQ0lGSUVEEAASFgoSUVVJWl9UWVBFX1NUQU5EQVJEEAESFQoRUVVJWl9UWVBFX1JFVkVSU0UQAhIWChJRVUlaX1RZUEVfRlJFRUZPUk0QAxIeChpRVUlaX1RZUEVfRVRZTU9MT0dZX09SSUdJThAEEhUKEVFVSVpfVFlQRV9SRUxFQVJOEAcSFQoRUVVJWl9UWVBFX0dSQU1NQVIQCCIECAUQBSIECAYQBjKmEAoLUXVpelNlcnZpY2USTwoOR2V0UXVpek9wdGlvbnMSHS5hcGkudjEuR2V0UXVpek9wdGlvbnNSZXF1ZXN0Gh4uYXBpLnYxLkdldFF1aXpPcHRpb25zUmVzcG9uc2USQAoJU3RhcnRRdWl6EhguYXBpLnYxLlN0YXJ0UXVpelJlcXVlc3QaGS5hcGkudjEuU3RhcnRRdWl6UmVzcG9uc2USSQoMU3VibWl0QW5zd2VyEhsuYXBpLnYxLlN1Ym1pdEFuc3dlclJlcXVlc3QaHC5hcGkudjEuU3VibWl0QW5zd2VyUmVzcG9uc2USWwoSQmF0Y2hTdWJtaXRBbnN3ZXJzEiEuYXBpLnYxLkJhdGNoU3VibWl0QW5zd2Vyc1JlcXVlc3QaIi5hcGkudjEuQmF0Y2hTdWJtaXRBbnN3ZXJzUmVzcG9uc2USVQoQU3RhcnRSZXZlcnNlUXVpehIfLmFwaS52MS5TdGFydFJldmVyc2VRdWl6UmVxdWVzdBogLmFwaS52MS5TdGFydFJldmVyc2VRdWl6UmVzcG9uc2USXgoTU3VibWl0UmV2ZXJzZUFuc3dlchIiLmFwaS52MS5TdWJtaXRSZXZlcnNlQW5zd2VyUmVxdWVzdBojLmFwaS52MS5TdWJtaXRSZXZlcnNlQW5zd2VyUmVzcG9uc2UScAoZQmF0Y2hTdWJtaXRSZXZlcnNlQW5zd2VycxIoLmFwaS52MS5CYXRjaFN1Ym1pdFJldmVyc2VBbnN3ZXJzUmVxdWVzdBopLmFwaS52MS5CYXRjaFN1Ym1pdFJldmVyc2VBbnN3ZXJzUmVzcG9uc2USWAoRU3RhcnRGcmVlZm9ybVF1aXoSIC5hcGkudjEuU3RhcnRGcmVlZm9ybVF1aXpSZXF1ZXN0GiEuYXBpLnYxLlN0YXJ0RnJlZWZvcm1RdWl6UmVzcG9uc2USYQoUU3VibWl0RnJlZWZvcm1BbnN3ZXISIy5hcGkudjEuU3VibWl0RnJlZWZvcm1BbnN3ZXJSZXF1ZXN0GiQuYXBpLnYxLlN1Ym1pdEZyZWVmb3JtQW5zd2VyUmVzcG9uc2USTwoOT3ZlcnJpZGVBbnN3ZXISHS5hcGkudjEuT3ZlcnJpZGVBbnN3ZXJSZXF1ZXN0Gh4uYXBpLnYxLk92ZXJyaWRlQW5zd2VyUmVzcG9uc2USWwoSVW5kb092ZXJyaWRlQW5zd2VyEiEuYXBpLnYxLlVuZG9PdmVycmlkZUFuc3dlclJlcXVlc3QaIi5hcGkudjEuVW5kb092ZXJyaWRlQW5zd2VyUmVzcG9uc2USPQoIU2tpcFdvcmQSFy5hcGkudjEuU2tpcFdvcmRSZXF1ZXN0GhguYXBpLnYxLlNraXBXb3JkUmVzcG9uc2USQwoKUmVzdW1lV29yZBIZLmFwaS52MS5SZXN1bWVXb3JkUmVxdWVzdBoaLmFwaS52MS5SZXN1bWVXb3JkUmVzcG9uc2USYQoURXhjbHVkZUV0eW1vbG9neVdvcmQSIy5hcGkudjEuRXhjbHVkZUV0eW1vbG9neVdvcmRSZXF1ZXN0GiQuYXBpLnYxLkV4Y2x1ZGVFdHltb2xvZ3lXb3JkUmVzcG9uc2USXgoTUmVzdW1lRXR5bW9sb2d5V29yZBIiLmFwaS52MS5SZXN1bWVFdHltb2xvZ3lXb3JkUmVxdWVzdBojLmFwaS52MS5SZXN1bWVFdHltb2xvZ3lXb3JkUmVzcG9uc2USVQoQU3RhcnRSZWxlYXJuUXVpehIfLmFwaS52MS5TdGFydFJlbGVhcm5RdWl6UmVxdWVzdBogLmFwaS52MS5TdGFydFJlbGVhcm5RdWl6UmVzcG9uc2USXgoTU3VibWl0UmVsZWFybkFuc3dlchIiLmFwaS52MS5TdWJtaXRSZWxlYXJuQW5zd2VyUmVxdWVzdBojLmFwaS52MS5TdWJtaXRSZWxlYXJuQW5zd2VyUmVzcG9uc2UScAoZQmF0Y2hTdWJtaXRSZWxlYXJuQW5zd2VycxIoLmFwaS52MS5CYXRjaFN1Ym1pdFJlbGVhcm5BbnN3ZXJzUmVxdWVzdBopLmFwaS52MS5CYXRjaFN1Ym1pdFJlbGVhcm5BbnN3ZXJzUmVzcG9uc2USVQoQU3RhcnRHcmFtbWFyUXVpehIfLmFwaS52MS5TdGFydEdyYW1tYXJRdWl6UmVxdWVzdBogLmFwaS52MS5TdGFydEdyYW1tYXJRdWl6UmVzcG9uc2USWAoRU3VibWl0R3JhbW1hclBvc3QSIC5hcGkudjEuU3VibWl0R3JhbW1hclBvc3RSZXF1ZXN0GiEuYXBpLnYxLlN1Ym1pdEdyYW1tYXJQb3N0UmVzcG9uc2USXgoTTGlzdEdyYW1tYXJNaXN0YWtlcxIiLmFwaS52MS5MaXN0R3JhbW1hck1pc3Rha2VzUmVxdWVzdBojLmFwaS52MS5MaXN0R3JhbW1hck1pc3Rha2VzUmVzcG9uc2USZAoVRXhjbHVkZUdyYW1tYXJNaXN0YWtlEiQuYXBpLnYxLkV4Y2x1ZGVHcmFtbWFyTWlzdGFrZVJlcXVlc3QaJS5hcGkudjEuRXhjbHVkZUdyYW1tYXJNaXN0YWtlUmVzcG9uc2USYQoUUmVzdW1lR3JhbW1hck1pc3Rha2USIy5hcGkudjEuUmVzdW1lR3JhbW1hck1pc3Rha2VSZXF1ZXN0GiQuYXBpLnYxLlJlc3VtZUdyYW1tYXJNaXN0YWtlUmVzcG9uc2VCOFo2Z2l0aHViLmNvbS9hdC1pc2hpa2F3YS9sYW5nbmVyL2dlbi1wcm90b3MvYXBpL3YxO2FwaXYxYgZwcm90bzM", [file_buf_validate_validate, file_api_v1_notebook]);
+  fileDesc("ChFhcGkvdjEvcXVpei5wcm90bxIGYXBpLnYxIjIKFUdldFF1aXpPcHRpb25zUmVxdWVzdBIZChFpbmNsdWRlX3Vuc3R1ZGllZBgBIAEoCCJEChZHZXRRdWl6T3B0aW9uc1Jlc3BvbnNlEioKCW5vdGVib29rcxgBIAMoCzIXLmFwaS52MS5Ob3RlYm9va1N1bW1hcnki3AIKD05vdGVib29rU3VtbWFyeRITCgtub3RlYm9va19pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhQKDHJldmlld19jb3VudBgDIAEoBRIMCgRraW5kGAQgASgJEhwKFHJldmVyc2VfcmV2aWV3X2NvdW50GAUgASgFEh4KFmV0eW1vbG9neV9yZXZpZXdfY291bnQYBiABKAUSEwoLaGFzX2NvbnRlbnQYByABKAgSMAoIc2VjdGlvbnMYCCADKAsyHi5hcGkudjEuTm90ZWJvb2tTZWN0aW9uU3VtbWFyeRImCh5ldHltb2xvZ3lfcmV2ZXJzZV9yZXZpZXdfY291bnQYCSABKAUSHAoUZ3JhbW1hcl9yZXZpZXdfY291bnQYCiABKAUSGAoQdm9jYWJ1bGFyeV9jb3VudBgLIAEoBRIdChVmcmVxdWVudF9yZXZpZXdfY291bnQYDCABKAUiwQEKFk5vdGVib29rU2VjdGlvblN1bW1hcnkSDQoFdGl0bGUYASABKAkSFAoMcmV2aWV3X2NvdW50GAIgASgFEhwKFHJldmVyc2VfcmV2aWV3X2NvdW50GAMgASgFEh4KFmV0eW1vbG9neV9yZXZpZXdfY291bnQYBCABKAUSJgoeZXR5bW9sb2d5X3JldmVyc2VfcmV2aWV3X2NvdW50GAUgASgFEhwKFGdyYW1tYXJfcmV2aWV3X2NvdW50GAYgASgFIkcKD05vdGVib29rU2VjdGlvbhIcCgtub3RlYm9va19pZBgBIAEoCUIHukgEcgIQARIWCg5zZWN0aW9uX3RpdGxlcxgCIAMoCSJ3ChBTdGFydFF1aXpSZXF1ZXN0EhQKDG5vdGVib29rX2lkcxgBIAMoCRIZChFpbmNsdWRlX3Vuc3R1ZGllZBgCIAEoCBIyChFub3RlYm9va19zZWN0aW9ucxgDIAMoCzIXLmFwaS52MS5Ob3RlYm9va1NlY3Rpb24iOgoRU3RhcnRRdWl6UmVzcG9uc2USJQoKZmxhc2hjYXJkcxgBIAMoCzIRLmFwaS52MS5GbGFzaGNhcmQirgEKCUZsYXNoY2FyZBIPCgdub3RlX2lkGAEgASgDEg0KBWVudHJ5GAIgASgJEiEKCGV4YW1wbGVzGAMgAygLMg8uYXBpLnYxLkV4YW1wbGUSFgoOb3JpZ2luYWxfZW50cnkYBCABKAkSFAoMY29uY2VwdF9oZWFkGAUgASgJEhcKD2NvbmNlcHRfbWVtYmVycxgGIAMoCRIXCg9jb25jZXB0X21lYW5pbmcYByABKAkiOwoHRXhhbXBsZRIMCgR0ZXh0GAEgASgJEg8KB3NwZWFrZXIYAiABKAkSEQoJaGlnaGxpZ2h0GAMgASgJIqsBCgpXb3JkRGV0YWlsEg4KBm9yaWdpbhgBIAEoCRIVCg1wcm9udW5jaWF0aW9uGAIgASgJEhYKDnBhcnRfb2Zfc3BlZWNoGAMgASgJEhAKCHN5bm9ueW1zGAQgAygJEhAKCGFudG9ueW1zGAUgAygJEgwKBG1lbW8YBiABKAkSLAoMb3JpZ2luX3BhcnRzGAcgAygLMhYuYXBpLnYxLldvcmRPcmlnaW5QYXJ0IlEKDldvcmRPcmlnaW5QYXJ0Eg4KBm9yaWdpbhgBIAEoCRIMCgR0eXBlGAIgASgJEhAKCGxhbmd1YWdlGAMgASgJEg8KB21lYW5pbmcYBCABKAkibQoTU3VibWl0QW5zd2VyUmVxdWVzdBIYCgdub3RlX2lkGAEgASgDQge6SAQiAiAAEg4KBmFuc3dlchgCIAEoCRIYChByZXNwb25zZV90aW1lX21zGAMgASgDEhIKCmlzX3NraXBwZWQYBCABKAgiwQEKFFN1Ym1pdEFuc3dlclJlc3BvbnNlEg8KB2NvcnJlY3QYASABKAgSDwoHbWVhbmluZxgCIAEoCRIOCgZyZWFzb24YAyABKAkSJwoLd29yZF9kZXRhaWwYBCABKAsyEi5hcGkudjEuV29yZERldGFpbBIYChBuZXh0X3Jldmlld19kYXRlGAUgASgJEhIKCmxlYXJuZWRfYXQYBiABKAkSDgoGaW1hZ2VzGAcgAygJEhAKCHNlbnNlX2lkGAggASgJIlMKGUJhdGNoU3VibWl0QW5zd2Vyc1JlcXVlc3QSNgoHYW5zd2VycxgBIAMoCzIbLmFwaS52MS5TdWJtaXRBbnN3ZXJSZXF1ZXN0Qgi6SAWSAQIIASJNChpCYXRjaFN1Ym1pdEFuc3dlcnNSZXNwb25zZRIvCglyZXNwb25zZXMYASADKAsyHC5hcGkudjEuU3VibWl0QW5zd2VyUmVzcG9uc2UinAEKF1N0YXJ0UmV2ZXJzZVF1aXpSZXF1ZXN0EhQKDG5vdGVib29rX2lkcxgBIAMoCRIcChRsaXN0X21pc3NpbmdfY29udGV4dBgCIAEoCBIyChFub3RlYm9va19zZWN0aW9ucxgDIAMoCzIXLmFwaS52MS5Ob3RlYm9va1NlY3Rpb24SGQoRaW5jbHVkZV91bnN0dWRpZWQYBCABKAgiSAoYU3RhcnRSZXZlcnNlUXVpelJlc3BvbnNlEiwKCmZsYXNoY2FyZHMYASADKAsyGC5hcGkudjEuUmV2ZXJzZUZsYXNoY2FyZCLoAQoQUmV2ZXJzZUZsYXNoY2FyZBIPCgdub3RlX2lkGAEgASgDEg8KB21lYW5pbmcYAiABKAkSKQoIY29udGV4dHMYAyADKAsyFy5hcGkudjEuQ29udGV4dFNlbnRlbmNlEhUKDW5vdGVib29rX25hbWUYBCABKAkSEwoLc3RvcnlfdGl0bGUYBSABKAkSEwoLc2NlbmVfdGl0bGUYBiABKAkSFAoMY29uY2VwdF9oZWFkGAcgASgJEhcKD2NvbmNlcHRfbWVtYmVycxgIIAMoCRIXCg9jb25jZXB0X21lYW5pbmcYCSABKAkiOgoPQ29udGV4dFNlbnRlbmNlEg8KB2NvbnRleHQYASABKAkSFgoObWFza2VkX2NvbnRleHQYAiABKAkilwEKGlN1Ym1pdFJldmVyc2VBbnN3ZXJSZXF1ZXN0EhgKB25vdGVfaWQYASABKANCB7pIBCICIAASDgoGYW5zd2VyGAIgASgJEhgKEHJlc3BvbnNlX3RpbWVfbXMYAyABKAMSIQoZYWNjZXB0X3N5bm9ueW1fYXNfY29ycmVjdBgEIAEoCBISCgppc19za2lwcGVkGAUgASgIIoYCChtTdWJtaXRSZXZlcnNlQW5zd2VyUmVzcG9uc2USDwoHY29ycmVjdBgBIAEoCBISCgpleHByZXNzaW9uGAIgASgJEg8KB21lYW5pbmcYAyABKAkSDgoGcmVhc29uGAQgASgJEhAKCGNvbnRleHRzGAUgAygJEicKC3dvcmRfZGV0YWlsGAYgASgLMhIuYXBpLnYxLldvcmREZXRhaWwSFgoOY2xhc3NpZmljYXRpb24YByABKAkSGAoQbmV4dF9yZXZpZXdfZGF0ZRgIIAEoCRISCgpsZWFybmVkX2F0GAkgASgJEg4KBmltYWdlcxgKIAMoCRIQCghzZW5zZV9pZBgLIAEoCSJhCiBCYXRjaFN1Ym1pdFJldmVyc2VBbnN3ZXJzUmVxdWVzdBI9CgdhbnN3ZXJzGAEgAygLMiIuYXBpLnYxLlN1Ym1pdFJldmVyc2VBbnN3ZXJSZXF1ZXN0Qgi6SAWSAQIIASJbCiFCYXRjaFN1Ym1pdFJldmVyc2VBbnN3ZXJzUmVzcG9uc2USNgoJcmVzcG9uc2VzGAEgAygLMiMuYXBpLnYxLlN1Ym1pdFJldmVyc2VBbnN3ZXJSZXNwb25zZSIaChhTdGFydEZyZWVmb3JtUXVpelJlcXVlc3Qi6wEKGVN0YXJ0RnJlZWZvcm1RdWl6UmVzcG9uc2USEgoKd29yZF9jb3VudBgBIAEoBRITCgtleHByZXNzaW9ucxgCIAMoCRJkChtleHByZXNzaW9uX25leHRfcmV2aWV3X2RhdGUYAyADKAsyPy5hcGkudjEuU3RhcnRGcmVlZm9ybVF1aXpSZXNwb25zZS5FeHByZXNzaW9uTmV4dFJldmlld0RhdGVFbnRyeRo/Ch1FeHByZXNzaW9uTmV4dFJldmlld0RhdGVFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBInAKG1N1Ym1pdEZyZWVmb3JtQW5zd2VyUmVxdWVzdBIZCgR3b3JkGAEgASgJQgu6SAhyBhABMgJcUxIcCgdtZWFuaW5nGAIgASgJQgu6SAhyBhABMgJcUxIYChByZXNwb25zZV90aW1lX21zGAMgASgDIpACChxTdWJtaXRGcmVlZm9ybUFuc3dlclJlc3BvbnNlEg8KB2NvcnJlY3QYASABKAgSDAoEd29yZBgCIAEoCRIPCgdtZWFuaW5nGAMgASgJEg4KBnJlYXNvbhgEIAEoCRIPCgdjb250ZXh0GAUgASgJEhUKDW5vdGVib29rX25hbWUYBiABKAkSJwoLd29yZF9kZXRhaWwYByABKAsyEi5hcGkudjEuV29yZERldGFpbBIYChBuZXh0X3Jldmlld19kYXRlGAggASgJEhIKCmxlYXJuZWRfYXQYCSABKAkSDwoHbm90ZV9pZBgKIAEoAxIOCgZpbWFnZXMYCyADKAkSEAoIc2Vuc2VfaWQYDCABKAkixQIKFU92ZXJyaWRlQW5zd2VyUmVxdWVzdBIYCgdub3RlX2lkGAEgASgDQge6SAQiAiAAEiMKCXF1aXpfdHlwZRgCIAEoDjIQLmFwaS52MS5RdWl6VHlwZRIbCgpsZWFybmVkX2F0GAMgASgJQge6SARyAhAKEhkKDG1hcmtfY29ycmVjdBgEIAEoCEgAiAEBEh0KEG5leHRfcmV2aWV3X2RhdGUYBSABKAlIAYgBARIQCghzZW5zZV9pZBgGIAEoCRIcCg93b3JkX2V4cHJlc3Npb24YByABKAlIAogBARIaCg13b3JkX2V4Y2x1ZGVkGAggASgISAOIAQFCDwoNX21hcmtfY29ycmVjdEITChFfbmV4dF9yZXZpZXdfZGF0ZUISChBfd29yZF9leHByZXNzaW9uQhAKDl93b3JkX2V4Y2x1ZGVkIqQBChZPdmVycmlkZUFuc3dlclJlc3BvbnNlEhgKEG5leHRfcmV2aWV3X2RhdGUYASABKAkSGAoQb3JpZ2luYWxfcXVhbGl0eRgCIAEoBRIXCg9vcmlnaW5hbF9zdGF0dXMYAyABKAkSHgoWb3JpZ2luYWxfaW50ZXJ2YWxfZGF5cxgEIAEoBRIXCg9vcmlnaW5hbF9ncmFkZXIYBiABKAlKBAgFEAYi+wEKGVVuZG9PdmVycmlkZUFuc3dlclJlcXVlc3QSGAoHbm90ZV9pZBgBIAEoA0IHukgEIgIgABIjCglxdWl6X3R5cGUYAiABKA4yEC5hcGkudjEuUXVpelR5cGUSGwoKbGVhcm5lZF9hdBgDIAEoCUIHukgEcgIQChIYChBvcmlnaW5hbF9xdWFsaXR5GAQgASgFEhcKD29yaWdpbmFsX3N0YXR1cxgFIAEoCRIeChZvcmlnaW5hbF9pbnRlcnZhbF9kYXlzGAYgASgFEhAKCHNlbnNlX2lkGAggASgJEhcKD29yaWdpbmFsX2dyYWRlchgJIAEoCUoECAcQCCJHChpVbmRvT3ZlcnJpZGVBbnN3ZXJSZXNwb25zZRIPCgdjb3JyZWN0GAEgASgIEhgKEG5leHRfcmV2aWV3X2RhdGUYAiABKAkigAEKD1NraXBXb3JkUmVxdWVzdBIYCgdub3RlX2lkGAEgASgDQge6SAQiAiAAEi4KCnF1aXpfdHlwZXMYBCADKA4yEC5hcGkudjEuUXVpelR5cGVCCLpIBZIBAggBEhIKCnNraXBfdW50aWwYAyABKAlKBAgCEANSCXF1aXpfdHlwZSISChBTa2lwV29yZFJlc3BvbnNlIm4KEVJlc3VtZVdvcmRSZXF1ZXN0EhgKB25vdGVfaWQYASABKANCB7pIBCICIAASLgoKcXVpel90eXBlcxgDIAMoDjIQLmFwaS52MS5RdWl6VHlwZUIIukgFkgECCAFKBAgCEANSCXF1aXpfdHlwZSIUChJSZXN1bWVXb3JkUmVzcG9uc2Ui4gEKC0dyYXBoUHJvbXB0EigKBXNoYXBlGAEgASgOMhkuYXBpLnYxLkdyYXBoUHJvbXB0LlNoYXBlEiAKBW5vZGVzGAIgAygLMhEuYXBpLnYxLkdyYXBoTm9kZRIgCgVlZGdlcxgDIAMoCzIRLmFwaS52MS5HcmFwaEVkZ2USFQoNYmxhbmtfbm9kZV9pZBgEIAEoCSJOCgVTaGFwZRIVChFTSEFQRV9VTlNQRUNJRklFRBAAEgsKB0NMVVNURVIQARIQCgxBTlRPTllNX1BBSVIQAhIPCgtGT1JNX0JSQU5DSBADItABCglHcmFwaE5vZGUSCgoCaWQYASABKAkSJAoEa2luZBgCIAEoDjIWLmFwaS52MS5HcmFwaE5vZGUuS2luZBINCgVsYWJlbBgDIAEoCRIQCghsYW5ndWFnZRgEIAEoCRIMCgRoaW50GAUgASgJEg8KB21lYW5pbmcYBiABKAkiUQoES2luZBIUChBLSU5EX1VOU1BFQ0lGSUVEEAASCwoHQ09OQ0VQVBABEgoKBk9SSUdJThACEggKBEZPUk0QAxIQCgxFTkdMSVNIX1dPUkQQBCIzCglHcmFwaEVkZ2USDAoEZnJvbRgBIAEoCRIKCgJ0bxgCIAEoCRIMCgR0eXBlGAMgASgJIjsKF1N0YXJ0UmVsZWFyblF1aXpSZXF1ZXN0EiAKDHdpbmRvd19ob3VycxgBIAEoBUIKukgHGgUYqAEoACI+ChhTdGFydFJlbGVhcm5RdWl6UmVzcG9uc2USIgoFY2FyZHMYASADKAsyEy5hcGkudjEuUmVsZWFybkNhcmQiwwMKC1JlbGVhcm5DYXJkEg8KB25vdGVfaWQYASABKAMSDQoFZW50cnkYAiABKAkSKgoQc291cmNlX3F1aXpfdHlwZRgDIAEoDjIQLmFwaS52MS5RdWl6VHlwZRIPCgdtZWFuaW5nGAQgASgJEiEKCGV4YW1wbGVzGAUgAygLMg8uYXBpLnYxLkV4YW1wbGUSKQoIY29udGV4dHMYBiADKAsyFy5hcGkudjEuQ29udGV4dFNlbnRlbmNlEgwKBHR5cGUYByABKAkSEAoIbGFuZ3VhZ2UYCCABKAkSDwoHY29udGVudBgJIAEoCRIRCglpbmNvcnJlY3QYCiABKAkSEwoLb3JpZ2luX3RleHQYCyABKAkSFgoOb3JpZ2luX21lYW5pbmcYDCABKAkSFQoNZW5nbGlzaF9mb3JtcxgNIAMoCRIqChBvcmlnaW5fZGlyZWN0aW9uGA4gASgOMhAuYXBpLnYxLlF1aXpUeXBlEjEKDXJlbGF0ZWRfd29yZHMYDyADKAsyGi5hcGkudjEuT3JpZ2luRmFtaWx5TWVtYmVyEg0KBWF1ZGlvGBAgASgJEhMKC25vdGVib29rX2lkGBEgASgJIjMKEk9yaWdpbkZhbWlseU1lbWJlchIMCgR3b3JkGAEgASgJEg8KB21lYW5pbmcYAiABKAkidAoaU3VibWl0UmVsZWFybkFuc3dlclJlcXVlc3QSGAoHbm90ZV9pZBgBIAEoA0IHukgEIgIgABIOCgZhbnN3ZXIYAiABKAkSGAoQcmVzcG9uc2VfdGltZV9tcxgDIAEoAxISCgppc19za2lwcGVkGAQgASgIIrgCChtTdWJtaXRSZWxlYXJuQW5zd2VyUmVzcG9uc2USDwoHY29ycmVjdBgBIAEoCBIPCgdtZWFuaW5nGAIgASgJEg4KBnJlYXNvbhgDIAEoCRInCgt3b3JkX2RldGFpbBgEIAEoCzISLmFwaS52MS5Xb3JkRGV0YWlsEg4KBmltYWdlcxgFIAMoCRIzCg5jb250ZXh0X3NjZW5lcxgGIAMoCzIbLmFwaS52MS5SZWxlYXJuQ29udGV4dFNjZW5lEhYKDmNvcnJlY3RfYW5zd2VyGAkgASgJEhAKCGNhdGVnb3J5GAogASgJEhQKDGdyYW1tYXJfbm90ZRgLIAEoCRIPCgdsaXRlcmFsGAwgASgJSgQIBxAISgQICBAJUg1ncmFwaF9jb250ZXh0Ug1leGFtcGxlX3dvcmRzIo0BChNSZWxlYXJuQ29udGV4dFNjZW5lEhUKDW5vdGVib29rX25hbWUYASABKAkSEwoLc2NlbmVfdGl0bGUYAiABKAkSEgoKc3RhdGVtZW50cxgDIAMoCRI2Cg1jb252ZXJzYXRpb25zGAQgAygLMh8uYXBpLnYxLlJlbGVhcm5Db252ZXJzYXRpb25MaW5lIjkKF1JlbGVhcm5Db252ZXJzYXRpb25MaW5lEg8KB3NwZWFrZXIYASABKAkSDQoFcXVvdGUYAiABKAkiYQogQmF0Y2hTdWJtaXRSZWxlYXJuQW5zd2Vyc1JlcXVlc3QSPQoHYW5zd2VycxgBIAMoCzIiLmFwaS52MS5TdWJtaXRSZWxlYXJuQW5zd2VyUmVxdWVzdEIIukgFkgECCAEiWwohQmF0Y2hTdWJtaXRSZWxlYXJuQW5zd2Vyc1Jlc3BvbnNlEjYKCXJlc3BvbnNlcxgBIAMoCzIjLmFwaS52MS5TdWJtaXRSZWxlYXJuQW5zd2VyUmVzcG9uc2UifgoXU3RhcnRHcmFtbWFyUXVpelJlcXVlc3QSFAoMbm90ZWJvb2tfaWRzGAEgAygJEhkKEWluY2x1ZGVfdW5zdHVkaWVkGAIgASgIEjIKEW5vdGVib29rX3NlY3Rpb25zGAMgAygLMhcuYXBpLnYxLk5vdGVib29rU2VjdGlvbiJCChhTdGFydEdyYW1tYXJRdWl6UmVzcG9uc2USJgoFcG9zdHMYASADKAsyFy5hcGkudjEuR3JhbW1hclBvc3RDYXJkIoABCg9HcmFtbWFyUG9zdENhcmQSEwoLbm90ZWJvb2tfaWQYASABKAkSEAoIZW50cnlfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSEQoJcG9zdF90ZXh0GAQgASgJEiQKBmJsYW5rcxgFIAMoCzIULmFwaS52MS5HcmFtbWFyQmxhbmsidAoMR3JhbW1hckJsYW5rEg8KB25vdGVfaWQYASABKAMSEAoIc2Vuc2VfaWQYAiABKAkSEQoJaW5jb3JyZWN0GAMgASgJEgwKBGxpbmUYBCABKAUSEAoIY2F0ZWdvcnkYBSABKAkSDgoGc3RhdHVzGAYgASgJIlEKGFN1Ym1pdEdyYW1tYXJQb3N0UmVxdWVzdBI1CgdhbnN3ZXJzGAEgAygLMhouYXBpLnYxLkdyYW1tYXJCbGFua0Fuc3dlckIIukgFkgECCAEibAoSR3JhbW1hckJsYW5rQW5zd2VyEhgKB25vdGVfaWQYASABKANCB7pIBCICIAASDgoGYW5zd2VyGAIgASgJEhgKEHJlc3BvbnNlX3RpbWVfbXMYAyABKAMSEgoKaXNfc2tpcHBlZBgEIAEoCCJIChlTdWJtaXRHcmFtbWFyUG9zdFJlc3BvbnNlEisKB3Jlc3VsdHMYASADKAsyGi5hcGkudjEuR3JhbW1hckJsYW5rUmVzdWx0ItcBChJHcmFtbWFyQmxhbmtSZXN1bHQSDwoHbm90ZV9pZBgBIAEoAxIQCghzZW5zZV9pZBgCIAEoCRIPCgdjb3JyZWN0GAMgASgIEhYKDmNvcnJlY3RfYW5zd2VyGAQgASgJEhEKCWluY29ycmVjdBgFIAEoCRIOCgZyZWFzb24YBiABKAkSEAoIY2F0ZWdvcnkYByABKAkSGAoQbmV4dF9yZXZpZXdfZGF0ZRgIIAEoCRISCgpsZWFybmVkX2F0GAkgASgJEhIKCmFzc2Vzc21lbnQYCiABKAkiUgoaTGlzdEdyYW1tYXJNaXN0YWtlc1JlcXVlc3QSHAoLbm90ZWJvb2tfaWQYASABKAlCB7pIBHICEAESFgoOc2VjdGlvbl90aXRsZXMYAiADKAkiRwobTGlzdEdyYW1tYXJNaXN0YWtlc1Jlc3BvbnNlEigKCG1pc3Rha2VzGAEgAygLMhYuYXBpLnYxLkdyYW1tYXJNaXN0YWtlIq4BCg5HcmFtbWFyTWlzdGFrZRIQCghzZW5zZV9pZBgBIAEoCRIQCghlbnRyeV9pZBgCIAEoCRINCgV0aXRsZRgDIAEoCRIRCglpbmNvcnJlY3QYBCABKAkSDwoHY29ycmVjdBgFIAEoCRIQCghjYXRlZ29yeRgGIAEoCRIOCgZyZWFzb24YByABKAkSDgoGc3RhdHVzGAggASgJEhMKC2lzX2V4Y2x1ZGVkGAkgASgIIlcKHEV4Y2x1ZGVHcmFtbWFyTWlzdGFrZVJlcXVlc3QSHAoLbm90ZWJvb2tfaWQYASABKAlCB7pIBHICEAESGQoIc2Vuc2VfaWQYAiABKAlCB7pIBHICEAEiHwodRXhjbHVkZUdyYW1tYXJNaXN0YWtlUmVzcG9uc2UiVgobUmVzdW1lR3JhbW1hck1pc3Rha2VSZXF1ZXN0EhwKC25vdGVib29rX2lkGAEgASgJQge6SARyAhABEhkKCHNlbnNlX2lkGAIgASgJQge6SARyAhABIh4KHFJlc3VtZUdyYW1tYXJNaXN0YWtlUmVzcG9uc2UiWAobRXhjbHVkZUV0eW1vbG9neVdvcmRSZXF1ZXN0EhwKC25vdGVib29rX2lkGAEgASgJQge6SARyAhABEhsKCmV4cHJlc3Npb24YAiABKAlCB7pIBHICEAEiHgocRXhjbHVkZUV0eW1vbG9neVdvcmRSZXNwb25zZSJXChpSZXN1bWVFdHltb2xvZ3lXb3JkUmVxdWVzdBIcCgtub3RlYm9va19pZBgBIAEoCUIHukgEcgIQARIbCgpleHByZXNzaW9uGAIgASgJQge6SARyAhABIh0KG1Jlc3VtZUV0eW1vbG9neVdvcmRSZXNwb25zZSKAAQoZU3RhcnREaWN0YXRpb25RdWl6UmVxdWVzdBIUCgxub3RlYm9va19pZHMYASADKAkSGQoRaW5jbHVkZV91bnN0dWRpZWQYAiABKAgSMgoRbm90ZWJvb2tfc2VjdGlvbnMYAyADKAsyFy5hcGkudjEuTm90ZWJvb2tTZWN0aW9uIkIKGlN0YXJ0RGljdGF0aW9uUXVpelJlc3BvbnNlEiQKBWNhcmRzGAEgAygLMhUuYXBpLnYxLkRpY3RhdGlvbkNhcmQikwEKDURpY3RhdGlvbkNhcmQSDwoHbm90ZV9pZBgBIAEoAxITCgtub3RlYm9va19pZBgCIAEoCRITCgtzdG9yeV90aXRsZRgDIAEoCRITCgtzY2VuZV90aXRsZRgEIAEoCRIPCgdzcGVha2VyGAUgASgJEg0KBWF1ZGlvGAYgASgJEhIKCndvcmRfY291bnQYByABKAUidgocU3VibWl0RGljdGF0aW9uQW5zd2VyUmVxdWVzdBIYCgdub3RlX2lkGAEgASgDQge6SAQiAiAAEg4KBmFuc3dlchgCIAEoCRIYChByZXNwb25zZV90aW1lX21zGAMgASgDEhIKCmlzX3NraXBwZWQYBCABKAgioQEKHVN1Ym1pdERpY3RhdGlvbkFuc3dlclJlc3BvbnNlEg8KB2NvcnJlY3QYASABKAgSDAoEbGluZRgCIAEoCRISCgpleHByZXNzaW9uGAMgASgJEg8KB21lYW5pbmcYBCABKAkSDgoGcmVhc29uGAUgASgJEhgKEG5leHRfcmV2aWV3X2RhdGUYBiABKAkSEgoKbGVhcm5lZF9hdBgHIAEoCSKPAQofU3VibWl0UmV2ZXJzZUFuc3dlckF1ZGlvUmVxdWVzdBIYCgdub3RlX2lkGAEgASgDQge6SAQiAiAAEhsKBWF1ZGlvGAIgASgMQgy6SAl6BxABGICAgAUSGwoKbWVkaWFfdHlwZRgDIAEoCUIHukgEcgIQARIYChByZXNwb25zZV90aW1lX21zGAQgASgDImsKIFN1Ym1pdFJldmVyc2VBbnN3ZXJBdWRpb1Jlc3BvbnNlEjMKBnJlc3VsdBgBIAEoCzIjLmFwaS52MS5TdWJtaXRSZXZlcnNlQW5zd2VyUmVzcG9uc2USEgoKdHJhbnNjcmlwdBgCIAEoCSIzChdHZW5lcmF0ZU1uZW1vbmljUmVxdWVzdBIYCgdub3RlX2lkGAEgASgDQge6SAQiAiAAIigKGEdlbmVyYXRlTW5lbW9uaWNSZXNwb25zZRIMCgRtZW1vGAEgASgJIoEBChpTdGFydFdvcmRDaG9pY2VRdWl6UmVxdWVzdBIUCgxub3RlYm9va19pZHMYASADKAkSGQoRaW5jbHVkZV91bnN0dWRpZWQYAiABKAgSMgoRbm90ZWJvb2tfc2VjdGlvbnMYAyADKAsyFy5hcGkudjEuTm90ZWJvb2tTZWN0aW9uIkQKG1N0YXJ0V29yZENob2ljZVF1aXpSZXNwb25zZRIlCgVjYXJkcxgBIAMoCzIWLmFwaS52MS5Xb3JkQ2hvaWNlQ2FyZCLOAQoOV29yZENob2ljZUNhcmQSDwoHbm90ZV9pZBgBIAEoAxITCgtub3RlYm9va19pZBgCIAEoCRIUCgxjb25jZXB0X2hlYWQYAyABKAkSFwoPY29uY2VwdF9tZWFuaW5nGAQgASgJEhQKDGNvbmNlcHRfa2luZBgFIAEoCRIQCghzZW50ZW5jZRgGIAEoCRIPCgdvcHRpb25zGAcgAygJEhIKCmV4cHJlc3Npb24YCCABKAkSGgoSY29tcGFyZV9leHByZXNzaW9uGAkgASgJIncKHVN1Ym1pdFdvcmRDaG9pY2VBbnN3ZXJSZXF1ZXN0EhgKB25vdGVfaWQYASABKANCB7pIBCICIAASDgoGYW5zd2VyGAIgASgJEhgKEHJlc3BvbnNlX3RpbWVfbXMYAyABKAMSEgoKaXNfc2tpcHBlZBgEIAEoCCLQAQoeU3VibWl0V29yZENob2ljZUFuc3dlclJlc3BvbnNlEg8KB2NvcnJlY3QYASABKAgSEgoKZXhwcmVzc2lvbhgCIAEoCRIPCgdtZWFuaW5nGAMgASgJEg8KB2V4YW1wbGUYBCABKAkSKQoHbWVtYmVycxgFIAMoCzIYLmFwaS52MS5Xb3JkQ2hvaWNlTWVtYmVyEg4KBnJlYXNvbhgGIAEoCRIYChBuZXh0X3Jldmlld19kYXRlGAcgASgJEhIKCmxlYXJuZWRfYXQYCCABKAkiNwoQV29yZENob2ljZU1lbWJlchISCgpleHByZXNzaW9uGAEgASgJEg8KB21lYW5pbmcYAiABKAkipwEKIVN1Ym1pdERpc2NyaW1pbmF0aW9uQW5zd2VyUmVxdWVzdBIcCgtub3RlYm9va19pZBgBIAEoCUIHukgEcgIQARI1CgV3b3JkcxgCIAMoCzIaLmFwaS52MS5EaXNjcmltaW5hdGlvbldvcmRCCrpIB5IBBAgCEAISHAoKcXVpel90eXBlcxgDIAMoCUIIukgFkgECCAESDwoHY29ycmVjdBgEIAEoCCJDChJEaXNjcmltaW5hdGlvbldvcmQSEAoIc2Vuc2VfaWQYASABKAkSGwoKZXhwcmVzc2lvbhgCIAEoCUIHukgEcgIQASJDCiJTdWJtaXREaXNjcmltaW5hdGlvbkFuc3dlclJlc3BvbnNlEh0KFW1vdmVkX3RvX3JlbGVhcm5fcG9vbBgBIAEoCCr6AQoIUXVpelR5cGUSGQoVUVVJWl9UWVBFX1VOU1BFQ0lGSUVEEAASFgoSUVVJWl9UWVBFX1NUQU5EQVJEEAESFQoRUVVJWl9UWVBFX1JFVkVSU0UQAhIWChJRVUlaX1RZUEVfRlJFRUZPUk0QAxIeChpRVUlaX1RZUEVfRVRZTU9MT0dZX09SSUdJThAEEhUKEVFVSVpfVFlQRV9SRUxFQVJOEAcSFQoRUVVJWl9UWVBFX0dSQU1NQVIQCBIXChNRVUlaX1RZUEVfRElDVEFUSU9OEAkSGQoVUVVJWl9UWVBFX1dPUkRfQ0hPSUNFEAoiBAgFEAUiBAgGEAYy7RUKC1F1aXpTZXJ2aWNlEk8KDkdldFF1aXpPcHRpb25zEh0uYXBpLnYxLkdldFF1aXpPcHRpb25zUmVxdWVzdBoeLmFwaS52MS5HZXRRdWl6T3B0aW9uc1Jlc3BvbnNlEkAKCVN0YXJ0UXVpehIYLmFwaS52MS5TdGFydFF1aXpSZXF1ZXN0GhkuYXBpLnYxLlN0YXJ0UXVpelJlc3BvbnNlEkkKDFN1Ym1pdEFuc3dlchIbLmFwaS52MS5TdWJtaXRBbnN3ZXJSZXF1ZXN0GhwuYXBpLnYxLlN1Ym1pdEFuc3dlclJlc3BvbnNlElsKEkJhdGNoU3VibWl0QW5zd2VycxIhLmFwaS52MS5CYXRjaFN1Ym1pdEFuc3dlcnNSZXF1ZXN0GiIuYXBpLnYxLkJhdGNoU3VibWl0QW5zd2Vyc1Jlc3BvbnNlElUKEFN0YXJ0UmV2ZXJzZVF1aXoSHy5hcGkudjEuU3RhcnRSZXZlcnNlUXVpelJlcXVlc3QaIC5hcGkudjEuU3RhcnRSZXZlcnNlUXVpelJlc3BvbnNlEl4KE1N1Ym1pdFJldmVyc2VBbnN3ZXISIi5hcGkudjEuU3VibWl0UmV2ZXJzZUFuc3dlclJlcXVlc3QaIy5hcGkudjEuU3VibWl0UmV2ZXJzZUFuc3dlclJlc3BvbnNlEnAKGUJhdGNoU3VibWl0UmV2ZXJzZUFuc3dlcnMSKC5hcGkudjEuQmF0Y2hTdWJtaXRSZXZlcnNlQW5zd2Vyc1JlcXVlc3QaKS5hcGkudjEuQmF0Y2hTdWJtaXRSZXZlcnNlQW5zd2Vyc1Jlc3BvbnNlEm0KGFN1Ym1pdFJldmVyc2VBbnN3ZXJBdWRpbxInLmFwaS52MS5TdWJtaXRSZXZlcnNlQW5zd2VyQXVkaW9SZXF1ZXN0GiguYXBpLnYxLlN1Ym1pdFJldmVyc2VBbnN3ZXJBdWRpb1Jlc3BvbnNlElgKEVN0YXJ0RnJlZWZvcm1RdWl6EiAuYXBpLnYxLlN0YXJ0RnJlZWZvcm1RdWl6UmVxdWVzdBohLmFwaS52MS5TdGFydEZyZWVmb3JtUXVpelJlc3BvbnNlEmEKFFN1Ym1pdEZyZWVmb3JtQW5zd2VyEiMuYXBpLnYxLlN1Ym1pdEZyZWVmb3JtQW5zd2VyUmVxdWVzdBokLmFwaS52MS5TdWJtaXRGcmVlZm9ybUFuc3dlclJlc3BvbnNlEk8KDk92ZXJyaWRlQW5zd2VyEh0uYXBpLnYxLk92ZXJyaWRlQW5zd2VyUmVxdWVzdBoeLmFwaS52MS5PdmVycmlkZUFuc3dlclJlc3BvbnNlElsKElVuZG9PdmVycmlkZUFuc3dlchIhLmFwaS52MS5VbmRvT3ZlcnJpZGVBbnN3ZXJSZXF1ZXN0GiIuYXBpLnYxLlVuZG9PdmVycmlkZUFuc3dlclJlc3BvbnNlEj0KCFNraXBXb3JkEhcuYXBpLnYxLlNraXBXb3JkUmVxdWVzdBoYLmFwaS52MS5Ta2lwV29yZFJlc3BvbnNlEkMKClJlc3VtZVdvcmQSGS5hcGkudjEuUmVzdW1lV29yZFJlcXVlc3QaGi5hcGkudjEuUmVzdW1lV29yZFJlc3BvbnNlEmEKFEV4Y2x1ZGVFdHltb2xvZ3lXb3JkEiMuYXBpLnYxLkV4Y2x1ZGVFdHltb2xvZ3lXb3JkUmVxdWVzdBokLmFwaS52MS5FeGNsdWRlRXR5bW9sb2d5V29yZFJlc3BvbnNlEl4KE1Jlc3VtZUV0eW1vbG9neVdvcmQSIi5hcGkudjEuUmVzdW1lRXR5bW9sb2d5V29yZFJlcXVlc3QaIy5hcGkudjEuUmVzdW1lRXR5bW9sb2d5V29yZFJlc3BvbnNlElUKEFN0YXJ0UmVsZWFyblF1aXoSHy5hcGkudjEuU3RhcnRSZWxlYXJuUXVpelJlcXVlc3QaIC5hcGkudjEuU3RhcnRSZWxlYXJuUXVpelJlc3BvbnNlEl4KE1N1Ym1pdFJlbGVhcm5BbnN3ZXISIi5hcGkudjEuU3VibWl0UmVsZWFybkFuc3dlclJlcXVlc3QaIy5hcGkudjEuU3VibWl0UmVsZWFybkFuc3dlclJlc3BvbnNlEnAKGUJhdGNoU3VibWl0UmVsZWFybkFuc3dlcnMSKC5hcGkudjEuQmF0Y2hTdWJtaXRSZWxlYXJuQW5zd2Vyc1JlcXVlc3QaKS5hcGkudjEuQmF0Y2hTdWJtaXRSZWxlYXJuQW5zd2Vyc1Jlc3BvbnNlElUKEFN0YXJ0R3JhbW1hclF1aXoSHy5hcGkudjEuU3RhcnRHcmFtbWFyUXVpelJlcXVlc3QaIC5hcGkudjEuU3RhcnRHcmFtbWFyUXVpelJlc3BvbnNlElgKEVN1Ym1pdEdyYW1tYXJQb3N0EiAuYXBpLnYxLlN1Ym1pdEdyYW1tYXJQb3N0UmVxdWVzdBohLmFwaS52MS5TdWJtaXRHcmFtbWFyUG9zdFJlc3BvbnNlEl4KE0xpc3RHcmFtbWFyTWlzdGFrZXMSIi5hcGkudjEuTGlzdEdyYW1tYXJNaXN0YWtlc1JlcXVlc3QaIy5hcGkudjEuTGlzdEdyYW1tYXJNaXN0YWtlc1Jlc3BvbnNlEmQKFUV4Y2x1ZGVHcmFtbWFyTWlzdGFrZRIkLmFwaS52MS5FeGNsdWRlR3JhbW1hck1pc3Rha2VSZXF1ZXN0GiUuYXBpLnYxLkV4Y2x1ZGVHcmFtbWFyTWlzdGFrZVJlc3BvbnNlEmEKFFJlc3VtZUdyYW1tYXJNaXN0YWtlEiMuYXBpLnYxLlJlc3VtZUdyYW1tYXJNaXN0YWtlUmVxdWVzdBokLmFwaS52MS5SZXN1bWVHcmFtbWFyTWlzdGFrZVJlc3BvbnNlElsKElN0YXJ0RGljdGF0aW9uUXVpehIhLmFwaS52MS5TdGFydERpY3RhdGlvblF1aXpSZXF1ZXN0GiIuYXBpLnYxLlN0YXJ0RGljdGF0aW9uUXVpelJlc3BvbnNlEmQKFVN1Ym1pdERpY3RhdGlvbkFuc3dlchIkLmFwaS52MS5TdWJtaXREaWN0YXRpb25BbnN3ZXJSZXF1ZXN0GiUuYXBpLnYxLlN1Ym1pdERpY3RhdGlvbkFuc3dlclJlc3BvbnNlEl4KE1N0YXJ0V29yZENob2ljZVF1aXoSIi5hcGkudjEuU3RhcnRXb3JkQ2hvaWNlUXVpelJlcXVlc3QaIy5hcGkudjEuU3RhcnRXb3JkQ2hvaWNlUXVpelJlc3BvbnNlEmcKFlN1Ym1pdFdvcmRDaG9pY2VBbnN3ZXISJS5hcGkudjEuU3VibWl0V29yZENob2ljZUFuc3dlclJlcXVlc3QaJi5hcGkudjEuU3VibWl0V29yZENob2ljZUFuc3dlclJlc3BvbnNlElUKEEdlbmVyYXRlTW5lbW9uaWMSHy5hcGkudjEuR2VuZXJhdGVNbmVtb25pY1JlcXVlc3QaIC5hcGkudjEuR2VuZXJhdGVNbmVtb25pY1Jlc3BvbnNlEnMKGlN1Ym1pdERpc2NyaW1pbmF0aW9uQW5zd2VyEikuYXBpLnYxLlN1Ym1pdERpc2NyaW1pbmF0aW9uQW5zd2VyUmVxdWVzdBoqLmFwaS52MS5TdWJtaXREaXNjcmltaW5hdGlvbkFuc3dlclJlc3BvbnNlQjhaNmdpdGh1Yi5jb20vYXQtaXNoaWthd2EvbGFuZ25lci9nZW4tcHJvdG9zL2FwaS92MTthcGl2MWIGcHJvdG8z", [file_buf_validate_validate, file_api_v1_notebook]);
 
 /**
  * @generated from message api.v1.GetQuizOptionsRequest
@@ -136,6 +136,15 @@ export type NotebookSummary = Message<"api.v1.NotebookSummary"> & {
    * @generated from field: int32 vocabulary_count = 11;
    */
   vocabularyCount: number;
+
+  /**
+   * frequent_review_count is how many of the review_count words are very
+   * common or common, by the configured frequency list or else the
+   * dictionary's word frequency.
+   *
+   * @generated from field: int32 frequent_review_count = 12;
+   */
+  frequentReviewCount: number;
 };
 
 /**
@@ -1075,6 +1084,15 @@ export type OverrideAnswerResponse = Message<"api.v1.OverrideAnswerResponse"> &
    * @generated from field: int32 original_interval_days = 4;
    */
   originalIntervalDays: number;
+
+  /**
+   * original_grader is who graded the answer before the override
+   * ("model", "deterministic", "override" or "" for older logs), passed
+   * back on undo.
+   *
+   * @generated from field: string original_grader = 6;
+   */
+  originalGrader: string;
 };
 
 /**
@@ -1126,6 +1144,13 @@ export type UndoOverrideAnswerRequest = Message<"api.v1.UndoOverrideAnswerReques
    * @generated from field: string sense_id = 8;
    */
   senseId: string;
+
+  /**
+   * original_grader — see OverrideAnswerResponse.original_grader.
+   *
+   * @generated from field: string original_grader = 9;
+   */
+  originalGrader: string;
 };
 
 /**
@@ -1632,6 +1657,19 @@ export type RelearnCard = Message<"api.v1.RelearnCard"> & {
    * @generated from field: repeated api.v1.OriginFamilyMember related_words = 15;
    */
   relatedWords: OriginFamilyMember[];
+
+  /**
+   * audio and notebook_id name the line a QUIZ_TYPE_DICTATION card plays;
+   * pass them to NotebookService.StreamNoteAudio. Empty for other cards.
+   *
+   * @generated from field: string audio = 16;
+   */
+  audio: string;
+
+  /**
+   * @generated from field: string notebook_id = 17;
+   */
+  notebookId: string;
 };
 
 /**
@@ -1743,7 +1781,8 @@ export type SubmitRelearnAnswerResponse = Message<"api.v1.SubmitRelearnAnswerRes
    * what the live grammar quiz's feedback card shows (see
    * GrammarFeedbackCard.tsx): the reference fix, the mistake's category,
    * and the authored grammar note (separate from `reason`, which here
-   * carries the grader's critique of THIS answer).
+   * carries the grader's critique of THIS answer). For a QUIZ_TYPE_DICTATION
+   * card correct_answer is the line that was played.
    *
    * @generated from field: string correct_answer = 9;
    */
@@ -2424,6 +2463,606 @@ export type ResumeEtymologyWordResponse = Message<"api.v1.ResumeEtymologyWordRes
 export const ResumeEtymologyWordResponseSchema: GenMessage<ResumeEtymologyWordResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_quiz, 66);
 
+/**
+ * @generated from message api.v1.StartDictationQuizRequest
+ */
+export type StartDictationQuizRequest = Message<"api.v1.StartDictationQuizRequest"> & {
+  /**
+   * @generated from field: repeated string notebook_ids = 1;
+   */
+  notebookIds: string[];
+
+  /**
+   * @generated from field: bool include_unstudied = 2;
+   */
+  includeUnstudied: boolean;
+
+  /**
+   * notebook_sections, when non-empty, replaces notebook_ids and narrows the
+   * quiz to specific scenes within each story notebook.
+   *
+   * @generated from field: repeated api.v1.NotebookSection notebook_sections = 3;
+   */
+  notebookSections: NotebookSection[];
+};
+
+/**
+ * Describes the message api.v1.StartDictationQuizRequest.
+ * Use `create(StartDictationQuizRequestSchema)` to create a new message.
+ */
+export const StartDictationQuizRequestSchema: GenMessage<StartDictationQuizRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 67);
+
+/**
+ * @generated from message api.v1.StartDictationQuizResponse
+ */
+export type StartDictationQuizResponse = Message<"api.v1.StartDictationQuizResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.DictationCard cards = 1;
+   */
+  cards: DictationCard[];
+};
+
+/**
+ * Describes the message api.v1.StartDictationQuizResponse.
+ * Use `create(StartDictationQuizResponseSchema)` to create a new message.
+ */
+export const StartDictationQuizResponseSchema: GenMessage<StartDictationQuizResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 68);
+
+/**
+ * DictationCard is one line to play. The line's text is NOT sent to the
+ * client; it is returned by SubmitDictationAnswer once the user answered.
+ *
+ * @generated from message api.v1.DictationCard
+ */
+export type DictationCard = Message<"api.v1.DictationCard"> & {
+  /**
+   * @generated from field: int64 note_id = 1;
+   */
+  noteId: bigint;
+
+  /**
+   * @generated from field: string notebook_id = 2;
+   */
+  notebookId: string;
+
+  /**
+   * @generated from field: string story_title = 3;
+   */
+  storyTitle: string;
+
+  /**
+   * @generated from field: string scene_title = 4;
+   */
+  sceneTitle: string;
+
+  /**
+   * @generated from field: string speaker = 5;
+   */
+  speaker: string;
+
+  /**
+   * audio is the line's audio, relative to its notebook. Pass it with
+   * notebook_id to NotebookService.StreamNoteAudio.
+   *
+   * @generated from field: string audio = 6;
+   */
+  audio: string;
+
+  /**
+   * word_count is the number of words in the line, for sizing the answer box.
+   *
+   * @generated from field: int32 word_count = 7;
+   */
+  wordCount: number;
+};
+
+/**
+ * Describes the message api.v1.DictationCard.
+ * Use `create(DictationCardSchema)` to create a new message.
+ */
+export const DictationCardSchema: GenMessage<DictationCard> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 69);
+
+/**
+ * @generated from message api.v1.SubmitDictationAnswerRequest
+ */
+export type SubmitDictationAnswerRequest = Message<"api.v1.SubmitDictationAnswerRequest"> & {
+  /**
+   * @generated from field: int64 note_id = 1;
+   */
+  noteId: bigint;
+
+  /**
+   * answer is what the user heard. When is_skipped is true the field is
+   * ignored and the backend records the result as incorrect without grading.
+   *
+   * @generated from field: string answer = 2;
+   */
+  answer: string;
+
+  /**
+   * @generated from field: int64 response_time_ms = 3;
+   */
+  responseTimeMs: bigint;
+
+  /**
+   * @generated from field: bool is_skipped = 4;
+   */
+  isSkipped: boolean;
+};
+
+/**
+ * Describes the message api.v1.SubmitDictationAnswerRequest.
+ * Use `create(SubmitDictationAnswerRequestSchema)` to create a new message.
+ */
+export const SubmitDictationAnswerRequestSchema: GenMessage<SubmitDictationAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 70);
+
+/**
+ * @generated from message api.v1.SubmitDictationAnswerResponse
+ */
+export type SubmitDictationAnswerResponse = Message<"api.v1.SubmitDictationAnswerResponse"> & {
+  /**
+   * @generated from field: bool correct = 1;
+   */
+  correct: boolean;
+
+  /**
+   * line is the conversation line that was played.
+   *
+   * @generated from field: string line = 2;
+   */
+  line: string;
+
+  /**
+   * expression is the target expression of the line.
+   *
+   * @generated from field: string expression = 3;
+   */
+  expression: string;
+
+  /**
+   * @generated from field: string meaning = 4;
+   */
+  meaning: string;
+
+  /**
+   * reason lists the words that were missed or misheard.
+   *
+   * @generated from field: string reason = 5;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: string next_review_date = 6;
+   */
+  nextReviewDate: string;
+
+  /**
+   * @generated from field: string learned_at = 7;
+   */
+  learnedAt: string;
+};
+
+/**
+ * Describes the message api.v1.SubmitDictationAnswerResponse.
+ * Use `create(SubmitDictationAnswerResponseSchema)` to create a new message.
+ */
+export const SubmitDictationAnswerResponseSchema: GenMessage<SubmitDictationAnswerResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 71);
+
+/**
+ * @generated from message api.v1.SubmitReverseAnswerAudioRequest
+ */
+export type SubmitReverseAnswerAudioRequest = Message<"api.v1.SubmitReverseAnswerAudioRequest"> & {
+  /**
+   * @generated from field: int64 note_id = 1;
+   */
+  noteId: bigint;
+
+  /**
+   * audio is the recorded answer, e.g. what MediaRecorder produced.
+   *
+   * @generated from field: bytes audio = 2;
+   */
+  audio: Uint8Array;
+
+  /**
+   * media_type is the type of audio, e.g. "audio/webm;codecs=opus".
+   *
+   * @generated from field: string media_type = 3;
+   */
+  mediaType: string;
+
+  /**
+   * @generated from field: int64 response_time_ms = 4;
+   */
+  responseTimeMs: bigint;
+};
+
+/**
+ * Describes the message api.v1.SubmitReverseAnswerAudioRequest.
+ * Use `create(SubmitReverseAnswerAudioRequestSchema)` to create a new message.
+ */
+export const SubmitReverseAnswerAudioRequestSchema: GenMessage<SubmitReverseAnswerAudioRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 72);
+
+/**
+ * @generated from message api.v1.SubmitReverseAnswerAudioResponse
+ */
+export type SubmitReverseAnswerAudioResponse = Message<"api.v1.SubmitReverseAnswerAudioResponse"> & {
+  /**
+   * result is the graded transcript, exactly as SubmitReverseAnswer returns
+   * it for a typed answer.
+   *
+   * @generated from field: api.v1.SubmitReverseAnswerResponse result = 1;
+   */
+  result?: SubmitReverseAnswerResponse;
+
+  /**
+   * transcript is what the speech-to-text service heard.
+   *
+   * @generated from field: string transcript = 2;
+   */
+  transcript: string;
+};
+
+/**
+ * Describes the message api.v1.SubmitReverseAnswerAudioResponse.
+ * Use `create(SubmitReverseAnswerAudioResponseSchema)` to create a new message.
+ */
+export const SubmitReverseAnswerAudioResponseSchema: GenMessage<SubmitReverseAnswerAudioResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 73);
+
+/**
+ * @generated from message api.v1.GenerateMnemonicRequest
+ */
+export type GenerateMnemonicRequest = Message<"api.v1.GenerateMnemonicRequest"> & {
+  /**
+   * @generated from field: int64 note_id = 1;
+   */
+  noteId: bigint;
+};
+
+/**
+ * Describes the message api.v1.GenerateMnemonicRequest.
+ * Use `create(GenerateMnemonicRequestSchema)` to create a new message.
+ */
+export const GenerateMnemonicRequestSchema: GenMessage<GenerateMnemonicRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 74);
+
+/**
+ * @generated from message api.v1.GenerateMnemonicResponse
+ */
+export type GenerateMnemonicResponse = Message<"api.v1.GenerateMnemonicResponse"> & {
+  /**
+   * memo is the memory hook written into the note.
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+};
+
+/**
+ * Describes the message api.v1.GenerateMnemonicResponse.
+ * Use `create(GenerateMnemonicResponseSchema)` to create a new message.
+ */
+export const GenerateMnemonicResponseSchema: GenMessage<GenerateMnemonicResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 75);
+
+/**
+ * @generated from message api.v1.StartWordChoiceQuizRequest
+ */
+export type StartWordChoiceQuizRequest = Message<"api.v1.StartWordChoiceQuizRequest"> & {
+  /**
+   * @generated from field: repeated string notebook_ids = 1;
+   */
+  notebookIds: string[];
+
+  /**
+   * @generated from field: bool include_unstudied = 2;
+   */
+  includeUnstudied: boolean;
+
+  /**
+   * notebook_sections, when non-empty, replaces notebook_ids and narrows the
+   * quiz to specific sessions within each definitions book.
+   *
+   * @generated from field: repeated api.v1.NotebookSection notebook_sections = 3;
+   */
+  notebookSections: NotebookSection[];
+};
+
+/**
+ * Describes the message api.v1.StartWordChoiceQuizRequest.
+ * Use `create(StartWordChoiceQuizRequestSchema)` to create a new message.
+ */
+export const StartWordChoiceQuizRequestSchema: GenMessage<StartWordChoiceQuizRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 76);
+
+/**
+ * @generated from message api.v1.StartWordChoiceQuizResponse
+ */
+export type StartWordChoiceQuizResponse = Message<"api.v1.StartWordChoiceQuizResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.WordChoiceCard cards = 1;
+   */
+  cards: WordChoiceCard[];
+};
+
+/**
+ * Describes the message api.v1.StartWordChoiceQuizResponse.
+ * Use `create(StartWordChoiceQuizResponseSchema)` to create a new message.
+ */
+export const StartWordChoiceQuizResponseSchema: GenMessage<StartWordChoiceQuizResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 77);
+
+/**
+ * WordChoiceCard is one question. A card with a sentence asks which of
+ * options fits its blank, and does not reveal which member it was written
+ * for. A card without one asks how expression differs from
+ * compare_expression.
+ *
+ * @generated from message api.v1.WordChoiceCard
+ */
+export type WordChoiceCard = Message<"api.v1.WordChoiceCard"> & {
+  /**
+   * @generated from field: int64 note_id = 1;
+   */
+  noteId: bigint;
+
+  /**
+   * @generated from field: string notebook_id = 2;
+   */
+  notebookId: string;
+
+  /**
+   * @generated from field: string concept_head = 3;
+   */
+  conceptHead: string;
+
+  /**
+   * @generated from field: string concept_meaning = 4;
+   */
+  conceptMeaning: string;
+
+  /**
+   * concept_kind is "synonym" or "antonym".
+   *
+   * @generated from field: string concept_kind = 5;
+   */
+  conceptKind: string;
+
+  /**
+   * sentence is an example with the member blanked out as "______".
+   *
+   * @generated from field: string sentence = 6;
+   */
+  sentence: string;
+
+  /**
+   * options are every member of the concept, in declaration order.
+   *
+   * @generated from field: repeated string options = 7;
+   */
+  options: string[];
+
+  /**
+   * expression and compare_expression are set for difference questions only.
+   *
+   * @generated from field: string expression = 8;
+   */
+  expression: string;
+
+  /**
+   * @generated from field: string compare_expression = 9;
+   */
+  compareExpression: string;
+};
+
+/**
+ * Describes the message api.v1.WordChoiceCard.
+ * Use `create(WordChoiceCardSchema)` to create a new message.
+ */
+export const WordChoiceCardSchema: GenMessage<WordChoiceCard> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 78);
+
+/**
+ * @generated from message api.v1.SubmitWordChoiceAnswerRequest
+ */
+export type SubmitWordChoiceAnswerRequest = Message<"api.v1.SubmitWordChoiceAnswerRequest"> & {
+  /**
+   * @generated from field: int64 note_id = 1;
+   */
+  noteId: bigint;
+
+  /**
+   * answer is the chosen member, or the explained difference. When
+   * is_skipped is true the field is ignored and the backend records the
+   * result as incorrect without grading.
+   *
+   * @generated from field: string answer = 2;
+   */
+  answer: string;
+
+  /**
+   * @generated from field: int64 response_time_ms = 3;
+   */
+  responseTimeMs: bigint;
+
+  /**
+   * @generated from field: bool is_skipped = 4;
+   */
+  isSkipped: boolean;
+};
+
+/**
+ * Describes the message api.v1.SubmitWordChoiceAnswerRequest.
+ * Use `create(SubmitWordChoiceAnswerRequestSchema)` to create a new message.
+ */
+export const SubmitWordChoiceAnswerRequestSchema: GenMessage<SubmitWordChoiceAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 79);
+
+/**
+ * @generated from message api.v1.SubmitWordChoiceAnswerResponse
+ */
+export type SubmitWordChoiceAnswerResponse = Message<"api.v1.SubmitWordChoiceAnswerResponse"> & {
+  /**
+   * @generated from field: bool correct = 1;
+   */
+  correct: boolean;
+
+  /**
+   * expression is the member the question was about.
+   *
+   * @generated from field: string expression = 2;
+   */
+  expression: string;
+
+  /**
+   * @generated from field: string meaning = 3;
+   */
+  meaning: string;
+
+  /**
+   * example is the sentence with the blank filled in.
+   *
+   * @generated from field: string example = 4;
+   */
+  example: string;
+
+  /**
+   * members are the members of the concept with their meanings.
+   *
+   * @generated from field: repeated api.v1.WordChoiceMember members = 5;
+   */
+  members: WordChoiceMember[];
+
+  /**
+   * @generated from field: string reason = 6;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: string next_review_date = 7;
+   */
+  nextReviewDate: string;
+
+  /**
+   * @generated from field: string learned_at = 8;
+   */
+  learnedAt: string;
+};
+
+/**
+ * Describes the message api.v1.SubmitWordChoiceAnswerResponse.
+ * Use `create(SubmitWordChoiceAnswerResponseSchema)` to create a new message.
+ */
+export const SubmitWordChoiceAnswerResponseSchema: GenMessage<SubmitWordChoiceAnswerResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 80);
+
+/**
+ * @generated from message api.v1.WordChoiceMember
+ */
+export type WordChoiceMember = Message<"api.v1.WordChoiceMember"> & {
+  /**
+   * @generated from field: string expression = 1;
+   */
+  expression: string;
+
+  /**
+   * @generated from field: string meaning = 2;
+   */
+  meaning: string;
+};
+
+/**
+ * Describes the message api.v1.WordChoiceMember.
+ * Use `create(WordChoiceMemberSchema)` to create a new message.
+ */
+export const WordChoiceMemberSchema: GenMessage<WordChoiceMember> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 81);
+
+/**
+ * @generated from message api.v1.SubmitDiscriminationAnswerRequest
+ */
+export type SubmitDiscriminationAnswerRequest = Message<"api.v1.SubmitDiscriminationAnswerRequest"> & {
+  /**
+   * @generated from field: string notebook_id = 1;
+   */
+  notebookId: string;
+
+  /**
+   * words are the two words of the pair.
+   *
+   * @generated from field: repeated api.v1.DiscriminationWord words = 2;
+   */
+  words: DiscriminationWord[];
+
+  /**
+   * quiz_types are the pair's ConfusionPair.quiz_types.
+   *
+   * @generated from field: repeated string quiz_types = 3;
+   */
+  quizTypes: string[];
+
+  /**
+   * @generated from field: bool correct = 4;
+   */
+  correct: boolean;
+};
+
+/**
+ * Describes the message api.v1.SubmitDiscriminationAnswerRequest.
+ * Use `create(SubmitDiscriminationAnswerRequestSchema)` to create a new message.
+ */
+export const SubmitDiscriminationAnswerRequestSchema: GenMessage<SubmitDiscriminationAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 82);
+
+/**
+ * @generated from message api.v1.DiscriminationWord
+ */
+export type DiscriminationWord = Message<"api.v1.DiscriminationWord"> & {
+  /**
+   * @generated from field: string sense_id = 1;
+   */
+  senseId: string;
+
+  /**
+   * @generated from field: string expression = 2;
+   */
+  expression: string;
+};
+
+/**
+ * Describes the message api.v1.DiscriminationWord.
+ * Use `create(DiscriminationWordSchema)` to create a new message.
+ */
+export const DiscriminationWordSchema: GenMessage<DiscriminationWord> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 83);
+
+/**
+ * @generated from message api.v1.SubmitDiscriminationAnswerResponse
+ */
+export type SubmitDiscriminationAnswerResponse = Message<"api.v1.SubmitDiscriminationAnswerResponse"> & {
+  /**
+   * moved_to_relearn_pool is true when a miss put the words in the Relearn
+   * Quiz pool.
+   *
+   * @generated from field: bool moved_to_relearn_pool = 1;
+   */
+  movedToRelearnPool: boolean;
+};
+
+/**
+ * Describes the message api.v1.SubmitDiscriminationAnswerResponse.
+ * Use `create(SubmitDiscriminationAnswerResponseSchema)` to create a new message.
+ */
+export const SubmitDiscriminationAnswerResponseSchema: GenMessage<SubmitDiscriminationAnswerResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_quiz, 84);
+
 /**
  * @generated from enum api.v1.QuizType
  */
@@ -2477,6 +3116,23 @@ export enum QuizType {
    * @generated from enum value: QUIZ_TYPE_GRAMMAR = 8;
    */
   GRAMMAR = 8,
+
+  /**
+   * QUIZ_TYPE_DICTATION plays a conversation line from a story scene and has
+   * the user type what they heard.
+   *
+   * @generated from enum value: QUIZ_TYPE_DICTATION = 9;
+   */
+  DICTATION = 9,
+
+  /**
+   * QUIZ_TYPE_WORD_CHOICE tells apart the members of a synonym or antonym
+   * concept of a definitions book: which member fits a sentence, or how two
+   * members differ.
+   *
+   * @generated from enum value: QUIZ_TYPE_WORD_CHOICE = 10;
+   */
+  WORD_CHOICE = 10,
 }
 
 /**
@@ -2545,6 +3201,19 @@ export const QuizService: GenService<{
     input: typeof BatchSubmitReverseAnswersRequestSchema;
     output: typeof BatchSubmitReverseAnswersResponseSchema;
   },
+  /**
+   * SubmitReverseAnswerAudio grades a spoken reverse-quiz answer: the audio
+   * is transcribed with the configured speech-to-text service and the
+   * transcript is graded and recorded like a typed SubmitReverseAnswer.
+   * Fails with FAILED_PRECONDITION when no speech-to-text service is set up.
+   *
+   * @generated from rpc api.v1.QuizService.SubmitReverseAnswerAudio
+   */
+  submitReverseAnswerAudio: {
+    methodKind: "unary";
+    input: typeof SubmitReverseAnswerAudioRequestSchema;
+    output: typeof SubmitReverseAnswerAudioResponseSchema;
+  },
   /**
    * @generated from rpc api.v1.QuizService.StartFreeformQuiz
    */
@@ -2703,6 +3372,79 @@ export const QuizService: GenService<{
     input: typeof ResumeGrammarMistakeRequestSchema;
     output: typeof ResumeGrammarMistakeResponseSchema;
   },
+  /**
+   * Dictation Quiz — plays a conversation line containing a story expression
+   * and has the user type what they heard. The answer is graded by word-level
+   * edit distance with the target expression weighted, and recorded in the
+   * expression's dictation learning history. The line's audio is streamed
+   * with NotebookService.StreamNoteAudio.
+   *
+   * @generated from rpc api.v1.QuizService.StartDictationQuiz
+   */
+  startDictationQuiz: {
+    methodKind: "unary";
+    input: typeof StartDictationQuizRequestSchema;
+    output: typeof StartDictationQuizResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.QuizService.SubmitDictationAnswer
+   */
+  submitDictationAnswer: {
+    methodKind: "unary";
+    input: typeof SubmitDictationAnswerRequestSchema;
+    output: typeof SubmitDictationAnswerResponseSchema;
+  },
+  /**
+   * Word Choice Quiz — asks about one member of a synonym or antonym concept
+   * of a definitions book: which member fits the blank of its example
+   * sentence, or, for a member without an example, how it differs from
+   * another member. Answers are graded by the model, falling back to a
+   * deterministic grade, and recorded in the member's word choice learning
+   * history.
+   *
+   * @generated from rpc api.v1.QuizService.StartWordChoiceQuiz
+   */
+  startWordChoiceQuiz: {
+    methodKind: "unary";
+    input: typeof StartWordChoiceQuizRequestSchema;
+    output: typeof StartWordChoiceQuizResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.QuizService.SubmitWordChoiceAnswer
+   */
+  submitWordChoiceAnswer: {
+    methodKind: "unary";
+    input: typeof SubmitWordChoiceAnswerRequestSchema;
+    output: typeof SubmitWordChoiceAnswerResponseSchema;
+  },
+  /**
+   * GenerateMnemonic writes an LLM-generated memory hook into the memo of
+   * the note a quiz card was built from, the same way `langner notebooks
+   * enrich --mnemonics` does. Fails with FAILED_PRECONDITION when the note
+   * already has a memo or has no meaning to hook to.
+   *
+   * @generated from rpc api.v1.QuizService.GenerateMnemonic
+   */
+  generateMnemonic: {
+    methodKind: "unary";
+    input: typeof GenerateMnemonicRequestSchema;
+    output: typeof GenerateMnemonicResponseSchema;
+  },
+  /**
+   * SubmitDiscriminationAnswer reports an answer of the discrimination
+   * drill on a confusion pair (AnalyticsService.GetConfusions). A miss moves
+   * the studied words of the pair into the Relearn Quiz pool for the quiz
+   * types they were confused in, so they are drilled there until answered
+   * correctly, which also resolves the pair. A correct answer changes
+   * nothing.
+   *
+   * @generated from rpc api.v1.QuizService.SubmitDiscriminationAnswer
+   */
+  submitDiscriminationAnswer: {
+    methodKind: "unary";
+    input: typeof SubmitDiscriminationAnswerRequestSchema;
+    output: typeof SubmitDiscriminationAnswerResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_quiz, 0);
 
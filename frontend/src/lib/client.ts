@@ -115,6 +115,9 @@ export type {
   TrendSeries,
   TrendsSummary,
   BacklogSnapshot,
+  ConfusionPair,
+  ConfusionWord,
+  GetFrequencyCoverageResponse,
 } from "@/gen-protos/api/v1/analytics_pb";
 
 export { Granularity, TrendGroupBy } from "@/gen-protos/api/v1/analytics_pb";